@@ -0,0 +1,127 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package aggregation
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/m3db/m3/src/metrics/aggregation"
+
+	"github.com/cespare/xxhash"
+)
+
+const (
+	// setPrecision is the number of hash bits used to select a register,
+	// giving a standard error of roughly 1.04/sqrt(2^12) ~= 1.6%.
+	setPrecision    = 12
+	setNumRegisters = 1 << setPrecision
+)
+
+var (
+	setAlpha = 0.7213 / (1 + 1.079/float64(setNumRegisters))
+)
+
+// Set estimates the number of distinct values received using HyperLogLog.
+type Set struct {
+	Options
+
+	registers []uint8
+	count     int64
+	estimates float64
+}
+
+// NewSet creates a new set.
+func NewSet(opts Options) Set {
+	return Set{
+		Options: opts,
+	}
+}
+
+// Update adds a value to the set.
+func (s *Set) Update(value []byte) {
+	if s.registers == nil {
+		s.registers = make([]uint8, setNumRegisters)
+	}
+	h := xxhash.Sum64(value)
+	idx := h >> (64 - setPrecision)
+	// The sentinel bit bounds the rank when the remaining bits are all zero.
+	rank := uint8(bits.LeadingZeros64(h<<setPrecision|1<<(setPrecision-1))) + 1
+	if s.registers[idx] < rank {
+		s.registers[idx] = rank
+	}
+	s.count++
+}
+
+// UpdateEstimate adds an already estimated distinct count to the set, in which
+// case estimates are summed. Estimates of different series may count the same
+// values so set aggregations are never rolled up or forwarded.
+func (s *Set) UpdateEstimate(value float64) {
+	s.estimates += value
+	s.count++
+}
+
+// Count returns the number of values received.
+func (s *Set) Count() int64 { return s.count }
+
+// CountDistinct returns the estimated number of distinct values received.
+func (s *Set) CountDistinct() float64 {
+	return s.estimate() + s.estimates
+}
+
+func (s *Set) estimate() float64 {
+	if s.registers == nil {
+		return 0
+	}
+	var (
+		sum   float64
+		zeros int
+	)
+	for _, r := range s.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	m := float64(setNumRegisters)
+	e := setAlpha * m * m / sum
+	if e <= 2.5*m && zeros > 0 {
+		// Use linear counting for small cardinalities.
+		e = m * math.Log(m/float64(zeros))
+	}
+	return math.Round(e)
+}
+
+// ValueOf returns the value for the aggregation type.
+func (s *Set) ValueOf(aggType aggregation.Type) float64 {
+	switch aggType {
+	case aggregation.Count:
+		return float64(s.Count())
+	case aggregation.CountDistinct:
+		return s.CountDistinct()
+	default:
+		return 0
+	}
+}
+
+// Close closes the set.
+func (s *Set) Close() {
+	s.registers = nil
+}
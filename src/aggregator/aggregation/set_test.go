@@ -0,0 +1,78 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package aggregation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/m3db/m3/src/metrics/aggregation"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetEmpty(t *testing.T) {
+	s := NewSet(NewOptions())
+	require.Equal(t, 0.0, s.ValueOf(aggregation.Count))
+	require.Equal(t, 0.0, s.ValueOf(aggregation.CountDistinct))
+}
+
+func TestSetDuplicateValues(t *testing.T) {
+	s := NewSet(NewOptions())
+	for i := 0; i < 100; i++ {
+		s.Update([]byte(fmt.Sprintf("user%d", i%10)))
+	}
+	require.Equal(t, int64(100), s.Count())
+	require.Equal(t, 10.0, s.CountDistinct())
+}
+
+func TestSetCountDistinctAccuracy(t *testing.T) {
+	for _, n := range []int{100, 1000, 10000, 100000} {
+		s := NewSet(NewOptions())
+		for i := 0; i < n; i++ {
+			s.Update([]byte(fmt.Sprintf("device%d", i)))
+		}
+		require.InEpsilon(t, float64(n), s.CountDistinct(), 0.05)
+	}
+}
+
+func TestSetUpdateEstimate(t *testing.T) {
+	s := NewSet(NewOptions())
+	s.Update([]byte("foo"))
+	s.UpdateEstimate(10)
+	s.UpdateEstimate(5)
+	require.Equal(t, 3.0, s.ValueOf(aggregation.Count))
+	require.Equal(t, 16.0, s.ValueOf(aggregation.CountDistinct))
+}
+
+func TestSetValueOf(t *testing.T) {
+	s := NewSet(NewOptions())
+	s.Update([]byte("foo"))
+	for aggType := range aggregation.ValidTypes {
+		v := s.ValueOf(aggType)
+		switch aggType {
+		case aggregation.Count, aggregation.CountDistinct:
+			require.Equal(t, 1.0, v)
+		default:
+			require.Equal(t, 0.0, v)
+			require.False(t, aggType.IsValidForSet())
+		}
+	}
+}
@@ -54,3 +54,20 @@ type gaugeAggregation struct {
 func newGaugeAggregation(g aggregation.Gauge) gaugeAggregation   { return gaugeAggregation{Gauge: g} }
 func (g *gaugeAggregation) Add(value float64)                    { g.Gauge.Update(value) }
 func (g *gaugeAggregation) AddUnion(mu unaggregated.MetricUnion) { g.Gauge.Update(mu.GaugeVal) }
+
+// setAggregation is a set aggregation.
+type setAggregation struct {
+	aggregation.Set
+}
+
+func newSetAggregation(s aggregation.Set) setAggregation { return setAggregation{Set: s} }
+
+// Add adds a distinct count estimate. Set aggregations are never rolled up or
+// forwarded since estimates cannot be merged across series.
+func (s *setAggregation) Add(value float64) { s.Set.UpdateEstimate(value) }
+
+func (s *setAggregation) AddUnion(mu unaggregated.MetricUnion) {
+	for _, v := range mu.SetVal {
+		s.Set.Update(v)
+	}
+}
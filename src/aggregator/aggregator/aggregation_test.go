@@ -105,3 +105,25 @@ func TestGaugeAggregationAddUnion(t *testing.T) {
 	require.Equal(t, int64(3), g.Count())
 	require.Equal(t, 123.456, g.Sum())
 }
+
+func TestSetAggregationAdd(t *testing.T) {
+	s := newSetAggregation(aggregation.NewSet(aggregation.NewOptions()))
+	s.Add(10)
+	s.Add(5)
+	require.Equal(t, int64(2), s.Count())
+	require.Equal(t, 15.0, s.CountDistinct())
+}
+
+func TestSetAggregationAddUnion(t *testing.T) {
+	s := newSetAggregation(aggregation.NewSet(aggregation.NewOptions()))
+	s.AddUnion(unaggregated.MetricUnion{
+		Type:   metric.SetType,
+		SetVal: [][]byte{[]byte("foo"), []byte("bar")},
+	})
+	s.AddUnion(unaggregated.MetricUnion{
+		Type:   metric.SetType,
+		SetVal: [][]byte{[]byte("foo")},
+	})
+	require.Equal(t, int64(3), s.Count())
+	require.Equal(t, 2.0, s.CountDistinct())
+}
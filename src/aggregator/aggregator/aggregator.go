@@ -31,6 +31,7 @@ import (
 
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/client"
+	schema "github.com/m3db/m3/src/aggregator/generated/proto/flush"
 	"github.com/m3db/m3/src/aggregator/sharding"
 	"github.com/m3db/m3/src/cluster/placement"
 	"github.com/m3db/m3/src/cluster/shard"
@@ -43,6 +44,7 @@ import (
 	"github.com/m3db/m3/src/x/instrument"
 
 	"github.com/uber-go/tally"
+	"go.uber.org/zap"
 )
 
 const (
@@ -56,6 +58,7 @@ var (
 	errInvalidMetricType             = errors.New("invalid metric type")
 	errActivePlacementChanged        = errors.New("active placement has changed")
 	errShardNotOwned                 = errors.New("aggregator shard is not owned")
+	errFlushTimesWaitTimeout         = errors.New("timed out waiting for flush times")
 )
 
 // Aggregator aggregates different types of metrics.
@@ -90,6 +93,7 @@ type aggregator struct {
 
 	opts              Options
 	nowFn             clock.NowFn
+	logger            *zap.Logger
 	shardFn           sharding.ShardFn
 	checkInterval     time.Duration
 	placementManager  PlacementManager
@@ -101,6 +105,9 @@ type aggregator struct {
 	adminClient       client.AdminClient
 	resignTimeout     time.Duration
 
+	checkpointManager         CheckpointManager
+	checkpointRecoveryTimeout time.Duration
+
 	shardSetID          uint32
 	shardSetOpen        bool
 	shardIDs            []uint32
@@ -123,6 +130,7 @@ func NewAggregator(opts Options) Aggregator {
 	return &aggregator{
 		opts:              opts,
 		nowFn:             opts.ClockOptions().NowFn(),
+		logger:            iOpts.Logger(),
 		shardFn:           opts.ShardFn(),
 		checkInterval:     opts.EntryCheckInterval(),
 		placementManager:  opts.PlacementManager(),
@@ -136,6 +144,9 @@ func NewAggregator(opts Options) Aggregator {
 		metrics:           newAggregatorMetrics(scope, samplingRate, opts.MaxAllowedForwardingDelayFn()),
 		doneCh:            make(chan struct{}),
 		sleepFn:           time.Sleep,
+
+		checkpointManager:         opts.CheckpointManager(),
+		checkpointRecoveryTimeout: opts.CheckpointRecoveryTimeout(),
 	}
 }
 
@@ -149,6 +160,11 @@ func (agg *aggregator) Open() error {
 	if err := agg.placementManager.Open(); err != nil {
 		return err
 	}
+	if agg.checkpointManager != nil {
+		if err := agg.checkpointManager.Open(); err != nil {
+			return err
+		}
+	}
 	stagedPlacement, placement, err := agg.placementManager.Placement()
 	if err != nil {
 		return err
@@ -258,6 +274,11 @@ func (agg *aggregator) Close() error {
 		agg.closeShardSetWithLock()
 	}
 	agg.flushHandler.Close()
+	if agg.checkpointManager != nil {
+		if err := agg.checkpointManager.Close(); err != nil {
+			agg.logger.Error("error closing checkpoint manager", zap.Error(err))
+		}
+	}
 	if agg.adminClient != nil {
 		agg.adminClient.Close()
 	}
@@ -406,12 +427,87 @@ func (agg *aggregator) openShardSetWithLock(shardSetID uint32) error {
 	if err := agg.flushTimesManager.Open(shardSetID); err != nil {
 		return err
 	}
+	// NB: checkpoints are only recovered when the aggregator is being opened,
+	// and before the flush manager is opened so that recovered windows which
+	// have already been flushed are discarded before any flush happens.
+	if agg.checkpointManager != nil && agg.state == aggregatorNotOpen {
+		agg.recoverFromCheckpointsWithLock()
+	}
 	if err := agg.electionManager.Open(shardSetID); err != nil {
 		return err
 	}
 	return agg.flushManager.Open()
 }
 
+// recoverFromCheckpointsWithLock replays the checkpoints of the owned shards,
+// and then discards recovered data older than the last flush times of each
+// shard so windows already flushed before a restart are not flushed again.
+// If the flush times cannot be retrieved every recovered window that has
+// ended is discarded instead.
+func (agg *aggregator) recoverFromCheckpointsWithLock() {
+	var numRecovered int
+	for _, shardID := range agg.shardIDs {
+		recovered, failed, err := agg.shards[shardID].Recover(agg.checkpointManager)
+		numRecovered += recovered
+		agg.metrics.recovery.recovered.Inc(int64(recovered))
+		agg.metrics.recovery.failed.Inc(int64(failed))
+		if err != nil {
+			agg.metrics.recovery.readErrors.Inc(1)
+			agg.logger.Error("error recovering shard from checkpoints",
+				zap.Uint32("shard", shardID),
+				zap.Error(err),
+			)
+		}
+	}
+	if numRecovered == 0 {
+		return
+	}
+
+	flushTimes, err := agg.waitForFlushTimes()
+	if err != nil {
+		// NB: without the flush times it is unknown which recovered windows
+		// have already been flushed, so every recovered window that has ended
+		// is dropped rather than risk emitting it more than once.
+		agg.metrics.recovery.flushTimesErrors.Inc(1)
+		agg.logger.Warn("unable to retrieve flush times after recovery, "+
+			"dropping recovered windows that have ended",
+			zap.Error(err),
+		)
+		nowNanos := agg.nowFn().UnixNano()
+		for _, shardID := range agg.shardIDs {
+			agg.shards[shardID].DiscardBefore(nowNanos)
+		}
+		return
+	}
+	for _, shardID := range agg.shardIDs {
+		shardFlushTimes, exists := flushTimes.ByShard[shardID]
+		if !exists || shardFlushTimes == nil {
+			continue
+		}
+		agg.shards[shardID].DiscardFlushed(shardFlushTimes)
+	}
+	agg.logger.Info("recovered from checkpoints", zap.Int("numRecovered", numRecovered))
+}
+
+// waitForFlushTimes waits for the flush times of the shard set to be retrieved.
+func (agg *aggregator) waitForFlushTimes() (*schema.ShardSetFlushTimes, error) {
+	flushTimesWatch, err := agg.flushTimesManager.Watch()
+	if err != nil {
+		return nil, err
+	}
+	defer flushTimesWatch.Close()
+
+	timer := time.NewTimer(agg.checkpointRecoveryTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-flushTimesWatch.C():
+		return flushTimesWatch.Get().(*schema.ShardSetFlushTimes), nil
+	case <-timer.C:
+		return nil, errFlushTimesWaitTimeout
+	}
+}
+
 func (agg *aggregator) closeShardSetWithLock() error {
 	agg.metrics.shardSetID.close.Inc(1)
 	if err := agg.flushManager.Close(); err != nil {
@@ -495,6 +591,9 @@ func (agg *aggregator) checkMetricType(mu unaggregated.MetricUnion) error {
 	case metric.GaugeType:
 		agg.metrics.gauges.Inc(1)
 		return nil
+	case metric.SetType:
+		agg.metrics.sets.Inc(1)
+		return nil
 	default:
 		return errInvalidMetricType
 	}
@@ -885,11 +984,28 @@ func newAggregatorShardSetIDMetrics(scope tally.Scope) aggregatorShardSetIDMetri
 	}
 }
 
+type aggregatorRecoveryMetrics struct {
+	recovered        tally.Counter
+	failed           tally.Counter
+	readErrors       tally.Counter
+	flushTimesErrors tally.Counter
+}
+
+func newAggregatorRecoveryMetrics(scope tally.Scope) aggregatorRecoveryMetrics {
+	return aggregatorRecoveryMetrics{
+		recovered:        scope.Counter("recovered"),
+		failed:           scope.Counter("failed"),
+		readErrors:       scope.Counter("read-errors"),
+		flushTimesErrors: scope.Counter("flush-times-errors"),
+	}
+}
+
 type aggregatorMetrics struct {
 	counters     tally.Counter
 	timers       tally.Counter
 	timerBatches tally.Counter
 	gauges       tally.Counter
+	sets         tally.Counter
 	forwarded    tally.Counter
 	timed        tally.Counter
 	addUntimed   aggregatorAddUntimedMetrics
@@ -899,6 +1015,7 @@ type aggregatorMetrics struct {
 	shards       aggregatorShardsMetrics
 	shardSetID   aggregatorShardSetIDMetrics
 	tick         aggregatorTickMetrics
+	recovery     aggregatorRecoveryMetrics
 }
 
 func newAggregatorMetrics(
@@ -913,11 +1030,13 @@ func newAggregatorMetrics(
 	shardsScope := scope.SubScope("shards")
 	shardSetIDScope := scope.SubScope("shard-set-id")
 	tickScope := scope.SubScope("tick")
+	recoveryScope := scope.SubScope("recovery")
 	return aggregatorMetrics{
 		counters:     scope.Counter("counters"),
 		timers:       scope.Counter("timers"),
 		timerBatches: scope.Counter("timer-batches"),
 		gauges:       scope.Counter("gauges"),
+		sets:         scope.Counter("sets"),
 		forwarded:    scope.Counter("forwarded"),
 		timed:        scope.Counter("timed"),
 		addUntimed:   newAggregatorAddUntimedMetrics(addUntimedScope, samplingRate),
@@ -927,6 +1046,7 @@ func newAggregatorMetrics(
 		shards:       newAggregatorShardsMetrics(shardsScope),
 		shardSetID:   newAggregatorShardSetIDMetrics(shardSetIDScope),
 		tick:         newAggregatorTickMetrics(tickScope),
+		recovery:     newAggregatorRecoveryMetrics(recoveryScope),
 	}
 }
 
@@ -55,6 +55,11 @@ var (
 		ID:       id.RawID("testCounter"),
 		GaugeVal: 123.456,
 	}
+	testSet = unaggregated.MetricUnion{
+		Type:   metric.SetType,
+		ID:     id.RawID("testSet"),
+		SetVal: [][]byte{[]byte("foo"), []byte("bar")},
+	}
 	testTimed = aggregated.Metric{
 		Type:      metric.CounterType,
 		ID:        []byte("testForwarded"),
@@ -102,7 +107,7 @@ func TestAggregator(t *testing.T) {
 
 	// Add valid untimed metrics with policies.
 	var expected SnapshotResult
-	for _, mu := range []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge} {
+	for _, mu := range []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge, testSet} {
 		switch mu.Type {
 		case metric.CounterType:
 			expected.CountersWithMetadatas = append(
@@ -125,6 +130,13 @@ func TestAggregator(t *testing.T) {
 					Gauge:           mu.Gauge(),
 					StagedMetadatas: metadatas,
 				})
+		case metric.SetType:
+			expected.SetsWithMetadatas = append(
+				expected.SetsWithMetadatas,
+				unaggregated.SetWithMetadatas{
+					Set:             mu.Set(),
+					StagedMetadatas: metadatas,
+				})
 		default:
 			require.Fail(t, fmt.Sprintf("unknown metric type %v", mu.Type))
 		}
@@ -141,7 +153,7 @@ func TestAggregator(t *testing.T) {
 	)
 	require.NoError(t, agg.AddTimed(testTimed, testTimedMetadata))
 
-	require.Equal(t, 4, agg.NumMetricsAdded())
+	require.Equal(t, 5, agg.NumMetricsAdded())
 
 	// Add valid forwarded metrics with metadata.
 	expected.ForwardedMetricsWithMetadata = append(
@@ -153,7 +165,7 @@ func TestAggregator(t *testing.T) {
 	)
 	require.NoError(t, agg.AddForwarded(testForwarded, testForwardMetadata))
 
-	require.Equal(t, 5, agg.NumMetricsAdded())
+	require.Equal(t, 6, agg.NumMetricsAdded())
 
 	res := agg.Snapshot()
 	require.Equal(t, expected, res)
@@ -43,6 +43,7 @@ type SnapshotResult struct {
 	CountersWithMetadatas        []unaggregated.CounterWithMetadatas
 	BatchTimersWithMetadatas     []unaggregated.BatchTimerWithMetadatas
 	GaugesWithMetadatas          []unaggregated.GaugeWithMetadatas
+	SetsWithMetadatas            []unaggregated.SetWithMetadatas
 	ForwardedMetricsWithMetadata []aggregated.ForwardedMetricWithMetadata
 	TimedMetricWithMetadata      []aggregated.TimedMetricWithMetadata
 }
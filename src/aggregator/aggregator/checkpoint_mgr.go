@@ -0,0 +1,650 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/metrics/encoding"
+	"github.com/m3db/m3/src/metrics/encoding/protobuf"
+	"github.com/m3db/m3/src/metrics/metadata"
+	"github.com/m3db/m3/src/metrics/metric"
+	"github.com/m3db/m3/src/metrics/metric/aggregated"
+	"github.com/m3db/m3/src/metrics/metric/unaggregated"
+	"github.com/m3db/m3/src/x/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	checkpointShardDirPrefix  = "shard-"
+	checkpointSegmentPrefix   = "checkpoint-"
+	checkpointSegmentSuffix   = ".db"
+	checkpointDirPermissions  = 0755
+	checkpointFilePermissions = 0644
+)
+
+var (
+	errCheckpointManagerNotOpenOrClosed     = errors.New("checkpoint manager not open or closed")
+	errCheckpointManagerAlreadyOpenOrClosed = errors.New("checkpoint manager already open or closed")
+	errCheckpointDirectoryNotSet            = errors.New("checkpoint directory not set")
+	errCheckpointWriterClosed               = errors.New("checkpoint writer closed")
+)
+
+// CheckpointManager checkpoints metrics added to in-flight aggregation windows
+// to local disk so they can be replayed after a restart. Each shard has its own
+// sequence of append-only segment files, and each record in a segment is the
+// time the metric was added to the aggregator followed by the metric encoded as
+// an unaggregated protobuf message.
+type CheckpointManager interface {
+	// Open opens the checkpoint manager.
+	Open() error
+
+	// Write buffers a message added to the given shard at the given time. The
+	// message is persisted to disk on the next checkpoint.
+	Write(shard uint32, addedAtNanos int64, msg encoding.UnaggregatedMessageUnion) error
+
+	// Read reads the checkpointed messages of a shard in the order they were
+	// written. The message passed to the read function is only valid for the
+	// duration of the call. Read should be called before any message is written
+	// to the shard.
+	Read(shard uint32, fn CheckpointReadFn) error
+
+	// Close persists buffered messages and closes the checkpoint manager.
+	Close() error
+}
+
+// CheckpointReadFn is called with each checkpointed message alongside
+// the time the message was added to the aggregator.
+type CheckpointReadFn func(addedAtNanos int64, msg encoding.UnaggregatedMessageUnion) error
+
+type checkpointManagerState int
+
+const (
+	checkpointManagerNotOpen checkpointManagerState = iota
+	checkpointManagerOpen
+	checkpointManagerClosed
+)
+
+type checkpointManagerMetrics struct {
+	checkpoint        instrument.MethodMetrics
+	writeErrors       tally.Counter
+	segmentsRemoved   tally.Counter
+	segmentsCorrupted tally.Counter
+	messagesRead      tally.Counter
+}
+
+func newCheckpointManagerMetrics(scope tally.Scope) checkpointManagerMetrics {
+	return checkpointManagerMetrics{
+		checkpoint:        instrument.NewMethodMetrics(scope, "checkpoint", 1.0),
+		writeErrors:       scope.Counter("write-errors"),
+		segmentsRemoved:   scope.Counter("segments-removed"),
+		segmentsCorrupted: scope.Counter("segments-corrupted"),
+		messagesRead:      scope.Counter("messages-read"),
+	}
+}
+
+type checkpointManager struct {
+	sync.RWMutex
+	sync.WaitGroup
+
+	nowFn              clock.NowFn
+	logger             *zap.Logger
+	dir                string
+	checkpointInterval time.Duration
+	segmentDuration    time.Duration
+	retentionPeriod    time.Duration
+	maxBufferSize      int
+	encodingOpts       protobuf.UnaggregatedOptions
+
+	state         checkpointManagerState
+	doneCh        chan struct{}
+	writers       map[uint32]*shardCheckpointWriter
+	toCheckpoint  []*shardCheckpointWriter
+	lastCleanupAt time.Time
+	metrics       checkpointManagerMetrics
+}
+
+// NewCheckpointManager creates a new checkpoint manager.
+func NewCheckpointManager(opts CheckpointManagerOptions) CheckpointManager {
+	instrumentOpts := opts.InstrumentOptions()
+	return &checkpointManager{
+		nowFn:              opts.ClockOptions().NowFn(),
+		logger:             instrumentOpts.Logger(),
+		dir:                opts.Directory(),
+		checkpointInterval: opts.CheckpointInterval(),
+		segmentDuration:    opts.SegmentDuration(),
+		retentionPeriod:    opts.RetentionPeriod(),
+		maxBufferSize:      opts.MaxBufferSize(),
+		encodingOpts:       opts.EncodingOptions(),
+		doneCh:             make(chan struct{}),
+		writers:            make(map[uint32]*shardCheckpointWriter),
+		metrics:            newCheckpointManagerMetrics(instrumentOpts.MetricsScope()),
+	}
+}
+
+func (mgr *checkpointManager) Open() error {
+	mgr.Lock()
+	defer mgr.Unlock()
+
+	if mgr.state != checkpointManagerNotOpen {
+		return errCheckpointManagerAlreadyOpenOrClosed
+	}
+	if mgr.dir == "" {
+		return errCheckpointDirectoryNotSet
+	}
+	if err := os.MkdirAll(mgr.dir, checkpointDirPermissions); err != nil {
+		return err
+	}
+	mgr.state = checkpointManagerOpen
+	mgr.lastCleanupAt = mgr.nowFn()
+
+	mgr.Add(1)
+	go mgr.checkpointLoop()
+
+	return nil
+}
+
+func (mgr *checkpointManager) Write(
+	shard uint32,
+	addedAtNanos int64,
+	msg encoding.UnaggregatedMessageUnion,
+) error {
+	w, err := mgr.findOrCreateWriter(shard)
+	if err != nil {
+		return err
+	}
+	if err := w.Write(addedAtNanos, msg); err != nil {
+		mgr.metrics.writeErrors.Inc(1)
+		return err
+	}
+	return nil
+}
+
+func (mgr *checkpointManager) Read(shard uint32, fn CheckpointReadFn) error {
+	mgr.RLock()
+	if mgr.state != checkpointManagerOpen {
+		mgr.RUnlock()
+		return errCheckpointManagerNotOpenOrClosed
+	}
+	mgr.RUnlock()
+
+	segments, err := checkpointSegments(mgr.shardDir(shard))
+	if err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		if err := mgr.readSegment(segment.path, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mgr *checkpointManager) Close() error {
+	mgr.Lock()
+	if mgr.state != checkpointManagerOpen {
+		mgr.Unlock()
+		return errCheckpointManagerNotOpenOrClosed
+	}
+	close(mgr.doneCh)
+	mgr.state = checkpointManagerClosed
+	mgr.Unlock()
+
+	mgr.Wait()
+
+	var multiErr xerrors.MultiError
+	now := mgr.nowFn()
+	for _, w := range mgr.writers {
+		multiErr = multiErr.Add(w.Close(now))
+	}
+	return multiErr.FinalError()
+}
+
+func (mgr *checkpointManager) findOrCreateWriter(shard uint32) (*shardCheckpointWriter, error) {
+	mgr.RLock()
+	if mgr.state != checkpointManagerOpen {
+		mgr.RUnlock()
+		return nil, errCheckpointManagerNotOpenOrClosed
+	}
+	w, exists := mgr.writers[shard]
+	mgr.RUnlock()
+	if exists {
+		return w, nil
+	}
+
+	mgr.Lock()
+	defer mgr.Unlock()
+
+	if mgr.state != checkpointManagerOpen {
+		return nil, errCheckpointManagerNotOpenOrClosed
+	}
+	if w, exists = mgr.writers[shard]; exists {
+		return w, nil
+	}
+	w = newShardCheckpointWriter(
+		mgr.shardDir(shard),
+		mgr.segmentDuration,
+		mgr.maxBufferSize,
+		mgr.nowFn,
+		mgr.encodingOpts,
+	)
+	mgr.writers[shard] = w
+	return w, nil
+}
+
+func (mgr *checkpointManager) shardDir(shard uint32) string {
+	return filepath.Join(mgr.dir, fmt.Sprintf("%s%d", checkpointShardDirPrefix, shard))
+}
+
+func (mgr *checkpointManager) readSegment(path string, fn CheckpointReadFn) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	it := protobuf.NewUnaggregatedIterator(r, mgr.encodingOpts)
+	defer it.Close()
+
+	for {
+		addedAtNanos, err := binary.ReadVarint(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err == nil && !it.Next() {
+			err = it.Err()
+		}
+		if err != nil {
+			// A partially written record at the end of a segment is expected if
+			// the process did not shut down cleanly, so the rest of the segment
+			// is skipped instead of failing the read.
+			mgr.metrics.segmentsCorrupted.Inc(1)
+			mgr.logger.Warn("skipping corrupted checkpoint segment remainder",
+				zap.String("path", path),
+				zap.Error(err),
+			)
+			return nil
+		}
+		mgr.metrics.messagesRead.Inc(1)
+		if err := fn(addedAtNanos, it.Current()); err != nil {
+			return err
+		}
+	}
+}
+
+func (mgr *checkpointManager) checkpointLoop() {
+	defer mgr.Done()
+
+	ticker := time.NewTicker(mgr.checkpointInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			mgr.checkpoint()
+		case <-mgr.doneCh:
+			return
+		}
+	}
+}
+
+func (mgr *checkpointManager) checkpoint() {
+	mgr.RLock()
+	mgr.toCheckpoint = mgr.toCheckpoint[:0]
+	for _, w := range mgr.writers {
+		mgr.toCheckpoint = append(mgr.toCheckpoint, w)
+	}
+	mgr.RUnlock()
+
+	start := mgr.nowFn()
+	for _, w := range mgr.toCheckpoint {
+		if err := w.Checkpoint(start); err != nil {
+			mgr.metrics.checkpoint.ReportError(mgr.nowFn().Sub(start))
+			mgr.logger.Error("checkpoint error",
+				zap.String("dir", w.dir),
+				zap.Error(err),
+			)
+			continue
+		}
+		mgr.metrics.checkpoint.ReportSuccess(mgr.nowFn().Sub(start))
+	}
+
+	if start.Sub(mgr.lastCleanupAt) < mgr.segmentDuration {
+		return
+	}
+	mgr.lastCleanupAt = start
+	if err := mgr.removeExpiredSegments(start); err != nil {
+		mgr.logger.Error("checkpoint segment cleanup error", zap.Error(err))
+	}
+}
+
+// removeExpiredSegments removes segments that have been closed for longer than
+// the retention period, including segments of shards no longer owned.
+func (mgr *checkpointManager) removeExpiredSegments(now time.Time) error {
+	shardDirs, err := filepath.Glob(filepath.Join(mgr.dir, checkpointShardDirPrefix+"*"))
+	if err != nil {
+		return err
+	}
+	expireBeforeNanos := now.Add(-mgr.retentionPeriod - mgr.segmentDuration).UnixNano()
+	for _, shardDir := range shardDirs {
+		segments, err := checkpointSegments(shardDir)
+		if err != nil {
+			return err
+		}
+		for _, segment := range segments {
+			if segment.startNanos >= expireBeforeNanos {
+				break
+			}
+			if err := os.Remove(segment.path); err != nil {
+				return err
+			}
+			mgr.metrics.segmentsRemoved.Inc(1)
+		}
+	}
+	return nil
+}
+
+func checkpointSegmentPath(shardDir string, startNanos int64) string {
+	name := fmt.Sprintf("%s%d%s", checkpointSegmentPrefix, startNanos, checkpointSegmentSuffix)
+	return filepath.Join(shardDir, name)
+}
+
+type checkpointSegment struct {
+	path       string
+	startNanos int64
+}
+
+// checkpointSegments returns the segments in a shard directory sorted by start time.
+func checkpointSegments(shardDir string) ([]checkpointSegment, error) {
+	paths, err := filepath.Glob(filepath.Join(
+		shardDir,
+		checkpointSegmentPrefix+"*"+checkpointSegmentSuffix,
+	))
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]checkpointSegment, 0, len(paths))
+	for _, path := range paths {
+		name := strings.TrimSuffix(
+			strings.TrimPrefix(filepath.Base(path), checkpointSegmentPrefix),
+			checkpointSegmentSuffix,
+		)
+		startNanos, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, checkpointSegment{path: path, startNanos: startNanos})
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].startNanos < segments[j].startNanos
+	})
+	return segments, nil
+}
+
+// shardCheckpointWriter buffers checkpointed messages for a shard and
+// periodically appends them to the current segment file of the shard.
+type shardCheckpointWriter struct {
+	sync.Mutex
+
+	dir             string
+	segmentDuration time.Duration
+	maxBufferSize   int
+	nowFn           clock.NowFn
+
+	closed        bool
+	encoder       protobuf.UnaggregatedEncoder
+	addedAtNanos  []int64
+	msgEndOffsets []int
+
+	// NB: the persist lock serializes writes to the segment file so
+	// the writer lock does not need to be held during disk I/O.
+	persistLock        sync.Mutex
+	spareAddedAtNanos  []int64
+	spareMsgEndOffsets []int
+	fd                 *os.File
+	bw                 *bufio.Writer
+	segmentStart       time.Time
+	varintBuf          [binary.MaxVarintLen64]byte
+}
+
+func newShardCheckpointWriter(
+	dir string,
+	segmentDuration time.Duration,
+	maxBufferSize int,
+	nowFn clock.NowFn,
+	encodingOpts protobuf.UnaggregatedOptions,
+) *shardCheckpointWriter {
+	return &shardCheckpointWriter{
+		dir:             dir,
+		segmentDuration: segmentDuration,
+		maxBufferSize:   maxBufferSize,
+		nowFn:           nowFn,
+		encoder:         protobuf.NewUnaggregatedEncoder(encodingOpts),
+	}
+}
+
+// Write buffers a message, persisting buffered messages if the buffer is full.
+func (w *shardCheckpointWriter) Write(
+	addedAtNanos int64,
+	msg encoding.UnaggregatedMessageUnion,
+) error {
+	w.Lock()
+	if w.closed {
+		w.Unlock()
+		return errCheckpointWriterClosed
+	}
+	prevLen := w.encoder.Len()
+	if err := w.encoder.EncodeMessage(msg); err != nil {
+		// Discard any partially encoded bytes.
+		w.encoder.Truncate(prevLen) // nolint: errcheck
+		w.Unlock()
+		return err
+	}
+	w.addedAtNanos = append(w.addedAtNanos, addedAtNanos)
+	w.msgEndOffsets = append(w.msgEndOffsets, w.encoder.Len())
+	shouldPersist := w.encoder.Len() >= w.maxBufferSize
+	w.Unlock()
+
+	if !shouldPersist {
+		return nil
+	}
+	return w.Checkpoint(w.nowFn())
+}
+
+// Checkpoint persists buffered messages to the current segment, and closes the
+// current segment if it has been open for longer than the segment duration.
+func (w *shardCheckpointWriter) Checkpoint(now time.Time) error {
+	w.persistLock.Lock()
+	defer w.persistLock.Unlock()
+
+	if err := w.persistWithPersistLock(now); err != nil {
+		return err
+	}
+	if w.fd == nil || now.Sub(w.segmentStart) < w.segmentDuration {
+		return nil
+	}
+	return w.closeSegmentWithPersistLock()
+}
+
+// Close persists buffered messages and closes the current segment.
+func (w *shardCheckpointWriter) Close(now time.Time) error {
+	w.persistLock.Lock()
+	defer w.persistLock.Unlock()
+
+	err := w.persistWithPersistLock(now)
+	w.Lock()
+	w.closed = true
+	w.Unlock()
+	if w.fd == nil {
+		return err
+	}
+	if closeErr := w.closeSegmentWithPersistLock(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (w *shardCheckpointWriter) persistWithPersistLock(now time.Time) error {
+	w.Lock()
+	if len(w.addedAtNanos) == 0 {
+		w.Unlock()
+		return nil
+	}
+	buf := w.encoder.Relinquish()
+	w.encoder.Reset(nil)
+	addedAtNanos, msgEndOffsets := w.addedAtNanos, w.msgEndOffsets
+	w.addedAtNanos, w.msgEndOffsets = w.spareAddedAtNanos[:0], w.spareMsgEndOffsets[:0]
+	w.Unlock()
+
+	err := w.writeRecords(now, buf.Bytes(), addedAtNanos, msgEndOffsets)
+	buf.Close()
+	w.spareAddedAtNanos, w.spareMsgEndOffsets = addedAtNanos[:0], msgEndOffsets[:0]
+	return err
+}
+
+func (w *shardCheckpointWriter) writeRecords(
+	now time.Time,
+	encoded []byte,
+	addedAtNanos []int64,
+	msgEndOffsets []int,
+) error {
+	if w.fd == nil {
+		if err := w.openSegmentWithPersistLock(now); err != nil {
+			return err
+		}
+	}
+	start := 0
+	for i, end := range msgEndOffsets {
+		n := binary.PutVarint(w.varintBuf[:], addedAtNanos[i])
+		if _, err := w.bw.Write(w.varintBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := w.bw.Write(encoded[start:end]); err != nil {
+			return err
+		}
+		start = end
+	}
+	if err := w.bw.Flush(); err != nil {
+		return err
+	}
+	return w.fd.Sync()
+}
+
+func (w *shardCheckpointWriter) openSegmentWithPersistLock(now time.Time) error {
+	if err := os.MkdirAll(w.dir, checkpointDirPermissions); err != nil {
+		return err
+	}
+	path := checkpointSegmentPath(w.dir, now.UnixNano())
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, checkpointFilePermissions)
+	if err != nil {
+		return err
+	}
+	w.fd = fd
+	w.segmentStart = now
+	if w.bw == nil {
+		w.bw = bufio.NewWriter(fd)
+	} else {
+		w.bw.Reset(fd)
+	}
+	return nil
+}
+
+func (w *shardCheckpointWriter) closeSegmentWithPersistLock() error {
+	err := w.fd.Close()
+	w.fd = nil
+	return err
+}
+
+func untimedMessageUnion(
+	mu unaggregated.MetricUnion,
+	metadatas metadata.StagedMetadatas,
+) encoding.UnaggregatedMessageUnion {
+	var msg encoding.UnaggregatedMessageUnion
+	switch mu.Type {
+	case metric.CounterType:
+		msg.Type = encoding.CounterWithMetadatasType
+		msg.CounterWithMetadatas = unaggregated.CounterWithMetadatas{
+			Counter:         mu.Counter(),
+			StagedMetadatas: metadatas,
+		}
+	case metric.TimerType:
+		msg.Type = encoding.BatchTimerWithMetadatasType
+		msg.BatchTimerWithMetadatas = unaggregated.BatchTimerWithMetadatas{
+			BatchTimer:      mu.BatchTimer(),
+			StagedMetadatas: metadatas,
+		}
+	case metric.GaugeType:
+		msg.Type = encoding.GaugeWithMetadatasType
+		msg.GaugeWithMetadatas = unaggregated.GaugeWithMetadatas{
+			Gauge:           mu.Gauge(),
+			StagedMetadatas: metadatas,
+		}
+	case metric.SetType:
+		msg.Type = encoding.SetWithMetadatasType
+		msg.SetWithMetadatas = unaggregated.SetWithMetadatas{
+			Set:             mu.Set(),
+			StagedMetadatas: metadatas,
+		}
+	}
+	return msg
+}
+
+func timedMessageUnion(
+	metric aggregated.Metric,
+	metadata metadata.TimedMetadata,
+) encoding.UnaggregatedMessageUnion {
+	return encoding.UnaggregatedMessageUnion{
+		Type: encoding.TimedMetricWithMetadataType,
+		TimedMetricWithMetadata: aggregated.TimedMetricWithMetadata{
+			Metric:        metric,
+			TimedMetadata: metadata,
+		},
+	}
+}
+
+func forwardedMessageUnion(
+	metric aggregated.ForwardedMetric,
+	metadata metadata.ForwardMetadata,
+) encoding.UnaggregatedMessageUnion {
+	return encoding.UnaggregatedMessageUnion{
+		Type: encoding.ForwardedMetricWithMetadataType,
+		ForwardedMetricWithMetadata: aggregated.ForwardedMetricWithMetadata{
+			ForwardedMetric: metric,
+			ForwardMetadata: metadata,
+		},
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/metrics/encoding/protobuf"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	defaultCheckpointInterval        = time.Second
+	defaultCheckpointSegmentDuration = 10 * time.Minute
+	defaultCheckpointRetention       = time.Hour
+	defaultCheckpointMaxBuffer       = 4 * 1024 * 1024
+)
+
+// CheckpointManagerOptions provide a set of options for checkpoint manager.
+type CheckpointManagerOptions interface {
+	// SetClockOptions sets the clock options.
+	SetClockOptions(value clock.Options) CheckpointManagerOptions
+
+	// ClockOptions returns the clock options.
+	ClockOptions() clock.Options
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) CheckpointManagerOptions
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+
+	// SetDirectory sets the directory checkpoints are written to.
+	SetDirectory(value string) CheckpointManagerOptions
+
+	// Directory returns the directory checkpoints are written to.
+	Directory() string
+
+	// SetCheckpointInterval sets how often buffered checkpoints are persisted to disk.
+	SetCheckpointInterval(value time.Duration) CheckpointManagerOptions
+
+	// CheckpointInterval returns how often buffered checkpoints are persisted to disk.
+	CheckpointInterval() time.Duration
+
+	// SetSegmentDuration sets the duration after which a new checkpoint segment
+	// file is started for a shard.
+	SetSegmentDuration(value time.Duration) CheckpointManagerOptions
+
+	// SegmentDuration returns the duration after which a new checkpoint segment
+	// file is started for a shard.
+	SegmentDuration() time.Duration
+
+	// SetRetentionPeriod sets how long checkpoint segments are retained after
+	// they have been closed. This should be longer than the longest aggregation
+	// window plus the maximum lateness allowed for metrics.
+	SetRetentionPeriod(value time.Duration) CheckpointManagerOptions
+
+	// RetentionPeriod returns how long checkpoint segments are retained after
+	// they have been closed.
+	RetentionPeriod() time.Duration
+
+	// SetMaxBufferSize sets the maximum number of bytes buffered per shard
+	// before checkpoints are persisted ahead of the next checkpoint interval.
+	SetMaxBufferSize(value int) CheckpointManagerOptions
+
+	// MaxBufferSize returns the maximum number of bytes buffered per shard
+	// before checkpoints are persisted ahead of the next checkpoint interval.
+	MaxBufferSize() int
+
+	// SetEncodingOptions sets the options used to encode and decode checkpoints.
+	SetEncodingOptions(value protobuf.UnaggregatedOptions) CheckpointManagerOptions
+
+	// EncodingOptions returns the options used to encode and decode checkpoints.
+	EncodingOptions() protobuf.UnaggregatedOptions
+}
+
+type checkpointManagerOptions struct {
+	clockOpts          clock.Options
+	instrumentOpts     instrument.Options
+	directory          string
+	checkpointInterval time.Duration
+	segmentDuration    time.Duration
+	retentionPeriod    time.Duration
+	maxBufferSize      int
+	encodingOpts       protobuf.UnaggregatedOptions
+}
+
+// NewCheckpointManagerOptions create a new set of checkpoint manager options.
+func NewCheckpointManagerOptions() CheckpointManagerOptions {
+	return &checkpointManagerOptions{
+		clockOpts:          clock.NewOptions(),
+		instrumentOpts:     instrument.NewOptions(),
+		checkpointInterval: defaultCheckpointInterval,
+		segmentDuration:    defaultCheckpointSegmentDuration,
+		retentionPeriod:    defaultCheckpointRetention,
+		maxBufferSize:      defaultCheckpointMaxBuffer,
+		encodingOpts:       protobuf.NewUnaggregatedOptions(),
+	}
+}
+
+func (o *checkpointManagerOptions) SetClockOptions(value clock.Options) CheckpointManagerOptions {
+	opts := *o
+	opts.clockOpts = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) ClockOptions() clock.Options {
+	return o.clockOpts
+}
+
+func (o *checkpointManagerOptions) SetInstrumentOptions(value instrument.Options) CheckpointManagerOptions {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
+
+func (o *checkpointManagerOptions) SetDirectory(value string) CheckpointManagerOptions {
+	opts := *o
+	opts.directory = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) Directory() string {
+	return o.directory
+}
+
+func (o *checkpointManagerOptions) SetCheckpointInterval(value time.Duration) CheckpointManagerOptions {
+	opts := *o
+	opts.checkpointInterval = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) CheckpointInterval() time.Duration {
+	return o.checkpointInterval
+}
+
+func (o *checkpointManagerOptions) SetSegmentDuration(value time.Duration) CheckpointManagerOptions {
+	opts := *o
+	opts.segmentDuration = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) SegmentDuration() time.Duration {
+	return o.segmentDuration
+}
+
+func (o *checkpointManagerOptions) SetRetentionPeriod(value time.Duration) CheckpointManagerOptions {
+	opts := *o
+	opts.retentionPeriod = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) RetentionPeriod() time.Duration {
+	return o.retentionPeriod
+}
+
+func (o *checkpointManagerOptions) SetMaxBufferSize(value int) CheckpointManagerOptions {
+	opts := *o
+	opts.maxBufferSize = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) MaxBufferSize() int {
+	return o.maxBufferSize
+}
+
+func (o *checkpointManagerOptions) SetEncodingOptions(value protobuf.UnaggregatedOptions) CheckpointManagerOptions {
+	opts := *o
+	opts.encodingOpts = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) EncodingOptions() protobuf.UnaggregatedOptions {
+	return o.encodingOpts
+}
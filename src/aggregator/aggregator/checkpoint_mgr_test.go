@@ -0,0 +1,213 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/metrics/encoding"
+	"github.com/m3db/m3/src/metrics/metric/unaggregated"
+	"github.com/m3db/m3/src/x/clock"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCheckpointRecord struct {
+	addedAtNanos int64
+	msg          encoding.UnaggregatedMessageUnion
+}
+
+func TestCheckpointManagerOpenNoDirectory(t *testing.T) {
+	mgr := NewCheckpointManager(NewCheckpointManagerOptions())
+	require.Equal(t, errCheckpointDirectoryNotSet, mgr.Open())
+}
+
+func TestCheckpointManagerOpenAlreadyOpen(t *testing.T) {
+	mgr, _ := testCheckpointManager(t, time.Unix(0, 0))
+	mgr.state = checkpointManagerOpen
+	require.Equal(t, errCheckpointManagerAlreadyOpenOrClosed, mgr.Open())
+}
+
+func TestCheckpointManagerWriteNotOpen(t *testing.T) {
+	mgr, _ := testCheckpointManager(t, time.Unix(0, 0))
+	msg := untimedMessageUnion(testUntimedMetric, testStagedMetadatas)
+	require.Equal(t, errCheckpointManagerNotOpenOrClosed, mgr.Write(0, 1000, msg))
+}
+
+func TestCheckpointManagerWriteAndRead(t *testing.T) {
+	now := time.Unix(0, 1234)
+	mgr, opts := testCheckpointManager(t, now)
+	defer os.RemoveAll(opts.Directory())
+	require.NoError(t, mgr.Open())
+
+	inputs := []testCheckpointRecord{
+		{addedAtNanos: 1000, msg: untimedMessageUnion(testUntimedMetric, testStagedMetadatas)},
+		{addedAtNanos: 2000, msg: untimedMessageUnion(testSet, testStagedMetadatas)},
+		{addedAtNanos: 3000, msg: timedMessageUnion(testTimedMetric, testTimedMetadata)},
+		{addedAtNanos: 4000, msg: forwardedMessageUnion(testForwardedMetric, testForwardMetadata)},
+	}
+	for _, input := range inputs {
+		require.NoError(t, mgr.Write(testShard, input.addedAtNanos, input.msg))
+	}
+	require.NoError(t, mgr.Write(testShard+1, 5000, untimedMessageUnion(testGauge, testStagedMetadatas)))
+	require.NoError(t, mgr.Close())
+
+	// Reopen the checkpoints as if the process has restarted.
+	mgr = NewCheckpointManager(opts).(*checkpointManager)
+	require.NoError(t, mgr.Open())
+	defer mgr.Close()
+
+	var results []testCheckpointRecord
+	require.NoError(t, mgr.Read(testShard, func(addedAtNanos int64, msg encoding.UnaggregatedMessageUnion) error {
+		results = append(results, testCheckpointRecord{addedAtNanos: addedAtNanos, msg: msg})
+		return nil
+	}))
+	require.Equal(t, len(inputs), len(results))
+	for i, input := range inputs {
+		require.Equal(t, input.addedAtNanos, results[i].addedAtNanos)
+		require.Equal(t, input.msg.Type, results[i].msg.Type)
+	}
+	require.Equal(t, testUntimedMetric.Counter(), results[0].msg.CounterWithMetadatas.Counter)
+	require.Equal(t, testSet.Set(), results[1].msg.SetWithMetadatas.Set)
+	require.Equal(t, testTimedMetric, results[2].msg.TimedMetricWithMetadata.Metric)
+	require.Equal(t, testTimedMetadata, results[2].msg.TimedMetricWithMetadata.TimedMetadata)
+	require.Equal(t, testForwardedMetric, results[3].msg.ForwardedMetricWithMetadata.ForwardedMetric)
+
+	var numRead int
+	require.NoError(t, mgr.Read(testShard+1, func(addedAtNanos int64, msg encoding.UnaggregatedMessageUnion) error {
+		require.Equal(t, int64(5000), addedAtNanos)
+		require.Equal(t, testGauge.Gauge(), msg.GaugeWithMetadatas.Gauge)
+		numRead++
+		return nil
+	}))
+	require.Equal(t, 1, numRead)
+}
+
+func TestCheckpointManagerReadCorruptedSegment(t *testing.T) {
+	now := time.Unix(0, 1234)
+	mgr, opts := testCheckpointManager(t, now)
+	defer os.RemoveAll(opts.Directory())
+	require.NoError(t, mgr.Open())
+	msg := untimedMessageUnion(testUntimedMetric, testStagedMetadatas)
+	require.NoError(t, mgr.Write(testShard, 1000, msg))
+	require.NoError(t, mgr.Write(testShard, 2000, msg))
+	require.NoError(t, mgr.Close())
+
+	// Simulate a partially written record at the end of the segment.
+	segments, err := checkpointSegments(mgr.shardDir(testShard))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(segments))
+	f, err := os.OpenFile(segments[0].path, os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0x10, 0x20})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	mgr = NewCheckpointManager(opts).(*checkpointManager)
+	require.NoError(t, mgr.Open())
+	defer mgr.Close()
+
+	var addedAtNanos []int64
+	require.NoError(t, mgr.Read(testShard, func(nanos int64, _ encoding.UnaggregatedMessageUnion) error {
+		addedAtNanos = append(addedAtNanos, nanos)
+		return nil
+	}))
+	require.Equal(t, []int64{1000, 2000}, addedAtNanos)
+}
+
+func TestCheckpointManagerCheckpointRotatesSegments(t *testing.T) {
+	now := time.Unix(0, 1234)
+	mgr, opts := testCheckpointManager(t, now)
+	defer os.RemoveAll(opts.Directory())
+	require.NoError(t, mgr.Open())
+	defer mgr.Close()
+
+	msg := untimedMessageUnion(testUntimedMetric, testStagedMetadatas)
+	require.NoError(t, mgr.Write(testShard, 1000, msg))
+	w := mgr.writers[testShard]
+	require.NoError(t, w.Checkpoint(now))
+	require.NotNil(t, w.fd)
+
+	// The segment is closed once it has been open for the segment duration.
+	require.NoError(t, w.Checkpoint(now.Add(opts.SegmentDuration())))
+	require.Nil(t, w.fd)
+
+	require.NoError(t, mgr.Write(testShard, 2000, msg))
+	require.NoError(t, w.Checkpoint(now.Add(2*opts.SegmentDuration())))
+
+	segments, err := checkpointSegments(mgr.shardDir(testShard))
+	require.NoError(t, err)
+	require.Equal(t, []int64{
+		now.UnixNano(),
+		now.Add(2 * opts.SegmentDuration()).UnixNano(),
+	}, []int64{segments[0].startNanos, segments[1].startNanos})
+}
+
+func TestCheckpointManagerRemoveExpiredSegments(t *testing.T) {
+	now := time.Unix(0, 0).Add(24 * time.Hour)
+	mgr, opts := testCheckpointManager(t, now)
+	defer os.RemoveAll(opts.Directory())
+	require.NoError(t, mgr.Open())
+	defer mgr.Close()
+
+	var (
+		expired = now.Add(-opts.RetentionPeriod() - opts.SegmentDuration() - time.Second)
+		active  = now.Add(-opts.RetentionPeriod())
+	)
+	for _, shard := range []uint32{0, 1} {
+		shardDir := mgr.shardDir(shard)
+		require.NoError(t, os.MkdirAll(shardDir, checkpointDirPermissions))
+		for _, start := range []time.Time{expired, active} {
+			path := checkpointSegmentPath(shardDir, start.UnixNano())
+			require.NoError(t, ioutil.WriteFile(path, nil, checkpointFilePermissions))
+		}
+	}
+
+	require.NoError(t, mgr.removeExpiredSegments(now))
+	for _, shard := range []uint32{0, 1} {
+		segments, err := checkpointSegments(mgr.shardDir(shard))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(segments))
+		require.Equal(t, active.UnixNano(), segments[0].startNanos)
+	}
+}
+
+func TestUntimedMessageUnion(t *testing.T) {
+	msg := untimedMessageUnion(testBatchTimer, testStagedMetadatas)
+	require.Equal(t, encoding.BatchTimerWithMetadatasType, msg.Type)
+	require.Equal(t, unaggregated.BatchTimerWithMetadatas{
+		BatchTimer:      testBatchTimer.BatchTimer(),
+		StagedMetadatas: testStagedMetadatas,
+	}, msg.BatchTimerWithMetadatas)
+}
+
+func testCheckpointManager(t *testing.T, now time.Time) (*checkpointManager, CheckpointManagerOptions) {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	require.NoError(t, err)
+	opts := NewCheckpointManagerOptions().
+		SetClockOptions(clock.NewOptions().SetNowFn(func() time.Time { return now })).
+		SetDirectory(dir).
+		SetCheckpointInterval(time.Hour)
+	return NewCheckpointManager(opts).(*checkpointManager), opts
+}
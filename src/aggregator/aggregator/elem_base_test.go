@@ -300,6 +300,43 @@ func TestGaugeElemBaseResetSetDataInvalidTypes(t *testing.T) {
 	require.True(t, strings.Contains(err.Error(), "invalid aggregation types P99 for gauge"))
 }
 
+func TestSetElemBase(t *testing.T) {
+	opts := NewOptions()
+	aggTypesOpts := opts.AggregationTypesOptions()
+	e := setElemBase{}
+	require.Equal(t, []byte("stats.sets."), e.FullPrefix(opts))
+	require.Equal(t, maggregation.Types{maggregation.CountDistinct}, e.DefaultAggregationTypes(aggTypesOpts))
+	require.Equal(t, []byte(nil), e.TypeStringFor(aggTypesOpts, maggregation.CountDistinct))
+	require.True(t, opts.SetElemPool() == e.ElemPool(opts))
+}
+
+func TestSetElemBaseNewLockedAggregation(t *testing.T) {
+	e := setElemBase{}
+	la := e.NewAggregation(nil, raggregation.Options{})
+	la.AddUnion(unaggregated.MetricUnion{
+		Type:   metric.SetType,
+		SetVal: [][]byte{[]byte("foo"), []byte("bar")},
+	})
+	la.AddUnion(unaggregated.MetricUnion{
+		Type:   metric.SetType,
+		SetVal: [][]byte{[]byte("bar"), []byte("baz")},
+	})
+	require.Equal(t, 3.0, la.ValueOf(maggregation.CountDistinct))
+	require.Equal(t, 4.0, la.ValueOf(maggregation.Count))
+}
+
+func TestSetElemBaseResetSetData(t *testing.T) {
+	e := setElemBase{}
+	require.NoError(t, e.ResetSetData(nil, maggregation.Types{maggregation.Count, maggregation.CountDistinct}, false))
+}
+
+func TestSetElemBaseResetSetDataInvalidTypes(t *testing.T) {
+	e := setElemBase{}
+	err := e.ResetSetData(nil, maggregation.Types{maggregation.Sum}, false)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "invalid aggregation types Sum for set"))
+}
+
 func TestParsedPipelineEmptyPipeline(t *testing.T) {
 	p := applied.Pipeline{}
 	pp, err := newParsedPipeline(p)
@@ -69,6 +69,21 @@ type GaugeElemPool interface {
 	Put(value *GaugeElem)
 }
 
+// SetElemAlloc allocates a new set element.
+type SetElemAlloc func() *SetElem
+
+// SetElemPool provides a pool of set elements.
+type SetElemPool interface {
+	// Init initializes the set element pool.
+	Init(alloc SetElemAlloc)
+
+	// Get gets a set element from the pool.
+	Get() *SetElem
+
+	// Put returns a set element to the pool.
+	Put(value *SetElem)
+}
+
 type counterElemPool struct {
 	pool pool.ObjectPool
 }
@@ -137,3 +152,26 @@ func (p *gaugeElemPool) Get() *GaugeElem {
 func (p *gaugeElemPool) Put(value *GaugeElem) {
 	p.pool.Put(value)
 }
+
+type setElemPool struct {
+	pool pool.ObjectPool
+}
+
+// NewSetElemPool creates a new pool for set elements.
+func NewSetElemPool(opts pool.ObjectPoolOptions) SetElemPool {
+	return &setElemPool{pool: pool.NewObjectPool(opts)}
+}
+
+func (p *setElemPool) Init(alloc SetElemAlloc) {
+	p.pool.Init(func() interface{} {
+		return alloc()
+	})
+}
+
+func (p *setElemPool) Get() *SetElem {
+	return p.pool.Get().(*SetElem)
+}
+
+func (p *setElemPool) Put(value *SetElem) {
+	p.pool.Put(value)
+}
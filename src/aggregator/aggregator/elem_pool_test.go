@@ -93,3 +93,24 @@ func TestGaugeElemPool(t *testing.T) {
 	require.Equal(t, testGaugeID, element.id)
 	require.Equal(t, testStoragePolicy, element.sp)
 }
+
+func TestSetElemPool(t *testing.T) {
+	p := NewSetElemPool(pool.NewObjectPoolOptions().SetSize(1))
+	p.Init(func() *SetElem {
+		return MustNewSetElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, NoPrefixNoSuffix, NewOptions())
+	})
+
+	// Retrieve an element from the pool.
+	element := p.Get()
+	require.NoError(t, element.ResetSetData(testSetID, testStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, NoPrefixNoSuffix))
+	require.Equal(t, testSetID, element.id)
+	require.Equal(t, testStoragePolicy, element.sp)
+
+	// Put the element back to pool.
+	p.Put(element)
+
+	// Retrieve the element and assert it's the same element.
+	element = p.Get()
+	require.Equal(t, testSetID, element.id)
+	require.Equal(t, testStoragePolicy, element.sp)
+}
@@ -46,6 +46,7 @@ var (
 	testCounterID                 = id.RawID("testCounter")
 	testBatchTimerID              = id.RawID("testBatchTimer")
 	testGaugeID                   = id.RawID("testGauge")
+	testSetID                     = id.RawID("testSet")
 	testStoragePolicy             = policy.NewStoragePolicy(10*time.Second, xtime.Second, 6*time.Hour)
 	testAggregationTypes          = maggregation.Types{maggregation.Mean, maggregation.Sum}
 	testAggregationTypesExpensive = maggregation.Types{maggregation.SumSq}
@@ -65,6 +66,11 @@ var (
 		ID:       testGaugeID,
 		GaugeVal: 123.456,
 	}
+	testSet = unaggregated.MetricUnion{
+		Type:   metric.SetType,
+		ID:     testSetID,
+		SetVal: [][]byte{[]byte("foo"), []byte("bar"), []byte("foo")},
+	}
 	testPipeline = applied.NewPipeline([]applied.OpUnion{
 		{
 			Type:           pipeline.TransformationOpType,
@@ -1256,6 +1262,46 @@ func TestGaugeElemAddUnion(t *testing.T) {
 	require.Equal(t, errElemClosed, e.AddUnion(testTimestamps[2], testGauge))
 }
 
+func TestSetElemAddUnion(t *testing.T) {
+	e, err := NewSetElem(testSetID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	require.NoError(t, err)
+	require.Equal(t, maggregation.Types{maggregation.CountDistinct}, e.aggTypes)
+
+	// Add a set metric.
+	require.NoError(t, e.AddUnion(testTimestamps[0], testSet))
+	require.Equal(t, 1, len(e.values))
+	require.Equal(t, testAlignedStarts[0], e.values[0].startAtNanos)
+	require.Equal(t, int64(3), e.values[0].lockedAgg.aggregation.Count())
+	require.Equal(t, 2.0, e.values[0].lockedAgg.aggregation.CountDistinct())
+
+	// Add the set metric with a new value within the same aggregation interval.
+	require.NoError(t, e.AddUnion(testTimestamps[1], unaggregated.MetricUnion{
+		Type:   metric.SetType,
+		ID:     testSetID,
+		SetVal: [][]byte{[]byte("baz")},
+	}))
+	require.Equal(t, 1, len(e.values))
+	require.Equal(t, int64(4), e.values[0].lockedAgg.aggregation.Count())
+	require.Equal(t, 3.0, e.values[0].lockedAgg.aggregation.CountDistinct())
+
+	// Add the set metric in the next aggregation interval.
+	require.NoError(t, e.AddUnion(testTimestamps[2], testSet))
+	require.Equal(t, 2, len(e.values))
+	require.Equal(t, testAlignedStarts[1], e.values[1].startAtNanos)
+	require.Equal(t, 2.0, e.values[1].lockedAgg.aggregation.CountDistinct())
+
+	// Adding the set metric to a closed element results in an error.
+	e.closed = true
+	require.Equal(t, errElemClosed, e.AddUnion(testTimestamps[2], testSet))
+}
+
+func TestSetResetSetDataInvalidAggregationType(t *testing.T) {
+	opts := NewOptions()
+	se := MustNewSetElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, 0, NoPrefixNoSuffix, opts)
+	err := se.ResetSetData(testSetID, testStoragePolicy, maggregation.Types{maggregation.Last}, applied.DefaultPipeline, 0, NoPrefixNoSuffix)
+	require.Error(t, err)
+}
+
 func TestGaugeElemAddUnionWithCustomAggregation(t *testing.T) {
 	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, testAggregationTypesExpensive, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
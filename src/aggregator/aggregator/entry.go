@@ -31,11 +31,14 @@ import (
 	"github.com/m3db/m3/src/aggregator/rate"
 	"github.com/m3db/m3/src/aggregator/runtime"
 	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/metrics/encoding"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
 	metricid "github.com/m3db/m3/src/metrics/metric/id"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
+	"github.com/m3db/m3/src/metrics/pipeline"
+	"github.com/m3db/m3/src/metrics/pipeline/applied"
 	"github.com/m3db/m3/src/metrics/policy"
 	xerrors "github.com/m3db/m3/src/x/errors"
 
@@ -57,6 +60,7 @@ var (
 	errTooFarInTheFuture           = errors.New("too far in the future")
 	errTooFarInThePast             = errors.New("too far in the past")
 	errArrivedTooLate              = errors.New("arrived too late")
+	errSetForwardingNotSupported   = errors.New("set aggregations cannot be rolled up or forwarded")
 )
 
 type rateLimitEntryMetrics struct {
@@ -130,9 +134,10 @@ func newForwardedEntryMetrics(scope tally.Scope) forwardedEntryMetrics {
 }
 
 type entryMetrics struct {
-	untimed   untimedEntryMetrics
-	timed     timedEntryMetrics
-	forwarded forwardedEntryMetrics
+	untimed          untimedEntryMetrics
+	timed            timedEntryMetrics
+	forwarded        forwardedEntryMetrics
+	checkpointErrors tally.Counter
 }
 
 func newEntryMetrics(scope tally.Scope) entryMetrics {
@@ -140,9 +145,10 @@ func newEntryMetrics(scope tally.Scope) entryMetrics {
 	timedEntryScope := scope.Tagged(map[string]string{"entry-type": "timed"})
 	forwardedEntryScope := scope.Tagged(map[string]string{"entry-type": "forwarded"})
 	return entryMetrics{
-		untimed:   newUntimedEntryMetrics(untimedEntryScope),
-		timed:     newTimedEntryMetrics(timedEntryScope),
-		forwarded: newForwardedEntryMetrics(forwardedEntryScope),
+		untimed:          newUntimedEntryMetrics(untimedEntryScope),
+		timed:            newTimedEntryMetrics(timedEntryScope),
+		forwarded:        newForwardedEntryMetrics(forwardedEntryScope),
+		checkpointErrors: scope.Counter("checkpoint-errors"),
 	}
 }
 
@@ -235,6 +241,14 @@ func (e *Entry) AddUntimed(
 			metricUnion.TimerValPool.Put(metricUnion.BatchTimerVal)
 		}
 		return err
+	case metric.SetType:
+		if err := e.applyValueRateLimit(
+			int64(len(metricUnion.SetVal)),
+			e.metrics.untimed.rateLimit,
+		); err != nil {
+			return err
+		}
+		return e.addUntimed(metricUnion, metadatas)
 	default:
 		// For counters and gauges, there is a single value in the metric union.
 		if err := e.applyValueRateLimit(1, e.metrics.untimed.rateLimit); err != nil {
@@ -266,6 +280,49 @@ func (e *Entry) AddForwarded(
 	return e.addForwarded(metric, metadata)
 }
 
+// recoverUntimed re-adds an untimed metric recovered from a checkpoint as if
+// it were added at the given time. Recovered metrics are not subject to rate
+// limiting and are not checkpointed again.
+func (e *Entry) recoverUntimed(
+	addedAt time.Time,
+	metric unaggregated.MetricUnion,
+	metadatas metadata.StagedMetadatas,
+) error {
+	timeLock := e.opts.TimeLock()
+	timeLock.RLock()
+	err := e.addUntimedWithTimeLock(addedAt, metric, metadatas)
+	timeLock.RUnlock()
+	return err
+}
+
+// recoverTimed re-adds a timed metric recovered from a checkpoint as if
+// it were added at the given time.
+func (e *Entry) recoverTimed(
+	addedAt time.Time,
+	metric aggregated.Metric,
+	metadata metadata.TimedMetadata,
+) error {
+	timeLock := e.opts.TimeLock()
+	timeLock.RLock()
+	err := e.addTimedWithTimeLock(addedAt, metric, metadata)
+	timeLock.RUnlock()
+	return err
+}
+
+// recoverForwarded re-adds a forwarded metric recovered from a checkpoint as if
+// it were added at the given time.
+func (e *Entry) recoverForwarded(
+	addedAt time.Time,
+	metric aggregated.ForwardedMetric,
+	metadata metadata.ForwardMetadata,
+) error {
+	timeLock := e.opts.TimeLock()
+	timeLock.RLock()
+	err := e.addForwardedWithTimeLock(addedAt, metric, metadata)
+	timeLock.RUnlock()
+	return err
+}
+
 // ShouldExpire returns whether the entry should expire.
 func (e *Entry) ShouldExpire(now time.Time) bool {
 	e.RLock()
@@ -351,12 +408,24 @@ func (e *Entry) addUntimed(
 	// must have all completed. This is used to ensure we never write metrics
 	// for times that have already been flushed.
 	currTime := e.opts.ClockOptions().NowFn()()
+	err := e.addUntimedWithTimeLock(currTime, metric, metadatas)
+	if err == nil && e.opts.CheckpointManager() != nil {
+		e.checkpoint(currTime, untimedMessageUnion(metric, metadatas))
+	}
+	timeLock.RUnlock()
+	return err
+}
+
+func (e *Entry) addUntimedWithTimeLock(
+	currTime time.Time,
+	metric unaggregated.MetricUnion,
+	metadatas metadata.StagedMetadatas,
+) error {
 	e.recordLastAccessed(currTime)
 
 	e.RLock()
 	if e.closed {
 		e.RUnlock()
-		timeLock.RUnlock()
 		return errEntryClosed
 	}
 
@@ -365,14 +434,12 @@ func (e *Entry) addUntimed(
 	if e.hasDefaultMetadatas && hasDefaultMetadatas {
 		err := e.addUntimedWithLock(currTime, metric)
 		e.RUnlock()
-		timeLock.RUnlock()
 		return err
 	}
 
 	sm, err := e.activeStagedMetadataWithLock(currTime, metadatas)
 	if err != nil {
 		e.RUnlock()
-		timeLock.RUnlock()
 		return err
 	}
 
@@ -383,7 +450,6 @@ func (e *Entry) addUntimed(
 	// may still be very much alive.
 	if sm.Tombstoned {
 		e.RUnlock()
-		timeLock.RUnlock()
 		e.metrics.untimed.tombstonedMetadata.Inc(1)
 		return nil
 	}
@@ -391,7 +457,6 @@ func (e *Entry) addUntimed(
 	// It is expected that there is at least one pipeline in the metadata.
 	if len(sm.Pipelines) == 0 {
 		e.RUnlock()
-		timeLock.RUnlock()
 		e.metrics.untimed.noPipelinesInMetadata.Inc(1)
 		return errNoPipelinesInMetadata
 	}
@@ -399,7 +464,6 @@ func (e *Entry) addUntimed(
 	if !e.shouldUpdateStagedMetadatasWithLock(sm) {
 		err = e.addUntimedWithLock(currTime, metric)
 		e.RUnlock()
-		timeLock.RUnlock()
 		return err
 	}
 	e.RUnlock()
@@ -407,7 +471,6 @@ func (e *Entry) addUntimed(
 	e.Lock()
 	if e.closed {
 		e.Unlock()
-		timeLock.RUnlock()
 		return errEntryClosed
 	}
 
@@ -416,14 +479,12 @@ func (e *Entry) addUntimed(
 			// NB(xichen): if an error occurred during policy update, the policies
 			// will remain as they are, i.e., there are no half-updated policies.
 			e.Unlock()
-			timeLock.RUnlock()
 			return err
 		}
 	}
 
 	err = e.addUntimedWithLock(currTime, metric)
 	e.Unlock()
-	timeLock.RUnlock()
 
 	return err
 }
@@ -534,6 +595,13 @@ func (e *Entry) addNewAggregationKeyWithLock(
 		newElem = e.opts.TimerElemPool().Get()
 	case metric.GaugeType:
 		newElem = e.opts.GaugeElemPool().Get()
+	case metric.SetType:
+		// NB: forwarded aggregations only carry values, which for sets are
+		// distinct count estimates that cannot be merged across series.
+		if key.numForwardedTimes > 0 || hasRollupOp(key.pipeline) {
+			return nil, errSetForwardingNotSupported
+		}
+		newElem = e.opts.SetElemPool().Get()
 	default:
 		return nil, errInvalidMetricType
 	}
@@ -554,6 +622,15 @@ func (e *Entry) addNewAggregationKeyWithLock(
 	return newAggregations, nil
 }
 
+func hasRollupOp(p applied.Pipeline) bool {
+	for i := 0; i < p.Len(); i++ {
+		if p.At(i).Type == pipeline.RollupOpType {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *Entry) removeOldAggregations(newAggregations aggregationValues) {
 	for _, val := range e.aggregations {
 		if !newAggregations.contains(val.key) {
@@ -629,12 +706,24 @@ func (e *Entry) addTimed(
 	// must have all completed. This is used to ensure we never write metrics
 	// for times that have already been flushed.
 	currTime := e.opts.ClockOptions().NowFn()()
+	err := e.addTimedWithTimeLock(currTime, metric, metadata)
+	if err == nil && e.opts.CheckpointManager() != nil {
+		e.checkpoint(currTime, timedMessageUnion(metric, metadata))
+	}
+	timeLock.RUnlock()
+	return err
+}
+
+func (e *Entry) addTimedWithTimeLock(
+	currTime time.Time,
+	metric aggregated.Metric,
+	metadata metadata.TimedMetadata,
+) error {
 	e.recordLastAccessed(currTime)
 
 	e.RLock()
 	if e.closed {
 		e.RUnlock()
-		timeLock.RUnlock()
 		return errEntryClosed
 	}
 
@@ -645,7 +734,6 @@ func (e *Entry) addTimed(
 		metadata.StoragePolicy.Resolution().Window,
 	); err != nil {
 		e.RUnlock()
-		timeLock.RUnlock()
 		return err
 	}
 
@@ -658,7 +746,6 @@ func (e *Entry) addTimed(
 	if idx := e.aggregations.index(key); idx >= 0 {
 		err := e.addTimedWithLock(e.aggregations[idx], metric)
 		e.RUnlock()
-		timeLock.RUnlock()
 		return err
 	}
 	e.RUnlock()
@@ -666,27 +753,23 @@ func (e *Entry) addTimed(
 	e.Lock()
 	if e.closed {
 		e.Unlock()
-		timeLock.RUnlock()
 		return errEntryClosed
 	}
 
 	if idx := e.aggregations.index(key); idx >= 0 {
 		err := e.addTimedWithLock(e.aggregations[idx], metric)
 		e.Unlock()
-		timeLock.RUnlock()
 		return err
 	}
 
 	// Update metatadata if not exists, and add metric.
 	if err := e.updateTimedMetadataWithLock(metric, metadata); err != nil {
 		e.Unlock()
-		timeLock.RUnlock()
 		return err
 	}
 	idx := e.aggregations.index(key)
 	err := e.addTimedWithLock(e.aggregations[idx], metric)
 	e.Unlock()
-	timeLock.RUnlock()
 	return err
 }
 
@@ -758,12 +841,24 @@ func (e *Entry) addForwarded(
 	// must have all completed. This is used to ensure we never write metrics
 	// for times that have already been flushed.
 	currTime := e.opts.ClockOptions().NowFn()()
+	err := e.addForwardedWithTimeLock(currTime, metric, metadata)
+	if err == nil && e.opts.CheckpointManager() != nil {
+		e.checkpoint(currTime, forwardedMessageUnion(metric, metadata))
+	}
+	timeLock.RUnlock()
+	return err
+}
+
+func (e *Entry) addForwardedWithTimeLock(
+	currTime time.Time,
+	metric aggregated.ForwardedMetric,
+	metadata metadata.ForwardMetadata,
+) error {
 	e.recordLastAccessed(currTime)
 
 	e.RLock()
 	if e.closed {
 		e.RUnlock()
-		timeLock.RUnlock()
 		return errEntryClosed
 	}
 
@@ -775,7 +870,6 @@ func (e *Entry) addForwarded(
 		metadata.NumForwardedTimes,
 	); err != nil {
 		e.RUnlock()
-		timeLock.RUnlock()
 		return err
 	}
 
@@ -790,7 +884,6 @@ func (e *Entry) addForwarded(
 	if idx := e.aggregations.index(key); idx >= 0 {
 		err := e.addForwardedWithLock(e.aggregations[idx], metric, metadata.SourceID)
 		e.RUnlock()
-		timeLock.RUnlock()
 		return err
 	}
 	e.RUnlock()
@@ -798,27 +891,23 @@ func (e *Entry) addForwarded(
 	e.Lock()
 	if e.closed {
 		e.Unlock()
-		timeLock.RUnlock()
 		return errEntryClosed
 	}
 
 	if idx := e.aggregations.index(key); idx >= 0 {
 		err := e.addForwardedWithLock(e.aggregations[idx], metric, metadata.SourceID)
 		e.Unlock()
-		timeLock.RUnlock()
 		return err
 	}
 
 	// Update metatadata if not exists, and add metric.
 	if err := e.updateForwardMetadataWithLock(metric, metadata); err != nil {
 		e.Unlock()
-		timeLock.RUnlock()
 		return err
 	}
 	idx := e.aggregations.index(key)
 	err := e.addForwardedWithLock(e.aggregations[idx], metric, metadata.SourceID)
 	e.Unlock()
-	timeLock.RUnlock()
 	return err
 }
 
@@ -883,6 +972,22 @@ func (e *Entry) addForwardedWithLock(
 	return err
 }
 
+// checkpoint writes a message that has been added to the entry to the
+// checkpoint manager so it can be recovered after a restart. Checkpoint
+// failures do not fail the write since the metric has already been added.
+func (e *Entry) checkpoint(currTime time.Time, msg encoding.UnaggregatedMessageUnion) {
+	e.RLock()
+	lists := e.lists
+	e.RUnlock()
+	if lists == nil {
+		return
+	}
+	cm := e.opts.CheckpointManager()
+	if err := cm.Write(lists.shard, currTime.UnixNano(), msg); err != nil {
+		e.metrics.checkpointErrors.Inc(1)
+	}
+}
+
 func (e *Entry) writerCount() int        { return int(atomic.LoadInt32(&e.numWriters)) }
 func (e *Entry) lastAccessed() time.Time { return time.Unix(0, atomic.LoadInt64(&e.lastAccessNanos)) }
 
@@ -32,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric"
+	"github.com/m3db/m3/src/metrics/metric/aggregated"
 	"github.com/m3db/m3/src/metrics/metric/id"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
 	"github.com/m3db/m3/src/metrics/pipeline"
@@ -446,6 +447,33 @@ func TestEntryAddUntimedInvalidMetricError(t *testing.T) {
 	require.Error(t, e.AddUntimed(testInvalidMetric, metadata.DefaultStagedMetadatas))
 }
 
+func TestEntryAddUntimedSetRollupError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, _, _ := testEntry(ctrl)
+	inputMetadatas := metadata.StagedMetadatas{
+		metadata.StagedMetadata{
+			Metadata: metadata.Metadata{Pipelines: testNewPipelines2},
+		},
+	}
+	require.Equal(t, errSetForwardingNotSupported, e.AddUntimed(testSet, inputMetadatas))
+}
+
+func TestEntryAddForwardedSetError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, _, now := testEntry(ctrl)
+	forwarded := aggregated.ForwardedMetric{
+		Type:      metric.SetType,
+		ID:        testSetID,
+		TimeNanos: now.UnixNano(),
+		Values:    []float64{12},
+	}
+	require.Equal(t, errSetForwardingNotSupported, e.AddForwarded(forwarded, testForwardMetadata1))
+}
+
 func TestEntryAddUntimedClosedEntryError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
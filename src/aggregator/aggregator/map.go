@@ -23,13 +23,16 @@ package aggregator
 import (
 	"container/list"
 	"errors"
+	"fmt"
 	"math"
 	"sync"
 	"time"
 
+	schema "github.com/m3db/m3/src/aggregator/generated/proto/flush"
 	"github.com/m3db/m3/src/aggregator/hash"
 	"github.com/m3db/m3/src/aggregator/rate"
 	"github.com/m3db/m3/src/aggregator/runtime"
+	"github.com/m3db/m3/src/metrics/encoding"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
@@ -196,6 +199,138 @@ func (m *metricMap) AddForwarded(
 	return err
 }
 
+// Recover re-adds a message recovered from a checkpoint as if it were added
+// at the given time.
+func (m *metricMap) Recover(
+	addedAtNanos int64,
+	msg encoding.UnaggregatedMessageUnion,
+) error {
+	addedAt := time.Unix(0, addedAtNanos)
+	switch msg.Type {
+	case encoding.CounterWithMetadatasType:
+		cm := msg.CounterWithMetadatas
+		return m.recoverUntimed(addedAt, cm.Counter.ToUnion(), cm.StagedMetadatas)
+	case encoding.BatchTimerWithMetadatasType:
+		bm := msg.BatchTimerWithMetadatas
+		return m.recoverUntimed(addedAt, bm.BatchTimer.ToUnion(), bm.StagedMetadatas)
+	case encoding.GaugeWithMetadatasType:
+		gm := msg.GaugeWithMetadatas
+		return m.recoverUntimed(addedAt, gm.Gauge.ToUnion(), gm.StagedMetadatas)
+	case encoding.SetWithMetadatasType:
+		sm := msg.SetWithMetadatas
+		return m.recoverUntimed(addedAt, sm.Set.ToUnion(), sm.StagedMetadatas)
+	case encoding.TimedMetricWithMetadataType:
+		tm := msg.TimedMetricWithMetadata
+		return m.recoverTimed(addedAt, tm.Metric, tm.TimedMetadata)
+	case encoding.ForwardedMetricWithMetadataType:
+		fm := msg.ForwardedMetricWithMetadata
+		return m.recoverForwarded(addedAt, fm.ForwardedMetric, fm.ForwardMetadata)
+	default:
+		return fmt.Errorf("unrecognized checkpointed message type: %v", msg.Type)
+	}
+}
+
+func (m *metricMap) recoverUntimed(
+	addedAt time.Time,
+	metric unaggregated.MetricUnion,
+	metadatas metadata.StagedMetadatas,
+) error {
+	key := entryKey{
+		metricCategory: untimedMetric,
+		metricType:     metric.Type,
+		idHash:         hash.Murmur3Hash128(metric.ID),
+	}
+	entry, err := m.findOrCreate(key)
+	if err != nil {
+		return err
+	}
+	err = entry.recoverUntimed(addedAt, metric, metadatas)
+	entry.DecWriter()
+	return err
+}
+
+func (m *metricMap) recoverTimed(
+	addedAt time.Time,
+	metric aggregated.Metric,
+	metadata metadata.TimedMetadata,
+) error {
+	key := entryKey{
+		metricCategory: timedMetric,
+		metricType:     metric.Type,
+		idHash:         hash.Murmur3Hash128(metric.ID),
+	}
+	entry, err := m.findOrCreate(key)
+	if err != nil {
+		return err
+	}
+	err = entry.recoverTimed(addedAt, metric, metadata)
+	entry.DecWriter()
+	return err
+}
+
+func (m *metricMap) recoverForwarded(
+	addedAt time.Time,
+	metric aggregated.ForwardedMetric,
+	metadata metadata.ForwardMetadata,
+) error {
+	key := entryKey{
+		metricCategory: forwardedMetric,
+		metricType:     metric.Type,
+		idHash:         hash.Murmur3Hash128(metric.ID),
+	}
+	entry, err := m.findOrCreate(key)
+	if err != nil {
+		return err
+	}
+	err = entry.recoverForwarded(addedAt, metric, metadata)
+	entry.DecWriter()
+	return err
+}
+
+// DiscardFlushed discards data in the metric lists that have already been
+// flushed according to the given shard flush times.
+func (m *metricMap) DiscardFlushed(flushTimes *schema.ShardFlushTimes) {
+	m.metricLists.RLock()
+	defer m.metricLists.RUnlock()
+
+	for id, list := range m.metricLists.lists {
+		var (
+			lastFlushedNanos int64
+			ok               bool
+		)
+		switch id.listType {
+		case standardMetricListType:
+			lastFlushedNanos, ok = flushTimes.StandardByResolution[int64(id.standard.resolution)]
+		case timedMetricListType:
+			lastFlushedNanos, ok = flushTimes.TimedByResolution[int64(id.timed.resolution)]
+		case forwardedMetricListType:
+			var forwardedFlushTimes *schema.ForwardedFlushTimesForResolution
+			forwardedFlushTimes, ok = flushTimes.ForwardedByResolution[int64(id.forwarded.resolution)]
+			if ok && forwardedFlushTimes != nil {
+				lastFlushedNanos, ok = forwardedFlushTimes.ByNumForwardedTimes[int32(id.forwarded.numForwardedTimes)]
+			}
+		}
+		if !ok {
+			continue
+		}
+		if flushingList, ok := list.(flushingMetricList); ok {
+			flushingList.DiscardBefore(lastFlushedNanos)
+		}
+	}
+}
+
+// DiscardBefore discards data in the metric lists before the given time.
+func (m *metricMap) DiscardBefore(beforeNanos int64) {
+	m.metricLists.RLock()
+	defer m.metricLists.RUnlock()
+
+	for _, list := range m.metricLists.lists {
+		if flushingList, ok := list.(flushingMetricList); ok {
+			flushingList.DiscardBefore(beforeNanos)
+		}
+	}
+}
+
 func (m *metricMap) Tick(target time.Duration) tickResult {
 	mapTickRes := m.tick(target)
 	listsTickRes := m.metricLists.Tick()
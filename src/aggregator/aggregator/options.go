@@ -42,6 +42,7 @@ var (
 	defaultCounterPrefix              = []byte("counts.")
 	defaultTimerPrefix                = []byte("timers.")
 	defaultGaugePrefix                = []byte("gauges.")
+	defaultSetPrefix                  = []byte("sets.")
 	defaultEntryTTL                   = 24 * time.Hour
 	defaultEntryCheckInterval         = time.Hour
 	defaultEntryCheckBatchPercent     = 0.01
@@ -49,6 +50,7 @@ var (
 	defaultMaxNumCachedSourceSets     = 2
 	defaultDiscardNaNAggregatedValues = true
 	defaultResignTimeout              = 5 * time.Minute
+	defaultCheckpointRecoveryTimeout  = 10 * time.Second
 	defaultDefaultStoragePolicies     = []policy.StoragePolicy{
 		policy.NewStoragePolicy(10*time.Second, xtime.Second, 2*24*time.Hour),
 		policy.NewStoragePolicy(time.Minute, xtime.Minute, 40*24*time.Hour),
@@ -108,6 +110,12 @@ type Options interface {
 	// GaugePrefix returns the prefix for gauges.
 	GaugePrefix() []byte
 
+	// SetSetPrefix sets the prefix for sets.
+	SetSetPrefix(value []byte) Options
+
+	// SetPrefix returns the prefix for sets.
+	SetPrefix() []byte
+
 	// SetTimeLock sets the time lock.
 	SetTimeLock(value *sync.RWMutex) Options
 
@@ -192,6 +200,22 @@ type Options interface {
 	// FlushManager returns the flush manager.
 	FlushManager() FlushManager
 
+	// SetCheckpointManager sets the checkpoint manager. Checkpointing of
+	// in-flight aggregation windows is disabled if the checkpoint manager is nil.
+	SetCheckpointManager(value CheckpointManager) Options
+
+	// CheckpointManager returns the checkpoint manager.
+	CheckpointManager() CheckpointManager
+
+	// SetCheckpointRecoveryTimeout sets how long recovery from checkpoints waits
+	// for the flush times of the shard set before replaying checkpoints without
+	// discarding windows that have already been flushed.
+	SetCheckpointRecoveryTimeout(value time.Duration) Options
+
+	// CheckpointRecoveryTimeout returns how long recovery from checkpoints waits
+	// for the flush times of the shard set.
+	CheckpointRecoveryTimeout() time.Duration
+
 	// SetFlushHandler sets the handler that flushes buffered encoders.
 	SetFlushHandler(value handler.Handler) Options
 
@@ -290,6 +314,12 @@ type Options interface {
 	// GaugeElemPool returns the gauge element pool.
 	GaugeElemPool() GaugeElemPool
 
+	// SetSetElemPool sets the set element pool.
+	SetSetElemPool(value SetElemPool) Options
+
+	// SetElemPool returns the set element pool.
+	SetElemPool() SetElemPool
+
 	/// Read-only derived options.
 
 	// FullCounterPrefix returns the full prefix for counters.
@@ -300,6 +330,9 @@ type Options interface {
 
 	// FullGaugePrefix returns the full prefix for gauges.
 	FullGaugePrefix() []byte
+
+	// FullSetPrefix returns the full prefix for sets.
+	FullSetPrefix() []byte
 }
 
 type options struct {
@@ -309,6 +342,7 @@ type options struct {
 	counterPrefix                    []byte
 	timerPrefix                      []byte
 	gaugePrefix                      []byte
+	setPrefix                        []byte
 	timeLock                         *sync.RWMutex
 	clockOpts                        clock.Options
 	instrumentOpts                   instrument.Options
@@ -320,6 +354,8 @@ type options struct {
 	bufferDurationBeforeShardCutover time.Duration
 	bufferDurationAfterShardCutoff   time.Duration
 	flushManager                     FlushManager
+	checkpointManager                CheckpointManager
+	checkpointRecoveryTimeout        time.Duration
 	flushHandler                     handler.Handler
 	entryTTL                         time.Duration
 	entryCheckInterval               time.Duration
@@ -338,11 +374,13 @@ type options struct {
 	counterElemPool                  CounterElemPool
 	timerElemPool                    TimerElemPool
 	gaugeElemPool                    GaugeElemPool
+	setElemPool                      SetElemPool
 
 	// Derived options.
 	fullCounterPrefix []byte
 	fullTimerPrefix   []byte
 	fullGaugePrefix   []byte
+	fullSetPrefix     []byte
 	timerQuantiles    []float64
 }
 
@@ -351,13 +389,15 @@ func NewOptions() Options {
 	aggTypesOptions := aggregation.NewTypesOptions().
 		SetCounterTypeStringTransformFn(aggregation.EmptyTransform).
 		SetTimerTypeStringTransformFn(aggregation.SuffixTransform).
-		SetGaugeTypeStringTransformFn(aggregation.EmptyTransform)
+		SetGaugeTypeStringTransformFn(aggregation.EmptyTransform).
+		SetSetTypeStringTransformFn(aggregation.EmptyTransform)
 	o := &options{
 		aggTypesOptions:    aggTypesOptions,
 		metricPrefix:       defaultMetricPrefix,
 		counterPrefix:      defaultCounterPrefix,
 		timerPrefix:        defaultTimerPrefix,
 		gaugePrefix:        defaultGaugePrefix,
+		setPrefix:          defaultSetPrefix,
 		timeLock:           &sync.RWMutex{},
 		clockOpts:          clock.NewOptions(),
 		instrumentOpts:     instrument.NewOptions(),
@@ -372,6 +412,7 @@ func NewOptions() Options {
 		maxTimerBatchSizePerWrite:        defaultMaxTimerBatchSizePerWrite,
 		defaultStoragePolicies:           defaultDefaultStoragePolicies,
 		resignTimeout:                    defaultResignTimeout,
+		checkpointRecoveryTimeout:        defaultCheckpointRecoveryTimeout,
 		maxAllowedForwardingDelayFn:      defaultMaxAllowedForwardingDelayFn,
 		bufferForPastTimedMetricFn:       defaultBufferForPastTimedMetricFn,
 		bufferForFutureTimedMetric:       defaultTimedMetricBuffer,
@@ -432,6 +473,17 @@ func (o *options) GaugePrefix() []byte {
 	return o.gaugePrefix
 }
 
+func (o *options) SetSetPrefix(value []byte) Options {
+	opts := *o
+	opts.setPrefix = value
+	opts.computeFullSetPrefix()
+	return &opts
+}
+
+func (o *options) SetPrefix() []byte {
+	return o.setPrefix
+}
+
 func (o *options) SetTimeLock(value *sync.RWMutex) Options {
 	opts := *o
 	opts.timeLock = value
@@ -572,6 +624,26 @@ func (o *options) FlushManager() FlushManager {
 	return o.flushManager
 }
 
+func (o *options) SetCheckpointManager(value CheckpointManager) Options {
+	opts := *o
+	opts.checkpointManager = value
+	return &opts
+}
+
+func (o *options) CheckpointManager() CheckpointManager {
+	return o.checkpointManager
+}
+
+func (o *options) SetCheckpointRecoveryTimeout(value time.Duration) Options {
+	opts := *o
+	opts.checkpointRecoveryTimeout = value
+	return &opts
+}
+
+func (o *options) CheckpointRecoveryTimeout() time.Duration {
+	return o.checkpointRecoveryTimeout
+}
+
 func (o *options) SetFlushHandler(value handler.Handler) Options {
 	opts := *o
 	opts.flushHandler = value
@@ -732,6 +804,16 @@ func (o *options) GaugeElemPool() GaugeElemPool {
 	return o.gaugeElemPool
 }
 
+func (o *options) SetSetElemPool(value SetElemPool) Options {
+	opts := *o
+	opts.setElemPool = value
+	return &opts
+}
+
+func (o *options) SetElemPool() SetElemPool {
+	return o.setElemPool
+}
+
 func (o *options) FullCounterPrefix() []byte {
 	return o.fullCounterPrefix
 }
@@ -744,6 +826,10 @@ func (o *options) FullGaugePrefix() []byte {
 	return o.fullGaugePrefix
 }
 
+func (o *options) FullSetPrefix() []byte {
+	return o.fullSetPrefix
+}
+
 func (o *options) TimerQuantiles() []float64 {
 	return o.timerQuantiles
 }
@@ -769,6 +855,11 @@ func (o *options) initPools() {
 	o.gaugeElemPool.Init(func() *GaugeElem {
 		return MustNewGaugeElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, WithPrefixWithSuffix, o)
 	})
+
+	o.setElemPool = NewSetElemPool(nil)
+	o.setElemPool.Init(func() *SetElem {
+		return MustNewSetElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, WithPrefixWithSuffix, o)
+	})
 }
 
 func (o *options) computeAllDerived() {
@@ -779,6 +870,7 @@ func (o *options) computeFullPrefixes() {
 	o.computeFullCounterPrefix()
 	o.computeFullTimerPrefix()
 	o.computeFullGaugePrefix()
+	o.computeFullSetPrefix()
 }
 
 func (o *options) computeFullCounterPrefix() {
@@ -802,6 +894,13 @@ func (o *options) computeFullGaugePrefix() {
 	o.fullGaugePrefix = fullGaugePrefix
 }
 
+func (o *options) computeFullSetPrefix() {
+	fullSetPrefix := make([]byte, len(o.metricPrefix)+len(o.setPrefix))
+	n := copy(fullSetPrefix, o.metricPrefix)
+	copy(fullSetPrefix[n:], o.setPrefix)
+	o.fullSetPrefix = fullSetPrefix
+}
+
 func defaultMaxAllowedForwardingDelayFn(
 	resolution time.Duration,
 	numForwardedTimes int,
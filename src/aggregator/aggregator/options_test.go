@@ -56,6 +56,7 @@ func TestOptionsValidateDefault(t *testing.T) {
 	require.Equal(t, defaultCounterPrefix, o.CounterPrefix())
 	require.Equal(t, defaultTimerPrefix, o.TimerPrefix())
 	require.Equal(t, defaultGaugePrefix, o.GaugePrefix())
+	require.Equal(t, defaultSetPrefix, o.SetPrefix())
 	require.Equal(t, defaultEntryTTL, o.EntryTTL())
 	require.Equal(t, defaultEntryCheckInterval, o.EntryCheckInterval())
 	require.Equal(t, defaultEntryCheckBatchPercent, o.EntryCheckBatchPercent())
@@ -67,11 +68,13 @@ func TestOptionsValidateDefault(t *testing.T) {
 	require.NotNil(t, o.CounterElemPool())
 	require.NotNil(t, o.TimerElemPool())
 	require.NotNil(t, o.GaugeElemPool())
+	require.NotNil(t, o.SetElemPool())
 
 	// Validate derived options.
 	validateDerivedPrefix(t, o.FullCounterPrefix(), o.MetricPrefix(), o.CounterPrefix())
 	validateDerivedPrefix(t, o.FullTimerPrefix(), o.MetricPrefix(), o.TimerPrefix())
 	validateDerivedPrefix(t, o.FullGaugePrefix(), o.MetricPrefix(), o.GaugePrefix())
+	validateDerivedPrefix(t, o.FullSetPrefix(), o.MetricPrefix(), o.SetPrefix())
 }
 
 func TestOptionsSetMetricPrefix(t *testing.T) {
@@ -81,6 +84,7 @@ func TestOptionsSetMetricPrefix(t *testing.T) {
 	validateDerivedPrefix(t, o.FullCounterPrefix(), o.MetricPrefix(), o.CounterPrefix())
 	validateDerivedPrefix(t, o.FullTimerPrefix(), o.MetricPrefix(), o.TimerPrefix())
 	validateDerivedPrefix(t, o.FullGaugePrefix(), o.MetricPrefix(), o.GaugePrefix())
+	validateDerivedPrefix(t, o.FullSetPrefix(), o.MetricPrefix(), o.SetPrefix())
 }
 
 func TestOptionsSetCounterPrefix(t *testing.T) {
@@ -104,6 +108,13 @@ func TestOptionsSetGaugePrefix(t *testing.T) {
 	validateDerivedPrefix(t, o.FullGaugePrefix(), o.MetricPrefix(), o.GaugePrefix())
 }
 
+func TestOptionsSetSetPrefix(t *testing.T) {
+	newPrefix := []byte("testSetPrefix")
+	o := NewOptions().SetSetPrefix(newPrefix)
+	require.Equal(t, newPrefix, o.SetPrefix())
+	validateDerivedPrefix(t, o.FullSetPrefix(), o.MetricPrefix(), o.SetPrefix())
+}
+
 func TestSetClockOptions(t *testing.T) {
 	value := clock.NewOptions()
 	o := NewOptions().SetClockOptions(value)
@@ -149,6 +160,18 @@ func TestSetFlushHandler(t *testing.T) {
 	require.Equal(t, h, o.FlushHandler())
 }
 
+func TestSetCheckpointManager(t *testing.T) {
+	value := NewCheckpointManager(NewCheckpointManagerOptions())
+	o := NewOptions().SetCheckpointManager(value)
+	require.Equal(t, value, o.CheckpointManager())
+}
+
+func TestSetCheckpointRecoveryTimeout(t *testing.T) {
+	value := time.Minute
+	o := NewOptions().SetCheckpointRecoveryTimeout(value)
+	require.Equal(t, value, o.CheckpointRecoveryTimeout())
+}
+
 func TestSetEntryTTL(t *testing.T) {
 	value := time.Minute
 	o := NewOptions().SetEntryTTL(value)
@@ -225,3 +248,9 @@ func TestSetGaugeElemPool(t *testing.T) {
 	o := NewOptions().SetGaugeElemPool(value)
 	require.Equal(t, value, o.GaugeElemPool())
 }
+
+func TestSetSetElemPool(t *testing.T) {
+	value := NewSetElemPool(nil)
+	o := NewOptions().SetSetElemPool(value)
+	require.Equal(t, value, o.SetElemPool())
+}
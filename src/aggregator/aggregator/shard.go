@@ -27,6 +27,8 @@ import (
 	"sync"
 	"time"
 
+	schema "github.com/m3db/m3/src/aggregator/generated/proto/flush"
+	"github.com/m3db/m3/src/metrics/encoding"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
@@ -224,6 +226,50 @@ func (s *aggregatorShard) AddForwarded(
 	return nil
 }
 
+// Recover replays the metrics checkpointed for the shard, returning the number
+// of messages recovered and the number of messages that failed to be recovered.
+func (s *aggregatorShard) Recover(cm CheckpointManager) (int, int, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return 0, 0, errAggregatorShardClosed
+	}
+	var numRecovered, numFailed int
+	err := cm.Read(s.shard, func(addedAtNanos int64, msg encoding.UnaggregatedMessageUnion) error {
+		if err := s.metricMap.Recover(addedAtNanos, msg); err != nil {
+			numFailed++
+			return nil
+		}
+		numRecovered++
+		return nil
+	})
+	return numRecovered, numFailed, err
+}
+
+// DiscardFlushed discards recovered data that have already been flushed.
+func (s *aggregatorShard) DiscardFlushed(flushTimes *schema.ShardFlushTimes) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return
+	}
+	s.metricMap.DiscardFlushed(flushTimes)
+}
+
+// DiscardBefore discards recovered data before the given time, used when it
+// is unknown which recovered windows have already been flushed.
+func (s *aggregatorShard) DiscardBefore(beforeNanos int64) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return
+	}
+	s.metricMap.DiscardBefore(beforeNanos)
+}
+
 func (s *aggregatorShard) Tick(target time.Duration) tickResult {
 	return s.metricMap.Tick(target)
 }
@@ -22,13 +22,17 @@ package aggregator
 
 import (
 	"math"
+	"os"
 	"testing"
 	"time"
 
+	schema "github.com/m3db/m3/src/aggregator/generated/proto/flush"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
+	"github.com/m3db/m3/src/x/clock"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -277,3 +281,55 @@ func TestAggregatorShardClose(t *testing.T) {
 	// Closing the shard again is a no op.
 	shard.Close()
 }
+
+func TestAggregatorShardRecover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Unix(0, 0).Add(time.Hour)
+	cm, cmOpts := testCheckpointManager(t, now)
+	defer os.RemoveAll(cmOpts.Directory())
+	require.NoError(t, cm.Open())
+
+	opts := testOptions(ctrl).
+		SetClockOptions(clock.NewOptions().SetNowFn(func() time.Time { return now })).
+		SetCheckpointManager(cm)
+	shard := newAggregatorShard(testShard, opts)
+	shard.SetWriteableRange(timeRange{cutoverNanos: 0, cutoffNanos: math.MaxInt64})
+	require.NoError(t, shard.AddUntimed(testUntimedMetric, testDefaultStagedMetadatas))
+	require.NoError(t, cm.Close())
+
+	// Recover the checkpointed metric into a new shard as if the process has restarted.
+	cm = NewCheckpointManager(cmOpts).(*checkpointManager)
+	require.NoError(t, cm.Open())
+	defer cm.Close()
+	shard = newAggregatorShard(testShard, opts.SetCheckpointManager(cm))
+	numRecovered, numFailed, err := shard.Recover(cm)
+	require.NoError(t, err)
+	require.Equal(t, 1, numRecovered)
+	require.Equal(t, 0, numFailed)
+	require.Equal(t, 1, len(shard.metricMap.entries))
+	require.Equal(t, 2, shard.metricMap.metricLists.Len())
+
+	// Windows that have already been flushed are discarded.
+	shard.DiscardFlushed(&schema.ShardFlushTimes{
+		StandardByResolution: map[int64]int64{
+			int64(10 * time.Second): now.UnixNano(),
+		},
+	})
+	for id, list := range shard.metricMap.metricLists.lists {
+		var expected int64
+		if id.standard.resolution == 10*time.Second {
+			expected = now.UnixNano()
+		}
+		require.Equal(t, expected, list.(flushingMetricList).LastFlushedNanos())
+	}
+
+	// Windows that may have been flushed are discarded when the flush times
+	// are unknown.
+	discardBefore := now.Add(time.Minute)
+	shard.DiscardBefore(discardBefore.UnixNano())
+	for _, list := range shard.metricMap.metricLists.lists {
+		require.Equal(t, discardBefore.UnixNano(), list.(flushingMetricList).LastFlushedNanos())
+	}
+}
@@ -64,6 +64,11 @@ var (
 		ID:       []byte("foo"),
 		GaugeVal: 123.456,
 	}
+	testSet = unaggregated.MetricUnion{
+		Type:   metric.SetType,
+		ID:     []byte("foo"),
+		SetVal: [][]byte{[]byte("bar"), []byte("baz")},
+	}
 	testTimed = aggregated.Metric{
 		Type:      metric.CounterType,
 		ID:        []byte("testForwarded"),
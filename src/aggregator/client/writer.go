@@ -262,6 +262,14 @@ func (w *writer) encodeUntimedWithLock(
 				StagedMetadatas: metadatas,
 			}}
 		encodeErr = encoder.EncodeMessage(msg)
+	case metric.SetType:
+		msg := encoding.UnaggregatedMessageUnion{
+			Type: encoding.SetWithMetadatasType,
+			SetWithMetadatas: unaggregated.SetWithMetadatas{
+				Set:             metricUnion.Set(),
+				StagedMetadatas: metadatas,
+			}}
+		encodeErr = encoder.EncodeMessage(msg)
 	default:
 		encodeErr = errUnrecognizedMetricType
 	}
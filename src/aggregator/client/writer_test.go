@@ -535,6 +535,37 @@ func TestWriterWriteUntimedGauge(t *testing.T) {
 	require.NoError(t, w.Write(0, payload))
 }
 
+func TestWriterWriteUntimedSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	encoder := protobuf.NewMockUnaggregatedEncoder(ctrl)
+	gomock.InOrder(
+		encoder.EXPECT().Len().Return(3),
+		encoder.EXPECT().EncodeMessage(encoding.UnaggregatedMessageUnion{
+			Type: encoding.SetWithMetadatasType,
+			SetWithMetadatas: unaggregated.SetWithMetadatas{
+				Set:             testSet.Set(),
+				StagedMetadatas: testStagedMetadatas,
+			},
+		}).Return(nil),
+		encoder.EXPECT().Len().Return(7),
+	)
+	w := newInstanceWriter(testPlacementInstance, testOptions()).(*writer)
+	w.newLockedEncoderFn = func(protobuf.UnaggregatedOptions) *lockedEncoder {
+		return &lockedEncoder{UnaggregatedEncoder: encoder}
+	}
+
+	payload := payloadUnion{
+		payloadType: untimedType,
+		untimed: untimedPayload{
+			metric:    testSet,
+			metadatas: testStagedMetadatas,
+		},
+	}
+	require.NoError(t, w.Write(0, payload))
+}
+
 func TestWriterWriteForwardedWithFlushingZeroSizeBefore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -7,7 +7,7 @@ m3db_package_path    := $(gopath_prefix)/$(m3db_package)
 
 # Generation rule for all generated types
 .PHONY: genny-all
-genny-all: genny-aggregator-counter-elem genny-aggregator-timer-elem genny-aggregator-gauge-elem
+genny-all: genny-aggregator-counter-elem genny-aggregator-timer-elem genny-aggregator-gauge-elem genny-aggregator-set-elem
 
 .PHONY: genny-aggregator-counter-elem
 genny-aggregator-counter-elem:
@@ -29,3 +29,10 @@ genny-aggregator-gauge-elem:
 		| awk '/^package/{i++}i'                                                                          \
 		| genny -out=$(m3db_package_path)/src/aggregator/aggregator/gauge_elem_gen.go -pkg=aggregator gen \
 		"timedAggregation=timedGauge lockedAggregation=lockedGaugeAggregation typeSpecificAggregation=gaugeAggregation typeSpecificElemBase=gaugeElemBase genericElemPool=GaugeElemPool GenericElem=GaugeElem"
+
+.PHONY: genny-aggregator-set-elem
+genny-aggregator-set-elem:
+	cat $(m3db_package_path)/src/aggregator/aggregator/generic_elem.go                                \
+		| awk '/^package/{i++}i'                                                                        \
+		| genny -out=$(m3db_package_path)/src/aggregator/aggregator/set_elem_gen.go -pkg=aggregator gen \
+		"timedAggregation=timedSet lockedAggregation=lockedSetAggregation typeSpecificAggregation=setAggregation typeSpecificElemBase=setElemBase genericElemPool=SetElemPool GenericElem=SetElem"
@@ -247,6 +247,73 @@ func (a mirroredAlgorithm) MarkAllShardsAvailable(
 	return a.shardedAlgo.MarkAllShardsAvailable(p)
 }
 
+func (a mirroredAlgorithm) Rebalance(p placement.Placement) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+
+	p, _, err := a.MarkAllShardsAvailable(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.rebalance(p)
+}
+
+func (a mirroredAlgorithm) SetInstanceWeights(
+	p placement.Placement,
+	weights map[string]uint32,
+) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+
+	p, _, err := a.MarkAllShardsAvailable(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// All the instances in the same shard set must share the same weight,
+	// so the weight of an instance is applied to its whole shard set.
+	shardSetWeights := make(map[uint32]uint32, len(weights))
+	for id, weight := range weights {
+		instance, ok := p.Instance(id)
+		if !ok {
+			return nil, fmt.Errorf("instance %s does not exist in the placement", id)
+		}
+		ssID := instance.ShardSetID()
+		if w, ok := shardSetWeights[ssID]; ok && w != weight {
+			return nil, fmt.Errorf("found different weights: %d and %d, for shardset id %d", w, weight, ssID)
+		}
+		shardSetWeights[ssID] = weight
+	}
+
+	instanceWeights := make(map[string]uint32, len(weights))
+	for _, instance := range p.Instances() {
+		if weight, ok := shardSetWeights[instance.ShardSetID()]; ok {
+			instanceWeights[instance.ID()] = weight
+		}
+	}
+	if err := setInstanceWeights(p, instanceWeights); err != nil {
+		return nil, err
+	}
+
+	return a.rebalance(p)
+}
+
+func (a mirroredAlgorithm) rebalance(p placement.Placement) (placement.Placement, error) {
+	mirrorPlacement, err := mirrorFromPlacement(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if mirrorPlacement, err = a.shardedAlgo.Rebalance(mirrorPlacement); err != nil {
+		return nil, err
+	}
+
+	return placementFromMirror(mirrorPlacement, p.Instances(), p.ReplicaFactor())
+}
+
 // allInitializing returns true when
 // 1: the given list of instances matches all the initializing instances in the placement.
 // 2: the shards are not cutover yet.
@@ -1371,6 +1371,67 @@ func TestReclaimLeavingShardsWithAvailable(t *testing.T) {
 	assert.Equal(t, uint32(2), p2.MaxShardSetID())
 }
 
+func TestMirrorRebalanceAndSetInstanceWeights(t *testing.T) {
+	i1 := placement.NewEmptyInstance("i1", "r1", "", "e1", 1).SetShardSetID(1)
+	i2 := placement.NewEmptyInstance("i2", "r2", "", "e2", 1).SetShardSetID(1)
+	i3 := placement.NewEmptyInstance("i3", "r1", "", "e3", 1).SetShardSetID(2)
+	i4 := placement.NewEmptyInstance("i4", "r2", "", "e4", 1).SetShardSetID(2)
+	for id := uint32(0); id < 6; id++ {
+		i1.Shards().Add(shard.NewShard(id).SetState(shard.Available))
+		i2.Shards().Add(shard.NewShard(id).SetState(shard.Available))
+	}
+	for id := uint32(6); id < 8; id++ {
+		i3.Shards().Add(shard.NewShard(id).SetState(shard.Available))
+		i4.Shards().Add(shard.NewShard(id).SetState(shard.Available))
+	}
+
+	p := placement.NewPlacement().
+		SetInstances([]placement.Instance{i1, i2, i3, i4}).
+		SetShards([]uint32{0, 1, 2, 3, 4, 5, 6, 7}).
+		SetReplicaFactor(2).
+		SetIsSharded(true).
+		SetIsMirrored(true).
+		SetMaxShardSetID(2)
+
+	a := newMirroredAlgorithm(placement.NewOptions())
+	p, err := a.Rebalance(p)
+	assert.NoError(t, err)
+	assert.NoError(t, placement.Validate(p))
+
+	for _, id := range []string{"i1", "i2"} {
+		instance, _ := p.Instance(id)
+		assert.Equal(t, 4, loadOnInstance(instance))
+		assert.Equal(t, 2, instance.Shards().NumShardsForState(shard.Leaving))
+	}
+	for id, sourceID := range map[string]string{"i3": "i1", "i4": "i2"} {
+		instance, _ := p.Instance(id)
+		assert.Equal(t, 4, loadOnInstance(instance))
+		initShards := instance.Shards().ShardsForState(shard.Initializing)
+		assert.Equal(t, 2, len(initShards))
+		for _, s := range initShards {
+			assert.Equal(t, sourceID, s.SourceID())
+		}
+	}
+
+	_, err = a.SetInstanceWeights(p, map[string]uint32{"i1": 3, "i2": 2})
+	assert.Error(t, err)
+
+	p, err = a.SetInstanceWeights(p, map[string]uint32{"i3": 3})
+	assert.NoError(t, err)
+	assert.NoError(t, placement.Validate(p))
+
+	for _, id := range []string{"i1", "i2"} {
+		instance, _ := p.Instance(id)
+		assert.Equal(t, uint32(1), instance.Weight())
+		assert.Equal(t, 2, loadOnInstance(instance))
+	}
+	for _, id := range []string{"i3", "i4"} {
+		instance, _ := p.Instance(id)
+		assert.Equal(t, uint32(3), instance.Weight())
+		assert.Equal(t, 6, loadOnInstance(instance))
+	}
+}
+
 func TestMarkShardAsAvailableWithMirroredAlgo(t *testing.T) {
 	var (
 		cutoverTime           = time.Now()
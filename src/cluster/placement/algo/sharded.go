@@ -218,3 +218,33 @@ func (a shardedPlacementAlgorithm) MarkAllShardsAvailable(
 
 	return markAllShardsAvailable(p, a.opts)
 }
+
+func (a shardedPlacementAlgorithm) Rebalance(p placement.Placement) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+
+	return a.rebalance(p.Clone())
+}
+
+func (a shardedPlacementAlgorithm) SetInstanceWeights(
+	p placement.Placement,
+	weights map[string]uint32,
+) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+
+	p = p.Clone()
+	if err := setInstanceWeights(p, weights); err != nil {
+		return nil, err
+	}
+
+	return a.rebalance(p)
+}
+
+func (a shardedPlacementAlgorithm) rebalance(p placement.Placement) (placement.Placement, error) {
+	ph := newHelper(p, p.ReplicaFactor(), a.opts)
+	ph.rebalance()
+	return tryCleanupShardState(ph.generatePlacement(), a.opts)
+}
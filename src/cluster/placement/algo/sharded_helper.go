@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/m3db/m3/src/cluster/placement"
 	"github.com/m3db/m3/src/cluster/shard"
@@ -36,6 +37,7 @@ var (
 	errAddingInstanceAlreadyExist         = errors.New("the adding instance is already in the placement")
 	errInstanceContainsNonLeavingShards   = errors.New("the adding instance contains non leaving shards")
 	errInstanceContainsInitializingShards = errors.New("the adding instance contains initializing shards")
+	errInvalidInstanceWeight              = errors.New("instance weight must be positive")
 )
 
 type instanceType int
@@ -72,6 +74,10 @@ type placementHelper interface {
 	// optimize rebalances the load distribution in the cluster.
 	optimize(t optimizeType) error
 
+	// rebalance moves shards from the instances above their target load to the
+	// instances below their target load with minimal shard movement.
+	rebalance()
+
 	// generatePlacement generates a placement.
 	generatePlacement() placement.Placement
 
@@ -534,6 +540,53 @@ func (ph *helper) optimize(t optimizeType) error {
 	}
 }
 
+func (ph *helper) rebalance() {
+	// Every shard moved goes from an instance above its target load to an
+	// instance below its target load, so each move strictly reduces the total
+	// imbalance and no shard is moved more than necessary.
+	for {
+		moved := false
+		for _, to := range ph.instancesByLoadGap(func(gap int) bool { return gap > 0 }) {
+			for _, from := range ph.instancesByLoadGap(func(gap int) bool { return gap < 0 }) {
+				for ph.loadGap(to) > 0 && ph.loadGap(from) < 0 && ph.moveOneShard(from, to) {
+					moved = true
+				}
+			}
+		}
+		if !moved {
+			return
+		}
+	}
+}
+
+// loadGap returns the number of shards the instance needs to reach its target load,
+// a negative value means the instance is above its target load.
+func (ph *helper) loadGap(instance placement.Instance) int {
+	return ph.targetLoadForInstance(instance.ID()) - loadOnInstance(instance)
+}
+
+// instancesByLoadGap returns the non leaving instances whose load gap matches
+// the given filter, sorted by the absolute load gap in descending order.
+func (ph *helper) instancesByLoadGap(filterFn func(gap int) bool) []placement.Instance {
+	res := make([]placement.Instance, 0, len(ph.instances))
+	for _, instance := range nonLeavingInstances(ph.Instances()) {
+		if filterFn(ph.loadGap(instance)) {
+			res = append(res, instance)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		gapI, gapJ := ph.loadGap(res[i]), ph.loadGap(res[j])
+		if gapI < 0 {
+			gapI, gapJ = -gapI, -gapJ
+		}
+		if gapI != gapJ {
+			return gapI > gapJ
+		}
+		return res[i].ID() < res[j].ID()
+	})
+	return res
+}
+
 func (ph *helper) assignLoadToInstanceSafe(addingInstance placement.Instance) error {
 	return ph.assignTargetLoad(addingInstance, func(from, to placement.Instance) bool {
 		return ph.moveOneShardInState(from, to, shard.Unknown)
@@ -716,6 +769,24 @@ func removeInstanceFromPlacement(p placement.Placement, id string) (placement.Pl
 	return p.SetInstances(removeInstanceFromList(p.Instances(), id)), leavingInstance, nil
 }
 
+// setInstanceWeights updates the weights of the given instances in the placement.
+func setInstanceWeights(p placement.Placement, weights map[string]uint32) error {
+	for id, weight := range weights {
+		instance, ok := p.Instance(id)
+		if !ok {
+			return fmt.Errorf("instance %s does not exist in placement", id)
+		}
+		if instance.IsLeaving() {
+			return fmt.Errorf("could not set weight for leaving instance %s", id)
+		}
+		if weight == 0 {
+			return errInvalidInstanceWeight
+		}
+		instance.SetWeight(weight)
+	}
+	return nil
+}
+
 func getShardMap(shards []shard.Shard) map[uint32]shard.Shard {
 	r := make(map[uint32]shard.Shard, len(shards))
 
@@ -1097,6 +1097,14 @@ func TestIncompatibleWithShardedAlgo(t *testing.T) {
 	_, err = a.MarkShardsAvailable(p, "i2", 0)
 	assert.Error(t, err)
 	assert.Equal(t, errIncompatibleWithShardedAlgo, err)
+
+	_, err = a.Rebalance(p)
+	assert.Error(t, err)
+	assert.Equal(t, errIncompatibleWithShardedAlgo, err)
+
+	_, err = a.SetInstanceWeights(p, map[string]uint32{"i1": 2})
+	assert.Error(t, err)
+	assert.Equal(t, errIncompatibleWithShardedAlgo, err)
 }
 
 func TestMarkShardAsAvailableWithShardedAlgo(t *testing.T) {
@@ -1179,6 +1187,88 @@ func TestMarkShardAsAvailableBulkWithShardedAlgo(t *testing.T) {
 	}
 }
 
+func TestRebalance(t *testing.T) {
+	i1 := placement.NewEmptyInstance("i1", "r1", "", "e1", 1)
+	for id := uint32(0); id < 6; id++ {
+		i1.Shards().Add(shard.NewShard(id).SetState(shard.Available))
+	}
+	i2 := placement.NewEmptyInstance("i2", "r2", "", "e2", 1)
+	i2.Shards().Add(shard.NewShard(6).SetState(shard.Available))
+	i2.Shards().Add(shard.NewShard(7).SetState(shard.Available))
+	i3 := placement.NewEmptyInstance("i3", "r3", "", "e3", 1)
+	i3.Shards().Add(shard.NewShard(8).SetState(shard.Available))
+	i3.Shards().Add(shard.NewShard(9).SetState(shard.Available))
+
+	p := placement.NewPlacement().
+		SetInstances([]placement.Instance{i1, i2, i3}).
+		SetShards([]uint32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}).
+		SetReplicaFactor(1).
+		SetIsSharded(true)
+
+	a := newShardedAlgorithm(placement.NewOptions())
+	p1, err := a.Rebalance(p)
+	require.NoError(t, err)
+	assert.NoError(t, placement.Validate(p1))
+
+	// The original placement is not modified.
+	i1, _ = p.Instance("i1")
+	assert.Equal(t, 6, loadOnInstance(i1))
+
+	i1, _ = p1.Instance("i1")
+	assert.Equal(t, 4, loadOnInstance(i1))
+	assert.Equal(t, 2, i1.Shards().NumShardsForState(shard.Leaving))
+	for _, id := range []string{"i2", "i3"} {
+		instance, _ := p1.Instance(id)
+		assert.Equal(t, 3, loadOnInstance(instance))
+		initShards := instance.Shards().ShardsForState(shard.Initializing)
+		require.Equal(t, 1, len(initShards))
+		assert.Equal(t, "i1", initShards[0].SourceID())
+	}
+
+	// Rebalancing a balanced placement does not move any shard.
+	p2, _ := mustMarkAllShardsAsAvailable(t, p1, placement.NewOptions())
+	p3, err := a.Rebalance(p2)
+	require.NoError(t, err)
+	for _, instance := range p3.Instances() {
+		assert.Equal(t, 0, instance.Shards().NumShardsForState(shard.Initializing))
+	}
+}
+
+func TestSetInstanceWeights(t *testing.T) {
+	i1 := placement.NewEmptyInstance("i1", "r1", "", "e1", 1)
+	i2 := placement.NewEmptyInstance("i2", "r2", "", "e2", 1)
+	for id := uint32(0); id < 4; id++ {
+		i1.Shards().Add(shard.NewShard(id).SetState(shard.Available))
+		i2.Shards().Add(shard.NewShard(id + 4).SetState(shard.Available))
+	}
+
+	p := placement.NewPlacement().
+		SetInstances([]placement.Instance{i1, i2}).
+		SetShards([]uint32{0, 1, 2, 3, 4, 5, 6, 7}).
+		SetReplicaFactor(1).
+		SetIsSharded(true)
+
+	a := newShardedAlgorithm(placement.NewOptions())
+	_, err := a.SetInstanceWeights(p, map[string]uint32{"i3": 3})
+	assert.Error(t, err)
+
+	_, err = a.SetInstanceWeights(p, map[string]uint32{"i1": 0})
+	assert.Equal(t, errInvalidInstanceWeight, err)
+
+	p, err = a.SetInstanceWeights(p, map[string]uint32{"i1": 3})
+	require.NoError(t, err)
+	assert.NoError(t, placement.Validate(p))
+
+	i1, _ = p.Instance("i1")
+	assert.Equal(t, uint32(3), i1.Weight())
+	assert.Equal(t, 6, loadOnInstance(i1))
+	assert.Equal(t, 2, i1.Shards().NumShardsForState(shard.Initializing))
+	i2, _ = p.Instance("i2")
+	assert.Equal(t, uint32(1), i2.Weight())
+	assert.Equal(t, 2, loadOnInstance(i2))
+	assert.Equal(t, 2, i2.Shards().NumShardsForState(shard.Leaving))
+}
+
 func TestGoodCaseWithSimpleShardStateType(t *testing.T) {
 	i1 := placement.NewEmptyInstance("i1", "r1", "z1", "endpoint", 1)
 	i2 := placement.NewEmptyInstance("i2", "r1", "z1", "endpoint", 1)
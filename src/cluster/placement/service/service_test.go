@@ -912,6 +912,36 @@ func TestReplaceInstances(t *testing.T) {
 	}
 }
 
+func TestSetInstanceWeightsAndRebalance(t *testing.T) {
+	ps := NewPlacementService(newMockStorage(), placement.NewOptions().SetValidZone("z1"))
+
+	i1 := placement.NewEmptyInstance("i1", "r1", "z1", "endpoint1", 1)
+	i2 := placement.NewEmptyInstance("i2", "r2", "z1", "endpoint2", 1)
+	_, err := ps.BuildInitialPlacement([]placement.Instance{i1, i2}, 12, 1)
+	require.NoError(t, err)
+	markAllInstancesAvailable(t, ps)
+
+	_, err = ps.SetInstanceWeights(map[string]uint32{"i3": 2})
+	assert.Error(t, err)
+
+	p, err := ps.SetInstanceWeights(map[string]uint32{"i2": 2})
+	require.NoError(t, err)
+	i2, ok := p.Instance("i2")
+	require.True(t, ok)
+	assert.Equal(t, uint32(2), i2.Weight())
+	assert.Equal(t, 8, i2.Shards().NumShards())
+	assert.Equal(t, 2, i2.Shards().NumShardsForState(shard.Initializing))
+
+	markAllInstancesAvailable(t, ps)
+
+	// The placement is already balanced, rebalancing does not move any shard.
+	p, err = ps.Rebalance()
+	require.NoError(t, err)
+	for _, instance := range p.Instances() {
+		assert.Equal(t, 0, instance.Shards().NumShardsForState(shard.Initializing))
+	}
+}
+
 func TestValidateFnBeforeUpdate(t *testing.T) {
 	p := NewPlacementService(newMockStorage(), placement.NewOptions().SetValidZone("z1")).(*placementService)
 
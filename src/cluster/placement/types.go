@@ -526,6 +526,13 @@ type Service interface {
 
 	// MarkAllShardsAvailable marks shard states as available where applicable.
 	MarkAllShardsAvailable() (Placement, error)
+
+	// Rebalance moves shards around to bring every instance to its target load
+	// with minimal shard movement.
+	Rebalance() (Placement, error)
+
+	// SetInstanceWeights updates the weights of the given instances and rebalances the placement.
+	SetInstanceWeights(weights map[string]uint32) (Placement, error)
 }
 
 // Algorithm places shards on instances.
@@ -557,6 +564,13 @@ type Algorithm interface {
 
 	// MarkAllShardsAvailable marks shard states as available where applicable.
 	MarkAllShardsAvailable(p Placement) (Placement, bool, error)
+
+	// Rebalance moves shards around to bring every instance to its target load
+	// with minimal shard movement.
+	Rebalance(p Placement) (Placement, error)
+
+	// SetInstanceWeights updates the weights of the given instances and rebalances the placement.
+	SetInstanceWeights(p Placement, weights map[string]uint32) (Placement, error)
 }
 
 // InstanceSelector selects valid instances for the placement change.
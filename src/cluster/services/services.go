@@ -758,7 +758,8 @@ func NewServiceInstanceFromProto(
 		SetServiceID(sid).
 		SetInstanceID(instance.Id).
 		SetEndpoint(instance.Endpoint).
-		SetShards(shards), nil
+		SetShards(shards).
+		SetIsolationGroup(instance.IsolationGroup), nil
 }
 
 // NewServiceInstanceFromPlacementInstance creates a new service instance from placement instance.
@@ -770,24 +771,32 @@ func NewServiceInstanceFromPlacementInstance(
 		SetServiceID(sid).
 		SetInstanceID(instance.ID()).
 		SetEndpoint(instance.Endpoint()).
-		SetShards(instance.Shards())
+		SetShards(instance.Shards()).
+		SetIsolationGroup(instance.IsolationGroup())
 }
 
 type serviceInstance struct {
-	service  ServiceID
-	id       string
-	endpoint string
-	shards   shard.Shards
+	service        ServiceID
+	id             string
+	endpoint       string
+	shards         shard.Shards
+	isolationGroup string
 }
 
 func (i *serviceInstance) InstanceID() string                       { return i.id }
 func (i *serviceInstance) Endpoint() string                         { return i.endpoint }
 func (i *serviceInstance) Shards() shard.Shards                     { return i.shards }
+func (i *serviceInstance) IsolationGroup() string                   { return i.isolationGroup }
 func (i *serviceInstance) ServiceID() ServiceID                     { return i.service }
 func (i *serviceInstance) SetInstanceID(id string) ServiceInstance  { i.id = id; return i }
 func (i *serviceInstance) SetEndpoint(e string) ServiceInstance     { i.endpoint = e; return i }
 func (i *serviceInstance) SetShards(s shard.Shards) ServiceInstance { i.shards = s; return i }
 
+func (i *serviceInstance) SetIsolationGroup(group string) ServiceInstance {
+	i.isolationGroup = group
+	return i
+}
+
 func (i *serviceInstance) SetServiceID(service ServiceID) ServiceInstance {
 	i.service = service
 	return i
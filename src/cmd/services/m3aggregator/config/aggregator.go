@@ -75,6 +75,9 @@ type AggregatorConfiguration struct {
 	// Gauge metric prefix.
 	GaugePrefix *string `yaml:"gaugePrefix"`
 
+	// Set metric prefix.
+	SetPrefix *string `yaml:"setPrefix"`
+
 	// Stream configuration for computing quantiles.
 	Stream streamConfiguration `yaml:"stream"`
 
@@ -111,6 +114,9 @@ type AggregatorConfiguration struct {
 	// Flush manager.
 	FlushManager flushManagerConfiguration `yaml:"flushManager"`
 
+	// Checkpointing of in-flight aggregation windows, disabled if not configured.
+	Checkpoint *checkpointConfiguration `yaml:"checkpoint"`
+
 	// Flushing handler configuration.
 	Flush handler.FlushHandlerConfiguration `yaml:"flush"`
 
@@ -147,6 +153,9 @@ type AggregatorConfiguration struct {
 	// Pool of gauge elements.
 	GaugeElemPool pool.ObjectPoolConfiguration `yaml:"gaugeElemPool"`
 
+	// Pool of set elements.
+	SetElemPool pool.ObjectPoolConfiguration `yaml:"setElemPool"`
+
 	// Pool of entries.
 	EntryPool pool.ObjectPoolConfiguration `yaml:"entryPool"`
 }
@@ -174,6 +183,7 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 	opts = setMetricPrefix(opts, c.CounterPrefix, opts.SetCounterPrefix)
 	opts = setMetricPrefix(opts, c.TimerPrefix, opts.SetTimerPrefix)
 	opts = setMetricPrefix(opts, c.GaugePrefix, opts.SetGaugePrefix)
+	opts = setMetricPrefix(opts, c.SetPrefix, opts.SetSetPrefix)
 
 	// Set stream options.
 	scope := instrumentOpts.MetricsScope()
@@ -278,6 +288,15 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 	flushManager := aggregator.NewFlushManager(flushManagerOpts)
 	opts = opts.SetFlushManager(flushManager)
 
+	// Set checkpoint manager.
+	if c.Checkpoint != nil {
+		iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("checkpoint-manager"))
+		opts = opts.SetCheckpointManager(c.Checkpoint.NewCheckpointManager(iOpts))
+		if c.Checkpoint.RecoveryTimeout != 0 {
+			opts = opts.SetCheckpointRecoveryTimeout(c.Checkpoint.RecoveryTimeout)
+		}
+	}
+
 	// Set flushing handler.
 	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("flush-handler"))
 	flushHandler, err := c.Flush.NewHandler(client, iOpts)
@@ -348,6 +367,15 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 		return aggregator.MustNewGaugeElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, aggregator.NoPrefixNoSuffix, opts)
 	})
 
+	// Set set elem pool.
+	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("set-elem-pool"))
+	setElemPoolOpts := c.SetElemPool.NewObjectPoolOptions(iOpts)
+	setElemPool := aggregator.NewSetElemPool(setElemPoolOpts)
+	opts = opts.SetSetElemPool(setElemPool)
+	setElemPool.Init(func() *aggregator.SetElem {
+		return aggregator.MustNewSetElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, aggregator.NoPrefixNoSuffix, opts)
+	})
+
 	// Set entry pool.
 	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("entry-pool"))
 	entryPoolOpts := c.EntryPool.NewObjectPoolOptions(iOpts)
@@ -531,6 +559,47 @@ func (c flushTimesManagerConfiguration) NewFlushTimesManager(
 	return aggregator.NewFlushTimesManager(flushTimesManagerOpts), nil
 }
 
+type checkpointConfiguration struct {
+	// Directory checkpoints are written to.
+	Directory string `yaml:"directory" validate:"nonzero"`
+
+	// How often buffered checkpoints are persisted to disk.
+	CheckpointInterval time.Duration `yaml:"checkpointInterval"`
+
+	// How long each checkpoint segment file covers.
+	SegmentDuration time.Duration `yaml:"segmentDuration"`
+
+	// How long checkpoint segments are retained after they are closed.
+	RetentionPeriod time.Duration `yaml:"retentionPeriod"`
+
+	// Maximum number of bytes buffered per shard between checkpoints.
+	MaxBufferSize int `yaml:"maxBufferSize" validate:"min=0"`
+
+	// How long recovery waits for flush times before replaying checkpoints.
+	RecoveryTimeout time.Duration `yaml:"recoveryTimeout"`
+}
+
+func (c checkpointConfiguration) NewCheckpointManager(
+	instrumentOpts instrument.Options,
+) aggregator.CheckpointManager {
+	opts := aggregator.NewCheckpointManagerOptions().
+		SetInstrumentOptions(instrumentOpts).
+		SetDirectory(c.Directory)
+	if c.CheckpointInterval != 0 {
+		opts = opts.SetCheckpointInterval(c.CheckpointInterval)
+	}
+	if c.SegmentDuration != 0 {
+		opts = opts.SetSegmentDuration(c.SegmentDuration)
+	}
+	if c.RetentionPeriod != 0 {
+		opts = opts.SetRetentionPeriod(c.RetentionPeriod)
+	}
+	if c.MaxBufferSize != 0 {
+		opts = opts.SetMaxBufferSize(c.MaxBufferSize)
+	}
+	return aggregator.NewCheckpointManager(opts)
+}
+
 type electionManagerConfiguration struct {
 	Election                   electionConfiguration  `yaml:"election"`
 	ServiceID                  serviceIDConfiguration `yaml:"serviceID"`
@@ -48,6 +48,9 @@ var (
 	// GraphiteIDSchemeTagValue specifies that the graphite ID
 	// scheme should be used for a metric.
 	GraphiteIDSchemeTagValue = []byte("graphite")
+	// GraphiteTaggedIDSchemeTagValue specifies that the graphite tagged ID
+	// scheme should be used for a metric.
+	GraphiteTaggedIDSchemeTagValue = []byte("graphite_tagged")
 )
 
 var (
@@ -141,10 +144,15 @@ func (w *downsamplerFlushHandlerWriter) Write(
 			// NB(r): Quite gross, need to actually make it possible to plumb this
 			// through for each metric.
 			if bytes.Equal(name, MetricsOptionIDSchemeTagName) {
-				if bytes.Equal(value, GraphiteIDSchemeTagValue) &&
-					tags.Opts.IDSchemeType() != models.TypeGraphite {
+				scheme := tags.Opts.IDSchemeType()
+				if bytes.Equal(value, GraphiteIDSchemeTagValue) {
+					scheme = models.TypeGraphite
+				} else if bytes.Equal(value, GraphiteTaggedIDSchemeTagValue) {
+					scheme = models.TypeGraphiteTagged
+				}
+				if scheme != tags.Opts.IDSchemeType() {
 					iter.Reset(mp.ChunkedID.Data)
-					tags.Opts = w.tagOptions.SetIDSchemeType(models.TypeGraphite)
+					tags.Opts = w.tagOptions.SetIDSchemeType(scheme)
 					tags.Tags = tags.Tags[:0]
 				}
 				// Continue, whether we updated and need to restart iteration,
@@ -0,0 +1,302 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package downsample
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/metrics/matcher"
+	"github.com/m3db/m3/src/metrics/rules"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/pool"
+	"github.com/m3db/m3/src/x/serialize"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	errNewIngestRulesApplierFailFmt = "ingest rules applier failed to initialize: %v"
+)
+
+var (
+	errIngestRulesApplierUninitialized = errors.New("ingest rules applier is not yet initialized")
+
+	errNoIngestRulesKVStore               = errors.New("ingest rules enabled with rules store not set")
+	errNoIngestRulesClockOptions          = errors.New("ingest rules enabled with clock options not set")
+	errNoIngestRulesInstrumentOptions     = errors.New("ingest rules enabled with instrument options not set")
+	errNoIngestRulesTagEncoderOptions     = errors.New("ingest rules enabled with tag encoder options not set")
+	errNoIngestRulesTagDecoderOptions     = errors.New("ingest rules enabled with tag decoder options not set")
+	errNoIngestRulesTagEncoderPoolOptions = errors.New("ingest rules enabled with tag encoder pool options not set")
+	errNoIngestRulesTagDecoderPoolOptions = errors.New("ingest rules enabled with tag decoder pool options not set")
+)
+
+// IngestRulesApplier applies the ingest rules stored alongside the mapping
+// and rollup rules to metrics as they are written, before they are stored
+// unaggregated or downsampled.
+type IngestRulesApplier interface {
+	// Apply returns the tags the metric should be written with after applying
+	// the ingest rules currently in effect, or false if it should be dropped.
+	// The tags passed in are never modified.
+	Apply(tags models.Tags) (models.Tags, bool, error)
+}
+
+// IngestRulesConfiguration configures the ingest rules.
+type IngestRulesConfiguration struct {
+	// Enabled matches written metrics against the ingest rules in the
+	// rules KV store, ingest rules are ignored if not enabled.
+	Enabled bool `yaml:"enabled"`
+}
+
+// IngestRulesApplierOptions is a set of required ingest rules applier options.
+type IngestRulesApplierOptions struct {
+	RulesKVStore          kv.Store
+	NameTag               string
+	ClockOptions          clock.Options
+	InstrumentOptions     instrument.Options
+	TagEncoderOptions     serialize.TagEncoderOptions
+	TagDecoderOptions     serialize.TagDecoderOptions
+	TagEncoderPoolOptions pool.ObjectPoolOptions
+	TagDecoderPoolOptions pool.ObjectPoolOptions
+}
+
+func (o IngestRulesApplierOptions) validate() error {
+	if o.RulesKVStore == nil {
+		return errNoIngestRulesKVStore
+	}
+	if o.ClockOptions == nil {
+		return errNoIngestRulesClockOptions
+	}
+	if o.InstrumentOptions == nil {
+		return errNoIngestRulesInstrumentOptions
+	}
+	if o.TagEncoderOptions == nil {
+		return errNoIngestRulesTagEncoderOptions
+	}
+	if o.TagDecoderOptions == nil {
+		return errNoIngestRulesTagDecoderOptions
+	}
+	if o.TagEncoderPoolOptions == nil {
+		return errNoIngestRulesTagEncoderPoolOptions
+	}
+	if o.TagDecoderPoolOptions == nil {
+		return errNoIngestRulesTagDecoderPoolOptions
+	}
+	return nil
+}
+
+type ingestRulesMetrics struct {
+	dropped   tally.Counter
+	rewritten tally.Counter
+}
+
+func newIngestRulesMetrics(scope tally.Scope) ingestRulesMetrics {
+	return ingestRulesMetrics{
+		dropped:   scope.Counter("dropped"),
+		rewritten: scope.Counter("rewritten"),
+	}
+}
+
+type ingestRulesApplier struct {
+	matcher                matcher.Matcher
+	nowFn                  clock.NowFn
+	tagEncoderPool         serialize.TagEncoderPool
+	metricTagsIteratorPool serialize.MetricTagsIteratorPool
+	metrics                ingestRulesMetrics
+}
+
+// NewIngestRulesApplier returns a new ingest rules applier which matches
+// metrics against the rulesets in the rules KV store the same way the
+// downsampler does.
+func (cfg IngestRulesConfiguration) NewIngestRulesApplier(
+	opts IngestRulesApplierOptions,
+) (IngestRulesApplier, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	// The ingest rules applier shares the ID encoding and the rule matching
+	// of the downsampler so that filters match the same tags in both.
+	dOpts := DownsamplerOptions{
+		RulesKVStore:          opts.RulesKVStore,
+		NameTag:               opts.NameTag,
+		ClockOptions:          opts.ClockOptions,
+		InstrumentOptions:     opts.InstrumentOptions,
+		TagEncoderOptions:     opts.TagEncoderOptions,
+		TagDecoderOptions:     opts.TagDecoderOptions,
+		TagEncoderPoolOptions: opts.TagEncoderPoolOptions,
+		TagDecoderPoolOptions: opts.TagDecoderPoolOptions,
+	}
+	pools := dOpts.newAggregatorPools()
+	ruleSetOpts := dOpts.newAggregatorRulesOptions(pools)
+
+	iOpts := opts.InstrumentOptions
+	scope := iOpts.MetricsScope().SubScope("ingest-rules")
+	ruleMatcher, err := dOpts.newAggregatorMatcher(opts.ClockOptions,
+		iOpts.SetMetricsScope(scope), ruleSetOpts, opts.RulesKVStore)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ingestRulesApplier{
+		matcher:                ruleMatcher,
+		nowFn:                  opts.ClockOptions.NowFn(),
+		tagEncoderPool:         pools.tagEncoderPool,
+		metricTagsIteratorPool: pools.metricTagsIteratorPool,
+		metrics:                newIngestRulesMetrics(scope),
+	}, nil
+}
+
+func (a *ingestRulesApplier) Apply(tags models.Tags) (models.Tags, bool, error) {
+	sortedTags := newTags()
+	for _, tag := range tags.Tags {
+		sortedTags.append(tag.Name, tag.Value)
+	}
+	sort.Sort(sortedTags)
+
+	tagEncoder := a.tagEncoderPool.Get()
+	defer tagEncoder.Finalize()
+
+	if err := tagEncoder.Encode(sortedTags); err != nil {
+		return models.Tags{}, false, err
+	}
+	data, ok := tagEncoder.Data()
+	if !ok {
+		return models.Tags{}, false, fmt.Errorf("unable to encode tags: names=%v, values=%v",
+			sortedTags.names, sortedTags.values)
+	}
+
+	id := a.metricTagsIteratorPool.Get()
+	id.Reset(data.Bytes())
+	nowNanos := a.nowFn().UnixNano()
+	matchResult := a.matcher.ForwardMatch(id, nowNanos, nowNanos+1)
+	id.Close()
+
+	result := matchResult.IngestAt(nowNanos)
+	if result.Drop {
+		a.metrics.dropped.Inc(1)
+		return models.Tags{}, false, nil
+	}
+	if result.IsEmpty() {
+		return tags, true, nil
+	}
+
+	a.metrics.rewritten.Inc(1)
+	return applyIngestResult(tags, result), true, nil
+}
+
+// applyIngestResult returns a copy of the tags with the tags dropped by the
+// ingest result removed, then the renamed tags renamed and finally the static
+// tags added. Renamed and added tags replace any existing tag of the same name.
+func applyIngestResult(tags models.Tags, result rules.IngestResult) models.Tags {
+	var (
+		res     = models.NewTags(tags.Len()+len(result.AddTags), tags.Opts)
+		renamed []models.Tag
+	)
+	for _, tag := range tags.Tags {
+		if containsTagName(result.DropTags, tag.Name) {
+			continue
+		}
+
+		name := tag.Name
+		for _, rename := range result.RenameTags {
+			if bytes.Equal(name, rename.From) {
+				name = rename.To
+			}
+		}
+		if !bytes.Equal(name, tag.Name) {
+			renamed = append(renamed, models.Tag{Name: name, Value: tag.Value})
+			continue
+		}
+
+		res = res.AddTagWithoutNormalizing(tag)
+	}
+
+	for _, tag := range renamed {
+		res = res.AddOrUpdateTag(tag)
+	}
+	for _, tag := range result.AddTags {
+		res = res.AddOrUpdateTag(models.Tag{Name: tag.Name, Value: tag.Value})
+	}
+
+	return res.Normalize()
+}
+
+func containsTagName(names [][]byte, name []byte) bool {
+	for _, n := range names {
+		if bytes.Equal(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// asyncIngestRulesApplier is an ingest rules applier that can be lazily
+// initialized, it will return errors on calls to its methods until it is
+// initialized, the same as the asynchronous downsampler.
+type asyncIngestRulesApplier struct {
+	sync.RWMutex
+	applier IngestRulesApplier
+	err     error
+}
+
+// NewIngestRulesApplierFn creates an ingest rules applier.
+type NewIngestRulesApplierFn func() (IngestRulesApplier, error)
+
+// NewAsyncIngestRulesApplier is an ingest rules applier that is lazily initialized.
+func NewAsyncIngestRulesApplier(
+	fn NewIngestRulesApplierFn,
+) IngestRulesApplier {
+	asyncApplier := &asyncIngestRulesApplier{
+		err: errIngestRulesApplierUninitialized,
+	}
+
+	go func() {
+		applier, err := fn()
+
+		asyncApplier.Lock()
+		defer asyncApplier.Unlock()
+		if err != nil {
+			asyncApplier.err = fmt.Errorf(errNewIngestRulesApplierFailFmt, err)
+			return
+		}
+
+		asyncApplier.applier = applier
+		asyncApplier.err = nil
+	}()
+
+	return asyncApplier
+}
+
+func (a *asyncIngestRulesApplier) Apply(tags models.Tags) (models.Tags, bool, error) {
+	a.RLock()
+	defer a.RUnlock()
+	if a.err != nil {
+		return models.Tags{}, false, a.err
+	}
+	return a.applier.Apply(tags)
+}
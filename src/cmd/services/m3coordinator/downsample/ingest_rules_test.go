@@ -0,0 +1,163 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package downsample
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/metrics/generated/proto/rulepb"
+	"github.com/m3db/m3/src/metrics/matcher"
+	"github.com/m3db/m3/src/metrics/metric/id"
+	"github.com/m3db/m3/src/metrics/rules"
+	ruleskv "github.com/m3db/m3/src/metrics/rules/store/kv"
+	"github.com/m3db/m3/src/metrics/rules/view"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/pool"
+	"github.com/m3db/m3/src/x/serialize"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIngestRulesApplierWithRulesStore(t *testing.T) {
+	rulesKVStore := mem.NewStore()
+	matcherOpts := matcher.NewOptions()
+	_, err := rulesKVStore.Set(matcherOpts.NamespacesKey(), &rulepb.Namespaces{})
+	require.NoError(t, err)
+
+	rulesetKeyFmt := matcherOpts.RuleSetKeyFn()([]byte("%s"))
+	rulesStoreOpts := ruleskv.NewStoreOptions(matcherOpts.NamespacesKey(),
+		rulesetKeyFmt, nil)
+	rulesStore := ruleskv.NewStore(rulesKVStore, rulesStoreOpts)
+
+	var cfg IngestRulesConfiguration
+	applier, err := cfg.NewIngestRulesApplier(IngestRulesApplierOptions{
+		RulesKVStore:          rulesKVStore,
+		ClockOptions:          clock.NewOptions(),
+		InstrumentOptions:     instrument.NewOptions(),
+		TagEncoderOptions:     serialize.NewTagEncoderOptions(),
+		TagDecoderOptions:     serialize.NewTagDecoderOptions(),
+		TagEncoderPoolOptions: pool.NewObjectPoolOptions(),
+		TagDecoderPoolOptions: pool.NewObjectPoolOptions(),
+	})
+	require.NoError(t, err)
+
+	rewriteTags := models.NewTags(4, nil).AddTags([]models.Tag{
+		{Name: []byte("__name__"), Value: []byte("foo")},
+		{Name: []byte("app"), Value: []byte("test123")},
+		{Name: []byte("host"), Value: []byte("host1")},
+		{Name: []byte("pod_uid"), Value: []byte("5d6a3f2e")},
+	})
+	dropTags := models.NewTags(2, nil).AddTags([]models.Tag{
+		{Name: []byte("__name__"), Value: []byte("foo")},
+		{Name: []byte("app"), Value: []byte("drop123")},
+	})
+
+	// Without rules all series are written as is.
+	tags, ok, err := applier.Apply(rewriteTags)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, rewriteTags, tags)
+
+	// Create rules
+	nss, err := rulesStore.ReadNamespaces()
+	require.NoError(t, err)
+	_, err = nss.AddNamespace("default", testUpdateMetadata())
+	require.NoError(t, err)
+
+	rs := rules.NewEmptyRuleSet("default", testUpdateMetadata())
+	_, err = rs.AddIngestRule(view.IngestRule{
+		Name:       "rewrite",
+		Filter:     "app:test*",
+		DropTags:   []string{"pod_uid"},
+		RenameTags: []view.IngestTagRename{{From: "host", To: "instance"}},
+		AddTags:    []view.IngestTag{{Name: "env", Value: "prod"}},
+	}, testUpdateMetadata())
+	require.NoError(t, err)
+	_, err = rs.AddIngestRule(view.IngestRule{
+		Name:   "drop",
+		Filter: "app:drop*",
+		Drop:   true,
+	}, testUpdateMetadata())
+	require.NoError(t, err)
+
+	err = rulesStore.WriteAll(nss, rs)
+	require.NoError(t, err)
+
+	// Wait for the ingest rules to propagate.
+	for {
+		_, ok, err := applier.Apply(dropTags)
+		require.NoError(t, err)
+		if !ok {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	tags, ok, err = applier.Apply(rewriteTags)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	expected := models.NewTags(4, nil).AddTags([]models.Tag{
+		{Name: []byte("__name__"), Value: []byte("foo")},
+		{Name: []byte("app"), Value: []byte("test123")},
+		{Name: []byte("env"), Value: []byte("prod")},
+		{Name: []byte("instance"), Value: []byte("host1")},
+	})
+	require.Equal(t, expected.Tags, tags.Tags)
+}
+
+func TestApplyIngestResult(t *testing.T) {
+	tags := models.NewTags(4, nil).AddTags([]models.Tag{
+		{Name: []byte("a"), Value: []byte("1")},
+		{Name: []byte("b"), Value: []byte("2")},
+		{Name: []byte("c"), Value: []byte("3")},
+		{Name: []byte("d"), Value: []byte("4")},
+	})
+
+	result := rules.IngestResult{
+		DropTags: [][]byte{[]byte("a")},
+		RenameTags: []rules.IngestTagRename{
+			{From: []byte("b"), To: []byte("e")},
+			{From: []byte("e"), To: []byte("f")},
+			{From: []byte("c"), To: []byte("d")},
+		},
+		AddTags: []id.TagPair{
+			{Name: []byte("a"), Value: []byte("5")},
+			{Name: []byte("g"), Value: []byte("6")},
+		},
+	}
+
+	expected := []models.Tag{
+		{Name: []byte("a"), Value: []byte("5")},
+		{Name: []byte("d"), Value: []byte("3")},
+		{Name: []byte("f"), Value: []byte("2")},
+		{Name: []byte("g"), Value: []byte("6")},
+	}
+	require.Equal(t, expected, applyIngestResult(tags, result).Tags)
+
+	// The tags passed in are not modified.
+	require.Equal(t, 4, tags.Len())
+	require.Equal(t, []byte("a"), tags.Tags[0].Name)
+}
@@ -201,6 +201,9 @@ type Configuration struct {
 
 	// Pool of gauge elements.
 	GaugeElemPool pool.ObjectPoolConfiguration `yaml:"gaugeElemPool"`
+
+	// SetElemPool is the set elem pool.
+	SetElemPool pool.ObjectPoolConfiguration `yaml:"setElemPool"`
 }
 
 // RemoteAggregatorConfiguration specifies a remote aggregator
@@ -339,6 +342,7 @@ func (cfg Configuration) newAggregator(o DownsamplerOptions) (agg, error) {
 		SetMetricPrefix(nil).
 		SetCounterPrefix(nil).
 		SetGaugePrefix(nil).
+		SetSetPrefix(nil).
 		SetTimerPrefix(nil).
 		SetAdminClient(adminAggClient).
 		SetPlacementManager(placementManager).
@@ -411,6 +415,24 @@ func (cfg Configuration) newAggregator(o DownsamplerOptions) (agg, error) {
 		)
 	})
 
+	// Set set elem pool.
+	setElemPoolOpts := cfg.SetElemPool.NewObjectPoolOptions(
+		instrumentOpts.SetMetricsScope(scope.SubScope("set-elem-pool")),
+	)
+	setElemPool := aggregator.NewSetElemPool(setElemPoolOpts)
+	aggregatorOpts = aggregatorOpts.SetSetElemPool(setElemPool)
+	setElemPool.Init(func() *aggregator.SetElem {
+		return aggregator.MustNewSetElem(
+			nil,
+			policy.EmptyStoragePolicy,
+			aggregation.DefaultTypes,
+			applied.DefaultPipeline,
+			0,
+			aggregator.WithPrefixWithSuffix,
+			aggregatorOpts,
+		)
+	})
+
 	aggregatorInstance := aggregator.NewAggregator(aggregatorOpts)
 	if err := aggregatorInstance.Open(); err != nil {
 		return agg{}, err
@@ -107,6 +107,8 @@ func NewIngester(
 		return nil, err
 	}
 
+	taggedTagOpts := tagOpts.SetIDSchemeType(models.TypeGraphiteTagged)
+
 	compiledRules, err := compileRules(rules)
 	if err != nil {
 		return nil, err
@@ -132,6 +134,7 @@ func NewIngester(
 		opts:                 opts,
 		logger:               opts.InstrumentOptions.Logger(),
 		tagOpts:              tagOpts,
+		taggedTagOpts:        taggedTagOpts,
 		metrics: newCarbonIngesterMetrics(
 			opts.InstrumentOptions.MetricsScope()),
 
@@ -147,6 +150,7 @@ type ingester struct {
 	logger               *zap.Logger
 	metrics              carbonIngesterMetrics
 	tagOpts              models.TagOptions
+	taggedTagOpts        models.TagOptions
 
 	rules []ruleAndRegex
 
@@ -245,7 +249,12 @@ func (i *ingester) write(
 	}
 
 	resources.datapoints[0] = ts.Datapoint{Timestamp: timestamp, Value: value}
-	tags, err := GenerateTagsFromNameIntoSlice(resources.name, i.tagOpts, resources.tags)
+	tagOpts := i.tagOpts
+	if carbon.IsTaggedName(resources.name) {
+		tagOpts = i.taggedTagOpts
+	}
+
+	tags, err := GenerateTagsFromNameIntoSlice(resources.name, tagOpts, resources.tags)
 	if err != nil {
 		i.logger.Error("err generating tags from carbon",
 			zap.String("name", string(resources.name)), zap.Error(err))
@@ -295,6 +304,16 @@ type carbonIngesterMetrics struct {
 //      __g0__:foo
 //      __g1__:bar
 //      __g2__:baz
+// The tags of names in the graphite tagged series format are kept as tags
+// following the path tags, such that an input like:
+//      foo.bar;dc=dc1;server=web01
+// becomes
+//      __g0__:foo
+//      __g1__:bar
+//      dc:dc1
+//      server:web01
+// The tags of tagged names always use the graphite tagged ID scheme so that
+// their IDs include the tags.
 func GenerateTagsFromName(
 	name []byte,
 	opts models.TagOptions,
@@ -321,7 +340,23 @@ func generateTagsFromName(
 		return models.EmptyTags(), errCannotGenerateTagsFromEmptyName
 	}
 
-	numTags := bytes.Count(name, carbonSeparatorBytes) + 1
+	var (
+		fullName   = name
+		taggedTags []carbon.Tag
+	)
+	if carbon.IsTaggedName(name) {
+		var err error
+		name, taggedTags, err = carbon.ParseTaggedName(name, nil)
+		if err != nil {
+			return models.EmptyTags(), err
+		}
+
+		if opts.IDSchemeType() != models.TypeGraphiteTagged {
+			opts = opts.SetIDSchemeType(models.TypeGraphiteTagged)
+		}
+	}
+
+	numTags := bytes.Count(name, carbonSeparatorBytes) + 1 + len(taggedTags)
 
 	if cap(tags) >= numTags {
 		tags = tags[:0]
@@ -335,7 +370,7 @@ func generateTagsFromName(
 		if charByte == carbonSeparatorByte {
 			if i+1 < len(name) && name[i+1] == carbonSeparatorByte {
 				return models.EmptyTags(),
-					fmt.Errorf("carbon metric: %s has duplicate separator", string(name))
+					fmt.Errorf("carbon metric: %s has duplicate separator", string(fullName))
 			}
 
 			tags = append(tags, models.Tag{
@@ -363,6 +398,12 @@ func generateTagsFromName(
 		})
 	}
 
+	// NB: the tags of a tagged name are already sorted by name, and ordered
+	// after the path tags as graphite tags are.
+	for _, tag := range taggedTags {
+		tags = append(tags, models.Tag{Name: tag.Name, Value: tag.Value})
+	}
+
 	return models.Tags{Opts: opts, Tags: tags}, nil
 }
 
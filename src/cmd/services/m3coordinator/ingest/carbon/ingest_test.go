@@ -30,6 +30,7 @@ import (
 	"net"
 	"reflect"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -332,6 +333,36 @@ func TestGenerateTagsFromName(t *testing.T) {
 				{Name: graphite.TagName(2), Value: []byte("baz")},
 			},
 		},
+		{
+			name: "foo.bar;dc=dc1;server=web01",
+			id:   "foo.bar;dc=dc1;server=web01",
+			expectedTags: []models.Tag{
+				{Name: graphite.TagName(0), Value: []byte("foo")},
+				{Name: graphite.TagName(1), Value: []byte("bar")},
+				{Name: []byte("dc"), Value: []byte("dc1")},
+				{Name: []byte("server"), Value: []byte("web01")},
+			},
+		},
+		{
+			name: "foo.bar;server=web01;dc=dc1",
+			id:   "foo.bar;dc=dc1;server=web01",
+			expectedTags: []models.Tag{
+				{Name: graphite.TagName(0), Value: []byte("foo")},
+				{Name: graphite.TagName(1), Value: []byte("bar")},
+				{Name: []byte("dc"), Value: []byte("dc1")},
+				{Name: []byte("server"), Value: []byte("web01")},
+			},
+		},
+		{
+			name:         "foo..bar;dc=dc1",
+			expectedErr:  fmt.Errorf("carbon metric: foo..bar;dc=dc1 has duplicate separator"),
+			expectedTags: []models.Tag{},
+		},
+		{
+			name:         "foo.bar;dc",
+			expectedErr:  fmt.Errorf("invalid tag dc in foo.bar;dc"),
+			expectedTags: []models.Tag{},
+		},
 		{
 			name:         "foo..bar..baz..",
 			expectedErr:  fmt.Errorf("carbon metric: foo..bar..baz.. has duplicate separator"),
@@ -352,6 +383,12 @@ func TestGenerateTagsFromName(t *testing.T) {
 		} else {
 			require.NoError(t, err)
 			assert.Equal(t, []byte(tc.id), tags.ID())
+
+			expectedScheme := models.TypeGraphite
+			if strings.Contains(tc.name, ";") {
+				expectedScheme = models.TypeGraphiteTagged
+			}
+			assert.Equal(t, expectedScheme, tags.Opts.IDSchemeType())
 		}
 		require.Equal(t, tc.expectedTags, tags.Tags)
 	}
@@ -0,0 +1,124 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingest
+
+import (
+	"context"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/downsample"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type ingestRulesDownsamplerAndWriter struct {
+	DownsamplerAndWriter
+
+	applier downsample.IngestRulesApplier
+}
+
+// NewIngestRulesDownsamplerAndWriter returns a downsampler and writer which
+// applies the ingest rules to each series before it is written, series that
+// the rules drop are neither stored unaggregated nor downsampled.
+func NewIngestRulesDownsamplerAndWriter(
+	downsamplerAndWriter DownsamplerAndWriter,
+	applier downsample.IngestRulesApplier,
+) DownsamplerAndWriter {
+	return &ingestRulesDownsamplerAndWriter{
+		DownsamplerAndWriter: downsamplerAndWriter,
+		applier:              applier,
+	}
+}
+
+func (d *ingestRulesDownsamplerAndWriter) Write(
+	ctx context.Context,
+	tags models.Tags,
+	datapoints ts.Datapoints,
+	unit xtime.Unit,
+	overrides WriteOptions,
+) error {
+	tags, ok, err := d.applier.Apply(tags)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	return d.DownsamplerAndWriter.Write(ctx, tags, datapoints, unit, overrides)
+}
+
+func (d *ingestRulesDownsamplerAndWriter) WriteBatch(
+	ctx context.Context,
+	iter DownsampleAndWriteIter,
+) error {
+	return d.DownsamplerAndWriter.WriteBatch(ctx, &ingestRulesIter{
+		iter:    iter,
+		applier: d.applier,
+	})
+}
+
+// ingestRulesIter skips the series dropped by the ingest rules and returns
+// the rewritten tags of the remaining series.
+type ingestRulesIter struct {
+	iter    DownsampleAndWriteIter
+	applier downsample.IngestRulesApplier
+	tags    models.Tags
+	err     error
+}
+
+func (i *ingestRulesIter) Next() bool {
+	if i.err != nil {
+		return false
+	}
+
+	for i.iter.Next() {
+		tags, _, _ := i.iter.Current()
+		tags, ok, err := i.applier.Apply(tags)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		if ok {
+			i.tags = tags
+			return true
+		}
+	}
+
+	return false
+}
+
+func (i *ingestRulesIter) Current() (models.Tags, ts.Datapoints, xtime.Unit) {
+	_, datapoints, unit := i.iter.Current()
+	return i.tags, datapoints, unit
+}
+
+func (i *ingestRulesIter) Reset() error {
+	i.tags = models.Tags{}
+	i.err = nil
+	return i.iter.Reset()
+}
+
+func (i *ingestRulesIter) Error() error {
+	if i.err != nil {
+		return i.err
+	}
+	return i.iter.Error()
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// testIngestRulesApplier drops testTags1 and adds a tag to all other series.
+type testIngestRulesApplier struct {
+	err error
+}
+
+func (a testIngestRulesApplier) Apply(tags models.Tags) (models.Tags, bool, error) {
+	if a.err != nil {
+		return models.Tags{}, false, a.err
+	}
+	if bytes.Equal(tags.ID(), testTags1.ID()) {
+		return models.Tags{}, false, nil
+	}
+	return tags.Clone().AddTag(models.Tag{
+		Name:  []byte("added"),
+		Value: []byte("value"),
+	}), true, nil
+}
+
+func TestIngestRulesDownsamplerAndWriterWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownAndWrite := NewMockDownsamplerAndWriter(ctrl)
+	downAndWrite := NewIngestRulesDownsamplerAndWriter(mockDownAndWrite,
+		testIngestRulesApplier{})
+
+	expectedTags := testTags2.Clone().AddTag(models.Tag{
+		Name:  []byte("added"),
+		Value: []byte("value"),
+	})
+	mockDownAndWrite.EXPECT().
+		Write(gomock.Any(), expectedTags, gomock.Any(), xtime.Second, defaultOverride).
+		Return(nil)
+
+	ctx := context.Background()
+	err := downAndWrite.Write(ctx, testTags1, testDatapoints1, xtime.Second, defaultOverride)
+	require.NoError(t, err)
+	err = downAndWrite.Write(ctx, testTags2, testDatapoints2, xtime.Second, defaultOverride)
+	require.NoError(t, err)
+}
+
+func TestIngestRulesDownsamplerAndWriterWriteError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	applyErr := errors.New("apply error")
+	downAndWrite := NewIngestRulesDownsamplerAndWriter(NewMockDownsamplerAndWriter(ctrl),
+		testIngestRulesApplier{err: applyErr})
+
+	err := downAndWrite.Write(context.Background(), testTags1, testDatapoints1,
+		xtime.Second, defaultOverride)
+	require.Equal(t, applyErr, err)
+}
+
+func TestIngestRulesDownsamplerAndWriterWriteBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownAndWrite := NewMockDownsamplerAndWriter(ctrl)
+	downAndWrite := NewIngestRulesDownsamplerAndWriter(mockDownAndWrite,
+		testIngestRulesApplier{})
+
+	expectedTags := testTags2.Clone().AddTag(models.Tag{
+		Name:  []byte("added"),
+		Value: []byte("value"),
+	})
+	mockDownAndWrite.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, iter DownsampleAndWriteIter) error {
+			// Iterate twice the same as the downsampler and writer does.
+			for i := 0; i < 2; i++ {
+				require.True(t, iter.Next())
+				tags, datapoints, unit := iter.Current()
+				require.Equal(t, expectedTags, tags)
+				require.Equal(t, testDatapoints2, []ts.Datapoint(datapoints))
+				require.Equal(t, xtime.Second, unit)
+				require.False(t, iter.Next())
+				require.NoError(t, iter.Error())
+				require.NoError(t, iter.Reset())
+			}
+			return nil
+		})
+
+	err := downAndWrite.WriteBatch(context.Background(), newTestIter(testEntries))
+	require.NoError(t, err)
+}
+
+func TestIngestRulesDownsamplerAndWriterWriteBatchError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	applyErr := errors.New("apply error")
+	mockDownAndWrite := NewMockDownsamplerAndWriter(ctrl)
+	downAndWrite := NewIngestRulesDownsamplerAndWriter(mockDownAndWrite,
+		testIngestRulesApplier{err: applyErr})
+
+	mockDownAndWrite.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, iter DownsampleAndWriteIter) error {
+			require.False(t, iter.Next())
+			return iter.Error()
+		})
+
+	err := downAndWrite.WriteBatch(context.Background(), newTestIter(testEntries))
+	require.Equal(t, applyErr, err)
+}
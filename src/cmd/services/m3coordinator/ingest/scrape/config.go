@@ -0,0 +1,270 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestscrape
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	defaultScrapeInterval        = 15 * time.Second
+	defaultScrapeTimeout         = 10 * time.Second
+	defaultMetricsPath           = "/metrics"
+	defaultScheme                = "http"
+	defaultFileSDRefreshInterval = 5 * time.Minute
+	defaultMaxBodySize           = 10 * 1024 * 1024 // 10MB.
+	defaultClientTimeout         = time.Minute
+	defaultDialTimeout           = 10 * time.Second
+	defaultIdleConnTimeout       = 90 * time.Second
+	defaultMaxIdleConnsPerHost   = 2
+	defaultTLSHandshakeTimeout   = 10 * time.Second
+	defaultKeepAlive             = 30 * time.Second
+)
+
+var (
+	errNoJobs          = errors.New("scrape configuration: no jobs configured")
+	errJobNameRequired = errors.New("scrape configuration: job name required")
+	errMaxBodySize     = errors.New("scrape configuration: max body size must be positive")
+	errClientTimeout   = errors.New("scrape configuration: client timeouts must be positive")
+)
+
+// Configuration is the configuration for scraping Prometheus exposition
+// format endpoints and writing the results through the coordinator.
+type Configuration struct {
+	// Interval is the default interval to scrape targets at.
+	Interval *time.Duration `yaml:"interval"`
+
+	// Timeout is the default timeout for a single scrape.
+	Timeout *time.Duration `yaml:"timeout"`
+
+	// MaxBodySize is the maximum size in bytes of a scrape response body,
+	// scrapes with larger bodies fail.
+	MaxBodySize *int `yaml:"maxBodySize"`
+
+	// Client is the configuration for the HTTP client used to scrape targets.
+	Client HTTPClientConfiguration `yaml:"client"`
+
+	// Jobs is the list of scrape jobs.
+	Jobs []JobConfiguration `yaml:"jobs"`
+}
+
+// HTTPClientConfiguration is the configuration for the HTTP client used to
+// scrape targets.
+type HTTPClientConfiguration struct {
+	// Timeout is the overall timeout for a scrape request, each scrape is
+	// also bounded by its job timeout.
+	Timeout *time.Duration `yaml:"timeout"`
+
+	// DialTimeout is the timeout for establishing a connection to a target.
+	DialTimeout *time.Duration `yaml:"dialTimeout"`
+
+	// IdleConnTimeout is how long idle connections to targets are kept open.
+	IdleConnTimeout *time.Duration `yaml:"idleConnTimeout"`
+
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept
+	// open to each target.
+	MaxIdleConnsPerHost *int `yaml:"maxIdleConnsPerHost"`
+}
+
+// NewHTTPClient creates a new HTTP client from the configuration.
+func (c HTTPClientConfiguration) NewHTTPClient() (*http.Client, error) {
+	var (
+		timeout             = defaultClientTimeout
+		dialTimeout         = defaultDialTimeout
+		idleConnTimeout     = defaultIdleConnTimeout
+		maxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	)
+	if c.Timeout != nil {
+		timeout = *c.Timeout
+	}
+	if c.DialTimeout != nil {
+		dialTimeout = *c.DialTimeout
+	}
+	if c.IdleConnTimeout != nil {
+		idleConnTimeout = *c.IdleConnTimeout
+	}
+	if c.MaxIdleConnsPerHost != nil {
+		maxIdleConnsPerHost = *c.MaxIdleConnsPerHost
+	}
+	if timeout <= 0 || dialTimeout <= 0 || idleConnTimeout <= 0 {
+		return nil, errClientTimeout
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   dialTimeout,
+				KeepAlive: defaultKeepAlive,
+			}).DialContext,
+			TLSHandshakeTimeout: defaultTLSHandshakeTimeout,
+			IdleConnTimeout:     idleConnTimeout,
+			MaxIdleConnsPerHost: maxIdleConnsPerHost,
+		},
+	}, nil
+}
+
+// JobConfiguration is the configuration for a single scrape job.
+type JobConfiguration struct {
+	// Name is the job name, which is attached to scraped series as the
+	// "job" tag.
+	Name string `yaml:"name" validate:"nonzero"`
+
+	// MetricsPath is the HTTP path to scrape on each target.
+	MetricsPath string `yaml:"metricsPath"`
+
+	// Scheme is the scheme to scrape targets with, either http or https.
+	Scheme string `yaml:"scheme"`
+
+	// Interval overrides the default scrape interval for this job.
+	Interval *time.Duration `yaml:"interval"`
+
+	// Timeout overrides the default scrape timeout for this job.
+	Timeout *time.Duration `yaml:"timeout"`
+
+	// StaticTargets is a static list of target groups.
+	StaticTargets []TargetGroupConfiguration `yaml:"staticTargets"`
+
+	// FileSD is a list of file based service discovery configurations.
+	FileSD []FileSDConfiguration `yaml:"fileSD"`
+}
+
+// TargetGroupConfiguration is a group of targets sharing a set of labels, the
+// same shape is used for file based service discovery files.
+type TargetGroupConfiguration struct {
+	Targets []string          `yaml:"targets" json:"targets"`
+	Labels  map[string]string `yaml:"labels" json:"labels"`
+}
+
+// FileSDConfiguration is the configuration for file based service discovery,
+// files are read as JSON or YAML depending on their extension.
+type FileSDConfiguration struct {
+	Files           []string       `yaml:"files" validate:"nonzero"`
+	RefreshInterval *time.Duration `yaml:"refreshInterval"`
+}
+
+// NewScraper creates a new scraper from the configuration.
+func (c Configuration) NewScraper(
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+	tagOptions models.TagOptions,
+	instrumentOpts instrument.Options,
+) (*Scraper, error) {
+	if len(c.Jobs) == 0 {
+		return nil, errNoJobs
+	}
+
+	interval := defaultScrapeInterval
+	if c.Interval != nil {
+		interval = *c.Interval
+	}
+	timeout := defaultScrapeTimeout
+	if c.Timeout != nil {
+		timeout = *c.Timeout
+	}
+	maxBodySize := defaultMaxBodySize
+	if c.MaxBodySize != nil {
+		maxBodySize = *c.MaxBodySize
+	}
+	if maxBodySize <= 0 {
+		return nil, errMaxBodySize
+	}
+
+	client, err := c.Client.NewHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]job, 0, len(c.Jobs))
+	for _, jc := range c.Jobs {
+		j, err := jc.newJob(interval, timeout)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+
+	return newScraper(jobs, downsamplerAndWriter, client, int64(maxBodySize),
+		tagOptions, instrumentOpts), nil
+}
+
+func (c JobConfiguration) newJob(
+	defaultInterval time.Duration,
+	defaultTimeout time.Duration,
+) (job, error) {
+	if c.Name == "" {
+		return job{}, errJobNameRequired
+	}
+
+	j := job{
+		name:        c.Name,
+		metricsPath: defaultMetricsPath,
+		scheme:      defaultScheme,
+		interval:    defaultInterval,
+		timeout:     defaultTimeout,
+	}
+	if c.MetricsPath != "" {
+		j.metricsPath = c.MetricsPath
+	}
+	if c.Scheme != "" {
+		j.scheme = c.Scheme
+	}
+	if j.scheme != "http" && j.scheme != "https" {
+		return job{}, fmt.Errorf("scrape job %s: invalid scheme %q", c.Name, j.scheme)
+	}
+	if c.Interval != nil {
+		j.interval = *c.Interval
+	}
+	if c.Timeout != nil {
+		j.timeout = *c.Timeout
+	}
+	if j.interval <= 0 {
+		return job{}, fmt.Errorf("scrape job %s: interval must be positive", c.Name)
+	}
+	if j.timeout <= 0 || j.timeout > j.interval {
+		return job{}, fmt.Errorf(
+			"scrape job %s: timeout must be positive and no greater than interval", c.Name)
+	}
+
+	for _, g := range c.StaticTargets {
+		j.discoverers = append(j.discoverers, newStaticDiscoverer(g))
+	}
+	for _, sd := range c.FileSD {
+		refresh := defaultFileSDRefreshInterval
+		if sd.RefreshInterval != nil {
+			refresh = *sd.RefreshInterval
+		}
+		j.discoverers = append(j.discoverers, newFileDiscoverer(sd.Files, refresh))
+	}
+	if len(j.discoverers) == 0 {
+		return job{}, fmt.Errorf("scrape job %s: no static targets or file SD configured", c.Name)
+	}
+
+	return j, nil
+}
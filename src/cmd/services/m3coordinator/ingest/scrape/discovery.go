@@ -0,0 +1,125 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestscrape
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// discoverer discovers the target groups for a scrape job.
+type discoverer interface {
+	// TargetGroups returns the current target groups.
+	TargetGroups(now time.Time) ([]TargetGroupConfiguration, error)
+}
+
+type staticDiscoverer struct {
+	groups []TargetGroupConfiguration
+}
+
+func newStaticDiscoverer(group TargetGroupConfiguration) discoverer {
+	return &staticDiscoverer{groups: []TargetGroupConfiguration{group}}
+}
+
+func (d *staticDiscoverer) TargetGroups(_ time.Time) ([]TargetGroupConfiguration, error) {
+	return d.groups, nil
+}
+
+type readFileFn func(filename string) ([]byte, error)
+
+type fileDiscoverer struct {
+	sync.Mutex
+
+	files           []string
+	refreshInterval time.Duration
+	readFileFn      readFileFn
+
+	lastRefresh time.Time
+	groups      []TargetGroupConfiguration
+}
+
+func newFileDiscoverer(files []string, refreshInterval time.Duration) discoverer {
+	return &fileDiscoverer{
+		files:           files,
+		refreshInterval: refreshInterval,
+		readFileFn:      ioutil.ReadFile,
+	}
+}
+
+// TargetGroups returns the target groups read from the configured files,
+// files are only re-read once the refresh interval has elapsed. If reading
+// fails the previously discovered target groups are returned alongside the
+// error so a transient failure does not drop all targets.
+func (d *fileDiscoverer) TargetGroups(now time.Time) ([]TargetGroupConfiguration, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	if !d.lastRefresh.IsZero() && now.Sub(d.lastRefresh) < d.refreshInterval {
+		return d.groups, nil
+	}
+	d.lastRefresh = now
+
+	var groups []TargetGroupConfiguration
+	for _, pattern := range d.files {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return d.groups, err
+		}
+		for _, file := range matches {
+			fileGroups, err := d.readFile(file)
+			if err != nil {
+				return d.groups, err
+			}
+			groups = append(groups, fileGroups...)
+		}
+	}
+
+	d.groups = groups
+	return d.groups, nil
+}
+
+func (d *fileDiscoverer) readFile(file string) ([]TargetGroupConfiguration, error) {
+	data, err := d.readFileFn(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []TargetGroupConfiguration
+	switch ext := strings.ToLower(filepath.Ext(file)); ext {
+	case ".json":
+		err = json.Unmarshal(data, &groups)
+	case ".yml", ".yaml":
+		err = yaml.Unmarshal(data, &groups)
+	default:
+		return nil, fmt.Errorf("file SD %s: unsupported file extension %q", file, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("file SD %s: %v", file, err)
+	}
+	return groups, nil
+}
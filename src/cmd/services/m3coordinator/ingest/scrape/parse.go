@@ -0,0 +1,257 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestscrape
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// format is an exposition format that can be parsed.
+type format int
+
+const (
+	// formatText is the Prometheus text exposition format 0.0.4.
+	formatText format = iota
+	// formatOpenMetrics is the OpenMetrics text exposition format.
+	formatOpenMetrics
+)
+
+var (
+	openMetricsEOF       = []byte("# EOF")
+	openMetricsExemplar  = []byte(" # ")
+	openMetricsMediaType = "application/openmetrics-text"
+)
+
+type label struct {
+	name  []byte
+	value []byte
+}
+
+// sample is a single parsed sample, the name and label byte slices reference
+// the parsed buffer and must be copied if held onto after the buffer is reused.
+type sample struct {
+	name         []byte
+	labels       []label
+	value        float64
+	hasTimestamp bool
+	timestamp    time.Time
+}
+
+// parseExposition parses the samples contained in an exposition body, HELP,
+// TYPE and UNIT metadata is skipped since M3 does not store metric metadata.
+func parseExposition(data []byte, f format) ([]sample, error) {
+	var (
+		samples []sample
+		lineNum int
+	)
+	for len(data) > 0 {
+		var line []byte
+		if idx := bytes.IndexByte(data, '\n'); idx >= 0 {
+			line, data = data[:idx], data[idx+1:]
+		} else {
+			line, data = data, nil
+		}
+		lineNum++
+
+		line = bytes.TrimRight(line, "\r")
+		if f == formatOpenMetrics && bytes.Equal(line, openMetricsEOF) {
+			return samples, nil
+		}
+		line = bytes.TrimLeft(line, " \t")
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		s, err := parseSampleLine(line, f)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNum, err)
+		}
+		samples = append(samples, s)
+	}
+
+	if f == formatOpenMetrics {
+		return nil, fmt.Errorf("openmetrics exposition missing %s", openMetricsEOF)
+	}
+	return samples, nil
+}
+
+func parseSampleLine(line []byte, f format) (sample, error) {
+	var s sample
+
+	n := 0
+	for n < len(line) && isMetricNameChar(line[n], n == 0) {
+		n++
+	}
+	if n == 0 {
+		return s, fmt.Errorf("invalid metric name")
+	}
+	s.name, line = line[:n], line[n:]
+
+	if len(line) > 0 && line[0] == '{' {
+		labels, rest, err := parseLabels(line[1:])
+		if err != nil {
+			return s, err
+		}
+		s.labels, line = labels, rest
+	}
+
+	if f == formatOpenMetrics {
+		// Exemplars are not stored, drop them.
+		if idx := bytes.Index(line, openMetricsExemplar); idx >= 0 {
+			line = line[:idx]
+		}
+	}
+
+	fields := bytes.Fields(line)
+	if len(fields) == 0 || len(fields) > 2 {
+		return s, fmt.Errorf("expected value and optional timestamp for metric %s", s.name)
+	}
+
+	value, err := strconv.ParseFloat(string(fields[0]), 64)
+	if err != nil {
+		return s, fmt.Errorf("invalid value for metric %s: %v", s.name, err)
+	}
+	s.value = value
+
+	if len(fields) == 2 {
+		ts, err := parseTimestamp(fields[1], f)
+		if err != nil {
+			return s, fmt.Errorf("invalid timestamp for metric %s: %v", s.name, err)
+		}
+		s.hasTimestamp = true
+		s.timestamp = ts
+	}
+
+	return s, nil
+}
+
+func parseLabels(line []byte) ([]label, []byte, error) {
+	var labels []label
+	for {
+		line = bytes.TrimLeft(line, " \t")
+		if len(line) == 0 {
+			return nil, nil, fmt.Errorf("unterminated label set")
+		}
+		if line[0] == '}' {
+			return labels, line[1:], nil
+		}
+
+		n := 0
+		for n < len(line) && isLabelNameChar(line[n], n == 0) {
+			n++
+		}
+		if n == 0 {
+			return nil, nil, fmt.Errorf("invalid label name")
+		}
+		name := line[:n]
+		line = bytes.TrimLeft(line[n:], " \t")
+		if len(line) < 2 || line[0] != '=' {
+			return nil, nil, fmt.Errorf("expected '=' after label name %s", name)
+		}
+		line = bytes.TrimLeft(line[1:], " \t")
+		if len(line) == 0 || line[0] != '"' {
+			return nil, nil, fmt.Errorf("expected quoted value for label %s", name)
+		}
+
+		value, rest, err := parseLabelValue(line[1:])
+		if err != nil {
+			return nil, nil, fmt.Errorf("label %s: %v", name, err)
+		}
+		labels = append(labels, label{name: name, value: value})
+
+		line = bytes.TrimLeft(rest, " \t")
+		if len(line) > 0 && line[0] == ',' {
+			line = line[1:]
+		}
+	}
+}
+
+// parseLabelValue parses a label value up to the closing quote, unescaping
+// the value only when it contains escape sequences.
+func parseLabelValue(line []byte) ([]byte, []byte, error) {
+	escaped := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			escaped = true
+			i++
+		case '"':
+			if !escaped {
+				return line[:i], line[i+1:], nil
+			}
+			return unescapeLabelValue(line[:i]), line[i+1:], nil
+		}
+	}
+	return nil, nil, fmt.Errorf("unterminated label value")
+}
+
+func unescapeLabelValue(value []byte) []byte {
+	result := make([]byte, 0, len(value))
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c == '\\' && i+1 < len(value) {
+			i++
+			switch value[i] {
+			case 'n':
+				c = '\n'
+			default:
+				c = value[i]
+			}
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+func parseTimestamp(value []byte, f format) (time.Time, error) {
+	if f == formatOpenMetrics {
+		// OpenMetrics timestamps are in seconds and may be fractional.
+		secs, err := strconv.ParseFloat(string(value), 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if math.IsNaN(secs) || math.IsInf(secs, 0) {
+			return time.Time{}, fmt.Errorf("timestamp must be finite")
+		}
+		whole, frac := math.Modf(secs)
+		return time.Unix(int64(whole), int64(frac*float64(time.Second))), nil
+	}
+
+	// Text format timestamps are integer milliseconds since epoch.
+	millis, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, millis*int64(time.Millisecond)), nil
+}
+
+func isMetricNameChar(c byte, first bool) bool {
+	return c == ':' || isLabelNameChar(c, first)
+}
+
+func isLabelNameChar(c byte, first bool) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_' ||
+		(!first && c >= '0' && c <= '9')
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestscrape
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpositionText(t *testing.T) {
+	body := `# HELP http_requests_total The total number of HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{method="post",code="200"} 1027 1395066363000
+http_requests_total{method="post",code="400",} 3 1395066363000
+
+# Escaping in label values:
+msdos_file_access_time_seconds{path="C:\\DIR\\FILE.TXT",error="Cannot find file:\n\"FILE.TXT\""} 1.458255915e9
+metric_without_timestamp_and_labels 12.47
+nan_metric NaN
+inf_metric{le="+Inf"} +Inf
+`
+
+	samples, err := parseExposition([]byte(body), formatText)
+	require.NoError(t, err)
+	require.Len(t, samples, 6)
+
+	assert.Equal(t, "http_requests_total", string(samples[0].name))
+	require.Len(t, samples[0].labels, 2)
+	assert.Equal(t, "method", string(samples[0].labels[0].name))
+	assert.Equal(t, "post", string(samples[0].labels[0].value))
+	assert.Equal(t, "code", string(samples[0].labels[1].name))
+	assert.Equal(t, "200", string(samples[0].labels[1].value))
+	assert.Equal(t, 1027.0, samples[0].value)
+	assert.True(t, samples[0].hasTimestamp)
+	assert.True(t, time.Unix(1395066363, 0).Equal(samples[0].timestamp))
+
+	require.Len(t, samples[1].labels, 2)
+	assert.Equal(t, 3.0, samples[1].value)
+
+	require.Len(t, samples[2].labels, 2)
+	assert.Equal(t, `C:\DIR\FILE.TXT`, string(samples[2].labels[0].value))
+	assert.Equal(t, "Cannot find file:\n\"FILE.TXT\"", string(samples[2].labels[1].value))
+	assert.Equal(t, 1.458255915e9, samples[2].value)
+
+	assert.Equal(t, "metric_without_timestamp_and_labels", string(samples[3].name))
+	assert.Len(t, samples[3].labels, 0)
+	assert.False(t, samples[3].hasTimestamp)
+	assert.Equal(t, 12.47, samples[3].value)
+
+	assert.True(t, math.IsNaN(samples[4].value))
+	assert.True(t, math.IsInf(samples[5].value, 1))
+}
+
+func TestParseExpositionOpenMetrics(t *testing.T) {
+	body := `# TYPE foo counter
+# HELP foo A counter.
+foo_total{a="b # c"} 17.0 1520879607.789 # {trace_id="KOO5S4vxi0o"} 0.67
+foo_created 1520430000.123
+# EOF
+`
+
+	samples, err := parseExposition([]byte(body), formatOpenMetrics)
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+
+	assert.Equal(t, "foo_total", string(samples[0].name))
+	require.Len(t, samples[0].labels, 1)
+	assert.Equal(t, "b # c", string(samples[0].labels[0].value))
+	assert.Equal(t, 17.0, samples[0].value)
+	require.True(t, samples[0].hasTimestamp)
+	assert.Equal(t, int64(1520879607789),
+		samples[0].timestamp.UnixNano()/int64(time.Millisecond))
+
+	assert.Equal(t, "foo_created", string(samples[1].name))
+	assert.False(t, samples[1].hasTimestamp)
+}
+
+func TestParseExpositionOpenMetricsMissingEOF(t *testing.T) {
+	_, err := parseExposition([]byte("foo 1\n"), formatOpenMetrics)
+	require.Error(t, err)
+}
+
+func TestParseExpositionInvalid(t *testing.T) {
+	for _, body := range []string{
+		"1foo 1",
+		"foo{a=\"b\" 1",
+		"foo{a=b} 1",
+		"foo{a=\"b} 1",
+		"foo",
+		"foo abc",
+		"foo 1 abc",
+		"foo 1 2 3",
+	} {
+		_, err := parseExposition([]byte(body), formatText)
+		assert.Error(t, err, body)
+	}
+}
@@ -0,0 +1,470 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestscrape
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	targetSyncInterval = 10 * time.Second
+
+	acceptHeader = "application/openmetrics-text; version=0.0.1," +
+		"text/plain;version=0.0.4;q=0.5,*/*;q=0.1"
+
+	jobLabel            = "job"
+	instanceLabel       = "instance"
+	exportedLabelPrefix = "exported_"
+
+	upMetricName             = "up"
+	scrapeDurationMetricName = "scrape_duration_seconds"
+	scrapeSamplesMetricName  = "scrape_samples_scraped"
+)
+
+var errBodyTooLarge = errors.New("scrape response body exceeds max body size")
+
+// job is a resolved scrape job.
+type job struct {
+	name        string
+	metricsPath string
+	scheme      string
+	interval    time.Duration
+	timeout     time.Duration
+	discoverers []discoverer
+}
+
+// target is a single endpoint to scrape.
+type target struct {
+	job      *job
+	url      string
+	labels   []models.Tag
+	labelSet map[string]struct{}
+}
+
+func newTarget(j *job, address string, groupLabels map[string]string) target {
+	labels := make([]models.Tag, 0, len(groupLabels)+2)
+	for name, value := range groupLabels {
+		if name == jobLabel || name == instanceLabel {
+			continue
+		}
+		labels = append(labels, models.Tag{Name: []byte(name), Value: []byte(value)})
+	}
+	labels = append(labels,
+		models.Tag{Name: []byte(jobLabel), Value: []byte(j.name)},
+		models.Tag{Name: []byte(instanceLabel), Value: []byte(address)})
+	sort.Slice(labels, func(i, k int) bool {
+		return bytes.Compare(labels[i].Name, labels[k].Name) < 0
+	})
+
+	labelSet := make(map[string]struct{}, len(labels))
+	for _, l := range labels {
+		labelSet[string(l.Name)] = struct{}{}
+	}
+
+	return target{
+		job:      j,
+		url:      fmt.Sprintf("%s://%s%s", j.scheme, address, j.metricsPath),
+		labels:   labels,
+		labelSet: labelSet,
+	}
+}
+
+// key uniquely identifies a target across target syncs.
+func (t target) key() string {
+	var b strings.Builder
+	b.WriteString(t.job.name)
+	b.WriteByte('|')
+	b.WriteString(t.url)
+	for _, l := range t.labels {
+		b.WriteByte('|')
+		b.Write(l.Name)
+		b.WriteByte('=')
+		b.Write(l.Value)
+	}
+	return b.String()
+}
+
+type scraperMetrics struct {
+	targets        tally.Gauge
+	scrapeSuccess  tally.Counter
+	scrapeErrors   tally.Counter
+	writeSuccess   tally.Counter
+	writeErrors    tally.Counter
+	discoverErrors tally.Counter
+	scrapeLatency  tally.Timer
+}
+
+func newScraperMetrics(scope tally.Scope) scraperMetrics {
+	return scraperMetrics{
+		targets:        scope.Gauge("targets"),
+		scrapeSuccess:  scope.Counter("scrape-success"),
+		scrapeErrors:   scope.Counter("scrape-errors"),
+		writeSuccess:   scope.Counter("write-success"),
+		writeErrors:    scope.Counter("write-errors"),
+		discoverErrors: scope.Counter("discover-errors"),
+		scrapeLatency:  scope.Timer("scrape-latency"),
+	}
+}
+
+// Scraper periodically scrapes Prometheus exposition format endpoints and
+// writes the scraped samples through the downsampler and writer.
+type Scraper struct {
+	sync.Mutex
+
+	jobs                 []job
+	downsamplerAndWriter ingest.DownsamplerAndWriter
+	tagOptions           models.TagOptions
+	nowFn                clock.NowFn
+	client               *http.Client
+	maxBodySize          int64
+	logger               *zap.Logger
+	metrics              scraperMetrics
+
+	loops   map[string]*scrapeLoop
+	started bool
+	closed  bool
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newScraper(
+	jobs []job,
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+	client *http.Client,
+	maxBodySize int64,
+	tagOptions models.TagOptions,
+	instrumentOpts instrument.Options,
+) *Scraper {
+	return &Scraper{
+		jobs:                 jobs,
+		downsamplerAndWriter: downsamplerAndWriter,
+		tagOptions:           tagOptions,
+		nowFn:                time.Now,
+		client:               client,
+		maxBodySize:          maxBodySize,
+		logger:               instrumentOpts.Logger(),
+		metrics:              newScraperMetrics(instrumentOpts.MetricsScope()),
+		loops:                make(map[string]*scrapeLoop),
+		closeCh:              make(chan struct{}),
+	}
+}
+
+// Start starts discovering and scraping targets in the background.
+func (s *Scraper) Start() {
+	s.Lock()
+	defer s.Unlock()
+	if s.started || s.closed {
+		return
+	}
+	s.started = true
+
+	s.wg.Add(1)
+	go s.syncLoop()
+}
+
+// Close stops all scraping and waits for in flight scrapes to complete.
+func (s *Scraper) Close() error {
+	s.Lock()
+	if s.closed {
+		s.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.closeCh)
+	for key, loop := range s.loops {
+		loop.stop()
+		delete(s.loops, key)
+	}
+	s.Unlock()
+
+	s.wg.Wait()
+	return nil
+}
+
+func (s *Scraper) syncLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(targetSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		s.syncTargets()
+		select {
+		case <-ticker.C:
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+// syncTargets starts scrape loops for newly discovered targets and stops
+// scrape loops for targets that are no longer discovered.
+func (s *Scraper) syncTargets() {
+	var (
+		now     = s.nowFn()
+		targets = make(map[string]target)
+	)
+	for i := range s.jobs {
+		j := &s.jobs[i]
+		for _, d := range j.discoverers {
+			groups, err := d.TargetGroups(now)
+			if err != nil {
+				s.metrics.discoverErrors.Inc(1)
+				s.logger.Error("scrape target discovery failed",
+					zap.String("job", j.name), zap.Error(err))
+			}
+			for _, g := range groups {
+				for _, address := range g.Targets {
+					t := newTarget(j, address, g.Labels)
+					targets[t.key()] = t
+				}
+			}
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if s.closed {
+		return
+	}
+
+	for key, loop := range s.loops {
+		if _, ok := targets[key]; !ok {
+			loop.stop()
+			delete(s.loops, key)
+		}
+	}
+	for key, t := range targets {
+		if _, ok := s.loops[key]; ok {
+			continue
+		}
+		loop := newScrapeLoop(s, t)
+		s.loops[key] = loop
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			loop.run()
+		}()
+	}
+	s.metrics.targets.Update(float64(len(s.loops)))
+}
+
+type scrapeLoop struct {
+	scraper *Scraper
+	target  target
+	stopCh  chan struct{}
+}
+
+func newScrapeLoop(s *Scraper, t target) *scrapeLoop {
+	return &scrapeLoop{
+		scraper: s,
+		target:  t,
+		stopCh:  make(chan struct{}),
+	}
+}
+
+func (l *scrapeLoop) stop() {
+	close(l.stopCh)
+}
+
+func (l *scrapeLoop) run() {
+	ticker := time.NewTicker(l.target.job.interval)
+	defer ticker.Stop()
+
+	for {
+		l.scrapeAndWrite()
+		select {
+		case <-ticker.C:
+		case <-l.stopCh:
+			return
+		}
+	}
+}
+
+func (l *scrapeLoop) scrapeAndWrite() {
+	var (
+		s       = l.scraper
+		start   = s.nowFn()
+		samples []sample
+		err     error
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), l.target.job.timeout)
+	samples, err = l.scrape(ctx)
+	cancel()
+
+	duration := s.nowFn().Sub(start)
+	s.metrics.scrapeLatency.Record(duration)
+
+	up := 1.0
+	if err != nil {
+		up = 0
+		s.metrics.scrapeErrors.Inc(1)
+		s.logger.Warn("scrape failed",
+			zap.String("job", l.target.job.name),
+			zap.String("url", l.target.url),
+			zap.Error(err))
+	} else {
+		s.metrics.scrapeSuccess.Inc(1)
+	}
+
+	iter := &sampleIter{
+		tags:       make([]models.Tags, 0, len(samples)+3),
+		datapoints: make([]ts.Datapoints, 0, len(samples)+3),
+	}
+	for _, smp := range samples {
+		timestamp := start
+		if smp.hasTimestamp {
+			timestamp = smp.timestamp
+		}
+		iter.add(l.tags(smp.name, smp.labels), timestamp, smp.value)
+	}
+
+	iter.add(l.tags([]byte(upMetricName), nil), start, up)
+	iter.add(l.tags([]byte(scrapeDurationMetricName), nil), start, duration.Seconds())
+	iter.add(l.tags([]byte(scrapeSamplesMetricName), nil), start, float64(len(samples)))
+
+	// NB: Writes are bounded by the scrape timeout as well so that a slow
+	// backend can not pile up writes from consecutive scrapes.
+	ctx, cancel = context.WithTimeout(context.Background(), l.target.job.timeout)
+	err = s.downsamplerAndWriter.WriteBatch(ctx, iter)
+	cancel()
+	if err != nil {
+		s.metrics.writeErrors.Inc(1)
+		s.logger.Error("scrape write failed",
+			zap.String("job", l.target.job.name),
+			zap.String("url", l.target.url),
+			zap.Error(err))
+		return
+	}
+	s.metrics.writeSuccess.Inc(1)
+}
+
+func (l *scrapeLoop) scrape(ctx context.Context) ([]sample, error) {
+	req, err := http.NewRequest(http.MethodGet, l.target.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", acceptHeader)
+
+	resp, err := l.scraper.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server returned HTTP status %s", resp.Status)
+	}
+
+	// NB: Read one byte past the max body size to tell a body of exactly
+	// the max size apart from a larger one.
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, l.scraper.maxBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > l.scraper.maxBodySize {
+		return nil, errBodyTooLarge
+	}
+
+	f := formatText
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), openMetricsMediaType) {
+		f = formatOpenMetrics
+	}
+	return parseExposition(body, f)
+}
+
+// tags returns the tags of a single sample, target labels take precedence
+// over scraped labels of the same name which are kept with an exported_ prefix.
+func (l *scrapeLoop) tags(name []byte, labels []label) models.Tags {
+	s := l.scraper
+	tags := models.NewTags(len(labels)+len(l.target.labels)+1, s.tagOptions)
+	tags = tags.AddTagWithoutNormalizing(models.Tag{
+		Name:  s.tagOptions.MetricName(),
+		Value: name,
+	})
+	for _, lbl := range labels {
+		if len(lbl.value) == 0 || bytes.Equal(lbl.name, s.tagOptions.MetricName()) {
+			continue
+		}
+		tagName := lbl.name
+		if _, ok := l.target.labelSet[string(tagName)]; ok {
+			tagName = append([]byte(exportedLabelPrefix), tagName...)
+		}
+		tags = tags.AddTagWithoutNormalizing(models.Tag{Name: tagName, Value: lbl.value})
+	}
+	return tags.AddTags(l.target.labels)
+}
+
+// sampleIter iterates over the samples of a single scrape for writing them
+// as a batch.
+type sampleIter struct {
+	idx        int
+	tags       []models.Tags
+	datapoints []ts.Datapoints
+}
+
+func (i *sampleIter) add(tags models.Tags, timestamp time.Time, value float64) {
+	i.tags = append(i.tags, tags)
+	i.datapoints = append(i.datapoints, ts.Datapoints{{Timestamp: timestamp, Value: value}})
+	i.idx = -1
+}
+
+func (i *sampleIter) Next() bool {
+	i.idx++
+	return i.idx < len(i.tags)
+}
+
+func (i *sampleIter) Current() (models.Tags, ts.Datapoints, xtime.Unit) {
+	if len(i.tags) == 0 || i.idx < 0 || i.idx >= len(i.tags) {
+		return models.EmptyTags(), nil, 0
+	}
+	return i.tags[i.idx], i.datapoints[i.idx], xtime.Millisecond
+}
+
+func (i *sampleIter) Reset() error {
+	i.idx = -1
+	return nil
+}
+
+func (i *sampleIter) Error() error {
+	return nil
+}
@@ -0,0 +1,334 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestscrape
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScraperScrapesTargets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/custom", r.URL.Path)
+		fmt.Fprintln(w, `# TYPE requests counter`)
+		fmt.Fprintln(w, `requests{job="inner",method="get"} 42 1500000000000`)
+	}))
+	defer server.Close()
+
+	var (
+		address  = strings.TrimPrefix(server.URL, "http://")
+		interval = time.Hour
+		written  = make(map[string]ts.Datapoint)
+		wg       sync.WaitGroup
+		lock     sync.Mutex
+	)
+	wg.Add(1)
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			iter ingest.DownsampleAndWriteIter,
+		) error {
+			_, ok := ctx.Deadline()
+			assert.True(t, ok)
+			lock.Lock()
+			for iter.Next() {
+				tags, dps, unit := iter.Current()
+				assert.Equal(t, xtime.Millisecond, unit)
+				written[string(tags.ID())] = dps[0]
+			}
+			lock.Unlock()
+			wg.Done()
+			return nil
+		})
+
+	cfg := Configuration{
+		Interval: &interval,
+		Jobs: []JobConfiguration{
+			{
+				Name:        "test",
+				MetricsPath: "/custom",
+				StaticTargets: []TargetGroupConfiguration{
+					{
+						Targets: []string{address},
+						Labels:  map[string]string{"env": "prod"},
+					},
+				},
+			},
+		},
+	}
+
+	tagOpts := models.NewTagOptions()
+	scraper, err := cfg.NewScraper(mockDownsamplerAndWriter, tagOpts,
+		instrument.NewOptions())
+	require.NoError(t, err)
+
+	scraper.Start()
+	wg.Wait()
+	require.NoError(t, scraper.Close())
+
+	expectedID := func(name string, extra ...models.Tag) string {
+		tags := models.NewTags(0, tagOpts).
+			SetName([]byte(name)).
+			AddTags([]models.Tag{
+				{Name: []byte("env"), Value: []byte("prod")},
+				{Name: []byte("instance"), Value: []byte(address)},
+				{Name: []byte("job"), Value: []byte("test")},
+			}).
+			AddTags(extra)
+		return string(tags.ID())
+	}
+
+	require.Equal(t, 4, len(written))
+	dp, ok := written[expectedID("requests",
+		models.Tag{Name: []byte("exported_job"), Value: []byte("inner")},
+		models.Tag{Name: []byte("method"), Value: []byte("get")})]
+	require.True(t, ok)
+	assert.Equal(t, 42.0, dp.Value)
+	assert.True(t, time.Unix(1500000000, 0).Equal(dp.Timestamp))
+
+	dp, ok = written[expectedID("up")]
+	require.True(t, ok)
+	assert.Equal(t, 1.0, dp.Value)
+
+	dp, ok = written[expectedID("scrape_samples_scraped")]
+	require.True(t, ok)
+	assert.Equal(t, 1.0, dp.Value)
+
+	_, ok = written[expectedID("scrape_duration_seconds")]
+	require.True(t, ok)
+}
+
+func TestScraperTargetDown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	var (
+		interval = time.Hour
+		up       = make(chan float64, 1)
+	)
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+		) error {
+			for iter.Next() {
+				tags, dps, _ := iter.Current()
+				if name, _ := tags.Name(); string(name) == upMetricName {
+					up <- dps[0].Value
+				}
+			}
+			return nil
+		})
+
+	cfg := Configuration{
+		Interval: &interval,
+		Jobs: []JobConfiguration{
+			{
+				Name: "test",
+				StaticTargets: []TargetGroupConfiguration{
+					{Targets: []string{strings.TrimPrefix(server.URL, "http://")}},
+				},
+			},
+		},
+	}
+
+	scraper, err := cfg.NewScraper(mockDownsamplerAndWriter, models.NewTagOptions(),
+		instrument.NewOptions())
+	require.NoError(t, err)
+
+	scraper.Start()
+	assert.Equal(t, 0.0, <-up)
+	require.NoError(t, scraper.Close())
+}
+
+func TestScraperBodyTooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `requests{method="get"} 42`)
+	}))
+	defer server.Close()
+
+	var (
+		interval    = time.Hour
+		maxBodySize = 8
+		samples     = make(chan float64, 1)
+	)
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+		) error {
+			for iter.Next() {
+				tags, dps, _ := iter.Current()
+				if name, _ := tags.Name(); string(name) == scrapeSamplesMetricName {
+					samples <- dps[0].Value
+				}
+			}
+			return nil
+		})
+
+	cfg := Configuration{
+		Interval:    &interval,
+		MaxBodySize: &maxBodySize,
+		Jobs: []JobConfiguration{
+			{
+				Name: "test",
+				StaticTargets: []TargetGroupConfiguration{
+					{Targets: []string{strings.TrimPrefix(server.URL, "http://")}},
+				},
+			},
+		},
+	}
+
+	scraper, err := cfg.NewScraper(mockDownsamplerAndWriter, models.NewTagOptions(),
+		instrument.NewOptions())
+	require.NoError(t, err)
+
+	scraper.Start()
+	assert.Equal(t, 0.0, <-samples)
+	require.NoError(t, scraper.Close())
+}
+
+func TestFileDiscovererRefresh(t *testing.T) {
+	dir, err := ioutil.TempDir("", "scrape-file-sd")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		jsonFile = path.Join(dir, "targets.json")
+		yamlFile = path.Join(dir, "targets.yml")
+	)
+	require.NoError(t, ioutil.WriteFile(jsonFile,
+		[]byte(`[{"targets": ["a:9090"], "labels": {"env": "prod"}}]`), 0644))
+	require.NoError(t, ioutil.WriteFile(yamlFile,
+		[]byte("- targets: [\"b:9090\", \"c:9090\"]\n"), 0644))
+
+	d := newFileDiscoverer([]string{path.Join(dir, "*.json"), yamlFile}, time.Minute)
+
+	now := time.Now()
+	groups, err := d.TargetGroups(now)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, []string{"a:9090"}, groups[0].Targets)
+	assert.Equal(t, map[string]string{"env": "prod"}, groups[0].Labels)
+	assert.Equal(t, []string{"b:9090", "c:9090"}, groups[1].Targets)
+
+	// Files are not re-read until the refresh interval has elapsed.
+	require.NoError(t, ioutil.WriteFile(yamlFile,
+		[]byte("- targets: [\"d:9090\"]\n"), 0644))
+	groups, err = d.TargetGroups(now.Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b:9090", "c:9090"}, groups[1].Targets)
+
+	groups, err = d.TargetGroups(now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d:9090"}, groups[1].Targets)
+
+	// A malformed file keeps the previously discovered targets.
+	require.NoError(t, ioutil.WriteFile(jsonFile, []byte("{"), 0644))
+	groups, err = d.TargetGroups(now.Add(2 * time.Minute))
+	require.Error(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, []string{"d:9090"}, groups[1].Targets)
+}
+
+func TestJobConfigurationValidation(t *testing.T) {
+	interval := time.Second
+	timeout := time.Minute
+
+	_, err := Configuration{}.NewScraper(nil, models.NewTagOptions(), instrument.NewOptions())
+	require.Error(t, err)
+
+	var (
+		zero = 0
+		jobs = []JobConfiguration{{
+			Name:          "a",
+			StaticTargets: []TargetGroupConfiguration{{Targets: []string{"a:1"}}},
+		}}
+	)
+	_, err = Configuration{MaxBodySize: &zero, Jobs: jobs}.
+		NewScraper(nil, models.NewTagOptions(), instrument.NewOptions())
+	require.Equal(t, errMaxBodySize, err)
+
+	negative := -time.Second
+	_, err = Configuration{
+		Client: HTTPClientConfiguration{Timeout: &negative},
+		Jobs:   jobs,
+	}.NewScraper(nil, models.NewTagOptions(), instrument.NewOptions())
+	require.Equal(t, errClientTimeout, err)
+
+	_, err = JobConfiguration{Name: "a"}.newJob(time.Second, time.Second)
+	require.Error(t, err)
+
+	_, err = JobConfiguration{
+		Name:          "a",
+		Interval:      &interval,
+		Timeout:       &timeout,
+		StaticTargets: []TargetGroupConfiguration{{Targets: []string{"a:1"}}},
+	}.newJob(time.Second, time.Second)
+	require.Error(t, err)
+
+	j, err := JobConfiguration{
+		Name:          "a",
+		StaticTargets: []TargetGroupConfiguration{{Targets: []string{"a:1"}}},
+	}.newJob(time.Second, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, defaultMetricsPath, j.metricsPath)
+	assert.Equal(t, defaultScheme, j.scheme)
+}
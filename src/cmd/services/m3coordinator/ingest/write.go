@@ -139,7 +139,8 @@ func (d *downsamplerAndWriter) maybeWriteDownsampler(
 			appender.AddTag(tag.Name, tag.Value)
 		}
 
-		if tags.Opts.IDSchemeType() == models.TypeGraphite {
+		switch tags.Opts.IDSchemeType() {
+		case models.TypeGraphite:
 			// NB(r): This is gross, but if this is a graphite metric then
 			// we are going to set a special tag that means the downsampler
 			// will write a graphite ID. This should really be plumbed
@@ -148,6 +149,9 @@ func (d *downsamplerAndWriter) maybeWriteDownsampler(
 			// back the context is lost currently.
 			appender.AddTag(downsample.MetricsOptionIDSchemeTagName,
 				downsample.GraphiteIDSchemeTagValue)
+		case models.TypeGraphiteTagged:
+			appender.AddTag(downsample.MetricsOptionIDSchemeTagName,
+				downsample.GraphiteTaggedIDSchemeTagValue)
 		}
 
 		var appenderOpts downsample.SampleAppenderOptions
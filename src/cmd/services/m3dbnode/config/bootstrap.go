@@ -27,12 +27,14 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/export"
 	"github.com/m3db/m3/src/dbnode/storage"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/commitlog"
 	bfs "github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/fs"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/peers"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/restore"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/uninitialized"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
@@ -56,6 +58,9 @@ type BootstrapConfiguration struct {
 	// Commitlog bootstrapper configuration.
 	Commitlog *BootstrapCommitlogConfiguration `yaml:"commitlog"`
 
+	// Restore bootstrapper configuration.
+	Restore *BootstrapRestoreConfiguration `yaml:"restore"`
+
 	// CacheSeriesMetadata determines whether individual bootstrappers cache
 	// series metadata across all calls (namespaces / shards / blocks).
 	CacheSeriesMetadata *bool `yaml:"cacheSeriesMetadata"`
@@ -86,6 +91,16 @@ type BootstrapCommitlogConfiguration struct {
 	ReturnUnfulfilledForCorruptCommitLogFiles bool `yaml:"returnUnfulfilledForCorruptCommitLogFiles"`
 }
 
+// BootstrapRestoreConfiguration specifies config for the restore bootstrapper.
+type BootstrapRestoreConfiguration struct {
+	// ExportPath is the directory namespace exports are read from.
+	ExportPath string `yaml:"exportPath" validate:"nonzero"`
+
+	// ExportID is the export to restore from, if not set the most recent
+	// export for each namespace is used.
+	ExportID string `yaml:"exportID"`
+}
+
 // New creates a bootstrap process based on the bootstrap configuration.
 func (bsc BootstrapConfiguration) New(
 	opts storage.Options,
@@ -114,6 +129,33 @@ func (bsc BootstrapConfiguration) New(
 	// Start from the end of the list because the bootstrappers are ordered by precedence in descending order.
 	for i := len(bsc.Bootstrappers) - 1; i >= 0; i-- {
 		switch bsc.Bootstrappers[i] {
+		case restore.RestoreBootstrapperName:
+			if bsc.Restore == nil {
+				return nil, fmt.Errorf("bootstrapper %s requires restore config",
+					restore.RestoreBootstrapperName)
+			}
+			fsbOpts := bfs.NewOptions().
+				SetInstrumentOptions(opts.InstrumentOptions()).
+				SetResultOptions(rsOpts).
+				SetFilesystemOptions(fsOpts).
+				SetPersistManager(opts.PersistManager()).
+				SetBoostrapDataNumProcessors(bsc.fsNumProcessors()).
+				SetDatabaseBlockRetrieverManager(opts.DatabaseBlockRetrieverManager()).
+				SetRuntimeOptionsManager(opts.RuntimeOptionsManager()).
+				SetIdentifierPool(opts.IdentifierPool())
+			exportOpts := export.NewOptions().
+				SetFilesystemOptions(fsOpts).
+				SetExportPath(bsc.Restore.ExportPath).
+				SetExportID(bsc.Restore.ExportID)
+			rOpts := restore.NewOptions().
+				SetResultOptions(rsOpts).
+				SetInstrumentOptions(opts.InstrumentOptions()).
+				SetFilesystemBootstrapOptions(fsbOpts).
+				SetExportOptions(exportOpts)
+			bs, err = restore.NewRestoreBootstrapperProvider(rOpts, bs)
+			if err != nil {
+				return nil, err
+			}
 		case bootstrapper.NoOpAllBootstrapperName:
 			bs = bootstrapper.NewNoOpAllBootstrapperProvider()
 		case bootstrapper.NoOpNoneBootstrapperName:
@@ -180,6 +222,7 @@ func (bsc BootstrapConfiguration) New(
 // is in valid order.
 func ValidateBootstrappersOrder(names []string) error {
 	dataFetchingBootstrappers := []string{
+		restore.RestoreBootstrapperName,
 		bfs.FileSystemBootstrapperName,
 		peers.PeersBootstrapperName,
 		commitlog.CommitLogBootstrapperName,
@@ -188,22 +231,29 @@ func ValidateBootstrappersOrder(names []string) error {
 	precedingBootstrappersAllowedByBootstrapper := map[string][]string{
 		bootstrapper.NoOpAllBootstrapperName:  dataFetchingBootstrappers,
 		bootstrapper.NoOpNoneBootstrapperName: dataFetchingBootstrappers,
-		bfs.FileSystemBootstrapperName:        []string{
-			// Filesystem bootstrapper must always appear first
+		restore.RestoreBootstrapperName:       []string{
+			// Restore bootstrapper must always appear first
+		},
+		bfs.FileSystemBootstrapperName: []string{
+			// Filesystem bootstrapper may only appear after restore
+			restore.RestoreBootstrapperName,
 		},
 		peers.PeersBootstrapperName: []string{
-			// Peers must always appear after filesystem
+			// Peers must always appear after restore and filesystem
+			restore.RestoreBootstrapperName,
 			bfs.FileSystemBootstrapperName,
 			// Peers may appear before OR after commitlog
 			commitlog.CommitLogBootstrapperName,
 		},
 		commitlog.CommitLogBootstrapperName: []string{
-			// Commit log bootstrapper may appear after filesystem or peers
+			// Commit log bootstrapper may appear after restore, filesystem or peers
+			restore.RestoreBootstrapperName,
 			bfs.FileSystemBootstrapperName,
 			peers.PeersBootstrapperName,
 		},
 		uninitialized.UninitializedTopologyBootstrapperName: []string{
-			// Unintialized bootstrapper may appear after filesystem or peers or commitlog
+			// Unintialized bootstrapper may appear after restore, filesystem or peers or commitlog
+			restore.RestoreBootstrapperName,
 			bfs.FileSystemBootstrapperName,
 			commitlog.CommitLogBootstrapperName,
 			peers.PeersBootstrapperName,
@@ -146,6 +146,10 @@ type DBConfiguration struct {
 	// Proto contains the configuration specific to running in the ProtoDataMode.
 	Proto *ProtoConfiguration `yaml:"proto"`
 
+	// Histogram contains the configuration specific to running in the
+	// histogram data mode.
+	Histogram *HistogramConfiguration `yaml:"histogram"`
+
 	// Tracing configures opentracing. If not provided, tracing is disabled.
 	Tracing *opentracing.TracingConfiguration `yaml:"tracing"`
 }
@@ -169,6 +173,10 @@ func (c *DBConfiguration) InitDefaultsAndValidate() error {
 		return err
 	}
 
+	if c.Proto != nil && c.Proto.Enabled && c.Histogram != nil && c.Histogram.Enabled {
+		return errors.New("proto and histogram data modes cannot both be enabled")
+	}
+
 	if err := c.Transforms.Validate(); err != nil {
 		return err
 	}
@@ -349,6 +357,15 @@ func (c NamespaceProtoSchema) Validate() error {
 	return nil
 }
 
+// HistogramConfiguration is the configuration for running with the histogram
+// data mode enabled, in which series whose first datapoint has a sparse
+// histogram annotation are encoded as histograms and all other series are
+// encoded with m3tsz as usual.
+type HistogramConfiguration struct {
+	// Enabled specifies whether the histogram data mode is enabled.
+	Enabled bool `yaml:"enabled"`
+}
+
 // Validate validates the ProtoConfiguration.
 func (c *ProtoConfiguration) Validate() error {
 	if c == nil || !c.Enabled {
@@ -385,11 +385,14 @@ func TestConfiguration(t *testing.T) {
       maxRetries: 3
       forever: null
       jitter: true
+    readRepair: null
+    hedgedReads: null
     backgroundHealthCheckFailLimit: 4
     backgroundHealthCheckFailThrottleFactor: 0.5
     hashing:
       seed: 42
     proto: null
+    histogram: null
   gcPercentage: 100
   writeNewSeriesLimitPerSecond: 1048576
   writeNewSeriesBackoffDuration: 2ms
@@ -402,6 +405,7 @@ func TestConfiguration(t *testing.T) {
     fs:
       numProcessorsPerCPU: 0.125
     commitlog: null
+    restore: null
     cacheSeriesMetadata: null
   blockRetrieve: null
   cache:
@@ -635,6 +639,7 @@ func TestConfiguration(t *testing.T) {
     seed: 42
   writeNewSeriesAsync: true
   proto: null
+  histogram: null
   tracing:
     serviceName: ""
     backend: jaeger
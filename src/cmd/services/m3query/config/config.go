@@ -28,10 +28,14 @@ import (
 	etcdclient "github.com/m3db/m3/src/cluster/client/etcd"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/downsample"
 	ingestm3msg "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/m3msg"
+	ingestscrape "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/scrape"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/server/m3msg"
 	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/query/cache"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/quota"
+	"github.com/m3db/m3/src/query/rules"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3"
 	xconfig "github.com/m3db/m3/src/x/config"
@@ -90,6 +94,10 @@ type Configuration struct {
 	// Filter is the read/write/complete tags filter configuration.
 	Filter FilterConfiguration `yaml:"filter"`
 
+	// PartialResults configures reads to return partial results rather than
+	// fail when some of the storages they fan out to fail.
+	PartialResults PartialResultsConfiguration `yaml:"partialResults"`
+
 	// RPC is the RPC configuration.
 	RPC *RPCConfiguration `yaml:"rpc"`
 
@@ -108,12 +116,18 @@ type Configuration struct {
 	// Downsample configurates how the metrics should be downsampled.
 	Downsample downsample.Configuration `yaml:"downsample"`
 
+	// IngestRules configures the rules applied to metrics as they are written.
+	IngestRules downsample.IngestRulesConfiguration `yaml:"ingestRules"`
+
 	// Ingest is the ingest server.
 	Ingest *IngestConfiguration `yaml:"ingest"`
 
 	// Carbon is the carbon configuration.
 	Carbon *CarbonConfiguration `yaml:"carbon"`
 
+	// Scrape is the Prometheus exposition format scrape configuration.
+	Scrape *ingestscrape.Configuration `yaml:"scrape"`
+
 	// Limits specifies limits on per-query resource usage.
 	Limits LimitsConfiguration `yaml:"limits"`
 
@@ -127,6 +141,15 @@ type Configuration struct {
 	//
 	// Deprecated: cache configurations are no longer supported. Remove from file.
 	DeprecatedCache CacheConfiguration `yaml:"cache"`
+
+	// ResultsCache is the range query results cache configuration.
+	ResultsCache cache.ResultsCacheConfiguration `yaml:"resultsCache"`
+
+	// Quotas is the per-tenant write and query quotas configuration.
+	Quotas quota.Configuration `yaml:"quotas"`
+
+	// Rules is the recording rules evaluation configuration.
+	Rules rules.Configuration `yaml:"rules"`
 }
 
 // Filter is a query filter type.
@@ -150,6 +173,19 @@ type FilterConfiguration struct {
 	CompleteTags Filter `yaml:"completeTags"`
 }
 
+// PartialResultsConfiguration is the configuration for returning partial
+// results from reads that fan out to multiple storages.
+type PartialResultsConfiguration struct {
+	// Enabled skips the storages that fail or time out during a read as long
+	// as at least one storage succeeds, marking the results as partial.
+	Enabled bool `yaml:"enabled"`
+
+	// StoreTimeout is the timeout of each storage read after which the
+	// storage is skipped, zero means reads are only bounded by the query
+	// timeout.
+	StoreTimeout time.Duration `yaml:"storeTimeout"`
+}
+
 // CacheConfiguration contains the cache configurations.
 type CacheConfiguration struct {
 	// Deprecated: remove from config.
@@ -388,6 +424,20 @@ type RPCConfiguration struct {
 	// RemoteListenAddresses is the remote listen addresses to call for remote
 	// coordinator calls.
 	RemoteListenAddresses []string `yaml:"remoteListenAddresses"`
+
+	// Remotes are the remote zones to call for remote coordinator calls, each
+	// zone is read from as a separate storage named after the zone.
+	Remotes []RemoteConfiguration `yaml:"remotes"`
+}
+
+// RemoteConfiguration is the configuration of a single remote zone.
+type RemoteConfiguration struct {
+	// Name is the name of the zone, which identifies it in the warnings and
+	// metrics of reads that return partial results without it.
+	Name string `yaml:"name"`
+
+	// RemoteListenAddresses is the remote listen addresses of the zone.
+	RemoteListenAddresses []string `yaml:"remoteListenAddresses"`
 }
 
 // TagOptionsConfiguration is the configuration for shared tag options
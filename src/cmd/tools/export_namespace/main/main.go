@@ -0,0 +1,137 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/export"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/pborman/getopt"
+	"go.uber.org/zap"
+)
+
+func main() {
+	var (
+		optPathPrefix = getopt.StringLong("path-prefix", 'p', "/var/lib/m3db", "Path prefix [e.g. /var/lib/m3db]")
+		optNamespace  = getopt.StringLong("namespace", 'n', "metrics", "Namespace [e.g. metrics]")
+		optRegistry   = getopt.StringLong("namespace-registry", 'r', "", "Namespace registry JSON file, as returned by the coordinator namespace API")
+		optExportPath = getopt.StringLong("export-path", 'e', "", "Export path [e.g. /mnt/backups/m3db]")
+		optShards     = getopt.StringLong("shards", 's', "", "Comma separated shards to export, defaults to all shards on disk")
+		optExportID   = getopt.StringLong("export-id", 'i', "", "Export ID, the same for every host of the export [e.g. 20191016]")
+		optHostID     = getopt.StringLong("host-id", 'H', "", "Host ID of this host [e.g. m3db-node-01]")
+	)
+	getopt.Parse()
+
+	rawLogger, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatalf("unable to create logger: %+v", err)
+	}
+	log := rawLogger.Sugar()
+
+	if *optPathPrefix == "" ||
+		*optNamespace == "" ||
+		*optRegistry == "" ||
+		*optExportPath == "" ||
+		*optExportID == "" ||
+		*optHostID == "" {
+		getopt.Usage()
+		os.Exit(1)
+	}
+
+	md, err := readNamespaceMetadata(*optRegistry, *optNamespace)
+	if err != nil {
+		log.Fatalf("unable to read namespace metadata: %v", err)
+	}
+
+	shards, err := parseShards(*optShards)
+	if err != nil {
+		log.Fatalf("unable to parse shards: %v", err)
+	}
+
+	opts := export.NewOptions().
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(*optPathPrefix)).
+		SetExportPath(*optExportPath).
+		SetExportID(*optExportID).
+		SetHostID(*optHostID)
+	exporter, err := export.NewExporter(opts)
+	if err != nil {
+		log.Fatalf("unable to create exporter: %v", err)
+	}
+
+	manifest, err := exporter.Export(md, shards)
+	if err != nil {
+		log.Fatalf("unable to export namespace: %v", err)
+	}
+
+	log.Infof("exported namespace %s to %s", *optNamespace,
+		filepath.Join(export.Dir(*optExportPath, *optNamespace, manifest.ID), manifest.HostID))
+	log.Infof("export ID: %s, host ID: %s, flushed up to: %v, shards: %d, data filesets: %d, index filesets: %d",
+		manifest.ID, manifest.HostID, manifest.FlushedUpTo, len(manifest.Shards),
+		len(manifest.DataFileSets), len(manifest.IndexFileSets))
+}
+
+func readNamespaceMetadata(registryPath, name string) (namespace.Metadata, error) {
+	f, err := os.Open(registryPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var resp admin.NamespaceGetResponse
+	if err := jsonpb.Unmarshal(f, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Registry == nil {
+		return nil, fmt.Errorf("no registry in %s", registryPath)
+	}
+
+	opts, ok := resp.Registry.Namespaces[name]
+	if !ok {
+		return nil, fmt.Errorf("namespace %s not found in %s", name, registryPath)
+	}
+	return namespace.ToMetadata(name, opts)
+}
+
+func parseShards(value string) ([]uint32, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var shards []uint32
+	for _, s := range strings.Split(value, ",") {
+		shard, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		shards = append(shards, uint32(shard))
+	}
+	return shards, nil
+}
@@ -79,7 +79,8 @@ func updateRuleSet(s *service, r *http.Request) (data interface{}, err error) {
 		return nil, NewBadInputError(err.Error())
 	}
 	if len(req.RuleSetChanges.MappingRuleChanges) == 0 &&
-		len(req.RuleSetChanges.RollupRuleChanges) == 0 {
+		len(req.RuleSetChanges.RollupRuleChanges) == 0 &&
+		len(req.RuleSetChanges.IngestRuleChanges) == 0 {
 		return nil, NewBadInputError(
 			"invalid request: no ruleset changes detected",
 		)
@@ -232,3 +233,64 @@ func fetchRollupRuleHistory(s *service, r *http.Request) (data interface{}, err
 	}
 	return view.RollupRuleSnapshots{RollupRules: snapshots}, nil
 }
+
+func fetchIngestRule(s *service, r *http.Request) (data interface{}, err error) {
+	vars := mux.Vars(r)
+	return s.store.FetchIngestRule(vars[namespaceIDVar], vars[ruleIDVar])
+}
+
+func createIngestRule(s *service, r *http.Request) (data interface{}, err error) {
+	vars := mux.Vars(r)
+	var irv view.IngestRule
+	if err := parseRequest(&irv, r.Body); err != nil {
+		return nil, err
+	}
+
+	uOpts, err := s.newUpdateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.CreateIngestRule(vars[namespaceIDVar], irv, uOpts)
+}
+
+func updateIngestRule(s *service, r *http.Request) (data interface{}, err error) {
+	vars := mux.Vars(r)
+	var irv view.IngestRule
+	if err := parseRequest(&irv, r.Body); err != nil {
+		return nil, err
+	}
+
+	uOpts, err := s.newUpdateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.UpdateIngestRule(vars[namespaceIDVar], vars[ruleIDVar], irv, uOpts)
+}
+
+func deleteIngestRule(s *service, r *http.Request) (data interface{}, err error) {
+	vars := mux.Vars(r)
+	namespaceID := vars[namespaceIDVar]
+	ingestRuleID := vars[ruleIDVar]
+
+	uOpts, err := s.newUpdateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteIngestRule(namespaceID, ingestRuleID, uOpts); err != nil {
+		return nil, err
+	}
+
+	return fmt.Sprintf("Deleted ingest rule: %s in namespace %s", ingestRuleID, namespaceID), nil
+}
+
+func fetchIngestRuleHistory(s *service, r *http.Request) (data interface{}, err error) {
+	vars := mux.Vars(r)
+	snapshots, err := s.store.FetchIngestRuleHistory(vars[namespaceIDVar], vars[ruleIDVar])
+	if err != nil {
+		return nil, err
+	}
+	return view.IngestRuleSnapshots{IngestRules: snapshots}, nil
+}
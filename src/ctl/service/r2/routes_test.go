@@ -170,6 +170,45 @@ func TestFetchRollupRuleHistorySuccess(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestFetchIngestRuleSuccess(t *testing.T) {
+	expected := view.IngestRule{}
+	actual, err := fetchIngestRule(newTestService(nil), newTestGetRequest())
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestCreateIngestRuleSuccess(t *testing.T) {
+	expected := view.IngestRule{}
+	actual, err := createIngestRule(newTestService(nil), newTestPostRequest(
+		[]byte(`{"filter": "key:val", "name": "name", "drop": true}`),
+	))
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestUpdateIngestRuleSuccess(t *testing.T) {
+	expected := view.IngestRule{}
+	actual, err := updateIngestRule(newTestService(nil), newTestPutRequest(
+		[]byte(`{"filter": "key:val", "name": "name", "dropTags": ["key2"]}`),
+	))
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestDeleteIngestRuleSuccess(t *testing.T) {
+	expected := fmt.Sprintf("Deleted ingest rule: %s in namespace %s", "", "")
+	actual, err := deleteIngestRule(newTestService(nil), newTestDeleteRequest())
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestFetchIngestRuleHistorySuccess(t *testing.T) {
+	expected := view.IngestRuleSnapshots{IngestRules: []view.IngestRule{}}
+	actual, err := fetchIngestRuleHistory(newTestService(nil), newTestGetRequest())
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
 func TestRulesetUpdateRuleSet(t *testing.T) {
 	namespaceID := "testNamespace"
 	bulkReqBody := newTestBulkReqBody()
@@ -469,4 +508,24 @@ func (s mockStore) FetchRollupRuleHistory(namespaceID, rollupRuleID string) ([]v
 	return make([]view.RollupRule, 0), nil
 }
 
+func (s mockStore) FetchIngestRule(namespaceID, ingestRuleID string) (view.IngestRule, error) {
+	return view.IngestRule{}, nil
+}
+
+func (s mockStore) CreateIngestRule(namespaceID string, irv view.IngestRule, uOpts store.UpdateOptions) (view.IngestRule, error) {
+	return view.IngestRule{}, nil
+}
+
+func (s mockStore) UpdateIngestRule(namespaceID, ingestRuleID string, irv view.IngestRule, uOpts store.UpdateOptions) (view.IngestRule, error) {
+	return view.IngestRule{}, nil
+}
+
+func (s mockStore) DeleteIngestRule(namespaceID, ingestRuleID string, uOpts store.UpdateOptions) error {
+	return nil
+}
+
+func (s mockStore) FetchIngestRuleHistory(namespaceID, ingestRuleID string) ([]view.IngestRule, error) {
+	return make([]view.IngestRule, 0), nil
+}
+
 func (s mockStore) Close() {}
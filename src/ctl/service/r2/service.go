@@ -41,6 +41,7 @@ const (
 	namespacePath     = "/namespaces"
 	mappingRulePrefix = "mapping-rules"
 	rollupRulePrefix  = "rollup-rules"
+	ingestRulePrefix  = "ingest-rules"
 	namespaceIDVar    = "namespaceID"
 	ruleIDVar         = "ruleID"
 )
@@ -58,6 +59,10 @@ var (
 	rollupRuleWithIDPath  = fmt.Sprintf("%s/{%s}", rollupRuleRoot, ruleIDVar)
 	rollupRuleHistoryPath = fmt.Sprintf("%s/history", rollupRuleWithIDPath)
 
+	ingestRuleRoot        = fmt.Sprintf("%s/%s", namespacePrefix, ingestRulePrefix)
+	ingestRuleWithIDPath  = fmt.Sprintf("%s/{%s}", ingestRuleRoot, ruleIDVar)
+	ingestRuleHistoryPath = fmt.Sprintf("%s/history", ingestRuleWithIDPath)
+
 	errNilRequest = errors.New("Nil request")
 )
 
@@ -77,6 +82,11 @@ type serviceMetrics struct {
 	updateRollupRule        instrument.MethodMetrics
 	deleteRollupRule        instrument.MethodMetrics
 	fetchRollupRuleHistory  instrument.MethodMetrics
+	fetchIngestRule         instrument.MethodMetrics
+	createIngestRule        instrument.MethodMetrics
+	updateIngestRule        instrument.MethodMetrics
+	deleteIngestRule        instrument.MethodMetrics
+	fetchIngestRuleHistory  instrument.MethodMetrics
 	updateRuleSet           instrument.MethodMetrics
 }
 
@@ -97,6 +107,11 @@ func newServiceMetrics(scope tally.Scope, samplingRate float64) serviceMetrics {
 		updateRollupRule:        instrument.NewMethodMetrics(scope, "updateRollupRule", samplingRate),
 		deleteRollupRule:        instrument.NewMethodMetrics(scope, "deleteRollupRule", samplingRate),
 		fetchRollupRuleHistory:  instrument.NewMethodMetrics(scope, "fetchRollupRuleHistory", samplingRate),
+		fetchIngestRule:         instrument.NewMethodMetrics(scope, "fetchIngestRule", samplingRate),
+		createIngestRule:        instrument.NewMethodMetrics(scope, "createIngestRule", samplingRate),
+		updateIngestRule:        instrument.NewMethodMetrics(scope, "updateIngestRule", samplingRate),
+		deleteIngestRule:        instrument.NewMethodMetrics(scope, "deleteIngestRule", samplingRate),
+		fetchIngestRuleHistory:  instrument.NewMethodMetrics(scope, "fetchIngestRuleHistory", samplingRate),
 		updateRuleSet:           instrument.NewMethodMetrics(scope, "updateRuleSet", samplingRate),
 	}
 }
@@ -194,6 +209,16 @@ func (s *service) RegisterHandlers(router *mux.Router) error {
 
 		// Rollup Rule history.
 		{route: route{path: rollupRuleHistoryPath, method: http.MethodGet}, handler: s.fetchRollupRuleHistory},
+
+		// Ingest Rule actions.
+		{route: route{path: ingestRuleRoot, method: http.MethodPost}, handler: s.createIngestRule},
+
+		{route: route{path: ingestRuleWithIDPath, method: http.MethodGet}, handler: s.fetchIngestRule},
+		{route: route{path: ingestRuleWithIDPath, method: http.MethodPut}, handler: s.updateIngestRule},
+		{route: route{path: ingestRuleWithIDPath, method: http.MethodDelete}, handler: s.deleteIngestRule},
+
+		// Ingest Rule history.
+		{route: route{path: ingestRuleHistoryPath, method: http.MethodGet}, handler: s.fetchIngestRuleHistory},
 	}
 
 	h := r2Handler{s.logger, s.authService}
@@ -372,6 +397,46 @@ func (s *service) fetchRollupRuleHistory(w http.ResponseWriter, r *http.Request)
 	return s.sendResponse(w, http.StatusOK, data)
 }
 
+func (s *service) fetchIngestRule(w http.ResponseWriter, r *http.Request) error {
+	data, err := s.handleRoute(fetchIngestRule, r, s.metrics.fetchIngestRule)
+	if err != nil {
+		return err
+	}
+	return s.sendResponse(w, http.StatusOK, data)
+}
+
+func (s *service) createIngestRule(w http.ResponseWriter, r *http.Request) error {
+	data, err := s.handleRoute(createIngestRule, r, s.metrics.createIngestRule)
+	if err != nil {
+		return err
+	}
+	return s.sendResponse(w, http.StatusCreated, data)
+}
+
+func (s *service) updateIngestRule(w http.ResponseWriter, r *http.Request) error {
+	data, err := s.handleRoute(updateIngestRule, r, s.metrics.updateIngestRule)
+	if err != nil {
+		return err
+	}
+	return s.sendResponse(w, http.StatusOK, data)
+}
+
+func (s *service) deleteIngestRule(w http.ResponseWriter, r *http.Request) error {
+	data, err := s.handleRoute(deleteIngestRule, r, s.metrics.deleteIngestRule)
+	if err != nil {
+		return err
+	}
+	return writeAPIResponse(w, http.StatusOK, data.(string))
+}
+
+func (s *service) fetchIngestRuleHistory(w http.ResponseWriter, r *http.Request) error {
+	data, err := s.handleRoute(fetchIngestRuleHistory, r, s.metrics.fetchIngestRuleHistory)
+	if err != nil {
+		return err
+	}
+	return s.sendResponse(w, http.StatusOK, data)
+}
+
 type route struct {
 	path   string
 	method string
@@ -439,6 +439,120 @@ func (s *store) FetchRollupRuleHistory(
 	return nil, rollupRuleNotFoundError(namespaceID, rollupRuleID)
 }
 
+func (s *store) FetchIngestRule(
+	namespaceID string,
+	ingestRuleID string,
+) (view.IngestRule, error) {
+	ruleset, err := s.FetchRuleSetSnapshot(namespaceID)
+	if err != nil {
+		return view.IngestRule{}, handleUpstreamError(err)
+	}
+
+	for _, ir := range ruleset.IngestRules {
+		if ir.ID == ingestRuleID {
+			return ir, nil
+		}
+	}
+
+	return view.IngestRule{}, ingestRuleNotFoundError(namespaceID, ingestRuleID)
+}
+
+func (s *store) CreateIngestRule(
+	namespaceID string,
+	irv view.IngestRule,
+	uOpts r2store.UpdateOptions,
+) (view.IngestRule, error) {
+	rs, err := s.ruleStore.ReadRuleSet(namespaceID)
+	if err != nil {
+		return view.IngestRule{}, handleUpstreamError(err)
+	}
+
+	mutable := rs.ToMutableRuleSet().Clone()
+	newID, err := mutable.AddIngestRule(irv, s.newUpdateMeta(uOpts))
+	if err != nil {
+		return view.IngestRule{}, handleUpstreamError(err)
+	}
+
+	err = s.ruleStore.WriteRuleSet(mutable)
+	if err != nil {
+		return view.IngestRule{}, handleUpstreamError(err)
+	}
+
+	return s.FetchIngestRule(namespaceID, newID)
+}
+
+func (s *store) UpdateIngestRule(
+	namespaceID,
+	ingestRuleID string,
+	irv view.IngestRule,
+	uOpts r2store.UpdateOptions,
+) (view.IngestRule, error) {
+	rs, err := s.ruleStore.ReadRuleSet(namespaceID)
+	if err != nil {
+		return view.IngestRule{}, handleUpstreamError(err)
+	}
+
+	mutable := rs.ToMutableRuleSet().Clone()
+	err = mutable.UpdateIngestRule(irv, s.newUpdateMeta(uOpts))
+	if err != nil {
+		return view.IngestRule{}, handleUpstreamError(err)
+	}
+
+	err = s.ruleStore.WriteRuleSet(mutable)
+	if err != nil {
+		return view.IngestRule{}, handleUpstreamError(err)
+	}
+
+	return s.FetchIngestRule(namespaceID, ingestRuleID)
+}
+
+func (s *store) DeleteIngestRule(
+	namespaceID string,
+	ingestRuleID string,
+	uOpts r2store.UpdateOptions,
+) error {
+	rs, err := s.ruleStore.ReadRuleSet(namespaceID)
+	if err != nil {
+		return handleUpstreamError(err)
+	}
+
+	mutable := rs.ToMutableRuleSet().Clone()
+	err = mutable.DeleteIngestRule(ingestRuleID, s.newUpdateMeta(uOpts))
+	if err != nil {
+		return handleUpstreamError(err)
+	}
+
+	err = s.ruleStore.WriteRuleSet(mutable)
+	if err != nil {
+		return handleUpstreamError(err)
+	}
+
+	return nil
+}
+
+func (s *store) FetchIngestRuleHistory(
+	namespaceID string,
+	ingestRuleID string,
+) ([]view.IngestRule, error) {
+	rs, err := s.ruleStore.ReadRuleSet(namespaceID)
+	if err != nil {
+		return nil, handleUpstreamError(err)
+	}
+
+	irs, err := rs.IngestRules()
+	if err != nil {
+		return nil, handleUpstreamError(err)
+	}
+
+	for _, ingests := range irs {
+		if len(ingests) > 0 && ingests[0].ID == ingestRuleID {
+			return ingests, nil
+		}
+	}
+
+	return nil, ingestRuleNotFoundError(namespaceID, ingestRuleID)
+}
+
 func (s *store) Close() { s.ruleStore.Close() }
 
 func (s *store) newUpdateMeta(uOpts r2store.UpdateOptions) rules.UpdateMetadata {
@@ -463,6 +577,15 @@ func rollupRuleNotFoundError(namespaceID, rollupRuleID string) error {
 	)
 }
 
+func ingestRuleNotFoundError(namespaceID, ingestRuleID string) error {
+	return r2.NewNotFoundError(
+		fmt.Sprintf("ingest rule: %s doesn't exist in Namespace: %s",
+			ingestRuleID,
+			namespaceID,
+		),
+	)
+}
+
 func handleUpstreamError(err error) error {
 	if err == nil {
 		return nil
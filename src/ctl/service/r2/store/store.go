@@ -77,6 +77,22 @@ type Store interface {
 	// and rule ID.
 	FetchRollupRuleHistory(namespaceID, rollupRuleID string) ([]view.RollupRule, error)
 
+	// FetchIngestRule fetches the ingest rule for the given namespace ID and rule ID.
+	FetchIngestRule(namespaceID, ingestRuleID string) (view.IngestRule, error)
+
+	// CreateIngestRule creates an ingest rule for the given namespace ID and rule data.
+	CreateIngestRule(namespaceID string, irv view.IngestRule, uOpts UpdateOptions) (view.IngestRule, error)
+
+	// UpdateIngestRule updates an ingest rule for the given namespace ID and rule data.
+	UpdateIngestRule(namespaceID, ingestRuleID string, irv view.IngestRule, uOpts UpdateOptions) (view.IngestRule, error)
+
+	// DeleteIngestRule deletes the ingest rule for the given namespace ID and rule ID.
+	DeleteIngestRule(namespaceID, ingestRuleID string, uOpts UpdateOptions) error
+
+	// FetchIngestRuleHistory fetches the history of the ingest rule for the given namespace ID
+	// and rule ID.
+	FetchIngestRuleHistory(namespaceID, ingestRuleID string) ([]view.IngestRule, error)
+
 	// Close closes the store.
 	Close()
 }
@@ -40,6 +40,7 @@ import (
 
 type mappingRuleHistories map[string][]view.MappingRule
 type rollupRuleHistories map[string][]view.RollupRule
+type ingestRuleHistories map[string][]view.IngestRule
 
 type stubData struct {
 	Namespaces        view.Namespaces
@@ -48,6 +49,7 @@ type stubData struct {
 	RuleSets          map[string]view.RuleSet
 	MappingHistory    map[string]mappingRuleHistories
 	RollupHistory     map[string]rollupRuleHistories
+	IngestHistory     map[string]ingestRuleHistories
 }
 
 var (
@@ -694,6 +696,130 @@ func (s *store) FetchRollupRuleHistory(namespaceID, rollupRuleID string) ([]view
 	}
 }
 
+func (s *store) FetchIngestRule(namespaceID, ingestRuleID string) (view.IngestRule, error) {
+	switch namespaceID {
+	case s.data.ErrorNamespace:
+		return view.IngestRule{}, r2.NewInternalError(fmt.Sprintf("Could not fetch ingestRule: %s in namespace: %s", namespaceID, ingestRuleID))
+	default:
+		rs, exists := s.data.RuleSets[namespaceID]
+		if !exists {
+			return view.IngestRule{}, r2.NewNotFoundError(fmt.Sprintf("namespace %s doesn't exist", namespaceID))
+		}
+		for _, r := range rs.IngestRules {
+			if ingestRuleID == r.ID {
+				return r, nil
+			}
+		}
+		return view.IngestRule{}, r2.NewNotFoundError(fmt.Sprintf("ingestRule: %s doesn't exist in Namespace: %s", ingestRuleID, namespaceID))
+	}
+}
+
+func (s *store) CreateIngestRule(
+	namespaceID string,
+	irv view.IngestRule,
+	uOpts r2store.UpdateOptions,
+) (view.IngestRule, error) {
+	switch namespaceID {
+	case s.data.ErrorNamespace:
+		return view.IngestRule{}, r2.NewInternalError("could not create ingest rule")
+	case s.data.ConflictNamespace:
+		return view.IngestRule{}, r2.NewVersionError("namespaces version mismatch")
+	default:
+		rs, exists := s.data.RuleSets[namespaceID]
+		if !exists {
+			return view.IngestRule{}, r2.NewNotFoundError(fmt.Sprintf("namespace %s doesn't exist", namespaceID))
+		}
+		for _, r := range rs.IngestRules {
+			if irv.Name == r.Name {
+				return view.IngestRule{}, r2.NewConflictError(fmt.Sprintf("ingest rule: %s already exists in namespace: %s", irv.Name, namespaceID))
+			}
+		}
+		newRule := irv
+		newRule.ID = uuid.New()
+		newRule.CutoverMillis = time.Now().UnixNano()
+		rs.IngestRules = append(rs.IngestRules, newRule)
+		return newRule, nil
+	}
+}
+
+func (s *store) UpdateIngestRule(
+	namespaceID,
+	ingestRuleID string,
+	irv view.IngestRule,
+	uOpts r2store.UpdateOptions,
+) (view.IngestRule, error) {
+	switch namespaceID {
+	case s.data.ErrorNamespace:
+		return view.IngestRule{}, r2.NewInternalError("could not update ingest rule.")
+	case s.data.ConflictNamespace:
+		return view.IngestRule{}, r2.NewVersionError("namespaces version mismatch")
+	default:
+		rs, exists := s.data.RuleSets[namespaceID]
+		if !exists {
+			return view.IngestRule{}, r2.NewNotFoundError(fmt.Sprintf("namespace %s doesn't exist", namespaceID))
+		}
+
+		for i, r := range rs.IngestRules {
+			if ingestRuleID == r.ID {
+				newRule := irv
+				newRule.ID = ingestRuleID
+				newRule.CutoverMillis = time.Now().UnixNano()
+				rs.IngestRules[i] = newRule
+				return newRule, nil
+			}
+		}
+		return view.IngestRule{}, r2.NewNotFoundError(fmt.Sprintf("ingest rule: %s doesn't exist in namespace: %s", ingestRuleID, namespaceID))
+	}
+}
+
+func (s *store) DeleteIngestRule(
+	namespaceID,
+	ingestRuleID string,
+	uOpts r2store.UpdateOptions,
+) error {
+	switch namespaceID {
+	case s.data.ErrorNamespace:
+		return r2.NewInternalError("could not delete ingest rule.")
+	case s.data.ConflictNamespace:
+		return r2.NewVersionError("namespaces version mismatch")
+	default:
+		rs, exists := s.data.RuleSets[namespaceID]
+		if !exists {
+			return r2.NewNotFoundError(fmt.Sprintf("namespace %s doesn't exist", namespaceID))
+		}
+
+		foundIdx := -1
+		for i, rule := range rs.IngestRules {
+			if rule.ID == ingestRuleID {
+				foundIdx = i
+				break
+			}
+		}
+		if foundIdx == -1 {
+			return r2.NewNotFoundError(fmt.Sprintf("ingest rule: %s doesn't exist in namespace: %s", ingestRuleID, namespaceID))
+		}
+		rs.IngestRules = append(rs.IngestRules[:foundIdx], rs.IngestRules[foundIdx+1:]...)
+		return nil
+	}
+}
+
+func (s *store) FetchIngestRuleHistory(namespaceID, ingestRuleID string) ([]view.IngestRule, error) {
+	switch namespaceID {
+	case s.data.ErrorNamespace:
+		return nil, r2.NewInternalError(fmt.Sprintf("Could not fetch ingestRule: %s in namespace: %s", namespaceID, ingestRuleID))
+	default:
+		ns, exists := s.data.IngestHistory[namespaceID]
+		if !exists {
+			return nil, r2.NewNotFoundError(fmt.Sprintf("namespace %s doesn't exist", namespaceID))
+		}
+		hist, exists := ns[ingestRuleID]
+		if !exists {
+			return nil, r2.NewNotFoundError(fmt.Sprintf("ingestRule: %s doesn't exist in Namespace: %s", ingestRuleID, namespaceID))
+		}
+		return hist, nil
+	}
+}
+
 func (s *store) Close() {}
 
 // nolint: unparam
@@ -0,0 +1,81 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"sync"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+)
+
+type cardinalityOp struct {
+	request      rpc.CardinalityRequest
+	completionFn completionFn
+}
+
+func (c *cardinalityOp) Size() int {
+	// Cardinality is always a single op
+	return 1
+}
+
+func (c *cardinalityOp) CompletionFn() completionFn {
+	return c.completionFn
+}
+
+// cardinalityResultAccumulator collects the cardinality results of every
+// shard from the hosts. Each shard is counted by every replica that owns it
+// so only the replica result with the most series is kept per shard, which
+// prefers replicas that have finished indexing the shard, and the shard
+// results are only summed and truncated once every host has responded.
+type cardinalityResultAccumulator struct {
+	sync.Mutex
+
+	shards map[uint32]index.CardinalityShardResult
+}
+
+func newCardinalityResultAccumulator() *cardinalityResultAccumulator {
+	return &cardinalityResultAccumulator{
+		shards: make(map[uint32]index.CardinalityShardResult),
+	}
+}
+
+func (a *cardinalityResultAccumulator) Add(results []index.CardinalityShardResult) {
+	a.Lock()
+	for _, r := range results {
+		existing, ok := a.shards[r.Shard]
+		if ok && existing.NumSeries >= r.NumSeries {
+			continue
+		}
+		a.shards[r.Shard] = r
+	}
+	a.Unlock()
+}
+
+func (a *cardinalityResultAccumulator) Result(limit int) index.CardinalityResult {
+	a.Lock()
+	defer a.Unlock()
+	results := make([]index.CardinalityShardResult, 0, len(a.shards))
+	for _, r := range a.shards {
+		results = append(results, r)
+	}
+	return index.SumCardinalityShardResults(results, limit)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/m3db/m3/src/dbnode/storage/index"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testCardinalityShardResult(
+	shard uint32,
+	names map[string]int64,
+) index.CardinalityShardResult {
+	var (
+		numSeries int64
+		entries   []index.CardinalityEntry
+	)
+	for name, n := range names {
+		numSeries += n
+		entries = append(entries, index.CardinalityEntry{
+			Name:      []byte(name),
+			NumSeries: n,
+		})
+	}
+	return index.CardinalityShardResult{
+		Shard: shard,
+		CardinalityResult: index.CardinalityResult{
+			NumSeries:   numSeries,
+			MetricNames: index.TopCardinalityEntries(entries, 0),
+		},
+	}
+}
+
+func TestCardinalityResultAccumulatorDedupesReplicas(t *testing.T) {
+	acc := newCardinalityResultAccumulator()
+
+	// Host a owns shards 0 and 1, host b owns shards 1 and 2 and is still
+	// indexing shard 1, host c owns shards 0 and 2.
+	acc.Add([]index.CardinalityShardResult{
+		testCardinalityShardResult(0, map[string]int64{"foo": 3}),
+		testCardinalityShardResult(1, map[string]int64{"foo": 1, "bar": 4}),
+	})
+	acc.Add([]index.CardinalityShardResult{
+		testCardinalityShardResult(1, map[string]int64{"bar": 2}),
+		testCardinalityShardResult(2, map[string]int64{"baz": 2}),
+	})
+	acc.Add([]index.CardinalityShardResult{
+		testCardinalityShardResult(0, map[string]int64{"foo": 3}),
+		testCardinalityShardResult(2, map[string]int64{"baz": 2}),
+	})
+
+	result := acc.Result(0)
+	require.Equal(t, int64(10), result.NumSeries)
+	require.Equal(t, []index.CardinalityEntry{
+		{Name: []byte("bar"), NumSeries: 4},
+		{Name: []byte("foo"), NumSeries: 4},
+		{Name: []byte("baz"), NumSeries: 2},
+	}, result.MetricNames)
+
+	result = acc.Result(1)
+	require.Equal(t, int64(10), result.NumSeries)
+	require.Equal(t, []index.CardinalityEntry{
+		{Name: []byte("bar"), NumSeries: 4},
+	}, result.MetricNames)
+}
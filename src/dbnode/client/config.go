@@ -70,6 +70,12 @@ type Configuration struct {
 	// FetchRetry is the fetch retry config.
 	FetchRetry *retry.Configuration `yaml:"fetchRetry"`
 
+	// ReadRepair is the read repair config.
+	ReadRepair *ReadRepairConfiguration `yaml:"readRepair"`
+
+	// HedgedReads is the hedged and isolation group aware reads config.
+	HedgedReads *HedgedReadsConfiguration `yaml:"hedgedReads"`
+
 	// BackgroundHealthCheckFailLimit is the amount of times a background check
 	// must fail before a connection is taken out of consideration.
 	BackgroundHealthCheckFailLimit *int `yaml:"backgroundHealthCheckFailLimit"`
@@ -83,6 +89,10 @@ type Configuration struct {
 
 	// Proto contains the configuration specific to running in the ProtoDataMode.
 	Proto *ProtoConfiguration `yaml:"proto"`
+
+	// Histogram contains the configuration specific to running in the
+	// histogram data mode.
+	Histogram *HistogramConfiguration `yaml:"histogram"`
 }
 
 // ProtoConfiguration is the configuration for running with ProtoDataMode enabled.
@@ -91,6 +101,50 @@ type ProtoConfiguration struct {
 	Enabled bool `yaml:"enabled"`
 }
 
+// HistogramConfiguration is the configuration for reading from nodes with
+// the histogram data mode enabled, where each series is either encoded as
+// sparse histograms or with m3tsz.
+type HistogramConfiguration struct {
+	// Whether histogram encoding is enabled.
+	Enabled bool `yaml:"enabled"`
+}
+
+// ReadRepairConfiguration is the configuration for repairing replicas that
+// return divergent data during fetches.
+type ReadRepairConfiguration struct {
+	// Enabled enables read repair for fetches at a read consistency level
+	// above one.
+	Enabled bool `yaml:"enabled"`
+
+	// Concurrency is the number of read repairs that can be performed
+	// concurrently in the background.
+	Concurrency *int `yaml:"concurrency"`
+}
+
+// HedgedReadsConfiguration is the configuration for reading from the replicas
+// required by the read consistency level first, preferring replicas in the
+// caller's isolation group, and hedging to further replicas when slow.
+type HedgedReadsConfiguration struct {
+	// Enabled enables hedged reads for fetches by ID, fetches by tag query
+	// and aggregates still query every host. Hedged reads cannot be enabled
+	// together with read repair.
+	Enabled bool `yaml:"enabled"`
+
+	// IsolationGroup is the isolation group of the caller, replicas in the
+	// same isolation group are preferred.
+	IsolationGroup string `yaml:"isolationGroup"`
+
+	// Percentile is the per host latency percentile after which a hedged
+	// request is sent to another replica.
+	Percentile *float64 `yaml:"percentile"`
+
+	// MinDelay is the minimum delay before a hedged request is sent.
+	MinDelay *time.Duration `yaml:"minDelay"`
+
+	// MaxDelay is the maximum delay before a hedged request is sent.
+	MaxDelay *time.Duration `yaml:"maxDelay"`
+}
+
 // Validate validates the ProtoConfiguration.
 func (c *ProtoConfiguration) Validate() error {
 	if c == nil {
@@ -128,6 +182,23 @@ func (c *Configuration) Validate() error {
 			*c.BackgroundHealthCheckFailThrottleFactor)
 	}
 
+	if c.ReadRepair != nil && c.ReadRepair.Concurrency != nil &&
+		*c.ReadRepair.Concurrency <= 0 {
+		return fmt.Errorf("m3db client readRepair concurrency was: %d but must be > 0",
+			*c.ReadRepair.Concurrency)
+	}
+
+	if c.HedgedReads != nil && c.HedgedReads.Percentile != nil &&
+		(*c.HedgedReads.Percentile <= 0 || *c.HedgedReads.Percentile > 1) {
+		return fmt.Errorf("m3db client hedgedReads percentile was: %f but must be > 0 and <= 1",
+			*c.HedgedReads.Percentile)
+	}
+
+	if c.HedgedReads != nil && c.HedgedReads.Enabled &&
+		c.ReadRepair != nil && c.ReadRepair.Enabled {
+		return errors.New("m3db client hedgedReads and readRepair cannot both be enabled")
+	}
+
 	if err := c.Proto.Validate(); err != nil {
 		return fmt.Errorf("error validating M3DB client proto configuration: %v", err)
 	}
@@ -271,6 +342,25 @@ func (c Configuration) NewAdminClient(
 	if c.FetchRetry != nil {
 		v = v.SetFetchRetrier(c.FetchRetry.NewRetrier(fetchRequestScope))
 	}
+	if c.ReadRepair != nil {
+		v = v.SetReadRepairEnabled(c.ReadRepair.Enabled)
+		if c.ReadRepair.Concurrency != nil {
+			v = v.SetReadRepairConcurrency(*c.ReadRepair.Concurrency)
+		}
+	}
+	if c.HedgedReads != nil {
+		v = v.SetHedgedReadsEnabled(c.HedgedReads.Enabled).
+			SetReadIsolationGroup(c.HedgedReads.IsolationGroup)
+		if c.HedgedReads.Percentile != nil {
+			v = v.SetHedgedReadPercentile(*c.HedgedReads.Percentile)
+		}
+		if c.HedgedReads.MinDelay != nil {
+			v = v.SetHedgedReadMinDelay(*c.HedgedReads.MinDelay)
+		}
+		if c.HedgedReads.MaxDelay != nil {
+			v = v.SetHedgedReadMaxDelay(*c.HedgedReads.MaxDelay)
+		}
+	}
 
 	encodingOpts := params.EncodingOptions
 	if encodingOpts == nil {
@@ -285,6 +375,9 @@ func (c Configuration) NewAdminClient(
 	if c.Proto != nil && c.Proto.Enabled {
 		v = v.SetEncodingProto(encodingOpts)
 	}
+	if c.Histogram != nil && c.Histogram.Enabled {
+		v = v.SetEncodingHistogram(encodingOpts)
+	}
 
 	// Apply programtic custom options last
 	opts := v.(AdminOptions)
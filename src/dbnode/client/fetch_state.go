@@ -219,6 +219,26 @@ func (f *fetchState) asEncodingSeriesIterators(pools fetchTaggedPools, descr nam
 	return f.tagResultAccumulator.AsEncodingSeriesIterators(limit, pools, descr)
 }
 
+// enqueueReadRepairs enqueues read repairs for the fetched series, it must
+// be called after asEncodingSeriesIterators while the fetched segments are
+// still referenced by the returned iterators.
+func (f *fetchState) enqueueReadRepairs(
+	repairer *readRepairer,
+	descr namespace.SchemaDescr,
+) {
+	if repairer == nil {
+		return
+	}
+
+	f.Lock()
+	requests := f.tagResultAccumulator.ReadRepairRequests(descr)
+	f.Unlock()
+
+	for _, req := range requests {
+		repairer.enqueue(req)
+	}
+}
+
 func (f *fetchState) asAggregatedTagsIterator(pools fetchTaggedPools) (AggregatedTagsIterator, bool, error) {
 	f.Lock()
 	defer f.Unlock()
@@ -64,6 +64,10 @@ type fetchTaggedResultAccumulator struct {
 	aggResponses   aggregateResults
 	exhaustive     bool
 
+	// responseHosts tracks the host that returned each fetch response, it is
+	// only allocated when responses are to be read repaired.
+	responseHosts map[*rpc.FetchTaggedIDResult_]topology.Host
+
 	startTime        time.Time
 	endTime          time.Time
 	majority         int
@@ -90,6 +94,9 @@ func (accum *fetchTaggedResultAccumulator) AddFetchTaggedResponse(
 		accum.exhaustive = accum.exhaustive && opts.response.Exhaustive
 		for _, elem := range opts.response.Elements {
 			accum.fetchResponses = append(accum.fetchResponses, elem)
+			if accum.responseHosts != nil {
+				accum.responseHosts[elem] = opts.host
+			}
 		}
 	}
 
@@ -202,6 +209,7 @@ func (accum *fetchTaggedResultAccumulator) Clear() {
 		accum.aggResponses[i] = nil
 	}
 	accum.aggResponses = accum.aggResponses[:0]
+	accum.responseHosts = nil
 	for i := range accum.errors {
 		accum.errors[i] = nil
 	}
@@ -243,6 +251,51 @@ func (accum *fetchTaggedResultAccumulator) Reset(
 	}
 }
 
+// TrackResponseHosts tracks the host that returned each fetch response so
+// that the responses can be read repaired.
+func (accum *fetchTaggedResultAccumulator) TrackResponseHosts() {
+	accum.responseHosts = make(map[*rpc.FetchTaggedIDResult_]topology.Host)
+}
+
+// ReadRepairRequests returns a read repair request for each series returned
+// by more than one host, it must only be called after responses have been
+// sorted by ID by converting them to iterators.
+func (accum *fetchTaggedResultAccumulator) ReadRepairRequests(
+	descr namespace.SchemaDescr,
+) []readRepairRequest {
+	if accum.responseHosts == nil {
+		return nil
+	}
+
+	var requests []readRepairRequest
+	accum.fetchResponses.forEachID(func(elems fetchTaggedIDResults, _ bool) bool {
+		if len(elems) < 2 {
+			return true
+		}
+		replicas := make([]readRepairReplica, 0, len(elems))
+		for _, elem := range elems {
+			host, ok := accum.responseHosts[elem]
+			if !ok {
+				continue
+			}
+			replicas = append(replicas, readRepairReplica{
+				host:     host,
+				segments: elem.Segments,
+			})
+		}
+		elem := elems[0]
+		requests = append(requests, readRepairRequest{
+			namespace:   ident.BytesID(elem.NameSpace),
+			id:          ident.BytesID(elem.ID),
+			encodedTags: elem.EncodedTags,
+			schema:      descr,
+			replicas:    replicas,
+		})
+		return true
+	})
+	return requests
+}
+
 func (accum *fetchTaggedResultAccumulator) sliceResponsesAsSeriesIter(
 	pools fetchTaggedPools,
 	elems fetchTaggedIDResults,
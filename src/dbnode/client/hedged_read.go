@@ -0,0 +1,612 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	// hostLatencySampleSize is the number of fetch latencies retained per host.
+	hostLatencySampleSize = 256
+	// hostLatencyMinSamples is the number of fetch latencies required for a
+	// host before its latency percentile is used to compute the hedge delay.
+	hostLatencyMinSamples = 16
+	// slowHostFactor is the factor of the fastest replica's median latency
+	// above which a replica is considered slow and read from last.
+	slowHostFactor = 2
+)
+
+// hedgedReader decides which replicas a fetch reads from and when to hedge.
+// Fetches read from only as many replicas as the read consistency level
+// requires, preferring replicas in the caller's isolation group and replicas
+// that are not slow. If a replica fails another is read from immediately, if
+// a replica has not responded after the hedge delay another is read from as
+// well and whichever responds first is used. The hedge delay is the highest
+// latency percentile of the hosts read from, clamped between the configured
+// min and max delay.
+type hedgedReader struct {
+	isolationGroup string
+	percentile     float64
+	minDelay       time.Duration
+	maxDelay       time.Duration
+	latencies      *hostLatencies
+	rotation       uint32
+	metrics        hedgedReaderMetrics
+}
+
+type hedgedReaderMetrics struct {
+	hedged        tally.Counter
+	retried       tally.Counter
+	lateResponses tally.Counter
+}
+
+func newHedgedReaderMetrics(scope tally.Scope) hedgedReaderMetrics {
+	return hedgedReaderMetrics{
+		hedged:        scope.Counter("hedged"),
+		retried:       scope.Counter("retried"),
+		lateResponses: scope.Counter("late-responses"),
+	}
+}
+
+func newHedgedReader(opts Options) *hedgedReader {
+	scope := opts.InstrumentOptions().MetricsScope().SubScope("hedged-read")
+	return &hedgedReader{
+		isolationGroup: opts.ReadIsolationGroup(),
+		percentile:     opts.HedgedReadPercentile(),
+		minDelay:       opts.HedgedReadMinDelay(),
+		maxDelay:       opts.HedgedReadMaxDelay(),
+		latencies:      newHostLatencies(),
+		metrics:        newHedgedReaderMetrics(scope),
+	}
+}
+
+// orderReplicas orders replicas by preference in place, replicas that are
+// equally preferred are rotated between calls to spread load across them.
+func (r *hedgedReader) orderReplicas(replicas []topology.Host) {
+	if len(replicas) < 2 {
+		return
+	}
+
+	offset := int(atomic.AddUint32(&r.rotation, 1) % uint32(len(replicas)))
+	ranked := make([]rankedReplica, 0, len(replicas))
+	for i := range replicas {
+		ranked = append(ranked, rankedReplica{host: replicas[(i+offset)%len(replicas)]})
+	}
+
+	var (
+		fastest  time.Duration
+		medians  = make([]time.Duration, len(ranked))
+		measured = make([]bool, len(ranked))
+	)
+	for i, replica := range ranked {
+		medians[i], measured[i] = r.latencies.percentile(replica.host.ID(), 0.5)
+		if measured[i] && (fastest == 0 || medians[i] < fastest) {
+			fastest = medians[i]
+		}
+	}
+
+	for i := range ranked {
+		if measured[i] && medians[i] > slowHostFactor*fastest {
+			ranked[i].rank += 2
+		}
+		if r.isolationGroup != "" &&
+			ranked[i].host.IsolationGroup() != r.isolationGroup {
+			ranked[i].rank++
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].rank < ranked[j].rank
+	})
+	for i := range ranked {
+		replicas[i] = ranked[i].host
+	}
+}
+
+type rankedReplica struct {
+	host topology.Host
+	rank int
+}
+
+// hedgeDelay returns the delay after which to hedge reads to the hosts.
+func (r *hedgedReader) hedgeDelay(hosts map[string]struct{}) time.Duration {
+	var delay time.Duration
+	for id := range hosts {
+		value, ok := r.latencies.percentile(id, r.percentile)
+		if !ok {
+			return r.maxDelay
+		}
+		if value > delay {
+			delay = value
+		}
+	}
+	if delay < r.minDelay {
+		return r.minDelay
+	}
+	if delay > r.maxDelay {
+		return r.maxDelay
+	}
+	return delay
+}
+
+// requiredReplicas returns the number of replicas that must successfully
+// respond for a fetch to not need to read from any further replicas.
+func requiredReplicas(
+	level topology.ReadConsistencyLevel,
+	majority, replicas int,
+) int {
+	required := replicas
+	switch level {
+	case topology.ReadConsistencyLevelOne, topology.ReadConsistencyLevelNone:
+		required = 1
+	case topology.ReadConsistencyLevelMajority, topology.ReadConsistencyLevelUnstrictMajority:
+		required = majority
+	}
+	if required > replicas {
+		return replicas
+	}
+	return required
+}
+
+// hostLatencies tracks recent successful fetch latencies per host.
+type hostLatencies struct {
+	sync.RWMutex
+	hosts map[string]*hostLatency
+}
+
+type hostLatency struct {
+	sync.Mutex
+	samples []time.Duration
+	next    int
+}
+
+func newHostLatencies() *hostLatencies {
+	return &hostLatencies{hosts: make(map[string]*hostLatency)}
+}
+
+func (l *hostLatencies) record(hostID string, value time.Duration) {
+	l.RLock()
+	host, ok := l.hosts[hostID]
+	l.RUnlock()
+	if !ok {
+		l.Lock()
+		host, ok = l.hosts[hostID]
+		if !ok {
+			host = &hostLatency{
+				samples: make([]time.Duration, 0, hostLatencySampleSize),
+			}
+			l.hosts[hostID] = host
+		}
+		l.Unlock()
+	}
+
+	host.Lock()
+	if len(host.samples) < cap(host.samples) {
+		host.samples = append(host.samples, value)
+	} else {
+		host.samples[host.next] = value
+		host.next = (host.next + 1) % len(host.samples)
+	}
+	host.Unlock()
+}
+
+// percentile returns the latency percentile of the host and whether enough
+// samples have been recorded for the host to compute it.
+func (l *hostLatencies) percentile(hostID string, p float64) (time.Duration, bool) {
+	l.RLock()
+	host, ok := l.hosts[hostID]
+	l.RUnlock()
+	if !ok {
+		return 0, false
+	}
+
+	host.Lock()
+	if len(host.samples) < hostLatencyMinSamples {
+		host.Unlock()
+		return 0, false
+	}
+	sorted := make([]time.Duration, len(host.samples))
+	copy(sorted, host.samples)
+	host.Unlock()
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx], true
+}
+
+// hedgedFetch is the state of fetching a single series from its replicas.
+type hedgedFetch struct {
+	sync.Mutex
+	id          ident.ID
+	replicas    []topology.Host
+	next        int
+	required    int
+	outstanding int
+	results     []encoding.MultiReaderIterator
+	errs        []error
+	done        bool
+}
+
+// takeReplicasWithLock returns up to n replicas not yet read from.
+func (f *hedgedFetch) takeReplicasWithLock(n int) []topology.Host {
+	if remaining := len(f.replicas) - f.next; n > remaining {
+		n = remaining
+	}
+	if n <= 0 {
+		return nil
+	}
+	hosts := f.replicas[f.next : f.next+n]
+	f.next += n
+	f.outstanding += n
+	return hosts
+}
+
+type hedgedFetchRequest struct {
+	fetch *hedgedFetch
+	host  topology.Host
+}
+
+// hedgedFetchAttempt is a single attempt at fetching a set of series.
+type hedgedFetchAttempt struct {
+	sync.Mutex
+	session    *session
+	nsCtx      namespace.Context
+	namespace  ident.ID
+	rangeStart int64
+	rangeEnd   int64
+	fetches    []*hedgedFetch
+	pending    int32
+	retries    []*hedgedFetch
+	retryCh    chan struct{}
+	doneCh     chan struct{}
+	refs       int32
+}
+
+func (s *session) fetchIDsAttemptHedged(
+	inputNamespace ident.ID,
+	inputIDs ident.Iterator,
+	startInclusive, endExclusive time.Time,
+) (encoding.SeriesIterators, error) {
+	startFetchAttempt := s.nowFn()
+
+	rangeStart, err := convert.ToValue(startInclusive, rpc.TimeType_UNIX_NANOSECONDS)
+	if err != nil {
+		return nil, err
+	}
+
+	rangeEnd, err := convert.ToValue(endExclusive, rpc.TimeType_UNIX_NANOSECONDS)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := inputIDs.Duplicate()
+
+	s.state.RLock()
+	if s.state.status != statusOpen {
+		s.state.RUnlock()
+		return nil, errSessionStatusNotOpen
+	}
+
+	var (
+		level    = s.state.readLevel
+		majority = s.state.majority
+		a        = &hedgedFetchAttempt{
+			session:    s,
+			nsCtx:      namespace.NewContextFor(inputNamespace, s.opts.SchemaRegistry()),
+			namespace:  s.pools.id.Clone(inputNamespace),
+			rangeStart: rangeStart,
+			rangeEnd:   rangeEnd,
+			fetches:    make([]*hedgedFetch, 0, ids.Remaining()),
+			retryCh:    make(chan struct{}, 1),
+			doneCh:     make(chan struct{}),
+			refs:       1, // reference held by the current go-routine
+		}
+		initial = make([]hedgedFetchRequest, 0, ids.Remaining())
+		hosts   = make(map[string]struct{})
+	)
+	for ids.Next() {
+		f := &hedgedFetch{id: s.pools.id.Clone(ids.Current())}
+		a.fetches = append(a.fetches, f)
+		if err := s.state.topoMap.RouteForEach(f.id, func(_ int, host topology.Host) {
+			f.replicas = append(f.replicas, host)
+		}); err != nil {
+			s.state.RUnlock()
+			a.decRef()
+			return nil, err
+		}
+
+		s.hedgedReader.orderReplicas(f.replicas)
+		f.required = requiredReplicas(level, majority, len(f.replicas))
+		if f.required == 0 {
+			f.done = true
+			continue
+		}
+
+		a.pending++
+		for _, host := range f.takeReplicasWithLock(f.required) {
+			initial = append(initial, hedgedFetchRequest{fetch: f, host: host})
+			hosts[host.ID()] = struct{}{}
+		}
+	}
+	if a.pending == 0 {
+		close(a.doneCh)
+	}
+
+	delay := s.hedgedReader.hedgeDelay(hosts)
+	a.dispatchWithRLock(initial)
+	s.state.RUnlock()
+
+	hedgeTimer := time.NewTimer(delay)
+	defer hedgeTimer.Stop()
+
+	for waiting := true; waiting; {
+		select {
+		case <-a.doneCh:
+			waiting = false
+		case <-hedgeTimer.C:
+			if requests := a.hedgeRequests(); len(requests) > 0 {
+				s.hedgedReader.metrics.hedged.Inc(int64(len(requests)))
+				a.dispatch(requests)
+				hedgeTimer.Reset(delay)
+			}
+		case <-a.retryCh:
+			if requests := a.retryRequests(); len(requests) > 0 {
+				s.hedgedReader.metrics.retried.Inc(int64(len(requests)))
+				a.dispatch(requests)
+			}
+		}
+	}
+
+	var (
+		iters    = s.pools.seriesIterators.Get(len(a.fetches))
+		firstErr error
+	)
+	iters.Reset(len(a.fetches))
+	for idx, f := range a.fetches {
+		f.Lock()
+		var (
+			responded = len(f.results) + len(f.errs)
+			err       error
+		)
+		if !topology.ReadConsistencyAchieved(level, majority,
+			len(f.replicas), len(f.results)) {
+			err = newConsistencyResultError(level, len(f.replicas), responded, f.errs)
+		}
+		s.recordFetchMetrics(err, int32(len(f.errs)), startFetchAttempt)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		if firstErr == nil {
+			iter := s.pools.seriesIterator.Get()
+			iter.Reset(encoding.SeriesIteratorOptions{
+				ID:             s.pools.id.Clone(f.id),
+				Namespace:      s.pools.id.Clone(a.namespace),
+				StartInclusive: startInclusive,
+				EndExclusive:   endExclusive,
+				Replicas:       f.results,
+			})
+			iters.SetAt(idx, iter)
+			// Ownership of the results is transferred to the series iterator.
+			f.results = nil
+		}
+		f.Unlock()
+	}
+	a.decRef()
+
+	if firstErr != nil {
+		iters.Close()
+		return nil, firstErr
+	}
+	return iters, nil
+}
+
+// hedgeRequests returns a request to another replica for every series that
+// has not yet completed.
+func (a *hedgedFetchAttempt) hedgeRequests() []hedgedFetchRequest {
+	var requests []hedgedFetchRequest
+	for _, f := range a.fetches {
+		f.Lock()
+		if !f.done {
+			for _, host := range f.takeReplicasWithLock(1) {
+				requests = append(requests, hedgedFetchRequest{fetch: f, host: host})
+			}
+		}
+		f.Unlock()
+	}
+	return requests
+}
+
+// retryRequests returns requests to further replicas for every series that
+// can no longer complete with the requests that are outstanding.
+func (a *hedgedFetchAttempt) retryRequests() []hedgedFetchRequest {
+	a.Lock()
+	retries := a.retries
+	a.retries = nil
+	a.Unlock()
+
+	var requests []hedgedFetchRequest
+	for _, f := range retries {
+		f.Lock()
+		if !f.done {
+			n := f.required - len(f.results) - f.outstanding
+			for _, host := range f.takeReplicasWithLock(n) {
+				requests = append(requests, hedgedFetchRequest{fetch: f, host: host})
+			}
+		}
+		f.Unlock()
+	}
+	return requests
+}
+
+func (a *hedgedFetchAttempt) dispatch(requests []hedgedFetchRequest) {
+	a.session.state.RLock()
+	a.dispatchWithRLock(requests)
+	a.session.state.RUnlock()
+}
+
+func (a *hedgedFetchAttempt) dispatchWithRLock(requests []hedgedFetchRequest) {
+	var (
+		s         = a.session
+		now       = s.nowFn()
+		opsByHost = make(map[string][]*fetchBatchOp)
+	)
+	for _, req := range requests {
+		hostID := req.host.ID()
+		ops := opsByHost[hostID]
+
+		var op *fetchBatchOp
+		if len(ops) > 0 {
+			op = ops[len(ops)-1]
+		}
+		if op == nil || op.Size() >= s.fetchBatchSize {
+			op = s.pools.fetchBatchOp.Get()
+			op.IncRef()
+			op.request.RangeStart = a.rangeStart
+			op.request.RangeEnd = a.rangeEnd
+			op.request.RangeTimeType = rpc.TimeType_UNIX_NANOSECONDS
+			opsByHost[hostID] = append(ops, op)
+		}
+
+		// Inc to indicate the op has a reference to the attempt.
+		atomic.AddInt32(&a.refs, 1)
+		op.append(a.namespace.Bytes(), req.fetch.id.Bytes(),
+			a.completionFn(req.fetch, req.host, now))
+	}
+
+	for hostID, ops := range opsByHost {
+		queue, ok := s.state.queuesByHostID[hostID]
+		for _, op := range ops {
+			var err error
+			if s.state.status != statusOpen {
+				err = errSessionStatusNotOpen
+			} else if !ok {
+				// The host left the topology since the replicas were routed.
+				err = errSessionHasNoHostQueueForHost
+			} else {
+				err = queue.Enqueue(op)
+			}
+			if err != nil {
+				op.completeAll(nil, err)
+			}
+			// Passing ownership of the op itself to the host queue.
+			op.DecRef()
+		}
+	}
+}
+
+func (a *hedgedFetchAttempt) completionFn(
+	f *hedgedFetch,
+	host topology.Host,
+	sent time.Time,
+) completionFn {
+	return func(result interface{}, err error) {
+		if err == nil {
+			a.session.hedgedReader.latencies.record(host.ID(),
+				a.session.nowFn().Sub(sent))
+		}
+		a.complete(f, result, err)
+		a.decRef()
+	}
+}
+
+func (a *hedgedFetchAttempt) complete(f *hedgedFetch, result interface{}, err error) {
+	f.Lock()
+	f.outstanding--
+	if f.done {
+		f.Unlock()
+		a.session.hedgedReader.metrics.lateResponses.Inc(1)
+		return
+	}
+
+	if err != nil {
+		f.errs = append(f.errs, err)
+	} else {
+		s := a.session
+		slicesIter := s.pools.readerSliceOfSlicesIterator.Get()
+		slicesIter.Reset(result.([]*rpc.Segments))
+		multiIter := s.pools.multiReaderIterator.Get()
+		multiIter.ResetSliceOfSlices(slicesIter, a.nsCtx.Schema)
+		f.results = append(f.results, multiIter)
+	}
+
+	var finished, retry bool
+	switch {
+	case len(f.results) >= f.required:
+		finished = true
+	case f.outstanding < f.required-len(f.results) && f.next < len(f.replicas):
+		retry = true
+	case f.outstanding == 0:
+		// No replicas left to read from.
+		finished = true
+	}
+	f.done = finished
+	f.Unlock()
+
+	if finished {
+		if atomic.AddInt32(&a.pending, -1) == 0 {
+			close(a.doneCh)
+		}
+		return
+	}
+	if retry {
+		a.Lock()
+		a.retries = append(a.retries, f)
+		a.Unlock()
+		select {
+		case a.retryCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (a *hedgedFetchAttempt) decRef() {
+	if atomic.AddInt32(&a.refs, -1) != 0 {
+		return
+	}
+	for _, f := range a.fetches {
+		// Close any results that were not transferred to a series iterator.
+		for _, iter := range f.results {
+			iter.Close()
+		}
+		f.results = nil
+		f.id.Finalize()
+	}
+	a.namespace.Finalize()
+}
@@ -0,0 +1,298 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostLatenciesPercentile(t *testing.T) {
+	l := newHostLatencies()
+
+	_, ok := l.percentile("a", 0.5)
+	assert.False(t, ok)
+
+	for i := 1; i < hostLatencyMinSamples; i++ {
+		l.record("a", time.Duration(i)*time.Millisecond)
+	}
+	_, ok = l.percentile("a", 0.5)
+	assert.False(t, ok)
+
+	l.record("a", hostLatencyMinSamples*time.Millisecond)
+	value, ok := l.percentile("a", 0.5)
+	require.True(t, ok)
+	assert.Equal(t, 8*time.Millisecond, value)
+
+	value, ok = l.percentile("a", 1)
+	require.True(t, ok)
+	assert.Equal(t, hostLatencyMinSamples*time.Millisecond, value)
+
+	// Older samples are replaced once the sample size is reached.
+	for i := 0; i < hostLatencySampleSize; i++ {
+		l.record("a", time.Second)
+	}
+	value, ok = l.percentile("a", 0)
+	require.True(t, ok)
+	assert.Equal(t, time.Second, value)
+}
+
+func TestHedgedReaderOrderReplicasPrefersIsolationGroup(t *testing.T) {
+	r := newHedgedReader(NewOptions().SetReadIsolationGroup("a"))
+
+	var (
+		local  = topology.NewHostWithIsolationGroup("local", "local:9000", "a")
+		remote = []topology.Host{
+			topology.NewHostWithIsolationGroup("remote0", "remote0:9000", "b"),
+			topology.NewHostWithIsolationGroup("remote1", "remote1:9000", "c"),
+		}
+		seen = make(map[string]struct{})
+	)
+	for i := 0; i < 6; i++ {
+		replicas := []topology.Host{remote[0], local, remote[1]}
+		r.orderReplicas(replicas)
+		assert.Equal(t, local.ID(), replicas[0].ID())
+		seen[replicas[1].ID()] = struct{}{}
+	}
+
+	// Equally preferred replicas are rotated.
+	assert.Equal(t, 2, len(seen))
+}
+
+func TestHedgedReaderOrderReplicasSlowHostsLast(t *testing.T) {
+	r := newHedgedReader(NewOptions().SetReadIsolationGroup("a"))
+
+	var (
+		slow   = topology.NewHostWithIsolationGroup("slow", "slow:9000", "a")
+		fast   = topology.NewHostWithIsolationGroup("fast", "fast:9000", "b")
+		remote = topology.NewHostWithIsolationGroup("remote", "remote:9000", "c")
+	)
+	for i := 0; i < hostLatencyMinSamples; i++ {
+		r.latencies.record(slow.ID(), 100*time.Millisecond)
+		r.latencies.record(fast.ID(), 10*time.Millisecond)
+	}
+
+	replicas := []topology.Host{slow, remote, fast}
+	r.orderReplicas(replicas)
+	assert.Equal(t, []string{"remote", "fast", "slow"},
+		[]string{replicas[0].ID(), replicas[1].ID(), replicas[2].ID()})
+}
+
+func TestHedgedReaderHedgeDelay(t *testing.T) {
+	opts := NewOptions().
+		SetHedgedReadPercentile(0.9).
+		SetHedgedReadMinDelay(5 * time.Millisecond).
+		SetHedgedReadMaxDelay(time.Second)
+	r := newHedgedReader(opts)
+
+	hosts := map[string]struct{}{"a": {}, "b": {}}
+	assert.Equal(t, time.Second, r.hedgeDelay(hosts))
+
+	for i := 0; i < hostLatencyMinSamples; i++ {
+		r.latencies.record("a", time.Millisecond)
+		r.latencies.record("b", 20*time.Millisecond)
+	}
+	assert.Equal(t, 20*time.Millisecond, r.hedgeDelay(hosts))
+	assert.Equal(t, 5*time.Millisecond, r.hedgeDelay(map[string]struct{}{"a": {}}))
+
+	for i := 0; i < hostLatencyMinSamples; i++ {
+		r.latencies.record("b", time.Minute)
+	}
+	assert.Equal(t, time.Second, r.hedgeDelay(hosts))
+}
+
+func TestRequiredReplicas(t *testing.T) {
+	tests := []struct {
+		level    topology.ReadConsistencyLevel
+		expected int
+	}{
+		{topology.ReadConsistencyLevelNone, 1},
+		{topology.ReadConsistencyLevelOne, 1},
+		{topology.ReadConsistencyLevelUnstrictMajority, 2},
+		{topology.ReadConsistencyLevelMajority, 2},
+		{topology.ReadConsistencyLevelAll, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.level.String(), func(t *testing.T) {
+			assert.Equal(t, test.expected, requiredReplicas(test.level, 2, 3))
+		})
+	}
+	assert.Equal(t, 0, requiredReplicas(topology.ReadConsistencyLevelOne, 2, 0))
+}
+
+type hedgedTestEnqueue struct {
+	host string
+	op   *fetchBatchOp
+}
+
+func newHedgedTestSession(
+	t *testing.T,
+	ctrl *gomock.Controller,
+	opts Options,
+) (*session, chan hedgedTestEnqueue) {
+	s, err := newSession(opts)
+	require.NoError(t, err)
+	session := s.(*session)
+
+	enqueued := make(chan hedgedTestEnqueue, 16)
+	session.newHostQueueFn = func(
+		host topology.Host,
+		opts hostQueueOpts,
+	) (hostQueue, error) {
+		hostQueue := NewMockhostQueue(ctrl)
+		hostQueue.EXPECT().Open()
+		hostQueue.EXPECT().Host().Return(host).AnyTimes()
+		hostQueue.EXPECT().ConnectionCount().
+			Return(opts.opts.MinConnectionCount()).AnyTimes()
+		hostQueue.EXPECT().Enqueue(gomock.Any()).Do(func(op op) error {
+			fetch, ok := op.(*fetchBatchOp)
+			require.True(t, ok)
+			fetch.IncRef()
+			enqueued <- hedgedTestEnqueue{host: host.ID(), op: fetch}
+			return nil
+		}).Return(nil).AnyTimes()
+		hostQueue.EXPECT().Close()
+		return hostQueue, nil
+	}
+	return session, enqueued
+}
+
+func TestSessionHedgedReadsWithReadRepairError(t *testing.T) {
+	opts := newSessionTestOptions().
+		SetHedgedReadsEnabled(true).
+		SetReadRepairEnabled(true)
+	require.Equal(t, errHedgedReadsWithReadRepair, opts.Validate())
+
+	_, err := newSession(opts)
+	require.Equal(t, errHedgedReadsWithReadRepair, err)
+}
+
+func TestSessionFetchIDsHedgedRetriesFailedReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions().
+		SetReadConsistencyLevel(topology.ReadConsistencyLevelOne).
+		SetHedgedReadsEnabled(true).
+		SetHedgedReadMinDelay(time.Minute).
+		SetHedgedReadMaxDelay(time.Minute)
+	testOpts := testOptions{nsID: ident.StringID(testNamespaceName), opts: opts}
+	session, enqueued := newHedgedTestSession(t, ctrl, opts)
+
+	start := time.Now().Truncate(time.Hour)
+	end := start.Add(2 * time.Hour)
+	fetches := testFetches([]testFetch{
+		{"foo", []testValue{
+			{1.0, start.Add(1 * time.Second), xtime.Second, nil},
+			{2.0, start.Add(2 * time.Second), xtime.Second, nil},
+		}},
+	})
+
+	hosts := make(chan []string, 1)
+	go func() {
+		// Fail the first replica, the second replica is then read from.
+		first := <-enqueued
+		first.op.completeAll(nil, &rpc.Error{
+			Type:    rpc.ErrorType_INTERNAL_ERROR,
+			Message: fetchFailureErrStr,
+		})
+		first.op.DecRef()
+
+		second := <-enqueued
+		fulfillFetchBatchOps(t, testOpts, fetches, []*fetchBatchOp{second.op}, 0)
+		second.op.DecRef()
+		hosts <- []string{first.host, second.host}
+	}()
+
+	require.NoError(t, session.Open())
+
+	results, err := session.FetchIDs(testOpts.nsID, fetches.IDsIter(), start, end)
+	require.NoError(t, err)
+	assertFetchResults(t, start, end, fetches, results, nil)
+
+	requested := <-hosts
+	assert.NotEqual(t, requested[0], requested[1])
+	assert.Equal(t, 0, len(enqueued))
+
+	require.NoError(t, session.Close())
+}
+
+func TestSessionFetchIDsHedgedSlowReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions().
+		SetReadConsistencyLevel(topology.ReadConsistencyLevelOne).
+		SetHedgedReadsEnabled(true).
+		SetHedgedReadMinDelay(10 * time.Millisecond).
+		SetHedgedReadMaxDelay(10 * time.Millisecond)
+	testOpts := testOptions{nsID: ident.StringID(testNamespaceName), opts: opts}
+	session, enqueued := newHedgedTestSession(t, ctrl, opts)
+
+	start := time.Now().Truncate(time.Hour)
+	end := start.Add(2 * time.Hour)
+	fetches := testFetches([]testFetch{
+		{"foo", []testValue{
+			{1.0, start.Add(1 * time.Second), xtime.Second, nil},
+		}},
+	})
+
+	var (
+		slow   hedgedTestEnqueue
+		slowWg sync.WaitGroup
+	)
+	slowWg.Add(1)
+	go func() {
+		// Never respond from the first replica until the fetch completes,
+		// the hedged request to the second replica is used instead.
+		slow = <-enqueued
+		slowWg.Done()
+
+		hedged := <-enqueued
+		assert.NotEqual(t, slow.host, hedged.host)
+		fulfillFetchBatchOps(t, testOpts, fetches, []*fetchBatchOp{hedged.op}, 0)
+		hedged.op.DecRef()
+	}()
+
+	require.NoError(t, session.Open())
+
+	results, err := session.FetchIDs(testOpts.nsID, fetches.IDsIter(), start, end)
+	require.NoError(t, err)
+	assertFetchResults(t, start, end, fetches, results, nil)
+
+	// Late responses are ignored.
+	slowWg.Wait()
+	slow.op.completeAll(nil, fmt.Errorf("late response"))
+	slow.op.DecRef()
+
+	require.NoError(t, session.Close())
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/clock"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/encoding/proto"
 	m3dbruntime "github.com/m3db/m3/src/dbnode/runtime"
@@ -57,6 +58,27 @@ const (
 	// defaultBootstrapConsistencyLevel is the default bootstrap consistency level
 	defaultBootstrapConsistencyLevel = m3dbruntime.DefaultBootstrapConsistencyLevel
 
+	// defaultReadRepairEnabled is the default read repair enabled setting
+	defaultReadRepairEnabled = false
+
+	// defaultReadRepairConcurrency is the default read repair concurrency
+	defaultReadRepairConcurrency = 4
+
+	// defaultHedgedReadsEnabled is the default hedged reads enabled setting
+	defaultHedgedReadsEnabled = false
+
+	// defaultHedgedReadPercentile is the default per host latency percentile
+	// after which a hedged request is sent to another replica
+	defaultHedgedReadPercentile = 0.95
+
+	// defaultHedgedReadMinDelay is the default minimum delay before a hedged
+	// request is sent to another replica
+	defaultHedgedReadMinDelay = 5 * time.Millisecond
+
+	// defaultHedgedReadMaxDelay is the default maximum delay before a hedged
+	// request is sent to another replica
+	defaultHedgedReadMaxDelay = time.Second
+
 	// defaultMaxConnectionCount is the default max connection count
 	defaultMaxConnectionCount = 32
 
@@ -190,8 +212,12 @@ var (
 			SetJitter(true),
 	)
 
-	errNoTopologyInitializerSet    = errors.New("no topology initializer set")
-	errNoReaderIteratorAllocateSet = errors.New("no reader iterator allocator set, encoding not set")
+	errNoTopologyInitializerSet         = errors.New("no topology initializer set")
+	errNoReaderIteratorAllocateSet      = errors.New("no reader iterator allocator set, encoding not set")
+	errReadRepairConcurrencyNotPositive = errors.New("read repair concurrency must be positive")
+	errHedgedReadPercentileInvalid      = errors.New("hedged read percentile must be between 0 and 1")
+	errHedgedReadDelayInvalid           = errors.New("hedged read min delay must be positive and not greater than max delay")
+	errHedgedReadsWithReadRepair        = errors.New("hedged reads and read repair cannot both be enabled")
 )
 
 type options struct {
@@ -202,6 +228,13 @@ type options struct {
 	readConsistencyLevel                    topology.ReadConsistencyLevel
 	writeConsistencyLevel                   topology.ConsistencyLevel
 	bootstrapConsistencyLevel               topology.ReadConsistencyLevel
+	readRepairEnabled                       bool
+	readRepairConcurrency                   int
+	readIsolationGroup                      string
+	hedgedReadsEnabled                      bool
+	hedgedReadPercentile                    float64
+	hedgedReadMinDelay                      time.Duration
+	hedgedReadMaxDelay                      time.Duration
 	channelOptions                          *tchannel.ChannelOptions
 	maxConnectionCount                      int
 	minConnectionCount                      int
@@ -284,6 +317,12 @@ func newOptions() *options {
 		writeConsistencyLevel:                   defaultWriteConsistencyLevel,
 		readConsistencyLevel:                    defaultReadConsistencyLevel,
 		bootstrapConsistencyLevel:               defaultBootstrapConsistencyLevel,
+		readRepairEnabled:                       defaultReadRepairEnabled,
+		readRepairConcurrency:                   defaultReadRepairConcurrency,
+		hedgedReadsEnabled:                      defaultHedgedReadsEnabled,
+		hedgedReadPercentile:                    defaultHedgedReadPercentile,
+		hedgedReadMinDelay:                      defaultHedgedReadMinDelay,
+		hedgedReadMaxDelay:                      defaultHedgedReadMaxDelay,
 		maxConnectionCount:                      defaultMaxConnectionCount,
 		minConnectionCount:                      defaultMinConnectionCount,
 		hostConnectTimeout:                      defaultHostConnectTimeout,
@@ -350,6 +389,20 @@ func (o *options) Validate() error {
 	); err != nil {
 		return err
 	}
+	if o.readRepairConcurrency <= 0 {
+		return errReadRepairConcurrencyNotPositive
+	}
+	if o.hedgedReadPercentile <= 0 || o.hedgedReadPercentile > 1 {
+		return errHedgedReadPercentileInvalid
+	}
+	if o.hedgedReadMinDelay <= 0 || o.hedgedReadMinDelay > o.hedgedReadMaxDelay {
+		return errHedgedReadDelayInvalid
+	}
+	if o.hedgedReadsEnabled && o.readRepairEnabled {
+		// NB: hedged reads only wait for the replicas required by the read
+		// consistency level so divergent replicas would go unrepaired.
+		return errHedgedReadsWithReadRepair
+	}
 	return topology.ValidateConnectConsistencyLevel(
 		o.clusterConnectConsistencyLevel,
 	)
@@ -371,6 +424,15 @@ func (o *options) SetEncodingProto(encodingOpts encoding.Options) Options {
 	return &opts
 }
 
+func (o *options) SetEncodingHistogram(encodingOpts encoding.Options) Options {
+	opts := *o
+	opts.readerIteratorAllocate = func(r io.Reader, descr namespace.SchemaDescr) encoding.ReaderIterator {
+		return histogram.NewMixedIterator(r, descr,
+			m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+	}
+	return &opts
+}
+
 func (o *options) SetRuntimeOptionsManager(value m3dbruntime.OptionsManager) Options {
 	opts := *o
 	opts.runtimeOptsMgr = value
@@ -421,6 +483,76 @@ func (o *options) ReadConsistencyLevel() topology.ReadConsistencyLevel {
 	return o.readConsistencyLevel
 }
 
+func (o *options) SetReadRepairEnabled(value bool) Options {
+	opts := *o
+	opts.readRepairEnabled = value
+	return &opts
+}
+
+func (o *options) ReadRepairEnabled() bool {
+	return o.readRepairEnabled
+}
+
+func (o *options) SetReadRepairConcurrency(value int) Options {
+	opts := *o
+	opts.readRepairConcurrency = value
+	return &opts
+}
+
+func (o *options) ReadRepairConcurrency() int {
+	return o.readRepairConcurrency
+}
+
+func (o *options) SetReadIsolationGroup(value string) Options {
+	opts := *o
+	opts.readIsolationGroup = value
+	return &opts
+}
+
+func (o *options) ReadIsolationGroup() string {
+	return o.readIsolationGroup
+}
+
+func (o *options) SetHedgedReadsEnabled(value bool) Options {
+	opts := *o
+	opts.hedgedReadsEnabled = value
+	return &opts
+}
+
+func (o *options) HedgedReadsEnabled() bool {
+	return o.hedgedReadsEnabled
+}
+
+func (o *options) SetHedgedReadPercentile(value float64) Options {
+	opts := *o
+	opts.hedgedReadPercentile = value
+	return &opts
+}
+
+func (o *options) HedgedReadPercentile() float64 {
+	return o.hedgedReadPercentile
+}
+
+func (o *options) SetHedgedReadMinDelay(value time.Duration) Options {
+	opts := *o
+	opts.hedgedReadMinDelay = value
+	return &opts
+}
+
+func (o *options) HedgedReadMinDelay() time.Duration {
+	return o.hedgedReadMinDelay
+}
+
+func (o *options) SetHedgedReadMaxDelay(value time.Duration) Options {
+	opts := *o
+	opts.hedgedReadMaxDelay = value
+	return &opts
+}
+
+func (o *options) HedgedReadMaxDelay() time.Duration {
+	return o.hedgedReadMaxDelay
+}
+
 func (o *options) SetWriteConsistencyLevel(value topology.ConsistencyLevel) Options {
 	opts := *o
 	opts.writeConsistencyLevel = value
@@ -0,0 +1,467 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xsync "github.com/m3db/m3/src/x/sync"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+// readRepairer repairs replicas that returned divergent blocks for a fetch
+// by writing the datapoints they are missing back to them. Divergent blocks
+// are detected by comparing per block checksums of the decoded datapoints
+// returned by each replica, so replicas that hold the same datapoints encoded
+// differently, i.e. merged and unmerged, are not considered divergent.
+//
+// Repairs are best effort and performed in the background, if all workers
+// are busy the repair is dropped. Datapoints are written back using regular
+// writes so repairing blocks outside of the buffer past and future ranges
+// requires cold writes to be enabled for the namespace.
+type readRepairer struct {
+	iterAlloc encoding.ReaderIteratorAllocate
+	writeFn   readRepairWriteFn
+	workers   xsync.WorkerPool
+	metrics   readRepairMetrics
+	logger    *zap.Logger
+}
+
+type readRepairWriteFn func(w readRepairWrite) error
+
+type readRepairMetrics struct {
+	enqueued       tally.Counter
+	dropped        tally.Counter
+	decodeErrors   tally.Counter
+	mismatches     tally.Counter
+	writeEnqueued  tally.Counter
+	writeErrors    tally.Counter
+	writeSuccesses tally.Counter
+}
+
+func newReadRepairMetrics(scope tally.Scope) readRepairMetrics {
+	return readRepairMetrics{
+		enqueued:       scope.Counter("enqueued"),
+		dropped:        scope.Counter("dropped"),
+		decodeErrors:   scope.Counter("decode-errors"),
+		mismatches:     scope.Counter("block-mismatches"),
+		writeEnqueued:  scope.Counter("write-enqueued"),
+		writeErrors:    scope.Counter("write-errors"),
+		writeSuccesses: scope.Counter("write-success"),
+	}
+}
+
+// readRepairReplica is the data a single replica returned for a series.
+type readRepairReplica struct {
+	host     topology.Host
+	segments []*rpc.Segments
+}
+
+// readRepairRequest is a request to compare and repair the replicas that
+// returned data for a single series.
+type readRepairRequest struct {
+	namespace   ident.ID
+	id          ident.ID
+	encodedTags []byte
+	schema      namespace.SchemaDescr
+	replicas    []readRepairReplica
+}
+
+// readRepairWrite is the set of datapoints to write back to a single replica.
+type readRepairWrite struct {
+	host        topology.Host
+	namespace   ident.ID
+	id          ident.ID
+	encodedTags []byte
+	datapoints  []readRepairDatapoint
+	// completionFn is called once for each datapoint written.
+	completionFn func(err error)
+}
+
+type readRepairDatapoint struct {
+	ts.Datapoint
+	unit       xtime.Unit
+	annotation ts.Annotation
+}
+
+func newReadRepairer(
+	opts Options,
+	writeFn readRepairWriteFn,
+) *readRepairer {
+	workers := xsync.NewWorkerPool(opts.ReadRepairConcurrency())
+	workers.Init()
+
+	scope := opts.InstrumentOptions().MetricsScope().SubScope("read-repair")
+	return &readRepairer{
+		iterAlloc: opts.ReaderIteratorAllocate(),
+		writeFn:   writeFn,
+		workers:   workers,
+		metrics:   newReadRepairMetrics(scope),
+		logger:    opts.InstrumentOptions().Logger(),
+	}
+}
+
+// shouldRepair returns whether fetches at the read consistency level should
+// be repaired, only fetches that read from more than one replica can detect
+// divergent replicas.
+func (r *readRepairer) shouldRepair(level topology.ReadConsistencyLevel) bool {
+	if r == nil {
+		return false
+	}
+	switch level {
+	case topology.ReadConsistencyLevelNone, topology.ReadConsistencyLevelOne:
+		return false
+	}
+	return true
+}
+
+// enqueue schedules the request to be repaired in the background if a worker
+// is available, the request is only copied once a worker has been acquired
+// so that dropped repairs do not pay for copying the replica segments.
+func (r *readRepairer) enqueue(req readRepairRequest) {
+	if len(req.replicas) < 2 {
+		// Nothing to compare against.
+		return
+	}
+
+	// NB: the copy is handed to the worker so the request outlives the fetch
+	// results it references, which are released once the caller returns.
+	copied := make(chan readRepairRequest, 1)
+	if !r.workers.GoIfAvailable(func() { r.repair(<-copied) }) {
+		r.metrics.dropped.Inc(1)
+		return
+	}
+	copied <- copyReadRepairRequest(req)
+	r.metrics.enqueued.Inc(1)
+}
+
+func (r *readRepairer) repair(req readRepairRequest) {
+	var (
+		// NB: blocks for each replica are keyed by block start, each block
+		// holds the datapoints of the block sorted by timestamp.
+		replicaBlocks = make([]map[xtime.UnixNano][]readRepairDatapoint, 0, len(req.replicas))
+		blockStarts   = make(map[xtime.UnixNano]struct{})
+	)
+	for _, replica := range req.replicas {
+		blocks, err := r.decodeBlocks(replica.segments, req.schema)
+		if err != nil {
+			r.metrics.decodeErrors.Inc(1)
+			r.logger.Warn("unable to decode replica for read repair",
+				zap.Stringer("host", replica.host),
+				zap.Stringer("id", req.id),
+				zap.Error(err))
+			return
+		}
+		for blockStart := range blocks {
+			blockStarts[blockStart] = struct{}{}
+		}
+		replicaBlocks = append(replicaBlocks, blocks)
+	}
+
+	writes := make([][]readRepairDatapoint, len(req.replicas))
+	for blockStart := range blockStarts {
+		var (
+			checksums = make([]uint32, 0, len(replicaBlocks))
+			divergent = false
+		)
+		for _, blocks := range replicaBlocks {
+			checksum := readRepairChecksum(blocks[blockStart])
+			if len(checksums) > 0 && checksum != checksums[0] {
+				divergent = true
+			}
+			checksums = append(checksums, checksum)
+		}
+		if !divergent {
+			continue
+		}
+
+		r.metrics.mismatches.Inc(1)
+		merged := mergeReadRepairBlocks(replicaBlocks, blockStart)
+		mergedChecksum := readRepairChecksum(merged)
+		for i, blocks := range replicaBlocks {
+			if checksums[i] == mergedChecksum {
+				continue
+			}
+			writes[i] = append(writes[i], missingReadRepairDatapoints(blocks[blockStart], merged)...)
+		}
+	}
+
+	for i, datapoints := range writes {
+		if len(datapoints) == 0 {
+			continue
+		}
+		err := r.writeFn(readRepairWrite{
+			host:         req.replicas[i].host,
+			namespace:    req.namespace,
+			id:           req.id,
+			encodedTags:  req.encodedTags,
+			datapoints:   datapoints,
+			completionFn: r.writeCompleted,
+		})
+		if err != nil {
+			r.metrics.writeErrors.Inc(int64(len(datapoints)))
+			r.logger.Warn("unable to enqueue read repair writes",
+				zap.Stringer("host", req.replicas[i].host),
+				zap.Stringer("id", req.id),
+				zap.Error(err))
+			continue
+		}
+		r.metrics.writeEnqueued.Inc(int64(len(datapoints)))
+	}
+}
+
+func (r *readRepairer) writeCompleted(err error) {
+	if err != nil {
+		r.metrics.writeErrors.Inc(1)
+		return
+	}
+	r.metrics.writeSuccesses.Inc(1)
+}
+
+func (r *readRepairer) decodeBlocks(
+	segments []*rpc.Segments,
+	schema namespace.SchemaDescr,
+) (map[xtime.UnixNano][]readRepairDatapoint, error) {
+	var (
+		blocks     = make(map[xtime.UnixNano][]readRepairDatapoint, len(segments))
+		slicesIter = newReaderSliceOfSlicesIterator(nil, nil)
+		iter       = encoding.NewMultiReaderIterator(r.iterAlloc, nil)
+	)
+	defer iter.Close()
+
+	for _, s := range segments {
+		blockStart, ok := readRepairBlockStart(s)
+		if !ok {
+			continue
+		}
+
+		slicesIter.Reset([]*rpc.Segments{s})
+		iter.ResetSliceOfSlices(slicesIter, schema)
+		for iter.Next() {
+			dp, unit, annotation := iter.Current()
+			blocks[blockStart] = append(blocks[blockStart], readRepairDatapoint{
+				Datapoint:  dp,
+				unit:       unit,
+				annotation: append(ts.Annotation(nil), annotation...),
+			})
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	// A replica may return more than one set of segments for a block, make
+	// sure each block is sorted by timestamp and free of duplicates.
+	for blockStart, datapoints := range blocks {
+		blocks[blockStart] = dedupeReadRepairDatapoints(datapoints)
+	}
+	return blocks, nil
+}
+
+func readRepairBlockStart(s *rpc.Segments) (xtime.UnixNano, bool) {
+	var seg *rpc.Segment
+	if s.Merged != nil {
+		seg = s.Merged
+	} else if len(s.Unmerged) > 0 {
+		seg = s.Unmerged[0]
+	}
+	if seg == nil || seg.StartTime == nil {
+		return 0, false
+	}
+	return xtime.UnixNano(*seg.StartTime), true
+}
+
+func dedupeReadRepairDatapoints(
+	datapoints []readRepairDatapoint,
+) []readRepairDatapoint {
+	sort.SliceStable(datapoints, func(i, j int) bool {
+		return datapoints[i].Timestamp.Before(datapoints[j].Timestamp)
+	})
+	deduped := datapoints[:0]
+	for _, dp := range datapoints {
+		if n := len(deduped); n > 0 && deduped[n-1].Timestamp.Equal(dp.Timestamp) {
+			continue
+		}
+		deduped = append(deduped, dp)
+	}
+	return deduped
+}
+
+// readRepairChecksum returns a checksum of the timestamps and values of a
+// block's datapoints.
+func readRepairChecksum(datapoints []readRepairDatapoint) uint32 {
+	var (
+		d   = digest.NewDigest()
+		buf [16]byte
+	)
+	for _, dp := range datapoints {
+		binary.BigEndian.PutUint64(buf[:8], uint64(dp.Timestamp.UnixNano()))
+		binary.BigEndian.PutUint64(buf[8:], math.Float64bits(dp.Value))
+		d = d.Update(buf[:])
+	}
+	return d.Sum32()
+}
+
+// mergeReadRepairBlocks merges the datapoints of a block across replicas.
+// When replicas disagree on the value of a datapoint the value returned by
+// the most replicas wins, ties are broken in favor of the first replica.
+func mergeReadRepairBlocks(
+	replicaBlocks []map[xtime.UnixNano][]readRepairDatapoint,
+	blockStart xtime.UnixNano,
+) []readRepairDatapoint {
+	type candidate struct {
+		datapoint readRepairDatapoint
+		votes     int
+	}
+	byTimestamp := make(map[xtime.UnixNano][]candidate)
+	for _, blocks := range replicaBlocks {
+		for _, dp := range blocks[blockStart] {
+			key := xtime.ToUnixNano(dp.Timestamp)
+			candidates := byTimestamp[key]
+			found := false
+			for i := range candidates {
+				if readRepairValueEqual(candidates[i].datapoint.Value, dp.Value) {
+					candidates[i].votes++
+					found = true
+					break
+				}
+			}
+			if !found {
+				candidates = append(candidates, candidate{datapoint: dp, votes: 1})
+			}
+			byTimestamp[key] = candidates
+		}
+	}
+
+	merged := make([]readRepairDatapoint, 0, len(byTimestamp))
+	for _, candidates := range byTimestamp {
+		best := candidates[0]
+		for _, c := range candidates[1:] {
+			if c.votes > best.votes {
+				best = c
+			}
+		}
+		merged = append(merged, best.datapoint)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Timestamp.Before(merged[j].Timestamp)
+	})
+	return merged
+}
+
+// missingReadRepairDatapoints returns the merged datapoints that are either
+// missing from or have a different value in the replica's block.
+func missingReadRepairDatapoints(
+	replica []readRepairDatapoint,
+	merged []readRepairDatapoint,
+) []readRepairDatapoint {
+	var (
+		missing []readRepairDatapoint
+		i       = 0
+	)
+	for _, dp := range merged {
+		for i < len(replica) && replica[i].Timestamp.Before(dp.Timestamp) {
+			i++
+		}
+		if i < len(replica) && replica[i].Timestamp.Equal(dp.Timestamp) &&
+			readRepairValueEqual(replica[i].Value, dp.Value) {
+			continue
+		}
+		missing = append(missing, dp)
+	}
+	return missing
+}
+
+func readRepairValueEqual(a, b float64) bool {
+	// Compare bits so that NaNs written by both replicas are considered equal.
+	return math.Float64bits(a) == math.Float64bits(b)
+}
+
+func copyReadRepairRequest(req readRepairRequest) readRepairRequest {
+	replicas := make([]readRepairReplica, 0, len(req.replicas))
+	for _, replica := range req.replicas {
+		segments := make([]*rpc.Segments, 0, len(replica.segments))
+		for _, s := range replica.segments {
+			segments = append(segments, copyReadRepairSegments(s))
+		}
+		replicas = append(replicas, readRepairReplica{
+			host:     replica.host,
+			segments: segments,
+		})
+	}
+
+	var encodedTags []byte
+	if req.encodedTags != nil {
+		encodedTags = append([]byte(nil), req.encodedTags...)
+	}
+	return readRepairRequest{
+		namespace:   ident.BytesID(append([]byte(nil), req.namespace.Bytes()...)),
+		id:          ident.BytesID(append([]byte(nil), req.id.Bytes()...)),
+		encodedTags: encodedTags,
+		schema:      req.schema,
+		replicas:    replicas,
+	}
+}
+
+func copyReadRepairSegments(s *rpc.Segments) *rpc.Segments {
+	if s == nil {
+		return nil
+	}
+	result := &rpc.Segments{}
+	if s.Merged != nil {
+		result.Merged = copyReadRepairSegment(s.Merged)
+	}
+	if len(s.Unmerged) > 0 {
+		result.Unmerged = make([]*rpc.Segment, 0, len(s.Unmerged))
+		for _, seg := range s.Unmerged {
+			result.Unmerged = append(result.Unmerged, copyReadRepairSegment(seg))
+		}
+	}
+	return result
+}
+
+func copyReadRepairSegment(seg *rpc.Segment) *rpc.Segment {
+	result := &rpc.Segment{
+		Head: append([]byte(nil), seg.Head...),
+		Tail: append([]byte(nil), seg.Tail...),
+	}
+	if seg.StartTime != nil {
+		startTime := *seg.StartTime
+		result.StartTime = &startTime
+	}
+	if seg.BlockSize != nil {
+		blockSize := *seg.BlockSize
+		result.BlockSize = &blockSize
+	}
+	return result
+}
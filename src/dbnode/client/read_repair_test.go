@@ -0,0 +1,276 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func newTestReadRepairer(t *testing.T) (*readRepairer, *[]readRepairWrite) {
+	var writes []readRepairWrite
+	opts := newSessionTestOptions().SetReadRepairEnabled(true)
+	r := newReadRepairer(opts, func(w readRepairWrite) error {
+		writes = append(writes, w)
+		return nil
+	})
+	return r, &writes
+}
+
+func testReadRepairSegment(
+	t *testing.T,
+	blockStart time.Time,
+	datapoints []ts.Datapoint,
+) *rpc.Segment {
+	encoder := m3tsz.NewEncoder(blockStart, nil, true, nil)
+	for _, dp := range datapoints {
+		require.NoError(t, encoder.Encode(dp, xtime.Second, nil))
+	}
+	seg := encoder.Discard()
+	startTime := blockStart.UnixNano()
+	return &rpc.Segment{
+		Head:      bytesIfNotNil(seg.Head),
+		Tail:      bytesIfNotNil(seg.Tail),
+		StartTime: &startTime,
+	}
+}
+
+func testReadRepairRequest(replicas ...readRepairReplica) readRepairRequest {
+	return readRepairRequest{
+		namespace: ident.StringID("testns"),
+		id:        ident.StringID("foo"),
+		replicas:  replicas,
+	}
+}
+
+func TestReadRepairerShouldRepair(t *testing.T) {
+	var nilRepairer *readRepairer
+	assert.False(t, nilRepairer.shouldRepair(topology.ReadConsistencyLevelMajority))
+
+	r, _ := newTestReadRepairer(t)
+	assert.False(t, r.shouldRepair(topology.ReadConsistencyLevelNone))
+	assert.False(t, r.shouldRepair(topology.ReadConsistencyLevelOne))
+	assert.True(t, r.shouldRepair(topology.ReadConsistencyLevelUnstrictMajority))
+	assert.True(t, r.shouldRepair(topology.ReadConsistencyLevelMajority))
+	assert.True(t, r.shouldRepair(topology.ReadConsistencyLevelAll))
+}
+
+func TestReadRepairerConsistentReplicasNotRepaired(t *testing.T) {
+	r, writes := newTestReadRepairer(t)
+
+	var (
+		start      = time.Now().Truncate(2 * time.Hour)
+		datapoints = []ts.Datapoint{
+			{Timestamp: start, Value: 1},
+			{Timestamp: start.Add(time.Minute), Value: 2},
+			{Timestamp: start.Add(2 * time.Minute), Value: 3},
+		}
+	)
+	// The same datapoints encoded as a merged and unmerged block are not
+	// considered divergent.
+	r.repair(testReadRepairRequest(
+		readRepairReplica{
+			host: topology.NewHost("a", "a:9000"),
+			segments: []*rpc.Segments{{
+				Merged: testReadRepairSegment(t, start, datapoints),
+			}},
+		},
+		readRepairReplica{
+			host: topology.NewHost("b", "b:9000"),
+			segments: []*rpc.Segments{{
+				Unmerged: []*rpc.Segment{
+					testReadRepairSegment(t, start, datapoints[:1]),
+					testReadRepairSegment(t, start, datapoints[1:]),
+				},
+			}},
+		},
+	))
+
+	assert.Equal(t, 0, len(*writes))
+}
+
+func TestReadRepairerDivergentReplicaRepaired(t *testing.T) {
+	r, writes := newTestReadRepairer(t)
+
+	var (
+		start = time.Now().Truncate(2 * time.Hour)
+		next  = start.Add(2 * time.Hour)
+		full  = []ts.Datapoint{
+			{Timestamp: start, Value: 1},
+			{Timestamp: start.Add(time.Minute), Value: 2},
+			{Timestamp: start.Add(2 * time.Minute), Value: 3},
+		}
+		lagging = []ts.Datapoint{
+			{Timestamp: start, Value: 1},
+			{Timestamp: start.Add(2 * time.Minute), Value: 42},
+		}
+		nextBlock = []ts.Datapoint{
+			{Timestamp: next, Value: 4},
+		}
+		hostA = topology.NewHost("a", "a:9000")
+		hostB = topology.NewHost("b", "b:9000")
+		hostC = topology.NewHost("c", "c:9000")
+	)
+	r.repair(testReadRepairRequest(
+		readRepairReplica{
+			host: hostA,
+			segments: []*rpc.Segments{
+				{Merged: testReadRepairSegment(t, start, full)},
+				{Merged: testReadRepairSegment(t, next, nextBlock)},
+			},
+		},
+		readRepairReplica{
+			host: hostB,
+			segments: []*rpc.Segments{
+				{Merged: testReadRepairSegment(t, start, full)},
+				{Merged: testReadRepairSegment(t, next, nextBlock)},
+			},
+		},
+		readRepairReplica{
+			host: hostC,
+			segments: []*rpc.Segments{
+				{Merged: testReadRepairSegment(t, start, lagging)},
+			},
+		},
+	))
+
+	require.Equal(t, 1, len(*writes))
+	write := (*writes)[0]
+	assert.Equal(t, hostC.ID(), write.host.ID())
+	assert.Equal(t, "foo", write.id.String())
+	assert.Nil(t, write.encodedTags)
+
+	// The missing datapoint, the datapoint with the minority value and the
+	// entirely missing block are all written back.
+	require.Equal(t, 3, len(write.datapoints))
+	expected := []ts.Datapoint{full[1], full[2], nextBlock[0]}
+	actual := make([]ts.Datapoint, 0, len(write.datapoints))
+	for _, dp := range write.datapoints {
+		actual = append(actual, dp.Datapoint)
+	}
+	timestamps := func(dps []ts.Datapoint) []int64 {
+		var result []int64
+		for _, dp := range dps {
+			result = append(result, dp.Timestamp.UnixNano())
+		}
+		return result
+	}
+	assert.ElementsMatch(t, timestamps(expected), timestamps(actual))
+	for _, dp := range write.datapoints {
+		if dp.Timestamp.Equal(full[2].Timestamp) {
+			assert.Equal(t, full[2].Value, dp.Value)
+		}
+	}
+}
+
+func TestReadRepairerEnqueueDroppedWhenWorkersBusy(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	opts := newSessionTestOptions().
+		SetReadRepairEnabled(true).
+		SetReadRepairConcurrency(1).
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope))
+	writes := make(chan readRepairWrite, 1)
+	r := newReadRepairer(opts, func(w readRepairWrite) error {
+		writes <- w
+		return nil
+	})
+
+	var (
+		start = time.Now().Truncate(2 * time.Hour)
+		req   = testReadRepairRequest(
+			readRepairReplica{
+				host: topology.NewHost("a", "a:9000"),
+				segments: []*rpc.Segments{{
+					Merged: testReadRepairSegment(t, start, []ts.Datapoint{
+						{Timestamp: start, Value: 1},
+					}),
+				}},
+			},
+			readRepairReplica{
+				host:     topology.NewHost("b", "b:9000"),
+				segments: []*rpc.Segments{},
+			},
+		)
+		counter = func(name string) int64 {
+			c, ok := scope.Snapshot().Counters()["read-repair."+name+"+"]
+			if !ok {
+				return 0
+			}
+			return c.Value()
+		}
+	)
+
+	// Occupy the only worker, the repair is dropped.
+	block := make(chan struct{})
+	r.workers.Go(func() { <-block })
+	r.enqueue(req)
+	assert.Equal(t, int64(1), counter("dropped"))
+	assert.Equal(t, int64(0), counter("enqueued"))
+
+	// Once the worker is released the repair is enqueued and performed.
+	close(block)
+	for counter("enqueued") == 0 {
+		r.enqueue(req)
+		time.Sleep(time.Millisecond)
+	}
+	write := <-writes
+	assert.Equal(t, "b", write.host.ID())
+	require.Equal(t, 1, len(write.datapoints))
+	assert.Equal(t, float64(1), write.datapoints[0].Value)
+}
+
+func TestCopyReadRepairRequest(t *testing.T) {
+	var (
+		start = time.Now().Truncate(2 * time.Hour)
+		seg   = testReadRepairSegment(t, start, []ts.Datapoint{
+			{Timestamp: start, Value: 1},
+		})
+		req = testReadRepairRequest(
+			readRepairReplica{
+				host:     topology.NewHost("a", "a:9000"),
+				segments: []*rpc.Segments{{Merged: seg}},
+			},
+			readRepairReplica{
+				host:     topology.NewHost("b", "b:9000"),
+				segments: []*rpc.Segments{{Merged: seg}},
+			},
+		)
+	)
+	copied := copyReadRepairRequest(req)
+	for i := range seg.Head {
+		seg.Head[i] = 0
+	}
+	require.Equal(t, 2, len(copied.replicas))
+	assert.NotEqual(t, seg.Head, copied.replicas[0].segments[0].Merged.Head)
+	assert.Equal(t, *seg.StartTime, *copied.replicas[0].segments[0].Merged.StartTime)
+}
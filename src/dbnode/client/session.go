@@ -151,6 +151,8 @@ type session struct {
 	streamBlocksBatchSize            int
 	streamBlocksMetadataBatchTimeout time.Duration
 	streamBlocksBatchTimeout         time.Duration
+	readRepairer                     *readRepairer
+	hedgedReader                     *hedgedReader
 	metrics                          sessionMetrics
 }
 
@@ -223,6 +225,10 @@ type newHostQueueFn func(
 ) (hostQueue, error)
 
 func newSession(opts Options) (clientSession, error) {
+	if opts.HedgedReadsEnabled() && opts.ReadRepairEnabled() {
+		return nil, errHedgedReadsWithReadRepair
+	}
+
 	topo, err := opts.TopologyInitializer().Init()
 	if err != nil {
 		return nil, err
@@ -254,6 +260,12 @@ func newSession(opts Options) (clientSession, error) {
 	}
 	s.reattemptStreamBlocksFromPeersFn = s.streamBlocksReattemptFromPeers
 	s.pickBestPeerFn = s.streamBlocksPickBestPeer
+	if opts.ReadRepairEnabled() {
+		s.readRepairer = newReadRepairer(opts, s.writeReadRepair)
+	}
+	if opts.HedgedReadsEnabled() {
+		s.hedgedReader = newHedgedReader(opts)
+	}
 	writeAttemptPoolOpts := pool.NewObjectPoolOptions().
 		SetSize(opts.WriteOpPoolSize()).
 		SetInstrumentOptions(opts.InstrumentOptions().SetMetricsScope(
@@ -1081,6 +1093,70 @@ func (s *session) writeAttemptWithRLock(
 	return state, majority, enqueued, nil
 }
 
+// writeReadRepair enqueues the datapoints repaired by a read repair directly
+// to the queue of the replica being repaired, bypassing the write consistency
+// checks as only the single replica is written to.
+func (s *session) writeReadRepair(w readRepairWrite) error {
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	if s.state.status != statusOpen {
+		return errSessionStatusNotOpen
+	}
+
+	queue, ok := s.state.queuesByHostID[w.host.ID()]
+	if !ok {
+		return errSessionHasNoHostQueueForHost
+	}
+
+	shardID := s.state.topoMap.ShardSet().Lookup(w.id)
+	completionFn := func(_ interface{}, err error) {
+		w.completionFn(err)
+	}
+	for _, dp := range w.datapoints {
+		timeType, err := convert.ToTimeType(dp.unit)
+		if err != nil {
+			timeType = rpc.TimeType_UNIX_NANOSECONDS
+		}
+		timestamp, err := convert.ToValue(dp.Timestamp, timeType)
+		if err != nil {
+			return err
+		}
+
+		var op writeOp
+		if w.encodedTags != nil {
+			wop := &writeTaggedOperation{}
+			wop.reset()
+			wop.namespace = w.namespace
+			wop.shardID = shardID
+			wop.request.ID = w.id.Bytes()
+			wop.request.EncodedTags = w.encodedTags
+			wop.request.Datapoint.Value = dp.Value
+			wop.request.Datapoint.Timestamp = timestamp
+			wop.request.Datapoint.TimestampTimeType = timeType
+			wop.request.Datapoint.Annotation = dp.annotation
+			op = wop
+		} else {
+			wop := &writeOperation{}
+			wop.reset()
+			wop.namespace = w.namespace
+			wop.shardID = shardID
+			wop.request.ID = w.id.Bytes()
+			wop.request.Datapoint.Value = dp.Value
+			wop.request.Datapoint.Timestamp = timestamp
+			wop.request.Datapoint.TimestampTimeType = timeType
+			wop.request.Datapoint.Annotation = dp.annotation
+			op = wop
+		}
+		op.SetCompletionFn(completionFn)
+
+		if err := queue.Enqueue(op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *session) Fetch(
 	nsID ident.ID,
 	id ident.ID,
@@ -1247,6 +1323,9 @@ func (s *session) fetchTaggedAttempt(
 	// the fetchState Lock
 	fetchState.Unlock()
 	iters, exhaustive, err := fetchState.asEncodingSeriesIterators(s.pools, nsCtx.Schema)
+	if err == nil {
+		fetchState.enqueueReadRepairs(s.readRepairer, nsCtx.Schema)
+	}
 
 	// must Unlock() before decRef'ing, as the latter releases the fetchState back into a
 	// pool if ref count == 0.
@@ -1348,6 +1427,10 @@ func (s *session) newFetchStateWithRLock(
 		fetchOp.update(opts.fetchTaggedRequest, fetchState.completionFn)
 		fetchState.ResetFetchTagged(opts.startInclusive, opts.endExclusive,
 			fetchOp, topoMap, s.state.majority, s.state.readLevel)
+		if opts.fetchTaggedRequest.FetchData &&
+			s.readRepairer.shouldRepair(s.state.readLevel) {
+			fetchState.tagResultAccumulator.TrackResponseHosts()
+		}
 		op = fetchOp
 
 	case aggregateFetchState:
@@ -1398,6 +1481,11 @@ func (s *session) fetchIDsAttempt(
 	inputIDs ident.Iterator,
 	startInclusive, endExclusive time.Time,
 ) (encoding.SeriesIterators, error) {
+	if s.hedgedReader != nil {
+		return s.fetchIDsAttemptHedged(inputNamespace, inputIDs,
+			startInclusive, endExclusive)
+	}
+
 	var (
 		wg                     sync.WaitGroup
 		allPending             int32
@@ -1459,6 +1547,7 @@ func (s *session) fetchIDsAttempt(
 
 	consistencyLevel = s.state.readLevel
 	majority = int32(s.state.majority)
+	readRepair := s.readRepairer.shouldRepair(consistencyLevel)
 
 	// NB(prateek): namespaceAccessors tracks the number of pending accessors for nsID.
 	// It is set to incremented by `replica` for each requested ID during fetch enqueuing,
@@ -1482,6 +1571,7 @@ func (s *session) fetchIDsAttempt(
 			idAccessors      int32 = 1
 			resultsLock      sync.RWMutex
 			results          []encoding.MultiReaderIterator
+			repairReplicas   []readRepairReplica
 			enqueued         int32
 			pending          int32
 			success          int32
@@ -1532,6 +1622,19 @@ func (s *session) fetchIDsAttempt(
 					Replicas:       successIters,
 				})
 				iters.SetAt(idx, iter)
+
+				if readRepair {
+					// NB: the segments are still referenced by the results at
+					// this point, the repairer takes a copy before returning.
+					resultsLock.RLock()
+					s.readRepairer.enqueue(readRepairRequest{
+						namespace: namespace,
+						id:        tsID,
+						schema:    nsCtx.Schema,
+						replicas:  repairReplicas,
+					})
+					resultsLock.RUnlock()
+				}
 			}
 			if atomic.AddInt32(&resultsAccessors, -1) == 0 {
 				s.pools.multiReaderIteratorArray.Put(results)
@@ -1618,8 +1721,25 @@ func (s *session) fetchIDsAttempt(
 				f.request.RangeTimeType = rpc.TimeType_UNIX_NANOSECONDS
 			}
 
+			fn := completionFn
+			if readRepair {
+				// Track which host returned each result so that divergent
+				// replicas can be repaired.
+				fn = func(result interface{}, err error) {
+					if err == nil {
+						resultsLock.Lock()
+						repairReplicas = append(repairReplicas, readRepairReplica{
+							host:     host,
+							segments: result.([]*rpc.Segments),
+						})
+						resultsLock.Unlock()
+					}
+					completionFn(result, err)
+				}
+			}
+
 			// Append IDWithNamespace to this request
-			f.append(namespace.Bytes(), tsID.Bytes(), completionFn)
+			f.append(namespace.Bytes(), tsID.Bytes(), fn)
 		}); err != nil {
 			routeErr = err
 			break
@@ -1803,6 +1923,67 @@ func (s *session) Truncate(namespace ident.ID) (int64, error) {
 	return truncated, resultErr.FinalError()
 }
 
+func (s *session) Cardinality(
+	namespace ident.ID,
+	opts index.CardinalityOptions,
+) (index.CardinalityResult, error) {
+	req, err := convert.ToRPCCardinalityRequest(namespace, opts)
+	if err != nil {
+		return index.CardinalityResult{}, err
+	}
+
+	var (
+		wg            sync.WaitGroup
+		enqueueErr    xerrors.MultiError
+		resultErrLock sync.Mutex
+		resultErr     xerrors.MultiError
+	)
+
+	s.state.RLock()
+	if s.state.status != statusOpen {
+		s.state.RUnlock()
+		return index.CardinalityResult{}, errSessionStatusNotOpen
+	}
+
+	acc := newCardinalityResultAccumulator()
+	c := &cardinalityOp{request: req}
+	c.completionFn = func(result interface{}, err error) {
+		if err != nil {
+			resultErrLock.Lock()
+			resultErr = resultErr.Add(err)
+			resultErrLock.Unlock()
+		} else {
+			res := result.(*rpc.CardinalityResult_)
+			acc.Add(convert.FromRPCCardinalityResult(res))
+		}
+		wg.Done()
+	}
+
+	for idx := range s.state.queues {
+		wg.Add(1)
+		if err := s.state.queues[idx].Enqueue(c); err != nil {
+			wg.Done()
+			enqueueErr = enqueueErr.Add(err)
+		}
+	}
+	s.state.RUnlock()
+
+	if err := enqueueErr.FinalError(); err != nil {
+		s.log.Error("failed to enqueue request", zap.Error(err))
+		return index.CardinalityResult{}, err
+	}
+
+	// Wait for every host to count its shards, replicas of a shard are
+	// deduplicated and the limit applied once all hosts have responded.
+	wg.Wait()
+
+	if err := resultErr.FinalError(); err != nil {
+		return index.CardinalityResult{}, err
+	}
+
+	return acc.Result(opts.Limit), nil
+}
+
 // NB(r): Excluding maligned struct check here as we can
 // live with a few extra bytes since this struct is only
 // ever passed by stack, its much more readable not optimized
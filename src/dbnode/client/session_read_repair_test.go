@@ -0,0 +1,96 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionWriteReadRepair(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions().SetReadRepairEnabled(true)
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+	require.NotNil(t, session.readRepairer)
+
+	var (
+		now         = time.Now().Truncate(time.Second)
+		encodedTags = []byte("encoded-tags")
+	)
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			write, ok := op.(*writeTaggedOperation)
+			require.True(t, ok)
+			assert.Equal(t, "testns", write.namespace.String())
+			assert.Equal(t, []byte("foo"), write.request.ID)
+			assert.Equal(t, encodedTags, write.request.EncodedTags)
+			assert.Equal(t, 42.0, write.request.Datapoint.Value)
+			assert.Equal(t, now.Unix(), write.request.Datapoint.Timestamp)
+			assert.Equal(t, rpc.TimeType_UNIX_SECONDS,
+				write.request.Datapoint.TimestampTimeType)
+			write.CompletionFn()(nil, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	var completed int32
+	session.state.RLock()
+	hosts := session.state.topoMap.Hosts()
+	session.state.RUnlock()
+	for _, host := range hosts {
+		err := session.writeReadRepair(readRepairWrite{
+			host:        host,
+			namespace:   ident.StringID("testns"),
+			id:          ident.StringID("foo"),
+			encodedTags: encodedTags,
+			datapoints: []readRepairDatapoint{{
+				Datapoint: ts.Datapoint{Timestamp: now, Value: 42},
+				unit:      xtime.Second,
+			}},
+			completionFn: func(err error) {
+				assert.NoError(t, err)
+				atomic.AddInt32(&completed, 1)
+			},
+		})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(len(hosts)), atomic.LoadInt32(&completed))
+
+	assert.NoError(t, session.Close())
+
+	err = session.writeReadRepair(readRepairWrite{host: hosts[0]})
+	assert.Equal(t, errSessionStatusNotOpen, err)
+}
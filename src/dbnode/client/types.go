@@ -81,6 +81,10 @@ type Session interface {
 	// Aggregate aggregates values from the database for the given set of constraints.
 	Aggregate(namespace ident.ID, q index.Query, opts index.AggregationOptions) (iter AggregatedTagsIterator, exhaustive bool, err error)
 
+	// Cardinality returns the metric names, label names and label value pairs
+	// with the most series in the namespace's reverse index across all hosts.
+	Cardinality(namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error)
+
 	// ShardID returns the given shard for an ID for callers
 	// to easily discern what shard is failing when operations
 	// for given IDs begin failing.
@@ -236,6 +240,10 @@ type Options interface {
 	// SetEncodingProto sets proto encoding.
 	SetEncodingProto(encodingOpts encoding.Options) Options
 
+	// SetEncodingHistogram sets histogram encoding, series are either streams
+	// of sparse histograms written as datapoint annotations or m3tsz encoded.
+	SetEncodingHistogram(encodingOpts encoding.Options) Options
+
 	// SetRuntimeOptionsManager sets the runtime options manager, it is optional
 	SetRuntimeOptionsManager(value runtime.OptionsManager) Options
 
@@ -266,6 +274,71 @@ type Options interface {
 	// topology.ReadConsistencyLevel returns the read consistency level.
 	ReadConsistencyLevel() topology.ReadConsistencyLevel
 
+	// SetReadRepairEnabled sets whether fetches at a read consistency level
+	// above one repair replicas that returned divergent blocks.
+	SetReadRepairEnabled(value bool) Options
+
+	// ReadRepairEnabled returns whether fetches at a read consistency level
+	// above one repair replicas that returned divergent blocks.
+	ReadRepairEnabled() bool
+
+	// SetReadRepairConcurrency sets the number of read repairs that can be
+	// performed concurrently in the background.
+	SetReadRepairConcurrency(value int) Options
+
+	// ReadRepairConcurrency returns the number of read repairs that can be
+	// performed concurrently in the background.
+	ReadRepairConcurrency() int
+
+	// SetReadIsolationGroup sets the isolation group of the caller, replicas
+	// in the same isolation group are preferred when performing hedged reads.
+	SetReadIsolationGroup(value string) Options
+
+	// ReadIsolationGroup returns the isolation group of the caller, replicas
+	// in the same isolation group are preferred when performing hedged reads.
+	ReadIsolationGroup() string
+
+	// SetHedgedReadsEnabled sets whether fetches only request the replicas
+	// required by the read consistency level up front and hedge to further
+	// replicas when they are slow to respond or fail. Hedged reads only
+	// apply to Fetch and FetchIDs, FetchTagged and Aggregate still query
+	// every host since index queries span the shards of every host. Hedged
+	// reads cannot be enabled together with read repair, since read repair
+	// compares the results of every replica while hedged reads only read
+	// from as many replicas as the read consistency level requires.
+	SetHedgedReadsEnabled(value bool) Options
+
+	// HedgedReadsEnabled returns whether fetches only request the replicas
+	// required by the read consistency level up front and hedge to further
+	// replicas when they are slow to respond or fail.
+	HedgedReadsEnabled() bool
+
+	// SetHedgedReadPercentile sets the per host latency percentile after
+	// which a hedged request is sent to another replica.
+	SetHedgedReadPercentile(value float64) Options
+
+	// HedgedReadPercentile returns the per host latency percentile after
+	// which a hedged request is sent to another replica.
+	HedgedReadPercentile() float64
+
+	// SetHedgedReadMinDelay sets the minimum delay before a hedged request
+	// is sent to another replica.
+	SetHedgedReadMinDelay(value time.Duration) Options
+
+	// HedgedReadMinDelay returns the minimum delay before a hedged request
+	// is sent to another replica.
+	HedgedReadMinDelay() time.Duration
+
+	// SetHedgedReadMaxDelay sets the maximum delay before a hedged request
+	// is sent to another replica, also used when there are not yet enough
+	// latency samples for the hosts being read from.
+	SetHedgedReadMaxDelay(value time.Duration) Options
+
+	// HedgedReadMaxDelay returns the maximum delay before a hedged request
+	// is sent to another replica, also used when there are not yet enough
+	// latency samples for the hosts being read from.
+	HedgedReadMaxDelay() time.Duration
+
 	// SetWriteConsistencyLevel sets the write consistency level.
 	SetWriteConsistencyLevel(value topology.ConsistencyLevel) Options
 
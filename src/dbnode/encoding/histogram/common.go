@@ -0,0 +1,108 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+const (
+	// streamMarker is the first byte of every histogram stream. Streams encoded
+	// with m3tsz start with the big endian start time of the block which never
+	// has its top byte set for times after the epoch, which allows the mixed
+	// iterator to tell the two apart.
+	streamMarker = 0xFF
+
+	currentEncodingSchemeVersion = 1
+)
+
+const (
+	opCodeNoMoreDataOrChange = 0
+	opCodeMoreData           = 1
+
+	opCodeNoMoreData = 0
+
+	opCodeNoChange = 0
+	opCodeChange   = 1
+)
+
+// mergeLayout returns the union of the layout and the bucket upper bounds,
+// and whether it differs from the layout.
+func mergeLayout(layout []float64, buckets []Bucket) ([]float64, bool) {
+	var (
+		changed bool
+		i       int
+	)
+	for _, b := range buckets {
+		for i < len(layout) && layout[i] < b.UpperBound {
+			i++
+		}
+		if i == len(layout) || layout[i] != b.UpperBound {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return layout, false
+	}
+
+	merged := make([]float64, 0, len(layout)+len(buckets))
+	i = 0
+	for _, b := range buckets {
+		for i < len(layout) && layout[i] < b.UpperBound {
+			merged = append(merged, layout[i])
+			i++
+		}
+		if i < len(layout) && layout[i] == b.UpperBound {
+			i++
+		}
+		merged = append(merged, b.UpperBound)
+	}
+	merged = append(merged, layout[i:]...)
+	return merged, true
+}
+
+// remapCounts returns the counts for the previous layout aligned to the
+// next layout, buckets that did not exist previously have a zero count.
+func remapCounts(counts []uint64, prev, next []float64) []uint64 {
+	result := make([]uint64, len(next))
+	i := 0
+	for j, bound := range next {
+		for i < len(prev) && prev[i] < bound {
+			i++
+		}
+		if i < len(prev) && prev[i] == bound {
+			result[j] = counts[i]
+		}
+	}
+	return result
+}
+
+// alignCounts appends the bucket counts aligned to the layout to dst, the
+// layout must contain all of the bucket upper bounds.
+func alignCounts(dst []uint64, layout []float64, buckets []Bucket) []uint64 {
+	i := 0
+	for _, bound := range layout {
+		var count uint64
+		if i < len(buckets) && buckets[i].UpperBound == bound {
+			count = buckets[i].Count
+			i++
+		}
+		dst = append(dst, count)
+	}
+	return dst
+}
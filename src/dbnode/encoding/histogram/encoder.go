@@ -0,0 +1,306 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/checked"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// Make sure encoder implements encoding.Encoder.
+var _ encoding.Encoder = &Encoder{}
+
+var (
+	encErrPrefix           = "histogram encoder:"
+	errEncoderClosed       = fmt.Errorf("%s encoder is closed", encErrPrefix)
+	errNoEncodedDatapoints = fmt.Errorf("%s encoder has no encoded datapoints", encErrPrefix)
+)
+
+// Encoder compresses streams of sparse histograms. Timestamps are compressed
+// using delta-of-delta encoding, sums using XOR compression and the total and
+// per bucket counts as deltas from the previous histogram. The bucket upper
+// bounds are only written when a histogram has a bucket not seen previously.
+type Encoder struct {
+	opts   encoding.Options
+	stream encoding.OStream
+
+	timestampEncoder m3tsz.TimestampEncoder
+	sumEncoder       m3tsz.FloatEncoderAndIterator
+
+	layout     []float64
+	prevCount  uint64
+	prevCounts []uint64
+	counts     []uint64
+
+	numEncoded    int
+	lastEncodedDP ts.Datapoint
+
+	varIntBuf [binary.MaxVarintLen64]byte
+
+	hardErr error
+	closed  bool
+}
+
+// NewEncoder creates a new histogram encoder.
+func NewEncoder(start time.Time, opts encoding.Options) *Encoder {
+	initAllocIfEmpty := opts.EncoderPool() == nil
+	return &Encoder{
+		opts:   opts,
+		stream: encoding.NewOStream(nil, initAllocIfEmpty, opts.BytesPool()),
+		timestampEncoder: m3tsz.NewTimestampEncoder(
+			start, opts.DefaultTimeUnit(), opts),
+	}
+}
+
+// Encode encodes a timestamp and a histogram. The annotation is expected to be
+// a histogram marshaled with MarshalAnnotation, the value of the datapoint is
+// ignored and the histogram count is returned as the value when iterating.
+func (enc *Encoder) Encode(dp ts.Datapoint, timeUnit xtime.Unit, annotation ts.Annotation) error {
+	if err := enc.isUsable(); err != nil {
+		return err
+	}
+
+	h, err := UnmarshalAnnotation(annotation)
+	if err != nil {
+		return fmt.Errorf("%s error unmarshaling annotation: %v", encErrPrefix, err)
+	}
+	if err := h.Validate(); err != nil {
+		return fmt.Errorf("%s invalid histogram: %v", encErrPrefix, err)
+	}
+
+	// From this point onwards all errors are "hard errors" meaning that they
+	// render the encoder unusable since partial data may have been encoded.
+
+	if enc.numEncoded == 0 {
+		enc.stream.WriteByte(streamMarker)
+		enc.encodeVarInt(currentEncodingSchemeVersion)
+	}
+
+	layout, layoutChanged := mergeLayout(enc.layout, h.Buckets)
+	if layoutChanged {
+		enc.prevCounts = remapCounts(enc.prevCounts, enc.layout, layout)
+		enc.layout = layout
+	}
+
+	timeUnitChanged := timeUnit != enc.timestampEncoder.TimeUnit
+	if timeUnitChanged || layoutChanged {
+		enc.stream.WriteBit(opCodeNoMoreDataOrChange)
+		enc.stream.WriteBit(opCodeChange)
+		enc.writeChangeBit(timeUnitChanged)
+		enc.writeChangeBit(layoutChanged)
+		if timeUnitChanged {
+			// Written explicitly rather than relying on the timestamp encoder's
+			// marker scheme since the bucket counts could legitimately match
+			// the markers.
+			enc.timestampEncoder.WriteTimeUnit(enc.stream, timeUnit)
+		}
+		if layoutChanged {
+			enc.encodeVarInt(uint64(len(enc.layout)))
+			for _, bound := range enc.layout {
+				enc.stream.WriteBits(math.Float64bits(bound), 64)
+			}
+		}
+	} else {
+		enc.stream.WriteBit(opCodeMoreData)
+	}
+
+	if err := enc.timestampEncoder.WriteTime(enc.stream, dp.Timestamp, nil, timeUnit); err != nil {
+		enc.hardErr = err
+		return fmt.Errorf("%s error encoding timestamp: %v", encErrPrefix, err)
+	}
+
+	enc.sumEncoder.WriteFloat(enc.stream, h.Sum)
+	enc.encodeDelta(h.Count, enc.prevCount)
+	enc.prevCount = h.Count
+
+	enc.counts = alignCounts(enc.counts[:0], enc.layout, h.Buckets)
+	for i, count := range enc.counts {
+		enc.encodeDelta(count, enc.prevCounts[i])
+		enc.prevCounts[i] = count
+	}
+
+	enc.numEncoded++
+	enc.lastEncodedDP = ts.Datapoint{
+		Timestamp: dp.Timestamp,
+		Value:     float64(h.Count),
+	}
+	return nil
+}
+
+func (enc *Encoder) writeChangeBit(changed bool) {
+	if changed {
+		enc.stream.WriteBit(opCodeChange)
+	} else {
+		enc.stream.WriteBit(opCodeNoChange)
+	}
+}
+
+func (enc *Encoder) encodeDelta(curr, prev uint64) {
+	if curr == prev {
+		enc.stream.WriteBit(opCodeNoChange)
+		return
+	}
+	enc.stream.WriteBit(opCodeChange)
+	delta := int64(curr - prev)
+	enc.encodeVarInt(uint64((delta << 1) ^ (delta >> 63)))
+}
+
+func (enc *Encoder) encodeVarInt(x uint64) {
+	n := binary.PutUvarint(enc.varIntBuf[:], x)
+	enc.stream.WriteBytes(enc.varIntBuf[:n])
+}
+
+// Stream returns a copy of the underlying data stream.
+func (enc *Encoder) Stream(opts encoding.StreamOptions) (xio.SegmentReader, bool) {
+	seg := enc.segment(true)
+	if seg.Len() == 0 {
+		return nil, false
+	}
+
+	if readerPool := enc.opts.SegmentReaderPool(); readerPool != nil {
+		reader := readerPool.Get()
+		reader.Reset(seg)
+		return reader, true
+	}
+	return xio.NewSegmentReader(seg), true
+}
+
+func (enc *Encoder) segment(copy bool) ts.Segment {
+	length := enc.stream.Len()
+	if length == 0 {
+		return ts.Segment{}
+	}
+
+	var head checked.Bytes
+	buffer, _ := enc.stream.Rawbytes()
+	if !copy {
+		// Take ref from the ostream.
+		head = enc.stream.Discard()
+	} else {
+		// Copy into new buffer.
+		head = enc.newBuffer(length)
+		head.IncRef()
+		head.AppendAll(buffer)
+		head.DecRef()
+	}
+
+	return ts.NewSegment(head, nil, ts.FinalizeHead)
+}
+
+// NumEncoded returns the number of encoded histograms.
+func (enc *Encoder) NumEncoded() int {
+	return enc.numEncoded
+}
+
+// LastEncoded returns the last encoded datapoint, the value is the count of
+// the last encoded histogram.
+func (enc *Encoder) LastEncoded() (ts.Datapoint, error) {
+	if err := enc.isUsable(); err != nil {
+		return ts.Datapoint{}, err
+	}
+	if enc.numEncoded == 0 {
+		return ts.Datapoint{}, errNoEncodedDatapoints
+	}
+	return enc.lastEncodedDP, nil
+}
+
+// Len returns the length of the data stream.
+func (enc *Encoder) Len() int {
+	return enc.stream.Len()
+}
+
+// SetSchema is a no-op since histograms are not schema aware.
+func (enc *Encoder) SetSchema(_ namespace.SchemaDescr) {}
+
+// Reset resets the encoder for reuse.
+func (enc *Encoder) Reset(start time.Time, capacity int, _ namespace.SchemaDescr) {
+	enc.stream.Reset(enc.newBuffer(capacity))
+	enc.timestampEncoder = m3tsz.NewTimestampEncoder(
+		start, enc.opts.DefaultTimeUnit(), enc.opts)
+	enc.sumEncoder = m3tsz.FloatEncoderAndIterator{}
+	enc.layout = nil
+	enc.prevCount = 0
+	enc.prevCounts = nil
+	enc.counts = enc.counts[:0]
+	enc.numEncoded = 0
+	enc.lastEncodedDP = ts.Datapoint{}
+	enc.hardErr = nil
+	enc.closed = false
+}
+
+// Close closes the encoder.
+func (enc *Encoder) Close() {
+	if enc.closed {
+		return
+	}
+
+	enc.Reset(time.Time{}, 0, nil)
+	enc.stream.Reset(nil)
+	enc.closed = true
+
+	if pool := enc.opts.EncoderPool(); pool != nil {
+		pool.Put(enc)
+	}
+}
+
+// Discard closes the encoder and transfers ownership of the data stream to
+// the caller.
+func (enc *Encoder) Discard() ts.Segment {
+	segment := enc.segment(false)
+	enc.Close()
+	return segment
+}
+
+// DiscardReset does the same thing as Discard except it also resets the encoder
+// for reuse.
+func (enc *Encoder) DiscardReset(start time.Time, capacity int, descr namespace.SchemaDescr) ts.Segment {
+	segment := enc.segment(false)
+	enc.Reset(start, capacity, descr)
+	return segment
+}
+
+func (enc *Encoder) isUsable() error {
+	if enc.closed {
+		return errEncoderClosed
+	}
+	if enc.hardErr != nil {
+		return fmt.Errorf("%s err encoder unusable due to hard err: %v",
+			encErrPrefix, enc.hardErr)
+	}
+	return nil
+}
+
+func (enc *Encoder) newBuffer(capacity int) checked.Bytes {
+	if bytesPool := enc.opts.BytesPool(); bytesPool != nil {
+		return bytesPool.Get(capacity)
+	}
+	return checked.NewBytes(make([]byte, 0, capacity), nil)
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	annotationMagic   = 0xb7
+	annotationVersion = 1
+	annotationHeader  = 2
+)
+
+var (
+	errAnnotationTooShort   = errors.New("histogram annotation too short")
+	errAnnotationNotMagic   = errors.New("annotation is not a histogram")
+	errAnnotationBadVersion = errors.New("histogram annotation has unknown version")
+	errAnnotationTruncated  = errors.New("histogram annotation truncated")
+)
+
+// Histogram is a sparse histogram of observations, only buckets with a
+// non-zero count are stored.
+type Histogram struct {
+	// Sum is the sum of all observations.
+	Sum float64
+	// Count is the number of observations, including those greater than the
+	// upper bound of the last bucket.
+	Count uint64
+	// Buckets are the non-cumulative bucket counts ordered by upper bound.
+	Buckets []Bucket
+}
+
+// Bucket is a single histogram bucket.
+type Bucket struct {
+	// UpperBound is the inclusive upper bound of the bucket.
+	UpperBound float64
+	// Count is the number of observations in the bucket that were greater
+	// than the upper bound of the previous bucket.
+	Count uint64
+}
+
+// NewFromCumulative creates a sparse histogram from cumulative bucket counts,
+// such as those of a Prometheus histogram, the count of the last bucket is
+// used as the histogram count if its upper bound is +Inf.
+func NewFromCumulative(
+	upperBounds []float64,
+	cumulativeCounts []uint64,
+	sum float64,
+) (Histogram, error) {
+	if len(upperBounds) != len(cumulativeCounts) {
+		return Histogram{}, fmt.Errorf(
+			"mismatched upper bounds and counts: %d != %d",
+			len(upperBounds), len(cumulativeCounts))
+	}
+
+	h := Histogram{Sum: sum}
+	var prev uint64
+	for i, bound := range upperBounds {
+		count := cumulativeCounts[i]
+		if count < prev {
+			return Histogram{}, fmt.Errorf(
+				"cumulative count decreased at bucket %v", bound)
+		}
+		if math.IsInf(bound, 1) {
+			h.Count = count
+			prev = count
+			break
+		}
+		if count > prev {
+			h.Buckets = append(h.Buckets, Bucket{UpperBound: bound, Count: count - prev})
+		}
+		prev = count
+	}
+	if h.Count < prev {
+		h.Count = prev
+	}
+	return h, h.Validate()
+}
+
+// Validate validates the histogram.
+func (h Histogram) Validate() error {
+	var total uint64
+	for i, b := range h.Buckets {
+		if math.IsNaN(b.UpperBound) || math.IsInf(b.UpperBound, 0) {
+			return fmt.Errorf("invalid bucket upper bound: %v", b.UpperBound)
+		}
+		if i > 0 && b.UpperBound <= h.Buckets[i-1].UpperBound {
+			return fmt.Errorf("bucket upper bounds not increasing: %v <= %v",
+				b.UpperBound, h.Buckets[i-1].UpperBound)
+		}
+		total += b.Count
+	}
+	if total > h.Count {
+		return fmt.Errorf("bucket counts %d exceed histogram count %d",
+			total, h.Count)
+	}
+	return nil
+}
+
+// Cumulative returns the cumulative count of observations less than or equal
+// to each of the upper bounds, which must be sorted in ascending order.
+func (h Histogram) Cumulative(upperBounds []float64) []uint64 {
+	var (
+		result = make([]uint64, len(upperBounds))
+		total  uint64
+		idx    int
+	)
+	for i, bound := range upperBounds {
+		if math.IsInf(bound, 1) {
+			result[i] = h.Count
+			continue
+		}
+		for idx < len(h.Buckets) && h.Buckets[idx].UpperBound <= bound {
+			total += h.Buckets[idx].Count
+			idx++
+		}
+		result[i] = total
+	}
+	return result
+}
+
+// MarshalAnnotation appends the histogram to buf in the form written as the
+// annotation of a datapoint.
+func (h Histogram) MarshalAnnotation(buf []byte) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+	buf = append(buf, annotationMagic, annotationVersion)
+	buf = appendUint64(buf, math.Float64bits(h.Sum))
+	buf = append(buf, scratch[:binary.PutUvarint(scratch[:], h.Count)]...)
+	buf = append(buf, scratch[:binary.PutUvarint(scratch[:], uint64(len(h.Buckets)))]...)
+	for _, b := range h.Buckets {
+		buf = appendUint64(buf, math.Float64bits(b.UpperBound))
+		buf = append(buf, scratch[:binary.PutUvarint(scratch[:], b.Count)]...)
+	}
+	return buf
+}
+
+// IsAnnotation returns whether the annotation is a marshaled histogram.
+func IsAnnotation(annotation []byte) bool {
+	return len(annotation) >= annotationHeader &&
+		annotation[0] == annotationMagic &&
+		annotation[1] == annotationVersion
+}
+
+// UnmarshalAnnotation unmarshals a histogram from a datapoint annotation.
+func UnmarshalAnnotation(annotation []byte) (Histogram, error) {
+	var h Histogram
+	if len(annotation) < annotationHeader {
+		return h, errAnnotationTooShort
+	}
+	if annotation[0] != annotationMagic {
+		return h, errAnnotationNotMagic
+	}
+	if annotation[1] != annotationVersion {
+		return h, errAnnotationBadVersion
+	}
+
+	r := annotationReader{buf: annotation[annotationHeader:]}
+	h.Sum = math.Float64frombits(r.uint64())
+	h.Count = r.uvarint()
+	numBuckets := r.uvarint()
+	if r.err == nil && numBuckets > uint64(len(r.buf)) {
+		// Each bucket takes at least one byte, guard against large allocations.
+		return Histogram{}, errAnnotationTruncated
+	}
+	if numBuckets > 0 {
+		h.Buckets = make([]Bucket, 0, numBuckets)
+	}
+	for i := uint64(0); i < numBuckets && r.err == nil; i++ {
+		h.Buckets = append(h.Buckets, Bucket{
+			UpperBound: math.Float64frombits(r.uint64()),
+			Count:      r.uvarint(),
+		})
+	}
+	if r.err != nil {
+		return Histogram{}, r.err
+	}
+	return h, nil
+}
+
+// UpperBounds returns the sorted union of the bucket upper bounds of the
+// histograms.
+func UpperBounds(histograms []Histogram) []float64 {
+	seen := make(map[float64]struct{})
+	var bounds []float64
+	for _, h := range histograms {
+		for _, b := range h.Buckets {
+			if _, ok := seen[b.UpperBound]; ok {
+				continue
+			}
+			seen[b.UpperBound] = struct{}{}
+			bounds = append(bounds, b.UpperBound)
+		}
+	}
+	sort.Float64s(bounds)
+	return bounds
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var scratch [8]byte
+	binary.BigEndian.PutUint64(scratch[:], v)
+	return append(buf, scratch[:]...)
+}
+
+type annotationReader struct {
+	buf []byte
+	err error
+}
+
+func (r *annotationReader) uint64() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.buf) < 8 {
+		r.err = errAnnotationTruncated
+		return 0
+	}
+	v := binary.BigEndian.Uint64(r.buf)
+	r.buf = r.buf[8:]
+	return v
+}
+
+func (r *annotationReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		r.err = errAnnotationTruncated
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
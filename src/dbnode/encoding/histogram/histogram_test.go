@@ -0,0 +1,105 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromCumulative(t *testing.T) {
+	h, err := NewFromCumulative(
+		[]float64{0.1, 0.5, 1, math.Inf(1)},
+		[]uint64{2, 2, 5, 7},
+		3.5)
+	require.NoError(t, err)
+	require.Equal(t, Histogram{
+		Sum:   3.5,
+		Count: 7,
+		Buckets: []Bucket{
+			{UpperBound: 0.1, Count: 2},
+			{UpperBound: 1, Count: 3},
+		},
+	}, h)
+
+	require.Equal(t, []uint64{2, 2, 5, 7},
+		h.Cumulative([]float64{0.1, 0.5, 1, math.Inf(1)}))
+
+	_, err = NewFromCumulative([]float64{0.1, 0.5}, []uint64{2, 1}, 0)
+	require.Error(t, err)
+
+	_, err = NewFromCumulative([]float64{0.1}, []uint64{2, 1}, 0)
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Histogram{}.Validate())
+	require.Error(t, Histogram{
+		Count:   1,
+		Buckets: []Bucket{{UpperBound: 1, Count: 1}, {UpperBound: 1}},
+	}.Validate())
+	require.Error(t, Histogram{
+		Count:   1,
+		Buckets: []Bucket{{UpperBound: 1, Count: 2}},
+	}.Validate())
+	require.Error(t, Histogram{
+		Count:   1,
+		Buckets: []Bucket{{UpperBound: math.Inf(1), Count: 1}},
+	}.Validate())
+}
+
+func TestAnnotationRoundTrip(t *testing.T) {
+	h := Histogram{
+		Sum:   -12.25,
+		Count: 300,
+		Buckets: []Bucket{
+			{UpperBound: -1, Count: 10},
+			{UpperBound: 0.25, Count: 200},
+			{UpperBound: 1e9, Count: 1},
+		},
+	}
+
+	annotation := h.MarshalAnnotation(nil)
+	require.True(t, IsAnnotation(annotation))
+
+	decoded, err := UnmarshalAnnotation(annotation)
+	require.NoError(t, err)
+	require.Equal(t, h, decoded)
+
+	for i := 0; i < len(annotation); i++ {
+		_, err := UnmarshalAnnotation(annotation[:i])
+		require.Error(t, err)
+	}
+
+	require.False(t, IsAnnotation([]byte("foo")))
+	_, err = UnmarshalAnnotation([]byte("foo"))
+	require.Error(t, err)
+}
+
+func TestUpperBounds(t *testing.T) {
+	bounds := UpperBounds([]Histogram{
+		{Buckets: []Bucket{{UpperBound: 1}, {UpperBound: 5}}},
+		{Buckets: []Bucket{{UpperBound: 0.5}, {UpperBound: 5}}},
+	})
+	require.Equal(t, []float64{0.5, 1, 5}, bounds)
+}
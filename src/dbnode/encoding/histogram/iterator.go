@@ -0,0 +1,306 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var itErrPrefix = "histogram iterator:"
+
+type iterator struct {
+	opts   encoding.Options
+	err    error
+	stream encoding.IStream
+
+	tsIterator  m3tsz.TimestampIterator
+	sumIterator m3tsz.FloatEncoderAndIterator
+
+	layout []float64
+	count  uint64
+	counts []uint64
+
+	// Fields that are reused between function calls to
+	// avoid allocations.
+	varIntBuf      [binary.MaxVarintLen64]byte
+	histogram      Histogram
+	annotationBuf  []byte
+	consumedHeader bool
+	done           bool
+	closed         bool
+}
+
+// NewIterator creates a new histogram iterator.
+func NewIterator(
+	reader io.Reader,
+	_ namespace.SchemaDescr,
+	opts encoding.Options,
+) encoding.ReaderIterator {
+	return &iterator{
+		opts:       opts,
+		stream:     encoding.NewIStream(reader),
+		tsIterator: m3tsz.NewTimestampIterator(opts, true),
+	}
+}
+
+func (it *iterator) Next() bool {
+	if !it.hasNext() {
+		return false
+	}
+
+	if !it.consumedHeader {
+		marker, err := it.stream.ReadByte()
+		if err == io.EOF {
+			it.done = true
+			return false
+		}
+		if err != nil {
+			it.err = fmt.Errorf("%s error reading stream marker: %v", itErrPrefix, err)
+			return false
+		}
+		if marker != streamMarker {
+			it.err = fmt.Errorf("%s unexpected stream marker: %x", itErrPrefix, marker)
+			return false
+		}
+
+		// Can ignore the version number for now because we only have one.
+		if _, err := it.readVarInt(); err != nil {
+			it.err = fmt.Errorf("%s error reading stream header: %v", itErrPrefix, err)
+			return false
+		}
+		it.consumedHeader = true
+	}
+
+	moreDataControlBit, err := it.stream.ReadBit()
+	if err == io.EOF {
+		it.done = true
+		return false
+	}
+	if err != nil {
+		it.err = fmt.Errorf(
+			"%s error reading more data control bit: %v", itErrPrefix, err)
+		return false
+	}
+
+	if moreDataControlBit == opCodeNoMoreDataOrChange {
+		// The next bit will tell us whether we've reached the end of the stream
+		// or that the time unit and/or bucket layout has changed.
+		noMoreDataControlBit, err := it.stream.ReadBit()
+		if err == io.EOF || (err == nil && noMoreDataControlBit == opCodeNoMoreData) {
+			it.done = true
+			return false
+		}
+		if err != nil {
+			it.err = fmt.Errorf(
+				"%s error reading no more data control bit: %v", itErrPrefix, err)
+			return false
+		}
+
+		if err := it.readChange(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+
+	_, done, err := it.tsIterator.ReadTimestamp(it.stream)
+	if err != nil {
+		it.err = fmt.Errorf("%s error reading timestamp: %v", itErrPrefix, err)
+		return false
+	}
+	if done {
+		// This should never happen since we never encode the EndOfStream marker.
+		it.err = fmt.Errorf("%s unexpected end of timestamp stream", itErrPrefix)
+		return false
+	}
+
+	if err := it.sumIterator.ReadFloat(it.stream); err != nil {
+		it.err = fmt.Errorf("%s error reading sum: %v", itErrPrefix, err)
+		return false
+	}
+
+	if it.count, err = it.readDelta(it.count); err != nil {
+		it.err = fmt.Errorf("%s error reading count: %v", itErrPrefix, err)
+		return false
+	}
+
+	for i := range it.counts {
+		if it.counts[i], err = it.readDelta(it.counts[i]); err != nil {
+			it.err = fmt.Errorf("%s error reading bucket count: %v", itErrPrefix, err)
+			return false
+		}
+	}
+
+	// Keep the annotation version of the last iterated histogram up to date so
+	// it can be returned in subsequent calls to Current().
+	it.histogram.Sum = math.Float64frombits(it.sumIterator.PrevFloatBits)
+	it.histogram.Count = it.count
+	it.histogram.Buckets = it.histogram.Buckets[:0]
+	for i, count := range it.counts {
+		if count == 0 {
+			continue
+		}
+		it.histogram.Buckets = append(it.histogram.Buckets, Bucket{
+			UpperBound: it.layout[i],
+			Count:      count,
+		})
+	}
+	it.annotationBuf = it.histogram.MarshalAnnotation(it.annotationBuf[:0])
+
+	return it.hasNext()
+}
+
+func (it *iterator) readChange() error {
+	timeUnitChangedControlBit, err := it.stream.ReadBit()
+	if err != nil {
+		return fmt.Errorf(
+			"%s error reading time unit changed control bit: %v", itErrPrefix, err)
+	}
+
+	layoutChangedControlBit, err := it.stream.ReadBit()
+	if err != nil {
+		return fmt.Errorf(
+			"%s error reading layout changed control bit: %v", itErrPrefix, err)
+	}
+
+	if timeUnitChangedControlBit == opCodeChange {
+		if err := it.tsIterator.ReadTimeUnit(it.stream); err != nil {
+			return fmt.Errorf("%s error reading new time unit: %v", itErrPrefix, err)
+		}
+		// The encoder writes the time unit ahead of the timestamp so the timestamp
+		// encoder never sees a change and the delta of delta is encoded as usual.
+		it.tsIterator.TimeUnitChanged = false
+	}
+
+	if layoutChangedControlBit == opCodeChange {
+		numBounds, err := it.readVarInt()
+		if err != nil {
+			return fmt.Errorf("%s error reading number of bucket bounds: %v", itErrPrefix, err)
+		}
+
+		layout := make([]float64, 0, numBounds)
+		for i := uint64(0); i < numBounds; i++ {
+			bits, err := it.stream.ReadBits(64)
+			if err != nil {
+				return fmt.Errorf("%s error reading bucket bound: %v", itErrPrefix, err)
+			}
+			layout = append(layout, math.Float64frombits(bits))
+		}
+
+		it.counts = remapCounts(it.counts, it.layout, layout)
+		it.layout = layout
+	}
+
+	return nil
+}
+
+func (it *iterator) readDelta(prev uint64) (uint64, error) {
+	changed, err := it.stream.ReadBit()
+	if err != nil {
+		return 0, err
+	}
+	if changed == opCodeNoChange {
+		return prev, nil
+	}
+
+	zigzag, err := it.readVarInt()
+	if err != nil {
+		return 0, err
+	}
+	delta := int64(zigzag>>1) ^ -int64(zigzag&1)
+	return prev + uint64(delta), nil
+}
+
+func (it *iterator) readVarInt() (uint64, error) {
+	buf := it.varIntBuf[:0]
+	for {
+		b, err := it.stream.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+
+		buf = append(buf, b)
+		if b>>7 == 0 {
+			break
+		}
+		if len(buf) == len(it.varIntBuf) {
+			return 0, fmt.Errorf("%s var int overflows 64 bits", itErrPrefix)
+		}
+	}
+
+	varInt, _ := binary.Uvarint(buf)
+	return varInt, nil
+}
+
+// Current returns the current timestamp, the count of the current histogram
+// as the value and the marshaled histogram as the annotation.
+func (it *iterator) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	dp := ts.Datapoint{
+		Timestamp: it.tsIterator.PrevTime,
+		Value:     float64(it.count),
+	}
+	return dp, it.tsIterator.TimeUnit, it.annotationBuf
+}
+
+func (it *iterator) Err() error {
+	return it.err
+}
+
+func (it *iterator) Reset(reader io.Reader, _ namespace.SchemaDescr) {
+	it.stream.Reset(reader)
+	it.tsIterator = m3tsz.NewTimestampIterator(it.opts, true)
+	it.sumIterator = m3tsz.FloatEncoderAndIterator{}
+
+	it.err = nil
+	it.layout = nil
+	it.count = 0
+	it.counts = nil
+	it.annotationBuf = it.annotationBuf[:0]
+	it.consumedHeader = false
+	it.done = false
+	it.closed = false
+}
+
+func (it *iterator) Close() {
+	if it.closed {
+		return
+	}
+
+	it.Reset(nil, nil)
+	it.stream.Reset(nil)
+	it.closed = true
+
+	if pool := it.opts.ReaderIteratorPool(); pool != nil {
+		pool.Put(it)
+	}
+}
+
+func (it *iterator) hasNext() bool {
+	return it.err == nil && !it.done && !it.closed
+}
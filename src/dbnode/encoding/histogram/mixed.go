@@ -0,0 +1,316 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	mixedEncErrPrefix     = "mixed encoder:"
+	errMixedEncoderClosed = fmt.Errorf("%s encoder is closed", mixedEncErrPrefix)
+	errNotHistogramSeries = fmt.Errorf(
+		"%s histogram written to a series of floats", mixedEncErrPrefix)
+	errMixedNoEncodedDatapoints = fmt.Errorf(
+		"%s encoder has no encoded datapoints", mixedEncErrPrefix)
+)
+
+// Make sure mixedEncoder implements encoding.Encoder.
+var _ encoding.Encoder = &mixedEncoder{}
+
+// mixedEncoder chooses the encoding of each series from its first datapoint,
+// series whose first datapoint is a histogram are encoded as histograms and
+// all other series with m3tsz.
+type mixedEncoder struct {
+	opts      encoding.Options
+	m3tsz     encoding.Encoder
+	histogram *Encoder
+	active    encoding.Encoder
+
+	start    time.Time
+	capacity int
+	closed   bool
+}
+
+// NewMixedEncoder creates a new encoder that encodes series of histograms
+// with the histogram encoding and all other series with m3tsz, so that a
+// node can store both.
+func NewMixedEncoder(
+	start time.Time,
+	intOptimized bool,
+	opts encoding.Options,
+) encoding.Encoder {
+	// The inner encoders are owned by the mixed encoder and must never be
+	// returned to the pool themselves.
+	innerOpts := opts.SetEncoderPool(nil)
+	return &mixedEncoder{
+		opts:      opts,
+		m3tsz:     m3tsz.NewEncoder(start, nil, intOptimized, innerOpts),
+		histogram: NewEncoder(start, innerOpts),
+		start:     start,
+	}
+}
+
+func (enc *mixedEncoder) SetSchema(_ namespace.SchemaDescr) {}
+
+func (enc *mixedEncoder) Encode(
+	dp ts.Datapoint,
+	timeUnit xtime.Unit,
+	annotation ts.Annotation,
+) error {
+	if enc.closed {
+		return errMixedEncoderClosed
+	}
+
+	isHistogram := IsAnnotation(annotation)
+	if enc.active == nil {
+		enc.active = enc.m3tsz
+		if isHistogram {
+			enc.active = enc.histogram
+		}
+		enc.active.Reset(enc.start, enc.capacity, nil)
+	}
+	if isHistogram && enc.active == enc.m3tsz {
+		return errNotHistogramSeries
+	}
+
+	return enc.active.Encode(dp, timeUnit, annotation)
+}
+
+func (enc *mixedEncoder) Stream(opts encoding.StreamOptions) (xio.SegmentReader, bool) {
+	if enc.active == nil {
+		return nil, false
+	}
+	return enc.active.Stream(opts)
+}
+
+func (enc *mixedEncoder) NumEncoded() int {
+	if enc.active == nil {
+		return 0
+	}
+	return enc.active.NumEncoded()
+}
+
+func (enc *mixedEncoder) LastEncoded() (ts.Datapoint, error) {
+	if enc.closed {
+		return ts.Datapoint{}, errMixedEncoderClosed
+	}
+	if enc.active == nil {
+		return ts.Datapoint{}, errMixedNoEncodedDatapoints
+	}
+	return enc.active.LastEncoded()
+}
+
+func (enc *mixedEncoder) Len() int {
+	if enc.active == nil {
+		return 0
+	}
+	return enc.active.Len()
+}
+
+func (enc *mixedEncoder) Reset(start time.Time, capacity int, _ namespace.SchemaDescr) {
+	// The inner encoder is only reset once the first datapoint determines
+	// which one is used, until then there is no stream to allocate.
+	if enc.active != nil {
+		enc.active.Close()
+		enc.active = nil
+	}
+	enc.start = start
+	enc.capacity = capacity
+	enc.closed = false
+}
+
+func (enc *mixedEncoder) Close() {
+	if enc.closed {
+		return
+	}
+
+	enc.Reset(time.Time{}, 0, nil)
+	enc.closed = true
+
+	if pool := enc.opts.EncoderPool(); pool != nil {
+		pool.Put(enc)
+	}
+}
+
+func (enc *mixedEncoder) Discard() ts.Segment {
+	segment := enc.discard()
+	enc.Close()
+	return segment
+}
+
+func (enc *mixedEncoder) DiscardReset(
+	start time.Time,
+	capacity int,
+	descr namespace.SchemaDescr,
+) ts.Segment {
+	segment := enc.discard()
+	enc.Reset(start, capacity, descr)
+	return segment
+}
+
+func (enc *mixedEncoder) discard() ts.Segment {
+	if enc.active == nil {
+		return ts.Segment{}
+	}
+	// Discarding closes the inner encoder which is reset again once the
+	// next series starts.
+	segment := enc.active.Discard()
+	enc.active = nil
+	return segment
+}
+
+// mixedIterator iterates over streams encoded by the mixed encoder, each
+// stream is read as histograms or m3tsz depending on its first byte.
+type mixedIterator struct {
+	opts         encoding.Options
+	innerOpts    encoding.Options
+	intOptimized bool
+	reader       prefixedReader
+	m3tsz        encoding.ReaderIterator
+	histogram    encoding.ReaderIterator
+	active       encoding.ReaderIterator
+	err          error
+	closed       bool
+}
+
+// NewMixedIterator creates a new iterator for streams encoded by the mixed
+// encoder.
+func NewMixedIterator(
+	reader io.Reader,
+	descr namespace.SchemaDescr,
+	intOptimized bool,
+	opts encoding.Options,
+) encoding.ReaderIterator {
+	it := &mixedIterator{
+		opts:         opts,
+		innerOpts:    opts.SetReaderIteratorPool(nil),
+		intOptimized: intOptimized,
+	}
+	it.Reset(reader, descr)
+	return it
+}
+
+func (it *mixedIterator) Next() bool {
+	if it.err != nil || it.active == nil || it.closed {
+		return false
+	}
+	return it.active.Next()
+}
+
+func (it *mixedIterator) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	if it.active == nil {
+		return ts.Datapoint{}, xtime.None, nil
+	}
+	return it.active.Current()
+}
+
+func (it *mixedIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	if it.active == nil {
+		return nil
+	}
+	return it.active.Err()
+}
+
+func (it *mixedIterator) Reset(reader io.Reader, descr namespace.SchemaDescr) {
+	it.resetActive()
+	it.err = nil
+	it.closed = false
+	if reader == nil {
+		return
+	}
+
+	if _, err := io.ReadFull(reader, it.reader.prefix[:]); err != nil {
+		if err != io.EOF {
+			it.err = fmt.Errorf("mixed iterator: error reading stream: %v", err)
+		}
+		return
+	}
+	it.reader.hasPrefix = true
+	it.reader.reader = reader
+
+	if it.reader.prefix[0] == streamMarker {
+		if it.histogram == nil {
+			it.histogram = NewIterator(nil, descr, it.innerOpts)
+		}
+		it.active = it.histogram
+	} else {
+		if it.m3tsz == nil {
+			it.m3tsz = m3tsz.NewReaderIterator(nil, it.intOptimized, it.innerOpts)
+		}
+		it.active = it.m3tsz
+	}
+	it.active.Reset(&it.reader, descr)
+}
+
+func (it *mixedIterator) Close() {
+	if it.closed {
+		return
+	}
+
+	it.Reset(nil, nil)
+	it.closed = true
+
+	if pool := it.opts.ReaderIteratorPool(); pool != nil {
+		pool.Put(it)
+	}
+}
+
+func (it *mixedIterator) resetActive() {
+	if it.active != nil {
+		// Reset rather than close the inner iterator so it can be reused.
+		it.active.Reset(nil, nil)
+		it.active = nil
+	}
+	it.reader = prefixedReader{}
+}
+
+// prefixedReader replays the byte read to determine the encoding of a stream
+// ahead of the rest of the stream.
+type prefixedReader struct {
+	prefix    [1]byte
+	hasPrefix bool
+	reader    io.Reader
+}
+
+func (r *prefixedReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if r.hasPrefix {
+		p[0] = r.prefix[0]
+		r.hasPrefix = false
+		return 1, nil
+	}
+	return r.reader.Read(p)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMixedEncoderChoosesEncodingPerSeries(t *testing.T) {
+	var (
+		start = time.Now().Truncate(time.Hour)
+		enc   = NewMixedEncoder(start, true, testEncodingOptions)
+		h     = Histogram{Sum: 1, Count: 1, Buckets: []Bucket{{UpperBound: 1, Count: 1}}}
+	)
+
+	// A series of floats.
+	enc.Reset(start, 0, nil)
+	for i := 0; i < 3; i++ {
+		dp := ts.Datapoint{Timestamp: start.Add(time.Duration(i) * time.Second), Value: float64(i)}
+		require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+	}
+	require.Equal(t, errNotHistogramSeries,
+		enc.Encode(ts.Datapoint{Timestamp: start.Add(3 * time.Second)},
+			xtime.Second, h.MarshalAnnotation(nil)))
+
+	floats := enc.DiscardReset(start, 0, nil)
+	iter := NewMixedIterator(xio.NewSegmentReader(floats), nil, true, testEncodingOptions)
+	for i := 0; i < 3; i++ {
+		require.True(t, iter.Next())
+		dp, _, annotation := iter.Current()
+		require.Equal(t, float64(i), dp.Value)
+		require.Nil(t, annotation)
+	}
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+
+	// A series of histograms, reusing both the encoder and the iterator.
+	for i := 0; i < 3; i++ {
+		dp := ts.Datapoint{Timestamp: start.Add(time.Duration(i) * time.Second)}
+		require.NoError(t, enc.Encode(dp, xtime.Second, h.MarshalAnnotation(nil)))
+	}
+	require.Equal(t, 3, enc.NumEncoded())
+
+	stream, ok := enc.Stream(encoding.StreamOptions{})
+	require.True(t, ok)
+	iter.Reset(stream, nil)
+	for i := 0; i < 3; i++ {
+		require.True(t, iter.Next())
+		dp, _, annotation := iter.Current()
+		require.Equal(t, float64(h.Count), dp.Value)
+
+		decoded, err := UnmarshalAnnotation(annotation)
+		require.NoError(t, err)
+		require.Equal(t, h, decoded)
+	}
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+	iter.Close()
+	enc.Close()
+}
+
+func TestMixedEncoderEmpty(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	enc := NewMixedEncoder(start, true, testEncodingOptions)
+
+	_, ok := enc.Stream(encoding.StreamOptions{})
+	require.False(t, ok)
+	require.Equal(t, 0, enc.NumEncoded())
+	require.Equal(t, 0, enc.Len())
+	_, err := enc.LastEncoded()
+	require.Error(t, err)
+	segment := enc.Discard()
+	require.Equal(t, 0, segment.Len())
+
+	iter := NewMixedIterator(xio.NewSegmentReader(ts.Segment{}), nil, true, testEncodingOptions)
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+}
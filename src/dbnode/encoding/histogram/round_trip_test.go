@@ -0,0 +1,210 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/pool"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	bytesPool = pool.NewCheckedBytesPool(nil, nil, func(s []pool.Bucket) pool.BytesPool {
+		return pool.NewBytesPool(s, nil)
+	})
+	testEncodingOptions = encoding.NewOptions().
+				SetDefaultTimeUnit(xtime.Second).
+				SetBytesPool(bytesPool)
+)
+
+func init() {
+	bytesPool.Init()
+}
+
+type testHistogram struct {
+	timestamp time.Time
+	unit      xtime.Unit
+	histogram Histogram
+}
+
+func testRoundTrip(t *testing.T, input []testHistogram) {
+	start := time.Now().Truncate(time.Hour)
+	enc := NewEncoder(start, testEncodingOptions)
+	for _, h := range input {
+		dp := ts.Datapoint{Timestamp: h.timestamp}
+		err := enc.Encode(dp, h.unit, h.histogram.MarshalAnnotation(nil))
+		require.NoError(t, err)
+	}
+	require.Equal(t, len(input), enc.NumEncoded())
+
+	last, err := enc.LastEncoded()
+	require.NoError(t, err)
+	require.Equal(t, input[len(input)-1].timestamp, last.Timestamp)
+	require.Equal(t, float64(input[len(input)-1].histogram.Count), last.Value)
+
+	stream, ok := enc.Stream(encoding.StreamOptions{})
+	require.True(t, ok)
+
+	iter := NewIterator(stream, nil, testEncodingOptions)
+	defer iter.Close()
+
+	i := 0
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		require.True(t, input[i].timestamp.Equal(dp.Timestamp))
+		require.Equal(t, input[i].unit, unit)
+		require.Equal(t, float64(input[i].histogram.Count), dp.Value)
+
+		decoded, err := UnmarshalAnnotation(annotation)
+		require.NoError(t, err)
+		require.Equal(t, input[i].histogram, decoded)
+		i++
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, len(input), i)
+}
+
+func TestRoundTrip(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	testRoundTrip(t, []testHistogram{
+		{
+			timestamp: start,
+			unit:      xtime.Second,
+			histogram: Histogram{},
+		},
+		{
+			timestamp: start.Add(10 * time.Second),
+			unit:      xtime.Second,
+			histogram: Histogram{
+				Sum:     1.5,
+				Count:   3,
+				Buckets: []Bucket{{UpperBound: 0.5, Count: 1}, {UpperBound: 1, Count: 2}},
+			},
+		},
+		{
+			timestamp: start.Add(20 * time.Second),
+			unit:      xtime.Second,
+			histogram: Histogram{
+				Sum:     1.5,
+				Count:   3,
+				Buckets: []Bucket{{UpperBound: 0.5, Count: 1}, {UpperBound: 1, Count: 2}},
+			},
+		},
+		{
+			// Counter reset, counts decrease.
+			timestamp: start.Add(30 * time.Second),
+			unit:      xtime.Second,
+			histogram: Histogram{
+				Sum:     0.25,
+				Count:   1,
+				Buckets: []Bucket{{UpperBound: 0.5, Count: 1}},
+			},
+		},
+	})
+}
+
+func TestRoundTripLayoutChange(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	testRoundTrip(t, []testHistogram{
+		{
+			timestamp: start,
+			unit:      xtime.Second,
+			histogram: Histogram{
+				Sum:     10,
+				Count:   5,
+				Buckets: []Bucket{{UpperBound: 1, Count: 2}, {UpperBound: 10, Count: 3}},
+			},
+		},
+		{
+			timestamp: start.Add(10 * time.Second),
+			unit:      xtime.Second,
+			histogram: Histogram{
+				Sum:     20,
+				Count:   9,
+				Buckets: []Bucket{{UpperBound: 0.1, Count: 1}, {UpperBound: 10, Count: 7}},
+			},
+		},
+		{
+			timestamp: start.Add(20 * time.Second),
+			unit:      xtime.Second,
+			histogram: Histogram{
+				Sum:     40,
+				Count:   12,
+				Buckets: []Bucket{{UpperBound: 1, Count: 4}, {UpperBound: 100, Count: 8}},
+			},
+		},
+	})
+}
+
+func TestRoundTripTimeUnitChange(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	h := Histogram{Sum: 1, Count: 1, Buckets: []Bucket{{UpperBound: 1, Count: 1}}}
+	testRoundTrip(t, []testHistogram{
+		{timestamp: start, unit: xtime.Second, histogram: h},
+		{timestamp: start.Add(1500 * time.Millisecond), unit: xtime.Millisecond, histogram: h},
+		{timestamp: start.Add(3 * time.Second), unit: xtime.Second, histogram: h},
+	})
+}
+
+func TestEncoderCompressesUnchangedHistograms(t *testing.T) {
+	var (
+		start = time.Now().Truncate(time.Hour)
+		enc   = NewEncoder(start, testEncodingOptions)
+		h     = Histogram{
+			Sum:   100,
+			Count: 100,
+			Buckets: []Bucket{
+				{UpperBound: 0.1, Count: 10},
+				{UpperBound: 1, Count: 40},
+				{UpperBound: 10, Count: 50},
+			},
+		}
+		annotation = h.MarshalAnnotation(nil)
+	)
+	for i := 0; i < 100; i++ {
+		dp := ts.Datapoint{Timestamp: start.Add(time.Duration(i) * 10 * time.Second)}
+		require.NoError(t, enc.Encode(dp, xtime.Second, annotation))
+	}
+	// Every repeated histogram only needs a handful of control bits.
+	require.True(t, enc.Len() < 2*len(annotation)+100, "len: %d", enc.Len())
+}
+
+func TestEncoderInvalidAnnotation(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	enc := NewEncoder(start, testEncodingOptions)
+
+	dp := ts.Datapoint{Timestamp: start}
+	require.Error(t, enc.Encode(dp, xtime.Second, []byte("foo")))
+	require.Error(t, enc.Encode(dp, xtime.Second, Histogram{
+		Count:   1,
+		Buckets: []Bucket{{UpperBound: 1, Count: 2}},
+	}.MarshalAnnotation(nil)))
+
+	// Soft errors leave the encoder usable.
+	require.NoError(t, enc.Encode(dp, xtime.Second, Histogram{}.MarshalAnnotation(nil)))
+	require.Equal(t, 1, enc.NumEncoded())
+}
@@ -238,7 +238,7 @@ func newStaticShardSet(numShards int, hosts []topology.HostShardConfig) (shardin
 	}
 
 	for _, i := range hosts {
-		host := topology.NewHost(i.HostID, i.ListenAddress)
+		host := topology.NewHostWithIsolationGroup(i.HostID, i.ListenAddress, i.IsolationGroup)
 		hostShardSet := topology.NewHostShardSet(host, shardSet)
 		hostShardSets = append(hostShardSets, hostShardSet)
 	}
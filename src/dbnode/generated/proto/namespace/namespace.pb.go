@@ -33,6 +33,8 @@
 		IndexOptions
 		NamespaceOptions
 		Registry
+		DownsampleTier
+		DownsampleOptions
 		SchemaOptions
 		SchemaHistory
 		FileDescriptorSet
@@ -145,16 +147,17 @@ func (m *IndexOptions) GetBlockSizeNanos() int64 {
 }
 
 type NamespaceOptions struct {
-	BootstrapEnabled  bool              `protobuf:"varint,1,opt,name=bootstrapEnabled,proto3" json:"bootstrapEnabled,omitempty"`
-	FlushEnabled      bool              `protobuf:"varint,2,opt,name=flushEnabled,proto3" json:"flushEnabled,omitempty"`
-	WritesToCommitLog bool              `protobuf:"varint,3,opt,name=writesToCommitLog,proto3" json:"writesToCommitLog,omitempty"`
-	CleanupEnabled    bool              `protobuf:"varint,4,opt,name=cleanupEnabled,proto3" json:"cleanupEnabled,omitempty"`
-	RepairEnabled     bool              `protobuf:"varint,5,opt,name=repairEnabled,proto3" json:"repairEnabled,omitempty"`
-	RetentionOptions  *RetentionOptions `protobuf:"bytes,6,opt,name=retentionOptions" json:"retentionOptions,omitempty"`
-	SnapshotEnabled   bool              `protobuf:"varint,7,opt,name=snapshotEnabled,proto3" json:"snapshotEnabled,omitempty"`
-	IndexOptions      *IndexOptions     `protobuf:"bytes,8,opt,name=indexOptions" json:"indexOptions,omitempty"`
-	SchemaOptions     *SchemaOptions    `protobuf:"bytes,9,opt,name=schemaOptions" json:"schemaOptions,omitempty"`
-	ColdWritesEnabled bool              `protobuf:"varint,10,opt,name=coldWritesEnabled,proto3" json:"coldWritesEnabled,omitempty"`
+	BootstrapEnabled  bool               `protobuf:"varint,1,opt,name=bootstrapEnabled,proto3" json:"bootstrapEnabled,omitempty"`
+	FlushEnabled      bool               `protobuf:"varint,2,opt,name=flushEnabled,proto3" json:"flushEnabled,omitempty"`
+	WritesToCommitLog bool               `protobuf:"varint,3,opt,name=writesToCommitLog,proto3" json:"writesToCommitLog,omitempty"`
+	CleanupEnabled    bool               `protobuf:"varint,4,opt,name=cleanupEnabled,proto3" json:"cleanupEnabled,omitempty"`
+	RepairEnabled     bool               `protobuf:"varint,5,opt,name=repairEnabled,proto3" json:"repairEnabled,omitempty"`
+	RetentionOptions  *RetentionOptions  `protobuf:"bytes,6,opt,name=retentionOptions" json:"retentionOptions,omitempty"`
+	SnapshotEnabled   bool               `protobuf:"varint,7,opt,name=snapshotEnabled,proto3" json:"snapshotEnabled,omitempty"`
+	IndexOptions      *IndexOptions      `protobuf:"bytes,8,opt,name=indexOptions" json:"indexOptions,omitempty"`
+	SchemaOptions     *SchemaOptions     `protobuf:"bytes,9,opt,name=schemaOptions" json:"schemaOptions,omitempty"`
+	ColdWritesEnabled bool               `protobuf:"varint,10,opt,name=coldWritesEnabled,proto3" json:"coldWritesEnabled,omitempty"`
+	DownsampleOptions *DownsampleOptions `protobuf:"bytes,11,opt,name=downsampleOptions" json:"downsampleOptions,omitempty"`
 }
 
 func (m *NamespaceOptions) Reset()                    { *m = NamespaceOptions{} }
@@ -232,6 +235,13 @@ func (m *NamespaceOptions) GetColdWritesEnabled() bool {
 	return false
 }
 
+func (m *NamespaceOptions) GetDownsampleOptions() *DownsampleOptions {
+	if m != nil {
+		return m.DownsampleOptions
+	}
+	return nil
+}
+
 type Registry struct {
 	Namespaces map[string]*NamespaceOptions `protobuf:"bytes,1,rep,name=namespaces" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -248,11 +258,61 @@ func (m *Registry) GetNamespaces() map[string]*NamespaceOptions {
 	return nil
 }
 
+type DownsampleTier struct {
+	ResolutionNanos int64  `protobuf:"varint,1,opt,name=resolutionNanos,proto3" json:"resolutionNanos,omitempty"`
+	AfterNanos      int64  `protobuf:"varint,2,opt,name=afterNanos,proto3" json:"afterNanos,omitempty"`
+	Aggregation     string `protobuf:"bytes,3,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+}
+
+func (m *DownsampleTier) Reset()                    { *m = DownsampleTier{} }
+func (m *DownsampleTier) String() string            { return proto.CompactTextString(m) }
+func (*DownsampleTier) ProtoMessage()               {}
+func (*DownsampleTier) Descriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{4} }
+
+func (m *DownsampleTier) GetResolutionNanos() int64 {
+	if m != nil {
+		return m.ResolutionNanos
+	}
+	return 0
+}
+
+func (m *DownsampleTier) GetAfterNanos() int64 {
+	if m != nil {
+		return m.AfterNanos
+	}
+	return 0
+}
+
+func (m *DownsampleTier) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+type DownsampleOptions struct {
+	Tiers []*DownsampleTier `protobuf:"bytes,1,rep,name=tiers" json:"tiers,omitempty"`
+}
+
+func (m *DownsampleOptions) Reset()                    { *m = DownsampleOptions{} }
+func (m *DownsampleOptions) String() string            { return proto.CompactTextString(m) }
+func (*DownsampleOptions) ProtoMessage()               {}
+func (*DownsampleOptions) Descriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{5} }
+
+func (m *DownsampleOptions) GetTiers() []*DownsampleTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RetentionOptions)(nil), "namespace.RetentionOptions")
 	proto.RegisterType((*IndexOptions)(nil), "namespace.IndexOptions")
 	proto.RegisterType((*NamespaceOptions)(nil), "namespace.NamespaceOptions")
 	proto.RegisterType((*Registry)(nil), "namespace.Registry")
+	proto.RegisterType((*DownsampleTier)(nil), "namespace.DownsampleTier")
+	proto.RegisterType((*DownsampleOptions)(nil), "namespace.DownsampleOptions")
 }
 func (m *RetentionOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -460,6 +520,16 @@ func (m *NamespaceOptions) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.DownsampleOptions != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.DownsampleOptions.Size()))
+		n4, err := m.DownsampleOptions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
 	return i, nil
 }
 
@@ -509,6 +579,70 @@ func (m *Registry) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *DownsampleTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownsampleTier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ResolutionNanos != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.ResolutionNanos))
+	}
+	if m.AfterNanos != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.AfterNanos))
+	}
+	if len(m.Aggregation) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(len(m.Aggregation)))
+		i += copy(dAtA[i:], m.Aggregation)
+	}
+	return i, nil
+}
+
+func (m *DownsampleOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownsampleOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, msg := range m.Tiers {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNamespace(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintNamespace(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -593,6 +727,10 @@ func (m *NamespaceOptions) Size() (n int) {
 	if m.ColdWritesEnabled {
 		n += 2
 	}
+	if m.DownsampleOptions != nil {
+		l = m.DownsampleOptions.Size()
+		n += 1 + l + sovNamespace(uint64(l))
+	}
 	return n
 }
 
@@ -615,6 +753,34 @@ func (m *Registry) Size() (n int) {
 	return n
 }
 
+func (m *DownsampleTier) Size() (n int) {
+	var l int
+	_ = l
+	if m.ResolutionNanos != 0 {
+		n += 1 + sovNamespace(uint64(m.ResolutionNanos))
+	}
+	if m.AfterNanos != 0 {
+		n += 1 + sovNamespace(uint64(m.AfterNanos))
+	}
+	l = len(m.Aggregation)
+	if l > 0 {
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	return n
+}
+
+func (m *DownsampleOptions) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovNamespace(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovNamespace(x uint64) (n int) {
 	for {
 		n++
@@ -1169,6 +1335,39 @@ func (m *NamespaceOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ColdWritesEnabled = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownsampleOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownsampleOptions == nil {
+				m.DownsampleOptions = &DownsampleOptions{}
+			}
+			if err := m.DownsampleOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespace(dAtA[iNdEx:])
@@ -1363,6 +1562,204 @@ func (m *Registry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DownsampleTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownsampleTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownsampleTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionNanos", wireType)
+			}
+			m.ResolutionNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterNanos", wireType)
+			}
+			m.AfterNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownsampleOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownsampleOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownsampleOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, &DownsampleTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNamespace(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1473,41 +1870,46 @@ func init() {
 }
 
 var fileDescriptorNamespace = []byte{
-	// 575 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x51, 0x6b, 0xd3, 0x50,
-	0x14, 0xc7, 0x4d, 0xb3, 0xad, 0xed, 0x59, 0xe7, 0xe2, 0x45, 0xb0, 0x54, 0x28, 0xa3, 0x8a, 0x14,
-	0x91, 0x06, 0xdb, 0x17, 0x51, 0x18, 0xcc, 0xad, 0x0e, 0x41, 0x6a, 0xb9, 0x13, 0x84, 0xbd, 0xdd,
-	0x24, 0xa7, 0x6d, 0x58, 0x92, 0x1b, 0xee, 0xbd, 0xd1, 0xd5, 0xcf, 0xe0, 0x83, 0xdf, 0xc3, 0x2f,
-	0xe2, 0xa3, 0x1f, 0x41, 0xea, 0xd7, 0xf0, 0x41, 0x72, 0x63, 0xba, 0x24, 0x1d, 0x32, 0xf6, 0x52,
-	0x6e, 0xff, 0xe7, 0x77, 0xce, 0xb9, 0x3d, 0xff, 0x73, 0x0b, 0xa7, 0x73, 0x5f, 0x2d, 0x12, 0x67,
-	0xe0, 0xf2, 0xd0, 0x0e, 0x47, 0x9e, 0x63, 0x87, 0x23, 0x5b, 0x0a, 0xd7, 0xf6, 0x9c, 0x88, 0x7b,
-	0x68, 0xcf, 0x31, 0x42, 0xc1, 0x14, 0x7a, 0x76, 0x2c, 0xb8, 0xe2, 0x76, 0xc4, 0x42, 0x94, 0x31,
-	0x73, 0xf1, 0xea, 0x34, 0xd0, 0x11, 0xd2, 0x5c, 0x0b, 0x9d, 0x93, 0xdb, 0xd6, 0x94, 0xee, 0x02,
-	0x43, 0x96, 0x15, 0xec, 0x7d, 0x35, 0xc1, 0xa2, 0xa8, 0x30, 0x52, 0x3e, 0x8f, 0xde, 0xc7, 0xe9,
-	0xa7, 0x24, 0x43, 0xb8, 0x2f, 0x72, 0x6d, 0x8a, 0xc2, 0xe7, 0xde, 0x84, 0x45, 0x5c, 0xb6, 0x8d,
-	0x03, 0xa3, 0x6f, 0xd2, 0x6b, 0x63, 0xe4, 0x09, 0xdc, 0x75, 0x02, 0xee, 0x5e, 0x9c, 0xf9, 0x5f,
-	0x30, 0xa3, 0x6b, 0x9a, 0xae, 0xa8, 0xe4, 0x19, 0xdc, 0x73, 0x92, 0xd9, 0x0c, 0xc5, 0x9b, 0x44,
-	0x25, 0xe2, 0x1f, 0x6a, 0x6a, 0x74, 0x33, 0x40, 0xfa, 0xb0, 0x9f, 0x89, 0x53, 0x26, 0x55, 0xc6,
-	0x6e, 0x69, 0xb6, 0x2a, 0x6b, 0x32, 0xed, 0x74, 0xc2, 0x14, 0x1b, 0x5f, 0xc6, 0xbe, 0x58, 0xb6,
-	0xb7, 0x0f, 0x8c, 0x7e, 0x83, 0x56, 0x65, 0x72, 0x0e, 0xfd, 0x8a, 0x74, 0x34, 0x53, 0x28, 0x26,
-	0x5c, 0x1d, 0xb9, 0x2e, 0x4a, 0x59, 0xfc, 0xc5, 0x3b, 0xba, 0xd9, 0x8d, 0x79, 0x72, 0x08, 0x9d,
-	0x99, 0xbe, 0x3e, 0xbd, 0x6e, 0x7e, 0x75, 0x5d, 0xed, 0x3f, 0x44, 0x6f, 0x0a, 0xad, 0xb7, 0x91,
-	0x87, 0x97, 0xb9, 0x13, 0x6d, 0xa8, 0x63, 0xc4, 0x9c, 0x00, 0x3d, 0x3d, 0xfc, 0x06, 0xcd, 0xbf,
-	0xde, 0x74, 0xde, 0xbd, 0x3f, 0x26, 0x58, 0x93, 0xdc, 0xfb, 0xbc, 0xec, 0x53, 0xb0, 0x1c, 0xce,
-	0x95, 0x54, 0x82, 0xc5, 0xe3, 0x52, 0xfd, 0x0d, 0x9d, 0xf4, 0xa0, 0x35, 0x0b, 0x12, 0xb9, 0xc8,
-	0xb9, 0x9a, 0xe6, 0x4a, 0x5a, 0x6a, 0xea, 0x67, 0xe1, 0x2b, 0x94, 0x1f, 0xf8, 0x31, 0x0f, 0x43,
-	0x5f, 0xbd, 0xe3, 0x73, 0x6d, 0x6a, 0x83, 0x6e, 0x06, 0xd2, 0xab, 0xbb, 0x01, 0xb2, 0x28, 0x59,
-	0xf7, 0xde, 0xd2, 0x68, 0x45, 0x25, 0x8f, 0x61, 0x4f, 0x60, 0xcc, 0x7c, 0x91, 0x63, 0x99, 0xa1,
-	0x65, 0x91, 0x9c, 0x82, 0x25, 0x2a, 0x0b, 0xac, 0x6d, 0xdb, 0x1d, 0x3e, 0x1c, 0x5c, 0x3d, 0x9f,
-	0xea, 0x8e, 0xd3, 0x8d, 0xa4, 0x74, 0x83, 0x64, 0xc4, 0x62, 0xb9, 0xe0, 0x2a, 0x6f, 0x58, 0xcf,
-	0x36, 0xa8, 0x22, 0x93, 0x57, 0xd0, 0xf2, 0x0b, 0x2e, 0xb5, 0x1b, 0xba, 0xdd, 0x83, 0x42, 0xbb,
-	0xa2, 0x89, 0xb4, 0x04, 0x93, 0x43, 0xd8, 0xcb, 0x5e, 0x60, 0x9e, 0xdd, 0xd4, 0xd9, 0xed, 0x42,
-	0xf6, 0x59, 0x31, 0x4e, 0xcb, 0x78, 0x3a, 0x6b, 0x97, 0x07, 0xde, 0x47, 0x3d, 0xd6, 0xfc, 0xa2,
-	0x90, 0xcd, 0x7a, 0x23, 0xd0, 0xfb, 0x6e, 0x40, 0x83, 0xe2, 0xdc, 0x97, 0x4a, 0x2c, 0xc9, 0x31,
-	0xc0, 0xba, 0x49, 0xfa, 0x9a, 0xcd, 0xfe, 0xee, 0xf0, 0x51, 0x69, 0x48, 0x19, 0x38, 0x58, 0x2f,
-	0x8c, 0x1c, 0x47, 0x4a, 0x2c, 0x69, 0x21, 0xad, 0x73, 0x0e, 0xfb, 0x95, 0x30, 0xb1, 0xc0, 0xbc,
-	0xc0, 0xa5, 0xde, 0xa0, 0x26, 0x4d, 0x8f, 0xe4, 0x39, 0x6c, 0x7f, 0x62, 0x41, 0x82, 0x7a, 0x5b,
-	0xca, 0x4e, 0x54, 0x97, 0x91, 0x66, 0xe4, 0xcb, 0xda, 0x0b, 0xe3, 0xb5, 0xf5, 0x63, 0xd5, 0x35,
-	0x7e, 0xae, 0xba, 0xc6, 0xaf, 0x55, 0xd7, 0xf8, 0xf6, 0xbb, 0x7b, 0xc7, 0xd9, 0xd1, 0x7f, 0x53,
-	0xa3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0xfa, 0xeb, 0x0c, 0x42, 0x05, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x54, 0xdd, 0x6a, 0xd4, 0x40,
+	0x14, 0x76, 0xbb, 0x6d, 0x77, 0xf7, 0x74, 0xdb, 0xee, 0x0e, 0x82, 0x6b, 0x95, 0x52, 0xa2, 0x94,
+	0x45, 0x64, 0x83, 0xed, 0x8d, 0x28, 0x08, 0xb5, 0x5b, 0x8b, 0x22, 0x6b, 0x99, 0x16, 0x84, 0xde,
+	0x4d, 0x92, 0xb3, 0xd9, 0xd0, 0x24, 0x13, 0x66, 0x26, 0xb6, 0x15, 0x1f, 0xc1, 0x0b, 0xdf, 0xc3,
+	0x17, 0xe9, 0xa5, 0x8f, 0x20, 0xfa, 0x22, 0x26, 0x13, 0xb3, 0xcd, 0x4f, 0x91, 0xe2, 0x45, 0x42,
+	0xf2, 0x9d, 0xef, 0xfc, 0xe4, 0x7c, 0xdf, 0x04, 0x0e, 0x5d, 0x4f, 0xcd, 0x62, 0x6b, 0x64, 0xf3,
+	0xc0, 0x0c, 0x76, 0x1d, 0x2b, 0xb9, 0x99, 0x52, 0xd8, 0xa6, 0x63, 0x85, 0xdc, 0x41, 0xd3, 0xc5,
+	0x10, 0x05, 0x53, 0xe8, 0x98, 0x91, 0xe0, 0x8a, 0x9b, 0x21, 0x0b, 0x50, 0x46, 0xcc, 0xc6, 0xeb,
+	0xa7, 0x91, 0x8e, 0x90, 0xce, 0x1c, 0xd8, 0x18, 0xff, 0x6f, 0x4d, 0x69, 0xcf, 0x30, 0x60, 0x59,
+	0x41, 0xe3, 0x6b, 0x13, 0x7a, 0x14, 0x15, 0x86, 0xca, 0xe3, 0xe1, 0x87, 0x28, 0xbd, 0x4b, 0xb2,
+	0x03, 0x77, 0x45, 0x8e, 0x1d, 0xa1, 0xf0, 0xb8, 0x33, 0x61, 0x21, 0x97, 0x83, 0xc6, 0x56, 0x63,
+	0xd8, 0xa4, 0x37, 0xc6, 0xc8, 0x36, 0xac, 0x59, 0x3e, 0xb7, 0xcf, 0x8e, 0xbd, 0xcf, 0x98, 0xb1,
+	0x17, 0x34, 0xbb, 0x82, 0x92, 0xa7, 0xd0, 0xb7, 0xe2, 0xe9, 0x14, 0xc5, 0x9b, 0x58, 0xc5, 0xe2,
+	0x2f, 0xb5, 0xa9, 0xa9, 0xf5, 0x00, 0x19, 0xc2, 0x7a, 0x06, 0x1e, 0x31, 0xa9, 0x32, 0xee, 0xa2,
+	0xe6, 0x56, 0x61, 0xcd, 0x4c, 0x3b, 0x8d, 0x99, 0x62, 0x07, 0x17, 0x91, 0x27, 0x2e, 0x07, 0x4b,
+	0x09, 0xb3, 0x4d, 0xab, 0x30, 0x39, 0x85, 0x61, 0x05, 0xda, 0x9b, 0x2a, 0x14, 0x13, 0xae, 0xf6,
+	0x6c, 0x1b, 0xa5, 0x2c, 0x7e, 0xf1, 0xb2, 0x6e, 0x76, 0x6b, 0x3e, 0x79, 0x05, 0x1b, 0x53, 0x3d,
+	0x3e, 0xbd, 0x69, 0x7f, 0x2d, 0x5d, 0xed, 0x1f, 0x0c, 0xe3, 0x08, 0xba, 0x6f, 0x43, 0x07, 0x2f,
+	0x72, 0x25, 0x06, 0xd0, 0xc2, 0x90, 0x59, 0x3e, 0x3a, 0x7a, 0xf9, 0x6d, 0x9a, 0xbf, 0xde, 0x76,
+	0xdf, 0xc6, 0xd5, 0x22, 0xf4, 0x26, 0xb9, 0xf6, 0x79, 0xd9, 0x27, 0xd0, 0xb3, 0x38, 0x57, 0x52,
+	0x09, 0x16, 0x1d, 0x94, 0xea, 0xd7, 0x70, 0x62, 0x40, 0x77, 0xea, 0xc7, 0x72, 0x96, 0xf3, 0x16,
+	0x34, 0xaf, 0x84, 0xa5, 0xa2, 0x9e, 0x0b, 0x4f, 0xa1, 0x3c, 0xe1, 0xfb, 0x3c, 0x08, 0x3c, 0xf5,
+	0x9e, 0xbb, 0x5a, 0xd4, 0x36, 0xad, 0x07, 0xd2, 0xd1, 0x6d, 0x1f, 0x59, 0x18, 0xcf, 0x7b, 0x2f,
+	0x6a, 0x6a, 0x05, 0x25, 0x8f, 0x61, 0x55, 0x60, 0xc4, 0x3c, 0x91, 0xd3, 0x32, 0x41, 0xcb, 0x20,
+	0x39, 0x84, 0x9e, 0xa8, 0x18, 0x58, 0xcb, 0xb6, 0xb2, 0xf3, 0x60, 0x74, 0x7d, 0x7c, 0xaa, 0x1e,
+	0xa7, 0xb5, 0xa4, 0xd4, 0x41, 0x32, 0x64, 0x91, 0x9c, 0x71, 0x95, 0x37, 0x6c, 0x65, 0x0e, 0xaa,
+	0xc0, 0xe4, 0x25, 0x74, 0xbd, 0x82, 0x4a, 0x83, 0xb6, 0x6e, 0x77, 0xaf, 0xd0, 0xae, 0x28, 0x22,
+	0x2d, 0x91, 0x13, 0x8b, 0xac, 0x66, 0x27, 0x30, 0xcf, 0xee, 0xe8, 0xec, 0x41, 0x21, 0xfb, 0xb8,
+	0x18, 0xa7, 0x65, 0x7a, 0xba, 0x6b, 0x9b, 0xfb, 0xce, 0x47, 0xbd, 0xd6, 0x7c, 0x50, 0xc8, 0x76,
+	0x5d, 0x0b, 0x90, 0x77, 0xd0, 0x77, 0xf8, 0x79, 0x28, 0x59, 0x10, 0xf9, 0xb9, 0xfc, 0x83, 0x15,
+	0xdd, 0xf1, 0x61, 0xa1, 0xe3, 0xb8, 0xca, 0xa1, 0xf5, 0x34, 0xe3, 0x7b, 0x03, 0xda, 0x14, 0x5d,
+	0x2f, 0xb1, 0xc7, 0x25, 0xd9, 0x07, 0x98, 0xa7, 0xa7, 0x7f, 0x86, 0x66, 0x52, 0xf1, 0x51, 0x69,
+	0xe1, 0x19, 0x71, 0x34, 0x37, 0x5f, 0x32, 0x53, 0xf2, 0x4e, 0x0b, 0x69, 0x1b, 0xa7, 0xb0, 0x5e,
+	0x09, 0x93, 0x1e, 0x34, 0xcf, 0xf0, 0x52, 0xbb, 0xb1, 0x43, 0xd3, 0x47, 0xf2, 0x0c, 0x96, 0x3e,
+	0x31, 0x3f, 0x46, 0xed, 0xbc, 0xb2, 0xaa, 0x55, 0x63, 0xd3, 0x8c, 0xf9, 0x62, 0xe1, 0x79, 0xc3,
+	0xf8, 0x02, 0x6b, 0xd7, 0x5f, 0x75, 0xe2, 0xa1, 0x48, 0x05, 0x16, 0x28, 0xb9, 0x1f, 0xa7, 0xdc,
+	0xe2, 0x1f, 0xad, 0x0a, 0x93, 0x4d, 0x00, 0xa6, 0xcf, 0x78, 0xe1, 0x60, 0x15, 0x10, 0xb2, 0x05,
+	0x2b, 0xcc, 0x75, 0x05, 0xba, 0x2c, 0xcd, 0xd1, 0x4e, 0xef, 0xd0, 0x22, 0x64, 0x8c, 0xa1, 0x5f,
+	0xdb, 0x29, 0x31, 0x61, 0x49, 0x25, 0x83, 0xe4, 0xeb, 0xba, 0x7f, 0xa3, 0x00, 0xe9, 0xa8, 0x34,
+	0xe3, 0xbd, 0xee, 0x5d, 0xfd, 0xda, 0x6c, 0xfc, 0x48, 0xae, 0x9f, 0xc9, 0xf5, 0xed, 0xf7, 0xe6,
+	0x1d, 0x6b, 0x59, 0xff, 0xb6, 0x77, 0xff, 0x00, 0xcc, 0xc2, 0xb8, 0x90, 0x52, 0x06, 0x00, 0x00,
 }
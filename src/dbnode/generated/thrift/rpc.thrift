@@ -60,6 +60,8 @@ service Node {
 	void writeTaggedBatchRaw(1: WriteTaggedBatchRawRequest req) throws (1: WriteBatchRawErrors err)
 	void repair() throws (1: Error err)
 	TruncateResult truncate(1: TruncateRequest req) throws (1: Error err)
+	DeleteTaggedResult deleteTagged(1: DeleteTaggedRequest req) throws (1: Error err)
+	CardinalityResult cardinality(1: CardinalityRequest req) throws (1: Error err)
 
 	// Management endpoints
 	NodeHealthResult health() throws (1: Error err)
@@ -256,6 +258,44 @@ struct TruncateResult {
 	1: required i64 numSeries
 }
 
+struct DeleteTaggedRequest {
+	1: required binary nameSpace
+	2: required binary query
+	3: required i64 rangeStart
+	4: required i64 rangeEnd
+	5: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+}
+
+struct DeleteTaggedResult {
+	1: required i64 numSeries
+}
+
+struct CardinalityRequest {
+	1: required binary nameSpace
+	2: required i64 rangeStart
+	3: required i64 rangeEnd
+	5: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+	6: optional binary metricNameField
+}
+
+struct CardinalityEntry {
+	1: required binary name
+	2: optional binary value
+	3: required i64 numSeries
+}
+
+struct CardinalityShardResult {
+	1: required i32 shard
+	2: required i64 numSeries
+	3: required list<CardinalityEntry> metricNames
+	4: required list<CardinalityEntry> labelNames
+	5: required list<CardinalityEntry> labelValuePairs
+}
+
+struct CardinalityResult {
+	1: required list<CardinalityShardResult> shards
+}
+
 struct NodeHealthResult {
 	1: required bool ok
 	2: required string status
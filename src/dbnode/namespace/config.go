@@ -57,15 +57,18 @@ type MetadataConfiguration struct {
 	ColdWritesEnabled *bool                   `yaml:"coldWritesEnabled"`
 	Retention         retention.Configuration `yaml:"retention" validate:"nonzero"`
 	Index             IndexConfiguration      `yaml:"index"`
+	Downsample        DownsampleConfiguration `yaml:"downsample"`
 }
 
 // Metadata returns a Metadata corresponding to the receiver struct
 func (mc *MetadataConfiguration) Metadata() (Metadata, error) {
 	iopts := mc.Index.Options()
 	ropts := mc.Retention.Options()
+	dopts := mc.Downsample.Options()
 	opts := NewOptions().
 		SetRetentionOptions(ropts).
-		SetIndexOptions(iopts)
+		SetIndexOptions(iopts).
+		SetDownsampleOptions(dopts)
 	if v := mc.BootstrapEnabled; v != nil {
 		opts = opts.SetBootstrapEnabled(*v)
 	}
@@ -99,3 +102,28 @@ func (ic *IndexConfiguration) Options() IndexOptions {
 		SetEnabled(ic.Enabled).
 		SetBlockSize(ic.BlockSize)
 }
+
+// DownsampleConfiguration controls the downsampling of flushed data.
+type DownsampleConfiguration struct {
+	Tiers []DownsampleTierConfiguration `yaml:"tiers"`
+}
+
+// DownsampleTierConfiguration is the configuration for a single downsample tier.
+type DownsampleTierConfiguration struct {
+	Resolution  time.Duration         `yaml:"resolution" validate:"nonzero"`
+	After       time.Duration         `yaml:"after" validate:"nonzero"`
+	Aggregation DownsampleAggregation `yaml:"aggregation"`
+}
+
+// Options returns the DownsampleOptions corresponding to the receiver struct.
+func (dc *DownsampleConfiguration) Options() DownsampleOptions {
+	tiers := make([]DownsampleTier, 0, len(dc.Tiers))
+	for _, t := range dc.Tiers {
+		tiers = append(tiers, DownsampleTier{
+			Resolution:  t.Resolution,
+			After:       t.After,
+			Aggregation: t.Aggregation,
+		})
+	}
+	return NewDownsampleOptions().SetTiers(tiers)
+}
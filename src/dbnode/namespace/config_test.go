@@ -166,6 +166,13 @@ metadatas:
     index:
       enabled: true
       blockSize: 24h
+    downsample:
+      tiers:
+        - resolution: 5m
+          after: 48h
+        - resolution: 1h
+          after: 240h
+          aggregation: max
 `)
 
 	var conf MapConfiguration
@@ -230,5 +237,9 @@ metadatas:
 		SetBufferFuture(10 * time.Minute).
 		SetBufferPast(10 * time.Minute)
 	require.True(t, testRetentionOpts.Equal(opts.RetentionOptions()))
+	require.Equal(t, []DownsampleTier{
+		{Resolution: 5 * time.Minute, After: 48 * time.Hour, Aggregation: DownsampleMean},
+		{Resolution: time.Hour, After: 240 * time.Hour, Aggregation: DownsampleMax},
+	}, opts.DownsampleOptions().Tiers())
 
 }
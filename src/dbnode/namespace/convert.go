@@ -79,6 +79,34 @@ func ToIndexOptions(
 	return iopts, nil
 }
 
+// ToDownsampleOptions converts nsproto.DownsampleOptions to DownsampleOptions
+func ToDownsampleOptions(
+	do *nsproto.DownsampleOptions,
+) (DownsampleOptions, error) {
+	dopts := NewDownsampleOptions()
+	if do == nil {
+		return dopts, nil
+	}
+
+	tiers := make([]DownsampleTier, 0, len(do.Tiers))
+	for _, tier := range do.Tiers {
+		if tier == nil {
+			continue
+		}
+		aggregation, err := ParseDownsampleAggregation(tier.Aggregation)
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, DownsampleTier{
+			Resolution:  fromNanos(tier.ResolutionNanos),
+			After:       fromNanos(tier.AfterNanos),
+			Aggregation: aggregation,
+		})
+	}
+
+	return dopts.SetTiers(tiers), nil
+}
+
 // ToMetadata converts nsproto.Options to Metadata
 func ToMetadata(
 	id string,
@@ -98,6 +126,11 @@ func ToMetadata(
 		return nil, err
 	}
 
+	dopts, err := ToDownsampleOptions(opts.DownsampleOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	sr, err := LoadSchemaHistory(opts.GetSchemaOptions())
 	if err != nil {
 		return nil, err
@@ -113,7 +146,8 @@ func ToMetadata(
 		SetSchemaHistory(sr).
 		SetRetentionOptions(ropts).
 		SetIndexOptions(iopts).
-		SetColdWritesEnabled(opts.ColdWritesEnabled)
+		SetColdWritesEnabled(opts.ColdWritesEnabled).
+		SetDownsampleOptions(dopts)
 
 	return NewMetadata(ident.StringID(id), mopts)
 }
@@ -171,5 +205,25 @@ func OptionsToProto(opts Options) *nsproto.NamespaceOptions {
 			BlockSizeNanos: iopts.BlockSize().Nanoseconds(),
 		},
 		ColdWritesEnabled: opts.ColdWritesEnabled(),
+		DownsampleOptions: downsampleOptionsToProto(opts.DownsampleOptions()),
+	}
+}
+
+func downsampleOptionsToProto(dopts DownsampleOptions) *nsproto.DownsampleOptions {
+	tiers := dopts.Tiers()
+	if len(tiers) == 0 {
+		return nil
+	}
+
+	pb := &nsproto.DownsampleOptions{
+		Tiers: make([]*nsproto.DownsampleTier, 0, len(tiers)),
+	}
+	for _, tier := range tiers {
+		pb.Tiers = append(pb.Tiers, &nsproto.DownsampleTier{
+			ResolutionNanos: tier.Resolution.Nanoseconds(),
+			AfterNanos:      tier.After.Nanoseconds(),
+			Aggregation:     tier.Aggregation.String(),
+		})
 	}
+	return pb
 }
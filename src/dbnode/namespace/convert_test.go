@@ -53,6 +53,21 @@ var (
 		BlockDataExpiryAfterNotAccessPeriodNanos: toNanos(30), // 30m
 	}
 
+	validDownsampleOpts = nsproto.DownsampleOptions{
+		Tiers: []*nsproto.DownsampleTier{
+			&nsproto.DownsampleTier{
+				ResolutionNanos: toNanos(5),   // 5m
+				AfterNanos:      toNanos(240), // 4h
+				Aggregation:     "mean",
+			},
+			&nsproto.DownsampleTier{
+				ResolutionNanos: toNanos(60),  // 1h
+				AfterNanos:      toNanos(720), // 12h
+				Aggregation:     "max",
+			},
+		},
+	}
+
 	validNamespaceOpts = []nsproto.NamespaceOptions{
 		nsproto.NamespaceOptions{
 			BootstrapEnabled:  true,
@@ -71,6 +86,7 @@ var (
 			RepairEnabled:     true,
 			RetentionOptions:  &validRetentionOpts,
 			IndexOptions:      &validIndexOpts,
+			DownsampleOptions: &validDownsampleOpts,
 		},
 	}
 
@@ -101,6 +117,29 @@ var (
 			BlockDataExpiryAfterNotAccessPeriodNanos: toNanos(30), // 30m
 		},
 	}
+
+	invalidDownsampleOpts = []nsproto.DownsampleOptions{
+		// unknown aggregation
+		nsproto.DownsampleOptions{
+			Tiers: []*nsproto.DownsampleTier{
+				&nsproto.DownsampleTier{
+					ResolutionNanos: toNanos(5),   // 5m
+					AfterNanos:      toNanos(240), // 4h
+					Aggregation:     "median",
+				},
+			},
+		},
+		// after > retention
+		nsproto.DownsampleOptions{
+			Tiers: []*nsproto.DownsampleTier{
+				&nsproto.DownsampleTier{
+					ResolutionNanos: toNanos(5),    // 5m
+					AfterNanos:      toNanos(1260), // 21h
+					Aggregation:     "mean",
+				},
+			},
+		},
+	}
 )
 
 func TestNamespaceToRetentionValid(t *testing.T) {
@@ -146,6 +185,15 @@ func TestToNamespaceInvalid(t *testing.T) {
 			require.Error(t, err)
 		}
 	}
+
+	for _, nsopts := range validNamespaceOpts {
+		for _, do := range invalidDownsampleOpts {
+			opts := nsopts
+			opts.DownsampleOptions = &do
+			_, err := namespace.ToMetadata("abc", &opts)
+			require.Error(t, err)
+		}
+	}
 }
 
 func TestFromProto(t *testing.T) {
@@ -173,7 +221,13 @@ func TestToProto(t *testing.T) {
 		namespace.NewOptions().SetBootstrapEnabled(true))
 	require.NoError(t, err)
 	md2, err := namespace.NewMetadata(ident.StringID("ns2"),
-		namespace.NewOptions().SetBootstrapEnabled(false))
+		namespace.NewOptions().SetBootstrapEnabled(false).
+			SetDownsampleOptions(namespace.NewDownsampleOptions().
+				SetTiers([]namespace.DownsampleTier{{
+					Resolution:  time.Hour,
+					After:       24 * time.Hour,
+					Aggregation: namespace.DownsampleLast,
+				}})))
 	require.NoError(t, err)
 	nsMap, err := namespace.NewMap([]namespace.Metadata{md1, md2})
 	require.NoError(t, err)
@@ -286,6 +340,10 @@ func assertEqualMetadata(t *testing.T, name string, expected nsproto.NamespaceOp
 	require.True(t, expectedSchemaReg.Equal(observed.Options().SchemaHistory()))
 
 	assertEqualRetentions(t, *expected.RetentionOptions, opts.RetentionOptions())
+
+	expectedDownsample, err := namespace.ToDownsampleOptions(expected.DownsampleOptions)
+	require.NoError(t, err)
+	require.True(t, expectedDownsample.Equal(opts.DownsampleOptions()))
 }
 
 func assertEqualRetentions(t *testing.T, expected nsproto.RetentionOptions, observed retention.Options) {
@@ -0,0 +1,216 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/retention"
+)
+
+var (
+	errDownsampleAggregationUnspecified = errors.New("downsample aggregation unspecified")
+	errDownsampleResolutionPositive     = errors.New("downsample tier resolution must be positive")
+	errDownsampleAfterPositive          = errors.New("downsample tier after must be positive")
+	errDownsampleAfterTooLarge          = errors.New("downsample tier after must be less than namespace retention period")
+	errDownsampleBlockSizeMultiple      = errors.New("namespace block size must be a multiple of downsample tier resolution")
+	errDownsampleTiersNotOrdered        = errors.New("downsample tiers must be ordered by increasing after and resolution")
+	errDownsampleResolutionMultiple     = errors.New("downsample tier resolution must be a multiple of the previous tier resolution")
+)
+
+// DownsampleAggregation is the aggregation used to combine the datapoints
+// that fall within a single downsampled resolution window.
+type DownsampleAggregation uint
+
+const (
+	// DownsampleMean takes the mean of the datapoints in the window.
+	DownsampleMean DownsampleAggregation = iota
+	// DownsampleLast takes the last datapoint in the window.
+	DownsampleLast
+	// DownsampleMin takes the minimum datapoint in the window.
+	DownsampleMin
+	// DownsampleMax takes the maximum datapoint in the window.
+	DownsampleMax
+	// DownsampleSum takes the sum of the datapoints in the window.
+	DownsampleSum
+	// DownsampleCount takes the number of datapoints in the window.
+	DownsampleCount
+
+	// DefaultDownsampleAggregation is the default downsample aggregation.
+	DefaultDownsampleAggregation = DownsampleMean
+)
+
+// ValidDownsampleAggregations returns the valid downsample aggregations.
+func ValidDownsampleAggregations() []DownsampleAggregation {
+	return []DownsampleAggregation{
+		DownsampleMean,
+		DownsampleLast,
+		DownsampleMin,
+		DownsampleMax,
+		DownsampleSum,
+		DownsampleCount,
+	}
+}
+
+func (a DownsampleAggregation) String() string {
+	switch a {
+	case DownsampleMean:
+		return "mean"
+	case DownsampleLast:
+		return "last"
+	case DownsampleMin:
+		return "min"
+	case DownsampleMax:
+		return "max"
+	case DownsampleSum:
+		return "sum"
+	case DownsampleCount:
+		return "count"
+	}
+	return "unknown"
+}
+
+// ValidateDownsampleAggregation validates a downsample aggregation.
+func ValidateDownsampleAggregation(v DownsampleAggregation) error {
+	for _, valid := range ValidDownsampleAggregations() {
+		if valid == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid DownsampleAggregation '%d' valid types are: %v",
+		uint(v), ValidDownsampleAggregations())
+}
+
+// ParseDownsampleAggregation parses a DownsampleAggregation from a string.
+func ParseDownsampleAggregation(str string) (DownsampleAggregation, error) {
+	var r DownsampleAggregation
+	if str == "" {
+		return r, errDownsampleAggregationUnspecified
+	}
+	for _, valid := range ValidDownsampleAggregations() {
+		if str == valid.String() {
+			return valid, nil
+		}
+	}
+	return r, fmt.Errorf("invalid DownsampleAggregation '%s' valid types are: %v",
+		str, ValidDownsampleAggregations())
+}
+
+// UnmarshalYAML unmarshals a DownsampleAggregation into a valid type from string.
+func (a *DownsampleAggregation) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	r, err := ParseDownsampleAggregation(str)
+	if err != nil {
+		return err
+	}
+	*a = r
+	return nil
+}
+
+type downsampleOpts struct {
+	tiers []DownsampleTier
+}
+
+// NewDownsampleOptions returns a new DownsampleOptions, with no tiers
+// configured downsampling is disabled.
+func NewDownsampleOptions() DownsampleOptions {
+	return &downsampleOpts{}
+}
+
+func (d *downsampleOpts) Equal(value DownsampleOptions) bool {
+	other := value.Tiers()
+	if len(d.tiers) != len(other) {
+		return false
+	}
+	for i, tier := range d.tiers {
+		if tier != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *downsampleOpts) Validate(ropts retention.Options) error {
+	if len(d.tiers) == 0 {
+		return nil
+	}
+	var (
+		retentionPeriod = ropts.RetentionPeriod()
+		blockSize       = ropts.BlockSize()
+	)
+	for i, tier := range d.tiers {
+		if tier.Resolution <= 0 {
+			return errDownsampleResolutionPositive
+		}
+		if tier.After <= 0 {
+			return errDownsampleAfterPositive
+		}
+		if tier.After >= retentionPeriod {
+			return errDownsampleAfterTooLarge
+		}
+		if blockSize%tier.Resolution != 0 {
+			return errDownsampleBlockSizeMultiple
+		}
+		if err := ValidateDownsampleAggregation(tier.Aggregation); err != nil {
+			return err
+		}
+		if i == 0 {
+			continue
+		}
+		prev := d.tiers[i-1]
+		if tier.After <= prev.After || tier.Resolution <= prev.Resolution {
+			return errDownsampleTiersNotOrdered
+		}
+		if tier.Resolution%prev.Resolution != 0 {
+			return errDownsampleResolutionMultiple
+		}
+	}
+	return nil
+}
+
+func (d *downsampleOpts) SetTiers(value []DownsampleTier) DownsampleOptions {
+	do := *d
+	do.tiers = append([]DownsampleTier(nil), value...)
+	return &do
+}
+
+func (d *downsampleOpts) Tiers() []DownsampleTier {
+	return d.tiers
+}
+
+func (d *downsampleOpts) TierAt(blockEnd time.Time, now time.Time) (DownsampleTier, bool) {
+	var (
+		result DownsampleTier
+		found  bool
+	)
+	for _, tier := range d.tiers {
+		if blockEnd.After(now.Add(-tier.After)) {
+			break
+		}
+		result, found = tier, true
+	}
+	return result, found
+}
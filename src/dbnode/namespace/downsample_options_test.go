@@ -0,0 +1,149 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/retention"
+
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func testDownsampleRetentionOptions() retention.Options {
+	return retention.NewOptions().
+		SetRetentionPeriod(90 * 24 * time.Hour).
+		SetBlockSize(2 * time.Hour)
+}
+
+func TestDownsampleOptionsEqual(t *testing.T) {
+	opts := NewDownsampleOptions()
+	tier := DownsampleTier{Resolution: 5 * time.Minute, After: 48 * time.Hour}
+	require.True(t, opts.Equal(NewDownsampleOptions()))
+	require.True(t, opts.SetTiers([]DownsampleTier{tier}).Equal(
+		opts.SetTiers([]DownsampleTier{tier})))
+	require.False(t, opts.Equal(opts.SetTiers([]DownsampleTier{tier})))
+
+	other := tier
+	other.Aggregation = DownsampleMax
+	require.False(t, opts.SetTiers([]DownsampleTier{tier}).Equal(
+		opts.SetTiers([]DownsampleTier{other})))
+}
+
+func TestDownsampleOptionsValidate(t *testing.T) {
+	ropts := testDownsampleRetentionOptions()
+	valid := []DownsampleTier{
+		{Resolution: 5 * time.Minute, After: 48 * time.Hour},
+		{Resolution: time.Hour, After: 30 * 24 * time.Hour, Aggregation: DownsampleMax},
+	}
+	require.NoError(t, NewDownsampleOptions().Validate(ropts))
+	require.NoError(t, NewDownsampleOptions().SetTiers(valid).Validate(ropts))
+
+	tests := []struct {
+		name  string
+		tiers []DownsampleTier
+		err   error
+	}{
+		{
+			name:  "zero resolution",
+			tiers: []DownsampleTier{{After: time.Hour}},
+			err:   errDownsampleResolutionPositive,
+		},
+		{
+			name:  "zero after",
+			tiers: []DownsampleTier{{Resolution: time.Minute}},
+			err:   errDownsampleAfterPositive,
+		},
+		{
+			name:  "after beyond retention",
+			tiers: []DownsampleTier{{Resolution: time.Minute, After: 90 * 24 * time.Hour}},
+			err:   errDownsampleAfterTooLarge,
+		},
+		{
+			name:  "resolution does not divide block size",
+			tiers: []DownsampleTier{{Resolution: 7 * time.Minute, After: time.Hour}},
+			err:   errDownsampleBlockSizeMultiple,
+		},
+		{
+			name: "tiers out of order",
+			tiers: []DownsampleTier{
+				{Resolution: time.Hour, After: 48 * time.Hour},
+				{Resolution: 5 * time.Minute, After: 72 * time.Hour},
+			},
+			err: errDownsampleTiersNotOrdered,
+		},
+		{
+			name: "resolution not a multiple of previous",
+			tiers: []DownsampleTier{
+				{Resolution: 10 * time.Minute, After: 48 * time.Hour},
+				{Resolution: 15 * time.Minute, After: 72 * time.Hour},
+			},
+			err: errDownsampleResolutionMultiple,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := NewDownsampleOptions().SetTiers(test.tiers).Validate(ropts)
+			require.Equal(t, test.err, err)
+		})
+	}
+
+	invalidAgg := []DownsampleTier{
+		{Resolution: time.Minute, After: time.Hour, Aggregation: DownsampleAggregation(100)},
+	}
+	require.Error(t, NewDownsampleOptions().SetTiers(invalidAgg).Validate(ropts))
+}
+
+func TestDownsampleOptionsTierAt(t *testing.T) {
+	var (
+		now   = time.Now().Truncate(time.Hour)
+		tier1 = DownsampleTier{Resolution: 5 * time.Minute, After: 48 * time.Hour}
+		tier2 = DownsampleTier{Resolution: time.Hour, After: 30 * 24 * time.Hour}
+		opts  = NewDownsampleOptions().SetTiers([]DownsampleTier{tier1, tier2})
+	)
+
+	_, ok := opts.TierAt(now.Add(-time.Hour), now)
+	require.False(t, ok)
+
+	tier, ok := opts.TierAt(now.Add(-48*time.Hour), now)
+	require.True(t, ok)
+	require.Equal(t, tier1, tier)
+
+	tier, ok = opts.TierAt(now.Add(-31*24*time.Hour), now)
+	require.True(t, ok)
+	require.Equal(t, tier2, tier)
+
+	_, ok = NewDownsampleOptions().TierAt(now.Add(-31*24*time.Hour), now)
+	require.False(t, ok)
+}
+
+func TestDownsampleAggregationUnmarshalYAML(t *testing.T) {
+	for _, agg := range ValidDownsampleAggregations() {
+		var parsed DownsampleAggregation
+		require.NoError(t, yaml.Unmarshal([]byte(agg.String()), &parsed))
+		require.Equal(t, agg, parsed)
+	}
+
+	var parsed DownsampleAggregation
+	require.Error(t, yaml.Unmarshal([]byte("median"), &parsed))
+}
@@ -66,6 +66,7 @@ type options struct {
 	retentionOpts     retention.Options
 	indexOpts         IndexOptions
 	schemaHis         SchemaHistory
+	downsampleOpts    DownsampleOptions
 }
 
 // NewSchemaHistory returns an empty schema history.
@@ -86,6 +87,7 @@ func NewOptions() Options {
 		retentionOpts:     retention.NewOptions(),
 		indexOpts:         NewIndexOptions(),
 		schemaHis:         NewSchemaHistory(),
+		downsampleOpts:    NewDownsampleOptions(),
 	}
 }
 
@@ -93,6 +95,9 @@ func (o *options) Validate() error {
 	if err := o.retentionOpts.Validate(); err != nil {
 		return err
 	}
+	if err := o.downsampleOpts.Validate(o.retentionOpts); err != nil {
+		return err
+	}
 	if !o.indexOpts.Enabled() {
 		return nil
 	}
@@ -124,7 +129,8 @@ func (o *options) Equal(value Options) bool {
 		o.coldWritesEnabled == value.ColdWritesEnabled() &&
 		o.retentionOpts.Equal(value.RetentionOptions()) &&
 		o.indexOpts.Equal(value.IndexOptions()) &&
-		o.schemaHis.Equal(value.SchemaHistory())
+		o.schemaHis.Equal(value.SchemaHistory()) &&
+		o.downsampleOpts.Equal(value.DownsampleOptions())
 }
 
 func (o *options) SetBootstrapEnabled(value bool) Options {
@@ -226,3 +232,13 @@ func (o *options) SetSchemaHistory(value SchemaHistory) Options {
 func (o *options) SchemaHistory() SchemaHistory {
 	return o.schemaHis
 }
+
+func (o *options) SetDownsampleOptions(value DownsampleOptions) Options {
+	opts := *o
+	opts.downsampleOpts = value
+	return &opts
+}
+
+func (o *options) DownsampleOptions() DownsampleOptions {
+	return o.downsampleOpts
+}
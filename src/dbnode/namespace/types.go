@@ -97,6 +97,12 @@ type Options interface {
 
 	// SchemaHistory returns the schema registry for this namespace.
 	SchemaHistory() SchemaHistory
+
+	// SetDownsampleOptions sets the downsample options for this namespace.
+	SetDownsampleOptions(value DownsampleOptions) Options
+
+	// DownsampleOptions returns the downsample options for this namespace.
+	DownsampleOptions() DownsampleOptions
 }
 
 // IndexOptions controls the indexing options for a namespace.
@@ -117,6 +123,36 @@ type IndexOptions interface {
 	BlockSize() time.Duration
 }
 
+// DownsampleOptions controls the downsampling of flushed data for a namespace.
+type DownsampleOptions interface {
+	// Equal returns true if the provide value is equal to this one.
+	Equal(value DownsampleOptions) bool
+
+	// Validate validates the downsample options against the retention options.
+	Validate(ropts retention.Options) error
+
+	// SetTiers sets the downsample tiers, ordered by increasing age.
+	SetTiers(value []DownsampleTier) DownsampleOptions
+
+	// Tiers returns the downsample tiers, ordered by increasing age.
+	Tiers() []DownsampleTier
+
+	// TierAt returns the tier that a block ending at the given time should be
+	// downsampled to, returns false if the block should hold raw datapoints.
+	TierAt(blockEnd time.Time, now time.Time) (DownsampleTier, bool)
+}
+
+// DownsampleTier describes a resolution that data is downsampled to once
+// it is older than the tier's age.
+type DownsampleTier struct {
+	// Resolution is the resolution that datapoints are downsampled to.
+	Resolution time.Duration
+	// After is the age after which datapoints are downsampled to this tier.
+	After time.Duration
+	// Aggregation is the aggregation used to combine datapoints.
+	Aggregation DownsampleAggregation
+}
+
 // SchemaDescr describes the schema for a complex type value.
 type SchemaDescr interface {
 	// DeployId returns the deploy id of the schema.
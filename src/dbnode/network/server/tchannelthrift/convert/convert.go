@@ -239,6 +239,35 @@ func FromRPCFetchTaggedRequest(
 	return ns, index.Query{Query: q}, opts, req.FetchData, nil
 }
 
+// FromRPCDeleteTaggedRequest converts the rpc request type for DeleteTaggedRequest into corresponding Go values.
+func FromRPCDeleteTaggedRequest(
+	req *rpc.DeleteTaggedRequest, pools FetchTaggedConversionPools,
+) (ident.ID, index.Query, time.Time, time.Time, error) {
+	start, rangeStartErr := ToTime(req.RangeStart, req.RangeTimeType)
+	if rangeStartErr != nil {
+		return nil, index.Query{}, timeZero, timeZero, rangeStartErr
+	}
+
+	end, rangeEndErr := ToTime(req.RangeEnd, req.RangeTimeType)
+	if rangeEndErr != nil {
+		return nil, index.Query{}, timeZero, timeZero, rangeEndErr
+	}
+
+	q, err := idx.Unmarshal(req.Query)
+	if err != nil {
+		return nil, index.Query{}, timeZero, timeZero, err
+	}
+
+	var ns ident.ID
+	if pools != nil {
+		nsBytes := pools.CheckedBytesWrapper().Get(req.NameSpace)
+		ns = pools.ID().BinaryID(nsBytes)
+	} else {
+		ns = ident.StringID(string(req.NameSpace))
+	}
+	return ns, index.Query{Query: q}, start, end, nil
+}
+
 // ToRPCFetchTaggedRequest converts the Go `client/` types into rpc request type for FetchTaggedRequest.
 func ToRPCFetchTaggedRequest(
 	ns ident.ID,
@@ -277,6 +306,110 @@ func ToRPCFetchTaggedRequest(
 	return request, nil
 }
 
+// FromRPCCardinalityRequest converts the rpc request type for CardinalityRequest into corresponding Go values.
+func FromRPCCardinalityRequest(
+	req *rpc.CardinalityRequest,
+) (ident.ID, index.CardinalityOptions, error) {
+	start, rangeStartErr := ToTime(req.RangeStart, req.RangeTimeType)
+	if rangeStartErr != nil {
+		return nil, index.CardinalityOptions{}, rangeStartErr
+	}
+
+	end, rangeEndErr := ToTime(req.RangeEnd, req.RangeTimeType)
+	if rangeEndErr != nil {
+		return nil, index.CardinalityOptions{}, rangeEndErr
+	}
+
+	opts := index.CardinalityOptions{
+		StartInclusive:  start,
+		EndExclusive:    end,
+		MetricNameField: req.MetricNameField,
+	}
+	return ident.StringID(string(req.NameSpace)), opts, nil
+}
+
+// ToRPCCardinalityRequest converts the Go `client/` types into rpc request type for CardinalityRequest.
+// The limit of the options is not sent since hosts return untruncated counts.
+func ToRPCCardinalityRequest(
+	ns ident.ID,
+	opts index.CardinalityOptions,
+) (rpc.CardinalityRequest, error) {
+	rangeStart, tsErr := ToValue(opts.StartInclusive, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.CardinalityRequest{}, tsErr
+	}
+
+	rangeEnd, tsErr := ToValue(opts.EndExclusive, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.CardinalityRequest{}, tsErr
+	}
+
+	return rpc.CardinalityRequest{
+		NameSpace:       ns.Bytes(),
+		RangeStart:      rangeStart,
+		RangeEnd:        rangeEnd,
+		RangeTimeType:   fetchTaggedTimeType,
+		MetricNameField: opts.MetricNameField,
+	}, nil
+}
+
+// ToRPCCardinalityResult converts the cardinality results of each shard into the rpc result type.
+func ToRPCCardinalityResult(results []index.CardinalityShardResult) *rpc.CardinalityResult_ {
+	res := rpc.NewCardinalityResult_()
+	res.Shards = make([]*rpc.CardinalityShardResult, 0, len(results))
+	for _, r := range results {
+		res.Shards = append(res.Shards, &rpc.CardinalityShardResult{
+			Shard:           int32(r.Shard),
+			NumSeries:       r.NumSeries,
+			MetricNames:     toRPCCardinalityEntries(r.MetricNames),
+			LabelNames:      toRPCCardinalityEntries(r.LabelNames),
+			LabelValuePairs: toRPCCardinalityEntries(r.LabelValuePairs),
+		})
+	}
+	return res
+}
+
+func toRPCCardinalityEntries(entries []index.CardinalityEntry) []*rpc.CardinalityEntry {
+	results := make([]*rpc.CardinalityEntry, 0, len(entries))
+	for _, entry := range entries {
+		results = append(results, &rpc.CardinalityEntry{
+			Name:      entry.Name,
+			Value:     entry.Value,
+			NumSeries: entry.NumSeries,
+		})
+	}
+	return results
+}
+
+// FromRPCCardinalityResult converts the rpc result type into the cardinality results of each shard.
+func FromRPCCardinalityResult(r *rpc.CardinalityResult_) []index.CardinalityShardResult {
+	results := make([]index.CardinalityShardResult, 0, len(r.Shards))
+	for _, shard := range r.Shards {
+		results = append(results, index.CardinalityShardResult{
+			Shard: uint32(shard.Shard),
+			CardinalityResult: index.CardinalityResult{
+				NumSeries:       shard.NumSeries,
+				MetricNames:     fromRPCCardinalityEntries(shard.MetricNames),
+				LabelNames:      fromRPCCardinalityEntries(shard.LabelNames),
+				LabelValuePairs: fromRPCCardinalityEntries(shard.LabelValuePairs),
+			},
+		})
+	}
+	return results
+}
+
+func fromRPCCardinalityEntries(entries []*rpc.CardinalityEntry) []index.CardinalityEntry {
+	results := make([]index.CardinalityEntry, 0, len(entries))
+	for _, entry := range entries {
+		results = append(results, index.CardinalityEntry{
+			Name:      entry.Name,
+			Value:     entry.Value,
+			NumSeries: entry.NumSeries,
+		})
+	}
+	return results
+}
+
 // FromRPCAggregateQueryRequest converts the rpc request type for AggregateRawQueryRequest into corresponding Go API types.
 func FromRPCAggregateQueryRequest(
 	req *rpc.AggregateQueryRequest,
@@ -0,0 +1,310 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+
+	"github.com/gogo/protobuf/jsonpb"
+)
+
+type exporter struct {
+	opts   Options
+	fsOpts fs.Options
+}
+
+// NewExporter creates a new namespace exporter.
+func NewExporter(opts Options) (Exporter, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.ExportID() == "" {
+		return nil, errExportIDNotSet
+	}
+	if opts.HostID() == "" {
+		return nil, errHostIDNotSet
+	}
+	return &exporter{
+		opts:   opts,
+		fsOpts: opts.FilesystemOptions(),
+	}, nil
+}
+
+func (e *exporter) Export(
+	md namespace.Metadata,
+	shards []uint32,
+) (Manifest, error) {
+	var (
+		prefix     = e.fsOpts.FilePathPrefix()
+		nsID       = md.ID()
+		exportedAt = e.opts.ClockOptions().NowFn()()
+		id         = e.opts.ExportID()
+		exportDir  = hostDirPath(Dir(e.opts.ExportPath(), nsID.String(), id),
+			e.opts.HostID())
+	)
+	if len(shards) == 0 {
+		var err error
+		shards, err = shardsOnDisk(fs.NamespaceDataDirPath(prefix, nsID))
+		if err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	if _, err := os.Stat(exportDir); err == nil {
+		return Manifest{}, fmt.Errorf("export already exists: %s", exportDir)
+	}
+	if err := os.MkdirAll(exportDir, e.fsOpts.NewDirectoryMode()); err != nil {
+		return Manifest{}, err
+	}
+
+	m := Manifest{
+		Version:    currentVersion,
+		ID:         id,
+		HostID:     e.opts.HostID(),
+		Namespace:  nsID.String(),
+		ExportedAt: exportedAt,
+		Shards:     shards,
+	}
+
+	nsOptsFile, err := e.exportNamespaceOptions(md, exportDir)
+	if err != nil {
+		return Manifest{}, err
+	}
+	m.NamespaceOptions = nsOptsFile
+
+	// Filesets are immutable once their checkpoint file is written, so copying
+	// the latest complete volumes listed up front yields a consistent export.
+	// Should a volume be cleaned up while it is being copied the export fails
+	// and no manifest is written.
+	for _, shard := range shards {
+		fileSets, err := e.exportDataFileSets(md, shard, exportDir)
+		if err != nil {
+			return Manifest{}, err
+		}
+		m.DataFileSets = append(m.DataFileSets, fileSets...)
+	}
+	m.FlushedUpTo = flushedUpTo(m.DataFileSets,
+		md.Options().RetentionOptions().BlockSize())
+
+	fileSets, err := e.exportIndexFileSets(md, exportDir)
+	if err != nil {
+		return Manifest{}, err
+	}
+	m.IndexFileSets = fileSets
+
+	if err := writeManifest(exportDir, m, e.fsOpts); err != nil {
+		return Manifest{}, err
+	}
+	return m, nil
+}
+
+func (e *exporter) exportNamespaceOptions(
+	md namespace.Metadata,
+	exportDir string,
+) (File, error) {
+	var (
+		buf       bytes.Buffer
+		marshaler = jsonpb.Marshaler{Indent: "  "}
+	)
+	if err := marshaler.Marshal(&buf, namespace.OptionsToProto(md.Options())); err != nil {
+		return File{}, err
+	}
+
+	return writeFile(filepath.Join(exportDir, namespaceOptionsFileName),
+		buf.Bytes(), e.fsOpts)
+}
+
+func (e *exporter) exportDataFileSets(
+	md namespace.Metadata,
+	shard uint32,
+	exportDir string,
+) ([]FileSet, error) {
+	files, err := fs.DataFiles(e.fsOpts.FilePathPrefix(), md.ID(), shard)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result []FileSet
+		dstDir = dataDirPath(exportDir, shard)
+		prev   time.Time
+	)
+	for i := range files {
+		blockStart := files[i].ID.BlockStart
+		if i > 0 && blockStart.Equal(prev) {
+			continue
+		}
+		prev = blockStart
+
+		latest, ok := files.LatestVolumeForBlock(blockStart)
+		if !ok {
+			continue
+		}
+
+		exported, err := e.copyFileSet(latest.AbsoluteFilepaths, dstDir)
+		if err != nil {
+			return nil, fmt.Errorf("unable to export data fileset: shard=%d, blockStart=%v, volume=%d: %v",
+				shard, blockStart, latest.ID.VolumeIndex, err)
+		}
+		result = append(result, FileSet{
+			BlockStart:  blockStart,
+			VolumeIndex: latest.ID.VolumeIndex,
+			Shards:      []uint32{shard},
+			Files:       exported,
+		})
+	}
+	return result, nil
+}
+
+func (e *exporter) exportIndexFileSets(
+	md namespace.Metadata,
+	exportDir string,
+) ([]FileSet, error) {
+	type volumeKey struct {
+		blockStart int64
+		volume     int
+	}
+
+	var (
+		prefix      = e.fsOpts.FilePathPrefix()
+		volumeShard = make(map[volumeKey][]uint32)
+	)
+	for _, info := range fs.ReadIndexInfoFiles(prefix, md.ID(),
+		e.fsOpts.InfoReaderBufferSize()) {
+		if info.Err.Error() != nil {
+			continue
+		}
+		key := volumeKey{
+			blockStart: info.ID.BlockStart.UnixNano(),
+			volume:     info.ID.VolumeIndex,
+		}
+		volumeShard[key] = info.Info.Shards
+	}
+
+	files, err := fs.IndexFiles(prefix, md.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result []FileSet
+		dstDir = indexDirPath(exportDir)
+	)
+	for i := range files {
+		if !files[i].HasCompleteCheckpointFile() {
+			continue
+		}
+		id := files[i].ID
+		shards, ok := volumeShard[volumeKey{
+			blockStart: id.BlockStart.UnixNano(),
+			volume:     id.VolumeIndex,
+		}]
+		if !ok {
+			// The info file could not be read, the index will be rebuilt
+			// from the data filesets on restore.
+			continue
+		}
+
+		exported, err := e.copyFileSet(files[i].AbsoluteFilepaths, dstDir)
+		if err != nil {
+			return nil, fmt.Errorf("unable to export index fileset: blockStart=%v, volume=%d: %v",
+				id.BlockStart, id.VolumeIndex, err)
+		}
+		result = append(result, FileSet{
+			BlockStart:  id.BlockStart,
+			VolumeIndex: id.VolumeIndex,
+			Shards:      shards,
+			Files:       exported,
+		})
+	}
+	return result, nil
+}
+
+func (e *exporter) copyFileSet(paths []string, dstDir string) ([]File, error) {
+	if err := os.MkdirAll(dstDir, e.fsOpts.NewDirectoryMode()); err != nil {
+		return nil, err
+	}
+
+	files := make([]File, 0, len(paths))
+	for _, path := range checkpointLast(paths) {
+		f, err := copyFile(path, filepath.Join(dstDir, filepath.Base(path)), e.fsOpts)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// flushedUpTo returns the earliest of the ends of the latest exported block
+// of each shard, the time up until which every shard had flushed its blocks.
+func flushedUpTo(fileSets []FileSet, blockSize time.Duration) time.Time {
+	latest := make(map[uint32]time.Time)
+	for _, fileSet := range fileSets {
+		for _, shard := range fileSet.Shards {
+			if blockEnd := fileSet.BlockStart.Add(blockSize); blockEnd.After(latest[shard]) {
+				latest[shard] = blockEnd
+			}
+		}
+	}
+
+	var result time.Time
+	for _, blockEnd := range latest {
+		if result.IsZero() || blockEnd.Before(result) {
+			result = blockEnd
+		}
+	}
+	return result
+}
+
+func shardsOnDisk(namespaceDir string) ([]uint32, error) {
+	entries, err := ioutil.ReadDir(namespaceDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var shards []uint32
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		shard, err := strconv.ParseUint(entry.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		shards = append(shards, uint32(shard))
+	}
+	sort.Slice(shards, func(i, j int) bool { return shards[i] < shards[j] })
+	return shards, nil
+}
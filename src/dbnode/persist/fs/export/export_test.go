@@ -0,0 +1,352 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testBlockSize = 2 * time.Hour
+
+var testNamespaceID = ident.StringID("testns")
+
+func newTestMetadata(t *testing.T, blockSize time.Duration) namespace.Metadata {
+	opts := namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().SetBlockSize(blockSize)).
+		SetIndexOptions(namespace.NewIndexOptions().SetEnabled(true).SetBlockSize(blockSize))
+	md, err := namespace.NewMetadata(testNamespaceID, opts)
+	require.NoError(t, err)
+	return md
+}
+
+func newTestOptions(t *testing.T, prefix, exportPath string, now time.Time) Options {
+	return NewOptions().
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(prefix)).
+		SetExportPath(exportPath).
+		SetClockOptions(clock.NewOptions().SetNowFn(func() time.Time {
+			return now
+		}))
+}
+
+func newTestExportOptions(
+	t *testing.T,
+	prefix, exportPath string,
+	now time.Time,
+	hostID string,
+) Options {
+	return newTestOptions(t, prefix, exportPath, now).
+		SetExportID(strconv.FormatInt(now.UnixNano(), 10)).
+		SetHostID(hostID)
+}
+
+func writeTestFileSet(
+	t *testing.T,
+	prefix string,
+	shard uint32,
+	blockStart time.Time,
+	ids ...string,
+) {
+	writeTestFileSetVolume(t, prefix, shard, blockStart, 0, ids...)
+}
+
+func writeTestFileSetVolume(
+	t *testing.T,
+	prefix string,
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+	ids ...string,
+) {
+	w, err := fs.NewWriter(fs.NewOptions().SetFilePathPrefix(prefix))
+	require.NoError(t, err)
+	require.NoError(t, w.Open(fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   testNamespaceID,
+			Shard:       shard,
+			BlockStart:  blockStart,
+			VolumeIndex: volume,
+		},
+		BlockSize:   testBlockSize,
+		FileSetType: persist.FileSetFlushType,
+	}))
+	for _, id := range ids {
+		data := []byte(id + "-data")
+		bytes := checked.NewBytes(data, nil)
+		bytes.IncRef()
+		require.NoError(t, w.Write(ident.StringID(id), ident.Tags{}, bytes,
+			digest.Checksum(data)))
+		bytes.DecRef()
+	}
+	require.NoError(t, w.Close())
+}
+
+func readTestFileSet(
+	t *testing.T,
+	prefix string,
+	shard uint32,
+	blockStart time.Time,
+) []string {
+	return readTestFileSetVolume(t, prefix, shard, blockStart, 0)
+}
+
+func readTestFileSetVolume(
+	t *testing.T,
+	prefix string,
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+) []string {
+	r, err := fs.NewReader(nil, fs.NewOptions().SetFilePathPrefix(prefix))
+	require.NoError(t, err)
+	require.NoError(t, r.Open(fs.DataReaderOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   testNamespaceID,
+			Shard:       shard,
+			BlockStart:  blockStart,
+			VolumeIndex: volume,
+		},
+		FileSetType: persist.FileSetFlushType,
+	}))
+	defer r.Close()
+
+	var ids []string
+	for {
+		id, _, data, _, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		ids = append(ids, id.String())
+		data.IncRef()
+		data.DecRef()
+		data.Finalize()
+	}
+	require.NoError(t, r.Validate())
+	return ids
+}
+
+func newTestDirs(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "export-test")
+	require.NoError(t, err)
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func TestExportAndRestore(t *testing.T) {
+	dir, cleanup := newTestDirs(t)
+	defer cleanup()
+
+	var (
+		srcPrefix  = filepath.Join(dir, "src")
+		dstPrefix  = filepath.Join(dir, "dst")
+		exportPath = filepath.Join(dir, "exports")
+		now        = time.Now().Truncate(testBlockSize)
+		block1     = now.Add(-2 * testBlockSize)
+		block2     = now.Add(-testBlockSize)
+		md         = newTestMetadata(t, testBlockSize)
+	)
+	writeTestFileSet(t, srcPrefix, 0, block1, "foo", "bar")
+	writeTestFileSet(t, srcPrefix, 0, block2, "baz")
+	writeTestFileSet(t, srcPrefix, 1, block1, "qux")
+
+	exporter, err := NewExporter(newTestExportOptions(t, srcPrefix, exportPath, now, "host0"))
+	require.NoError(t, err)
+
+	m, err := exporter.Export(md, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{0, 1}, m.Shards)
+	require.Equal(t, 3, len(m.DataFileSets))
+	require.Equal(t, "host0", m.HostID)
+	require.True(t, now.Equal(m.ExportedAt))
+	// Shard 1 has only flushed the first block.
+	require.True(t, block2.Equal(m.FlushedUpTo))
+
+	latest, err := LatestExportID(exportPath, testNamespaceID.String())
+	require.NoError(t, err)
+	require.Equal(t, m.ID, latest)
+
+	// Restore only shard 0 and the latest block.
+	restorer, err := NewRestorer(newTestOptions(t, dstPrefix, exportPath, now))
+	require.NoError(t, err)
+
+	res, err := restorer.Restore(md, []uint32{0}, block2, now)
+	require.NoError(t, err)
+	require.Equal(t, RestoreResult{ExportID: m.ID, DataFileSets: 1}, res)
+	require.Equal(t, []string{"baz"}, readTestFileSet(t, dstPrefix, 0, block2))
+
+	exists, err := fs.DataFileSetExistsAt(dstPrefix, testNamespaceID, 0, block1)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Restore everything, already restored filesets are skipped.
+	res, err = restorer.Restore(md, []uint32{0, 1}, block1, now)
+	require.NoError(t, err)
+	require.Equal(t, RestoreResult{ExportID: m.ID, DataFileSets: 2}, res)
+	require.Equal(t, []string{"foo", "bar"}, readTestFileSet(t, dstPrefix, 0, block1))
+	require.Equal(t, []string{"qux"}, readTestFileSet(t, dstPrefix, 1, block1))
+}
+
+func TestExportAndRestoreMultipleHosts(t *testing.T) {
+	dir, cleanup := newTestDirs(t)
+	defer cleanup()
+
+	var (
+		host0Prefix = filepath.Join(dir, "host0")
+		host1Prefix = filepath.Join(dir, "host1")
+		dstPrefix   = filepath.Join(dir, "dst")
+		exportPath  = filepath.Join(dir, "exports")
+		now         = time.Now().Truncate(testBlockSize)
+		block       = now.Add(-testBlockSize)
+		md          = newTestMetadata(t, testBlockSize)
+	)
+	// Shard 0 moved from host0 to host1 which has since merged a newer volume.
+	writeTestFileSet(t, host0Prefix, 0, block, "foo")
+	writeTestFileSetVolume(t, host1Prefix, 0, block, 1, "foo", "bar")
+	writeTestFileSet(t, host1Prefix, 1, block, "baz")
+
+	for host, prefix := range map[string]string{
+		"host0": host0Prefix,
+		"host1": host1Prefix,
+	} {
+		exporter, err := NewExporter(newTestExportOptions(t, prefix, exportPath, now, host))
+		require.NoError(t, err)
+		_, err = exporter.Export(md, nil)
+		require.NoError(t, err)
+	}
+
+	exportDir := Dir(exportPath, testNamespaceID.String(),
+		strconv.FormatInt(now.UnixNano(), 10))
+	manifests, err := ReadManifests(exportDir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(manifests))
+	require.Equal(t, "host0", manifests[0].HostID)
+	require.Equal(t, "host1", manifests[1].HostID)
+
+	restorer, err := NewRestorer(newTestOptions(t, dstPrefix, exportPath, now))
+	require.NoError(t, err)
+	res, err := restorer.Restore(md, []uint32{0, 1}, block, now)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.DataFileSets)
+	require.Equal(t, []string{"foo", "bar"}, readTestFileSetVolume(t, dstPrefix, 0, block, 1))
+	require.Equal(t, []string{"baz"}, readTestFileSet(t, dstPrefix, 1, block))
+}
+
+func TestNewExporterRequiresIDs(t *testing.T) {
+	opts := newTestOptions(t, "prefix", "exports", time.Now())
+
+	_, err := NewExporter(opts.SetHostID("host0"))
+	require.Equal(t, errExportIDNotSet, err)
+
+	_, err = NewExporter(opts.SetExportID("1"))
+	require.Equal(t, errHostIDNotSet, err)
+
+	_, err = NewExporter(opts.SetExportID("..").SetHostID("host0"))
+	require.Equal(t, errInvalidExportID, err)
+}
+
+func TestRestoreChecksumMismatch(t *testing.T) {
+	dir, cleanup := newTestDirs(t)
+	defer cleanup()
+
+	var (
+		srcPrefix  = filepath.Join(dir, "src")
+		dstPrefix  = filepath.Join(dir, "dst")
+		exportPath = filepath.Join(dir, "exports")
+		now        = time.Now().Truncate(testBlockSize)
+		block      = now.Add(-testBlockSize)
+		md         = newTestMetadata(t, testBlockSize)
+	)
+	writeTestFileSet(t, srcPrefix, 0, block, "foo")
+
+	exporter, err := NewExporter(newTestExportOptions(t, srcPrefix, exportPath, now, "host0"))
+	require.NoError(t, err)
+	m, err := exporter.Export(md, []uint32{0})
+	require.NoError(t, err)
+
+	// Corrupt the data file in the export.
+	exportDir := hostDirPath(Dir(exportPath, testNamespaceID.String(), m.ID), m.HostID)
+	for _, f := range m.DataFileSets[0].Files {
+		if filepath.Ext(f.Name) == ".db" && !fs.IsCheckpointFile(f.Name) {
+			path := filepath.Join(dataDirPath(exportDir, 0), f.Name)
+			require.NoError(t, ioutil.WriteFile(path, []byte("corrupt"), 0666))
+			break
+		}
+	}
+
+	restorer, err := NewRestorer(newTestOptions(t, dstPrefix, exportPath, now))
+	require.NoError(t, err)
+	_, err = restorer.Restore(md, []uint32{0}, block, now)
+	require.Error(t, err)
+
+	exists, err := fs.DataFileSetExistsAt(dstPrefix, testNamespaceID, 0, block)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestRestoreNamespaceMismatch(t *testing.T) {
+	dir, cleanup := newTestDirs(t)
+	defer cleanup()
+
+	var (
+		srcPrefix  = filepath.Join(dir, "src")
+		exportPath = filepath.Join(dir, "exports")
+		now        = time.Now().Truncate(testBlockSize)
+	)
+	writeTestFileSet(t, srcPrefix, 0, now.Add(-testBlockSize), "foo")
+
+	exporter, err := NewExporter(newTestExportOptions(t, srcPrefix, exportPath, now, "host0"))
+	require.NoError(t, err)
+	_, err = exporter.Export(newTestMetadata(t, testBlockSize), nil)
+	require.NoError(t, err)
+
+	restorer, err := NewRestorer(newTestOptions(t, filepath.Join(dir, "dst"), exportPath, now))
+	require.NoError(t, err)
+	_, err = restorer.Restore(newTestMetadata(t, time.Hour), []uint32{0}, now.Add(-testBlockSize), now)
+	require.Error(t, err)
+}
+
+func TestRestoreExportNotFound(t *testing.T) {
+	dir, cleanup := newTestDirs(t)
+	defer cleanup()
+
+	restorer, err := NewRestorer(newTestOptions(t, filepath.Join(dir, "dst"),
+		filepath.Join(dir, "exports"), time.Now()))
+	require.NoError(t, err)
+	_, err = restorer.Restore(newTestMetadata(t, testBlockSize), []uint32{0},
+		time.Now().Add(-time.Hour), time.Now())
+	require.Equal(t, ErrExportNotFound, err)
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/adler32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+)
+
+const (
+	currentVersion = 2
+
+	manifestFileName         = "manifest.json"
+	namespaceOptionsFileName = "namespace.json"
+	dataDirName              = "data"
+	indexDirName             = "index"
+)
+
+var (
+	// ErrExportNotFound is returned when there is no complete export of a
+	// namespace.
+	ErrExportNotFound = errors.New("export not found")
+
+	errManifestVersion  = errors.New("unsupported export manifest version")
+	errNoCheckpointFile = errors.New("fileset has no checkpoint file")
+)
+
+// Dir returns the directory of an export of a namespace. Each host exports
+// to its own directory of the export, laid out as a flat tree of immutable
+// files so they can be uploaded to object storage as is:
+//
+//	<exportPath>/<namespace>/<id>/<host>/manifest.json
+//	<exportPath>/<namespace>/<id>/<host>/namespace.json
+//	<exportPath>/<namespace>/<id>/<host>/data/<shard>/<fileset file>
+//	<exportPath>/<namespace>/<id>/<host>/index/<fileset file>
+func Dir(exportPath string, namespace string, id string) string {
+	return filepath.Join(exportPath, namespace, id)
+}
+
+func hostDirPath(exportDir string, hostID string) string {
+	return filepath.Join(exportDir, hostID)
+}
+
+func dataDirPath(exportDir string, shard uint32) string {
+	return filepath.Join(exportDir, dataDirName, strconv.Itoa(int(shard)))
+}
+
+func indexDirPath(exportDir string) string {
+	return filepath.Join(exportDir, indexDirName)
+}
+
+// ReadManifest reads the manifest of the part of an export taken by a host.
+func ReadManifest(exportDir string, hostID string) (Manifest, error) {
+	var m Manifest
+	b, err := ioutil.ReadFile(filepath.Join(hostDirPath(exportDir, hostID),
+		manifestFileName))
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, fmt.Errorf("unable to decode manifest: %v", err)
+	}
+	if m.Version != currentVersion {
+		return m, errManifestVersion
+	}
+	return m, nil
+}
+
+// ReadManifests reads the manifests of every host that completed its part of
+// an export, ordered by host ID, or returns ErrExportNotFound if there are
+// none.
+func ReadManifests(exportDir string) ([]Manifest, error) {
+	entries, err := ioutil.ReadDir(exportDir)
+	if os.IsNotExist(err) {
+		return nil, ErrExportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []Manifest
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		m, err := ReadManifest(exportDir, entry.Name())
+		if err != nil {
+			// Incomplete or unreadable part of the export.
+			continue
+		}
+		manifests = append(manifests, m)
+	}
+	if len(manifests) == 0 {
+		return nil, ErrExportNotFound
+	}
+
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].HostID < manifests[j].HostID
+	})
+	return manifests, nil
+}
+
+// LatestExportID returns the ID of the latest export of a namespace that at
+// least one host completed, or ErrExportNotFound if there is none.
+func LatestExportID(exportPath string, namespace string) (string, error) {
+	entries, err := ioutil.ReadDir(filepath.Join(exportPath, namespace))
+	if os.IsNotExist(err) {
+		return "", ErrExportNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		latestID string
+		latestAt time.Time
+	)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		manifests, err := ReadManifests(Dir(exportPath, namespace, entry.Name()))
+		if err != nil {
+			continue
+		}
+		for _, m := range manifests {
+			if latestID == "" || m.ExportedAt.After(latestAt) {
+				latestID = entry.Name()
+				latestAt = m.ExportedAt
+			}
+		}
+	}
+	if latestID == "" {
+		return "", ErrExportNotFound
+	}
+	return latestID, nil
+}
+
+func writeManifest(exportDir string, m Manifest, fsOpts fs.Options) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename so the manifest is never observed
+	// partially written.
+	var (
+		manifestPath = filepath.Join(exportDir, manifestFileName)
+		tmpPath      = manifestPath + ".tmp"
+	)
+	if err := ioutil.WriteFile(tmpPath, b, fsOpts.NewFileMode()); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, manifestPath)
+}
+
+// writeFile writes a file, returning its size and checksum. The destination
+// must not already exist.
+func writeFile(dstPath string, data []byte, fsOpts fs.Options) (File, error) {
+	dst, err := os.OpenFile(dstPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL,
+		fsOpts.NewFileMode())
+	if err != nil {
+		return File{}, err
+	}
+
+	_, err = dst.Write(data)
+	if err == nil {
+		err = dst.Sync()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(dstPath)
+		return File{}, err
+	}
+
+	return File{
+		Name:     filepath.Base(dstPath),
+		Size:     int64(len(data)),
+		Checksum: adler32.Checksum(data),
+	}, nil
+}
+
+// copyFile copies a file, returning its size and checksum. The destination
+// must not already exist.
+func copyFile(
+	srcPath, dstPath string,
+	fsOpts fs.Options,
+) (File, error) {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return File{}, err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(dstPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL,
+		fsOpts.NewFileMode())
+	if err != nil {
+		return File{}, err
+	}
+
+	var (
+		digest = adler32.New()
+		reader = bufio.NewReaderSize(src, fsOpts.DataReaderBufferSize())
+		writer = io.MultiWriter(dst, digest)
+	)
+	size, err := io.Copy(writer, reader)
+	if err == nil {
+		err = dst.Sync()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(dstPath)
+		return File{}, err
+	}
+
+	return File{
+		Name:     filepath.Base(dstPath),
+		Size:     size,
+		Checksum: digest.Sum32(),
+	}, nil
+}
+
+// checkpointLast orders the files of a fileset so that the checkpoint file is
+// copied last, a fileset is only considered complete once its checkpoint file
+// exists.
+func checkpointLast(names []string) []string {
+	ordered := make([]string, 0, len(names))
+	var checkpoints []string
+	for _, name := range names {
+		if fs.IsCheckpointFile(name) {
+			checkpoints = append(checkpoints, name)
+			continue
+		}
+		ordered = append(ordered, name)
+	}
+	return append(ordered, checkpoints...)
+}
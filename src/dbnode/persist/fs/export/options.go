@@ -0,0 +1,124 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/clock"
+)
+
+var (
+	errExportPathNotSet     = errors.New("export path not set")
+	errFilePathPrefixNotSet = errors.New("file path prefix not set")
+	errExportIDNotSet       = errors.New("export ID not set")
+	errHostIDNotSet         = errors.New("host ID not set")
+	errInvalidExportID      = errors.New("export ID must be a valid directory name")
+	errInvalidHostID        = errors.New("host ID must be a valid directory name")
+)
+
+type options struct {
+	fsOpts     fs.Options
+	exportPath string
+	exportID   string
+	hostID     string
+	clockOpts  clock.Options
+}
+
+// NewOptions creates new export options.
+func NewOptions() Options {
+	return &options{
+		fsOpts:    fs.NewOptions(),
+		clockOpts: clock.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.exportPath == "" {
+		return errExportPathNotSet
+	}
+	if o.fsOpts.FilePathPrefix() == "" {
+		return errFilePathPrefixNotSet
+	}
+	if o.exportID != "" && !isDirName(o.exportID) {
+		return errInvalidExportID
+	}
+	if o.hostID != "" && !isDirName(o.hostID) {
+		return errInvalidHostID
+	}
+	return nil
+}
+
+func isDirName(value string) bool {
+	return value != "." && value != ".." && filepath.Base(value) == value
+}
+
+func (o *options) SetFilesystemOptions(value fs.Options) Options {
+	opts := *o
+	opts.fsOpts = value
+	return &opts
+}
+
+func (o *options) FilesystemOptions() fs.Options {
+	return o.fsOpts
+}
+
+func (o *options) SetExportPath(value string) Options {
+	opts := *o
+	opts.exportPath = value
+	return &opts
+}
+
+func (o *options) ExportPath() string {
+	return o.exportPath
+}
+
+func (o *options) SetExportID(value string) Options {
+	opts := *o
+	opts.exportID = value
+	return &opts
+}
+
+func (o *options) ExportID() string {
+	return o.exportID
+}
+
+func (o *options) SetHostID(value string) Options {
+	opts := *o
+	opts.hostID = value
+	return &opts
+}
+
+func (o *options) HostID() string {
+	return o.hostID
+}
+
+func (o *options) SetClockOptions(value clock.Options) Options {
+	opts := *o
+	opts.clockOpts = value
+	return &opts
+}
+
+func (o *options) ClockOptions() clock.Options {
+	return o.clockOpts
+}
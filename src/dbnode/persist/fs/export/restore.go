@@ -0,0 +1,351 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"hash/adler32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+
+	"github.com/gogo/protobuf/jsonpb"
+)
+
+type restorer struct {
+	opts   Options
+	fsOpts fs.Options
+}
+
+// NewRestorer creates a new namespace restorer.
+func NewRestorer(opts Options) (Restorer, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return &restorer{
+		opts:   opts,
+		fsOpts: opts.FilesystemOptions(),
+	}, nil
+}
+
+func (r *restorer) Restore(
+	md namespace.Metadata,
+	shards []uint32,
+	start, end time.Time,
+) (RestoreResult, error) {
+	var (
+		nsID   = md.ID()
+		prefix = r.fsOpts.FilePathPrefix()
+		id     = r.opts.ExportID()
+		err    error
+	)
+	if id == "" {
+		id, err = LatestExportID(r.opts.ExportPath(), nsID.String())
+		if err != nil {
+			return RestoreResult{}, err
+		}
+	}
+
+	exportDir := Dir(r.opts.ExportPath(), nsID.String(), id)
+	manifests, err := ReadManifests(exportDir)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+	for _, m := range manifests {
+		hostDir := hostDirPath(exportDir, m.HostID)
+		if err := validateNamespaceOptions(md, hostDir, m.NamespaceOptions); err != nil {
+			return RestoreResult{}, err
+		}
+	}
+
+	var (
+		res            = RestoreResult{ExportID: id}
+		shardSet       = make(map[uint32]struct{}, len(shards))
+		blockSize      = md.Options().RetentionOptions().BlockSize()
+		indexBlockSize = md.Options().IndexOptions().BlockSize()
+	)
+	for _, shard := range shards {
+		shardSet[shard] = struct{}{}
+	}
+
+	dataFileSets, err := unionDataFileSets(exportDir, manifests, shardSet,
+		blockSize, start, end)
+	if err != nil {
+		return res, err
+	}
+	for _, fileSet := range dataFileSets {
+		shard := fileSet.Shards[0]
+		restored, err := r.restoreFileSet(fileSet.FileSet,
+			dataDirPath(fileSet.hostDir, shard),
+			fs.ShardDataDirPath(prefix, nsID, shard))
+		if err != nil {
+			return res, fmt.Errorf("unable to restore data fileset: host=%s, shard=%d, blockStart=%v, volume=%d: %v",
+				fileSet.hostID, shard, fileSet.BlockStart, fileSet.VolumeIndex, err)
+		}
+		if restored {
+			res.DataFileSets++
+		}
+	}
+
+	indexFileSets := unionIndexFileSets(exportDir, manifests, shardSet,
+		indexBlockSize, start, end)
+	for _, fileSet := range indexFileSets {
+		restored, err := r.restoreFileSet(fileSet.FileSet,
+			indexDirPath(fileSet.hostDir), fs.NamespaceIndexDataDirPath(prefix, nsID))
+		if err != nil {
+			return res, fmt.Errorf("unable to restore index fileset: host=%s, blockStart=%v, volume=%d: %v",
+				fileSet.hostID, fileSet.BlockStart, fileSet.VolumeIndex, err)
+		}
+		if restored {
+			res.IndexFileSets++
+		}
+	}
+
+	return res, nil
+}
+
+type hostFileSet struct {
+	FileSet
+
+	hostID  string
+	hostDir string
+}
+
+type dataFileSetKey struct {
+	shard      uint32
+	blockStart int64
+}
+
+// unionDataFileSets returns the data filesets of every host to restore,
+// ordered by shard and block start. Where several hosts exported the same
+// block of a shard the latest volume is restored, ties going to the first
+// host.
+func unionDataFileSets(
+	exportDir string,
+	manifests []Manifest,
+	shardSet map[uint32]struct{},
+	blockSize time.Duration,
+	start, end time.Time,
+) ([]hostFileSet, error) {
+	byKey := make(map[dataFileSetKey]hostFileSet)
+	for _, m := range manifests {
+		for _, fileSet := range m.DataFileSets {
+			if len(fileSet.Shards) != 1 {
+				return nil, fmt.Errorf("invalid data fileset in export %s: host=%s, shards=%v",
+					m.ID, m.HostID, fileSet.Shards)
+			}
+			shard := fileSet.Shards[0]
+			if _, ok := shardSet[shard]; !ok {
+				continue
+			}
+			if !overlaps(fileSet.BlockStart, blockSize, start, end) {
+				continue
+			}
+
+			key := dataFileSetKey{shard: shard, blockStart: fileSet.BlockStart.UnixNano()}
+			if existing, ok := byKey[key]; ok && existing.VolumeIndex >= fileSet.VolumeIndex {
+				continue
+			}
+			byKey[key] = hostFileSet{
+				FileSet: fileSet,
+				hostID:  m.HostID,
+				hostDir: hostDirPath(exportDir, m.HostID),
+			}
+		}
+	}
+
+	result := make([]hostFileSet, 0, len(byKey))
+	for _, fileSet := range byKey {
+		result = append(result, fileSet)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Shards[0] != result[j].Shards[0] {
+			return result[i].Shards[0] < result[j].Shards[0]
+		}
+		return result[i].BlockStart.Before(result[j].BlockStart)
+	})
+	return result, nil
+}
+
+// unionIndexFileSets returns the index filesets of every host to restore,
+// ordered by block start. Index filesets of different hosts share file names
+// so only one can be restored per block, the one covering the most shards
+// and then the latest volume. Series of shards it does not cover are indexed
+// from their data filesets when bootstrapping.
+func unionIndexFileSets(
+	exportDir string,
+	manifests []Manifest,
+	shardSet map[uint32]struct{},
+	indexBlockSize time.Duration,
+	start, end time.Time,
+) []hostFileSet {
+	byBlockStart := make(map[int64]hostFileSet)
+	for _, m := range manifests {
+		for _, fileSet := range m.IndexFileSets {
+			// Only restore index filesets that do not contain series of
+			// shards which are not being restored.
+			if !containsAll(shardSet, fileSet.Shards) {
+				continue
+			}
+			if !overlaps(fileSet.BlockStart, indexBlockSize, start, end) {
+				continue
+			}
+
+			key := fileSet.BlockStart.UnixNano()
+			if existing, ok := byBlockStart[key]; ok {
+				if len(existing.Shards) > len(fileSet.Shards) {
+					continue
+				}
+				if len(existing.Shards) == len(fileSet.Shards) &&
+					existing.VolumeIndex >= fileSet.VolumeIndex {
+					continue
+				}
+			}
+			byBlockStart[key] = hostFileSet{
+				FileSet: fileSet,
+				hostID:  m.HostID,
+				hostDir: hostDirPath(exportDir, m.HostID),
+			}
+		}
+	}
+
+	result := make([]hostFileSet, 0, len(byBlockStart))
+	for _, fileSet := range byBlockStart {
+		result = append(result, fileSet)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].BlockStart.Before(result[j].BlockStart)
+	})
+	return result
+}
+
+// restoreFileSet copies a fileset from the export unless it already exists,
+// the checkpoint file is copied last so a partially restored fileset is never
+// considered complete.
+func (r *restorer) restoreFileSet(
+	fileSet FileSet,
+	srcDir, dstDir string,
+) (bool, error) {
+	byName := make(map[string]File, len(fileSet.Files))
+	names := make([]string, 0, len(fileSet.Files))
+	var checkpoint string
+	for _, f := range fileSet.Files {
+		byName[f.Name] = f
+		names = append(names, f.Name)
+		if fs.IsCheckpointFile(f.Name) {
+			checkpoint = f.Name
+		}
+	}
+	if checkpoint == "" {
+		return false, errNoCheckpointFile
+	}
+
+	exists, err := fs.CompleteCheckpointFileExists(filepath.Join(dstDir, checkpoint))
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	if err := os.MkdirAll(dstDir, r.fsOpts.NewDirectoryMode()); err != nil {
+		return false, err
+	}
+
+	var copied []string
+	for _, name := range checkpointLast(names) {
+		dstPath := filepath.Join(dstDir, name)
+		// Remove any leftovers of a previously failed restore.
+		if err := os.Remove(dstPath); err != nil && !os.IsNotExist(err) {
+			return false, err
+		}
+
+		f, err := copyFile(filepath.Join(srcDir, name), dstPath, r.fsOpts)
+		if err == nil {
+			copied = append(copied, dstPath)
+			if expected := byName[name]; f.Size != expected.Size ||
+				f.Checksum != expected.Checksum {
+				err = fmt.Errorf("checksum mismatch for %s: expected=%d, actual=%d",
+					name, expected.Checksum, f.Checksum)
+			}
+		}
+		if err != nil {
+			fs.DeleteFiles(copied)
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func validateNamespaceOptions(
+	md namespace.Metadata,
+	exportDir string,
+	file File,
+) error {
+	b, err := ioutil.ReadFile(filepath.Join(exportDir, file.Name))
+	if err != nil {
+		return err
+	}
+	if int64(len(b)) != file.Size || adler32.Checksum(b) != file.Checksum {
+		return fmt.Errorf("checksum mismatch for %s", file.Name)
+	}
+
+	var nsOpts nsproto.NamespaceOptions
+	if err := jsonpb.Unmarshal(bytes.NewReader(b), &nsOpts); err != nil {
+		return fmt.Errorf("unable to decode namespace options: %v", err)
+	}
+
+	var (
+		opts           = md.Options()
+		blockSize      = opts.RetentionOptions().BlockSize()
+		indexBlockSize = opts.IndexOptions().BlockSize()
+	)
+	if exported := time.Duration(nsOpts.GetRetentionOptions().GetBlockSizeNanos()); exported != blockSize {
+		return fmt.Errorf("export block size %v does not match namespace block size %v",
+			exported, blockSize)
+	}
+	if exported := time.Duration(nsOpts.GetIndexOptions().GetBlockSizeNanos()); exported != indexBlockSize {
+		return fmt.Errorf("export index block size %v does not match namespace index block size %v",
+			exported, indexBlockSize)
+	}
+	return nil
+}
+
+func overlaps(blockStart time.Time, blockSize time.Duration, start, end time.Time) bool {
+	return blockStart.Before(end) && blockStart.Add(blockSize).After(start)
+}
+
+func containsAll(set map[uint32]struct{}, shards []uint32) bool {
+	for _, shard := range shards {
+		if _, ok := set[shard]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package export provides point in time exports of namespaces that can be
+// stored in object storage and restored into the filesystem of a fresh node.
+package export
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/clock"
+)
+
+// Manifest describes the export of a namespace from a single host. It is
+// written after every other file the host exported, a host without a
+// manifest did not complete its part of the export.
+type Manifest struct {
+	// Version is the version of the export layout.
+	Version int `json:"version"`
+	// ID is the identifier of the export, shared by every host that took
+	// part in the export and unique per namespace.
+	ID string `json:"id"`
+	// HostID is the host the filesets were exported from.
+	HostID string `json:"hostID"`
+	// Namespace is the exported namespace.
+	Namespace string `json:"namespace"`
+	// ExportedAt is the time the host exported the namespace.
+	ExportedAt time.Time `json:"exportedAt"`
+	// FlushedUpTo is the time that every exported shard with flushed data
+	// had been flushed up to. Data written after it had not been flushed
+	// when the export was taken and is not part of the export.
+	FlushedUpTo time.Time `json:"flushedUpTo"`
+	// Shards are the exported shards.
+	Shards []uint32 `json:"shards"`
+	// NamespaceOptions is the file containing the namespace options.
+	NamespaceOptions File `json:"namespaceOptions"`
+	// DataFileSets are the exported data filesets.
+	DataFileSets []FileSet `json:"dataFileSets"`
+	// IndexFileSets are the exported index filesets.
+	IndexFileSets []FileSet `json:"indexFileSets"`
+}
+
+// FileSet is an exported fileset volume.
+type FileSet struct {
+	// BlockStart is the start of the block of the fileset.
+	BlockStart time.Time `json:"blockStart"`
+	// VolumeIndex is the volume index of the fileset.
+	VolumeIndex int `json:"volumeIndex"`
+	// Shards are the shards of the fileset, data filesets have a single
+	// shard and index filesets the shards that were indexed.
+	Shards []uint32 `json:"shards"`
+	// Files are the files of the fileset.
+	Files []File `json:"files"`
+}
+
+// File is an exported file.
+type File struct {
+	// Name is the name of the file relative to its directory in the export.
+	Name string `json:"name"`
+	// Size is the size of the file in bytes.
+	Size int64 `json:"size"`
+	// Checksum is the adler32 checksum of the file.
+	Checksum uint32 `json:"checksum"`
+}
+
+// Exporter exports namespaces.
+type Exporter interface {
+	// Export exports the complete flushed filesets of the given shards of a
+	// namespace, or of all shards on disk if none are given, as the part of
+	// the export taken by this host.
+	Export(md namespace.Metadata, shards []uint32) (Manifest, error)
+}
+
+// Restorer restores namespaces from exports.
+type Restorer interface {
+	// Restore copies the filesets of an export for the given shards that
+	// overlap the time range into the filesystem. The filesets exported by
+	// every host are restored from, where hosts exported the same block of
+	// a shard the latest volume is restored. Filesets that already exist in
+	// the filesystem are skipped.
+	Restore(
+		md namespace.Metadata,
+		shards []uint32,
+		start, end time.Time,
+	) (RestoreResult, error)
+}
+
+// RestoreResult is the result of a restore.
+type RestoreResult struct {
+	// ExportID is the ID of the export that was restored from.
+	ExportID string
+	// DataFileSets is the number of data filesets restored.
+	DataFileSets int
+	// IndexFileSets is the number of index filesets restored.
+	IndexFileSets int
+}
+
+// Options represents the options for exports.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetFilesystemOptions sets the filesystem options, the file path prefix
+	// is the source of exports and the destination of restores.
+	SetFilesystemOptions(value fs.Options) Options
+
+	// FilesystemOptions returns the filesystem options.
+	FilesystemOptions() fs.Options
+
+	// SetExportPath sets the root directory of exports.
+	SetExportPath(value string) Options
+
+	// ExportPath returns the root directory of exports.
+	ExportPath() string
+
+	// SetExportID sets the ID of the export. Exports require the ID to be
+	// chosen up front so that every host exports under the same ID, the
+	// latest export is restored from when empty.
+	SetExportID(value string) Options
+
+	// ExportID returns the ID of the export.
+	ExportID() string
+
+	// SetHostID sets the ID of the host exporting, required for exports.
+	SetHostID(value string) Options
+
+	// HostID returns the ID of the host exporting.
+	HostID() string
+
+	// SetClockOptions sets the clock options.
+	SetClockOptions(value clock.Options) Options
+
+	// ClockOptions returns the clock options.
+	ClockOptions() clock.Options
+}
@@ -57,9 +57,12 @@ const (
 	indexDirName      = "index"
 	snapshotDirName   = "snapshots"
 	commitLogsDirName = "commitlogs"
+	tombstonesDirName = "tombstones"
+	tombstonesLogName = "log"
 
 	commitLogComponentPosition    = 2
 	indexFileSetComponentPosition = 2
+	dataFileSetComponentPosition  = 2
 
 	numComponentsSnapshotMetadataFile           = 4
 	numComponentsSnapshotMetadataCheckpointFile = 5
@@ -174,7 +177,7 @@ func (f FileSetFilesSlice) Filepaths() []string {
 }
 
 // LatestVolumeForBlock returns the latest (highest index) FileSetFile in the
-// slice for a given block start, only applicable for index and snapshot file set files.
+// slice for a given block start.
 func (f FileSetFilesSlice) LatestVolumeForBlock(blockStart time.Time) (FileSetFile, bool) {
 	// Make sure we're already sorted
 	f.sortByTimeAndVolumeIndexAscending()
@@ -357,6 +360,22 @@ func (a commitlogsByTimeAndIndexAscending) Less(i, j int) bool {
 	return ti.Equal(tj) && ii < ij
 }
 
+// dataFileSetFilesByTimeAndVolumeIndexAscending sorts data file sets files by their block start
+// times and volume index in ascending order. Data file sets written without a volume index in
+// their names are treated as volume zero.
+type dataFileSetFilesByTimeAndVolumeIndexAscending []string
+
+func (a dataFileSetFilesByTimeAndVolumeIndexAscending) Len() int      { return len(a) }
+func (a dataFileSetFilesByTimeAndVolumeIndexAscending) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a dataFileSetFilesByTimeAndVolumeIndexAscending) Less(i, j int) bool {
+	ti, ii, _ := TimeAndVolumeIndexFromDataFileSetFilename(a[i])
+	tj, ij, _ := TimeAndVolumeIndexFromDataFileSetFilename(a[j])
+	if ti.Before(tj) {
+		return true
+	}
+	return ti.Equal(tj) && ii < ij
+}
+
 // fileSetFilesByTimeAndIndexAscending sorts file sets files by their block start times and volume
 // index in ascending order. If the files do not have block start times or indexes in their names,
 // the result is undefined.
@@ -402,6 +421,20 @@ func TimeAndVolumeIndexFromFileSetFilename(fname string) (time.Time, int, error)
 	return timeAndIndexFromFileName(fname, indexFileSetComponentPosition)
 }
 
+// TimeAndVolumeIndexFromDataFileSetFilename extracts the block start and volume index from
+// the file name of a data file set. The first volume of a block is written without a volume
+// index in its name for backwards compatibility, so it is returned as volume zero.
+func TimeAndVolumeIndexFromDataFileSetFilename(fname string) (time.Time, int, error) {
+	components, t, err := componentsAndTimeFromFileName(fname)
+	if err != nil {
+		return timeZero, 0, err
+	}
+	if len(components) == 3 {
+		return t, 0, nil
+	}
+	return timeAndIndexFromFileName(fname, dataFileSetComponentPosition)
+}
+
 func timeAndIndexFromFileName(fname string, componentPosition int) (time.Time, int, error) {
 	components, t, err := componentsAndTimeFromFileName(fname)
 	if err != nil {
@@ -556,9 +589,9 @@ func forEachInfoFile(
 		case persist.FileSetFlushType:
 			switch args.contentType {
 			case persist.FileSetDataContentType:
-				checkpointFilePath = filesetPathFromTime(dir, t, checkpointFileSuffix)
-				digestsFilePath = filesetPathFromTime(dir, t, digestFileSuffix)
-				infoFilePath = filesetPathFromTime(dir, t, infoFileSuffix)
+				checkpointFilePath = dataFilesetPathFromTimeAndIndex(dir, t, volume, checkpointFileSuffix)
+				digestsFilePath = dataFilesetPathFromTimeAndIndex(dir, t, volume, digestFileSuffix)
+				infoFilePath = dataFilesetPathFromTimeAndIndex(dir, t, volume, infoFileSuffix)
 			case persist.FileSetIndexContentType:
 				checkpointFilePath = filesetPathFromTimeAndIndex(dir, t, volume, checkpointFileSuffix)
 				digestsFilePath = filesetPathFromTimeAndIndex(dir, t, volume, digestFileSuffix)
@@ -621,6 +654,7 @@ func forEachInfoFile(
 
 // ReadInfoFileResult is the result of reading an info file
 type ReadInfoFileResult struct {
+	ID   FileSetFileIdentifier
 	Info schema.IndexInfo
 	Err  ReadInfoFileResultError
 }
@@ -648,7 +682,8 @@ func (r readInfoFileResultError) Filepath() string {
 }
 
 // ReadInfoFiles reads all the valid info entries. Even if ReadInfoFiles returns an error,
-// there may be some valid entries in the returned slice.
+// there may be some valid entries in the returned slice. Only the info entry of the latest
+// complete volume is returned for each block start.
 func ReadInfoFiles(
 	filePathPrefix string,
 	namespace ident.ID,
@@ -670,13 +705,22 @@ func ReadInfoFiles(
 		func(filepath string, id FileSetFileIdentifier, data []byte) {
 			decoder.Reset(msgpack.NewByteDecoderStream(data))
 			info, err := decoder.DecodeIndexInfo()
-			infoFileResults = append(infoFileResults, ReadInfoFileResult{
+			result := ReadInfoFileResult{
+				ID:   id,
 				Info: info,
 				Err: readInfoFileResultError{
 					err:      err,
 					filepath: filepath,
 				},
-			})
+			}
+			// Info files are visited in ascending block start and volume
+			// index order so a later volume supersedes an earlier one.
+			if n := len(infoFileResults); n > 0 &&
+				infoFileResults[n-1].ID.BlockStart.Equal(id.BlockStart) {
+				infoFileResults[n-1] = result
+				return
+			}
+			infoFileResults = append(infoFileResults, result)
 		})
 	return infoFileResults
 }
@@ -793,6 +837,31 @@ func SortedSnapshotMetadataFiles(opts Options) (
 	return metadatas, errorsWithPaths, nil
 }
 
+// DataFiles returns a slice of all the names for all the flush data fileset
+// files for a given namespace and shard combination.
+func DataFiles(filePathPrefix string, namespace ident.ID, shard uint32) (FileSetFilesSlice, error) {
+	return filesetFiles(filesetFilesSelector{
+		fileSetType:    persist.FileSetFlushType,
+		contentType:    persist.FileSetDataContentType,
+		filePathPrefix: filePathPrefix,
+		namespace:      namespace,
+		shard:          shard,
+		pattern:        filesetFilePattern,
+	})
+}
+
+// IndexFiles returns a slice of all the names for all the flush index fileset
+// files for a given namespace.
+func IndexFiles(filePathPrefix string, namespace ident.ID) (FileSetFilesSlice, error) {
+	return filesetFiles(filesetFilesSelector{
+		fileSetType:    persist.FileSetFlushType,
+		contentType:    persist.FileSetIndexContentType,
+		filePathPrefix: filePathPrefix,
+		namespace:      namespace,
+		pattern:        filesetFilePattern,
+	})
+}
+
 // SnapshotFiles returns a slice of all the names for all the fileset files
 // for a given namespace and shard combination.
 func SnapshotFiles(filePathPrefix string, namespace ident.ID, shard uint32) (FileSetFilesSlice, error) {
@@ -818,41 +887,27 @@ func IndexSnapshotFiles(filePathPrefix string, namespace ident.ID) (FileSetFiles
 	})
 }
 
-// FileSetAt returns a FileSetFile for the given namespace/shard/blockStart combination if it exists.
+// FileSetAt returns the latest complete volume FileSetFile for the given
+// namespace/shard/blockStart combination if it exists.
 func FileSetAt(filePathPrefix string, namespace ident.ID, shard uint32, blockStart time.Time) (FileSetFile, bool, error) {
-	matched, err := filesetFiles(filesetFilesSelector{
+	matched, err := dataFileSetFilesAt(filePathPrefix, namespace, shard, blockStart)
+	if err != nil {
+		return FileSetFile{}, false, err
+	}
+
+	latest, ok := matched.LatestVolumeForBlock(blockStart)
+	return latest, ok, nil
+}
+
+func dataFileSetFilesAt(filePathPrefix string, namespace ident.ID, shard uint32, blockStart time.Time) (FileSetFilesSlice, error) {
+	return filesetFiles(filesetFilesSelector{
 		fileSetType:    persist.FileSetFlushType,
 		contentType:    persist.FileSetDataContentType,
 		filePathPrefix: filePathPrefix,
 		namespace:      namespace,
 		shard:          shard,
-		pattern:        filesetFileForTime(blockStart, anyLowerCaseCharsPattern),
+		pattern:        filesetFileForTime(blockStart, anyLowerCaseCharsNumbersPattern),
 	})
-	if err != nil {
-		return FileSetFile{}, false, err
-	}
-
-	matched.sortByTimeAscending()
-	for i, fileset := range matched {
-		if fileset.ID.BlockStart.Equal(blockStart) {
-			nextIdx := i + 1
-			if nextIdx < len(matched) && matched[nextIdx].ID.BlockStart.Equal(blockStart) {
-				// Should never happen
-				return FileSetFile{}, false, fmt.Errorf(
-					"found multiple fileset files for blockStart: %d",
-					blockStart.Unix(),
-				)
-			}
-
-			if !fileset.HasCompleteCheckpointFile() {
-				continue
-			}
-
-			return fileset, true, nil
-		}
-	}
-
-	return FileSetFile{}, false, nil
 }
 
 // IndexFileSetsAt returns all FileSetFile(s) for the given namespace/blockStart combination.
@@ -883,17 +938,24 @@ func IndexFileSetsAt(filePathPrefix string, namespace ident.ID, blockStart time.
 	return filesets, nil
 }
 
-// DeleteFileSetAt deletes a FileSetFile for a given namespace/shard/blockStart combination if it exists.
-func DeleteFileSetAt(filePathPrefix string, namespace ident.ID, shard uint32, t time.Time) error {
-	fileset, ok, err := FileSetAt(filePathPrefix, namespace, shard, t)
+// DeleteFileSetAt deletes a FileSetFile for a given namespace/shard/blockStart/volume combination if it exists.
+func DeleteFileSetAt(filePathPrefix string, namespace ident.ID, shard uint32, t time.Time, volume int) error {
+	matched, err := dataFileSetFilesAt(filePathPrefix, namespace, shard, t)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return fmt.Errorf("fileset for blockStart: %d does not exist", t.Unix())
+
+	for _, fileset := range matched {
+		if !fileset.ID.BlockStart.Equal(t) || fileset.ID.VolumeIndex != volume {
+			continue
+		}
+		if !fileset.HasCompleteCheckpointFile() {
+			continue
+		}
+		return DeleteFiles(fileset.AbsoluteFilepaths)
 	}
 
-	return DeleteFiles(fileset.AbsoluteFilepaths)
+	return fmt.Errorf("fileset for blockStart: %d, volume: %d does not exist", t.Unix(), volume)
 }
 
 // DataFileSetsBefore returns all the flush data fileset files whose timestamps are earlier than a given time.
@@ -912,6 +974,32 @@ func DataFileSetsBefore(filePathPrefix string, namespace ident.ID, shard uint32,
 	return FilesBefore(matched.Filepaths(), t)
 }
 
+// DataFileSetsSuperseded returns all the flush data fileset files that belong to a
+// volume which has been superseded by a later complete volume for the same block start.
+func DataFileSetsSuperseded(filePathPrefix string, namespace ident.ID, shard uint32) ([]string, error) {
+	matched, err := filesetFiles(filesetFilesSelector{
+		fileSetType:    persist.FileSetFlushType,
+		contentType:    persist.FileSetDataContentType,
+		filePathPrefix: filePathPrefix,
+		namespace:      namespace,
+		shard:          shard,
+		pattern:        filesetFilePattern,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var superseded []string
+	for i := range matched {
+		latest, ok := matched.LatestVolumeForBlock(matched[i].ID.BlockStart)
+		if !ok || matched[i].ID.VolumeIndex >= latest.ID.VolumeIndex {
+			continue
+		}
+		superseded = append(superseded, matched[i].AbsoluteFilepaths...)
+	}
+	return superseded, nil
+}
+
 // IndexFileSetsBefore returns all the flush index fileset files whose timestamps are earlier than a given time.
 func IndexFileSetsBefore(filePathPrefix string, namespace ident.ID, t time.Time) ([]string, error) {
 	matched, err := filesetFiles(filesetFilesSelector{
@@ -1011,7 +1099,7 @@ func filesetFiles(args filesetFilesSelector) (FileSetFilesSlice, error) {
 		case persist.FileSetDataContentType:
 			dir := ShardDataDirPath(args.filePathPrefix, args.namespace, args.shard)
 			byTimeAsc, err = findFiles(dir, args.pattern, func(files []string) sort.Interface {
-				return byTimeAscending(files)
+				return dataFileSetFilesByTimeAndVolumeIndexAscending(files)
 			})
 		case persist.FileSetIndexContentType:
 			dir := NamespaceIndexDataDirPath(args.filePathPrefix, args.namespace)
@@ -1061,7 +1149,7 @@ func filesetFiles(args filesetFilesSelector) (FileSetFilesSlice, error) {
 		case persist.FileSetFlushType:
 			switch args.contentType {
 			case persist.FileSetDataContentType:
-				currentFileBlockStart, err = TimeFromFileName(file)
+				currentFileBlockStart, volumeIndex, err = TimeAndVolumeIndexFromDataFileSetFilename(file)
 			case persist.FileSetIndexContentType:
 				currentFileBlockStart, volumeIndex, err = TimeAndVolumeIndexFromFileSetFilename(file)
 			default:
@@ -1211,10 +1299,33 @@ func CommitLogsDirPath(prefix string) string {
 	return path.Join(prefix, commitLogsDirName)
 }
 
+// TombstonesDirPath returns the path to the series tombstones directory.
+func TombstonesDirPath(prefix string) string {
+	return path.Join(prefix, tombstonesDirName)
+}
+
+// NamespaceTombstonesFilePath returns the path to the series tombstones file for a given namespace.
+func NamespaceTombstonesFilePath(prefix string, namespace ident.ID) string {
+	return path.Join(TombstonesDirPath(prefix), namespace.String()+fileSuffix)
+}
+
+// NamespaceTombstonesLogFilePath returns the path to the series tombstones log file for a given namespace.
+func NamespaceTombstonesLogFilePath(prefix string, namespace ident.ID) string {
+	return path.Join(TombstonesDirPath(prefix),
+		namespace.String()+separator+tombstonesLogName+fileSuffix)
+}
+
 // DataFileSetExistsAt determines whether data fileset files exist for the given namespace, shard, and block start.
 func DataFileSetExistsAt(filePathPrefix string, namespace ident.ID, shard uint32, blockStart time.Time) (bool, error) {
+	_, ok, err := FileSetAt(filePathPrefix, namespace, shard, blockStart)
+	return ok, err
+}
+
+// DataFileSetVolumeExistsAt determines whether data fileset files exist for the given
+// namespace, shard, block start and volume.
+func DataFileSetVolumeExistsAt(filePathPrefix string, namespace ident.ID, shard uint32, blockStart time.Time, volume int) (bool, error) {
 	shardDir := ShardDataDirPath(filePathPrefix, namespace, shard)
-	checkpointPath := filesetPathFromTime(shardDir, blockStart, checkpointFileSuffix)
+	checkpointPath := dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volume, checkpointFileSuffix)
 	return CompleteCheckpointFileExists(checkpointPath)
 }
 
@@ -1268,6 +1379,20 @@ func NextSnapshotFileSetVolumeIndex(filePathPrefix string, namespace ident.ID, s
 	return latestFile.ID.VolumeIndex + 1, nil
 }
 
+// NextDataFileSetVolumeIndex returns the next data file set index for a given
+// namespace/shard/blockStart combination.
+func NextDataFileSetVolumeIndex(filePathPrefix string, namespace ident.ID, shard uint32, blockStart time.Time) (int, error) {
+	latestFile, ok, err := FileSetAt(filePathPrefix, namespace, shard, blockStart)
+	if err != nil {
+		return -1, err
+	}
+	if !ok {
+		return 0, nil
+	}
+
+	return latestFile.ID.VolumeIndex + 1, nil
+}
+
 // NextIndexFileSetVolumeIndex returns the next index file set index for a given
 // namespace/blockStart combination.
 func NextIndexFileSetVolumeIndex(filePathPrefix string, namespace ident.ID, blockStart time.Time) (int, error) {
@@ -1309,6 +1434,12 @@ func NextIndexSnapshotFileIndex(filePathPrefix string, namespace ident.ID, block
 	return currentSnapshotIndex + 1, nil
 }
 
+// IsCheckpointFile returns whether the file path is that of a fileset
+// checkpoint file.
+func IsCheckpointFile(filePath string) bool {
+	return strings.HasSuffix(filePath, separator+checkpointFileSuffix+fileSuffix)
+}
+
 // CompleteCheckpointFileExists returns whether a checkpoint file exists, and if so,
 // is it complete.
 func CompleteCheckpointFileExists(filePath string) (bool, error) {
@@ -1383,6 +1514,16 @@ func filesetPathFromTimeAndIndex(prefix string, t time.Time, index int, suffix s
 	return path.Join(prefix, filesetFileForTime(t, fmt.Sprintf("%d%s%s", index, separator, suffix)))
 }
 
+// dataFilesetPathFromTimeAndIndex returns the path of a data fileset file, the
+// first volume of a block omits the volume index to remain compatible with
+// data filesets written before volumes were introduced.
+func dataFilesetPathFromTimeAndIndex(prefix string, t time.Time, index int, suffix string) string {
+	if index == 0 {
+		return filesetPathFromTime(prefix, t, suffix)
+	}
+	return filesetPathFromTimeAndIndex(prefix, t, index, suffix)
+}
+
 func filesetIndexSegmentFileSuffixFromTime(
 	t time.Time,
 	segmentIndex int,
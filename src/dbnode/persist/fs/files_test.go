@@ -488,7 +488,7 @@ func TestDeleteFileSetAt(t *testing.T) {
 		require.True(t, ok)
 		require.Equal(t, timestamp, res.ID.BlockStart)
 
-		err = DeleteFileSetAt(dir, testNs1ID, shard, timestamp)
+		err = DeleteFileSetAt(dir, testNs1ID, shard, timestamp, 0)
 		require.NoError(t, err)
 
 		res, ok, err = FileSetAt(dir, testNs1ID, shard, timestamp)
@@ -497,6 +497,73 @@ func TestDeleteFileSetAt(t *testing.T) {
 	}
 }
 
+func TestFileSetAtLatestVolume(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		shard      = uint32(0)
+		blockStart = time.Unix(0, 0)
+	)
+	fileSetFileIdentifiers{
+		{FileSetContentType: persist.FileSetDataContentType, Namespace: testNs1ID,
+			Shard: shard, BlockStart: blockStart, VolumeIndex: 0},
+		{FileSetContentType: persist.FileSetDataContentType, Namespace: testNs1ID,
+			Shard: shard, BlockStart: blockStart, VolumeIndex: 1},
+	}.create(t, dir, persist.FileSetFlushType, infoFileSuffix, checkpointFileSuffix)
+	// Incomplete volume without a checkpoint file.
+	fileSetFileIdentifiers{
+		{FileSetContentType: persist.FileSetDataContentType, Namespace: testNs1ID,
+			Shard: shard, BlockStart: blockStart, VolumeIndex: 2},
+	}.create(t, dir, persist.FileSetFlushType, infoFileSuffix)
+
+	res, ok, err := FileSetAt(dir, testNs1ID, shard, blockStart)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1, res.ID.VolumeIndex)
+	require.Equal(t, 2, len(res.AbsoluteFilepaths))
+
+	next, err := NextDataFileSetVolumeIndex(dir, testNs1ID, shard, blockStart)
+	require.NoError(t, err)
+	require.Equal(t, 2, next)
+
+	exists, err := DataFileSetVolumeExistsAt(dir, testNs1ID, shard, blockStart, 0)
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = DataFileSetVolumeExistsAt(dir, testNs1ID, shard, blockStart, 2)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	superseded, err := DataFileSetsSuperseded(dir, testNs1ID, shard)
+	require.NoError(t, err)
+	shardDir := ShardDataDirPath(dir, testNs1ID, shard)
+	require.Equal(t, []string{
+		filesetPathFromTime(shardDir, blockStart, checkpointFileSuffix),
+		filesetPathFromTime(shardDir, blockStart, infoFileSuffix),
+	}, superseded)
+
+	require.NoError(t, DeleteFileSetAt(dir, testNs1ID, shard, blockStart, 1))
+	res, ok, err = FileSetAt(dir, testNs1ID, shard, blockStart)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 0, res.ID.VolumeIndex)
+}
+
+func TestTimeAndVolumeIndexFromDataFileSetFilename(t *testing.T) {
+	start := time.Unix(0, 12345)
+	ts, volume, err := TimeAndVolumeIndexFromDataFileSetFilename(
+		filesetPathFromTime("/foo", start, dataFileSuffix))
+	require.NoError(t, err)
+	require.Equal(t, start, ts)
+	require.Equal(t, 0, volume)
+
+	ts, volume, err = TimeAndVolumeIndexFromDataFileSetFilename(
+		dataFilesetPathFromTimeAndIndex("/foo", start, 3, dataFileSuffix))
+	require.NoError(t, err)
+	require.Equal(t, start, ts)
+	require.Equal(t, 3, volume)
+}
+
 func TestFileSetAtNotExist(t *testing.T) {
 	shard := uint32(0)
 	dir := createDataFlushInfoFilesDir(t, testNs1ID, shard, 0)
@@ -1190,7 +1257,7 @@ func (filesets fileSetFileIdentifiers) create(t *testing.T, prefixDir string, fi
 				var path string
 				switch fileSetType {
 				case persist.FileSetFlushType:
-					path = filesetPathFromTime(shardDir, blockStart, suffix)
+					path = dataFilesetPathFromTimeAndIndex(shardDir, blockStart, fileset.VolumeIndex, suffix)
 					writeFile(t, path, nil)
 				case persist.FileSetSnapshotType:
 					path = filesetPathFromTimeAndIndex(shardDir, blockStart, 0, fileSuffix)
@@ -0,0 +1,338 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/context"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+type merger struct {
+	reader         DataFileSetReader
+	blockAllocSize int
+	srPool         xio.SegmentReaderPool
+	multiIterPool  encoding.MultiReaderIteratorPool
+	identPool      ident.Pool
+	encoderPool    encoding.EncoderPool
+	filePathPrefix string
+}
+
+// NewMerger returns a new Merger. This implementation is in charge of merging
+// the data from an existing fileset with a merge target, the merged data is
+// then persisted as the next volume of the fileset.
+//
+// Note that the merger is not safe for concurrent use since the reader is
+// reused between merges.
+func NewMerger(
+	reader DataFileSetReader,
+	blockAllocSize int,
+	srPool xio.SegmentReaderPool,
+	multiIterPool encoding.MultiReaderIteratorPool,
+	identPool ident.Pool,
+	encoderPool encoding.EncoderPool,
+	filePathPrefix string,
+) Merger {
+	return &merger{
+		reader:         reader,
+		blockAllocSize: blockAllocSize,
+		srPool:         srPool,
+		multiIterPool:  multiIterPool,
+		identPool:      identPool,
+		encoderPool:    encoderPool,
+		filePathPrefix: filePathPrefix,
+	}
+}
+
+// Merge merges data from a fileset with a merge target and persists it. The
+// persisted fileset is written as the next volume so the volume being read
+// remains intact until the merged volume is complete. A next volume index of
+// zero means no volume of the fileset exists yet, in which case only the data
+// of the merge target is persisted. If the merge fails the partially written
+// volume is removed.
+func (m *merger) Merge(
+	fileID FileSetFileIdentifier,
+	mergeWith MergeWith,
+	deleted DeletedRangesFn,
+	nextVolumeIndex int,
+	flushPreparer persist.FlushPreparer,
+	nsMd namespace.Metadata,
+) (err error) {
+	var (
+		reader    = m.reader
+		nsCtx     = namespace.NewContextFrom(nsMd)
+		blockSize = nsMd.Options().RetentionOptions().BlockSize()
+		shard     = fileID.Shard
+		startTime = fileID.BlockStart
+
+		hasFileSet           = nextVolumeIndex > 0
+		downsampleResolution time.Duration
+	)
+
+	if hasFileSet {
+		openOpts := DataReaderOpenOptions{
+			Identifier:  fileID,
+			FileSetType: persist.FileSetFlushType,
+		}
+		if err := reader.Open(openOpts); err != nil {
+			return err
+		}
+		defer func() {
+			// Only set the error here if not set by the end of the function, since
+			// an error in the close function shouldn't take precedence over other
+			// errors in the function.
+			if closeErr := reader.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		downsampleResolution = reader.DownsampleResolution()
+	}
+
+	prepareOpts := persist.DataPrepareOptions{
+		NamespaceMetadata:    nsMd,
+		Shard:                shard,
+		BlockStart:           startTime,
+		VolumeIndex:          nextVolumeIndex,
+		FileSetType:          persist.FileSetFlushType,
+		DownsampleResolution: downsampleResolution,
+	}
+	prepared, err := flushPreparer.PrepareData(prepareOpts)
+	if err != nil {
+		return err
+	}
+
+	var (
+		// There will likely be at least two segment readers, one for the disk
+		// data and one for the data from the merge target.
+		segmentReaders = make([]xio.SegmentReader, 0, 2)
+		// It's safe to share these between iterations and just reset them
+		// each time because each series gets persisted before moving on to
+		// the next, so the previous iteration's reader and iterator will never
+		// be needed again.
+		segReader = m.srPool.Get()
+		multiIter = m.multiIterPool.Get()
+		multiErr  xerrors.MultiError
+		// The writer holds on to the IDs and tags of the series persisted
+		// until it is closed, so they can only be finalized afterwards.
+		idsToFinalize  []ident.ID
+		tagsToFinalize []ident.Tags
+	)
+	defer func() {
+		segReader.Reset(ts.Segment{})
+		segReader.Finalize()
+		multiIter.Close()
+		for _, id := range idsToFinalize {
+			id.Finalize()
+		}
+		for _, tags := range tagsToFinalize {
+			tags.Finalize()
+		}
+	}()
+
+	persistMerged := func(
+		id ident.ID,
+		tags ident.Tags,
+		readers []xio.SegmentReader,
+	) error {
+		multiIter.Reset(readers, startTime, blockSize, nsCtx.Schema)
+		encoder := m.encoderPool.Get()
+		encoder.Reset(startTime, m.blockAllocSize, nsCtx.Schema)
+		defer encoder.Close()
+
+		for multiIter.Next() {
+			dp, unit, annotation := multiIter.Current()
+			if err := encoder.Encode(dp, unit, annotation); err != nil {
+				return err
+			}
+		}
+		if err := multiIter.Err(); err != nil {
+			return err
+		}
+
+		stream, ok := encoder.Stream(encoding.StreamOptions{})
+		if !ok {
+			// Don't write out series with no data.
+			return nil
+		}
+		defer stream.Finalize()
+
+		segment, err := stream.Segment()
+		if err != nil {
+			return err
+		}
+		checksum := digest.SegmentChecksum(segment)
+		return prepared.Persist(id, tags, segment, checksum)
+	}
+
+	for hasFileSet {
+		id, tagsIter, data, checksum, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			break
+		}
+
+		tags, err := convert.TagsFromTagsIter(id, tagsIter, m.identPool)
+		tagsIter.Close()
+		if err != nil {
+			id.Finalize()
+			data.Finalize()
+			multiErr = multiErr.Add(err)
+			break
+		}
+
+		segment := ts.NewSegment(data, nil, ts.FinalizeHead)
+		if deleted != nil {
+			if ranges := deleted(id); len(ranges) > 0 {
+				segment, err = m.dropDeleted(segment, startTime, blockSize,
+					ranges, nsCtx)
+				if err == nil {
+					checksum = digest.SegmentChecksum(segment)
+				}
+			}
+		}
+
+		var (
+			ctx           = context.NewContext()
+			mergeWithData []xio.BlockReader
+			hasData       bool
+		)
+		if err == nil {
+			mergeWithData, hasData, err = mergeWith.Read(ctx, id, startTime, nsCtx)
+		}
+		if err == nil {
+			if hasData {
+				segmentReaders = segmentReaders[:0]
+				if segment.Len() > 0 {
+					segReader.Reset(segment)
+					segmentReaders = append(segmentReaders, segReader)
+				}
+				for _, br := range mergeWithData {
+					segmentReaders = append(segmentReaders, br.SegmentReader)
+				}
+				err = persistMerged(id, tags, segmentReaders)
+			} else if segment.Len() > 0 {
+				// Nothing to merge, the series can be persisted as is unless
+				// all of its data has been deleted.
+				err = prepared.Persist(id, tags, segment, checksum)
+			}
+		}
+		ctx.BlockingClose()
+		segment.Finalize()
+		idsToFinalize = append(idsToFinalize, id)
+		tagsToFinalize = append(tagsToFinalize, tags)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			break
+		}
+	}
+
+	if multiErr.Empty() {
+		ctx := context.NewContext()
+		err := mergeWith.ForEachRemaining(ctx, startTime, func(
+			id ident.ID,
+			tags ident.Tags,
+			data []xio.BlockReader,
+		) error {
+			segmentReaders = segmentReaders[:0]
+			for _, br := range data {
+				segmentReaders = append(segmentReaders, br.SegmentReader)
+			}
+			return persistMerged(id, tags, segmentReaders)
+		}, nsCtx)
+		ctx.BlockingClose()
+		if err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	if err := prepared.Close(); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
+	if err := multiErr.FinalError(); err != nil {
+		// Closing the prepared persist completes the volume, make sure a
+		// partially merged volume is never mistaken for the latest volume.
+		if exists, _ := DataFileSetVolumeExistsAt(m.filePathPrefix, fileID.Namespace,
+			shard, startTime, nextVolumeIndex); exists {
+			if delErr := DeleteFileSetAt(m.filePathPrefix, fileID.Namespace,
+				shard, startTime, nextVolumeIndex); delErr != nil {
+				multiErr = multiErr.Add(delErr)
+			}
+		}
+		return multiErr.FinalError()
+	}
+
+	return nil
+}
+
+// dropDeleted re-encodes the segment without the datapoints within any of
+// the deleted ranges, the given segment is finalized.
+func (m *merger) dropDeleted(
+	segment ts.Segment,
+	startTime time.Time,
+	blockSize time.Duration,
+	deleted DeletedRanges,
+	nsCtx namespace.Context,
+) (ts.Segment, error) {
+	var (
+		segReader = m.srPool.Get()
+		multiIter = m.multiIterPool.Get()
+		encoder   = m.encoderPool.Get()
+	)
+	defer func() {
+		segReader.Reset(ts.Segment{})
+		segReader.Finalize()
+		multiIter.Close()
+		segment.Finalize()
+	}()
+
+	segReader.Reset(segment)
+	multiIter.Reset([]xio.SegmentReader{segReader}, startTime, blockSize, nsCtx.Schema)
+	encoder.Reset(startTime, m.blockAllocSize, nsCtx.Schema)
+	for multiIter.Next() {
+		dp, unit, annotation := multiIter.Current()
+		if deleted.Contains(dp.Timestamp) {
+			continue
+		}
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			encoder.Close()
+			return ts.Segment{}, err
+		}
+	}
+	if err := multiIter.Err(); err != nil {
+		encoder.Close()
+		return ts.Segment{}, err
+	}
+	return encoder.Discard(), nil
+}
@@ -0,0 +1,334 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/runtime"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mergerTestSeries struct {
+	id     string
+	points []ts.Datapoint
+}
+
+type testMergeWith struct {
+	t        *testing.T
+	start    time.Time
+	encOpts  encoding.Options
+	series   map[string][]ts.Datapoint
+	returned map[string]struct{}
+}
+
+func newTestMergeWith(
+	t *testing.T,
+	start time.Time,
+	encOpts encoding.Options,
+	series []mergerTestSeries,
+) *testMergeWith {
+	m := &testMergeWith{
+		t:        t,
+		start:    start,
+		encOpts:  encOpts,
+		series:   make(map[string][]ts.Datapoint),
+		returned: make(map[string]struct{}),
+	}
+	for _, s := range series {
+		m.series[s.id] = s.points
+	}
+	return m
+}
+
+func (m *testMergeWith) blockReaders(points []ts.Datapoint) []xio.BlockReader {
+	encoder := m3tsz.NewEncoder(m.start, nil, m3tsz.DefaultIntOptimizationEnabled, m.encOpts)
+	for _, dp := range points {
+		require.NoError(m.t, encoder.Encode(dp, xtime.Second, nil))
+	}
+	stream, ok := encoder.Stream(encoding.StreamOptions{})
+	require.True(m.t, ok)
+	return []xio.BlockReader{{
+		SegmentReader: stream,
+		Start:         m.start,
+		BlockSize:     testBlockSize,
+	}}
+}
+
+func (m *testMergeWith) Read(
+	ctx context.Context,
+	seriesID ident.ID,
+	blockStart time.Time,
+	nsCtx namespace.Context,
+) ([]xio.BlockReader, bool, error) {
+	points, ok := m.series[seriesID.String()]
+	if !ok {
+		return nil, false, nil
+	}
+	m.returned[seriesID.String()] = struct{}{}
+	return m.blockReaders(points), true, nil
+}
+
+func (m *testMergeWith) ForEachRemaining(
+	ctx context.Context,
+	blockStart time.Time,
+	fn ForEachRemainingFn,
+	nsCtx namespace.Context,
+) error {
+	for id, points := range m.series {
+		if _, ok := m.returned[id]; ok {
+			continue
+		}
+		err := fn(ident.StringID(id), ident.Tags{}, m.blockReaders(points))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeMergerTestFileSet(
+	t *testing.T,
+	filePathPrefix string,
+	start time.Time,
+	encOpts encoding.Options,
+	series []mergerTestSeries,
+) {
+	w := newTestWriter(t, filePathPrefix)
+	require.NoError(t, w.Open(DataWriterOpenOptions{
+		Identifier: FileSetFileIdentifier{
+			Namespace:  testNs1ID,
+			Shard:      0,
+			BlockStart: start,
+		},
+		BlockSize:   testBlockSize,
+		FileSetType: persist.FileSetFlushType,
+	}))
+	for _, s := range series {
+		encoder := m3tsz.NewEncoder(start, nil, m3tsz.DefaultIntOptimizationEnabled, encOpts)
+		for _, dp := range s.points {
+			require.NoError(t, encoder.Encode(dp, xtime.Second, nil))
+		}
+		stream, ok := encoder.Stream(encoding.StreamOptions{})
+		require.True(t, ok)
+		segment, err := stream.Segment()
+		require.NoError(t, err)
+		require.NoError(t, w.WriteAll(ident.StringID(s.id), ident.Tags{},
+			[]checked.Bytes{segment.Head, segment.Tail}, digest.SegmentChecksum(segment)))
+		stream.Finalize()
+	}
+	require.NoError(t, w.Close())
+}
+
+func readMergerTestFileSet(
+	t *testing.T,
+	filePathPrefix string,
+	start time.Time,
+	volume int,
+	encOpts encoding.Options,
+) map[string][]ts.Datapoint {
+	r, err := NewReader(testBytesPool, testDefaultOpts.SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, err)
+	require.NoError(t, r.Open(DataReaderOpenOptions{
+		Identifier: FileSetFileIdentifier{
+			Namespace:   testNs1ID,
+			Shard:       0,
+			BlockStart:  start,
+			VolumeIndex: volume,
+		},
+		FileSetType: persist.FileSetFlushType,
+	}))
+	defer r.Close()
+
+	results := make(map[string][]ts.Datapoint)
+	for {
+		id, tagsIter, data, _, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		tagsIter.Close()
+
+		data.IncRef()
+		iter := m3tsz.NewReaderIterator(bytes.NewReader(data.Bytes()),
+			m3tsz.DefaultIntOptimizationEnabled, encOpts)
+		var points []ts.Datapoint
+		for iter.Next() {
+			dp, _, _ := iter.Current()
+			points = append(points, ts.Datapoint{Timestamp: dp.Timestamp, Value: dp.Value})
+		}
+		require.NoError(t, iter.Err())
+		iter.Close()
+		data.DecRef()
+		data.Finalize()
+
+		results[id.String()] = points
+		id.Finalize()
+	}
+	return results
+}
+
+func newTestMerger(
+	t *testing.T,
+	dir string,
+	start time.Time,
+	encOpts encoding.Options,
+) (Merger, persist.FlushPreparer, namespace.Metadata) {
+	encoderPool := encoding.NewEncoderPool(nil)
+	encoderPool.Init(func() encoding.Encoder {
+		return m3tsz.NewEncoder(start, nil, m3tsz.DefaultIntOptimizationEnabled, encOpts)
+	})
+	multiIterPool := encoding.NewMultiReaderIteratorPool(nil)
+	multiIterPool.Init(func(r io.Reader, _ namespace.SchemaDescr) encoding.ReaderIterator {
+		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encOpts)
+	})
+	srPool := xio.NewSegmentReaderPool(nil)
+	srPool.Init()
+	identPool := ident.NewPool(testBytesPool, ident.PoolOptions{})
+
+	reader, err := NewReader(testBytesPool, testDefaultOpts.SetFilePathPrefix(dir))
+	require.NoError(t, err)
+	merger := NewMerger(reader, 0, srPool, multiIterPool, identPool, encoderPool, dir)
+
+	pm, err := NewPersistManager(testDefaultOpts.
+		SetFilePathPrefix(dir).
+		SetRuntimeOptionsManager(runtime.NewOptionsManager()))
+	require.NoError(t, err)
+	flush, err := pm.StartFlushPersist()
+	require.NoError(t, err)
+
+	md, err := namespace.NewMetadata(testNs1ID, namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().SetBlockSize(testBlockSize)))
+	require.NoError(t, err)
+	return merger, flush, md
+}
+
+func TestMergerMergesIntoNextVolume(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		start   = testWriterStart.Truncate(testBlockSize)
+		encOpts = encoding.NewOptions()
+		dp      = func(offset time.Duration, v float64) ts.Datapoint {
+			return ts.Datapoint{Timestamp: start.Add(offset), Value: v}
+		}
+	)
+
+	writeMergerTestFileSet(t, dir, start, encOpts, []mergerTestSeries{
+		{id: "foo", points: []ts.Datapoint{dp(time.Minute, 1), dp(3*time.Minute, 3)}},
+		{id: "bar", points: []ts.Datapoint{dp(time.Minute, 10)}},
+		{id: "qux", points: []ts.Datapoint{dp(time.Minute, 1000)}},
+	})
+
+	mergeWith := newTestMergeWith(t, start, encOpts, []mergerTestSeries{
+		{id: "foo", points: []ts.Datapoint{dp(2*time.Minute, 2)}},
+		{id: "baz", points: []ts.Datapoint{dp(time.Minute, 100)}},
+	})
+
+	merger, flush, md := newTestMerger(t, dir, start, encOpts)
+
+	fileID := FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		Shard:      0,
+		BlockStart: start,
+	}
+	deleted := func(id ident.ID) DeletedRanges {
+		switch id.String() {
+		case "foo":
+			// Only data on disk is deleted, data merged in is always kept.
+			return DeletedRanges{{Start: start.Add(2 * time.Minute), End: start.Add(4 * time.Minute)}}
+		case "qux":
+			return DeletedRanges{{Start: start, End: start.Add(testBlockSize)}}
+		}
+		return nil
+	}
+	require.NoError(t, merger.Merge(fileID, mergeWith, deleted, 1, flush, md))
+	require.NoError(t, flush.DoneFlush())
+
+	latest, ok, err := FileSetAt(dir, testNs1ID, 0, start)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1, latest.ID.VolumeIndex)
+
+	require.Equal(t, map[string][]ts.Datapoint{
+		"foo": {dp(time.Minute, 1), dp(2*time.Minute, 2)},
+		"bar": {dp(time.Minute, 10)},
+		"baz": {dp(time.Minute, 100)},
+	}, readMergerTestFileSet(t, dir, start, 1, encOpts))
+
+	// The merged volume supersedes the original volume.
+	superseded, err := DataFileSetsSuperseded(dir, testNs1ID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, superseded)
+}
+
+func TestMergerWithoutFileSetWritesFirstVolume(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		start   = testWriterStart.Truncate(testBlockSize)
+		encOpts = encoding.NewOptions()
+		dp      = func(offset time.Duration, v float64) ts.Datapoint {
+			return ts.Datapoint{Timestamp: start.Add(offset), Value: v}
+		}
+	)
+
+	mergeWith := newTestMergeWith(t, start, encOpts, []mergerTestSeries{
+		{id: "foo", points: []ts.Datapoint{dp(2*time.Minute, 2)}},
+	})
+	merger, flush, md := newTestMerger(t, dir, start, encOpts)
+
+	fileID := FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		Shard:      0,
+		BlockStart: start,
+	}
+	require.NoError(t, merger.Merge(fileID, mergeWith, nil, 0, flush, md))
+	require.NoError(t, flush.DoneFlush())
+
+	latest, ok, err := FileSetAt(dir, testNs1ID, 0, start)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 0, latest.ID.VolumeIndex)
+
+	require.Equal(t, map[string][]ts.Datapoint{
+		"foo": {dp(2*time.Minute, 2)},
+	}, readMergerTestFileSet(t, dir, start, 0, encOpts))
+}
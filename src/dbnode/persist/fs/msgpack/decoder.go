@@ -251,6 +251,11 @@ func (dec *Decoder) decodeIndexInfo() schema.IndexInfo {
 		opts.override = true
 		opts.numExpectedMinFields = 8
 		opts.numExpectedCurrFields = 8
+	} else if dec.legacy.decodeLegacyIndexInfoVersion == legacyEncodingIndexVersionV3 {
+		// V3 had 9 fields.
+		opts.override = true
+		opts.numExpectedMinFields = 9
+		opts.numExpectedCurrFields = 9
 	}
 
 	numFieldsToSkip, actual, ok := dec.checkNumFieldsFor(indexInfoType, opts)
@@ -285,6 +290,15 @@ func (dec *Decoder) decodeIndexInfo() schema.IndexInfo {
 	// Decode fields added in V3.
 	indexInfo.SnapshotID, _, _ = dec.decodeBytes()
 
+	// At this point if its a V3 file we've decoded all the available fields.
+	if dec.legacy.decodeLegacyIndexInfoVersion == legacyEncodingIndexVersionV3 || actual < 10 {
+		dec.skip(numFieldsToSkip)
+		return indexInfo
+	}
+
+	// Decode fields added in V4.
+	indexInfo.DownsampleResolution = dec.decodeVarint()
+
 	dec.skip(numFieldsToSkip)
 	return indexInfo
 }
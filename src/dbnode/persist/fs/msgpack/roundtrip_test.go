@@ -47,6 +47,8 @@ var (
 		SnapshotTime: time.Now().UnixNano(),
 		FileType:     persist.FileSetSnapshotType,
 		SnapshotID:   []byte("some_bytes"),
+
+		DownsampleResolution: int64(5 * time.Minute),
 	}
 
 	testIndexEntry = schema.IndexEntry{
@@ -112,17 +114,20 @@ func TestIndexInfoRoundTripBackwardsCompatibilityV1(t *testing.T) {
 	// because the new decoder won't try and read the new fields from
 	// the old file format
 	var (
-		currSnapshotTime = testIndexInfo.SnapshotTime
-		currFileType     = testIndexInfo.FileType
-		currSnapshotID   = testIndexInfo.SnapshotID
+		currSnapshotTime         = testIndexInfo.SnapshotTime
+		currFileType             = testIndexInfo.FileType
+		currSnapshotID           = testIndexInfo.SnapshotID
+		currDownsampleResolution = testIndexInfo.DownsampleResolution
 	)
 	testIndexInfo.SnapshotTime = 0
 	testIndexInfo.FileType = 0
 	testIndexInfo.SnapshotID = nil
+	testIndexInfo.DownsampleResolution = 0
 	defer func() {
 		testIndexInfo.SnapshotTime = currSnapshotTime
 		testIndexInfo.FileType = currFileType
 		testIndexInfo.SnapshotID = currSnapshotID
+		testIndexInfo.DownsampleResolution = currDownsampleResolution
 	}()
 
 	enc.EncodeIndexInfo(testIndexInfo)
@@ -144,9 +149,10 @@ func TestIndexInfoRoundTripForwardsCompatibilityV2(t *testing.T) {
 	// and then restore them at the end of the test - This is required
 	// because the old decoder won't read the new fields
 	var (
-		currSnapshotTime = testIndexInfo.SnapshotTime
-		currFileType     = testIndexInfo.FileType
-		currSnapshotID   = testIndexInfo.SnapshotID
+		currSnapshotTime         = testIndexInfo.SnapshotTime
+		currFileType             = testIndexInfo.FileType
+		currSnapshotID           = testIndexInfo.SnapshotID
+		currDownsampleResolution = testIndexInfo.DownsampleResolution
 	)
 
 	enc.EncodeIndexInfo(testIndexInfo)
@@ -156,10 +162,12 @@ func TestIndexInfoRoundTripForwardsCompatibilityV2(t *testing.T) {
 	testIndexInfo.SnapshotTime = 0
 	testIndexInfo.FileType = 0
 	testIndexInfo.SnapshotID = nil
+	testIndexInfo.DownsampleResolution = 0
 	defer func() {
 		testIndexInfo.SnapshotTime = currSnapshotTime
 		testIndexInfo.FileType = currFileType
 		testIndexInfo.SnapshotID = currSnapshotID
+		testIndexInfo.DownsampleResolution = currDownsampleResolution
 	}()
 
 	dec.Reset(NewByteDecoderStream(enc.Bytes()))
@@ -181,17 +189,20 @@ func TestIndexInfoRoundTripBackwardsCompatibilityV2(t *testing.T) {
 	// because the new decoder won't try and read the new fields from
 	// the old file format.
 	var (
-		currSnapshotTime = testIndexInfo.SnapshotTime
-		currFileType     = testIndexInfo.FileType
-		currSnapshotID   = testIndexInfo.SnapshotID
+		currSnapshotTime         = testIndexInfo.SnapshotTime
+		currFileType             = testIndexInfo.FileType
+		currSnapshotID           = testIndexInfo.SnapshotID
+		currDownsampleResolution = testIndexInfo.DownsampleResolution
 	)
 	testIndexInfo.SnapshotTime = 0
 	testIndexInfo.FileType = 0
 	testIndexInfo.SnapshotID = nil
+	testIndexInfo.DownsampleResolution = 0
 	defer func() {
 		testIndexInfo.SnapshotTime = currSnapshotTime
 		testIndexInfo.FileType = currFileType
 		testIndexInfo.SnapshotID = currSnapshotID
+		testIndexInfo.DownsampleResolution = currDownsampleResolution
 	}()
 
 	enc.EncodeIndexInfo(testIndexInfo)
@@ -212,15 +223,73 @@ func TestIndexInfoRoundTripForwardsCompatibilityV3(t *testing.T) {
 	// Set the default values on the fields that did not exist in V2
 	// and then restore them at the end of the test - This is required
 	// because the old decoder won't read the new fields.
-	currSnapshotID := testIndexInfo.SnapshotID
+	var (
+		currSnapshotID           = testIndexInfo.SnapshotID
+		currDownsampleResolution = testIndexInfo.DownsampleResolution
+	)
 
 	enc.EncodeIndexInfo(testIndexInfo)
 
 	// Make sure to zero them before we compare, but after we have
 	// encoded the data.
 	testIndexInfo.SnapshotID = nil
+	testIndexInfo.DownsampleResolution = 0
 	defer func() {
 		testIndexInfo.SnapshotID = currSnapshotID
+		testIndexInfo.DownsampleResolution = currDownsampleResolution
+	}()
+
+	dec.Reset(NewByteDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeIndexInfo()
+	require.NoError(t, err)
+	require.Equal(t, testIndexInfo, res)
+}
+
+// Make sure the V4 decoding code can handle the V3 file format.
+func TestIndexInfoRoundTripBackwardsCompatibilityV3(t *testing.T) {
+	var (
+		opts = legacyEncodingOptions{encodeLegacyIndexInfoVersion: legacyEncodingIndexVersionV3}
+		enc  = newEncoder(opts)
+		dec  = newDecoder(opts, nil)
+	)
+
+	// Set the default values on the fields that did not exist in V3,
+	// and then restore them at the end of the test - This is required
+	// because the new decoder won't try and read the new fields from
+	// the old file format.
+	currDownsampleResolution := testIndexInfo.DownsampleResolution
+	testIndexInfo.DownsampleResolution = 0
+	defer func() {
+		testIndexInfo.DownsampleResolution = currDownsampleResolution
+	}()
+
+	enc.EncodeIndexInfo(testIndexInfo)
+	dec.Reset(NewByteDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeIndexInfo()
+	require.NoError(t, err)
+	require.Equal(t, testIndexInfo, res)
+}
+
+// Make sure the V3 decoder code can handle the V4 file format.
+func TestIndexInfoRoundTripForwardsCompatibilityV4(t *testing.T) {
+	var (
+		opts = legacyEncodingOptions{decodeLegacyIndexInfoVersion: legacyEncodingIndexVersionV3}
+		enc  = newEncoder(opts)
+		dec  = newDecoder(opts, nil)
+	)
+
+	// Set the default values on the fields that did not exist in V3
+	// and then restore them at the end of the test - This is required
+	// because the old decoder won't read the new fields.
+	currDownsampleResolution := testIndexInfo.DownsampleResolution
+
+	enc.EncodeIndexInfo(testIndexInfo)
+
+	// Make sure to zero them before we compare, but after we have
+	// encoded the data.
+	testIndexInfo.DownsampleResolution = 0
+	defer func() {
+		testIndexInfo.DownsampleResolution = currDownsampleResolution
 	}()
 
 	dec.Reset(NewByteDecoderStream(enc.Bytes()))
@@ -421,7 +421,7 @@ func (pm *persistManager) PrepareData(opts persist.DataPrepareOptions) (persist.
 		return prepared, err
 	}
 
-	var volumeIndex int
+	volumeIndex := opts.VolumeIndex
 	if opts.FileSetType == persist.FileSetSnapshotType {
 		// Need to work out the volume index for the next snapshot
 		volumeIndex, err = NextSnapshotFileSetVolumeIndex(pm.opts.FilePathPrefix(),
@@ -453,7 +453,7 @@ func (pm *persistManager) PrepareData(opts persist.DataPrepareOptions) (persist.
 	}
 
 	if exists && opts.DeleteIfExists {
-		err := DeleteFileSetAt(pm.opts.FilePathPrefix(), nsID, shard, blockStart)
+		err := DeleteFileSetAt(pm.opts.FilePathPrefix(), nsID, shard, blockStart, volumeIndex)
 		if err != nil {
 			return prepared, err
 		}
@@ -473,6 +473,7 @@ func (pm *persistManager) PrepareData(opts persist.DataPrepareOptions) (persist.
 			BlockStart:  blockStart,
 			VolumeIndex: volumeIndex,
 		},
+		DownsampleResolution: opts.DownsampleResolution,
 	}
 	if err := pm.dataPM.writer.Open(dataWriterOpts); err != nil {
 		return prepared, err
@@ -611,7 +612,8 @@ func (pm *persistManager) dataFilesetExistsAt(prepareOpts persist.DataPrepareOpt
 		// already exist doesn't make much sense
 		return false, nil
 	case persist.FileSetFlushType:
-		return DataFileSetExistsAt(pm.filePathPrefix, nsID, shard, blockStart)
+		return DataFileSetVolumeExistsAt(pm.filePathPrefix, nsID, shard, blockStart,
+			prepareOpts.VolumeIndex)
 	default:
 		return false, fmt.Errorf(
 			"unable to determine if fileset exists in persist manager for fileset type: %s",
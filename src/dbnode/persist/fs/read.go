@@ -59,8 +59,9 @@ type reader struct {
 	filePathPrefix string
 	namespace      ident.ID
 
-	start     time.Time
-	blockSize time.Duration
+	start                time.Time
+	blockSize            time.Duration
+	downsampleResolution time.Duration
 
 	infoFdWithDigest           digest.FdWithDigestReader
 	bloomFilterWithDigest      digest.FdWithDigestReader
@@ -92,6 +93,7 @@ type reader struct {
 	expectedDigestOfDigest    uint32
 	expectedBloomFilterDigest uint32
 	shard                     uint32
+	volume                    int
 	open                      bool
 }
 
@@ -132,6 +134,7 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 		shard         = opts.Identifier.Shard
 		blockStart    = opts.Identifier.BlockStart
 		snapshotIndex = opts.Identifier.VolumeIndex
+		volumeIndex   = opts.Identifier.VolumeIndex
 		err           error
 	)
 
@@ -155,12 +158,12 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 		dataFilepath = filesetPathFromTimeAndIndex(shardDir, blockStart, snapshotIndex, dataFileSuffix)
 	case persist.FileSetFlushType:
 		shardDir = ShardDataDirPath(r.filePathPrefix, namespace, shard)
-		checkpointFilepath = filesetPathFromTime(shardDir, blockStart, checkpointFileSuffix)
-		infoFilepath = filesetPathFromTime(shardDir, blockStart, infoFileSuffix)
-		digestFilepath = filesetPathFromTime(shardDir, blockStart, digestFileSuffix)
-		bloomFilterFilepath = filesetPathFromTime(shardDir, blockStart, bloomFilterFileSuffix)
-		indexFilepath = filesetPathFromTime(shardDir, blockStart, indexFileSuffix)
-		dataFilepath = filesetPathFromTime(shardDir, blockStart, dataFileSuffix)
+		checkpointFilepath = dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, checkpointFileSuffix)
+		infoFilepath = dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, infoFileSuffix)
+		digestFilepath = dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, digestFileSuffix)
+		bloomFilterFilepath = dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, bloomFilterFileSuffix)
+		indexFilepath = dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, indexFileSuffix)
+		dataFilepath = dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, dataFileSuffix)
 	default:
 		return fmt.Errorf("unable to open reader with fileset type: %s", opts.FileSetType)
 	}
@@ -237,6 +240,7 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 	r.open = true
 	r.namespace = namespace
 	r.shard = shard
+	r.volume = volumeIndex
 
 	return nil
 }
@@ -246,6 +250,7 @@ func (r *reader) Status() DataFileSetReaderStatus {
 		Open:       r.open,
 		Namespace:  r.namespace,
 		Shard:      r.shard,
+		Volume:     r.volume,
 		BlockStart: r.start,
 	}
 }
@@ -284,6 +289,7 @@ func (r *reader) readInfo(size int) error {
 	}
 	r.start = xtime.FromNanoseconds(info.BlockStart)
 	r.blockSize = time.Duration(info.BlockSize)
+	r.downsampleResolution = time.Duration(info.DownsampleResolution)
 	r.entries = int(info.Entries)
 	r.entriesRead = 0
 	r.metadataRead = 0
@@ -434,6 +440,10 @@ func (r *reader) Range() xtime.Range {
 	return xtime.Range{Start: r.start, End: r.start.Add(r.blockSize)}
 }
 
+func (r *reader) DownsampleResolution() time.Duration {
+	return r.downsampleResolution
+}
+
 func (r *reader) Entries() int {
 	return r.entries
 }
@@ -66,6 +66,7 @@ type seeker struct {
 	// instead of time.Time to avoid keeping an extra pointer around.
 	start     xtime.UnixNano
 	blockSize time.Duration
+	volume    int
 
 	dataFd        *os.File
 	indexFd       *os.File
@@ -147,6 +148,7 @@ func (s *seeker) Open(
 	namespace ident.ID,
 	shard uint32,
 	blockStart time.Time,
+	volume int,
 	resources ReusableSeekerResources,
 ) error {
 	if s.isClone {
@@ -154,16 +156,17 @@ func (s *seeker) Open(
 	}
 
 	s.shardDir = ShardDataDirPath(s.opts.filePathPrefix, namespace, shard)
+	s.volume = volume
 	var infoFd, digestFd, bloomFilterFd, summariesFd *os.File
 
 	// Open necessary files
 	if err := openFiles(os.Open, map[string]**os.File{
-		filesetPathFromTime(s.shardDir, blockStart, infoFileSuffix):        &infoFd,
-		filesetPathFromTime(s.shardDir, blockStart, indexFileSuffix):       &s.indexFd,
-		filesetPathFromTime(s.shardDir, blockStart, dataFileSuffix):        &s.dataFd,
-		filesetPathFromTime(s.shardDir, blockStart, digestFileSuffix):      &digestFd,
-		filesetPathFromTime(s.shardDir, blockStart, bloomFilterFileSuffix): &bloomFilterFd,
-		filesetPathFromTime(s.shardDir, blockStart, summariesFileSuffix):   &summariesFd,
+		dataFilesetPathFromTimeAndIndex(s.shardDir, blockStart, volume, infoFileSuffix):        &infoFd,
+		dataFilesetPathFromTimeAndIndex(s.shardDir, blockStart, volume, indexFileSuffix):       &s.indexFd,
+		dataFilesetPathFromTimeAndIndex(s.shardDir, blockStart, volume, dataFileSuffix):        &s.dataFd,
+		dataFilesetPathFromTimeAndIndex(s.shardDir, blockStart, volume, digestFileSuffix):      &digestFd,
+		dataFilesetPathFromTimeAndIndex(s.shardDir, blockStart, volume, bloomFilterFileSuffix): &bloomFilterFd,
+		dataFilesetPathFromTimeAndIndex(s.shardDir, blockStart, volume, summariesFileSuffix):   &summariesFd,
 	}); err != nil {
 		return err
 	}
@@ -221,7 +224,7 @@ func (s *seeker) Open(
 		s.Close()
 		return fmt.Errorf(
 			"index file digest for file: %s does not match the expected digest: %c",
-			filesetPathFromTime(s.shardDir, blockStart, indexFileSuffix), err,
+			dataFilesetPathFromTimeAndIndex(s.shardDir, blockStart, volume, indexFileSuffix), err,
 		)
 	}
 
@@ -496,6 +499,7 @@ func (s *seeker) ConcurrentClone() (ConcurrentDataFileSetSeeker, error) {
 
 	seeker := &seeker{
 		opts:          s.opts,
+		volume:        s.volume,
 		indexFileSize: s.indexFileSize,
 		// BloomFilter is concurrency safe.
 		bloomFilter: s.bloomFilter,
@@ -506,8 +510,8 @@ func (s *seeker) ConcurrentClone() (ConcurrentDataFileSetSeeker, error) {
 	// File descriptors are not concurrency safe since they have an internal
 	// seek position.
 	if err := openFiles(os.Open, map[string]**os.File{
-		filesetPathFromTime(s.shardDir, s.start.ToTime(), indexFileSuffix): &seeker.indexFd,
-		filesetPathFromTime(s.shardDir, s.start.ToTime(), dataFileSuffix):  &seeker.dataFd,
+		dataFilesetPathFromTimeAndIndex(s.shardDir, s.start.ToTime(), s.volume, indexFileSuffix): &seeker.indexFd,
+		dataFilesetPathFromTimeAndIndex(s.shardDir, s.start.ToTime(), s.volume, dataFileSuffix):  &seeker.dataFd,
 	}); err != nil {
 		return nil, err
 	}
@@ -55,8 +55,20 @@ type openAnyUnopenSeekersFn func(*seekersByTime) error
 type newOpenSeekerFn func(
 	shard uint32,
 	blockStart time.Time,
+	volume int,
 ) (DataFileSetSeeker, error)
 
+type latestVolumeFn func(
+	shard uint32,
+	blockStart time.Time,
+) (int, error)
+
+type volumeExistsFn func(
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+) (bool, error)
+
 type seekerManagerStatus int
 
 const (
@@ -82,6 +94,8 @@ type seekerManager struct {
 	unreadBuf              seekerUnreadBuf
 	openAnyUnopenSeekersFn openAnyUnopenSeekersFn
 	newOpenSeekerFn        newOpenSeekerFn
+	latestVolumeFn         latestVolumeFn
+	volumeExistsFn         volumeExistsFn
 	sleepFn                func(d time.Duration)
 	openCloseLoopDoneCh    chan struct{}
 	// Pool of seeker resources that can be used to open new seekers.
@@ -95,11 +109,12 @@ type seekerUnreadBuf struct {
 
 // seekersAndBloom contains a slice of seekers for a given shard/blockStart. One of the seeker will be the original,
 // and the others will be clones. The bloomFilter field is a reference to the underlying bloom filter that the
-// original seeker and all of its clones share.
+// original seeker and all of its clones share. The volume field is the fileset volume the seekers were opened on.
 type seekersAndBloom struct {
 	wg          *sync.WaitGroup
 	seekers     []borrowableSeeker
 	bloomFilter *ManagedConcurrentBloomFilter
+	volume      int
 }
 
 // borrowableSeeker is just a seeker with an additional field for keeping track of whether or not it has been borrowed.
@@ -118,6 +133,11 @@ type seekersByTime struct {
 type seekerManagerPendingClose struct {
 	shard      uint32
 	blockStart time.Time
+	// superseded is set when the seekers are being closed because a newer
+	// volume of the fileset exists, in which case volume is the volume of
+	// the seekers to close.
+	superseded bool
+	volume     int
 }
 
 // NewSeekerManager returns a new TSDB file set seeker manager.
@@ -146,6 +166,8 @@ func NewSeekerManager(
 	}
 	m.openAnyUnopenSeekersFn = m.openAnyUnopenSeekers
 	m.newOpenSeekerFn = m.newOpenSeeker
+	m.latestVolumeFn = m.latestVolume
+	m.volumeExistsFn = m.volumeExists
 	m.sleepFn = time.Sleep
 	return m
 }
@@ -313,7 +335,11 @@ func (m *seekerManager) getOrOpenSeekersWithLock(start xtime.UnixNano, byTime *s
 	byTime.Unlock()
 	// Open first one - Do this outside the context of the lock because opening
 	// a seeker can be an expensive operation (validating index files)
-	seeker, err := m.newOpenSeekerFn(byTime.shard, start.ToTime())
+	volume, err := m.latestVolumeFn(byTime.shard, start.ToTime())
+	var seeker DataFileSetSeeker
+	if err == nil {
+		seeker, err = m.newOpenSeekerFn(byTime.shard, start.ToTime(), volume)
+	}
 	// Immediately re-lock once the seeker is open regardless of errors because
 	// thats the contract of this function
 	byTime.Lock()
@@ -347,6 +373,7 @@ func (m *seekerManager) getOrOpenSeekersWithLock(start xtime.UnixNano, byTime *s
 
 	seekers.wg = nil
 	seekers.seekers = borrowableSeekers
+	seekers.volume = volume
 	// Doesn't matter which seeker we pick to grab the bloom filter from, they all share the same underlying one.
 	// Use index 0 because its guaranteed to be there.
 	seekers.bloomFilter = borrowableSeekers[0].seeker.ConcurrentIDBloomFilter()
@@ -372,18 +399,33 @@ func (m *seekerManager) openAnyUnopenSeekers(byTime *seekersByTime) error {
 	return multiErr.FinalError()
 }
 
-func (m *seekerManager) newOpenSeeker(
+func (m *seekerManager) latestVolume(
 	shard uint32,
 	blockStart time.Time,
-) (DataFileSetSeeker, error) {
-	exists, err := DataFileSetExistsAt(m.filePathPrefix, m.namespace, shard, blockStart)
+) (int, error) {
+	fileset, exists, err := FileSetAt(m.filePathPrefix, m.namespace, shard, blockStart)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 	if !exists {
-		return nil, errSeekerManagerFileSetNotFound
+		return 0, errSeekerManagerFileSetNotFound
 	}
+	return fileset.ID.VolumeIndex, nil
+}
+
+func (m *seekerManager) volumeExists(
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+) (bool, error) {
+	return DataFileSetVolumeExistsAt(m.filePathPrefix, m.namespace, shard, blockStart, volume)
+}
 
+func (m *seekerManager) newOpenSeeker(
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+) (DataFileSetSeeker, error) {
 	// NB(r): Use a lock on the unread buffer to avoid multiple
 	// goroutines reusing the unread buffer that we share between the seekers
 	// when we open each seeker.
@@ -404,7 +446,7 @@ func (m *seekerManager) newOpenSeeker(
 	seeker.setUnreadBuffer(m.unreadBuf.value)
 
 	resources := m.getSeekerResources()
-	err = seeker.Open(m.namespace, shard, blockStart, resources)
+	err := seeker.Open(m.namespace, shard, blockStart, volume, resources)
 	m.putSeekerResources(resources)
 	if err != nil {
 		return nil, err
@@ -507,6 +549,7 @@ func (m *seekerManager) openCloseLoop() {
 	var (
 		shouldTryOpen []*seekersByTime
 		shouldClose   []seekerManagerPendingClose
+		maybeOutdated []seekerManagerPendingClose
 		closing       []borrowableSeeker
 	)
 	resetSlices := func() {
@@ -518,12 +561,21 @@ func (m *seekerManager) openCloseLoop() {
 			shouldClose[i] = seekerManagerPendingClose{}
 		}
 		shouldClose = shouldClose[:0]
+		for i := range maybeOutdated {
+			maybeOutdated[i] = seekerManagerPendingClose{}
+		}
+		maybeOutdated = maybeOutdated[:0]
 		for i := range closing {
 			closing[i] = borrowableSeeker{}
 		}
 		closing = closing[:0]
 	}
 
+	// Only namespaces that rewrite flushed blocks produce newer volumes.
+	nsOpts := m.namespaceMetadata.Options()
+	checkVolumes := nsOpts.ColdWritesEnabled() ||
+		len(nsOpts.DownsampleOptions().Tiers()) > 0
+
 	for {
 		earliestSeekableBlockStart :=
 			m.earliestSeekableBlockStart()
@@ -553,24 +605,60 @@ func (m *seekerManager) openCloseLoop() {
 		m.RLock()
 		for shard, byTime := range m.seekersByShardIdx {
 			byTime.RLock()
-			for blockStartNano := range byTime.seekers {
+			for blockStartNano, seekers := range byTime.seekers {
 				blockStart := blockStartNano.ToTime()
 				if blockStart.Before(earliestSeekableBlockStart) {
 					shouldClose = append(shouldClose, seekerManagerPendingClose{
 						shard:      uint32(shard),
 						blockStart: blockStart,
 					})
+					continue
+				}
+				if checkVolumes && seekers.wg == nil {
+					maybeOutdated = append(maybeOutdated, seekerManagerPendingClose{
+						shard:      uint32(shard),
+						blockStart: blockStart,
+						superseded: true,
+						volume:     seekers.volume,
+					})
 				}
 			}
 			byTime.RUnlock()
 		}
+		m.RUnlock()
+
+		// Check for newer volumes (written when a flushed block is rewritten)
+		// outside of the lock, seekers for superseded volumes are closed so
+		// that they are reopened against the latest volume.
+		for _, elem := range maybeOutdated {
+			exists, err := m.volumeExistsFn(elem.shard, elem.blockStart, elem.volume+1)
+			if err != nil {
+				m.logger.Error("err checking for newer volume in SeekerManager openCloseLoop", zap.Error(err))
+				continue
+			}
+			if exists {
+				shouldClose = append(shouldClose, elem)
+			}
+		}
+
+		m.RLock()
+		if m.status != seekerManagerOpen {
+			m.RUnlock()
+			break
+		}
 
 		if len(shouldClose) > 0 {
 			for _, elem := range shouldClose {
 				byTime := m.seekersByShardIdx[elem.shard]
 				blockStartNano := xtime.ToUnixNano(elem.blockStart)
 				byTime.Lock()
-				seekersAndBloom := byTime.seekers[blockStartNano]
+				seekersAndBloom, ok := byTime.seekers[blockStartNano]
+				if !ok || (elem.superseded &&
+					(seekersAndBloom.wg != nil || seekersAndBloom.volume != elem.volume)) {
+					// Already closed or reopened since the check.
+					byTime.Unlock()
+					continue
+				}
 				allSeekersAreReturned := true
 				for _, seeker := range seekersAndBloom.seekers {
 					if seeker.isBorrowed {
@@ -25,6 +25,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/retention"
 	xtime "github.com/m3db/m3/src/x/time"
 
 	"github.com/fortytw2/leaktest"
@@ -76,12 +78,19 @@ func TestSeekerManagerBorrowOpenSeekersLazy(t *testing.T) {
 
 	shards := []uint32{2, 5, 9, 478, 1023}
 	m := NewSeekerManager(nil, testDefaultOpts, defaultFetchConcurrency).(*seekerManager)
+	m.latestVolumeFn = func(
+		shard uint32,
+		blockStart time.Time,
+	) (int, error) {
+		return 0, nil
+	}
 	m.newOpenSeekerFn = func(
 		shard uint32,
 		blockStart time.Time,
+		volume int,
 	) (DataFileSetSeeker, error) {
 		mock := NewMockDataFileSetSeeker(ctrl)
-		mock.EXPECT().Open(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mock.EXPECT().Open(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 		mock.EXPECT().ConcurrentClone().Return(mock, nil)
 		for i := 0; i < defaultFetchConcurrency; i++ {
 			mock.EXPECT().Close().Return(nil)
@@ -275,3 +284,111 @@ func TestSeekerManagerOpenCloseLoop(t *testing.T) {
 	// to prevent the test itself from interfering with the goroutine leak test
 	close(cleanupCh)
 }
+
+// TestSeekerManagerOpenCloseLoopReopensNewerVolume tests that the openCloseLoop
+// closes seekers once a newer volume of their fileset has been written so that
+// they are reopened against the latest volume.
+func TestSeekerManagerOpenCloseLoopReopensNewerVolume(t *testing.T) {
+	defer leaktest.CheckTimeout(t, 1*time.Minute)()
+
+	ctrl := gomock.NewController(t)
+
+	var (
+		shard      = uint32(3)
+		latest     = 0
+		latestLock sync.Mutex
+	)
+	m := NewSeekerManager(nil, testDefaultOpts, defaultFetchConcurrency).(*seekerManager)
+	m.latestVolumeFn = func(
+		shard uint32,
+		blockStart time.Time,
+	) (int, error) {
+		latestLock.Lock()
+		defer latestLock.Unlock()
+		return latest, nil
+	}
+	m.volumeExistsFn = func(
+		shard uint32,
+		blockStart time.Time,
+		volume int,
+	) (bool, error) {
+		latestLock.Lock()
+		defer latestLock.Unlock()
+		return volume <= latest, nil
+	}
+	m.newOpenSeekerFn = func(
+		shard uint32,
+		blockStart time.Time,
+		volume int,
+	) (DataFileSetSeeker, error) {
+		mock := NewMockDataFileSetSeeker(ctrl)
+		mock.EXPECT().ConcurrentClone().Return(mock, nil).AnyTimes()
+		mock.EXPECT().ConcurrentIDBloomFilter().Return(nil).AnyTimes()
+		mock.EXPECT().Close().Return(nil).Times(defaultFetchConcurrency)
+		return mock, nil
+	}
+	m.openAnyUnopenSeekersFn = func(byTime *seekersByTime) error {
+		return nil
+	}
+
+	tickCh := make(chan struct{})
+	cleanupCh := make(chan struct{})
+	m.sleepFn = func(_ time.Duration) {
+		tickCh <- struct{}{}
+	}
+
+	md, err := namespace.NewMetadata(testNs1ID, namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().SetBlockSize(testBlockSize)).
+		SetDownsampleOptions(namespace.NewDownsampleOptions().SetTiers([]namespace.DownsampleTier{
+			{Resolution: time.Minute, After: 24 * time.Hour, Aggregation: namespace.DownsampleMean},
+		})))
+	require.NoError(t, err)
+	require.NoError(t, m.Open(md))
+
+	now := m.opts.ClockOptions().NowFn()().Truncate(testBlockSize)
+	seekersVolume := func() (int, bool) {
+		byTime := m.seekersByTime(shard)
+		byTime.RLock()
+		defer byTime.RUnlock()
+		seekers, ok := byTime.seekers[xtime.ToUnixNano(now)]
+		return seekers.volume, ok
+	}
+
+	seeker, err := m.Borrow(shard, now)
+	require.NoError(t, err)
+	require.NoError(t, m.Return(shard, now, seeker))
+	volume, ok := seekersVolume()
+	require.True(t, ok)
+	require.Equal(t, 0, volume)
+
+	latestLock.Lock()
+	latest = 1
+	latestLock.Unlock()
+
+	// Wait for two ticks to guarantee an entire openCloseLoop has executed.
+	<-tickCh
+	<-tickCh
+	_, ok = seekersVolume()
+	require.False(t, ok)
+
+	seeker, err = m.Borrow(shard, now)
+	require.NoError(t, err)
+	require.NoError(t, m.Return(shard, now, seeker))
+	volume, ok = seekersVolume()
+	require.True(t, ok)
+	require.Equal(t, 1, volume)
+
+	go func() {
+		for {
+			select {
+			case <-tickCh:
+				continue
+			case <-cleanupCh:
+				return
+			}
+		}
+	}()
+
+	require.NoError(t, m.Close())
+	close(cleanupCh)
+}
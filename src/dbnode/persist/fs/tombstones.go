@@ -0,0 +1,597 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+const (
+	tombstonesFileVersion = 3
+	tombstonesHeaderLen   = 8
+	tombstonesDigestLen   = 4
+	tombstonesRangeLen    = 28
+	tombstonesWrittenLen  = 8
+
+	tombstonesLogEntryHeaderLen = 8
+	tombstonesLogEntryLen       = 37
+)
+
+var (
+	errTombstonesFileTooShort      = errors.New("tombstones file too short")
+	errTombstonesFileTruncated     = errors.New("tombstones file truncated")
+	errTombstonesDigestMismatch    = errors.New("tombstones file digest mismatch")
+	errTombstonesVersionMismatch   = errors.New("tombstones file version mismatch")
+	errTombstonesLogEntryTruncated = errors.New("tombstones log entry truncated")
+	errTombstonesLogEntryMismatch  = errors.New("tombstones log entry digest mismatch")
+)
+
+// SeriesTombstone is the set of deleted time ranges for a single series.
+type SeriesTombstone struct {
+	ID     []byte
+	Ranges []DeletedRange
+}
+
+// DeletedRange is a deleted time range [Start, End) of a series along with
+// the time it was deleted at and the timestamps within the range that the
+// series was written to after it was deleted, which are not deleted.
+type DeletedRange struct {
+	Start     time.Time
+	End       time.Time
+	DeletedAt time.Time
+	// Written is sorted in ascending order.
+	Written []time.Time
+}
+
+// Contains returns whether the timestamp was deleted by the range.
+func (r DeletedRange) Contains(timestamp time.Time) bool {
+	if timestamp.Before(r.Start) || !timestamp.Before(r.End) {
+		return false
+	}
+	return !r.written(timestamp)
+}
+
+func (r DeletedRange) written(timestamp time.Time) bool {
+	i := sort.Search(len(r.Written), func(i int) bool {
+		return !r.Written[i].Before(timestamp)
+	})
+	return i < len(r.Written) && r.Written[i].Equal(timestamp)
+}
+
+func (r DeletedRange) equalRange(other DeletedRange) bool {
+	return r.Start.Equal(other.Start) && r.End.Equal(other.End) &&
+		r.DeletedAt.Equal(other.DeletedAt)
+}
+
+// DeletedRanges is a set of deleted time ranges.
+type DeletedRanges []DeletedRange
+
+// Contains returns whether the timestamp was deleted by any of the ranges.
+func (r DeletedRanges) Contains(timestamp time.Time) bool {
+	for _, dr := range r {
+		if dr.Contains(timestamp) {
+			return true
+		}
+	}
+	return false
+}
+
+// TombstoneLogEntryType is the type of a change appended to the tombstones
+// log.
+type TombstoneLogEntryType uint8
+
+const (
+	// TombstoneAdded adds the deleted range of the entry to the series.
+	TombstoneAdded TombstoneLogEntryType = iota + 1
+	// TombstoneRemoved removes the deleted range of the entry from the series.
+	TombstoneRemoved
+	// TombstoneWritten records that the series was written to at the
+	// timestamp of the entry after the deleted range of the entry.
+	TombstoneWritten
+)
+
+// TombstoneLogEntry is a change to the tombstones of a series. The written
+// timestamps of the range of an entry are ignored, the range only identifies
+// the range added, removed or written to.
+type TombstoneLogEntry struct {
+	Type      TombstoneLogEntryType
+	ID        []byte
+	Range     DeletedRange
+	Timestamp time.Time
+}
+
+// TombstonesLog appends changes to the series tombstones of a namespace to a
+// log so that a change does not rewrite every tombstone of the namespace.
+// The log is replayed on top of the tombstones file when the tombstones are
+// read and is compacted into the tombstones file by Compact. The log file is
+// only created once an entry is appended. A TombstonesLog is not safe for
+// concurrent use.
+type TombstonesLog struct {
+	opts      Options
+	namespace ident.ID
+	fd        *os.File
+	size      int64
+}
+
+// NewTombstonesLog returns the tombstones log of a namespace.
+func NewTombstonesLog(opts Options, namespace ident.ID) (*TombstonesLog, error) {
+	var (
+		filePath = NamespaceTombstonesLogFilePath(opts.FilePathPrefix(), namespace)
+		size     int64
+	)
+	info, err := os.Stat(filePath)
+	if err == nil {
+		size = info.Size()
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	return &TombstonesLog{opts: opts, namespace: namespace, size: size}, nil
+}
+
+// Size returns the size of the log in bytes.
+func (l *TombstonesLog) Size() int64 {
+	return l.size
+}
+
+// Append appends the entries to the log, syncing the log before returning
+// if sync is set.
+func (l *TombstonesLog) Append(entries []TombstoneLogEntry, sync bool) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	if l.fd == nil {
+		if err := l.open(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := l.fd.Write(encodeTombstoneLogEntries(entries)); err != nil {
+		// Drop any partially written entries so that the entries appended
+		// after them can be read back.
+		l.closeAfterError()
+		return err
+	}
+	if sync {
+		if err := l.fd.Sync(); err != nil {
+			l.closeAfterError()
+			return err
+		}
+	}
+
+	info, err := l.fd.Stat()
+	if err != nil {
+		l.closeAfterError()
+		return err
+	}
+	l.size = info.Size()
+	return nil
+}
+
+func (l *TombstonesLog) open() error {
+	dir := TombstonesDirPath(l.opts.FilePathPrefix())
+	if err := os.MkdirAll(dir, l.opts.NewDirectoryMode()); err != nil {
+		return err
+	}
+	filePath := NamespaceTombstonesLogFilePath(l.opts.FilePathPrefix(), l.namespace)
+	fd, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND,
+		l.opts.NewFileMode())
+	if err != nil {
+		return err
+	}
+	// Drop any partially written entries left by a previous failure.
+	if err := fd.Truncate(l.size); err != nil {
+		fd.Close()
+		return err
+	}
+	l.fd = fd
+	return nil
+}
+
+func (l *TombstonesLog) closeAfterError() {
+	l.fd.Close()
+	l.fd = nil
+}
+
+// Compact atomically replaces the tombstones file of the namespace with the
+// given tombstones, which must include every change appended to the log,
+// and then removes the log.
+func (l *TombstonesLog) Compact(tombstones []SeriesTombstone) error {
+	if err := WriteTombstones(l.opts, l.namespace, tombstones); err != nil {
+		return err
+	}
+	if err := l.Close(); err != nil {
+		return err
+	}
+
+	// NB: The log is replayed on top of the compacted tombstones if it is not
+	// removed, which is safe since replaying every entry is idempotent.
+	filePath := NamespaceTombstonesLogFilePath(l.opts.FilePathPrefix(), l.namespace)
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	l.size = 0
+	return nil
+}
+
+// Close closes the log.
+func (l *TombstonesLog) Close() error {
+	if l.fd == nil {
+		return nil
+	}
+	err := l.fd.Close()
+	l.fd = nil
+	return err
+}
+
+// WriteTombstones atomically replaces the series tombstones file for a
+// namespace with the given tombstones.
+func WriteTombstones(
+	opts Options,
+	namespace ident.ID,
+	tombstones []SeriesTombstone,
+) error {
+	dir := TombstonesDirPath(opts.FilePathPrefix())
+	if err := os.MkdirAll(dir, opts.NewDirectoryMode()); err != nil {
+		return err
+	}
+
+	data := encodeTombstones(tombstones)
+	filePath := NamespaceTombstonesFilePath(opts.FilePathPrefix(), namespace)
+	tmpFilePath := filePath + ".tmp"
+	if err := writeAndSync(tmpFilePath, opts.NewFileMode(), data); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFilePath, filePath); err != nil {
+		return err
+	}
+
+	// Sync the parent directory so the rename is durable.
+	dirFile, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err := dirFile.Sync(); err != nil {
+		dirFile.Close()
+		return err
+	}
+	return dirFile.Close()
+}
+
+// ReadTombstones reads the series tombstones file for a namespace and
+// replays the tombstones log on top of it, returning no tombstones if
+// neither exist.
+func ReadTombstones(
+	filePathPrefix string,
+	namespace ident.ID,
+) ([]SeriesTombstone, error) {
+	filePath := NamespaceTombstonesFilePath(filePathPrefix, namespace)
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var tombstones []SeriesTombstone
+	if err == nil {
+		tombstones, err = decodeTombstones(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode tombstones file %s: %v",
+				filepath.Base(filePath), err)
+		}
+	}
+
+	logFilePath := NamespaceTombstonesLogFilePath(filePathPrefix, namespace)
+	logData, err := ioutil.ReadFile(logFilePath)
+	if os.IsNotExist(err) {
+		return tombstones, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// NB: Entries that fail to decode were only partially appended and were
+	// never acknowledged, so replaying stops at the first of them.
+	entries, _ := decodeTombstoneLogEntries(logData)
+	return replayTombstoneLogEntries(tombstones, entries), nil
+}
+
+func writeAndSync(filePath string, perm os.FileMode, data []byte) error {
+	fd, err := OpenWritable(filePath, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(data); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
+// replayTombstoneLogEntries applies the log entries to the tombstones in
+// order, applying an entry that has already been applied has no effect.
+func replayTombstoneLogEntries(
+	tombstones []SeriesTombstone,
+	entries []TombstoneLogEntry,
+) []SeriesTombstone {
+	byID := make(map[string]int, len(tombstones))
+	for i, t := range tombstones {
+		byID[string(t.ID)] = i
+	}
+
+	for _, entry := range entries {
+		idx, ok := byID[string(entry.ID)]
+		if !ok {
+			if entry.Type != TombstoneAdded {
+				continue
+			}
+			idx = len(tombstones)
+			byID[string(entry.ID)] = idx
+			tombstones = append(tombstones, SeriesTombstone{ID: entry.ID})
+		}
+
+		series := &tombstones[idx]
+		switch entry.Type {
+		case TombstoneAdded:
+			if indexOfRange(series.Ranges, entry.Range) < 0 {
+				added := entry.Range
+				added.Written = nil
+				series.Ranges = append(series.Ranges, added)
+			}
+		case TombstoneRemoved:
+			if i := indexOfRange(series.Ranges, entry.Range); i >= 0 {
+				series.Ranges = append(series.Ranges[:i], series.Ranges[i+1:]...)
+			}
+		case TombstoneWritten:
+			if i := indexOfRange(series.Ranges, entry.Range); i >= 0 {
+				r := &series.Ranges[i]
+				if !r.written(entry.Timestamp) {
+					r.Written = insertWritten(r.Written, entry.Timestamp)
+				}
+			}
+		}
+	}
+
+	// Drop the series left without any tombstones.
+	remaining := tombstones[:0]
+	for _, t := range tombstones {
+		if len(t.Ranges) > 0 {
+			remaining = append(remaining, t)
+		}
+	}
+	return remaining
+}
+
+func indexOfRange(ranges []DeletedRange, r DeletedRange) int {
+	for i := range ranges {
+		if ranges[i].equalRange(r) {
+			return i
+		}
+	}
+	return -1
+}
+
+func insertWritten(written []time.Time, timestamp time.Time) []time.Time {
+	i := sort.Search(len(written), func(i int) bool {
+		return !written[i].Before(timestamp)
+	})
+	written = append(written, time.Time{})
+	copy(written[i+1:], written[i:])
+	written[i] = timestamp
+	return written
+}
+
+// encodeTombstones encodes tombstones as a header of version and number of
+// series, followed by each series ID and deleted ranges along with their
+// written timestamps, followed by a digest of everything preceding it.
+func encodeTombstones(tombstones []SeriesTombstone) []byte {
+	size := tombstonesHeaderLen + tombstonesDigestLen
+	for _, t := range tombstones {
+		size += 8 + len(t.ID) + tombstonesRangeLen*len(t.Ranges)
+		for _, r := range t.Ranges {
+			size += tombstonesWrittenLen * len(r.Written)
+		}
+	}
+
+	var (
+		buf = make([]byte, size)
+		be  = binary.BigEndian
+		idx = 0
+	)
+	be.PutUint32(buf[idx:], tombstonesFileVersion)
+	idx += 4
+	be.PutUint32(buf[idx:], uint32(len(tombstones)))
+	idx += 4
+	for _, t := range tombstones {
+		be.PutUint32(buf[idx:], uint32(len(t.ID)))
+		idx += 4
+		idx += copy(buf[idx:], t.ID)
+		be.PutUint32(buf[idx:], uint32(len(t.Ranges)))
+		idx += 4
+		for _, r := range t.Ranges {
+			be.PutUint64(buf[idx:], uint64(r.Start.UnixNano()))
+			be.PutUint64(buf[idx+8:], uint64(r.End.UnixNano()))
+			be.PutUint64(buf[idx+16:], uint64(r.DeletedAt.UnixNano()))
+			be.PutUint32(buf[idx+24:], uint32(len(r.Written)))
+			idx += tombstonesRangeLen
+			for _, written := range r.Written {
+				be.PutUint64(buf[idx:], uint64(written.UnixNano()))
+				idx += tombstonesWrittenLen
+			}
+		}
+	}
+	be.PutUint32(buf[idx:], digest.Checksum(buf[:idx]))
+	return buf
+}
+
+func decodeTombstones(data []byte) ([]SeriesTombstone, error) {
+	if len(data) < tombstonesHeaderLen+tombstonesDigestLen {
+		return nil, errTombstonesFileTooShort
+	}
+
+	var (
+		be      = binary.BigEndian
+		bodyLen = len(data) - tombstonesDigestLen
+	)
+	if digest.Checksum(data[:bodyLen]) != be.Uint32(data[bodyLen:]) {
+		return nil, errTombstonesDigestMismatch
+	}
+
+	buf := data[:bodyLen]
+	if be.Uint32(buf) != tombstonesFileVersion {
+		return nil, errTombstonesVersionMismatch
+	}
+	numSeries := int(be.Uint32(buf[4:]))
+	buf = buf[tombstonesHeaderLen:]
+
+	tombstones := make([]SeriesTombstone, 0, numSeries)
+	for i := 0; i < numSeries; i++ {
+		if len(buf) < 4 {
+			return nil, errTombstonesFileTruncated
+		}
+		idLen := int(be.Uint32(buf))
+		buf = buf[4:]
+		if len(buf) < idLen+4 {
+			return nil, errTombstonesFileTruncated
+		}
+		id := append([]byte(nil), buf[:idLen]...)
+		buf = buf[idLen:]
+		numRanges := int(be.Uint32(buf))
+		buf = buf[4:]
+		ranges := make([]DeletedRange, 0, numRanges)
+		for j := 0; j < numRanges; j++ {
+			if len(buf) < tombstonesRangeLen {
+				return nil, errTombstonesFileTruncated
+			}
+			r := DeletedRange{
+				Start:     time.Unix(0, int64(be.Uint64(buf))),
+				End:       time.Unix(0, int64(be.Uint64(buf[8:]))),
+				DeletedAt: time.Unix(0, int64(be.Uint64(buf[16:]))),
+			}
+			numWritten := int(be.Uint32(buf[24:]))
+			buf = buf[tombstonesRangeLen:]
+			if len(buf) < tombstonesWrittenLen*numWritten {
+				return nil, errTombstonesFileTruncated
+			}
+			if numWritten > 0 {
+				r.Written = make([]time.Time, 0, numWritten)
+			}
+			for k := 0; k < numWritten; k++ {
+				r.Written = append(r.Written, time.Unix(0, int64(be.Uint64(buf))))
+				buf = buf[tombstonesWrittenLen:]
+			}
+			ranges = append(ranges, r)
+		}
+		tombstones = append(tombstones, SeriesTombstone{
+			ID:     id,
+			Ranges: ranges,
+		})
+	}
+	return tombstones, nil
+}
+
+// encodeTombstoneLogEntries encodes each entry as a header of the length and
+// digest of the entry followed by the type, series ID, deleted range and
+// timestamp of the entry.
+func encodeTombstoneLogEntries(entries []TombstoneLogEntry) []byte {
+	size := 0
+	for _, e := range entries {
+		size += tombstonesLogEntryHeaderLen + tombstonesLogEntryLen + len(e.ID)
+	}
+
+	var (
+		buf = make([]byte, size)
+		be  = binary.BigEndian
+		idx = 0
+	)
+	for _, e := range entries {
+		body := buf[idx+tombstonesLogEntryHeaderLen:]
+		body[0] = byte(e.Type)
+		be.PutUint32(body[1:], uint32(len(e.ID)))
+		n := 5 + copy(body[5:], e.ID)
+		be.PutUint64(body[n:], uint64(e.Range.Start.UnixNano()))
+		be.PutUint64(body[n+8:], uint64(e.Range.End.UnixNano()))
+		be.PutUint64(body[n+16:], uint64(e.Range.DeletedAt.UnixNano()))
+		be.PutUint64(body[n+24:], uint64(e.Timestamp.UnixNano()))
+		n += 32
+
+		be.PutUint32(buf[idx:], uint32(n))
+		be.PutUint32(buf[idx+4:], digest.Checksum(body[:n]))
+		idx += tombstonesLogEntryHeaderLen + n
+	}
+	return buf
+}
+
+// decodeTombstoneLogEntries decodes the entries of the log, returning the
+// entries decoded before the first that fails to decode along with the
+// error it failed with.
+func decodeTombstoneLogEntries(data []byte) ([]TombstoneLogEntry, error) {
+	var (
+		be      = binary.BigEndian
+		entries []TombstoneLogEntry
+	)
+	for len(data) > 0 {
+		if len(data) < tombstonesLogEntryHeaderLen {
+			return entries, errTombstonesLogEntryTruncated
+		}
+		n := int(be.Uint32(data))
+		if n < tombstonesLogEntryLen ||
+			len(data) < tombstonesLogEntryHeaderLen+n {
+			return entries, errTombstonesLogEntryTruncated
+		}
+		body := data[tombstonesLogEntryHeaderLen : tombstonesLogEntryHeaderLen+n]
+		if digest.Checksum(body) != be.Uint32(data[4:]) {
+			return entries, errTombstonesLogEntryMismatch
+		}
+		idLen := int(be.Uint32(body[1:]))
+		if idLen != n-tombstonesLogEntryLen {
+			return entries, errTombstonesLogEntryTruncated
+		}
+		var (
+			id     = append([]byte(nil), body[5:5+idLen]...)
+			fields = body[5+idLen:]
+		)
+		entries = append(entries, TombstoneLogEntry{
+			Type: TombstoneLogEntryType(body[0]),
+			ID:   id,
+			Range: DeletedRange{
+				Start:     time.Unix(0, int64(be.Uint64(fields))),
+				End:       time.Unix(0, int64(be.Uint64(fields[8:]))),
+				DeletedAt: time.Unix(0, int64(be.Uint64(fields[16:]))),
+			},
+			Timestamp: time.Unix(0, int64(be.Uint64(fields[24:]))),
+		})
+		data = data[tombstonesLogEntryHeaderLen+n:]
+	}
+	return entries, nil
+}
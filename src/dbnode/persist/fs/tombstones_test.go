@@ -0,0 +1,245 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTombstonesWriteAndRead(t *testing.T) {
+	var (
+		dir       = createTempDir(t)
+		opts      = testDefaultOpts.SetFilePathPrefix(dir)
+		namespace = ident.StringID("testns")
+		now       = time.Now().Truncate(time.Hour)
+		expected  = []SeriesTombstone{
+			{
+				ID: []byte("foo"),
+				Ranges: []DeletedRange{
+					{
+						Start:     now,
+						End:       now.Add(time.Minute),
+						DeletedAt: now.Add(time.Hour),
+						Written:   []time.Time{now, now.Add(time.Second)},
+					},
+					{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour), DeletedAt: now.Add(time.Hour)},
+				},
+			},
+			{
+				ID: []byte("bar"),
+				Ranges: []DeletedRange{
+					{Start: now.Add(-time.Hour), End: now.Add(-time.Second), DeletedAt: now},
+				},
+			},
+		}
+	)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, WriteTombstones(opts, namespace, expected))
+
+	tombstones, err := ReadTombstones(dir, namespace)
+	require.NoError(t, err)
+	require.Equal(t, len(expected), len(tombstones))
+	for i := range expected {
+		require.Equal(t, expected[i].ID, tombstones[i].ID)
+		require.Equal(t, len(expected[i].Ranges), len(tombstones[i].Ranges))
+		for j, r := range expected[i].Ranges {
+			actual := tombstones[i].Ranges[j]
+			require.True(t, r.Start.Equal(actual.Start))
+			require.True(t, r.End.Equal(actual.End))
+			require.True(t, r.DeletedAt.Equal(actual.DeletedAt))
+			require.Equal(t, len(r.Written), len(actual.Written))
+			for k := range r.Written {
+				require.True(t, r.Written[k].Equal(actual.Written[k]))
+			}
+		}
+	}
+
+	// Overwriting replaces the existing tombstones.
+	require.NoError(t, WriteTombstones(opts, namespace, nil))
+	tombstones, err = ReadTombstones(dir, namespace)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tombstones))
+}
+
+func TestTombstonesReadMissingFile(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	tombstones, err := ReadTombstones(dir, ident.StringID("testns"))
+	require.NoError(t, err)
+	require.Nil(t, tombstones)
+}
+
+func TestTombstonesReadCorruptFile(t *testing.T) {
+	var (
+		dir       = createTempDir(t)
+		opts      = testDefaultOpts.SetFilePathPrefix(dir)
+		namespace = ident.StringID("testns")
+	)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, WriteTombstones(opts, namespace, []SeriesTombstone{
+		{
+			ID: []byte("foo"),
+			Ranges: []DeletedRange{
+				{Start: time.Unix(0, 0), End: time.Unix(60, 0), DeletedAt: time.Unix(120, 0)},
+			},
+		},
+	}))
+
+	filePath := NamespaceTombstonesFilePath(dir, namespace)
+	data, err := ioutil.ReadFile(filePath)
+	require.NoError(t, err)
+	data[tombstonesHeaderLen] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(filePath, data, opts.NewFileMode()))
+
+	_, err = ReadTombstones(dir, namespace)
+	require.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(filePath, data[:4], opts.NewFileMode()))
+	_, err = ReadTombstones(dir, namespace)
+	require.Error(t, err)
+}
+
+func TestDeletedRangesContains(t *testing.T) {
+	var (
+		now     = time.Unix(1565000000, 0)
+		deleted = DeletedRanges{
+			{
+				Start:     now,
+				End:       now.Add(time.Hour),
+				DeletedAt: now.Add(2 * time.Hour),
+				Written:   []time.Time{now.Add(time.Minute), now.Add(2 * time.Minute)},
+			},
+		}
+	)
+	require.True(t, deleted.Contains(now))
+	require.False(t, deleted.Contains(now.Add(-time.Second)))
+	require.False(t, deleted.Contains(now.Add(time.Hour)))
+
+	// Timestamps written to after the delete are not deleted.
+	require.False(t, deleted.Contains(now.Add(time.Minute)))
+	require.False(t, deleted.Contains(now.Add(2*time.Minute)))
+	require.True(t, deleted.Contains(now.Add(3*time.Minute)))
+
+	// Unless deleted again afterwards.
+	deleted = append(deleted, DeletedRange{
+		Start:     now,
+		End:       now.Add(time.Hour),
+		DeletedAt: now.Add(3 * time.Hour),
+	})
+	require.True(t, deleted.Contains(now.Add(time.Minute)))
+}
+
+func TestTombstonesLogReplay(t *testing.T) {
+	var (
+		dir       = createTempDir(t)
+		opts      = testDefaultOpts.SetFilePathPrefix(dir)
+		namespace = ident.StringID("testns")
+		now       = time.Unix(1565000000, 0)
+		first     = DeletedRange{Start: now, End: now.Add(time.Hour), DeletedAt: now}
+		second    = DeletedRange{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour), DeletedAt: now}
+		written   = now.Add(time.Minute)
+	)
+	defer os.RemoveAll(dir)
+
+	log, err := NewTombstonesLog(opts, namespace)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), log.Size())
+
+	require.NoError(t, log.Append([]TombstoneLogEntry{
+		{Type: TombstoneAdded, ID: []byte("foo"), Range: first},
+		{Type: TombstoneAdded, ID: []byte("foo"), Range: second},
+		{Type: TombstoneAdded, ID: []byte("bar"), Range: first},
+	}, true))
+	require.NoError(t, log.Append([]TombstoneLogEntry{
+		{Type: TombstoneWritten, ID: []byte("foo"), Range: first, Timestamp: written},
+		{Type: TombstoneRemoved, ID: []byte("bar"), Range: first},
+	}, false))
+	require.True(t, log.Size() > 0)
+
+	expected := []SeriesTombstone{
+		{
+			ID: []byte("foo"),
+			Ranges: []DeletedRange{
+				{Start: first.Start, End: first.End, DeletedAt: first.DeletedAt, Written: []time.Time{written}},
+				second,
+			},
+		},
+	}
+	requireTombstones := func(expected []SeriesTombstone) {
+		tombstones, err := ReadTombstones(dir, namespace)
+		require.NoError(t, err)
+		require.Equal(t, len(expected), len(tombstones))
+		for i := range expected {
+			require.Equal(t, expected[i].ID, tombstones[i].ID)
+			require.Equal(t, len(expected[i].Ranges), len(tombstones[i].Ranges))
+			for j, r := range expected[i].Ranges {
+				actual := tombstones[i].Ranges[j]
+				require.True(t, r.equalRange(actual))
+				require.Equal(t, len(r.Written), len(actual.Written))
+				for k := range r.Written {
+					require.True(t, r.Written[k].Equal(actual.Written[k]))
+				}
+			}
+		}
+	}
+	requireTombstones(expected)
+
+	// Partially appended entries are not replayed.
+	filePath := NamespaceTombstonesLogFilePath(dir, namespace)
+	fd, err := os.OpenFile(filePath, os.O_WRONLY|os.O_APPEND, opts.NewFileMode())
+	require.NoError(t, err)
+	_, err = fd.Write([]byte{0, 0, 0, 64, 1})
+	require.NoError(t, err)
+	require.NoError(t, fd.Close())
+	requireTombstones(expected)
+
+	// Replaying the log on top of tombstones it was compacted into has no
+	// effect, as when a compaction fails to remove the log.
+	require.NoError(t, WriteTombstones(opts, namespace, expected))
+	requireTombstones(expected)
+
+	require.NoError(t, log.Compact(expected))
+	require.Equal(t, int64(0), log.Size())
+	_, err = os.Stat(filePath)
+	require.True(t, os.IsNotExist(err))
+	requireTombstones(expected)
+
+	// The log is recreated once appended to again.
+	require.NoError(t, log.Append([]TombstoneLogEntry{
+		{Type: TombstoneRemoved, ID: []byte("foo"), Range: second},
+	}, true))
+	require.NoError(t, log.Close())
+	requireTombstones([]SeriesTombstone{{ID: []byte("foo"), Ranges: expected[0].Ranges[:1]}})
+
+	reopened, err := NewTombstonesLog(opts, namespace)
+	require.NoError(t, err)
+	require.Equal(t, log.Size(), reopened.Size())
+}
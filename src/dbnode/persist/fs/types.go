@@ -36,6 +36,7 @@ import (
 	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
 	"github.com/m3db/m3/src/x/serialize"
 	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/pool"
@@ -49,7 +50,7 @@ type FileSetFileIdentifier struct {
 	BlockStart         time.Time
 	// Only required for data content files
 	Shard uint32
-	// Required for snapshot files (index yes, data yes) and flush files (index yes, data no)
+	// Required for snapshot files (index yes, data yes) and flush files (index yes, data yes)
 	VolumeIndex int
 }
 
@@ -61,6 +62,9 @@ type DataWriterOpenOptions struct {
 	BlockSize          time.Duration
 	// Only used when writing snapshot files
 	Snapshot DataWriterSnapshotOptions
+	// DownsampleResolution is recorded in the info file when the data
+	// being written has been downsampled, zero for raw datapoints.
+	DownsampleResolution time.Duration
 }
 
 // DataWriterSnapshotOptions is the options struct for Open method on the DataFileSetWriter
@@ -104,8 +108,9 @@ type DataFileSetReaderStatus struct {
 	Namespace  ident.ID
 	BlockStart time.Time
 
-	Shard uint32
-	Open  bool
+	Shard  uint32
+	Volume int
+	Open   bool
 }
 
 // DataReaderOpenOptions is options struct for the reader open method.
@@ -152,6 +157,10 @@ type DataFileSetReader interface {
 	// Range returns the time range associated with data in the volume
 	Range() xtime.Range
 
+	// DownsampleResolution returns the resolution the data in the volume
+	// was downsampled to, zero if the volume holds raw datapoints
+	DownsampleResolution() time.Duration
+
 	// Entries returns the count of entries in the volume
 	Entries() int
 
@@ -166,11 +175,12 @@ type DataFileSetReader interface {
 type DataFileSetSeeker interface {
 	io.Closer
 
-	// Open opens the files for the given shard and version for reading
+	// Open opens the files for the given shard, block start and volume for reading
 	Open(
 		namespace ident.ID,
 		shard uint32,
 		start time.Time,
+		volume int,
 		resources ReusableSeekerResources,
 	) error
 
@@ -489,3 +499,47 @@ type BlockRetrieverOptions interface {
 	// IdentifierPool returns the identifierPool
 	IdentifierPool() ident.Pool
 }
+
+// Merger is in charge of merging filesets with some target MergeWith interface.
+type Merger interface {
+	// Merge merges the specified fileset file with a merge target and
+	// persists the result as the given volume of the fileset, dropping any
+	// data of the fileset within the deleted ranges of each series.
+	Merge(
+		fileID FileSetFileIdentifier,
+		mergeWith MergeWith,
+		deleted DeletedRangesFn,
+		nextVolumeIndex int,
+		flushPreparer persist.FlushPreparer,
+		nsMd namespace.Metadata,
+	) error
+}
+
+// DeletedRangesFn returns the deleted time ranges of a series within the
+// block being merged, it may be nil if no series have deleted ranges.
+type DeletedRangesFn func(seriesID ident.ID) DeletedRanges
+
+// ForEachRemainingFn is the function that is run on each of the remaining
+// series of the merge target that did not intersect with the fileset.
+type ForEachRemainingFn func(seriesID ident.ID, tags ident.Tags, data []xio.BlockReader) error
+
+// MergeWith is an interface that the fs merger uses to merge data with.
+type MergeWith interface {
+	// Read returns the data for the given block start and series ID, whether
+	// any data was found, and the error encountered (if any).
+	Read(
+		ctx context.Context,
+		seriesID ident.ID,
+		blockStart time.Time,
+		nsCtx namespace.Context,
+	) ([]xio.BlockReader, bool, error)
+
+	// ForEachRemaining loops through each series of the merge target for
+	// the block start that was not already returned by a call to Read.
+	ForEachRemaining(
+		ctx context.Context,
+		blockStart time.Time,
+		fn ForEachRemainingFn,
+		nsCtx namespace.Context,
+	) error
+}
@@ -40,6 +40,9 @@ type IndexInfo struct {
 	SnapshotTime int64
 	FileType     persist.FileSetType
 	SnapshotID   []byte
+	// DownsampleResolution is the resolution the data in the fileset was
+	// downsampled to, zero if the fileset holds raw datapoints.
+	DownsampleResolution int64
 }
 
 // IndexSummariesInfo stores metadata about the summaries
@@ -140,6 +140,14 @@ type DataPrepareOptions struct {
 	DeleteIfExists    bool
 	// Snapshot options are applicable to snapshots (index yes, data yes)
 	Snapshot DataPrepareSnapshotOptions
+	// DownsampleResolution is set when the data being persisted has been
+	// downsampled to a coarser resolution, applicable to flushes only.
+	DownsampleResolution time.Duration
+	// VolumeIndex is the volume of the fileset to write, applicable to
+	// flushes only. Volumes after the first are written when a flushed
+	// block is rewritten, for instance when downsampling it or merging
+	// in cold writes into it.
+	VolumeIndex int
 }
 
 // DataPrepareVolumeOptions is the options struct for the prepare method that contains
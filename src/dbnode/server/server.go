@@ -42,6 +42,7 @@ import (
 	"github.com/m3db/m3/src/cmd/services/m3dbnode/config"
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/encoding/proto"
 	"github.com/m3db/m3/src/dbnode/environment"
@@ -589,6 +590,11 @@ func Run(runOpts RunOptions) {
 					encoding.NewOptions(),
 				).(client.AdminOptions)
 			}
+			if cfg.Histogram != nil && cfg.Histogram.Enabled {
+				return opts.SetEncodingHistogram(
+					encoding.NewOptions(),
+				).(client.AdminOptions)
+			}
 			return opts
 		},
 		func(opts client.AdminOptions) client.AdminOptions {
@@ -1210,6 +1216,10 @@ func withEncodingAndPoolingOptions(
 			enc := proto.NewEncoder(time.Time{}, encodingOpts)
 			return enc
 		}
+		if cfg.Histogram != nil && cfg.Histogram.Enabled {
+			return histogram.NewMixedEncoder(time.Time{},
+				m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+		}
 
 		return m3tsz.NewEncoder(time.Time{}, nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
 	})
@@ -1218,6 +1228,10 @@ func withEncodingAndPoolingOptions(
 		if cfg.Proto != nil && cfg.Proto.Enabled {
 			return proto.NewIterator(r, descr, encodingOpts)
 		}
+		if cfg.Histogram != nil && cfg.Histogram.Enabled {
+			return histogram.NewMixedIterator(r, descr,
+				m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+		}
 		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
 	})
 
@@ -83,6 +83,13 @@ type fileSystemSourceMetrics struct {
 	persistedIndexBlocksWrite tally.Counter
 }
 
+// NewFileSystemSource creates a new filesystem bootstrap source, this is
+// exposed so that other bootstrappers can read from filesets they place on
+// disk themselves.
+func NewFileSystemSource(opts Options) bootstrap.Source {
+	return newFileSystemSource(opts)
+}
+
 func newFileSystemSource(opts Options) bootstrap.Source {
 	iopts := opts.InstrumentOptions()
 	scope := iopts.MetricsScope().SubScope("fs-bootstrapper")
@@ -343,9 +350,10 @@ func (s *fileSystemSource) newShardReaders(
 
 		openOpts := fs.DataReaderOpenOptions{
 			Identifier: fs.FileSetFileIdentifier{
-				Namespace:  ns.ID(),
-				Shard:      shard,
-				BlockStart: blockStart,
+				Namespace:   ns.ID(),
+				Shard:       shard,
+				BlockStart:  blockStart,
+				VolumeIndex: result.ID.VolumeIndex,
 			},
 		}
 		if err := r.Open(openOpts); err != nil {
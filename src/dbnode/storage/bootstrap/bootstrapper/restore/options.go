@@ -0,0 +1,106 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package restore
+
+import (
+	"errors"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs/export"
+	bfs "github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/fs"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+var (
+	errFilesystemBootstrapOptionsNotSet = errors.New("filesystem bootstrap options not set")
+	errExportOptionsNotSet              = errors.New("export options not set")
+)
+
+type options struct {
+	resultOpts result.Options
+	iOpts      instrument.Options
+	bfsOpts    bfs.Options
+	exportOpts export.Options
+}
+
+// NewOptions creates a new Options.
+func NewOptions() Options {
+	return &options{
+		resultOpts: result.NewOptions(),
+		iOpts:      instrument.NewOptions(),
+		exportOpts: export.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.bfsOpts == nil {
+		return errFilesystemBootstrapOptionsNotSet
+	}
+	if err := o.bfsOpts.Validate(); err != nil {
+		return err
+	}
+	if o.exportOpts == nil {
+		return errExportOptionsNotSet
+	}
+	return o.exportOpts.
+		SetFilesystemOptions(o.bfsOpts.FilesystemOptions()).
+		Validate()
+}
+
+func (o *options) SetResultOptions(value result.Options) Options {
+	opts := *o
+	opts.resultOpts = value
+	return &opts
+}
+
+func (o *options) ResultOptions() result.Options {
+	return o.resultOpts
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.iOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.iOpts
+}
+
+func (o *options) SetFilesystemBootstrapOptions(value bfs.Options) Options {
+	opts := *o
+	opts.bfsOpts = value
+	return &opts
+}
+
+func (o *options) FilesystemBootstrapOptions() bfs.Options {
+	return o.bfsOpts
+}
+
+func (o *options) SetExportOptions(value export.Options) Options {
+	opts := *o
+	opts.exportOpts = value
+	return &opts
+}
+
+func (o *options) ExportOptions() export.Options {
+	return o.exportOpts
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package restore
+
+import (
+	"sync"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs/export"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
+	bfs "github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/fs"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+
+	"go.uber.org/zap"
+)
+
+// restoreSource copies filesets from a namespace export into the local
+// filesystem and then bootstraps from them exactly as the filesystem
+// bootstrapper would. Filesets that already exist locally are left in place
+// so restarting a node configured with the restore bootstrapper is cheap.
+type restoreSource struct {
+	sync.Mutex
+
+	opts     Options
+	log      *zap.Logger
+	restorer export.Restorer
+	fsSource bootstrap.Source
+	restored map[string]map[uint32]struct{}
+}
+
+func newRestoreSource(opts Options) (bootstrap.Source, error) {
+	var (
+		bfsOpts    = opts.FilesystemBootstrapOptions()
+		exportOpts = opts.ExportOptions().
+				SetFilesystemOptions(bfsOpts.FilesystemOptions())
+	)
+	restorer, err := export.NewRestorer(exportOpts)
+	if err != nil {
+		return nil, err
+	}
+	return &restoreSource{
+		opts:     opts,
+		log:      opts.InstrumentOptions().Logger(),
+		restorer: restorer,
+		fsSource: bfs.NewFileSystemSource(bfsOpts),
+		restored: make(map[string]map[uint32]struct{}),
+	}, nil
+}
+
+func (s *restoreSource) Can(strategy bootstrap.Strategy) bool {
+	switch strategy {
+	case bootstrap.BootstrapSequential:
+		return true
+	}
+	return false
+}
+
+func (s *restoreSource) AvailableData(
+	md namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	runOpts bootstrap.RunOptions,
+) (result.ShardTimeRanges, error) {
+	if err := s.restore(md, shardsTimeRanges); err != nil {
+		return nil, err
+	}
+	return s.fsSource.AvailableData(md, shardsTimeRanges, runOpts)
+}
+
+func (s *restoreSource) ReadData(
+	md namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	runOpts bootstrap.RunOptions,
+) (result.DataBootstrapResult, error) {
+	return s.fsSource.ReadData(md, shardsTimeRanges, runOpts)
+}
+
+func (s *restoreSource) AvailableIndex(
+	md namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	runOpts bootstrap.RunOptions,
+) (result.ShardTimeRanges, error) {
+	if err := s.restore(md, shardsTimeRanges); err != nil {
+		return nil, err
+	}
+	return s.fsSource.AvailableIndex(md, shardsTimeRanges, runOpts)
+}
+
+func (s *restoreSource) ReadIndex(
+	md namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	runOpts bootstrap.RunOptions,
+) (result.IndexBootstrapResult, error) {
+	return s.fsSource.ReadIndex(md, shardsTimeRanges, runOpts)
+}
+
+func (s *restoreSource) restore(
+	md namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+) error {
+	s.Lock()
+	defer s.Unlock()
+
+	nsID := md.ID().String()
+	restored, ok := s.restored[nsID]
+	if !ok {
+		restored = make(map[uint32]struct{})
+		s.restored[nsID] = restored
+	}
+
+	var shards []uint32
+	for shard := range shardsTimeRanges {
+		if _, ok := restored[shard]; !ok {
+			shards = append(shards, shard)
+		}
+	}
+	if len(shards) == 0 {
+		return nil
+	}
+
+	start, end := shardsTimeRanges.MinMax()
+	res, err := s.restorer.Restore(md, shards, start, end)
+	if err == export.ErrExportNotFound {
+		s.log.Warn("no export found to restore namespace from",
+			zap.String("namespace", nsID))
+	} else if err != nil {
+		return err
+	} else {
+		s.log.Info("restored namespace from export",
+			zap.String("namespace", nsID),
+			zap.String("exportID", res.ExportID),
+			zap.Int("dataFileSets", res.DataFileSets),
+			zap.Int("indexFileSets", res.IndexFileSets))
+	}
+
+	for _, shard := range shards {
+		restored[shard] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package restore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/export"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
+	bfs "github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/fs"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testNsID           = ident.StringID("testNs")
+	testBlockSize      = 2 * time.Hour
+	testStart          = time.Now().Truncate(testBlockSize)
+	testDefaultRunOpts = bootstrap.NewRunOptions().
+				SetPersistConfig(bootstrap.PersistConfig{Enabled: false})
+)
+
+func testNsMetadata(t *testing.T) namespace.Metadata {
+	md, err := namespace.NewMetadata(testNsID, namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().SetBlockSize(testBlockSize)).
+		SetIndexOptions(namespace.NewIndexOptions().
+			SetEnabled(true).
+			SetBlockSize(testBlockSize)))
+	require.NoError(t, err)
+	return md
+}
+
+func testShardTimeRanges() result.ShardTimeRanges {
+	return map[uint32]xtime.Ranges{
+		0: xtime.NewRanges(xtime.Range{
+			Start: testStart,
+			End:   testStart.Add(testBlockSize),
+		}),
+	}
+}
+
+func newTestOptions(prefix, exportPath string) Options {
+	bfsOpts := bfs.NewOptions().
+		SetResultOptions(result.NewOptions().SetSeriesCachePolicy(series.CacheAll)).
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(prefix)).
+		SetBoostrapDataNumProcessors(1).
+		SetBoostrapIndexNumProcessors(1)
+	return NewOptions().
+		SetFilesystemBootstrapOptions(bfsOpts).
+		SetExportOptions(export.NewOptions().SetExportPath(exportPath))
+}
+
+func writeTestFileSet(t *testing.T, prefix string, ids ...string) {
+	w, err := fs.NewWriter(fs.NewOptions().SetFilePathPrefix(prefix))
+	require.NoError(t, err)
+	require.NoError(t, w.Open(fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  testNsID,
+			Shard:      0,
+			BlockStart: testStart,
+		},
+		BlockSize:   testBlockSize,
+		FileSetType: persist.FileSetFlushType,
+	}))
+	for _, id := range ids {
+		data := []byte(id + "-data")
+		bytes := checked.NewBytes(data, nil)
+		bytes.IncRef()
+		require.NoError(t, w.Write(ident.StringID(id), ident.Tags{}, bytes,
+			digest.Checksum(data)))
+		bytes.DecRef()
+	}
+	require.NoError(t, w.Close())
+}
+
+func TestRestoreSourceReadData(t *testing.T) {
+	dir, err := ioutil.TempDir("", "restore-source")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		srcPrefix  = filepath.Join(dir, "src")
+		dstPrefix  = filepath.Join(dir, "dst")
+		exportPath = filepath.Join(dir, "exports")
+		md         = testNsMetadata(t)
+	)
+	writeTestFileSet(t, srcPrefix, "foo", "bar")
+
+	exporter, err := export.NewExporter(export.NewOptions().
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(srcPrefix)).
+		SetExportPath(exportPath).
+		SetExportID("1").
+		SetHostID("host0"))
+	require.NoError(t, err)
+	_, err = exporter.Export(md, nil)
+	require.NoError(t, err)
+
+	src, err := newRestoreSource(newTestOptions(dstPrefix, exportPath))
+	require.NoError(t, err)
+
+	ranges := testShardTimeRanges()
+	available, err := src.AvailableData(md, ranges, testDefaultRunOpts)
+	require.NoError(t, err)
+	require.Equal(t, ranges, available)
+
+	res, err := src.ReadData(md, available, testDefaultRunOpts)
+	require.NoError(t, err)
+	require.True(t, res.Unfulfilled().IsEmpty())
+	require.Equal(t, int64(2), res.ShardResults().NumSeries())
+}
+
+func TestRestoreSourceNoExport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "restore-source")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src, err := newRestoreSource(newTestOptions(filepath.Join(dir, "dst"),
+		filepath.Join(dir, "exports")))
+	require.NoError(t, err)
+
+	available, err := src.AvailableData(testNsMetadata(t),
+		testShardTimeRanges(), testDefaultRunOpts)
+	require.NoError(t, err)
+	require.True(t, available.IsEmpty())
+}
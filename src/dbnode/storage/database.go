@@ -287,9 +287,12 @@ func (d *db) UpdateOwnedNamespaces(newNamespaces namespace.Map) error {
 		return err
 	}
 
+	// Downsample tiers are applied to existing namespaces without a restart.
+	d.updateDownsampleOptionsWithLock(updates)
+
 	// log that updates and removals are skipped
 	if len(removes) > 0 || len(updates) > 0 {
-		d.log.Warn("skipping namespace removals and updates (except schema updates), restart process if you want changes to take effect.")
+		d.log.Warn("skipping namespace removals and updates (except schema and downsample updates), restart process if you want changes to take effect.")
 	}
 
 	// enqueue bootstraps if new namespaces
@@ -319,8 +322,11 @@ func (d *db) namespaceDeltaWithLock(newNamespaces namespace.Map) ([]ident.ID, []
 			continue
 		}
 
-		// if namespace exists in newNamespaces, check if options are the same
-		optionsSame := newMd.Options().Equal(ns.Options())
+		// if namespace exists in newNamespaces, check if options are the same,
+		// comparing against the downsample options currently in use as they
+		// may have been updated since the namespace was created
+		currOpts := ns.Options().SetDownsampleOptions(ns.DownsampleOptions())
+		optionsSame := newMd.Options().Equal(currOpts)
 
 		// if options are the same, we don't need to do anything
 		if optionsSame {
@@ -342,6 +348,26 @@ func (d *db) namespaceDeltaWithLock(newNamespaces namespace.Map) ([]ident.ID, []
 	return removes, adds, updates
 }
 
+func (d *db) updateDownsampleOptionsWithLock(updates []namespace.Metadata) {
+	for _, md := range updates {
+		ns, ok := d.namespaces.Get(md.ID())
+		if !ok {
+			continue
+		}
+		dsOpts := md.Options().DownsampleOptions()
+		if dsOpts.Equal(ns.DownsampleOptions()) {
+			continue
+		}
+		if err := ns.SetDownsampleOptions(dsOpts); err != nil {
+			d.log.Error("failed to update namespace downsample options",
+				zap.Stringer("namespace", md.ID()), zap.Error(err))
+			continue
+		}
+		d.log.Info("updated namespace downsample options",
+			zap.Stringer("namespace", md.ID()))
+	}
+}
+
 func (d *db) logNamespaceUpdate(removes []ident.ID, adds, updates []namespace.Metadata) error {
 	removalString, err := tsIDs(removes).String()
 	if err != nil {
@@ -919,6 +945,31 @@ func (d *db) Truncate(namespace ident.ID) (int64, error) {
 	return n.Truncate()
 }
 
+func (d *db) DeleteTagged(
+	ctx context.Context,
+	namespace ident.ID,
+	query index.Query,
+	start, end time.Time,
+) (int64, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		return 0, err
+	}
+	return n.DeleteTagged(ctx, query, start, end)
+}
+
+func (d *db) Cardinality(
+	ctx context.Context,
+	namespace ident.ID,
+	opts index.CardinalityOptions,
+) ([]index.CardinalityShardResult, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return n.Cardinality(ctx, opts)
+}
+
 func (d *db) IsOverloaded() bool {
 	queueSize := float64(d.commitLog.QueueLength())
 	queueCapacity := float64(d.opts.CommitLogOptions().BacklogQueueSize())
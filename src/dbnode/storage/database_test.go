@@ -666,6 +666,55 @@ func TestDatabaseUpdateNamespace(t *testing.T) {
 	require.Nil(t, schema)
 }
 
+func TestDatabaseUpdateNamespaceDownsampleOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d, mapCh, _ := defaultTestDatabase(t, ctrl, Bootstrapped)
+	require.NoError(t, d.Open())
+	defer func() {
+		close(mapCh)
+		require.NoError(t, d.Close())
+		leaktest.CheckTimeout(t, time.Second)()
+	}()
+
+	// retrieve the update channel to track propatation
+	updateCh := d.opts.NamespaceInitializer().(*mockNsInitializer).updateCh
+
+	// construct new namespace Map with downsample tiers for ns1
+	dsOpts := namespace.NewDownsampleOptions().SetTiers([]namespace.DownsampleTier{{
+		Resolution:  30 * time.Minute,
+		After:       4 * time.Hour,
+		Aggregation: namespace.DownsampleMax,
+	}})
+	md1, err := namespace.NewMetadata(defaultTestNs1ID,
+		defaultTestNs1Opts.SetDownsampleOptions(dsOpts))
+	require.NoError(t, err)
+	md2, err := namespace.NewMetadata(defaultTestNs2ID, defaultTestNs2Opts)
+	require.NoError(t, err)
+	nsMap, err := namespace.NewMap([]namespace.Metadata{md1, md2})
+	require.NoError(t, err)
+
+	// update the database watch with new Map
+	mapCh <- nsMap
+
+	// wait till the update has propagated
+	<-updateCh
+	<-updateCh
+
+	ns1, ok := d.Namespace(defaultTestNs1ID)
+	require.True(t, ok)
+	require.True(t, xclock.WaitUntil(func() bool {
+		return dsOpts.Equal(ns1.(databaseNamespace).DownsampleOptions())
+	}, 2*time.Second))
+
+	// Only the downsample options are updated.
+	require.Equal(t, defaultTestNs1Opts, ns1.Options())
+	ns2, ok := d.Namespace(defaultTestNs2ID)
+	require.True(t, ok)
+	require.Equal(t, 0, len(ns2.(databaseNamespace).DownsampleOptions().Tiers()))
+}
+
 func TestDatabaseCreateSchemaNotSet(t *testing.T) {
 	protoTestDatabaseOptions := defaultTestDatabaseOptions.SetSchemaRegistry(namespace.NewSchemaRegistry(true, nil))
 
@@ -0,0 +1,136 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"math"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// downsampleWindow accumulates the datapoints of a series that fall within
+// a single resolution window of a downsample tier.
+type downsampleWindow struct {
+	start time.Time
+	count int
+	sum   float64
+	min   float64
+	max   float64
+	last  float64
+	unit  xtime.Unit
+}
+
+func (w *downsampleWindow) reset(start time.Time) {
+	*w = downsampleWindow{
+		start: start,
+		min:   math.Inf(1),
+		max:   math.Inf(-1),
+	}
+}
+
+func (w *downsampleWindow) add(dp ts.Datapoint, unit xtime.Unit) {
+	w.count++
+	w.sum += dp.Value
+	w.min = math.Min(w.min, dp.Value)
+	w.max = math.Max(w.max, dp.Value)
+	w.last = dp.Value
+	w.unit = unit
+}
+
+// value returns the aggregated value of the window, fromResolution is the
+// resolution the source datapoints were already downsampled to (zero if
+// they are raw) and is used to keep counts cumulative across tiers.
+func (w *downsampleWindow) value(
+	agg namespace.DownsampleAggregation,
+	fromResolution time.Duration,
+) float64 {
+	switch agg {
+	case namespace.DownsampleLast:
+		return w.last
+	case namespace.DownsampleMin:
+		return w.min
+	case namespace.DownsampleMax:
+		return w.max
+	case namespace.DownsampleSum:
+		return w.sum
+	case namespace.DownsampleCount:
+		if fromResolution > 0 {
+			// Source datapoints are already counts.
+			return w.sum
+		}
+		return float64(w.count)
+	default:
+		return w.sum / float64(w.count)
+	}
+}
+
+// downsampleSeries encodes one datapoint per resolution window of the tier
+// from the datapoints of a single series, each timestamped at the start of
+// its window so that it remains within the block. Returns the number of
+// datapoints that were encoded.
+func downsampleSeries(
+	iter encoding.Iterator,
+	encoder encoding.Encoder,
+	tier namespace.DownsampleTier,
+	fromResolution time.Duration,
+) (int, error) {
+	var (
+		window  downsampleWindow
+		encoded int
+	)
+	flush := func() error {
+		if window.count == 0 {
+			return nil
+		}
+		dp := ts.Datapoint{
+			Timestamp: window.start,
+			Value:     window.value(tier.Aggregation, fromResolution),
+		}
+		if err := encoder.Encode(dp, window.unit, nil); err != nil {
+			return err
+		}
+		encoded++
+		return nil
+	}
+
+	for iter.Next() {
+		dp, unit, _ := iter.Current()
+		start := dp.Timestamp.Truncate(tier.Resolution)
+		if window.count == 0 || !start.Equal(window.start) {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+			window.reset(start)
+		}
+		window.add(dp, unit)
+	}
+	if err := iter.Err(); err != nil {
+		return 0, err
+	}
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	return encoded, nil
+}
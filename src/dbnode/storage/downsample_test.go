@@ -0,0 +1,141 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/context"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testEncodeDatapoints(
+	t *testing.T,
+	start time.Time,
+	dps []ts.Datapoint,
+) encoding.ReaderIterator {
+	encOpts := encoding.NewOptions()
+	enc := m3tsz.NewEncoder(start, nil, true, encOpts)
+	for _, dp := range dps {
+		require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+	}
+	stream, ok := enc.Stream(encoding.StreamOptions{})
+	require.True(t, ok)
+	return m3tsz.NewReaderIterator(stream, true, encOpts)
+}
+
+func testDecodeDatapoints(t *testing.T, enc encoding.Encoder) []ts.Datapoint {
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	stream, ok := enc.Stream(encoding.StreamOptions{})
+	if !ok {
+		return nil
+	}
+	ctx.RegisterFinalizer(stream)
+
+	var (
+		iter = m3tsz.NewReaderIterator(stream, true, encoding.NewOptions())
+		dps  []ts.Datapoint
+	)
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		dps = append(dps, dp)
+	}
+	require.NoError(t, iter.Err())
+	return dps
+}
+
+func TestDownsampleSeries(t *testing.T) {
+	start := time.Now().Truncate(time.Hour)
+	dps := []ts.Datapoint{
+		{Timestamp: start, Value: 4},
+		{Timestamp: start.Add(time.Minute), Value: 1},
+		{Timestamp: start.Add(2 * time.Minute), Value: 7},
+		{Timestamp: start.Add(10 * time.Minute), Value: 3},
+		{Timestamp: start.Add(12 * time.Minute), Value: 5},
+		{Timestamp: start.Add(40 * time.Minute), Value: 2},
+	}
+
+	tests := []struct {
+		agg      namespace.DownsampleAggregation
+		expected []float64
+	}{
+		{agg: namespace.DownsampleMean, expected: []float64{4, 4, 2}},
+		{agg: namespace.DownsampleLast, expected: []float64{7, 5, 2}},
+		{agg: namespace.DownsampleMin, expected: []float64{1, 3, 2}},
+		{agg: namespace.DownsampleMax, expected: []float64{7, 5, 2}},
+		{agg: namespace.DownsampleSum, expected: []float64{12, 8, 2}},
+		{agg: namespace.DownsampleCount, expected: []float64{3, 2, 1}},
+	}
+	for _, test := range tests {
+		t.Run(test.agg.String(), func(t *testing.T) {
+			var (
+				tier = namespace.DownsampleTier{
+					Resolution:  10 * time.Minute,
+					Aggregation: test.agg,
+				}
+				iter = testEncodeDatapoints(t, start, dps)
+				enc  = m3tsz.NewEncoder(start, nil, true, encoding.NewOptions())
+			)
+			n, err := downsampleSeries(iter, enc, tier, 0)
+			require.NoError(t, err)
+			require.Equal(t, len(test.expected), n)
+
+			results := testDecodeDatapoints(t, enc)
+			require.Equal(t, []ts.Datapoint{
+				{Timestamp: start, Value: test.expected[0]},
+				{Timestamp: start.Add(10 * time.Minute), Value: test.expected[1]},
+				{Timestamp: start.Add(40 * time.Minute), Value: test.expected[2]},
+			}, results)
+		})
+	}
+}
+
+func TestDownsampleSeriesCountFromDownsampled(t *testing.T) {
+	var (
+		start = time.Now().Truncate(time.Hour)
+		dps   = []ts.Datapoint{
+			{Timestamp: start, Value: 3},
+			{Timestamp: start.Add(10 * time.Minute), Value: 2},
+		}
+		tier = namespace.DownsampleTier{
+			Resolution:  time.Hour,
+			Aggregation: namespace.DownsampleCount,
+		}
+		iter = testEncodeDatapoints(t, start, dps)
+		enc  = m3tsz.NewEncoder(start, nil, true, encoding.NewOptions())
+	)
+
+	// Counts that were already downsampled are summed rather than counted.
+	n, err := downsampleSeries(iter, enc, tier, 10*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, []ts.Datapoint{{Timestamp: start, Value: 5}},
+		testDecodeDatapoints(t, enc))
+}
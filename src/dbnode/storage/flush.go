@@ -47,6 +47,7 @@ const (
 	// when we haven't begun either a flush or snapshot.
 	flushManagerNotIdle
 	flushManagerFlushInProgress
+	flushManagerColdFlushInProgress
 	flushManagerSnapshotInProgress
 	flushManagerIndexFlushInProgress
 )
@@ -63,6 +64,7 @@ type flushManager struct {
 	// are used for emitting granular gauges.
 	state           flushManagerState
 	isFlushing      tally.Gauge
+	isColdFlushing  tally.Gauge
 	isSnapshotting  tally.Gauge
 	isIndexFlushing tally.Gauge
 	// This is a "debug" metric for making sure that the snapshotting process
@@ -81,6 +83,7 @@ func newFlushManager(
 		opts:                            opts,
 		pm:                              opts.PersistManager(),
 		isFlushing:                      scope.Gauge("flush"),
+		isColdFlushing:                  scope.Gauge("cold-flush"),
 		isSnapshotting:                  scope.Gauge("snapshot"),
 		isIndexFlushing:                 scope.Gauge("index-flush"),
 		maxBlocksSnapshottedByNamespace: scope.Gauge("max-blocks-snapshotted-by-namespace"),
@@ -142,6 +145,34 @@ func (m *flushManager) Flush(
 		}
 	}
 
+	// Cold flush after the warm flushes so that cold writes are merged into
+	// the filesets of blocks that have been flushed, backfilled data is then
+	// persisted without waiting for a repair.
+	m.setState(flushManagerColdFlushInProgress)
+	for _, ns := range namespaces {
+		if !ns.Options().ColdWritesEnabled() {
+			continue
+		}
+		if err := ns.ColdFlush(flushPersist); err != nil {
+			detailedErr := fmt.Errorf("namespace %s failed to cold flush data: %v",
+				ns.ID().String(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	// Downsample after flushing so that blocks which have aged into a
+	// downsample tier are rewritten at the coarser resolution of the tier.
+	for _, ns := range namespaces {
+		if len(ns.DownsampleOptions().Tiers()) == 0 {
+			continue
+		}
+		if err := ns.Downsample(tickStart, flushPersist); err != nil {
+			detailedErr := fmt.Errorf("namespace %s failed to downsample data: %v",
+				ns.ID().String(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
 	err = flushPersist.DoneFlush()
 	if err != nil {
 		multiErr = multiErr.Add(err)
@@ -235,6 +266,12 @@ func (m *flushManager) Report() {
 		m.isFlushing.Update(0)
 	}
 
+	if state == flushManagerColdFlushInProgress {
+		m.isColdFlushing.Update(1)
+	} else {
+		m.isColdFlushing.Update(0)
+	}
+
 	if state == flushManagerSnapshotInProgress {
 		m.isSnapshotting.Update(1)
 	} else {
@@ -0,0 +1,119 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/series/lookup"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+// fsMergeWithMem implements fs.MergeWith, merging the cold writes buffered
+// in memory for a set of series with the data of a flushed fileset.
+type fsMergeWithMem struct {
+	entries []*lookup.Entry
+	byID    map[string]*lookup.Entry
+	merged  map[*lookup.Entry]struct{}
+	version int
+
+	// numSeriesMerged is the number of series that had cold writes merged.
+	numSeriesMerged int
+}
+
+func newFSMergeWithMem(entries []*lookup.Entry, version int) *fsMergeWithMem {
+	byID := make(map[string]*lookup.Entry, len(entries))
+	for _, entry := range entries {
+		byID[entry.Series.ID().String()] = entry
+	}
+	return &fsMergeWithMem{
+		entries: entries,
+		byID:    byID,
+		merged:  make(map[*lookup.Entry]struct{}, len(entries)),
+		version: version,
+	}
+}
+
+func (m *fsMergeWithMem) Read(
+	ctx context.Context,
+	seriesID ident.ID,
+	blockStart time.Time,
+	nsCtx namespace.Context,
+) ([]xio.BlockReader, bool, error) {
+	entry, ok := m.byID[string(seriesID.Bytes())]
+	if !ok {
+		return nil, false, nil
+	}
+
+	m.merged[entry] = struct{}{}
+	return m.fetch(ctx, entry, blockStart, nsCtx)
+}
+
+func (m *fsMergeWithMem) ForEachRemaining(
+	ctx context.Context,
+	blockStart time.Time,
+	fn fs.ForEachRemainingFn,
+	nsCtx namespace.Context,
+) error {
+	for _, entry := range m.entries {
+		if _, ok := m.merged[entry]; ok {
+			continue
+		}
+
+		m.merged[entry] = struct{}{}
+		data, ok, err := m.fetch(ctx, entry, blockStart, nsCtx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		if err := fn(entry.Series.ID(), entry.Series.Tags(), data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *fsMergeWithMem) fetch(
+	ctx context.Context,
+	entry *lookup.Entry,
+	blockStart time.Time,
+	nsCtx namespace.Context,
+) ([]xio.BlockReader, bool, error) {
+	data, err := entry.Series.FetchBlocksForColdFlush(ctx, blockStart,
+		m.version, nsCtx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(data) == 0 {
+		return nil, false, nil
+	}
+
+	m.numSeriesMerged++
+	return data, true, nil
+}
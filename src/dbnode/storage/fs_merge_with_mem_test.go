@@ -0,0 +1,91 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/dbnode/storage/series/lookup"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFSMergeWithMem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx        = context.NewContext()
+		nsCtx      = namespace.Context{}
+		blockStart = time.Now().Truncate(time.Hour)
+		version    = 3
+		entries    []*lookup.Entry
+		data       = []xio.BlockReader{{Start: blockStart}}
+	)
+	defer ctx.Close()
+
+	for i, id := range []string{"foo", "bar", "baz"} {
+		s := series.NewMockDatabaseSeries(ctrl)
+		s.EXPECT().ID().Return(ident.StringID(id)).AnyTimes()
+		s.EXPECT().Tags().Return(ident.Tags{}).AnyTimes()
+		entries = append(entries, lookup.NewEntry(s, uint64(i)))
+	}
+	entries[0].Series.(*series.MockDatabaseSeries).EXPECT().
+		FetchBlocksForColdFlush(ctx, blockStart, version, nsCtx).Return(data, nil)
+	entries[1].Series.(*series.MockDatabaseSeries).EXPECT().
+		FetchBlocksForColdFlush(ctx, blockStart, version, nsCtx).Return(nil, nil)
+	entries[2].Series.(*series.MockDatabaseSeries).EXPECT().
+		FetchBlocksForColdFlush(ctx, blockStart, version, nsCtx).Return(data, nil)
+
+	mergeWith := newFSMergeWithMem(entries, version)
+
+	result, ok, err := mergeWith.Read(ctx, ident.StringID("foo"), blockStart, nsCtx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, data, result)
+
+	_, ok, err = mergeWith.Read(ctx, ident.StringID("qux"), blockStart, nsCtx)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Only series not already read and with data are remaining.
+	var remaining []string
+	err = mergeWith.ForEachRemaining(ctx, blockStart, func(
+		id ident.ID,
+		_ ident.Tags,
+		result []xio.BlockReader,
+	) error {
+		remaining = append(remaining, id.String())
+		assert.Equal(t, data, result)
+		return nil
+	}, nsCtx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"baz"}, remaining)
+	assert.Equal(t, 2, mergeWith.numSeriesMerged)
+}
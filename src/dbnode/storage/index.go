@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/runtime"
+	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	m3dberrors "github.com/m3db/m3/src/dbnode/storage/errors"
@@ -939,6 +940,73 @@ func (i *nsIndex) AggregateQuery(
 	}, nil
 }
 
+func (i *nsIndex) Cardinality(
+	ctx context.Context,
+	opts index.CardinalityOptions,
+	shardSet sharding.ShardSet,
+) ([]index.CardinalityShardResult, error) {
+	i.state.RLock()
+	if !i.isOpenWithRLock() {
+		i.state.RUnlock()
+		return nil, errDbIndexUnableToQueryClosed
+	}
+
+	// Track this as an inflight query that needs to finish
+	// when the index is closed.
+	i.queriesWg.Add(1)
+	defer i.queriesWg.Done()
+
+	blocks, err := i.blocksForQueryWithRLock(xtime.NewRanges(xtime.Range{
+		Start: opts.StartInclusive,
+		End:   opts.EndExclusive,
+	}))
+
+	// Can now release the lock and count without holding the lock.
+	i.state.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	metricNameField := opts.MetricNameField
+	if len(metricNameField) == 0 {
+		metricNameField = index.DefaultCardinalityMetricNameField
+	}
+
+	cancellable := resource.NewCancellableLifetime()
+	defer cancellable.Cancel()
+
+	counts := index.NewCardinalityCounts()
+	for _, block := range blocks {
+		blockCounts, err := block.Cardinality(cancellable, metricNameField,
+			shardSet.Lookup)
+		if err == index.ErrUnableToQueryBlockClosed {
+			// NB: the block slid out of retention while counting, its
+			// series are no longer considered valid.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		counts.Merge(blockCounts)
+	}
+
+	// NB: only return the shards currently owned, the index may still hold
+	// series of shards that have since been moved to other hosts.
+	owned := make(map[uint32]struct{}, len(shardSet.AllIDs()))
+	for _, shard := range shardSet.AllIDs() {
+		owned[shard] = struct{}{}
+	}
+	results := counts.Results()
+	filtered := results[:0]
+	for _, result := range results {
+		if _, ok := owned[result.Shard]; ok {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered, nil
+}
+
 func (i *nsIndex) query(
 	ctx context.Context,
 	query index.Query,
@@ -31,6 +31,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/index/compaction"
 	"github.com/m3db/m3/src/dbnode/storage/index/segments"
 	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/m3ninx/doc"
 	m3ninxindex "github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/index/segment"
@@ -996,6 +997,67 @@ func (b *block) Aggregate(
 	return exhaustive, nil
 }
 
+// Cardinality acquires a read lock on the block so that the segments
+// are guaranteed to not be freed/released while counting. Documents are
+// read so that every series is counted against the shard it belongs to,
+// allowing callers to deduplicate the counts of shard replicas.
+func (b *block) Cardinality(
+	cancellable *resource.CancellableLifetime,
+	metricNameField []byte,
+	shardFn sharding.HashFn,
+) (*CardinalityCounts, error) {
+	b.RLock()
+	defer b.RUnlock()
+
+	if b.state == blockStateClosed {
+		return nil, ErrUnableToQueryBlockClosed
+	}
+
+	counts := NewCardinalityCounts()
+	for _, s := range b.segmentsWithRLock() {
+		// checkout the lifetime of the query before counting each segment.
+		if !cancellable.TryCheckout() {
+			return nil, errCancelledQuery
+		}
+		err := b.addSegmentCardinality(counts, s, metricNameField, shardFn)
+		cancellable.ReleaseCheckout()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return counts, nil
+}
+
+func (b *block) addSegmentCardinality(
+	counts *CardinalityCounts,
+	s segment.Segment,
+	metricNameField []byte,
+	shardFn sharding.HashFn,
+) error {
+	reader, err := s.Reader()
+	if err != nil {
+		return err
+	}
+
+	docs, err := reader.AllDocs()
+	if err != nil {
+		reader.Close()
+		return err
+	}
+
+	for docs.Next() {
+		d := docs.Current()
+		shard := shardFn(ident.BytesID(d.ID))
+		counts.AddSeries(shard, 1)
+		for _, f := range d.Fields {
+			counts.AddTerm(shard, metricNameField, f.Name, f.Value, 1)
+		}
+	}
+
+	return xerrors.FirstError(docs.Err(), docs.Close(), reader.Close())
+}
+
 func (b *block) appendFieldAndTermToBatch(
 	batch []AggregateResultsEntry,
 	field, term []byte,
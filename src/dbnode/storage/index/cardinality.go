@@ -0,0 +1,261 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"bytes"
+	"sort"
+	"time"
+)
+
+var (
+	// DefaultCardinalityMetricNameField is the field used to derive metric
+	// names from when none is specified.
+	DefaultCardinalityMetricNameField = []byte("__name__")
+)
+
+// CardinalityOptions enables users to specify constraints on cardinality
+// analysis of the index. The limit is only applied once the counts of every
+// shard have been summed, hosts always return untruncated counts.
+type CardinalityOptions struct {
+	StartInclusive  time.Time
+	EndExclusive    time.Time
+	Limit           int
+	MetricNameField []byte
+}
+
+// CardinalityEntry is the series count of a metric name, a label name or
+// a label name and value pair. Value is only set for label value pairs.
+type CardinalityEntry struct {
+	Name      []byte
+	Value     []byte
+	NumSeries int64
+}
+
+// CardinalityResult is the result of a cardinality analysis, each set of
+// entries is sorted by descending series count.
+type CardinalityResult struct {
+	NumSeries       int64
+	MetricNames     []CardinalityEntry
+	LabelNames      []CardinalityEntry
+	LabelValuePairs []CardinalityEntry
+}
+
+// CardinalityShardResult is the untruncated result of a cardinality analysis
+// of the series of a single shard.
+type CardinalityShardResult struct {
+	Shard uint32
+	CardinalityResult
+}
+
+type cardinalityPair struct {
+	name  string
+	value string
+}
+
+// CardinalityCounts accumulates the series counts of metric names, label
+// names and label value pairs of each shard from the documents of index
+// segments.
+type CardinalityCounts struct {
+	shards map[uint32]*shardCardinalityCounts
+}
+
+type shardCardinalityCounts struct {
+	numSeries       int64
+	metricNames     map[string]int64
+	labelNames      map[string]int64
+	labelValuePairs map[cardinalityPair]int64
+}
+
+// NewCardinalityCounts returns a new set of empty cardinality counts.
+func NewCardinalityCounts() *CardinalityCounts {
+	return &CardinalityCounts{
+		shards: make(map[uint32]*shardCardinalityCounts),
+	}
+}
+
+func newShardCardinalityCounts() *shardCardinalityCounts {
+	return &shardCardinalityCounts{
+		metricNames:     make(map[string]int64),
+		labelNames:      make(map[string]int64),
+		labelValuePairs: make(map[cardinalityPair]int64),
+	}
+}
+
+func (c *CardinalityCounts) forShard(shard uint32) *shardCardinalityCounts {
+	counts, ok := c.shards[shard]
+	if !ok {
+		counts = newShardCardinalityCounts()
+		c.shards[shard] = counts
+	}
+	return counts
+}
+
+// AddSeries adds to the number of series of a shard counted.
+func (c *CardinalityCounts) AddSeries(shard uint32, n int64) {
+	c.forShard(shard).numSeries += n
+}
+
+// AddTerm adds the number of series of a shard with the given field and
+// term, a field matching the metric name field also counts towards the
+// term's metric name.
+// NB: since a series has at most one term per field, summing term counts
+// yields the number of series carrying the field.
+func (c *CardinalityCounts) AddTerm(
+	shard uint32,
+	metricNameField, field, term []byte,
+	n int64,
+) {
+	counts := c.forShard(shard)
+	if bytes.Equal(field, metricNameField) {
+		counts.metricNames[string(term)] += n
+	}
+	counts.labelNames[string(field)] += n
+	counts.labelValuePairs[cardinalityPair{
+		name:  string(field),
+		value: string(term),
+	}] += n
+}
+
+// Merge merges the counts of another index block into the receiver. Series
+// IDs are not comparable across blocks so the maximum count of each entry
+// of a shard is kept, which is exact for series that span every block in the
+// range and a lower bound otherwise.
+func (c *CardinalityCounts) Merge(other *CardinalityCounts) {
+	for shard, otherCounts := range other.shards {
+		counts := c.forShard(shard)
+		if otherCounts.numSeries > counts.numSeries {
+			counts.numSeries = otherCounts.numSeries
+		}
+		for k, v := range otherCounts.metricNames {
+			if v > counts.metricNames[k] {
+				counts.metricNames[k] = v
+			}
+		}
+		for k, v := range otherCounts.labelNames {
+			if v > counts.labelNames[k] {
+				counts.labelNames[k] = v
+			}
+		}
+		for k, v := range otherCounts.labelValuePairs {
+			if v > counts.labelValuePairs[k] {
+				counts.labelValuePairs[k] = v
+			}
+		}
+	}
+}
+
+// Results returns the untruncated counts of each shard ordered by shard.
+func (c *CardinalityCounts) Results() []CardinalityShardResult {
+	results := make([]CardinalityShardResult, 0, len(c.shards))
+	for shard, counts := range c.shards {
+		results = append(results, CardinalityShardResult{
+			Shard:             shard,
+			CardinalityResult: counts.result(),
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Shard < results[j].Shard
+	})
+	return results
+}
+
+func (c *shardCardinalityCounts) result() CardinalityResult {
+	metricNames := make([]CardinalityEntry, 0, len(c.metricNames))
+	for k, v := range c.metricNames {
+		metricNames = append(metricNames, CardinalityEntry{
+			Name:      []byte(k),
+			NumSeries: v,
+		})
+	}
+	labelNames := make([]CardinalityEntry, 0, len(c.labelNames))
+	for k, v := range c.labelNames {
+		labelNames = append(labelNames, CardinalityEntry{
+			Name:      []byte(k),
+			NumSeries: v,
+		})
+	}
+	labelValuePairs := make([]CardinalityEntry, 0, len(c.labelValuePairs))
+	for k, v := range c.labelValuePairs {
+		labelValuePairs = append(labelValuePairs, CardinalityEntry{
+			Name:      []byte(k.name),
+			Value:     []byte(k.value),
+			NumSeries: v,
+		})
+	}
+	return CardinalityResult{
+		NumSeries:       c.numSeries,
+		MetricNames:     TopCardinalityEntries(metricNames, 0),
+		LabelNames:      TopCardinalityEntries(labelNames, 0),
+		LabelValuePairs: TopCardinalityEntries(labelValuePairs, 0),
+	}
+}
+
+// SumCardinalityShardResults sums the results of distinct shards, limited
+// to limit entries per set if limit is greater than zero.
+func SumCardinalityShardResults(
+	results []CardinalityShardResult,
+	limit int,
+) CardinalityResult {
+	sum := newShardCardinalityCounts()
+	for _, r := range results {
+		sum.numSeries += r.NumSeries
+		for _, entry := range r.MetricNames {
+			sum.metricNames[string(entry.Name)] += entry.NumSeries
+		}
+		for _, entry := range r.LabelNames {
+			sum.labelNames[string(entry.Name)] += entry.NumSeries
+		}
+		for _, entry := range r.LabelValuePairs {
+			sum.labelValuePairs[cardinalityPair{
+				name:  string(entry.Name),
+				value: string(entry.Value),
+			}] += entry.NumSeries
+		}
+	}
+
+	result := sum.result()
+	result.MetricNames = TopCardinalityEntries(result.MetricNames, limit)
+	result.LabelNames = TopCardinalityEntries(result.LabelNames, limit)
+	result.LabelValuePairs = TopCardinalityEntries(result.LabelValuePairs, limit)
+	return result
+}
+
+// TopCardinalityEntries sorts entries by descending series count, breaking
+// ties by name and value, and truncates them to limit if greater than zero.
+func TopCardinalityEntries(
+	entries []CardinalityEntry,
+	limit int,
+) []CardinalityEntry {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].NumSeries != entries[j].NumSeries {
+			return entries[i].NumSeries > entries[j].NumSeries
+		}
+		if c := bytes.Compare(entries[i].Name, entries[j].Name); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(entries[i].Value, entries[j].Value) < 0
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardinalityCountsAddTerm(t *testing.T) {
+	counts := NewCardinalityCounts()
+	counts.AddSeries(0, 5)
+	counts.AddTerm(0, []byte("__name__"), []byte("__name__"), []byte("foo"), 3)
+	counts.AddTerm(0, []byte("__name__"), []byte("__name__"), []byte("bar"), 2)
+	counts.AddTerm(0, []byte("__name__"), []byte("host"), []byte("a"), 4)
+	counts.AddTerm(0, []byte("__name__"), []byte("host"), []byte("b"), 1)
+
+	results := counts.Results()
+	require.Len(t, results, 1)
+	require.Equal(t, uint32(0), results[0].Shard)
+
+	result := results[0].CardinalityResult
+	require.Equal(t, int64(5), result.NumSeries)
+	require.Equal(t, []CardinalityEntry{
+		{Name: []byte("foo"), NumSeries: 3},
+		{Name: []byte("bar"), NumSeries: 2},
+	}, result.MetricNames)
+	require.Equal(t, []CardinalityEntry{
+		{Name: []byte("__name__"), NumSeries: 5},
+		{Name: []byte("host"), NumSeries: 5},
+	}, result.LabelNames)
+	require.Len(t, result.LabelValuePairs, 4)
+	require.Equal(t, []CardinalityEntry{
+		{Name: []byte("host"), Value: []byte("a"), NumSeries: 4},
+		{Name: []byte("__name__"), Value: []byte("foo"), NumSeries: 3},
+	}, result.LabelValuePairs[:2])
+}
+
+func TestCardinalityCountsMergeKeepsMaxPerShard(t *testing.T) {
+	name := DefaultCardinalityMetricNameField
+
+	a := NewCardinalityCounts()
+	a.AddSeries(0, 3)
+	a.AddTerm(0, name, name, []byte("foo"), 3)
+
+	b := NewCardinalityCounts()
+	b.AddSeries(0, 4)
+	b.AddTerm(0, name, name, []byte("foo"), 1)
+	b.AddTerm(0, name, name, []byte("bar"), 3)
+	b.AddSeries(1, 2)
+	b.AddTerm(1, name, name, []byte("foo"), 2)
+
+	a.Merge(b)
+	results := a.Results()
+	require.Len(t, results, 2)
+
+	require.Equal(t, uint32(0), results[0].Shard)
+	require.Equal(t, int64(4), results[0].NumSeries)
+	require.Equal(t, []CardinalityEntry{
+		{Name: []byte("bar"), NumSeries: 3},
+		{Name: []byte("foo"), NumSeries: 3},
+	}, results[0].MetricNames)
+
+	require.Equal(t, uint32(1), results[1].Shard)
+	require.Equal(t, int64(2), results[1].NumSeries)
+}
+
+func TestSumCardinalityShardResults(t *testing.T) {
+	name := DefaultCardinalityMetricNameField
+
+	counts := NewCardinalityCounts()
+	counts.AddSeries(0, 3)
+	counts.AddTerm(0, name, name, []byte("foo"), 1)
+	counts.AddTerm(0, name, name, []byte("bar"), 2)
+	counts.AddSeries(1, 2)
+	counts.AddTerm(1, name, name, []byte("foo"), 2)
+
+	result := SumCardinalityShardResults(counts.Results(), 1)
+	require.Equal(t, int64(5), result.NumSeries)
+	require.Equal(t, []CardinalityEntry{
+		{Name: []byte("foo"), NumSeries: 3},
+	}, result.MetricNames)
+	require.Equal(t, []CardinalityEntry{
+		{Name: name, NumSeries: 5},
+	}, result.LabelNames)
+	require.Len(t, result.LabelValuePairs, 1)
+
+	result = SumCardinalityShardResults(counts.Results(), 0)
+	require.Len(t, result.MetricNames, 2)
+	require.Len(t, result.LabelValuePairs, 2)
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index/compaction"
 	"github.com/m3db/m3/src/m3ninx/doc"
@@ -300,6 +301,14 @@ type Block interface {
 		results AggregateResults,
 	) (exhaustive bool, err error)
 
+	// Cardinality counts the series of each metric name, label name and
+	// label value pair known to the block per shard, as given by shardFn.
+	Cardinality(
+		cancellable *resource.CancellableLifetime,
+		metricNameField []byte,
+		shardFn sharding.HashFn,
+	) (*CardinalityCounts, error)
+
 	// AddResults adds bootstrap results to the block.
 	AddResults(results result.IndexBlock) error
 
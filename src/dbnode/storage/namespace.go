@@ -56,8 +56,9 @@ import (
 )
 
 var (
-	errNamespaceAlreadyClosed    = errors.New("namespace already closed")
-	errNamespaceIndexingDisabled = errors.New("namespace indexing is disabled")
+	errNamespaceAlreadyClosed       = errors.New("namespace already closed")
+	errNamespaceIndexingDisabled    = errors.New("namespace indexing is disabled")
+	errDeleteTaggedInvalidTimeRange = errors.New("delete tagged start must be before end")
 )
 
 type commitLogWriter interface {
@@ -121,6 +122,10 @@ type dbNamespace struct {
 	schemaListener xclose.SimpleCloser
 	schemaDescr    namespace.SchemaDescr
 
+	// downsampleOpts are the downsample options currently in use, they are
+	// updated whenever the namespace registry changes the downsample tiers.
+	downsampleOpts namespace.DownsampleOptions
+
 	// Contains an entry to all shards for fast shard lookup, an
 	// entry will be nil when this shard does not belong to current database
 	shards []databaseShard
@@ -128,6 +133,7 @@ type dbNamespace struct {
 	increasingIndex increasingIndex
 	commitLogWriter commitLogWriter
 	reverseIndex    namespaceIndex
+	tombstones      *seriesTombstones
 
 	tickWorkers            xsync.WorkerPool
 	tickWorkersConcurrency int
@@ -154,6 +160,8 @@ type databaseNamespaceMetrics struct {
 	flush               instrument.MethodMetrics
 	flushIndex          instrument.MethodMetrics
 	snapshot            instrument.MethodMetrics
+	downsample          instrument.MethodMetrics
+	coldFlush           instrument.MethodMetrics
 	write               instrument.MethodMetrics
 	writeTagged         instrument.MethodMetrics
 	read                instrument.MethodMetrics
@@ -161,6 +169,8 @@ type databaseNamespaceMetrics struct {
 	fetchBlocksMetadata instrument.MethodMetrics
 	queryIDs            instrument.MethodMetrics
 	aggregateQuery      instrument.MethodMetrics
+	deleteTagged        instrument.MethodMetrics
+	cardinality         instrument.MethodMetrics
 	unfulfilled         tally.Counter
 	bootstrapStart      tally.Counter
 	bootstrapEnd        tally.Counter
@@ -232,6 +242,8 @@ func newDatabaseNamespaceMetrics(scope tally.Scope, samplingRate float64) databa
 		flush:               instrument.NewMethodMetrics(scope, "flush", samplingRate),
 		flushIndex:          instrument.NewMethodMetrics(scope, "flushIndex", samplingRate),
 		snapshot:            instrument.NewMethodMetrics(scope, "snapshot", samplingRate),
+		downsample:          instrument.NewMethodMetrics(scope, "downsample", samplingRate),
+		coldFlush:           instrument.NewMethodMetrics(scope, "coldFlush", samplingRate),
 		write:               instrument.NewMethodMetrics(scope, "write", overrideWriteSamplingRate),
 		writeTagged:         instrument.NewMethodMetrics(scope, "write-tagged", overrideWriteSamplingRate),
 		read:                instrument.NewMethodMetrics(scope, "read", samplingRate),
@@ -239,6 +251,8 @@ func newDatabaseNamespaceMetrics(scope tally.Scope, samplingRate float64) databa
 		fetchBlocksMetadata: instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", samplingRate),
 		queryIDs:            instrument.NewMethodMetrics(scope, "queryIDs", samplingRate),
 		aggregateQuery:      instrument.NewMethodMetrics(scope, "aggregateQuery", samplingRate),
+		deleteTagged:        instrument.NewMethodMetrics(scope, "deleteTagged", samplingRate),
+		cardinality:         instrument.NewMethodMetrics(scope, "cardinality", samplingRate),
 		unfulfilled:         scope.Counter("bootstrap.unfulfilled"),
 		bootstrapStart:      scope.Counter("bootstrap.start"),
 		bootstrapEnd:        scope.Counter("bootstrap.end"),
@@ -329,6 +343,14 @@ func newDatabaseNamespace(
 		}
 	}
 
+	tombstones, err := newSeriesTombstones(id, nopts.RetentionOptions().BlockSize(),
+		shardSet.HashFn(), opts)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to create namespace %v, could not read tombstones: %v",
+			metadata.ID().String(), err)
+	}
+
 	n := &dbNamespace{
 		id:                     id,
 		shutdownCh:             make(chan struct{}),
@@ -338,6 +360,7 @@ func newDatabaseNamespace(
 		opts:                   opts,
 		metadata:               metadata,
 		nopts:                  nopts,
+		downsampleOpts:         nopts.DownsampleOptions(),
 		seriesOpts:             seriesOpts,
 		nowFn:                  opts.ClockOptions().NowFn(),
 		snapshotFilesFn:        fs.SnapshotFiles,
@@ -345,6 +368,7 @@ func newDatabaseNamespace(
 		increasingIndex:        increasingIndex,
 		commitLogWriter:        commitLogWriter,
 		reverseIndex:           index,
+		tombstones:             tombstones,
 		tickWorkers:            tickWorkers,
 		tickWorkersConcurrency: tickWorkersConcurrency,
 		metrics:                newDatabaseNamespaceMetrics(scope, iops.MetricsSamplingRate()),
@@ -396,6 +420,23 @@ func (n *dbNamespace) Options() namespace.Options {
 	return n.nopts
 }
 
+func (n *dbNamespace) DownsampleOptions() namespace.DownsampleOptions {
+	n.RLock()
+	value := n.downsampleOpts
+	n.RUnlock()
+	return value
+}
+
+func (n *dbNamespace) SetDownsampleOptions(value namespace.DownsampleOptions) error {
+	if err := value.Validate(n.nopts.RetentionOptions()); err != nil {
+		return err
+	}
+	n.Lock()
+	n.downsampleOpts = value
+	n.Unlock()
+	return nil
+}
+
 func (n *dbNamespace) ID() ident.ID {
 	return n.id
 }
@@ -455,7 +496,7 @@ func (n *dbNamespace) AssignShardSet(shardSet sharding.ShardSet) {
 			bootstrapEnabled := n.nopts.BootstrapEnabled()
 			n.shards[shard] = newDatabaseShard(n.metadata, shard, n.blockRetriever,
 				n.namespaceReaderMgr, n.increasingIndex, n.reverseIndex,
-				bootstrapEnabled, n.opts, n.seriesOpts)
+				n.tombstones, bootstrapEnabled, n.opts, n.seriesOpts)
 			n.metrics.shards.add.Inc(1)
 		}
 	}
@@ -552,6 +593,16 @@ func (n *dbNamespace) Tick(c context.Cancellable, tickStart time.Time) error {
 		}
 	}
 
+	// Expire tombstones for blocks that have fallen out of retention.
+	ropts := n.nopts.RetentionOptions()
+	expireCutoff := tickStart.Add(-ropts.RetentionPeriod()).Truncate(ropts.BlockSize())
+	if err := n.tombstones.Expire(expireCutoff); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	if err := n.tombstones.Compact(); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
 	// NB: we early terminate here to ensure we are not reporting metrics
 	// based on in-accurate/partial tick results.
 	if err := multiErr.FinalError(); err != nil || c.IsCancelled() {
@@ -608,6 +659,11 @@ func (n *dbNamespace) Write(
 	}
 	series, wasWritten, err := shard.Write(ctx, id, timestamp,
 		value, unit, annotation, opts)
+	if err == nil && wasWritten {
+		// The write is only durable once it is no longer masked by any
+		// tombstone it falls within on restart.
+		err = n.tombstones.MarkWritten(id, timestamp)
+	}
 	n.metrics.write.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return series, wasWritten, err
 }
@@ -637,6 +693,11 @@ func (n *dbNamespace) WriteTagged(
 	}
 	series, wasWritten, err := shard.WriteTagged(ctx, id, tags, timestamp,
 		value, unit, annotation, opts)
+	if err == nil && wasWritten {
+		// The write is only durable once it is no longer masked by any
+		// tombstone it falls within on restart.
+		err = n.tombstones.MarkWritten(id, timestamp)
+	}
 	n.metrics.writeTagged.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return series, wasWritten, err
 }
@@ -677,6 +738,9 @@ func (n *dbNamespace) QueryIDs(
 	res, err := n.reverseIndex.Query(ctx, query, opts)
 	if err != nil {
 		sp.LogFields(opentracinglog.Error(err))
+	} else {
+		n.tombstones.FilterQueryResults(res.Results,
+			opts.StartInclusive, opts.EndExclusive)
 	}
 	n.metrics.queryIDs.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
 	return res, err
@@ -705,6 +769,31 @@ func (n *dbNamespace) AggregateQuery(
 	return res, err
 }
 
+func (n *dbNamespace) Cardinality(
+	ctx context.Context,
+	opts index.CardinalityOptions,
+) ([]index.CardinalityShardResult, error) {
+	callStart := n.nowFn()
+	if n.reverseIndex == nil { // only happens if indexing is enabled.
+		n.metrics.cardinality.ReportError(n.nowFn().Sub(callStart))
+		return nil, errNamespaceIndexingDisabled
+	}
+
+	if n.reverseIndex.BootstrapsDone() < 1 {
+		// Similar to reading shard data, return not bootstrapped
+		n.metrics.cardinality.ReportError(n.nowFn().Sub(callStart))
+		return nil, xerrors.NewRetryableError(errIndexNotBootstrappedToRead)
+	}
+
+	n.RLock()
+	shardSet := n.shardSet
+	n.RUnlock()
+
+	res, err := n.reverseIndex.Cardinality(ctx, opts, shardSet)
+	n.metrics.cardinality.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	return res, err
+}
+
 func (n *dbNamespace) ReadEncoded(
 	ctx context.Context,
 	id ident.ID,
@@ -770,6 +859,7 @@ func (n *dbNamespace) Bootstrap(start time.Time, process bootstrap.Process) erro
 		return errNamespaceIsBootstrapping
 	}
 	n.bootstrapState = Bootstrapping
+	nsCtx := namespace.Context{Schema: n.schemaDescr}
 	n.Unlock()
 
 	n.metrics.bootstrapStart.Inc(1)
@@ -848,7 +938,12 @@ func (n *dbNamespace) Bootstrap(start time.Time, process bootstrap.Process) erro
 				bootstrapped = result.NewMap(result.MapOptions{})
 			}
 
-			err := shard.Bootstrap(bootstrapped)
+			// Ensure deleted data bootstrapped from commit logs, snapshots
+			// or peers is not loaded back into memory.
+			err := n.tombstones.FilterBootstrapped(bootstrapped, nsCtx)
+			if err == nil {
+				err = shard.Bootstrap(bootstrapped)
+			}
 
 			mutex.Lock()
 			multiErr = multiErr.Add(err)
@@ -1031,6 +1126,78 @@ func (n *dbNamespace) Snapshot(
 	return res
 }
 
+func (n *dbNamespace) Downsample(
+	tickStart time.Time,
+	flushPersist persist.FlushPreparer,
+) error {
+	callStart := n.nowFn()
+
+	n.RLock()
+	if n.bootstrapState != Bootstrapped {
+		n.RUnlock()
+		n.metrics.downsample.ReportError(n.nowFn().Sub(callStart))
+		return errNamespaceNotBootstrapped
+	}
+	nsCtx := namespace.Context{Schema: n.schemaDescr}
+	n.RUnlock()
+
+	// Downsampling aggregates float datapoints so it does not apply to
+	// namespaces that encode values with a schema.
+	dsOpts := n.DownsampleOptions()
+	if !n.nopts.FlushEnabled() || len(dsOpts.Tiers()) == 0 || nsCtx.Schema != nil {
+		n.metrics.downsample.ReportSuccess(n.nowFn().Sub(callStart))
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	shards := n.GetOwnedShards()
+	for _, shard := range shards {
+		// NB: we still want to proceed if a shard fails to downsample its data.
+		if err := shard.Downsample(tickStart, flushPersist, dsOpts, nsCtx); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to downsample: %v", shard.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	res := multiErr.FinalError()
+	n.metrics.downsample.ReportSuccessOrError(res, n.nowFn().Sub(callStart))
+	return res
+}
+
+func (n *dbNamespace) ColdFlush(
+	flushPersist persist.FlushPreparer,
+) error {
+	callStart := n.nowFn()
+
+	n.RLock()
+	if n.bootstrapState != Bootstrapped {
+		n.RUnlock()
+		n.metrics.coldFlush.ReportError(n.nowFn().Sub(callStart))
+		return errNamespaceNotBootstrapped
+	}
+	nsCtx := namespace.Context{Schema: n.schemaDescr}
+	n.RUnlock()
+
+	if !n.nopts.FlushEnabled() || !n.nopts.ColdWritesEnabled() {
+		n.metrics.coldFlush.ReportSuccess(n.nowFn().Sub(callStart))
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	shards := n.GetOwnedShards()
+	for _, shard := range shards {
+		// NB: we still want to proceed if a shard fails to cold flush its data.
+		if err := shard.ColdFlush(flushPersist, nsCtx); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to cold flush: %v", shard.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	res := multiErr.FinalError()
+	n.metrics.coldFlush.ReportSuccessOrError(res, n.nowFn().Sub(callStart))
+	return res
+}
+
 func (n *dbNamespace) NeedsFlush(
 	alignedInclusiveStart time.Time, alignedInclusiveEnd time.Time) bool {
 	// NB(r): Essentially if all are success, we don't need to flush, if any
@@ -1134,6 +1301,80 @@ func (n *dbNamespace) Truncate() (int64, error) {
 	return totalNumSeries, nil
 }
 
+func (n *dbNamespace) DeleteTagged(
+	ctx context.Context,
+	query index.Query,
+	start, end time.Time,
+) (int64, error) {
+	callStart := n.nowFn()
+	if n.reverseIndex == nil { // only happens if indexing is enabled.
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return 0, errNamespaceIndexingDisabled
+	}
+
+	if !start.Before(end) {
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return 0, xerrors.NewInvalidParamsError(errDeleteTaggedInvalidTimeRange)
+	}
+
+	if n.reverseIndex.BootstrapsDone() < 1 {
+		// Similar to reading shard data, return not bootstrapped
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return 0, xerrors.NewRetryableError(errIndexNotBootstrappedToRead)
+	}
+
+	res, err := n.reverseIndex.Query(ctx, query, index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+	})
+	if err != nil {
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return 0, err
+	}
+
+	ids := make([]ident.ID, 0, res.Results.Size())
+	for _, entry := range res.Results.Map().Iter() {
+		ids = append(ids, entry.Key())
+	}
+
+	// Persist the tombstones before dropping any data so that a failure part
+	// way through never leaves deleted data able to be resurrected.
+	deletedAt := n.nowFn()
+	if err := n.tombstones.Add(ids, start, end, deletedAt); err != nil {
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return 0, err
+	}
+
+	var (
+		multiErr = xerrors.NewMultiError()
+		applied  = make([]ident.ID, 0, len(ids))
+	)
+	for _, id := range ids {
+		shard, nsCtx, err := n.shardFor(id)
+		if err != nil {
+			// The shard is no longer owned, the tombstone still masks any
+			// data for the series should it be reassigned.
+			applied = append(applied, id)
+			continue
+		}
+		if err := shard.DeleteSeries(id, start, end, nsCtx); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		applied = append(applied, id)
+	}
+	// Tombstones are only cleared once applied, those of series that failed
+	// to have their data dropped from memory keep masking data on disk.
+	n.tombstones.Applied(applied, deletedAt, n.nowFn())
+	if err := multiErr.FinalError(); err != nil {
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return 0, err
+	}
+
+	n.metrics.deleteTagged.ReportSuccess(n.nowFn().Sub(callStart))
+	return int64(len(ids)), nil
+}
+
 func (n *dbNamespace) Repair(
 	repairer databaseShardRepairer,
 	tr xtime.Range,
@@ -1293,7 +1534,7 @@ func (n *dbNamespace) initShards(needBootstrap bool) {
 	for _, shard := range shards {
 		dbShards[shard] = newDatabaseShard(n.metadata, shard, n.blockRetriever,
 			n.namespaceReaderMgr, n.increasingIndex, n.reverseIndex,
-			needBootstrap, n.opts, n.seriesOpts)
+			n.tombstones, needBootstrap, n.opts, n.seriesOpts)
 	}
 	n.shards = dbShards
 	n.Unlock()
@@ -1313,6 +1554,9 @@ func (n *dbNamespace) Close() error {
 	n.namespaceReaderMgr.close()
 	n.closeShards(shards, true)
 	close(n.shutdownCh)
+	if err := n.tombstones.Close(); err != nil {
+		n.log.Error("unable to close tombstones log", zap.Error(err))
+	}
 	if n.reverseIndex != nil {
 		return n.reverseIndex.Close()
 	}
@@ -24,8 +24,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/clock"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -52,6 +54,9 @@ import (
 // be called regularly in order to shrunk the closedReaders stack after bursts
 // of usage, as well as to expire cached open readers which have not been used
 // for a configurable number of ticks.
+// The latest volume index of each fileset is cached so that readers can be
+// opened without listing the fileset directory, shards update the cache as
+// they flush, cold flush or downsample a block into a new volume.
 
 const (
 	expireCachedReadersAfterNumTicks = 2
@@ -71,6 +76,17 @@ type databaseNamespaceReaderManager interface {
 
 	put(reader fs.DataFileSetReader)
 
+	latestVolume(
+		shard uint32,
+		blockStart time.Time,
+	) (int, bool, error)
+
+	updateLatestVolume(
+		shard uint32,
+		blockStart time.Time,
+		volume int,
+	)
+
 	tick()
 
 	close()
@@ -83,6 +99,13 @@ type fsFileSetExistsAtFn func(
 	blockStart time.Time,
 ) (bool, error)
 
+type fsFileSetAtFn func(
+	prefix string,
+	namespace ident.ID,
+	shard uint32,
+	blockStart time.Time,
+) (fs.FileSetFile, bool, error)
+
 type fsNewReaderFn func(
 	bytesPool pool.CheckedBytesPool,
 	opts fs.Options,
@@ -92,16 +115,20 @@ type namespaceReaderManager struct {
 	sync.Mutex
 
 	filesetExistsAtFn fsFileSetExistsAtFn
+	filesetAtFn       fsFileSetAtFn
 	newReaderFn       fsNewReaderFn
 
 	namespace namespace.Metadata
 	fsOpts    fs.Options
 	bytesPool pool.CheckedBytesPool
+	nowFn     clock.NowFn
 
 	logger *zap.Logger
 
 	closedReaders []cachedReader
 	openReaders   map[cachedOpenReaderKey]cachedReader
+	// latestVolumes only holds the volumes of filesets known to exist.
+	latestVolumes map[latestVolumeKey]int
 
 	metrics namespaceReaderManagerMetrics
 }
@@ -109,9 +136,15 @@ type namespaceReaderManager struct {
 type cachedOpenReaderKey struct {
 	shard      uint32
 	blockStart xtime.UnixNano
+	volume     int
 	position   readerPosition
 }
 
+type latestVolumeKey struct {
+	shard      uint32
+	blockStart xtime.UnixNano
+}
+
 type readerPosition struct {
 	dataIdx     int
 	metadataIdx int
@@ -150,12 +183,15 @@ func newNamespaceReaderManager(
 ) databaseNamespaceReaderManager {
 	return &namespaceReaderManager{
 		filesetExistsAtFn: fs.DataFileSetExistsAt,
+		filesetAtFn:       fs.FileSetAt,
 		newReaderFn:       fs.NewReader,
 		namespace:         namespace,
 		fsOpts:            opts.CommitLogOptions().FilesystemOptions(),
 		bytesPool:         opts.BytesPool(),
+		nowFn:             opts.ClockOptions().NowFn(),
 		logger:            opts.InstrumentOptions().Logger(),
 		openReaders:       make(map[cachedOpenReaderKey]cachedReader),
+		latestVolumes:     make(map[latestVolumeKey]int),
 		metrics:           newNamespaceReaderManagerMetrics(namespaceScope),
 	}
 }
@@ -168,6 +204,56 @@ func (m *namespaceReaderManager) filesetExistsAt(
 		m.namespace.ID(), shard, blockStart)
 }
 
+// latestVolume returns the latest volume index of the fileset of the shard
+// and block start and whether any volume exists, the fileset directory is
+// only listed the first time a fileset is looked up.
+func (m *namespaceReaderManager) latestVolume(
+	shard uint32,
+	blockStart time.Time,
+) (int, bool, error) {
+	key := latestVolumeKey{
+		shard:      shard,
+		blockStart: xtime.ToUnixNano(blockStart),
+	}
+
+	m.Lock()
+	volume, ok := m.latestVolumes[key]
+	m.Unlock()
+	if ok {
+		return volume, true, nil
+	}
+
+	latest, ok, err := m.filesetAtFn(m.fsOpts.FilePathPrefix(),
+		m.namespace.ID(), shard, blockStart)
+	if err != nil || !ok {
+		// NB: filesets that do not exist yet are not cached, they are added
+		// once flushed.
+		return 0, false, err
+	}
+
+	m.updateLatestVolume(shard, blockStart, latest.ID.VolumeIndex)
+	return latest.ID.VolumeIndex, true, nil
+}
+
+// updateLatestVolume records a volume of the fileset of the shard and block
+// start that has been completely written, volumes never go backwards.
+func (m *namespaceReaderManager) updateLatestVolume(
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+) {
+	key := latestVolumeKey{
+		shard:      shard,
+		blockStart: xtime.ToUnixNano(blockStart),
+	}
+
+	m.Lock()
+	if curr, ok := m.latestVolumes[key]; !ok || volume > curr {
+		m.latestVolumes[key] = volume
+	}
+	m.Unlock()
+}
+
 type cachedReaderForKeyResult struct {
 	openReader   fs.DataFileSetReader
 	closedReader fs.DataFileSetReader
@@ -234,9 +320,17 @@ func (m *namespaceReaderManager) get(
 	blockStart time.Time,
 	position readerPosition,
 ) (fs.DataFileSetReader, error) {
+	// Always read from the latest volume of the fileset, earlier volumes are
+	// superseded by the latest one once it is complete.
+	volume, _, err := m.latestVolume(shard, blockStart)
+	if err != nil {
+		return nil, err
+	}
+
 	key := cachedOpenReaderKey{
 		shard:      shard,
 		blockStart: xtime.ToUnixNano(blockStart),
+		volume:     volume,
 		position:   position,
 	}
 
@@ -253,9 +347,10 @@ func (m *namespaceReaderManager) get(
 	reader := lookup.closedReader
 	openOpts := fs.DataReaderOpenOptions{
 		Identifier: fs.FileSetFileIdentifier{
-			Namespace:  m.namespace.ID(),
-			Shard:      shard,
-			BlockStart: blockStart,
+			Namespace:   m.namespace.ID(),
+			Shard:       shard,
+			BlockStart:  blockStart,
+			VolumeIndex: volume,
 		},
 	}
 	if err := reader.Open(openOpts); err != nil {
@@ -303,6 +398,7 @@ func (m *namespaceReaderManager) put(reader fs.DataFileSetReader) {
 	key := cachedOpenReaderKey{
 		shard:      status.Shard,
 		blockStart: xtime.ToUnixNano(status.BlockStart),
+		volume:     status.Volume,
 		position: readerPosition{
 			dataIdx:     reader.EntriesRead(),
 			metadataIdx: reader.MetadataRead(),
@@ -325,6 +421,17 @@ func (m *namespaceReaderManager) put(reader fs.DataFileSetReader) {
 
 func (m *namespaceReaderManager) tick() {
 	m.tickWithThreshold(expireCachedReadersAfterNumTicks)
+
+	// Forget the volumes of filesets that have fallen out of retention.
+	earliest := xtime.ToUnixNano(retention.FlushTimeStart(
+		m.namespace.Options().RetentionOptions(), m.nowFn()))
+	m.Lock()
+	for key := range m.latestVolumes {
+		if key.blockStart < earliest {
+			delete(m.latestVolumes, key)
+		}
+	}
+	m.Unlock()
 }
 
 func (m *namespaceReaderManager) close() {
@@ -42,6 +42,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
 	xmetrics "github.com/m3db/m3/src/dbnode/x/metrics"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	xidx "github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/x/context"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -492,6 +493,30 @@ type snapshotTestCase struct {
 	shardSnapshotErr              error
 }
 
+func TestNamespaceSetDownsampleOptions(t *testing.T) {
+	ns, closer := newTestNamespace(t)
+	defer closer()
+
+	require.Equal(t, 0, len(ns.DownsampleOptions().Tiers()))
+
+	valid := namespace.NewDownsampleOptions().SetTiers([]namespace.DownsampleTier{{
+		Resolution:  30 * time.Minute,
+		After:       4 * time.Hour,
+		Aggregation: namespace.DownsampleMean,
+	}})
+	require.NoError(t, ns.SetDownsampleOptions(valid))
+	require.True(t, valid.Equal(ns.DownsampleOptions()))
+
+	// Tiers beyond the retention period of the namespace are rejected.
+	invalid := namespace.NewDownsampleOptions().SetTiers([]namespace.DownsampleTier{{
+		Resolution:  30 * time.Minute,
+		After:       defaultTestRetentionOpts.RetentionPeriod(),
+		Aggregation: namespace.DownsampleMean,
+	}})
+	require.Error(t, ns.SetDownsampleOptions(invalid))
+	require.True(t, valid.Equal(ns.DownsampleOptions()))
+}
+
 func TestNamespaceSnapshotNotBootstrapped(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1230,6 +1255,89 @@ func TestNamespaceIndexDisabledQuery(t *testing.T) {
 	require.NoError(t, ns.Close())
 }
 
+func TestNamespaceDeleteTagged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idx := NewMocknamespaceIndex(ctrl)
+	idx.EXPECT().BootstrapsDone().Return(uint(1))
+
+	ns, closer := newTestNamespaceWithIndex(t, idx)
+	defer closer()
+	ns.tombstones.log = &testTombstonesLog{}
+
+	var (
+		ctx       = context.NewContext()
+		blockSize = ns.Options().RetentionOptions().BlockSize()
+		start     = time.Now().Truncate(blockSize).Add(-2 * blockSize)
+		end       = start.Add(blockSize + time.Minute)
+		query     = index.Query{
+			Query: xidx.NewTermQuery([]byte("foo"), []byte("bar")),
+		}
+		results = index.NewQueryResults(ns.ID(), index.QueryResultsOptions{},
+			testDatabaseOptions().IndexOptions())
+	)
+	_, err := results.AddDocuments([]doc.Document{{ID: []byte("foo")}})
+	require.NoError(t, err)
+
+	idx.EXPECT().
+		Query(gomock.Any(), query, index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+		}).
+		Return(index.QueryResult{Results: results}, nil)
+
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().
+		DeleteSeries(ident.NewIDMatcher("foo"), start, end, gomock.Any()).
+		Return(nil)
+	ns.shards[testShardIDs[0].ID()] = shard
+
+	numSeries, err := ns.DeleteTagged(ctx, query, start, end)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), numSeries)
+
+	id := ident.StringID("foo")
+	deleted := ns.tombstones.DeletedRanges(id, start)
+	require.Equal(t, 1, len(deleted))
+	require.True(t, start.Equal(deleted[0].Start))
+	require.True(t, start.Add(blockSize).Equal(deleted[0].End))
+	deleted = ns.tombstones.DeletedRanges(id, start.Add(blockSize))
+	require.Equal(t, 1, len(deleted))
+	require.True(t, start.Add(blockSize).Equal(deleted[0].Start))
+	require.True(t, end.Equal(deleted[0].End))
+	require.Nil(t, ns.tombstones.DeletedRanges(id, start.Add(2*blockSize)))
+	require.Nil(t, ns.tombstones.DeletedRanges(ident.StringID("bar"), start))
+	require.True(t, ns.tombstones.IsDeletedRange(id, start, end))
+}
+
+func TestNamespaceDeleteTaggedInvalidTimeRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idx := NewMocknamespaceIndex(ctrl)
+	ns, closer := newTestNamespaceWithIndex(t, idx)
+	defer closer()
+
+	now := time.Now()
+	_, err := ns.DeleteTagged(context.NewContext(), index.Query{
+		Query: xidx.NewTermQuery([]byte("foo"), []byte("bar")),
+	}, now, now)
+	require.Error(t, err)
+	require.True(t, xerrors.IsInvalidParams(err))
+}
+
+func TestNamespaceIndexDisabledDeleteTagged(t *testing.T) {
+	ns, closer := newTestNamespace(t)
+	defer closer()
+
+	now := time.Now()
+	_, err := ns.DeleteTagged(context.NewContext(), index.Query{
+		Query: xidx.NewTermQuery([]byte("foo"), []byte("bar")),
+	}, now.Add(-time.Hour), now)
+	require.Equal(t, errNamespaceIndexingDisabled, err)
+}
+
 func TestNamespaceBootstrapState(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -92,6 +92,15 @@ type databaseBuffer interface {
 		nsCtx namespace.Context,
 	) (FlushOutcome, error)
 
+	ColdFlushBlockStarts(blockStates map[xtime.UnixNano]BlockState) []xtime.UnixNano
+
+	FetchBlocksForColdFlush(
+		ctx context.Context,
+		blockStart time.Time,
+		version int,
+		nsCtx namespace.Context,
+	) ([]xio.BlockReader, error)
+
 	ReadEncoded(
 		ctx context.Context,
 		start, end time.Time,
@@ -118,6 +127,8 @@ type databaseBuffer interface {
 
 	Bootstrap(bl block.DatabaseBlock)
 
+	DeleteRange(start, end time.Time, nsCtx namespace.Context) error
+
 	Reset(opts Options)
 }
 
@@ -301,7 +312,7 @@ func (b *dbBuffer) Tick(blockStates map[xtime.UnixNano]BlockState, nsCtx namespa
 		// collected in the next tick.
 		blockState := blockStates[tNano]
 		if blockState.Retrievable {
-			buckets.removeBucketsUpToVersion(blockState.Version)
+			removed := buckets.removeBucketsUpToVersion(blockState.Version)
 
 			if buckets.streamsLen() == 0 {
 				t := tNano.ToTime()
@@ -322,6 +333,13 @@ func (b *dbBuffer) Tick(blockStates map[xtime.UnixNano]BlockState, nsCtx namespa
 				evictedBucketTimes.add(tNano)
 				continue
 			}
+
+			if removed > 0 {
+				// Cold writes can keep arriving for a block while a previous
+				// set of them is persisted, so the cached block may be stale
+				// even though the buckets for the block remain.
+				evictedBucketTimes.add(tNano)
+			}
 		}
 
 		// Once we've evicted all eligible buckets, we merge duplicate encoders
@@ -347,6 +365,27 @@ func (b *dbBuffer) Bootstrap(bl block.DatabaseBlock) {
 	buckets.bootstrap(bl)
 }
 
+// DeleteRange removes all datapoints within [start, end), bucket versions
+// for blocks entirely within the range are removed altogether.
+func (b *dbBuffer) DeleteRange(start, end time.Time, nsCtx namespace.Context) error {
+	blockSize := b.opts.RetentionOptions().BlockSize()
+	for tNano, buckets := range b.bucketsMap {
+		blockStart := tNano.ToTime()
+		blockEnd := blockStart.Add(blockSize)
+		if !blockStart.Before(end) || !blockEnd.After(start) {
+			continue
+		}
+		if !blockStart.Before(start) && !blockEnd.After(end) {
+			b.removeBucketVersionsAt(blockStart)
+			continue
+		}
+		if err := buckets.deleteRange(start, end, nsCtx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *dbBuffer) Snapshot(
 	ctx context.Context,
 	blockStart time.Time,
@@ -458,7 +497,8 @@ func (b *dbBuffer) Flush(
 		// there be buckets for previous versions. In this case, we need to try
 		// to flush them again, so we merge them together to one stream and
 		// persist it.
-		encoder, _, err := mergeStreamsToEncoder(blockStart, streams, b.opts, nsCtx)
+		encoder, _, err := mergeStreamsToEncoder(blockStart, streams,
+			xtime.Range{}, b.opts, nsCtx)
 		if err != nil {
 			return FlushOutcomeErr, err
 		}
@@ -495,6 +535,58 @@ func (b *dbBuffer) Flush(
 	return FlushOutcomeFlushedToDisk, nil
 }
 
+func (b *dbBuffer) ColdFlushBlockStarts(
+	blockStates map[xtime.UnixNano]BlockState,
+) []xtime.UnixNano {
+	var res []xtime.UnixNano
+	for tNano, buckets := range b.bucketsMap {
+		// Cold writes can only be merged into a block that has already been
+		// flushed, until then they stay in the buffer.
+		blockState := blockStates[tNano]
+		if !blockState.Retrievable {
+			continue
+		}
+		if buckets.hasColdWritesPendingFlush(blockState.Version) {
+			res = append(res, tNano)
+		}
+	}
+	return res
+}
+
+func (b *dbBuffer) FetchBlocksForColdFlush(
+	ctx context.Context,
+	blockStart time.Time,
+	version int,
+	nsCtx namespace.Context,
+) ([]xio.BlockReader, error) {
+	buckets, exists := b.bucketVersionsAt(blockStart)
+	if !exists {
+		return nil, nil
+	}
+
+	// Merge the writable cold bucket to reduce the number of streams that
+	// need to be merged into the fileset.
+	if _, err := buckets.merge(ColdWrite, nsCtx); err != nil {
+		return nil, err
+	}
+
+	var res []xio.BlockReader
+	for _, bucket := range buckets.buckets {
+		if bucket.writeType != ColdWrite {
+			continue
+		}
+		// Buckets already tagged with this version belong to a previous
+		// cold flush that did not complete, so they need to be flushed again.
+		if bucket.version != writableBucketVer && bucket.version < version {
+			continue
+		}
+		res = append(res, bucket.streams(ctx)...)
+		bucket.version = version
+	}
+
+	return res, nil
+}
+
 func (b *dbBuffer) ReadEncoded(
 	ctx context.Context,
 	start time.Time,
@@ -796,26 +888,37 @@ func (b *BufferBucketVersions) merge(writeType WriteType, nsCtx namespace.Contex
 	return res, nil
 }
 
-func (b *BufferBucketVersions) removeBucketsUpToVersion(version int) {
-	// TODO(juchan): in order to support ColdWrites, we need to keep track of
-	// separate bucket versions for ColdWrites and WarmWrites, since they have
-	// different persist cycles. This will involve storing that state in the
-	// shard flush state management. That state will need to be passed down
-	// here so that this function will know which WriteType/version is safe to
-	// remove.
+func (b *BufferBucketVersions) deleteRange(
+	start, end time.Time,
+	nsCtx namespace.Context,
+) error {
+	deleted := xtime.Range{Start: start, End: end}
+	for _, bucket := range b.buckets {
+		if _, err := bucket.mergeExcluding(deleted, nsCtx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+// removeBucketsUpToVersion removes the buckets that have been persisted as of
+// the given version and returns the number of buckets removed. Warm and cold
+// flushes of a block share the flush version of the block, since each
+// successful flush of either kind produces a new version of the block on disk.
+func (b *BufferBucketVersions) removeBucketsUpToVersion(version int) int {
 	// Avoid allocating a new backing array.
 	nonEvictedBuckets := b.buckets[:0]
 
+	removed := 0
 	for _, bucket := range b.buckets {
 		bVersion := bucket.version
-		if bucket.writeType == WarmWrite && bVersion != writableBucketVer &&
-			bVersion <= version {
+		if bVersion != writableBucketVer && bVersion <= version {
 			// We no longer need to keep any version which is equal to
 			// or less than the retrievable version, since that means
 			// that the version has successfully persisted to disk.
 			// Bucket gets reset before use.
 			b.bucketPool.Put(bucket)
+			removed++
 			continue
 		}
 
@@ -823,6 +926,21 @@ func (b *BufferBucketVersions) removeBucketsUpToVersion(version int) {
 	}
 
 	b.buckets = nonEvictedBuckets
+	return removed
+}
+
+// hasColdWritesPendingFlush returns whether there are cold writes that have
+// not yet been persisted as of the given retrievable version.
+func (b *BufferBucketVersions) hasColdWritesPendingFlush(retrievableVersion int) bool {
+	for _, bucket := range b.buckets {
+		if bucket.writeType != ColdWrite || bucket.streamsLen() == 0 {
+			continue
+		}
+		if bucket.version == writableBucketVer || bucket.version > retrievableVersion {
+			return true
+		}
+	}
+	return false
 }
 
 func (b *BufferBucketVersions) setLastRead(value time.Time) {
@@ -1096,6 +1214,15 @@ func (b *BufferBucket) merge(nsCtx namespace.Context) (int, error) {
 		return 0, nil
 	}
 
+	return b.mergeExcluding(xtime.Range{}, nsCtx)
+}
+
+// mergeExcluding merges the encoders and bootstrapped blocks of the bucket
+// into a single encoder, dropping any datapoints within the excluded range.
+func (b *BufferBucket) mergeExcluding(
+	exclude xtime.Range,
+	nsCtx namespace.Context,
+) (int, error) {
 	var (
 		start   = b.start
 		readers = make([]xio.SegmentReader, 0, len(b.encoders)+len(b.bootstrapped))
@@ -1131,7 +1258,8 @@ func (b *BufferBucket) merge(nsCtx namespace.Context) (int, error) {
 		}
 	}
 
-	encoder, lastWriteAt, err := mergeStreamsToEncoder(start, readers, b.opts, nsCtx)
+	encoder, lastWriteAt, err := mergeStreamsToEncoder(start, readers,
+		exclude, b.opts, nsCtx)
 	if err != nil {
 		return 0, err
 	}
@@ -1147,12 +1275,13 @@ func (b *BufferBucket) merge(nsCtx namespace.Context) (int, error) {
 	return merges, nil
 }
 
-// mergeStreamsToEncoder merges streams to an encoder and returns the last
-// write time. It is the responsibility of the caller to close the returned
-// encoder when appropriate.
+// mergeStreamsToEncoder merges streams to an encoder, skipping datapoints
+// within the excluded range, and returns the last write time. It is the
+// responsibility of the caller to close the returned encoder when appropriate.
 func mergeStreamsToEncoder(
 	blockStart time.Time,
 	streams []xio.SegmentReader,
+	exclude xtime.Range,
 	opts Options,
 	nsCtx namespace.Context,
 ) (encoding.Encoder, time.Time, error) {
@@ -1166,6 +1295,9 @@ func mergeStreamsToEncoder(
 	iter.Reset(streams, blockStart, opts.RetentionOptions().BlockSize(), nsCtx.Schema)
 	for iter.Next() {
 		dp, unit, annotation := iter.Current()
+		if !dp.Timestamp.Before(exclude.Start) && dp.Timestamp.Before(exclude.End) {
+			continue
+		}
 		if err := encoder.Encode(dp, unit, annotation); err != nil {
 			encoder.Close()
 			return nil, timeZero, err
@@ -858,6 +858,56 @@ func TestBufferRemoveBucket(t *testing.T) {
 	assert.True(t, buffer.IsEmpty())
 }
 
+func TestBufferColdFlush(t *testing.T) {
+	opts := newBufferTestOptions().SetColdWritesEnabled(true)
+	rops := opts.RetentionOptions()
+	curr := time.Now().Truncate(rops.BlockSize())
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+	buffer := newDatabaseBuffer().(*dbBuffer)
+	buffer.Reset(opts)
+
+	verifyWriteToBuffer(t, buffer, value{curr, 1, xtime.Second, nil}, nil)
+
+	// Simulate a warm flush of the block.
+	buckets, exists := buffer.bucketVersionsAt(start)
+	require.True(t, exists)
+	bucket, exists := buckets.writableBucket(WarmWrite)
+	require.True(t, exists)
+	bucket.version = 1
+
+	// Write to the block after it has been flushed.
+	curr = curr.Add(2 * rops.BlockSize())
+	verifyWriteToBuffer(t, buffer, value{start.Add(mins(1)), 2, xtime.Second, nil}, nil)
+
+	startNano := xtime.ToUnixNano(start)
+	assert.Empty(t, buffer.ColdFlushBlockStarts(nil))
+	blockStates := map[xtime.UnixNano]BlockState{
+		startNano: BlockState{Retrievable: true, Version: 1},
+	}
+	assert.Equal(t, []xtime.UnixNano{startNano}, buffer.ColdFlushBlockStarts(blockStates))
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	readers, err := buffer.FetchBlocksForColdFlush(ctx, start, 2, namespace.Context{})
+	require.NoError(t, err)
+	require.Len(t, readers, 1)
+	requireReaderValuesEqual(t, []value{{start.Add(mins(1)), 2, xtime.Second, nil}},
+		[][]xio.BlockReader{readers}, opts, namespace.Context{})
+
+	// The cold writes remain pending until the new version is retrievable.
+	assert.Equal(t, []xtime.UnixNano{startNano}, buffer.ColdFlushBlockStarts(blockStates))
+	blockStates[startNano] = BlockState{Retrievable: true, Version: 2}
+	assert.Empty(t, buffer.ColdFlushBlockStarts(blockStates))
+
+	result := buffer.Tick(blockStates, namespace.Context{})
+	assert.True(t, result.evictedBucketTimes.contains(startNano))
+	assert.True(t, buffer.IsEmpty())
+}
+
 func TestBuffertoStream(t *testing.T) {
 	opts := newBufferTestOptions()
 
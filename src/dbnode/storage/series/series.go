@@ -419,6 +419,39 @@ func (s *dbSeries) Bootstrap(bootstrappedBlocks block.DatabaseSeriesBlocks) (Boo
 	return result, nil
 }
 
+func (s *dbSeries) DeleteRange(
+	start, end time.Time,
+	nsCtx namespace.Context,
+) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if err := s.buffer.DeleteRange(start, end, nsCtx); err != nil {
+		return err
+	}
+
+	// Cached blocks are only ever retrieved from disk, drop any overlapping
+	// the range so that they are retrieved again without the deleted data.
+	var (
+		cachePolicy = s.opts.CachePolicy()
+		blockSize   = s.opts.RetentionOptions().BlockSize()
+	)
+	for startNano, currBlock := range s.cachedBlocks.AllBlocks() {
+		blockStart := startNano.ToTime()
+		if !blockStart.Before(end) || !blockStart.Add(blockSize).After(start) {
+			continue
+		}
+		s.cachedBlocks.RemoveBlockAt(blockStart)
+		// Blocks retrieved from disk under the LRU policy are closed by the
+		// WiredList, see updateBlocksWithLock.
+		if cachePolicy == CacheLRU && currBlock.WasRetrievedFromDisk() {
+			continue
+		}
+		currBlock.Close()
+	}
+	return nil
+}
+
 func (s *dbSeries) OnRetrieveBlock(
 	id ident.ID,
 	tags ident.TagIterator,
@@ -534,6 +567,33 @@ func (s *dbSeries) Flush(
 	return s.buffer.Flush(ctx, blockStart, s.id, s.tags, persistFn, version, nsCtx)
 }
 
+func (s *dbSeries) ColdFlushBlockStarts(
+	blockStates map[xtime.UnixNano]BlockState,
+) []xtime.UnixNano {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.buffer.ColdFlushBlockStarts(blockStates)
+}
+
+func (s *dbSeries) FetchBlocksForColdFlush(
+	ctx context.Context,
+	blockStart time.Time,
+	version int,
+	nsCtx namespace.Context,
+) ([]xio.BlockReader, error) {
+	// Need a write lock because fetching the cold flush blocks marks the
+	// buffer buckets with the version they are being persisted as.
+	s.Lock()
+	defer s.Unlock()
+
+	if s.bs != bootstrapped {
+		return nil, errSeriesNotBootstrapped
+	}
+
+	return s.buffer.FetchBlocksForColdFlush(ctx, blockStart, version, nsCtx)
+}
+
 func (s *dbSeries) Snapshot(
 	ctx context.Context,
 	blockStart time.Time,
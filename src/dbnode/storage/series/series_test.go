@@ -132,6 +132,62 @@ func TestSeriesWriteFlush(t *testing.T) {
 	requireSegmentValuesEqual(t, data[:2], streams, opts, namespace.Context{})
 }
 
+func TestSeriesDeleteRange(t *testing.T) {
+	opts := newSeriesTestOptions()
+	blockSize := opts.RetentionOptions().BlockSize()
+	curr := time.Now().Truncate(blockSize)
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+	series := NewDatabaseSeries(ident.StringID("foo"), ident.Tags{}, opts).(*dbSeries)
+	_, err := series.Bootstrap(nil)
+	assert.NoError(t, err)
+
+	data := []value{
+		{curr, 1, xtime.Second, nil},
+		{curr.Add(mins(1)), 2, xtime.Second, nil},
+		{curr.Add(mins(2)), 3, xtime.Second, nil},
+	}
+	for _, v := range data {
+		curr = v.timestamp
+		verifyWriteToSeries(t, series, v)
+	}
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	// Deleting a range that does not overlap the block leaves it untouched.
+	nsCtx := namespace.Context{}
+	require.NoError(t, series.DeleteRange(start.Add(blockSize),
+		start.Add(2*blockSize), nsCtx))
+	results, err := series.ReadEncoded(ctx, start, start.Add(blockSize), nsCtx)
+	require.NoError(t, err)
+	requireReaderValuesEqual(t, data, results, opts, nsCtx)
+
+	// Deleting part of the block only drops the datapoints within the range.
+	require.NoError(t, series.DeleteRange(start.Add(mins(1)),
+		start.Add(mins(2)), nsCtx))
+	results, err = series.ReadEncoded(ctx, start, start.Add(blockSize), nsCtx)
+	require.NoError(t, err)
+	requireReaderValuesEqual(t, []value{data[0], data[2]}, results, opts, nsCtx)
+
+	// Writes after the delete are kept.
+	verifyWriteToSeries(t, series, data[1])
+	results, err = series.ReadEncoded(ctx, start, start.Add(blockSize), nsCtx)
+	require.NoError(t, err)
+	requireReaderValuesEqual(t, data, results, opts, nsCtx)
+
+	// Deleting the whole block drops the block.
+	require.NoError(t, series.DeleteRange(start, start.Add(blockSize), nsCtx))
+	_, exists := series.buffer.(*dbBuffer).bucketVersionsAt(start)
+	require.False(t, exists)
+
+	results, err = series.ReadEncoded(ctx, start, start.Add(blockSize), nsCtx)
+	require.NoError(t, err)
+	require.Len(t, results, 0)
+}
+
 func TestSeriesSamePointDoesNotWrite(t *testing.T) {
 	opts := newSeriesTestOptions()
 	rops := opts.RetentionOptions()
@@ -95,6 +95,9 @@ type DatabaseSeries interface {
 	// Bootstrap merges the raw series bootstrapped along with any buffered data.
 	Bootstrap(blocks block.DatabaseSeriesBlocks) (BootstrapResult, error)
 
+	// DeleteRange drops any buffered and cached data within [start, end).
+	DeleteRange(start, end time.Time, nsCtx namespace.Context) error
+
 	// Flush flushes the data blocks of this series for a given start time
 	Flush(
 		ctx context.Context,
@@ -104,6 +107,21 @@ type DatabaseSeries interface {
 		nsCtx namespace.Context,
 	) (FlushOutcome, error)
 
+	// ColdFlushBlockStarts returns the block starts with cold writes that
+	// have not yet been persisted, limited to blocks that are retrievable.
+	ColdFlushBlockStarts(blockStates map[xtime.UnixNano]BlockState) []xtime.UnixNano
+
+	// FetchBlocksForColdFlush returns the cold writes for a block start that
+	// have not yet been persisted and marks them as persisted as the given
+	// version, once the block is retrievable at that version they are evicted
+	// from the buffer.
+	FetchBlocksForColdFlush(
+		ctx context.Context,
+		blockStart time.Time,
+		version int,
+		nsCtx namespace.Context,
+	) ([]xio.BlockReader, error)
+
 	// Snapshot snapshots the buffer buckets of this series for any data that has
 	// not been rotated into a block yet
 	Snapshot(
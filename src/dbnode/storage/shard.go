@@ -21,15 +21,19 @@
 package storage
 
 import (
+	"bytes"
 	"container/list"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/generated/proto/pagetoken"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
@@ -66,6 +70,7 @@ const (
 var (
 	errShardEntryNotFound                  = errors.New("shard entry not found")
 	errShardNotOpen                        = errors.New("shard is not open")
+	errShardNotBootstrappedToDownsample    = errors.New("shard is not bootstrapped to downsample")
 	errShardAlreadyTicking                 = errors.New("shard is already ticking")
 	errShardClosingTickTerminated          = errors.New("shard is closing, terminating tick")
 	errShardInvalidPageToken               = errors.New("shard could not unmarshal page token")
@@ -80,6 +85,12 @@ type filesetBeforeFn func(
 	t time.Time,
 ) ([]string, error)
 
+type filesetsSupersededFn func(
+	filePathPrefix string,
+	namespace ident.ID,
+	shardID uint32,
+) ([]string, error)
+
 type tickPolicy int
 
 const (
@@ -144,13 +155,16 @@ type dbShard struct {
 	increasingIndex          increasingIndex
 	seriesPool               series.DatabaseSeriesPool
 	reverseIndex             namespaceIndex
+	tombstones               *seriesTombstones
 	insertQueue              *dbShardInsertQueue
 	lookup                   *shardMap
 	list                     *list.List
 	bootstrapState           BootstrapState
 	filesetBeforeFn          filesetBeforeFn
+	filesetsSupersededFn     filesetsSupersededFn
 	deleteFilesFn            deleteFilesFn
 	snapshotFilesFn          snapshotFilesFn
+	newReaderFn              fsNewReaderFn
 	sleepFn                  func(time.Duration)
 	identifierPool           ident.Pool
 	contextPool              context.Pool
@@ -188,10 +202,18 @@ type dbShardMetrics struct {
 	seriesBootstrapBlocksToBuffer tally.Counter
 	seriesBootstrapBlocksMerged   tally.Counter
 	seriesTicked                  tally.Gauge
+	coldFlushSuccess              tally.Counter
+	coldFlushErrors               tally.Counter
+	coldFlushBlocks               tally.Counter
+	coldFlushSeries               tally.Counter
+	coldFlushLatency              tally.Timer
 }
 
 func newDatabaseShardMetrics(shardID uint32, scope tally.Scope) dbShardMetrics {
 	seriesBootstrapScope := scope.SubScope("series-bootstrap")
+	coldFlushScope := scope.SubScope("cold-flush").Tagged(map[string]string{
+		"shard": fmt.Sprintf("%d", shardID),
+	})
 	return dbShardMetrics{
 		create:       scope.Counter("create"),
 		close:        scope.Counter("close"),
@@ -211,6 +233,11 @@ func newDatabaseShardMetrics(shardID uint32, scope tally.Scope) dbShardMetrics {
 		seriesTicked: scope.Tagged(map[string]string{
 			"shard": fmt.Sprintf("%d", shardID),
 		}).Gauge("series-ticked"),
+		coldFlushSuccess: coldFlushScope.Counter("success"),
+		coldFlushErrors:  coldFlushScope.Counter("errors"),
+		coldFlushBlocks:  coldFlushScope.Counter("blocks"),
+		coldFlushSeries:  coldFlushScope.Counter("series"),
+		coldFlushLatency: coldFlushScope.Timer("latency"),
 	}
 }
 
@@ -244,6 +271,7 @@ func newDatabaseShard(
 	namespaceReaderMgr databaseNamespaceReaderManager,
 	increasingIndex increasingIndex,
 	reverseIndex namespaceIndex,
+	tombstones *seriesTombstones,
 	needsBootstrap bool,
 	opts Options,
 	seriesOpts series.Options,
@@ -252,28 +280,31 @@ func newDatabaseShard(
 		SubScope("dbshard")
 
 	s := &dbShard{
-		opts:               opts,
-		seriesOpts:         seriesOpts,
-		nowFn:              opts.ClockOptions().NowFn(),
-		state:              dbShardStateOpen,
-		namespace:          namespaceMetadata,
-		shard:              shard,
-		namespaceReaderMgr: namespaceReaderMgr,
-		increasingIndex:    increasingIndex,
-		seriesPool:         opts.DatabaseSeriesPool(),
-		reverseIndex:       reverseIndex,
-		lookup:             newShardMap(shardMapOptions{}),
-		list:               list.New(),
-		filesetBeforeFn:    fs.DataFileSetsBefore,
-		deleteFilesFn:      fs.DeleteFiles,
-		snapshotFilesFn:    fs.SnapshotFiles,
-		sleepFn:            time.Sleep,
-		identifierPool:     opts.IdentifierPool(),
-		contextPool:        opts.ContextPool(),
-		flushState:         newShardFlushState(),
-		tickWg:             &sync.WaitGroup{},
-		logger:             opts.InstrumentOptions().Logger(),
-		metrics:            newDatabaseShardMetrics(shard, scope),
+		opts:                 opts,
+		seriesOpts:           seriesOpts,
+		nowFn:                opts.ClockOptions().NowFn(),
+		state:                dbShardStateOpen,
+		namespace:            namespaceMetadata,
+		shard:                shard,
+		namespaceReaderMgr:   namespaceReaderMgr,
+		increasingIndex:      increasingIndex,
+		seriesPool:           opts.DatabaseSeriesPool(),
+		reverseIndex:         reverseIndex,
+		tombstones:           tombstones,
+		lookup:               newShardMap(shardMapOptions{}),
+		list:                 list.New(),
+		filesetBeforeFn:      fs.DataFileSetsBefore,
+		filesetsSupersededFn: fs.DataFileSetsSuperseded,
+		deleteFilesFn:        fs.DeleteFiles,
+		snapshotFilesFn:      fs.SnapshotFiles,
+		newReaderFn:          fs.NewReader,
+		sleepFn:              time.Sleep,
+		identifierPool:       opts.IdentifierPool(),
+		contextPool:          opts.ContextPool(),
+		flushState:           newShardFlushState(),
+		tickWg:               &sync.WaitGroup{},
+		logger:               opts.InstrumentOptions().Logger(),
+		metrics:              newDatabaseShardMetrics(shard, scope),
 	}
 	s.insertQueue = newDatabaseShardInsertQueue(s.insertSeriesBatch,
 		s.nowFn, scope)
@@ -341,7 +372,33 @@ func (s *dbShard) Stream(
 	onRetrieve block.OnRetrieveBlock,
 	nsCtx namespace.Context,
 ) (xio.BlockReader, error) {
-	return s.DatabaseBlockRetriever.Stream(ctx, s.shard, id, blockStart, onRetrieve, nsCtx)
+	deleted := s.tombstones.DeletedRanges(id, blockStart)
+	if len(deleted) == 0 {
+		return s.DatabaseBlockRetriever.Stream(ctx, s.shard, id, blockStart, onRetrieve, nsCtx)
+	}
+
+	// Data of the block has been deleted but not yet dropped from disk, do
+	// not cache the block retrieved as cached blocks are not filtered.
+	reader, err := s.DatabaseBlockRetriever.Stream(ctx, s.shard, id, blockStart, nil, nsCtx)
+	if err != nil || reader.IsEmpty() {
+		return reader, err
+	}
+	segment, err := dropDeleted(reader, deleted, s.opts, nsCtx)
+	if err != nil {
+		return xio.EmptyBlockReader, err
+	}
+	if segment.Len() == 0 {
+		segment.Finalize()
+		return xio.EmptyBlockReader, nil
+	}
+	segmentReader := s.opts.SegmentReaderPool().Get()
+	segmentReader.Reset(segment)
+	ctx.RegisterFinalizer(segmentReader)
+	return xio.BlockReader{
+		SegmentReader: segmentReader,
+		Start:         reader.Start,
+		BlockSize:     reader.BlockSize,
+	}, nil
 }
 
 // IsBlockRetrievable implements series.QueryableBlockRetriever
@@ -1453,6 +1510,29 @@ func (s *dbShard) FetchBlocks(
 	return reader.FetchBlocks(ctx, starts, nsCtx)
 }
 
+func (s *dbShard) DeleteSeries(
+	id ident.ID,
+	start, end time.Time,
+	nsCtx namespace.Context,
+) error {
+	s.RLock()
+	entry, _, err := s.lookupEntryWithLock(id)
+	if entry != nil {
+		// Ensure the series is not expired and returned to the pool
+		// while its blocks are being dropped.
+		entry.IncrementReaderWriterCount()
+		defer entry.DecrementReaderWriterCount()
+	}
+	s.RUnlock()
+
+	if err != nil || entry == nil {
+		// Nothing in memory to drop.
+		return nil
+	}
+
+	return entry.Series.DeleteRange(start, end, nsCtx)
+}
+
 func (s *dbShard) fetchActiveBlocksMetadata(
 	ctx context.Context,
 	start, end time.Time,
@@ -1875,6 +1955,7 @@ func (s *dbShard) Flush(
 		// racing competing processes.
 		DeleteIfExists: false,
 	}
+	flushStart := s.nowFn()
 	prepared, err := flushPreparer.PrepareData(prepareOpts)
 	if err != nil {
 		return s.markFlushStateSuccessOrError(blockStart, 0, err)
@@ -1911,7 +1992,143 @@ func (s *dbShard) Flush(
 		multiErr = multiErr.Add(err)
 	}
 
-	return s.markFlushStateSuccessOrError(blockStart, version, multiErr.FinalError())
+	err = s.markFlushStateSuccessOrError(blockStart, version, multiErr.FinalError())
+	if err != nil {
+		return err
+	}
+	s.namespaceReaderMgr.updateLatestVolume(s.shard, blockStart, 0)
+
+	// Deleted data is dropped from memory once a delete is applied, so the
+	// flushed block holds none of the data deleted before the flush started.
+	return s.tombstones.ClearRewritten(s.shard, blockStart, flushStart)
+}
+
+func (s *dbShard) ColdFlush(
+	flushPreparer persist.FlushPreparer,
+	nsCtx namespace.Context,
+) error {
+	// We don't flush data when the shard is still bootstrapping.
+	s.RLock()
+	if s.bootstrapState != Bootstrapped {
+		s.RUnlock()
+		return errShardNotBootstrappedToFlush
+	}
+	s.RUnlock()
+
+	start := s.nowFn()
+	coldEntries := s.coldFlushEntries(s.BlockStatesSnapshot())
+	defer func() {
+		for _, entries := range coldEntries {
+			for _, entry := range entries {
+				entry.DecrementReaderWriterCount()
+			}
+		}
+	}()
+	if len(coldEntries) == 0 {
+		return nil
+	}
+
+	blockStarts := make([]xtime.UnixNano, 0, len(coldEntries))
+	for blockStart := range coldEntries {
+		blockStarts = append(blockStarts, blockStart)
+	}
+	sort.Slice(blockStarts, func(i, j int) bool {
+		return blockStarts[i] < blockStarts[j]
+	})
+
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	reader, err := s.newReaderFn(s.opts.BytesPool(), fsOpts)
+	if err != nil {
+		return err
+	}
+	merger := fs.NewMerger(reader, s.opts.DatabaseBlockOptions().DatabaseBlockAllocSize(),
+		s.opts.SegmentReaderPool(), s.opts.MultiReaderIteratorPool(),
+		s.identifierPool, s.opts.EncoderPool(), fsOpts.FilePathPrefix())
+
+	multiErr := xerrors.NewMultiError()
+	for _, blockStart := range blockStarts {
+		err := s.coldFlushBlock(merger, blockStart.ToTime(),
+			coldEntries[blockStart], flushPreparer, nsCtx)
+		if err != nil {
+			s.metrics.coldFlushErrors.Inc(1)
+			multiErr = multiErr.Add(fmt.Errorf(
+				"failed to cold flush block %v: %v", blockStart.ToTime(), err))
+			continue
+		}
+		s.metrics.coldFlushSuccess.Inc(1)
+	}
+	s.metrics.coldFlushLatency.Record(s.nowFn().Sub(start))
+
+	return multiErr.FinalError()
+}
+
+// coldFlushEntries returns the series with cold writes pending a flush for
+// each retrievable block start. A reference is held on each of the entries
+// returned so they are not purged while being flushed, callers must release
+// them once done.
+func (s *dbShard) coldFlushEntries(
+	blockStates map[xtime.UnixNano]series.BlockState,
+) map[xtime.UnixNano][]*lookup.Entry {
+	coldEntries := make(map[xtime.UnixNano][]*lookup.Entry)
+	s.forEachShardEntry(func(entry *lookup.Entry) bool {
+		for _, blockStart := range entry.Series.ColdFlushBlockStarts(blockStates) {
+			entry.IncrementReaderWriterCount()
+			coldEntries[blockStart] = append(coldEntries[blockStart], entry)
+		}
+		return true
+	})
+	return coldEntries
+}
+
+func (s *dbShard) coldFlushBlock(
+	merger fs.Merger,
+	blockStart time.Time,
+	entries []*lookup.Entry,
+	flushPreparer persist.FlushPreparer,
+	nsCtx namespace.Context,
+) error {
+	// NB: a flushed block without a fileset, e.g. one that was flushed
+	// before any of its data arrived, is written as its first volume.
+	nextVolume := 0
+	latest, ok, err := s.namespaceReaderMgr.latestVolume(s.shard, blockStart)
+	if err != nil {
+		return err
+	}
+	if ok {
+		nextVolume = latest + 1
+	}
+	fileID := fs.FileSetFileIdentifier{
+		Namespace:   s.namespace.ID(),
+		Shard:       s.ID(),
+		BlockStart:  blockStart,
+		VolumeIndex: latest,
+	}
+
+	// Cold writes are marked as persisted with the next version of the block,
+	// they are evicted from the buffer once that version is retrievable.
+	var (
+		mergeStart = s.nowFn()
+		version    = s.RetrievableBlockVersion(blockStart) + 1
+		mergeWith  = newFSMergeWithMem(entries, version)
+		deleted    = func(id ident.ID) fs.DeletedRanges {
+			return s.tombstones.DeletedRanges(id, blockStart)
+		}
+	)
+	err = merger.Merge(fileID, mergeWith, deleted, nextVolume,
+		flushPreparer, s.namespace)
+	if err != nil {
+		// The block remains retrievable from the previous volume, the cold
+		// writes remain in the buffer and are retried on the next cold flush.
+		return err
+	}
+
+	s.metrics.coldFlushBlocks.Inc(1)
+	s.metrics.coldFlushSeries.Inc(int64(mergeWith.numSeriesMerged))
+	s.namespaceReaderMgr.updateLatestVolume(s.shard, blockStart, nextVolume)
+	s.markFlushStateSuccess(blockStart, version)
+
+	// Deleted data on disk was dropped by the merge.
+	return s.tombstones.ClearRewritten(s.shard, blockStart, mergeStart)
 }
 
 func (s *dbShard) Snapshot(
@@ -1982,6 +2199,256 @@ func (s *dbShard) Snapshot(
 	return multiErr.FinalError()
 }
 
+func (s *dbShard) Downsample(
+	tickStart time.Time,
+	flushPreparer persist.FlushPreparer,
+	dsOpts namespace.DownsampleOptions,
+	nsCtx namespace.Context,
+) error {
+	s.RLock()
+	if s.bootstrapState != Bootstrapped {
+		s.RUnlock()
+		return errShardNotBootstrappedToDownsample
+	}
+	s.RUnlock()
+
+	var (
+		nsOpts    = s.namespace.Options()
+		blockSize = nsOpts.RetentionOptions().BlockSize()
+		fsOpts    = s.opts.CommitLogOptions().FilesystemOptions()
+		multiErr  = xerrors.NewMultiError()
+	)
+
+	// Blocks with cold writes pending a flush are downsampled once the cold
+	// writes have been merged into the fileset.
+	pendingColdFlush := make(map[xtime.UnixNano]struct{})
+	if nsOpts.ColdWritesEnabled() {
+		blockStates := s.BlockStatesSnapshot()
+		s.forEachShardEntry(func(entry *lookup.Entry) bool {
+			for _, blockStart := range entry.Series.ColdFlushBlockStarts(blockStates) {
+				pendingColdFlush[blockStart] = struct{}{}
+			}
+			return true
+		})
+	}
+
+	infoFiles := fs.ReadInfoFiles(fsOpts.FilePathPrefix(), s.namespace.ID(),
+		s.ID(), fsOpts.InfoReaderBufferSize(), fsOpts.DecodingOptions())
+	for _, result := range infoFiles {
+		if err := result.Err.Error(); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		blockStart := xtime.FromNanoseconds(result.Info.BlockStart)
+		tier, ok := dsOpts.TierAt(blockStart.Add(blockSize), tickStart)
+		if !ok {
+			continue
+		}
+		fromResolution := time.Duration(result.Info.DownsampleResolution)
+		if fromResolution >= tier.Resolution {
+			// Already downsampled to this tier.
+			continue
+		}
+		if s.FlushState(blockStart).Status != fileOpSuccess {
+			// Only downsample blocks that have been completely flushed.
+			continue
+		}
+		if _, ok := pendingColdFlush[xtime.ToUnixNano(blockStart)]; ok {
+			continue
+		}
+
+		err := s.downsampleBlock(blockStart, result.ID.VolumeIndex, fromResolution,
+			tier, flushPreparer, nsCtx)
+		if err != nil {
+			multiErr = multiErr.Add(fmt.Errorf(
+				"failed to downsample block %v: %v", blockStart, err))
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+// downsampledSeries is a series persisted to a downsampled volume, its ID and
+// tags are retained until the volume is closed since they are written to the
+// index of the volume when it is closed.
+type downsampledSeries struct {
+	id   ident.ID
+	tags ident.Tags
+}
+
+func (s *dbShard) downsampleBlock(
+	blockStart time.Time,
+	volume int,
+	fromResolution time.Duration,
+	tier namespace.DownsampleTier,
+	flushPreparer persist.FlushPreparer,
+	nsCtx namespace.Context,
+) error {
+	var (
+		downsampleStart = s.nowFn()
+		fsOpts          = s.opts.CommitLogOptions().FilesystemOptions()
+	)
+	reader, err := s.newReaderFn(s.opts.BytesPool(), fsOpts)
+	if err != nil {
+		return err
+	}
+	openOpts := fs.DataReaderOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   s.namespace.ID(),
+			Shard:       s.ID(),
+			BlockStart:  blockStart,
+			VolumeIndex: volume,
+		},
+		FileSetType: persist.FileSetFlushType,
+	}
+	if err := reader.Open(openOpts); err != nil {
+		return err
+	}
+
+	prepareOpts := persist.DataPrepareOptions{
+		NamespaceMetadata:    s.namespace,
+		Shard:                s.ID(),
+		BlockStart:           blockStart,
+		VolumeIndex:          volume + 1,
+		FileSetType:          persist.FileSetFlushType,
+		DownsampleResolution: tier.Resolution,
+	}
+	prepared, err := flushPreparer.PrepareData(prepareOpts)
+	if err != nil {
+		reader.Close()
+		return err
+	}
+
+	// NB: Series are read, downsampled and persisted one at a time, the same
+	// as when merging, so that only a single series is held in memory.
+	var multiErr xerrors.MultiError
+	persisted, err := s.downsampleBlockSeries(reader, prepared, blockStart,
+		fromResolution, tier, nsCtx)
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	if err := reader.Close(); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	if err := prepared.Close(); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	for _, series := range persisted {
+		series.id.Finalize()
+		series.tags.Finalize()
+	}
+
+	if err := multiErr.FinalError(); err != nil {
+		// The block remains retrievable from the previous volume, make sure
+		// the partially downsampled volume does not supersede it.
+		prefix := fsOpts.FilePathPrefix()
+		if exists, _ := fs.DataFileSetVolumeExistsAt(prefix, s.namespace.ID(),
+			s.ID(), blockStart, volume+1); exists {
+			if delErr := fs.DeleteFileSetAt(prefix, s.namespace.ID(), s.ID(),
+				blockStart, volume+1); delErr != nil {
+				multiErr = multiErr.Add(delErr)
+			}
+		}
+		return multiErr.FinalError()
+	}
+
+	// Bump the version so any blocks cached from the previous fileset are
+	// no longer considered valid.
+	version := s.RetrievableBlockVersion(blockStart) + 1
+	s.namespaceReaderMgr.updateLatestVolume(s.shard, blockStart, volume+1)
+	s.markFlushStateSuccess(blockStart, version)
+
+	// Deleted data was dropped when downsampling.
+	return s.tombstones.ClearRewritten(s.shard, blockStart, downsampleStart)
+}
+
+// downsampleBlockSeries downsamples and persists each series read from the
+// reader and returns the series persisted, which must be finalized once the
+// prepared volume is closed.
+func (s *dbShard) downsampleBlockSeries(
+	reader fs.DataFileSetReader,
+	prepared persist.PreparedDataPersist,
+	blockStart time.Time,
+	fromResolution time.Duration,
+	tier namespace.DownsampleTier,
+	nsCtx namespace.Context,
+) ([]downsampledSeries, error) {
+	var (
+		allocSize = s.opts.DatabaseBlockOptions().DatabaseBlockAllocSize()
+		iter      = s.opts.ReaderIteratorPool().Get()
+		encoder   = s.opts.EncoderPool().Get()
+		persisted []downsampledSeries
+	)
+	defer func() {
+		iter.Close()
+		encoder.Close()
+	}()
+
+	for {
+		id, tagsIter, data, _, err := reader.Read()
+		if err == io.EOF {
+			return persisted, nil
+		}
+		if err != nil {
+			return persisted, err
+		}
+
+		tags, err := convert.TagsFromTagsIter(id, tagsIter, s.identifierPool)
+		tagsIter.Close()
+		if err != nil {
+			id.Finalize()
+			data.Finalize()
+			return persisted, err
+		}
+
+		encoder.Reset(blockStart, allocSize, nsCtx.Schema)
+
+		data.IncRef()
+		iter.Reset(bytes.NewReader(data.Bytes()), nsCtx.Schema)
+		filtered := deletedFilterIterator{
+			Iterator: iter,
+			deleted:  s.tombstones.DeletedRanges(id, blockStart),
+		}
+		n, err := downsampleSeries(filtered, encoder, tier, fromResolution)
+		data.DecRef()
+		data.Finalize()
+		if err == nil && n > 0 {
+			err = persistEncoded(prepared, id, tags, encoder)
+		}
+		if err != nil || n == 0 {
+			id.Finalize()
+			tags.Finalize()
+			if err != nil {
+				return persisted, err
+			}
+			continue
+		}
+		persisted = append(persisted, downsampledSeries{id: id, tags: tags})
+	}
+}
+
+// persistEncoded persists the data encoded by the encoder.
+func persistEncoded(
+	prepared persist.PreparedDataPersist,
+	id ident.ID,
+	tags ident.Tags,
+	encoder encoding.Encoder,
+) error {
+	stream, ok := encoder.Stream(encoding.StreamOptions{})
+	if !ok {
+		return nil
+	}
+	defer stream.Finalize()
+
+	segment, err := stream.Segment()
+	if err != nil {
+		return err
+	}
+	checksum := digest.SegmentChecksum(segment)
+	return prepared.Persist(id, tags, segment, checksum)
+}
+
 func (s *dbShard) FlushState(blockStart time.Time) fileOpState {
 	s.flushState.RLock()
 	defer s.flushState.RUnlock()
@@ -2064,6 +2531,16 @@ func (s *dbShard) CleanupExpiredFileSets(earliestToRetain time.Time) error {
 				filePathPrefix, s.namespace.ID(), s.ID(), err)
 		multiErr = multiErr.Add(detailedErr)
 	}
+	// Volumes superseded by a later volume of the same block are no longer
+	// read from and can be removed along with the expired filesets.
+	superseded, err := s.filesetsSupersededFn(filePathPrefix, s.namespace.ID(), s.ID())
+	if err != nil {
+		detailedErr :=
+			fmt.Errorf("encountered errors when getting superseded fileset files for prefix %s namespace %s shard %d: %v",
+				filePathPrefix, s.namespace.ID(), s.ID(), err)
+		multiErr = multiErr.Add(detailedErr)
+	}
+	expired = append(expired, superseded...)
 	if err := s.deleteFilesFn(expired); err != nil {
 		multiErr = multiErr.Add(err)
 	}
@@ -21,8 +21,11 @@
 package storage
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -30,7 +33,11 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/storage/block"
@@ -79,7 +86,7 @@ func testDatabaseShardWithIndexFn(
 		SetBufferBucketVersionsPool(series.NewBufferBucketVersionsPool(nil)).
 		SetBufferBucketPool(series.NewBufferBucketPool(nil))
 	return newDatabaseShard(metadata, 0, nil, nsReaderMgr,
-		&testIncreasingIndex{}, idx, true, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, idx, nil, true, opts, seriesOpts).(*dbShard)
 }
 
 func addMockSeries(ctrl *gomock.Controller, shard *dbShard, id ident.ID, tags ident.Tags, index uint64) *series.MockDatabaseSeries {
@@ -99,7 +106,7 @@ func TestShardDontNeedBootstrap(t *testing.T) {
 	defer closer()
 	seriesOpts := NewSeriesOptionsFromOptions(opts, testNs.Options().RetentionOptions())
 	shard := newDatabaseShard(testNs.metadata, 0, nil, nil,
-		&testIncreasingIndex{}, nil, false, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, nil, nil, false, opts, seriesOpts).(*dbShard)
 	defer shard.Close()
 
 	require.Equal(t, Bootstrapped, shard.bootstrapState)
@@ -112,7 +119,7 @@ func TestShardBootstrapState(t *testing.T) {
 	defer closer()
 	seriesOpts := NewSeriesOptionsFromOptions(opts, testNs.Options().RetentionOptions())
 	shard := newDatabaseShard(testNs.metadata, 0, nil, nil,
-		&testIncreasingIndex{}, nil, false, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, nil, nil, false, opts, seriesOpts).(*dbShard)
 	defer shard.Close()
 
 	require.Equal(t, Bootstrapped, shard.bootstrapState)
@@ -1004,8 +1011,278 @@ func TestShardCleanupExpiredFileSets(t *testing.T) {
 		deletedFiles = append(deletedFiles, files...)
 		return nil
 	}
+	shard.filesetsSupersededFn = func(_ string, namespace ident.ID, shardID uint32) ([]string, error) {
+		return []string{"superseded"}, nil
+	}
 	require.NoError(t, shard.CleanupExpiredFileSets(time.Now()))
-	require.Equal(t, []string{defaultTestNs1ID.String(), "0"}, deletedFiles)
+	require.Equal(t, []string{defaultTestNs1ID.String(), "0", "superseded"}, deletedFiles)
+}
+
+func TestShardDownsample(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	opts := testDatabaseOptions()
+	fsOpts := opts.CommitLogOptions().FilesystemOptions().
+		SetFilePathPrefix(dir).
+		SetRuntimeOptionsManager(runtime.NewOptionsManager())
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts))
+
+	shard := testDatabaseShard(t, opts)
+	defer shard.Close()
+
+	tier := namespace.DownsampleTier{
+		Resolution:  30 * time.Minute,
+		After:       2 * time.Hour,
+		Aggregation: namespace.DownsampleMax,
+	}
+	nsOpts := defaultTestNs1Opts.SetDownsampleOptions(
+		namespace.NewDownsampleOptions().SetTiers([]namespace.DownsampleTier{tier}))
+	md, err := namespace.NewMetadata(defaultTestNs1ID, nsOpts)
+	require.NoError(t, err)
+	shard.namespace = md
+	shard.bootstrapState = Bootstrapped
+
+	var (
+		blockSize        = defaultTestRetentionOpts.BlockSize()
+		now              = time.Now()
+		downsampledStart = now.Truncate(blockSize).Add(-2 * blockSize)
+		recentStart      = now.Truncate(blockSize).Add(-blockSize)
+		id               = ident.StringID("foo")
+		tags             = ident.NewTags(ident.StringTag("bar", "baz"))
+	)
+	for _, blockStart := range []time.Time{downsampledStart, recentStart} {
+		writer, err := fs.NewWriter(fsOpts)
+		require.NoError(t, err)
+		require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+			Identifier: fs.FileSetFileIdentifier{
+				Namespace:  md.ID(),
+				Shard:      shard.ID(),
+				BlockStart: blockStart,
+			},
+			BlockSize: blockSize,
+		}))
+
+		enc := m3tsz.NewEncoder(blockStart, nil, true, encoding.NewOptions())
+		for i := 0; i < 120; i++ {
+			dp := ts.Datapoint{
+				Timestamp: blockStart.Add(time.Duration(i) * time.Minute),
+				Value:     float64(i),
+			}
+			require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+		}
+		stream, ok := enc.Stream(encoding.StreamOptions{})
+		require.True(t, ok)
+		segment, err := stream.Segment()
+		require.NoError(t, err)
+		require.NoError(t, writer.WriteAll(id, tags,
+			[]checked.Bytes{segment.Head, segment.Tail}, digest.SegmentChecksum(segment)))
+		require.NoError(t, writer.Close())
+
+		shard.markFlushStateSuccess(blockStart, 1)
+	}
+
+	pm, err := fs.NewPersistManager(fsOpts)
+	require.NoError(t, err)
+	downsample := func() {
+		flush, err := pm.StartFlushPersist()
+		require.NoError(t, err)
+		require.NoError(t, shard.Downsample(now, flush, nsOpts.DownsampleOptions(),
+			namespace.Context{}))
+		require.NoError(t, flush.DoneFlush())
+	}
+	downsample()
+
+	require.Equal(t, 2, shard.RetrievableBlockVersion(downsampledStart))
+	require.Equal(t, 1, shard.RetrievableBlockVersion(recentStart))
+
+	infoFiles := fs.ReadInfoFiles(dir, md.ID(), shard.ID(),
+		fsOpts.InfoReaderBufferSize(), fsOpts.DecodingOptions())
+	require.Equal(t, 2, len(infoFiles))
+	for _, result := range infoFiles {
+		require.NoError(t, result.Err.Error())
+		expected := int64(0)
+		if result.Info.BlockStart == downsampledStart.UnixNano() {
+			expected = int64(tier.Resolution)
+		}
+		require.Equal(t, expected, result.Info.DownsampleResolution)
+	}
+
+	reader, err := fs.NewReader(opts.BytesPool(), fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, reader.Open(fs.DataReaderOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   md.ID(),
+			Shard:       shard.ID(),
+			BlockStart:  downsampledStart,
+			VolumeIndex: 1,
+		},
+		FileSetType: persist.FileSetFlushType,
+	}))
+	defer reader.Close()
+	require.Equal(t, 1, reader.Entries())
+
+	readID, readTags, data, _, err := reader.Read()
+	require.NoError(t, err)
+	require.True(t, id.Equal(readID))
+	require.True(t, readTags.Next())
+	require.Equal(t, "bar", readTags.Current().Name.String())
+	require.Equal(t, "baz", readTags.Current().Value.String())
+
+	data.IncRef()
+	iter := m3tsz.NewReaderIterator(bytes.NewReader(data.Bytes()), true, encoding.NewOptions())
+	var results []ts.Datapoint
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		results = append(results, dp)
+	}
+	require.NoError(t, iter.Err())
+	data.DecRef()
+	require.Equal(t, []ts.Datapoint{
+		{Timestamp: downsampledStart, Value: 29},
+		{Timestamp: downsampledStart.Add(30 * time.Minute), Value: 59},
+		{Timestamp: downsampledStart.Add(60 * time.Minute), Value: 89},
+		{Timestamp: downsampledStart.Add(90 * time.Minute), Value: 119},
+	}, results)
+
+	// Blocks already downsampled to the tier are not downsampled again.
+	downsample()
+	require.Equal(t, 2, shard.RetrievableBlockVersion(downsampledStart))
+}
+
+func TestShardColdFlush(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		blockSize  = defaultTestRetentionOpts.BlockSize()
+		now        = time.Now()
+		blockStart = now.Truncate(blockSize).Add(-2 * blockSize)
+	)
+	opts := testDatabaseOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return now
+	}))
+	fsOpts := opts.CommitLogOptions().FilesystemOptions().
+		SetFilePathPrefix(dir).
+		SetRuntimeOptionsManager(runtime.NewOptionsManager())
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts))
+
+	nsOpts := defaultTestNs1Opts.SetColdWritesEnabled(true)
+	md, err := namespace.NewMetadata(defaultTestNs1ID, nsOpts)
+	require.NoError(t, err)
+	seriesOpts := NewSeriesOptionsFromOptions(opts, nsOpts.RetentionOptions()).
+		SetBufferBucketVersionsPool(series.NewBufferBucketVersionsPool(nil)).
+		SetBufferBucketPool(series.NewBufferBucketPool(nil)).
+		SetColdWritesEnabled(true)
+	nsReaderMgr := newNamespaceReaderManager(md, tally.NoopScope, opts)
+	shard := newDatabaseShard(md, 0, nil, nsReaderMgr,
+		&testIncreasingIndex{}, nil, nil, false, opts, seriesOpts).(*dbShard)
+	defer shard.Close()
+
+	// Flushed data for the block.
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  md.ID(),
+			Shard:      shard.ID(),
+			BlockStart: blockStart,
+		},
+		BlockSize: blockSize,
+	}))
+	enc := m3tsz.NewEncoder(blockStart, nil, true, encoding.NewOptions())
+	for _, dp := range []ts.Datapoint{
+		{Timestamp: blockStart, Value: 1},
+		{Timestamp: blockStart.Add(2 * time.Minute), Value: 3},
+	} {
+		require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+	}
+	stream, ok := enc.Stream(encoding.StreamOptions{})
+	require.True(t, ok)
+	segment, err := stream.Segment()
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteAll(ident.StringID("foo"), ident.Tags{},
+		[]checked.Bytes{segment.Head, segment.Tail}, digest.SegmentChecksum(segment)))
+	require.NoError(t, writer.Close())
+	shard.markFlushStateSuccess(blockStart, 1)
+
+	// Cold writes to the flushed block.
+	ctx := context.NewContext()
+	for _, id := range []string{"foo", "bar"} {
+		_, wasWritten, err := shard.Write(ctx, ident.StringID(id),
+			blockStart.Add(time.Minute), 2, xtime.Second, nil, series.WriteOptions{})
+		require.NoError(t, err)
+		require.True(t, wasWritten)
+	}
+	ctx.Close()
+
+	pm, err := fs.NewPersistManager(fsOpts)
+	require.NoError(t, err)
+	coldFlush := func() {
+		flush, err := pm.StartFlushPersist()
+		require.NoError(t, err)
+		require.NoError(t, shard.ColdFlush(flush, namespace.Context{}))
+		require.NoError(t, flush.DoneFlush())
+	}
+	coldFlush()
+
+	require.Equal(t, 2, shard.RetrievableBlockVersion(blockStart))
+	latest, ok, err := fs.FileSetAt(dir, md.ID(), shard.ID(), blockStart)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1, latest.ID.VolumeIndex)
+
+	// The merged volume is cached as the latest volume of the block.
+	volume, ok, err := nsReaderMgr.latestVolume(shard.ID(), blockStart)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1, volume)
+
+	reader, err := fs.NewReader(opts.BytesPool(), fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, reader.Open(fs.DataReaderOpenOptions{
+		Identifier:  latest.ID,
+		FileSetType: persist.FileSetFlushType,
+	}))
+	defer reader.Close()
+	require.Equal(t, 2, reader.Entries())
+
+	results := make(map[string][]ts.Datapoint)
+	for i := 0; i < reader.Entries(); i++ {
+		id, tags, data, _, err := reader.Read()
+		require.NoError(t, err)
+		tags.Close()
+
+		data.IncRef()
+		iter := m3tsz.NewReaderIterator(bytes.NewReader(data.Bytes()), true, encoding.NewOptions())
+		for iter.Next() {
+			dp, _, _ := iter.Current()
+			results[id.String()] = append(results[id.String()], dp)
+		}
+		require.NoError(t, iter.Err())
+		data.DecRef()
+	}
+	require.Equal(t, map[string][]ts.Datapoint{
+		"foo": {
+			{Timestamp: blockStart, Value: 1},
+			{Timestamp: blockStart.Add(time.Minute), Value: 2},
+			{Timestamp: blockStart.Add(2 * time.Minute), Value: 3},
+		},
+		"bar": {
+			{Timestamp: blockStart.Add(time.Minute), Value: 2},
+		},
+	}, results)
+
+	// Cold writes already persisted are not flushed again.
+	coldFlush()
+	require.Equal(t, 2, shard.RetrievableBlockVersion(blockStart))
+	latest, ok, err = fs.FileSetAt(dir, md.ID(), shard.ID(), blockStart)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1, latest.ID.VolumeIndex)
 }
 
 type testCloser struct {
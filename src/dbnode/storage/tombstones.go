@@ -0,0 +1,691 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// compactTombstonesLogSize is the size the tombstones log grows to before it
+// is compacted into the tombstones file.
+const compactTombstonesLogSize = 1 << 20
+
+// tombstone is a deleted time range [start, end) of a series. A tombstone
+// never spans more than a single block so that it can be cleared once the
+// block has been rewritten without the deleted data.
+type tombstone struct {
+	start     xtime.UnixNano
+	end       xtime.UnixNano
+	deletedAt xtime.UnixNano
+	// appliedAt is when the deleted data was dropped from memory, it is
+	// zero while the delete is still being applied.
+	appliedAt xtime.UnixNano
+	// written are the timestamps within the range that the series has been
+	// written to since it was deleted, sorted in ascending order.
+	written []xtime.UnixNano
+}
+
+func (t tombstone) contains(timestamp xtime.UnixNano) bool {
+	return timestamp >= t.start && timestamp < t.end
+}
+
+func (t tombstone) isWritten(timestamp xtime.UnixNano) bool {
+	i := sort.Search(len(t.written), func(i int) bool {
+		return t.written[i] >= timestamp
+	})
+	return i < len(t.written) && t.written[i] == timestamp
+}
+
+// seriesTombstones tracks the deleted time ranges of series and persists
+// them so that deleted data is not resurrected by bootstrapping from commit
+// logs, snapshots or peers. Deleted data is dropped from memory when it is
+// deleted and filtered when read from disk, until the block is next
+// rewritten without it at which point the tombstones of the block are
+// cleared. Data written after a series was deleted is never filtered, the
+// timestamps written to within a tombstone are persisted along with it.
+// Changes are appended to a log which is compacted by Compact.
+// A nil *seriesTombstones has no tombstones.
+type seriesTombstones struct {
+	sync.RWMutex
+
+	// logLock is held while a change is appended to the log and applied so
+	// that changes are logged in the order they are applied, without holding
+	// the read write lock and blocking reads while persisting.
+	logLock   sync.Mutex
+	log       tombstonesLog
+	namespace ident.ID
+	blockSize time.Duration
+	shardFn   sharding.HashFn
+	opts      Options
+	numSeries int64
+	byID      map[string][]tombstone
+}
+
+// tombstonesLog is the log that changes to tombstones are appended to.
+type tombstonesLog interface {
+	Append(entries []fs.TombstoneLogEntry, sync bool) error
+	Size() int64
+	Compact(tombstones []fs.SeriesTombstone) error
+	Close() error
+}
+
+func newSeriesTombstones(
+	namespace ident.ID,
+	blockSize time.Duration,
+	shardFn sharding.HashFn,
+	opts Options,
+) (*seriesTombstones, error) {
+	fsOpts := opts.CommitLogOptions().FilesystemOptions()
+	persisted, err := fs.ReadTombstones(fsOpts.FilePathPrefix(), namespace)
+	if err != nil {
+		return nil, err
+	}
+	log, err := fs.NewTombstonesLog(fsOpts, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compact the log replayed so that it does not grow across restarts.
+	if log.Size() > 0 {
+		if err := log.Compact(persisted); err != nil {
+			return nil, err
+		}
+	}
+
+	t := &seriesTombstones{
+		log:       log,
+		namespace: namespace,
+		blockSize: blockSize,
+		shardFn:   shardFn,
+		opts:      opts,
+		byID:      make(map[string][]tombstone, len(persisted)),
+	}
+	for _, series := range persisted {
+		tombstones := make([]tombstone, 0, len(series.Ranges))
+		for _, r := range series.Ranges {
+			deletedAt := xtime.ToUnixNano(r.DeletedAt)
+			ts := tombstone{
+				start:     xtime.ToUnixNano(r.Start),
+				end:       xtime.ToUnixNano(r.End),
+				deletedAt: deletedAt,
+				// Persisted deletes were applied before the node restarted,
+				// the data bootstrapped since is filtered.
+				appliedAt: deletedAt,
+			}
+			for _, written := range r.Written {
+				ts.written = append(ts.written, xtime.ToUnixNano(written))
+			}
+			tombstones = append(tombstones, ts)
+		}
+		t.byID[string(series.ID)] = tombstones
+	}
+	t.numSeries = int64(len(t.byID))
+	return t, nil
+}
+
+// Add tombstones [start, end) for each of the series as deleted at the given
+// time and durably persists the tombstones before returning. Callers must
+// call Applied once the deleted data has been dropped from memory.
+func (t *seriesTombstones) Add(
+	ids []ident.ID,
+	start, end time.Time,
+	deletedAt time.Time,
+) error {
+	if len(ids) == 0 || !start.Before(end) {
+		return nil
+	}
+
+	var (
+		deletedAtNanos = xtime.ToUnixNano(deletedAt)
+		ranges         []tombstone
+	)
+	for blockStart := start.Truncate(t.blockSize); blockStart.Before(end); blockStart = blockStart.Add(t.blockSize) {
+		rangeStart, rangeEnd := start, end
+		if rangeStart.Before(blockStart) {
+			rangeStart = blockStart
+		}
+		if blockEnd := blockStart.Add(t.blockSize); rangeEnd.After(blockEnd) {
+			rangeEnd = blockEnd
+		}
+		ranges = append(ranges, tombstone{
+			start:     xtime.ToUnixNano(rangeStart),
+			end:       xtime.ToUnixNano(rangeEnd),
+			deletedAt: deletedAtNanos,
+		})
+	}
+
+	t.logLock.Lock()
+	defer t.logLock.Unlock()
+
+	var (
+		keys    = make([]string, 0, len(ids))
+		seen    = make(map[string]struct{}, len(ids))
+		entries = make([]fs.TombstoneLogEntry, 0, len(ids)*len(ranges))
+	)
+	for _, id := range ids {
+		key := id.String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+		for _, r := range ranges {
+			entries = append(entries, fs.TombstoneLogEntry{
+				Type:  fs.TombstoneAdded,
+				ID:    []byte(key),
+				Range: r.deletedRange(),
+			})
+		}
+	}
+
+	// Persist the tombstones before adding them so the in-memory state never
+	// masks data that a restart would not.
+	if err := t.log.Append(entries, true); err != nil {
+		return err
+	}
+
+	t.Lock()
+	for _, key := range keys {
+		t.byID[key] = append(t.byID[key], ranges...)
+	}
+	t.updateNumSeriesWithLock()
+	t.Unlock()
+	return nil
+}
+
+// Applied marks the tombstones of the series deleted at the given time as
+// applied, the deleted data no longer being held in memory.
+func (t *seriesTombstones) Applied(ids []ident.ID, deletedAt, appliedAt time.Time) {
+	var (
+		deletedAtNanos = xtime.ToUnixNano(deletedAt)
+		appliedAtNanos = xtime.ToUnixNano(appliedAt)
+	)
+	t.Lock()
+	for _, id := range ids {
+		tombstones := t.byID[string(id.Bytes())]
+		for i := range tombstones {
+			if tombstones[i].deletedAt == deletedAtNanos && tombstones[i].appliedAt == 0 {
+				tombstones[i].appliedAt = appliedAtNanos
+			}
+		}
+	}
+	t.Unlock()
+}
+
+// MarkWritten records that the series was written to at the given time so
+// that the write is not masked by the tombstones it falls within, neither
+// when read nor when bootstrapped, and that the series is no longer hidden
+// from index queries by them. The write is only logged and not synced, the
+// same as commit log writes are not synced before being acknowledged.
+func (t *seriesTombstones) MarkWritten(id ident.ID, timestamp time.Time) error {
+	if t.Len() == 0 {
+		return nil
+	}
+
+	var (
+		key   = string(id.Bytes())
+		nanos = xtime.ToUnixNano(timestamp)
+	)
+	if !t.needsMark(key, nanos) {
+		return nil
+	}
+
+	t.logLock.Lock()
+	defer t.logLock.Unlock()
+
+	// NB: The tombstones of the series only change while the log lock is
+	// held, so the indexes of those to mark remain valid.
+	var (
+		entries []fs.TombstoneLogEntry
+		marks   []int
+	)
+	t.RLock()
+	for i, ts := range t.byID[key] {
+		if ts.contains(nanos) && !ts.isWritten(nanos) {
+			entries = append(entries, fs.TombstoneLogEntry{
+				Type:      fs.TombstoneWritten,
+				ID:        []byte(key),
+				Range:     ts.deletedRange(),
+				Timestamp: timestamp,
+			})
+			marks = append(marks, i)
+		}
+	}
+	t.RUnlock()
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err := t.log.Append(entries, false); err != nil {
+		return err
+	}
+
+	t.Lock()
+	tombstones := t.byID[key]
+	for _, i := range marks {
+		tombstones[i].written = insertWritten(tombstones[i].written, nanos)
+	}
+	t.Unlock()
+	return nil
+}
+
+func (t *seriesTombstones) needsMark(key string, timestamp xtime.UnixNano) bool {
+	t.RLock()
+	defer t.RUnlock()
+	for _, ts := range t.byID[key] {
+		if ts.contains(timestamp) && !ts.isWritten(timestamp) {
+			return true
+		}
+	}
+	return false
+}
+
+func insertWritten(written []xtime.UnixNano, timestamp xtime.UnixNano) []xtime.UnixNano {
+	i := sort.Search(len(written), func(i int) bool {
+		return written[i] >= timestamp
+	})
+	// NB: Copy rather than insert in place since readers of the tombstones
+	// only hold the read lock while copying them.
+	result := make([]xtime.UnixNano, 0, len(written)+1)
+	result = append(result, written[:i]...)
+	result = append(result, timestamp)
+	return append(result, written[i:]...)
+}
+
+// Expire removes tombstones for blocks that start before the cutoff as the
+// data they masked has fallen out of retention.
+func (t *seriesTombstones) Expire(cutoff time.Time) error {
+	if t == nil {
+		return nil
+	}
+
+	cutoffNanos := xtime.ToUnixNano(cutoff)
+	return t.removeWhere(func(_ string, ts tombstone) bool {
+		return ts.start < cutoffNanos
+	})
+}
+
+// ClearRewritten removes the tombstones of the series of a shard for the
+// block starting at blockStart that were applied before the block was
+// rewritten at rewrittenAt, as the rewritten block no longer holds any of
+// the data they masked.
+func (t *seriesTombstones) ClearRewritten(
+	shard uint32,
+	blockStart time.Time,
+	rewrittenAt time.Time,
+) error {
+	if t.Len() == 0 {
+		return nil
+	}
+
+	var (
+		blockStartNanos  = xtime.ToUnixNano(blockStart)
+		blockEndNanos    = xtime.ToUnixNano(blockStart.Add(t.blockSize))
+		rewrittenAtNanos = xtime.ToUnixNano(rewrittenAt)
+	)
+	return t.removeWhere(func(id string, ts tombstone) bool {
+		return ts.start >= blockStartNanos && ts.start < blockEndNanos &&
+			ts.appliedAt != 0 && ts.appliedAt < rewrittenAtNanos &&
+			t.shardFn(ident.StringID(id)) == shard
+	})
+}
+
+// Len returns the number of series with tombstones.
+func (t *seriesTombstones) Len() int {
+	if t == nil {
+		return 0
+	}
+	return int(atomic.LoadInt64(&t.numSeries))
+}
+
+// DeletedRanges returns the deleted ranges of the series within the block
+// starting at blockStart.
+func (t *seriesTombstones) DeletedRanges(
+	id ident.ID,
+	blockStart time.Time,
+) fs.DeletedRanges {
+	if t.Len() == 0 {
+		return nil
+	}
+
+	var (
+		blockStartNanos = xtime.ToUnixNano(blockStart)
+		blockEndNanos   = xtime.ToUnixNano(blockStart.Add(t.blockSize))
+		ranges          fs.DeletedRanges
+	)
+	t.RLock()
+	for _, ts := range t.byID[string(id.Bytes())] {
+		if ts.start >= blockStartNanos && ts.start < blockEndNanos {
+			ranges = append(ranges, ts.deletedRange())
+		}
+	}
+	t.RUnlock()
+	return ranges
+}
+
+// IsDeletedRange returns whether all of [start, end) has been deleted for
+// the series and not written to since.
+func (t *seriesTombstones) IsDeletedRange(id ident.ID, start, end time.Time) bool {
+	if t.Len() == 0 || !start.Before(end) {
+		return false
+	}
+
+	var (
+		startNanos = xtime.ToUnixNano(start)
+		endNanos   = xtime.ToUnixNano(end)
+		overlaps   []tombstone
+	)
+	t.RLock()
+	for _, ts := range t.byID[string(id.Bytes())] {
+		if len(ts.written) == 0 && ts.start < endNanos && ts.end > startNanos {
+			overlaps = append(overlaps, ts)
+		}
+	}
+	t.RUnlock()
+
+	sort.Slice(overlaps, func(i, j int) bool {
+		return overlaps[i].start < overlaps[j].start
+	})
+	covered := startNanos
+	for _, ts := range overlaps {
+		if ts.start > covered {
+			return false
+		}
+		if ts.end > covered {
+			covered = ts.end
+		}
+		if covered >= endNanos {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterQueryResults removes series from the index query results which have
+// all of [start, end) deleted.
+func (t *seriesTombstones) FilterQueryResults(
+	results index.QueryResults,
+	start, end time.Time,
+) {
+	if t.Len() == 0 || results == nil {
+		return
+	}
+
+	resultsMap := results.Map()
+	for _, entry := range resultsMap.Iter() {
+		if id := entry.Key(); t.IsDeletedRange(id, start, end) {
+			resultsMap.Delete(id)
+		}
+	}
+}
+
+// FilterBootstrapped drops deleted datapoints from the bootstrapped series so
+// that deleted data is not loaded back into memory.
+func (t *seriesTombstones) FilterBootstrapped(
+	bootstrapped *result.Map,
+	nsCtx namespace.Context,
+) error {
+	if t.Len() == 0 || bootstrapped == nil {
+		return nil
+	}
+
+	for _, elem := range bootstrapped.Iter() {
+		series := elem.Value()
+		if series.Blocks == nil {
+			continue
+		}
+		for blockStartNanos, bl := range series.Blocks.AllBlocks() {
+			blockStart := blockStartNanos.ToTime()
+			deleted := t.DeletedRanges(series.ID, blockStart)
+			if len(deleted) == 0 {
+				continue
+			}
+
+			filtered, err := t.filterBlock(bl, deleted, nsCtx)
+			if err != nil {
+				return err
+			}
+			series.Blocks.RemoveBlockAt(blockStart)
+			bl.Close()
+			if filtered != nil {
+				series.Blocks.AddBlock(filtered)
+			}
+		}
+	}
+	return nil
+}
+
+// filterBlock returns a copy of the block without the deleted datapoints, or
+// nil if no datapoints remain.
+func (t *seriesTombstones) filterBlock(
+	bl block.DatabaseBlock,
+	deleted fs.DeletedRanges,
+	nsCtx namespace.Context,
+) (block.DatabaseBlock, error) {
+	ctx := t.opts.ContextPool().Get()
+	defer ctx.BlockingClose()
+
+	reader, err := bl.Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if reader.IsEmpty() {
+		return nil, nil
+	}
+
+	segment, err := dropDeleted(reader, deleted, t.opts, nsCtx)
+	if err != nil {
+		return nil, err
+	}
+	if segment.Len() == 0 {
+		segment.Finalize()
+		return nil, nil
+	}
+	return block.NewDatabaseBlock(bl.StartTime(), bl.BlockSize(), segment,
+		t.opts.DatabaseBlockOptions(), nsCtx), nil
+}
+
+func (t *seriesTombstones) removeWhere(
+	fn func(id string, ts tombstone) bool,
+) error {
+	t.logLock.Lock()
+	defer t.logLock.Unlock()
+
+	// NB: The tombstones only change while the log lock is held, so the
+	// indexes of those to remove remain valid.
+	var (
+		entries []fs.TombstoneLogEntry
+		removed = make(map[string][]int)
+	)
+	t.RLock()
+	for id, tombstones := range t.byID {
+		for i, ts := range tombstones {
+			if !fn(id, ts) {
+				continue
+			}
+			entries = append(entries, fs.TombstoneLogEntry{
+				Type:  fs.TombstoneRemoved,
+				ID:    []byte(id),
+				Range: ts.deletedRange(),
+			})
+			removed[id] = append(removed[id], i)
+		}
+	}
+	t.RUnlock()
+	if len(entries) == 0 {
+		return nil
+	}
+
+	// Persist the removals before removing the tombstones, a tombstone
+	// removed from memory but not on disk would mask writes made since on
+	// restart.
+	if err := t.log.Append(entries, true); err != nil {
+		return err
+	}
+
+	t.Lock()
+	for id, indexes := range removed {
+		var (
+			tombstones = t.byID[id]
+			remaining  = make([]tombstone, 0, len(tombstones)-len(indexes))
+			next       = 0
+		)
+		for i, ts := range tombstones {
+			if next < len(indexes) && indexes[next] == i {
+				next++
+				continue
+			}
+			remaining = append(remaining, ts)
+		}
+		if len(remaining) == 0 {
+			delete(t.byID, id)
+			continue
+		}
+		t.byID[id] = remaining
+	}
+	t.updateNumSeriesWithLock()
+	t.Unlock()
+	return nil
+}
+
+// Compact compacts the log into the tombstones file once the log has grown
+// larger than compactTombstonesLogSize.
+func (t *seriesTombstones) Compact() error {
+	if t == nil {
+		return nil
+	}
+
+	t.logLock.Lock()
+	defer t.logLock.Unlock()
+	if t.log.Size() < compactTombstonesLogSize {
+		return nil
+	}
+
+	t.RLock()
+	tombstones := make([]fs.SeriesTombstone, 0, len(t.byID))
+	for id, series := range t.byID {
+		ranges := make([]fs.DeletedRange, 0, len(series))
+		for _, ts := range series {
+			ranges = append(ranges, ts.deletedRange())
+		}
+		tombstones = append(tombstones, fs.SeriesTombstone{
+			ID:     []byte(id),
+			Ranges: ranges,
+		})
+	}
+	t.RUnlock()
+
+	return t.log.Compact(tombstones)
+}
+
+// Close closes the log.
+func (t *seriesTombstones) Close() error {
+	if t == nil {
+		return nil
+	}
+
+	t.logLock.Lock()
+	defer t.logLock.Unlock()
+	return t.log.Close()
+}
+
+func (t *seriesTombstones) updateNumSeriesWithLock() {
+	atomic.StoreInt64(&t.numSeries, int64(len(t.byID)))
+}
+
+// deletedRange returns the deleted range of the tombstone along with the
+// timestamps written to within it.
+func (t tombstone) deletedRange() fs.DeletedRange {
+	r := fs.DeletedRange{
+		Start:     t.start.ToTime(),
+		End:       t.end.ToTime(),
+		DeletedAt: t.deletedAt.ToTime(),
+	}
+	if len(t.written) > 0 {
+		r.Written = make([]time.Time, 0, len(t.written))
+		for _, written := range t.written {
+			r.Written = append(r.Written, written.ToTime())
+		}
+	}
+	return r
+}
+
+// dropDeleted re-encodes the block without the datapoints within any of the
+// deleted ranges. The returned segment is empty if no datapoints remain.
+func dropDeleted(
+	reader xio.BlockReader,
+	deleted fs.DeletedRanges,
+	opts Options,
+	nsCtx namespace.Context,
+) (ts.Segment, error) {
+	iter := opts.ReaderIteratorPool().Get()
+	iter.Reset(reader, nsCtx.Schema)
+	defer iter.Close()
+
+	encoder := opts.EncoderPool().Get()
+	encoder.Reset(reader.Start,
+		opts.DatabaseBlockOptions().DatabaseBlockAllocSize(), nsCtx.Schema)
+	filtered := deletedFilterIterator{Iterator: iter, deleted: deleted}
+	for filtered.Next() {
+		dp, unit, annotation := filtered.Current()
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			encoder.Close()
+			return ts.Segment{}, err
+		}
+	}
+	if err := filtered.Err(); err != nil {
+		encoder.Close()
+		return ts.Segment{}, err
+	}
+	return encoder.Discard(), nil
+}
+
+// deletedFilterIterator skips the datapoints of an iterator that are within
+// any of the deleted ranges.
+type deletedFilterIterator struct {
+	encoding.Iterator
+	deleted fs.DeletedRanges
+}
+
+func (it deletedFilterIterator) Next() bool {
+	for it.Iterator.Next() {
+		dp, _, _ := it.Iterator.Current()
+		if !it.deleted.Contains(dp.Timestamp) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testTombstonesBlockSize = 2 * time.Hour
+
+func newTestSeriesTombstones(t *testing.T) (*seriesTombstones, func()) {
+	dir, err := ioutil.TempDir("", "tombstones")
+	require.NoError(t, err)
+
+	opts := testDatabaseOptions()
+	fsOpts := opts.CommitLogOptions().FilesystemOptions().
+		SetFilePathPrefix(dir)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts))
+
+	tombstones, err := newSeriesTombstones(ident.StringID("testns"),
+		testTombstonesBlockSize, sharding.DefaultHashFn(1), opts)
+	require.NoError(t, err)
+	return tombstones, func() { os.RemoveAll(dir) }
+}
+
+// testTombstonesLog is a tombstones log that does not persist any changes.
+type testTombstonesLog struct {
+	appendErr error
+	size      int64
+	compacted []fs.SeriesTombstone
+}
+
+func (l *testTombstonesLog) Append(entries []fs.TombstoneLogEntry, _ bool) error {
+	if l.appendErr != nil {
+		return l.appendErr
+	}
+	l.size += int64(len(entries))
+	return nil
+}
+
+func (l *testTombstonesLog) Size() int64 {
+	return l.size
+}
+
+func (l *testTombstonesLog) Compact(tombstones []fs.SeriesTombstone) error {
+	l.compacted = tombstones
+	l.size = 0
+	return nil
+}
+
+func (l *testTombstonesLog) Close() error {
+	return nil
+}
+
+func reloadTestSeriesTombstones(
+	t *testing.T,
+	tombstones *seriesTombstones,
+) *seriesTombstones {
+	require.NoError(t, tombstones.Close())
+	reloaded, err := newSeriesTombstones(tombstones.namespace,
+		tombstones.blockSize, tombstones.shardFn, tombstones.opts)
+	require.NoError(t, err)
+
+	// The log is compacted when reloaded, further changes to the original
+	// tombstones are appended to the log of the reloaded tombstones.
+	tombstones.log = reloaded.log
+	return reloaded
+}
+
+func TestSeriesTombstonesAdd(t *testing.T) {
+	tombstones, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	var (
+		start     = time.Now().Truncate(testTombstonesBlockSize)
+		next      = start.Add(testTombstonesBlockSize)
+		deletedAt = time.Now().Truncate(time.Millisecond)
+		foo       = ident.StringID("foo")
+		bar       = ident.StringID("bar")
+	)
+	// Ranges spanning multiple blocks are split per block.
+	err := tombstones.Add([]ident.ID{foo}, start.Add(time.Minute),
+		next.Add(time.Minute), deletedAt)
+	require.NoError(t, err)
+	require.Equal(t, 1, tombstones.Len())
+
+	require.Equal(t, fs.DeletedRanges{{
+		Start:     start.Add(time.Minute),
+		End:       next,
+		DeletedAt: deletedAt,
+	}}, tombstones.DeletedRanges(foo, start))
+	require.Equal(t, fs.DeletedRanges{{
+		Start:     next,
+		End:       next.Add(time.Minute),
+		DeletedAt: deletedAt,
+	}}, tombstones.DeletedRanges(foo, next))
+	require.Nil(t, tombstones.DeletedRanges(foo, start.Add(-testTombstonesBlockSize)))
+	require.Nil(t, tombstones.DeletedRanges(bar, start))
+
+	require.True(t, tombstones.IsDeletedRange(foo, start.Add(time.Minute),
+		next.Add(time.Minute)))
+	require.False(t, tombstones.IsDeletedRange(foo, start, next))
+	require.False(t, tombstones.IsDeletedRange(bar, start, next))
+}
+
+func TestSeriesTombstonesMarkWritten(t *testing.T) {
+	tombstones, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	var (
+		start = time.Now().Truncate(testTombstonesBlockSize)
+		foo   = ident.StringID("foo")
+	)
+	require.NoError(t, tombstones.Add([]ident.ID{foo}, start,
+		start.Add(time.Hour), time.Now()))
+	require.True(t, tombstones.IsDeletedRange(foo, start, start.Add(time.Hour)))
+
+	// Writes outside of the deleted range do not unhide the series.
+	require.NoError(t, tombstones.MarkWritten(foo, start.Add(2*time.Hour)))
+	require.True(t, tombstones.IsDeletedRange(foo, start, start.Add(time.Hour)))
+
+	require.NoError(t, tombstones.MarkWritten(foo, start.Add(time.Minute)))
+	require.False(t, tombstones.IsDeletedRange(foo, start, start.Add(time.Hour)))
+
+	// The write does not remove the tombstone, data written before the
+	// delete is still masked but the write is not.
+	deleted := tombstones.DeletedRanges(foo, start)
+	require.Equal(t, 1, len(deleted))
+	require.False(t, deleted.Contains(start.Add(time.Minute)))
+	require.True(t, deleted.Contains(start.Add(2*time.Minute)))
+
+	// The write is persisted along with the tombstone.
+	deleted = reloadTestSeriesTombstones(t, tombstones).DeletedRanges(foo, start)
+	require.Equal(t, 1, len(deleted))
+	require.False(t, deleted.Contains(start.Add(time.Minute)))
+	require.True(t, deleted.Contains(start.Add(2*time.Minute)))
+
+	// Deleting the range again masks the write.
+	require.NoError(t, tombstones.Add([]ident.ID{foo}, start,
+		start.Add(time.Hour), time.Now().Add(time.Second)))
+	require.True(t, tombstones.DeletedRanges(foo, start).Contains(start.Add(time.Minute)))
+}
+
+func TestSeriesTombstonesFilterBootstrappedKeepsWritesAfterDelete(t *testing.T) {
+	tombstones, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	var (
+		opts    = tombstones.opts
+		start   = time.Now().Truncate(testTombstonesBlockSize)
+		foo     = ident.StringID("foo")
+		before  = start.Add(time.Minute)
+		after   = start.Add(2 * time.Minute)
+		outside = start.Add(90 * time.Minute)
+	)
+	require.NoError(t, tombstones.Add([]ident.ID{foo}, start,
+		start.Add(time.Hour), time.Now()))
+	require.NoError(t, tombstones.MarkWritten(foo, after))
+
+	// Bootstrapping from commit logs or snapshots after a restart yields the
+	// datapoints written both before and after the delete.
+	encoder := opts.EncoderPool().Get()
+	encoder.Reset(start, 0, nil)
+	for _, timestamp := range []time.Time{before, after, outside} {
+		require.NoError(t, encoder.Encode(ts.Datapoint{Timestamp: timestamp, Value: 1},
+			xtime.Second, nil))
+	}
+	blocks := block.NewDatabaseSeriesBlocks(1)
+	blocks.AddBlock(block.NewDatabaseBlock(start, testTombstonesBlockSize,
+		encoder.Discard(), opts.DatabaseBlockOptions(), namespace.Context{}))
+	bootstrapped := result.NewMap(result.MapOptions{})
+	bootstrapped.Set(foo, result.DatabaseSeriesBlocks{ID: foo, Blocks: blocks})
+
+	reloaded := reloadTestSeriesTombstones(t, tombstones)
+	require.NoError(t, reloaded.FilterBootstrapped(bootstrapped, namespace.Context{}))
+
+	bl, ok := blocks.BlockAt(start)
+	require.True(t, ok)
+	ctx := opts.ContextPool().Get()
+	defer ctx.Close()
+	reader, err := bl.Stream(ctx)
+	require.NoError(t, err)
+
+	iter := opts.ReaderIteratorPool().Get()
+	iter.Reset(reader, nil)
+	defer iter.Close()
+	var timestamps []time.Time
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		timestamps = append(timestamps, dp.Timestamp)
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, 2, len(timestamps))
+	require.True(t, after.Equal(timestamps[0]))
+	require.True(t, outside.Equal(timestamps[1]))
+}
+
+func TestSeriesTombstonesCompact(t *testing.T) {
+	tombstones, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	var (
+		log   = &testTombstonesLog{}
+		start = time.Now().Truncate(testTombstonesBlockSize)
+		foo   = ident.StringID("foo")
+	)
+	tombstones.log = log
+	require.NoError(t, tombstones.Add([]ident.ID{foo}, start,
+		start.Add(time.Hour), time.Now()))
+	require.NoError(t, tombstones.MarkWritten(foo, start.Add(time.Minute)))
+
+	// The log is not compacted until it has grown large enough.
+	require.NoError(t, tombstones.Compact())
+	require.Nil(t, log.compacted)
+
+	log.size = compactTombstonesLogSize
+	require.NoError(t, tombstones.Compact())
+	require.Equal(t, 1, len(log.compacted))
+	require.Equal(t, []byte("foo"), log.compacted[0].ID)
+	require.Equal(t, tombstones.DeletedRanges(foo, start),
+		fs.DeletedRanges(log.compacted[0].Ranges))
+}
+
+func TestSeriesTombstonesClearRewritten(t *testing.T) {
+	tombstones, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	var (
+		start     = time.Now().Truncate(testTombstonesBlockSize)
+		next      = start.Add(testTombstonesBlockSize)
+		deletedAt = time.Now().Truncate(time.Millisecond)
+		foo       = ident.StringID("foo")
+		ids       = []ident.ID{foo}
+	)
+	require.NoError(t, tombstones.Add(ids, start, next.Add(time.Hour), deletedAt))
+
+	// Tombstones not yet applied are not cleared.
+	require.NoError(t, tombstones.ClearRewritten(0, start, deletedAt.Add(time.Minute)))
+	require.Equal(t, 1, len(tombstones.DeletedRanges(foo, start)))
+
+	appliedAt := deletedAt.Add(time.Second)
+	tombstones.Applied(ids, deletedAt, appliedAt)
+
+	// Rewrites that started before the delete was applied may hold the
+	// deleted data.
+	require.NoError(t, tombstones.ClearRewritten(0, start, deletedAt))
+	require.Equal(t, 1, len(tombstones.DeletedRanges(foo, start)))
+
+	// Rewrites of other shards do not clear the tombstones.
+	require.NoError(t, tombstones.ClearRewritten(1, start, appliedAt.Add(time.Minute)))
+	require.Equal(t, 1, len(tombstones.DeletedRanges(foo, start)))
+
+	require.NoError(t, tombstones.ClearRewritten(0, start, appliedAt.Add(time.Minute)))
+	require.Nil(t, tombstones.DeletedRanges(foo, start))
+	require.Equal(t, 1, len(tombstones.DeletedRanges(foo, next)))
+	require.Equal(t, 1, tombstones.Len())
+
+	reloaded := reloadTestSeriesTombstones(t, tombstones)
+	require.Nil(t, reloaded.DeletedRanges(foo, start))
+	require.Equal(t, 1, len(reloaded.DeletedRanges(foo, next)))
+
+	require.NoError(t, tombstones.ClearRewritten(0, next, appliedAt.Add(time.Minute)))
+	require.Equal(t, 0, tombstones.Len())
+}
+
+func TestSeriesTombstonesPersisted(t *testing.T) {
+	tombstones, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	var (
+		start     = time.Now().Truncate(testTombstonesBlockSize)
+		deletedAt = time.Now().Truncate(time.Millisecond)
+		foo       = ident.StringID("foo")
+	)
+	require.NoError(t, tombstones.Add([]ident.ID{foo}, start,
+		start.Add(time.Hour), deletedAt))
+
+	reloaded := reloadTestSeriesTombstones(t, tombstones)
+	require.Equal(t, fs.DeletedRanges{{
+		Start:     start,
+		End:       start.Add(time.Hour),
+		DeletedAt: deletedAt,
+	}}, reloaded.DeletedRanges(foo, start))
+
+	require.NoError(t, tombstones.Expire(start.Add(testTombstonesBlockSize)))
+	require.Equal(t, 0, tombstones.Len())
+
+	reloaded = reloadTestSeriesTombstones(t, tombstones)
+	require.Equal(t, 0, reloaded.Len())
+}
+
+func TestSeriesTombstonesAddPersistError(t *testing.T) {
+	tombstones, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	var (
+		start = time.Now().Truncate(testTombstonesBlockSize)
+		foo   = ident.StringID("foo")
+	)
+	require.NoError(t, tombstones.Add([]ident.ID{foo}, start,
+		start.Add(time.Hour), time.Now()))
+
+	tombstones.log = &testTombstonesLog{appendErr: errors.New("an error")}
+
+	err := tombstones.Add([]ident.ID{foo, ident.StringID("bar")},
+		start.Add(time.Hour), start.Add(2*time.Hour), time.Now())
+	require.Error(t, err)
+	require.Equal(t, 1, tombstones.Len())
+	require.Equal(t, 1, len(tombstones.DeletedRanges(foo, start)))
+}
+
+func TestSeriesTombstonesNil(t *testing.T) {
+	var (
+		tombstones *seriesTombstones
+		now        = time.Now()
+	)
+	require.Equal(t, 0, tombstones.Len())
+	require.Nil(t, tombstones.DeletedRanges(ident.StringID("foo"), now))
+	require.False(t, tombstones.IsDeletedRange(ident.StringID("foo"), now,
+		now.Add(time.Hour)))
+	require.NoError(t, tombstones.ClearRewritten(0, now, now))
+	require.NoError(t, tombstones.Expire(now))
+	require.NoError(t, tombstones.MarkWritten(ident.StringID("foo"), now))
+	require.NoError(t, tombstones.Compact())
+	require.NoError(t, tombstones.Close())
+}
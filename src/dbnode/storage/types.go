@@ -206,6 +206,27 @@ type Database interface {
 	// Truncate truncates data for the given namespace.
 	Truncate(namespace ident.ID) (int64, error)
 
+	// DeleteTagged deletes the data within [start, end) of all series in the
+	// given namespace matching the query and returns the number of series
+	// deleted. Only datapoints written before the delete are removed, and
+	// the data is physically dropped the next time each affected block is
+	// flushed or merged to disk.
+	DeleteTagged(
+		ctx context.Context,
+		namespace ident.ID,
+		query index.Query,
+		start, end time.Time,
+	) (int64, error)
+
+	// Cardinality returns the untruncated series counts of the metric names,
+	// label names and label value pairs of each shard owned in the given
+	// namespace's reverse index.
+	Cardinality(
+		ctx context.Context,
+		namespace ident.ID,
+		opts index.CardinalityOptions,
+	) ([]index.CardinalityShardResult, error)
+
 	// BootstrapState captures and returns a snapshot of the databases'
 	// bootstrap state.
 	BootstrapState() DatabaseBootstrapState
@@ -264,6 +285,14 @@ type databaseNamespace interface {
 	// GetIndex returns the reverse index backing the namespace, if it exists.
 	GetIndex() (namespaceIndex, error)
 
+	// DownsampleOptions returns the downsample options currently in use by
+	// the namespace.
+	DownsampleOptions() namespace.DownsampleOptions
+
+	// SetDownsampleOptions updates the downsample options of the namespace,
+	// the new tiers take effect from the next downsample.
+	SetDownsampleOptions(value namespace.DownsampleOptions) error
+
 	// Tick performs any regular maintenance operations.
 	Tick(c context.Cancellable, tickStart time.Time) error
 
@@ -343,6 +372,14 @@ type databaseNamespace interface {
 		flush persist.IndexFlush,
 	) error
 
+	// ColdFlush merges any cold writes into the flushed filesets of the
+	// blocks they were written to.
+	ColdFlush(flush persist.FlushPreparer) error
+
+	// Downsample rewrites flushed data that has aged into a downsample tier
+	// at the coarser resolution of that tier.
+	Downsample(tickStart time.Time, flush persist.FlushPreparer) error
+
 	// Snapshot snapshots unflushed in-memory data
 	Snapshot(blockStart, snapshotTime time.Time, flush persist.SnapshotPreparer) error
 
@@ -364,6 +401,22 @@ type databaseNamespace interface {
 	// Truncate truncates the in-memory data for this namespace.
 	Truncate() (int64, error)
 
+	// DeleteTagged deletes the data within [start, end) of all series
+	// matching the query and returns the number of series deleted.
+	DeleteTagged(
+		ctx context.Context,
+		query index.Query,
+		start, end time.Time,
+	) (int64, error)
+
+	// Cardinality returns the untruncated series counts of the metric names,
+	// label names and label value pairs of each shard owned in the
+	// namespace's reverse index.
+	Cardinality(
+		ctx context.Context,
+		opts index.CardinalityOptions,
+	) ([]index.CardinalityShardResult, error)
+
 	// Repair repairs the namespace data for a given time range
 	Repair(repairer databaseShardRepairer, tr xtime.Range) error
 
@@ -448,6 +501,14 @@ type databaseShard interface {
 		opts block.FetchBlocksMetadataOptions,
 	) (block.FetchBlocksMetadataResults, PageToken, error)
 
+	// DeleteSeries drops any in-memory data of the series within
+	// [start, end).
+	DeleteSeries(
+		id ident.ID,
+		start, end time.Time,
+		nsCtx namespace.Context,
+	) error
+
 	// Bootstrap bootstraps the shard with provided data.
 	Bootstrap(
 		bootstrappedSeries *result.Map,
@@ -463,6 +524,20 @@ type databaseShard interface {
 	// Snapshot snapshot's the unflushed series' in this shard.
 	Snapshot(blockStart, snapshotStart time.Time, flush persist.SnapshotPreparer, nsCtx namespace.Context) error
 
+	// ColdFlush merges the cold writes of the series in this shard into
+	// the flushed filesets of the blocks they were written to, each merged
+	// fileset is written as a new volume of the block.
+	ColdFlush(flush persist.FlushPreparer, nsCtx namespace.Context) error
+
+	// Downsample rewrites the flushed filesets in this shard that have aged
+	// into a downsample tier at the coarser resolution of that tier.
+	Downsample(
+		tickStart time.Time,
+		flush persist.FlushPreparer,
+		dsOpts namespace.DownsampleOptions,
+		nsCtx namespace.Context,
+	) error
+
 	// FlushState returns the flush state for this shard at block start.
 	FlushState(blockStart time.Time) fileOpState
 
@@ -507,6 +582,15 @@ type namespaceIndex interface {
 		opts index.AggregationOptions,
 	) (index.AggregateQueryResult, error)
 
+	// Cardinality returns the untruncated series counts of the metric names,
+	// label names and label value pairs of each shard in the given shard set
+	// over the given time range.
+	Cardinality(
+		ctx context.Context,
+		opts index.CardinalityOptions,
+		shardSet sharding.ShardSet,
+	) ([]index.CardinalityShardResult, error)
+
 	// Bootstrap bootstraps the index the provided segments.
 	Bootstrap(
 		bootstrapResults result.IndexResults,
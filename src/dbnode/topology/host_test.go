@@ -35,6 +35,7 @@ func TestNewHostShardSetFromServiceInstance(t *testing.T) {
 	i1 := services.NewServiceInstance().
 		SetInstanceID("h1").
 		SetEndpoint("h1:9000").
+		SetIsolationGroup("r1").
 		SetShards(shard.NewShards([]shard.Shard{
 			shard.NewShard(1),
 			shard.NewShard(2),
@@ -45,6 +46,7 @@ func TestNewHostShardSetFromServiceInstance(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "h1:9000", host.Host().Address())
 	assert.Equal(t, "h1", host.Host().ID())
+	assert.Equal(t, "r1", host.Host().IsolationGroup())
 	assert.Equal(t, 3, len(host.ShardSet().AllIDs()))
 	assert.Equal(t, uint32(1), host.ShardSet().Min())
 	assert.Equal(t, uint32(3), host.ShardSet().Max())
@@ -37,6 +37,10 @@ type Host interface {
 	// Address returns the address of the host
 	Address() string
 
+	// IsolationGroup returns the isolation group of the host, empty if
+	// the host has no known isolation group
+	IsolationGroup() string
+
 	// String returns a string representation of the host
 	String() string
 }
@@ -145,8 +149,9 @@ type StaticConfiguration struct {
 
 // HostShardConfig stores host information for fanout
 type HostShardConfig struct {
-	HostID        string `yaml:"hostID"`
-	ListenAddress string `yaml:"listenAddress"`
+	HostID         string `yaml:"hostID"`
+	ListenAddress  string `yaml:"listenAddress"`
+	IsolationGroup string `yaml:"isolationGroup"`
 }
 
 // StaticOptions is a set of options for static topology
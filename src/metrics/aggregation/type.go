@@ -54,6 +54,7 @@ const (
 	P99
 	P999
 	P9999
+	CountDistinct
 
 	nextTypeID = iota
 )
@@ -77,28 +78,29 @@ var (
 
 	// ValidTypes is the list of all the valid aggregation types.
 	ValidTypes = map[Type]struct{}{
-		Last:   emptyStruct,
-		Min:    emptyStruct,
-		Max:    emptyStruct,
-		Mean:   emptyStruct,
-		Median: emptyStruct,
-		Count:  emptyStruct,
-		Sum:    emptyStruct,
-		SumSq:  emptyStruct,
-		Stdev:  emptyStruct,
-		P10:    emptyStruct,
-		P20:    emptyStruct,
-		P30:    emptyStruct,
-		P40:    emptyStruct,
-		P50:    emptyStruct,
-		P60:    emptyStruct,
-		P70:    emptyStruct,
-		P80:    emptyStruct,
-		P90:    emptyStruct,
-		P95:    emptyStruct,
-		P99:    emptyStruct,
-		P999:   emptyStruct,
-		P9999:  emptyStruct,
+		Last:          emptyStruct,
+		Min:           emptyStruct,
+		Max:           emptyStruct,
+		Mean:          emptyStruct,
+		Median:        emptyStruct,
+		Count:         emptyStruct,
+		Sum:           emptyStruct,
+		SumSq:         emptyStruct,
+		Stdev:         emptyStruct,
+		P10:           emptyStruct,
+		P20:           emptyStruct,
+		P30:           emptyStruct,
+		P40:           emptyStruct,
+		P50:           emptyStruct,
+		P60:           emptyStruct,
+		P70:           emptyStruct,
+		P80:           emptyStruct,
+		P90:           emptyStruct,
+		P95:           emptyStruct,
+		P99:           emptyStruct,
+		P999:          emptyStruct,
+		P9999:         emptyStruct,
+		CountDistinct: emptyStruct,
 	}
 
 	typeStringMap map[string]Type
@@ -150,13 +152,23 @@ func (a Type) IsValidForCounter() bool {
 // IsValidForTimer if an Type is valid for Timer.
 func (a Type) IsValidForTimer() bool {
 	switch a {
-	case Last:
+	case Last, CountDistinct:
 		return false
 	default:
 		return true
 	}
 }
 
+// IsValidForSet if an Type is valid for Set.
+func (a Type) IsValidForSet() bool {
+	switch a {
+	case Count, CountDistinct:
+		return true
+	default:
+		return false
+	}
+}
+
 // Quantile returns the quantile represented by the Type.
 func (a Type) Quantile() (float64, bool) {
 	switch a {
@@ -346,6 +358,16 @@ func (aggTypes Types) IsValidForTimer() bool {
 	return true
 }
 
+// IsValidForSet checks if the list of aggregation types is valid for Set.
+func (aggTypes Types) IsValidForSet() bool {
+	for _, aggType := range aggTypes {
+		if !aggType.IsValidForSet() {
+			return false
+		}
+	}
+	return true
+}
+
 // PooledQuantiles returns all the quantiles found in the list
 // of aggregation types. Using a floats pool if available.
 //
@@ -38,6 +38,9 @@ type TypesConfiguration struct {
 	// Default aggregation types for gauge metrics.
 	DefaultGaugeAggregationTypes *Types `yaml:"defaultGaugeAggregationTypes"`
 
+	// Default aggregation types for set metrics.
+	DefaultSetAggregationTypes *Types `yaml:"defaultSetAggregationTypes"`
+
 	// CounterTransformFnType configures the type string transformation function for counters.
 	CounterTransformFnType *transformFnType `yaml:"counterTransformFnType"`
 
@@ -47,6 +50,9 @@ type TypesConfiguration struct {
 	// GaugeTransformFnType configures the type string transformation function for gauges.
 	GaugeTransformFnType *transformFnType `yaml:"gaugeTransformFnType"`
 
+	// SetTransformFnType configures the type string transformation function for sets.
+	SetTransformFnType *transformFnType `yaml:"setTransformFnType"`
+
 	// Pool of aggregation types.
 	AggregationTypesPool pool.ObjectPoolConfiguration `yaml:"aggregationTypesPool"`
 
@@ -66,6 +72,9 @@ func (c TypesConfiguration) NewOptions(instrumentOpts instrument.Options) (Types
 	if c.DefaultTimerAggregationTypes != nil {
 		opts = opts.SetDefaultTimerAggregationTypes(*c.DefaultTimerAggregationTypes)
 	}
+	if c.DefaultSetAggregationTypes != nil {
+		opts = opts.SetDefaultSetAggregationTypes(*c.DefaultSetAggregationTypes)
+	}
 	if c.CounterTransformFnType != nil {
 		fn, err := c.CounterTransformFnType.TransformFn()
 		if err != nil {
@@ -87,6 +96,13 @@ func (c TypesConfiguration) NewOptions(instrumentOpts instrument.Options) (Types
 		}
 		opts = opts.SetGaugeTypeStringTransformFn(fn)
 	}
+	if c.SetTransformFnType != nil {
+		fn, err := c.SetTransformFnType.TransformFn()
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetSetTypeStringTransformFn(fn)
+	}
 
 	// Set aggregation types pool.
 	scope := instrumentOpts.MetricsScope()
@@ -32,15 +32,24 @@ import (
 
 func TestTypeIsValid(t *testing.T) {
 	require.True(t, P9999.IsValid())
-	require.False(t, Type(int(P9999)+1).IsValid())
+	require.True(t, CountDistinct.IsValid())
+	require.False(t, Type(int(CountDistinct)+1).IsValid())
 }
 
 func TestTypeMaxID(t *testing.T) {
-	require.Equal(t, maxTypeID, P9999.ID())
-	require.Equal(t, P9999, Type(maxTypeID))
+	require.Equal(t, maxTypeID, CountDistinct.ID())
+	require.Equal(t, CountDistinct, Type(maxTypeID))
 	require.Equal(t, maxTypeID, len(ValidTypes))
 }
 
+func TestTypesIsValidForSet(t *testing.T) {
+	require.True(t, Types{Count, CountDistinct}.IsValidForSet())
+	require.False(t, Types{CountDistinct, Sum}.IsValidForSet())
+	require.False(t, Types{CountDistinct}.IsValidForTimer())
+	require.False(t, Types{CountDistinct}.IsValidForGauge())
+	require.False(t, Types{CountDistinct}.IsValidForCounter())
+}
+
 func TestTypeUnmarshalYAML(t *testing.T) {
 	inputs := []struct {
 		str         string
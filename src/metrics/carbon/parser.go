@@ -22,10 +22,12 @@ package carbon
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -49,12 +51,27 @@ const (
 
 	initScannerBufferSize = 2 << 15 // ~ 65KiB
 	maxScannerBufferSize  = 2 << 17 // ~ 0.25iB
+
+	// TaggedSeparator separates the path and each of the tags of a graphite
+	// tagged series name, e.g. disk.used;datacenter=dc1;server=web01.
+	TaggedSeparator = byte(';')
+
+	// NameTag is the graphite tag which refers to the path of a tagged series,
+	// it is reserved and cannot be set as a tag.
+	NameTag = "name"
+
+	tagValueSeparator = byte('=')
+	invalidTagChars   = ";!^="
 )
 
 var (
 	errInvalidLine = errors.New("invalid line")
 	errNotUTF8     = errors.New("not valid UTF8 string")
+	errEmptyPath   = errors.New("tagged name has no path")
 	mathNan        = math.NaN()
+
+	pathTagPrefix = []byte("__g")
+	pathTagSuffix = []byte("__")
 )
 
 // Metric represents a carbon metric.
@@ -64,6 +81,18 @@ type Metric struct {
 	Val  float64
 }
 
+// Tag is a tag of a graphite tagged series.
+type Tag struct {
+	Name  []byte
+	Value []byte
+}
+
+type tagsByName []Tag
+
+func (t tagsByName) Len() int           { return len(t) }
+func (t tagsByName) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+func (t tagsByName) Less(i, j int) bool { return bytes.Compare(t[i].Name, t[j].Name) < 0 }
+
 // ToLine converts the carbon Metric struct to a line.
 func (m *Metric) ToLine() string {
 	return string(m.Name) + " " + strconv.FormatFloat(m.Val, floatFormatByte, floatPrecision, floatBitSize) +
@@ -152,6 +181,87 @@ func ParseName(line []byte) (name []byte, rest []byte, err error) {
 	return
 }
 
+// IsTaggedName returns true if the name is in the graphite tagged series
+// format, e.g. disk.used;datacenter=dc1;server=web01.
+func IsTaggedName(name []byte) bool {
+	return bytes.IndexByte(name, TaggedSeparator) >= 0
+}
+
+// ParseTaggedName splits a name in the graphite tagged series format, e.g.
+// disk.used;datacenter=dc1;server=web01, into its path and its tags which are
+// appended to the given slice sorted by name. Names without tags are returned
+// as the path with no tags appended. The returned slices reference the name.
+func ParseTaggedName(name []byte, tags []Tag) ([]byte, []Tag, error) {
+	idx := bytes.IndexByte(name, TaggedSeparator)
+	if idx < 0 {
+		return name, tags, nil
+	}
+
+	path := name[:idx]
+	if len(path) == 0 {
+		return nil, tags, errEmptyPath
+	}
+
+	start := len(tags)
+	rest := name[idx+1:]
+	for len(rest) > 0 {
+		var tag []byte
+		if idx := bytes.IndexByte(rest, TaggedSeparator); idx >= 0 {
+			tag, rest = rest[:idx], rest[idx+1:]
+		} else {
+			tag, rest = rest, nil
+		}
+
+		valueIdx := bytes.IndexByte(tag, tagValueSeparator)
+		if valueIdx <= 0 {
+			return nil, tags[:start], fmt.Errorf("invalid tag %s in %s", tag, name)
+		}
+
+		tagName, tagValue := tag[:valueIdx], tag[valueIdx+1:]
+		if bytes.ContainsAny(tagName, invalidTagChars) {
+			return nil, tags[:start], fmt.Errorf("invalid tag name %s in %s", tagName, name)
+		}
+		if string(tagName) == NameTag || isPathTagName(tagName) {
+			return nil, tags[:start], fmt.Errorf("reserved tag name %s in %s", tagName, name)
+		}
+		// NB: a leading ~ is reserved by graphite for regexp tag expressions.
+		if len(tagValue) == 0 || tagValue[0] == '~' {
+			return nil, tags[:start], fmt.Errorf("invalid tag value %s in %s", tagValue, name)
+		}
+
+		tags = append(tags, Tag{Name: tagName, Value: tagValue})
+	}
+
+	parsed := tags[start:]
+	sort.Sort(tagsByName(parsed))
+	for i := 1; i < len(parsed); i++ {
+		if bytes.Equal(parsed[i-1].Name, parsed[i].Name) {
+			return nil, tags[:start], fmt.Errorf("duplicate tag %s in %s", parsed[i].Name, name)
+		}
+	}
+
+	return path, tags, nil
+}
+
+// isPathTagName returns true if the tag name is the name of the tags the
+// nodes of graphite paths are stored as, e.g. __g0__, which would collide
+// with the path of the series.
+func isPathTagName(name []byte) bool {
+	if len(name) <= len(pathTagPrefix)+len(pathTagSuffix) ||
+		!bytes.HasPrefix(name, pathTagPrefix) ||
+		!bytes.HasSuffix(name, pathTagSuffix) {
+		return false
+	}
+
+	for _, c := range name[len(pathTagPrefix) : len(name)-len(pathTagSuffix)] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ParseRemainder parses a line's components (name and remainder) and returns
 // all but the name and returns the timestamp of the metric, its value, the
 // time it was received and any error encountered.
@@ -120,6 +120,43 @@ func TestParseName(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestParseTaggedName(t *testing.T) {
+	path, tags, err := ParseTaggedName([]byte("disk.used;server=web01;datacenter=dc1"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "disk.used", string(path))
+	assert.Equal(t, []Tag{
+		{Name: []byte("datacenter"), Value: []byte("dc1")},
+		{Name: []byte("server"), Value: []byte("web01")},
+	}, tags)
+
+	path, tags, err = ParseTaggedName([]byte("disk.used"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "disk.used", string(path))
+	assert.Equal(t, 0, len(tags))
+
+	// Only names of path tags are reserved.
+	_, tags, err = ParseTaggedName([]byte("disk.used;__gx__=x"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []Tag{{Name: []byte("__gx__"), Value: []byte("x")}}, tags)
+
+	for _, name := range []string{
+		";dc=dc1",
+		"disk.used;dc",
+		"disk.used;=dc1",
+		"disk.used;dc=",
+		"disk.used;dc=~dc1",
+		"disk.used;d!c=dc1",
+		"disk.used;name=other",
+		"disk.used;__g0__=other",
+		"disk.used;__g12__=other",
+		"disk.used;dc=dc1;dc=dc2",
+		"disk.used;dc=dc1;;server=web01",
+	} {
+		_, _, err := ParseTaggedName([]byte(name), nil)
+		assert.Error(t, err, name)
+	}
+}
+
 func TestParseErrors(t *testing.T) {
 	assertParseError(t, " ")
 	assertParseError(t, "  ")
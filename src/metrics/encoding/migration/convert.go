@@ -60,6 +60,14 @@ func toUnaggregatedMessageUnion(
 				StagedMetadatas: metadatas,
 			},
 		}, nil
+	case metric.SetType:
+		return encoding.UnaggregatedMessageUnion{
+			Type: encoding.SetWithMetadatasType,
+			SetWithMetadatas: unaggregated.SetWithMetadatas{
+				Set:             metricUnion.Set(),
+				StagedMetadatas: metadatas,
+			},
+		}, nil
 	default:
 		return encoding.UnaggregatedMessageUnion{}, fmt.Errorf("unknown metric type: %v", metricUnion.Type)
 	}
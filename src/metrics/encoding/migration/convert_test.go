@@ -52,6 +52,11 @@ var (
 		ID:       []byte("testConvertGauge"),
 		GaugeVal: 123.456,
 	}
+	testConvertSetUnion = unaggregated.MetricUnion{
+		Type:   metric.SetType,
+		ID:     []byte("testConvertSet"),
+		SetVal: [][]byte{[]byte("foo"), []byte("bar")},
+	}
 	testConvertPoliciesList = policy.PoliciesList{
 		// Default staged policies.
 		policy.DefaultStagedPolicies,
@@ -120,6 +125,10 @@ var (
 		ID:    []byte("testConvertGauge"),
 		Value: 123.456,
 	}
+	testConvertSet = unaggregated.Set{
+		ID:     []byte("testConvertSet"),
+		Values: [][]byte{[]byte("foo"), []byte("bar")},
+	}
 	testConvertStagedMetadatas = metadata.StagedMetadatas{
 		metadata.DefaultStagedMetadata,
 		metadata.StagedMetadata{
@@ -193,6 +202,10 @@ func TestToUnaggregatedMessageUnion(t *testing.T) {
 			metricUnion:  testConvertGaugeUnion,
 			policiesList: testConvertPoliciesList,
 		},
+		{
+			metricUnion:  testConvertSetUnion,
+			policiesList: testConvertPoliciesList,
+		},
 	}
 	expected := []encoding.UnaggregatedMessageUnion{
 		{
@@ -216,6 +229,13 @@ func TestToUnaggregatedMessageUnion(t *testing.T) {
 				StagedMetadatas: testConvertStagedMetadatas,
 			},
 		},
+		{
+			Type: encoding.SetWithMetadatasType,
+			SetWithMetadatas: unaggregated.SetWithMetadatas{
+				Set:             testConvertSet,
+				StagedMetadatas: testConvertStagedMetadatas,
+			},
+		},
 	}
 
 	for i, input := range inputs {
@@ -66,6 +66,8 @@ const (
 
 	// Additional object types.
 	rawMetricWithStoragePolicyAndEncodeTimeType
+	setWithPoliciesListType
+	setType
 
 	// Total number of object types.
 	numObjectTypes = iota - 1
@@ -76,11 +78,13 @@ const (
 	numCounterWithPoliciesListFields                 = 2
 	numBatchTimerWithPoliciesListFields              = 2
 	numGaugeWithPoliciesListFields                   = 2
+	numSetWithPoliciesListFields                     = 2
 	numRawMetricWithStoragePolicyFields              = 2
 	numRawMetricWithStoragePolicyAndEncodeTimeFields = 3
 	numCounterFields                                 = 2
 	numBatchTimerFields                              = 2
 	numGaugeFields                                   = 2
+	numSetFields                                     = 2
 	numMetricFields                                  = 3
 	numDefaultStagedPoliciesListFields               = 1
 	numCustomStagedPoliciesListFields                = 2
@@ -135,4 +139,6 @@ func init() {
 	setNumFieldsForType(shortAggregationID, numShortAggregationIDFields)
 	setNumFieldsForType(longAggregationID, numLongAggregationIDFields)
 	setNumFieldsForType(policyType, numPolicyFields)
+	setNumFieldsForType(setWithPoliciesListType, numSetWithPoliciesListFields)
+	setNumFieldsForType(setType, numSetFields)
 }
@@ -239,6 +239,12 @@ type UnaggregatedEncoder interface {
 	// EncodeGaugeWithPoliciesList encodes a gauge with applicable policies list.
 	EncodeGaugeWithPoliciesList(gp unaggregated.GaugeWithPoliciesList) error
 
+	// EncodeSet encodes a set.
+	EncodeSet(s unaggregated.Set) error
+
+	// EncodeSetWithPoliciesList encodes a set with applicable policies list.
+	EncodeSetWithPoliciesList(sp unaggregated.SetWithPoliciesList) error
+
 	// Encoder returns the encoder.
 	Encoder() BufferedEncoder
 
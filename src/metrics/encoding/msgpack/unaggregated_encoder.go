@@ -30,9 +30,11 @@ type encodeRootObjectFn func(objType objectType)
 type encodeCounterWithPoliciesListFn func(cp unaggregated.CounterWithPoliciesList)
 type encodeBatchTimerWithPoliciesListFn func(btp unaggregated.BatchTimerWithPoliciesList)
 type encodeGaugeWithPoliciesListFn func(gp unaggregated.GaugeWithPoliciesList)
+type encodeSetWithPoliciesListFn func(sp unaggregated.SetWithPoliciesList)
 type encodeCounterFn func(c unaggregated.Counter)
 type encodeBatchTimerFn func(bt unaggregated.BatchTimer)
 type encodeGaugeFn func(g unaggregated.Gauge)
+type encodeSetFn func(s unaggregated.Set)
 type encodePoliciesListFn func(spl policy.PoliciesList)
 
 // unaggregatedEncoder uses MessagePack for encoding different types of unaggregated metrics.
@@ -44,9 +46,11 @@ type unaggregatedEncoder struct {
 	encodeCounterWithPoliciesListFn    encodeCounterWithPoliciesListFn
 	encodeBatchTimerWithPoliciesListFn encodeBatchTimerWithPoliciesListFn
 	encodeGaugeWithPoliciesListFn      encodeGaugeWithPoliciesListFn
+	encodeSetWithPoliciesListFn        encodeSetWithPoliciesListFn
 	encodeCounterFn                    encodeCounterFn
 	encodeBatchTimerFn                 encodeBatchTimerFn
 	encodeGaugeFn                      encodeGaugeFn
+	encodeSetFn                        encodeSetFn
 	encodePoliciesListFn               encodePoliciesListFn
 }
 
@@ -58,9 +62,11 @@ func NewUnaggregatedEncoder(encoder BufferedEncoder) UnaggregatedEncoder {
 	enc.encodeCounterWithPoliciesListFn = enc.encodeCounterWithPoliciesList
 	enc.encodeBatchTimerWithPoliciesListFn = enc.encodeBatchTimerWithPoliciesList
 	enc.encodeGaugeWithPoliciesListFn = enc.encodeGaugeWithPoliciesList
+	enc.encodeSetWithPoliciesListFn = enc.encodeSetWithPoliciesList
 	enc.encodeCounterFn = enc.encodeCounter
 	enc.encodeBatchTimerFn = enc.encodeBatchTimer
 	enc.encodeGaugeFn = enc.encodeGauge
+	enc.encodeSetFn = enc.encodeSet
 	enc.encodePoliciesListFn = enc.encodePoliciesList
 
 	return enc
@@ -96,6 +102,15 @@ func (enc *unaggregatedEncoder) EncodeGauge(g unaggregated.Gauge) error {
 	return enc.err()
 }
 
+func (enc *unaggregatedEncoder) EncodeSet(s unaggregated.Set) error {
+	if err := enc.err(); err != nil {
+		return err
+	}
+	enc.encodeRootObjectFn(setType)
+	enc.encodeSetFn(s)
+	return enc.err()
+}
+
 func (enc *unaggregatedEncoder) EncodeCounterWithPoliciesList(cp unaggregated.CounterWithPoliciesList) error {
 	if err := enc.err(); err != nil {
 		return err
@@ -123,6 +138,15 @@ func (enc *unaggregatedEncoder) EncodeGaugeWithPoliciesList(gp unaggregated.Gaug
 	return enc.err()
 }
 
+func (enc *unaggregatedEncoder) EncodeSetWithPoliciesList(sp unaggregated.SetWithPoliciesList) error {
+	if err := enc.err(); err != nil {
+		return err
+	}
+	enc.encodeRootObjectFn(setWithPoliciesListType)
+	enc.encodeSetWithPoliciesListFn(sp)
+	return enc.err()
+}
+
 func (enc *unaggregatedEncoder) encodeRootObject(objType objectType) {
 	enc.encodeVersion(unaggregatedVersion)
 	enc.encodeNumObjectFields(numFieldsForType(rootObjectType))
@@ -147,6 +171,12 @@ func (enc *unaggregatedEncoder) encodeGaugeWithPoliciesList(gp unaggregated.Gaug
 	enc.encodePoliciesListFn(gp.PoliciesList)
 }
 
+func (enc *unaggregatedEncoder) encodeSetWithPoliciesList(sp unaggregated.SetWithPoliciesList) {
+	enc.encodeNumObjectFields(numFieldsForType(setWithPoliciesListType))
+	enc.encodeSetFn(sp.Set)
+	enc.encodePoliciesListFn(sp.PoliciesList)
+}
+
 func (enc *unaggregatedEncoder) encodeCounter(c unaggregated.Counter) {
 	enc.encodeNumObjectFields(numFieldsForType(counterType))
 	enc.encodeRawID(c.ID)
@@ -168,6 +198,15 @@ func (enc *unaggregatedEncoder) encodeGauge(g unaggregated.Gauge) {
 	enc.encodeFloat64(g.Value)
 }
 
+func (enc *unaggregatedEncoder) encodeSet(s unaggregated.Set) {
+	enc.encodeNumObjectFields(numFieldsForType(setType))
+	enc.encodeRawID(s.ID)
+	enc.encodeArrayLen(len(s.Values))
+	for _, v := range s.Values {
+		enc.encodeBytes(v)
+	}
+}
+
 func (enc *unaggregatedEncoder) encodePoliciesList(pl policy.PoliciesList) {
 	if pl.IsDefault() {
 		enc.encodeNumObjectFields(numFieldsForType(defaultPoliciesListType))
@@ -95,6 +95,7 @@ func (it *unaggregatedIterator) Next() bool {
 	// Reset the pointers in metric union to reduce GC sweep overhead.
 	it.metric.BatchTimerVal = nil
 	it.metric.TimerValPool = nil
+	it.metric.SetVal = nil
 
 	return it.decodeRootObject()
 }
@@ -139,9 +140,10 @@ func (it *unaggregatedIterator) decodeRootObject() bool {
 		return false
 	}
 	switch objType {
-	case counterType, timerType, gaugeType:
+	case counterType, timerType, gaugeType, setType:
 		it.decodeMetric(objType)
-	case counterWithPoliciesListType, batchTimerWithPoliciesListType, gaugeWithPoliciesListType:
+	case counterWithPoliciesListType, batchTimerWithPoliciesListType, gaugeWithPoliciesListType,
+		setWithPoliciesListType:
 		it.decodeMetricWithPoliciesList(objType)
 	default:
 		it.setErr(fmt.Errorf("unrecognized object type %v", objType))
@@ -159,6 +161,8 @@ func (it *unaggregatedIterator) decodeMetric(objType objectType) {
 		it.decodeBatchTimer()
 	case gaugeType:
 		it.decodeGauge()
+	case setType:
+		it.decodeSet()
 	default:
 		it.setErr(fmt.Errorf("unrecognized metric type %v", objType))
 	}
@@ -176,6 +180,8 @@ func (it *unaggregatedIterator) decodeMetricWithPoliciesList(objType objectType)
 		it.decodeBatchTimer()
 	case gaugeWithPoliciesListType:
 		it.decodeGauge()
+	case setWithPoliciesListType:
+		it.decodeSet()
 	default:
 		it.setErr(fmt.Errorf("unrecognized metric with policies type %v", objType))
 		return
@@ -242,6 +248,25 @@ func (it *unaggregatedIterator) decodeGauge() {
 	it.skip(numActualFields - numExpectedFields)
 }
 
+func (it *unaggregatedIterator) decodeSet() {
+	numExpectedFields, numActualFields, ok := it.checkNumFieldsForType(setType)
+	if !ok {
+		return
+	}
+	it.metric.Type = metric.SetType
+	it.metric.ID = it.decodeID()
+	numValues := it.decodeArrayLen()
+	if numValues < 0 {
+		numValues = 0
+	}
+	values := make([][]byte, 0, numValues)
+	for i := 0; i < numValues; i++ {
+		values = append(values, it.decodeBytes())
+	}
+	it.metric.SetVal = values
+	it.skip(numActualFields - numExpectedFields)
+}
+
 func (it *unaggregatedIterator) decodePoliciesList() {
 	numActualFields := it.decodeNumObjectFields()
 	policiesListType := it.decodeObjectType()
@@ -56,6 +56,12 @@ var (
 		GaugeVal: 123.456,
 	}
 
+	testSet = unaggregated.MetricUnion{
+		Type:   metric.SetType,
+		ID:     []byte("foo"),
+		SetVal: [][]byte{[]byte("bar"), []byte("baz")},
+	}
+
 	testDefaultStagedPoliciesList = policy.DefaultPoliciesList
 
 	testSingleCustomStagedPoliciesList = policy.PoliciesList{
@@ -234,6 +240,10 @@ func TestUnaggregatedEncodeDecodeGauge(t *testing.T) {
 	validateUnaggregatedMetricRoundtrip(t, testGauge)
 }
 
+func TestUnaggregatedEncodeDecodeSet(t *testing.T) {
+	validateUnaggregatedMetricRoundtrip(t, testSet)
+}
+
 func TestUnaggregatedEncodeDecodeCounterWithDefaultPoliciesList(t *testing.T) {
 	validateUnaggregatedMetricWithPoliciesListRoundtrip(t, metricWithPoliciesList{
 		metric:       testCounter,
@@ -255,8 +265,15 @@ func TestUnaggregatedEncodeDecodeGaugeWithDefaultPoliciesList(t *testing.T) {
 	})
 }
 
+func TestUnaggregatedEncodeDecodeSetWithDefaultPoliciesList(t *testing.T) {
+	validateUnaggregatedMetricWithPoliciesListRoundtrip(t, metricWithPoliciesList{
+		metric:       testSet,
+		policiesList: testDefaultStagedPoliciesList,
+	})
+}
+
 func TestUnaggregatedEncodeDecodeAllMetricTypes(t *testing.T) {
-	inputs := []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge}
+	inputs := []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge, testSet}
 	validateUnaggregatedMetricRoundtrip(t, inputs...)
 }
 
@@ -390,6 +407,8 @@ func testUnaggregatedEncodeMetric(encoder UnaggregatedEncoder, m unaggregated.Me
 		return encoder.EncodeBatchTimer(m.BatchTimer())
 	case metric.GaugeType:
 		return encoder.EncodeGauge(m.Gauge())
+	case metric.SetType:
+		return encoder.EncodeSet(m.Set())
 	default:
 		return fmt.Errorf("unrecognized metric type %v", m.Type)
 	}
@@ -416,6 +435,11 @@ func testUnaggregatedEncodeMetricWithPoliciesList(
 			Gauge:        m.Gauge(),
 			PoliciesList: pl,
 		})
+	case metric.SetType:
+		return encoder.EncodeSetWithPoliciesList(unaggregated.SetWithPoliciesList{
+			Set:          m.Set(),
+			PoliciesList: pl,
+		})
 	default:
 		return fmt.Errorf("unrecognized metric type %v", m.Type)
 	}
@@ -434,6 +458,8 @@ func compareUnaggregatedMetric(
 		require.Equal(t, expected.BatchTimer(), actual.BatchTimer())
 	case metric.GaugeType:
 		require.Equal(t, expected.Gauge(), actual.Gauge())
+	case metric.SetType:
+		require.Equal(t, expected.Set(), actual.Set())
 	default:
 		require.Fail(t, fmt.Sprintf("unrecognized metric type %v", expected.Type))
 	}
@@ -48,6 +48,7 @@ func resetMetricWithMetadatasProto(pb *metricpb.MetricWithMetadatas) {
 	resetGaugeWithMetadatasProto(pb.GaugeWithMetadatas)
 	resetForwardedMetricWithMetadataProto(pb.ForwardedMetricWithMetadata)
 	resetTimedMetricWithMetadataProto(pb.TimedMetricWithMetadata)
+	resetSetWithMetadatasProto(pb.SetWithMetadatas)
 }
 
 func resetCounterWithMetadatasProto(pb *metricpb.CounterWithMetadatas) {
@@ -74,6 +75,14 @@ func resetGaugeWithMetadatasProto(pb *metricpb.GaugeWithMetadatas) {
 	resetMetadatas(&pb.Metadatas)
 }
 
+func resetSetWithMetadatasProto(pb *metricpb.SetWithMetadatas) {
+	if pb == nil {
+		return
+	}
+	resetSet(&pb.Set)
+	resetMetadatas(&pb.Metadatas)
+}
+
 func resetForwardedMetricWithMetadataProto(pb *metricpb.ForwardedMetricWithMetadata) {
 	if pb == nil {
 		return
@@ -122,6 +131,14 @@ func resetGauge(pb *metricpb.Gauge) {
 	pb.Value = 0.0
 }
 
+func resetSet(pb *metricpb.Set) {
+	if pb == nil {
+		return
+	}
+	pb.Id = pb.Id[:0]
+	pb.Values = pb.Values[:0]
+}
+
 func resetForwardedMetric(pb *metricpb.ForwardedMetric) {
 	if pb == nil {
 		return
@@ -68,6 +68,7 @@ type unaggregatedEncoder struct {
 	gm   metricpb.GaugeWithMetadatas
 	fm   metricpb.ForwardedMetricWithMetadata
 	tm   metricpb.TimedMetricWithMetadata
+	sm   metricpb.SetWithMetadatas
 	buf  []byte
 	used int
 
@@ -127,6 +128,8 @@ func (enc *unaggregatedEncoder) EncodeMessage(msg encoding.UnaggregatedMessageUn
 		return enc.encodeForwardedMetricWithMetadata(msg.ForwardedMetricWithMetadata)
 	case encoding.TimedMetricWithMetadataType:
 		return enc.encodeTimedMetricWithMetadata(msg.TimedMetricWithMetadata)
+	case encoding.SetWithMetadatasType:
+		return enc.encodeSetWithMetadatas(msg.SetWithMetadatas)
 	default:
 		return fmt.Errorf("unknown message type: %v", msg.Type)
 	}
@@ -165,6 +168,17 @@ func (enc *unaggregatedEncoder) encodeGaugeWithMetadatas(gm unaggregated.GaugeWi
 	return enc.encodeMetricWithMetadatas(mm)
 }
 
+func (enc *unaggregatedEncoder) encodeSetWithMetadatas(sm unaggregated.SetWithMetadatas) error {
+	if err := sm.ToProto(&enc.sm); err != nil {
+		return fmt.Errorf("set with metadatas proto conversion failed: %v", err)
+	}
+	mm := metricpb.MetricWithMetadatas{
+		Type:             metricpb.MetricWithMetadatas_SET_WITH_METADATAS,
+		SetWithMetadatas: &enc.sm,
+	}
+	return enc.encodeMetricWithMetadatas(mm)
+}
+
 func (enc *unaggregatedEncoder) encodeForwardedMetricWithMetadata(fm aggregated.ForwardedMetricWithMetadata) error {
 	if err := fm.ToProto(&enc.fm); err != nil {
 		return fmt.Errorf("forwarded metric with metadata proto conversion failed: %v", err)
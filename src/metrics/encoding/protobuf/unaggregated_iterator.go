@@ -149,6 +149,9 @@ func (it *unaggregatedIterator) decodeMessage(size int) error {
 	case metricpb.MetricWithMetadatas_TIMED_METRIC_WITH_METADATA:
 		it.msg.Type = encoding.TimedMetricWithMetadataType
 		it.err = it.msg.TimedMetricWithMetadata.FromProto(it.pb.TimedMetricWithMetadata)
+	case metricpb.MetricWithMetadatas_SET_WITH_METADATAS:
+		it.msg.Type = encoding.SetWithMetadatasType
+		it.err = it.msg.SetWithMetadatas.FromProto(it.pb.SetWithMetadatas)
 	default:
 		it.err = fmt.Errorf("unrecognized message type: %v", it.pb.Type)
 	}
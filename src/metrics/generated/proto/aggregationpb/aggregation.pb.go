@@ -52,29 +52,30 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type AggregationType int32
 
 const (
-	AggregationType_UNKNOWN AggregationType = 0
-	AggregationType_LAST    AggregationType = 1
-	AggregationType_MIN     AggregationType = 2
-	AggregationType_MAX     AggregationType = 3
-	AggregationType_MEAN    AggregationType = 4
-	AggregationType_MEDIAN  AggregationType = 5
-	AggregationType_COUNT   AggregationType = 6
-	AggregationType_SUM     AggregationType = 7
-	AggregationType_SUMSQ   AggregationType = 8
-	AggregationType_STDEV   AggregationType = 9
-	AggregationType_P10     AggregationType = 10
-	AggregationType_P20     AggregationType = 11
-	AggregationType_P30     AggregationType = 12
-	AggregationType_P40     AggregationType = 13
-	AggregationType_P50     AggregationType = 14
-	AggregationType_P60     AggregationType = 15
-	AggregationType_P70     AggregationType = 16
-	AggregationType_P80     AggregationType = 17
-	AggregationType_P90     AggregationType = 18
-	AggregationType_P95     AggregationType = 19
-	AggregationType_P99     AggregationType = 20
-	AggregationType_P999    AggregationType = 21
-	AggregationType_P9999   AggregationType = 22
+	AggregationType_UNKNOWN        AggregationType = 0
+	AggregationType_LAST           AggregationType = 1
+	AggregationType_MIN            AggregationType = 2
+	AggregationType_MAX            AggregationType = 3
+	AggregationType_MEAN           AggregationType = 4
+	AggregationType_MEDIAN         AggregationType = 5
+	AggregationType_COUNT          AggregationType = 6
+	AggregationType_SUM            AggregationType = 7
+	AggregationType_SUMSQ          AggregationType = 8
+	AggregationType_STDEV          AggregationType = 9
+	AggregationType_P10            AggregationType = 10
+	AggregationType_P20            AggregationType = 11
+	AggregationType_P30            AggregationType = 12
+	AggregationType_P40            AggregationType = 13
+	AggregationType_P50            AggregationType = 14
+	AggregationType_P60            AggregationType = 15
+	AggregationType_P70            AggregationType = 16
+	AggregationType_P80            AggregationType = 17
+	AggregationType_P90            AggregationType = 18
+	AggregationType_P95            AggregationType = 19
+	AggregationType_P99            AggregationType = 20
+	AggregationType_P999           AggregationType = 21
+	AggregationType_P9999          AggregationType = 22
+	AggregationType_COUNT_DISTINCT AggregationType = 23
 )
 
 var AggregationType_name = map[int32]string{
@@ -101,31 +102,33 @@ var AggregationType_name = map[int32]string{
 	20: "P99",
 	21: "P999",
 	22: "P9999",
+	23: "COUNT_DISTINCT",
 }
 var AggregationType_value = map[string]int32{
-	"UNKNOWN": 0,
-	"LAST":    1,
-	"MIN":     2,
-	"MAX":     3,
-	"MEAN":    4,
-	"MEDIAN":  5,
-	"COUNT":   6,
-	"SUM":     7,
-	"SUMSQ":   8,
-	"STDEV":   9,
-	"P10":     10,
-	"P20":     11,
-	"P30":     12,
-	"P40":     13,
-	"P50":     14,
-	"P60":     15,
-	"P70":     16,
-	"P80":     17,
-	"P90":     18,
-	"P95":     19,
-	"P99":     20,
-	"P999":    21,
-	"P9999":   22,
+	"UNKNOWN":        0,
+	"LAST":           1,
+	"MIN":            2,
+	"MAX":            3,
+	"MEAN":           4,
+	"MEDIAN":         5,
+	"COUNT":          6,
+	"SUM":            7,
+	"SUMSQ":          8,
+	"STDEV":          9,
+	"P10":            10,
+	"P20":            11,
+	"P30":            12,
+	"P40":            13,
+	"P50":            14,
+	"P60":            15,
+	"P70":            16,
+	"P80":            17,
+	"P90":            18,
+	"P95":            19,
+	"P99":            20,
+	"P999":           21,
+	"P9999":          22,
+	"COUNT_DISTINCT": 23,
 }
 
 func (x AggregationType) String() string {
@@ -388,25 +391,26 @@ func init() {
 }
 
 var fileDescriptorAggregation = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0xd1, 0x3d, 0x4e, 0xc3, 0x30,
-	0x1c, 0x05, 0xf0, 0xba, 0xdf, 0x75, 0x69, 0xfb, 0xc7, 0x7c, 0x88, 0x29, 0x20, 0x26, 0xc4, 0x50,
-	0x1b, 0x42, 0x81, 0x48, 0x2c, 0x81, 0x76, 0xa8, 0x20, 0x6e, 0x21, 0x09, 0x20, 0xb6, 0xa6, 0x89,
-	0x42, 0x86, 0x34, 0x55, 0x1a, 0x06, 0x6e, 0xc1, 0xb1, 0x18, 0x39, 0x02, 0x0a, 0x37, 0xe0, 0x04,
-	0xc8, 0xff, 0x0e, 0x94, 0x99, 0xed, 0xe7, 0xf7, 0x9e, 0x64, 0x4b, 0xa6, 0x32, 0x8c, 0xb2, 0xe7,
-	0x17, 0xaf, 0x3b, 0x4d, 0x62, 0x1e, 0xeb, 0xbe, 0xc7, 0x63, 0x9d, 0x2f, 0xd2, 0x29, 0x8f, 0x83,
-	0x2c, 0x8d, 0xa6, 0x0b, 0x1e, 0x06, 0xb3, 0x20, 0x9d, 0x64, 0x81, 0xcf, 0xe7, 0x69, 0x92, 0x25,
-	0x7c, 0x12, 0x86, 0x69, 0x10, 0x4e, 0xb2, 0x28, 0x99, 0xcd, 0xbd, 0xd5, 0x53, 0x17, 0x7b, 0xd6,
-	0xfa, 0x33, 0xd8, 0xdf, 0xa5, 0x2d, 0xf3, 0x37, 0x18, 0xf6, 0x59, 0x9b, 0x16, 0x23, 0x7f, 0x87,
-	0xec, 0x91, 0x83, 0xf2, 0x5d, 0x31, 0xf2, 0x0f, 0xbf, 0x09, 0xed, 0xac, 0x2c, 0x9c, 0xd7, 0x79,
-	0xc0, 0x9a, 0xb4, 0xe6, 0xca, 0x6b, 0x39, 0x7a, 0x90, 0x50, 0x60, 0x75, 0x5a, 0xbe, 0x31, 0x6d,
-	0x07, 0x08, 0xab, 0xd1, 0x92, 0x35, 0x94, 0x50, 0x44, 0x98, 0x8f, 0x50, 0x52, 0x9d, 0x35, 0x30,
-	0x25, 0x94, 0x19, 0xa5, 0x55, 0x6b, 0xd0, 0x1f, 0x9a, 0x12, 0x2a, 0xac, 0x41, 0x2b, 0x57, 0x23,
-	0x57, 0x3a, 0x50, 0x55, 0x4b, 0xdb, 0xb5, 0xa0, 0xa6, 0x32, 0xdb, 0xb5, 0xec, 0x5b, 0xa8, 0x23,
-	0x9d, 0xfe, 0xe0, 0x1e, 0x1a, 0xaa, 0x1e, 0x1f, 0x09, 0xa0, 0x88, 0x63, 0x01, 0x4d, 0x84, 0x2e,
-	0x60, 0x0d, 0x71, 0x22, 0xa0, 0x85, 0xe8, 0x09, 0x68, 0x23, 0x4e, 0x05, 0x74, 0x10, 0x67, 0x02,
-	0x00, 0x71, 0x2e, 0x60, 0x1d, 0x61, 0x08, 0x60, 0x4b, 0xf4, 0x60, 0x63, 0x09, 0x03, 0x36, 0xd5,
-	0x13, 0xc7, 0x86, 0x61, 0xc0, 0x96, 0xba, 0x57, 0xc9, 0x80, 0xed, 0x4b, 0xf9, 0x9e, 0x6b, 0xe4,
-	0x23, 0xd7, 0xc8, 0x67, 0xae, 0x91, 0xb7, 0x2f, 0xad, 0xf0, 0x74, 0xf1, 0x9f, 0x6f, 0xf0, 0xaa,
-	0x18, 0xea, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x82, 0xc4, 0x3c, 0xea, 0xcd, 0x01, 0x00, 0x00,
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0xd1, 0xbd, 0x4e, 0xc3, 0x30,
+	0x10, 0x07, 0xf0, 0xa6, 0xdf, 0x75, 0x49, 0x7b, 0x98, 0xcf, 0xa9, 0x20, 0x26, 0xc4, 0xd0, 0x18,
+	0x4a, 0x81, 0x48, 0x2c, 0xa1, 0xc9, 0x10, 0x41, 0x5c, 0x20, 0x29, 0x54, 0x2c, 0x28, 0x69, 0xa3,
+	0x90, 0xa1, 0x4d, 0x95, 0x86, 0x81, 0x17, 0x60, 0xe6, 0xb1, 0x18, 0x79, 0x04, 0x04, 0x2f, 0x82,
+	0x7d, 0x1d, 0x28, 0x33, 0xc3, 0x59, 0x3f, 0xff, 0xef, 0x24, 0x9f, 0x64, 0xc2, 0xa3, 0x38, 0x7b,
+	0x7a, 0x0e, 0xda, 0xa3, 0x64, 0xa2, 0x4d, 0x3a, 0xe3, 0x40, 0x1c, 0xda, 0x3c, 0x1d, 0x69, 0x93,
+	0x30, 0x4b, 0xe3, 0xd1, 0x5c, 0x8b, 0xc2, 0x69, 0x98, 0xfa, 0x59, 0x38, 0xd6, 0x66, 0x69, 0x92,
+	0x25, 0x9a, 0x1f, 0x45, 0x69, 0x18, 0xf9, 0x59, 0x9c, 0x4c, 0x67, 0xc1, 0xf2, 0xad, 0x8d, 0x7d,
+	0xaa, 0xfe, 0x19, 0xd8, 0xdb, 0x21, 0xaa, 0xf1, 0x1b, 0xd8, 0x26, 0x6d, 0x90, 0x7c, 0x3c, 0xde,
+	0x56, 0x76, 0x95, 0xfd, 0xe2, 0xad, 0xd0, 0xc1, 0x6b, 0x9e, 0x34, 0x97, 0x26, 0xbc, 0x97, 0x59,
+	0x48, 0xeb, 0xa4, 0x32, 0xe0, 0x97, 0xbc, 0x7f, 0xcf, 0x21, 0x47, 0xab, 0xa4, 0x78, 0x65, 0xb8,
+	0x1e, 0x28, 0xb4, 0x42, 0x0a, 0x8e, 0xcd, 0x21, 0x8f, 0x30, 0x86, 0x50, 0x90, 0x3d, 0xc7, 0x32,
+	0x38, 0x14, 0x29, 0x21, 0x65, 0xc7, 0x32, 0x6d, 0xe1, 0x12, 0xad, 0x91, 0x52, 0xaf, 0x3f, 0xe0,
+	0x1e, 0x94, 0xe5, 0xa4, 0x3b, 0x70, 0xa0, 0x22, 0x33, 0x01, 0xf7, 0x06, 0xaa, 0x48, 0xcf, 0xb4,
+	0xee, 0xa0, 0x26, 0xdb, 0xd7, 0x87, 0x0c, 0x08, 0xe2, 0x88, 0x41, 0x1d, 0xd1, 0x61, 0xb0, 0x82,
+	0x38, 0x66, 0xa0, 0x22, 0xba, 0x0c, 0x1a, 0x88, 0x13, 0x06, 0x4d, 0xc4, 0x29, 0x03, 0x40, 0x9c,
+	0x31, 0x58, 0x45, 0xe8, 0x0c, 0xe8, 0x02, 0x5d, 0x58, 0x5b, 0x40, 0x87, 0x75, 0xb9, 0xa2, 0x80,
+	0x0e, 0x1b, 0xf2, 0x5d, 0x29, 0x1d, 0x36, 0x29, 0x25, 0x0d, 0xdc, 0xf0, 0xd1, 0xb4, 0x5d, 0xcf,
+	0xe6, 0x3d, 0x0f, 0xb6, 0x2e, 0xf8, 0xfb, 0x57, 0x4b, 0xf9, 0x10, 0xf5, 0x29, 0xea, 0xed, 0xbb,
+	0x95, 0x7b, 0x38, 0xff, 0xcf, 0xd7, 0x04, 0x65, 0x0c, 0x3b, 0x3f, 0xb0, 0xf8, 0xe4, 0xb8, 0xe1,
+	0x01, 0x00, 0x00,
 }
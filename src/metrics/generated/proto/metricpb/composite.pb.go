@@ -38,6 +38,7 @@
 		TimedMetricWithStoragePolicy
 		AggregatedMetric
 		MetricWithMetadatas
+		SetWithMetadatas
 		PipelineMetadata
 		Metadata
 		StagedMetadata
@@ -49,6 +50,7 @@
 		Gauge
 		TimedMetric
 		ForwardedMetric
+		Set
 */
 package metricpb
 
@@ -80,6 +82,7 @@ const (
 	MetricWithMetadatas_GAUGE_WITH_METADATAS           MetricWithMetadatas_Type = 3
 	MetricWithMetadatas_FORWARDED_METRIC_WITH_METADATA MetricWithMetadatas_Type = 4
 	MetricWithMetadatas_TIMED_METRIC_WITH_METADATA     MetricWithMetadatas_Type = 5
+	MetricWithMetadatas_SET_WITH_METADATAS             MetricWithMetadatas_Type = 6
 )
 
 var MetricWithMetadatas_Type_name = map[int32]string{
@@ -89,6 +92,7 @@ var MetricWithMetadatas_Type_name = map[int32]string{
 	3: "GAUGE_WITH_METADATAS",
 	4: "FORWARDED_METRIC_WITH_METADATA",
 	5: "TIMED_METRIC_WITH_METADATA",
+	6: "SET_WITH_METADATAS",
 }
 var MetricWithMetadatas_Type_value = map[string]int32{
 	"UNKNOWN":                        0,
@@ -97,6 +101,7 @@ var MetricWithMetadatas_Type_value = map[string]int32{
 	"GAUGE_WITH_METADATAS":           3,
 	"FORWARDED_METRIC_WITH_METADATA": 4,
 	"TIMED_METRIC_WITH_METADATA":     5,
+	"SET_WITH_METADATAS":             6,
 }
 
 func (x MetricWithMetadatas_Type) String() string {
@@ -291,6 +296,7 @@ type MetricWithMetadatas struct {
 	GaugeWithMetadatas          *GaugeWithMetadatas          `protobuf:"bytes,4,opt,name=gauge_with_metadatas,json=gaugeWithMetadatas" json:"gauge_with_metadatas,omitempty"`
 	ForwardedMetricWithMetadata *ForwardedMetricWithMetadata `protobuf:"bytes,5,opt,name=forwarded_metric_with_metadata,json=forwardedMetricWithMetadata" json:"forwarded_metric_with_metadata,omitempty"`
 	TimedMetricWithMetadata     *TimedMetricWithMetadata     `protobuf:"bytes,6,opt,name=timed_metric_with_metadata,json=timedMetricWithMetadata" json:"timed_metric_with_metadata,omitempty"`
+	SetWithMetadatas            *SetWithMetadatas            `protobuf:"bytes,7,opt,name=set_with_metadatas,json=setWithMetadatas" json:"set_with_metadatas,omitempty"`
 }
 
 func (m *MetricWithMetadatas) Reset()                    { *m = MetricWithMetadatas{} }
@@ -340,6 +346,37 @@ func (m *MetricWithMetadatas) GetTimedMetricWithMetadata() *TimedMetricWithMetad
 	return nil
 }
 
+func (m *MetricWithMetadatas) GetSetWithMetadatas() *SetWithMetadatas {
+	if m != nil {
+		return m.SetWithMetadatas
+	}
+	return nil
+}
+
+type SetWithMetadatas struct {
+	Set       Set             `protobuf:"bytes,1,opt,name=set" json:"set"`
+	Metadatas StagedMetadatas `protobuf:"bytes,2,opt,name=metadatas" json:"metadatas"`
+}
+
+func (m *SetWithMetadatas) Reset()                    { *m = SetWithMetadatas{} }
+func (m *SetWithMetadatas) String() string            { return proto.CompactTextString(m) }
+func (*SetWithMetadatas) ProtoMessage()               {}
+func (*SetWithMetadatas) Descriptor() ([]byte, []int) { return fileDescriptorComposite, []int{8} }
+
+func (m *SetWithMetadatas) GetSet() Set {
+	if m != nil {
+		return m.Set
+	}
+	return Set{}
+}
+
+func (m *SetWithMetadatas) GetMetadatas() StagedMetadatas {
+	if m != nil {
+		return m.Metadatas
+	}
+	return StagedMetadatas{}
+}
+
 func init() {
 	proto.RegisterType((*CounterWithMetadatas)(nil), "metricpb.CounterWithMetadatas")
 	proto.RegisterType((*BatchTimerWithMetadatas)(nil), "metricpb.BatchTimerWithMetadatas")
@@ -349,6 +386,7 @@ func init() {
 	proto.RegisterType((*TimedMetricWithStoragePolicy)(nil), "metricpb.TimedMetricWithStoragePolicy")
 	proto.RegisterType((*AggregatedMetric)(nil), "metricpb.AggregatedMetric")
 	proto.RegisterType((*MetricWithMetadatas)(nil), "metricpb.MetricWithMetadatas")
+	proto.RegisterType((*SetWithMetadatas)(nil), "metricpb.SetWithMetadatas")
 	proto.RegisterEnum("metricpb.MetricWithMetadatas_Type", MetricWithMetadatas_Type_name, MetricWithMetadatas_Type_value)
 }
 func (m *CounterWithMetadatas) Marshal() (dAtA []byte, err error) {
@@ -656,6 +694,50 @@ func (m *MetricWithMetadatas) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n18
 	}
+	if m.SetWithMetadatas != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintComposite(dAtA, i, uint64(m.SetWithMetadatas.Size()))
+		n19, err := m.SetWithMetadatas.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	return i, nil
+}
+
+func (m *SetWithMetadatas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetWithMetadatas) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintComposite(dAtA, i, uint64(m.Set.Size()))
+	n20, err := m.Set.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n20
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintComposite(dAtA, i, uint64(m.Metadatas.Size()))
+	n21, err := m.Metadatas.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
 	return i, nil
 }
 
@@ -765,6 +847,20 @@ func (m *MetricWithMetadatas) Size() (n int) {
 		l = m.TimedMetricWithMetadata.Size()
 		n += 1 + l + sovComposite(uint64(l))
 	}
+	if m.SetWithMetadatas != nil {
+		l = m.SetWithMetadatas.Size()
+		n += 1 + l + sovComposite(uint64(l))
+	}
+	return n
+}
+
+func (m *SetWithMetadatas) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Set.Size()
+	n += 1 + l + sovComposite(uint64(l))
+	l = m.Metadatas.Size()
+	n += 1 + l + sovComposite(uint64(l))
 	return n
 }
 
@@ -1753,6 +1849,149 @@ func (m *MetricWithMetadatas) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetWithMetadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComposite
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComposite
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetWithMetadatas == nil {
+				m.SetWithMetadatas = &SetWithMetadatas{}
+			}
+			if err := m.SetWithMetadatas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComposite(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthComposite
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetWithMetadatas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComposite
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetWithMetadatas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetWithMetadatas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComposite
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComposite
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Set.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComposite
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComposite
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadatas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipComposite(dAtA[iNdEx:])
@@ -1884,51 +2123,54 @@ func init() {
 }
 
 var fileDescriptorComposite = []byte{
-	// 732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcd, 0x6e, 0xd3, 0x5a,
-	0x10, 0xc7, 0xeb, 0x36, 0x69, 0x7b, 0x27, 0xbd, 0xbd, 0xb9, 0xe7, 0xe6, 0x36, 0x21, 0xad, 0x0c,
-	0xb5, 0x04, 0x42, 0x42, 0xc4, 0xa2, 0x91, 0xa8, 0x50, 0x05, 0x92, 0xf3, 0xd1, 0x34, 0x42, 0x4d,
-	0x91, 0xeb, 0x2a, 0x12, 0x8b, 0x5a, 0xfe, 0xaa, 0x63, 0x44, 0x62, 0xcb, 0x3e, 0x51, 0x55, 0xb1,
-	0x61, 0x09, 0x3b, 0x24, 0xc4, 0x1b, 0xb0, 0xe5, 0x3d, 0xba, 0x83, 0x27, 0x40, 0xa8, 0xbc, 0x08,
-	0xb2, 0x7d, 0x1c, 0xdb, 0xc7, 0x0e, 0x42, 0xed, 0xce, 0x9e, 0x99, 0xff, 0x6f, 0xfe, 0x39, 0x9e,
-	0x39, 0x81, 0x9e, 0x69, 0xe1, 0xd1, 0x54, 0x6d, 0x68, 0xf6, 0x98, 0x1f, 0x37, 0x75, 0x95, 0x1f,
-	0x37, 0x79, 0xcf, 0xd5, 0xf8, 0xb1, 0x81, 0x5d, 0x4b, 0xf3, 0x78, 0xd3, 0x98, 0x18, 0xae, 0x82,
-	0x0d, 0x9d, 0x77, 0x5c, 0x1b, 0xdb, 0x24, 0xee, 0xa8, 0xbc, 0x66, 0x8f, 0x1d, 0xdb, 0xb3, 0xb0,
-	0xd1, 0x08, 0x12, 0x68, 0x35, 0xca, 0xd4, 0x1f, 0x26, 0x90, 0xa6, 0x6d, 0xda, 0xa1, 0x52, 0x9d,
-	0x9e, 0x05, 0x6f, 0x21, 0xc6, 0x7f, 0x0a, 0x85, 0xf5, 0xce, 0x75, 0x1d, 0x84, 0x0f, 0x84, 0xb2,
-	0x7f, 0x03, 0x8a, 0xa2, 0x2b, 0x58, 0xb9, 0xa6, 0x1b, 0xc7, 0x7e, 0x6d, 0x69, 0x17, 0x8e, 0x4a,
-	0x1e, 0x42, 0x0a, 0xf7, 0x8e, 0x81, 0x4a, 0xdb, 0x9e, 0x4e, 0xb0, 0xe1, 0x0e, 0x2d, 0x3c, 0x3a,
-	0x24, 0x3d, 0x3c, 0xf4, 0x08, 0x56, 0xb4, 0x30, 0x5e, 0x63, 0xee, 0x30, 0xf7, 0x4b, 0x3b, 0xff,
-	0x36, 0x22, 0x27, 0x0d, 0x22, 0x68, 0x15, 0x2e, 0xbf, 0xdf, 0x5e, 0x10, 0xa3, 0x3a, 0xf4, 0x14,
-	0xfe, 0x8a, 0x3c, 0x7a, 0xb5, 0xc5, 0x40, 0x74, 0x2b, 0x16, 0x1d, 0x63, 0xc5, 0x34, 0xf4, 0x59,
-	0x03, 0x22, 0x8e, 0x15, 0xdc, 0x27, 0x06, 0xaa, 0x2d, 0x05, 0x6b, 0x23, 0xc9, 0x1a, 0xd3, 0x6e,
-	0xf6, 0xa0, 0xa4, 0xfa, 0x29, 0x19, 0xfb, 0x39, 0xe2, 0xa8, 0x12, 0xc3, 0x63, 0x1d, 0xe1, 0x82,
-	0x3a, 0x8b, 0xdc, 0xd4, 0xd7, 0x5b, 0x06, 0x50, 0x4f, 0x99, 0x9a, 0x46, 0xda, 0xd2, 0x03, 0x28,
-	0x9a, 0x7e, 0x94, 0x98, 0xf9, 0x27, 0x26, 0x06, 0xc5, 0x84, 0x13, 0xd6, 0xdc, 0xd4, 0xc2, 0x47,
-	0x06, 0x36, 0xf7, 0x6d, 0xf7, 0x5c, 0x71, 0xf5, 0xa0, 0xce, 0xb5, 0xb4, 0xa4, 0x19, 0xb4, 0x0b,
-	0xcb, 0x21, 0x8c, 0x98, 0x49, 0xb0, 0x29, 0x19, 0x61, 0x93, 0x72, 0xb4, 0x07, 0xab, 0x51, 0x97,
-	0xac, 0x2d, 0x22, 0x8d, 0xba, 0x10, 0xe9, 0x4c, 0xc0, 0xbd, 0x67, 0xa0, 0xea, 0x9f, 0x70, 0x9e,
-	0xa3, 0x26, 0xe5, 0xe8, 0xff, 0x18, 0x9b, 0x90, 0x50, 0x6e, 0x9e, 0x64, 0xdc, 0x54, 0xb3, 0xb2,
-	0x7c, 0x2f, 0x9f, 0x19, 0xd8, 0xa2, 0xbc, 0x1c, 0x63, 0xdb, 0x55, 0x4c, 0xe3, 0x45, 0x30, 0xee,
-	0xe8, 0x19, 0xac, 0xf9, 0xb3, 0xa3, 0xcb, 0x7f, 0x6e, 0xab, 0x84, 0xe3, 0x10, 0xea, 0xc0, 0xba,
-	0x17, 0x02, 0xe5, 0x70, 0x81, 0x66, 0x0e, 0xa3, 0xc5, 0x6a, 0xa4, 0x1a, 0x12, 0xc6, 0xdf, 0x5e,
-	0x32, 0xc8, 0xbd, 0x81, 0xb2, 0x60, 0x9a, 0xae, 0x61, 0xfa, 0x8b, 0x39, 0x23, 0xa7, 0x8f, 0xea,
-	0x5e, 0xae, 0xa7, 0xcc, 0x2f, 0xa2, 0xce, 0x6e, 0x1b, 0xd6, 0x8c, 0x89, 0x66, 0xeb, 0x86, 0x3c,
-	0x51, 0x26, 0x76, 0x38, 0x64, 0x4b, 0x62, 0x29, 0x8c, 0x0d, 0xfc, 0x10, 0xf7, 0xb5, 0x08, 0xff,
-	0x65, 0x3f, 0x95, 0x87, 0x1e, 0x43, 0x01, 0x5f, 0x38, 0xe1, 0x20, 0xaf, 0xef, 0x70, 0x71, 0xfb,
-	0x9c, 0xe2, 0x86, 0x74, 0xe1, 0x18, 0x62, 0x50, 0x8f, 0x24, 0xd8, 0x20, 0xab, 0x2f, 0x9f, 0x5b,
-	0x78, 0x24, 0xd3, 0x13, 0xce, 0x66, 0x6e, 0x8c, 0x14, 0x4a, 0xac, 0x68, 0x79, 0x17, 0xcf, 0x29,
-	0xd4, 0x13, 0xab, 0x4e, 0x93, 0x97, 0x02, 0xf2, 0x76, 0xde, 0xe6, 0xa7, 0xe1, 0x55, 0x75, 0xce,
-	0x55, 0x32, 0x80, 0x4a, 0xb0, 0x93, 0x34, 0xb9, 0x10, 0x90, 0xb7, 0xa8, 0x35, 0x4e, 0x43, 0x91,
-	0x99, 0xbd, 0x07, 0x5e, 0x01, 0x7b, 0x16, 0xed, 0x18, 0x19, 0xae, 0x34, 0xba, 0x56, 0x0c, 0xc8,
-	0x77, 0xe7, 0xee, 0x64, 0x92, 0x27, 0x6e, 0x9e, 0xfd, 0x66, 0xcf, 0x4f, 0xa1, 0x9e, 0x1c, 0x62,
-	0xaa, 0xcf, 0x32, 0x7d, 0x36, 0x73, 0x96, 0x53, 0xac, 0xe2, 0xfc, 0x04, 0xf7, 0x85, 0x81, 0x82,
-	0xff, 0x81, 0x51, 0x09, 0x56, 0x4e, 0x06, 0xcf, 0x07, 0x47, 0xc3, 0x41, 0x79, 0x01, 0xd5, 0x61,
-	0xa3, 0x7d, 0x74, 0x32, 0x90, 0xba, 0xa2, 0x3c, 0xec, 0x4b, 0x07, 0xf2, 0x61, 0x57, 0x12, 0x3a,
-	0x82, 0x24, 0x1c, 0x97, 0x19, 0xc4, 0x42, 0xbd, 0x25, 0x48, 0xed, 0x03, 0x59, 0xea, 0x1f, 0x66,
-	0xf3, 0x8b, 0xa8, 0x06, 0x95, 0x9e, 0x70, 0xd2, 0xeb, 0xd2, 0x99, 0x25, 0xc4, 0x01, 0xbb, 0x7f,
-	0x24, 0x0e, 0x05, 0xb1, 0xd3, 0xed, 0xf8, 0x09, 0xb1, 0xdf, 0x4e, 0x17, 0x95, 0x0b, 0x3e, 0xdd,
-	0xe7, 0xce, 0xc9, 0x17, 0x5b, 0xfd, 0xcb, 0x2b, 0x96, 0xf9, 0x76, 0xc5, 0x32, 0x3f, 0xae, 0x58,
-	0xe6, 0xc3, 0x4f, 0x76, 0xe1, 0xe5, 0xee, 0x35, 0xff, 0x5d, 0xd5, 0xe5, 0xe0, 0xbd, 0xf9, 0x2b,
-	0x00, 0x00, 0xff, 0xff, 0x1a, 0x70, 0xed, 0x30, 0x67, 0x08, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0x96, 0xdf, 0x6b, 0xd3, 0x50,
+	0x14, 0xc7, 0x17, 0xdb, 0x75, 0xf3, 0x74, 0x9b, 0xf5, 0x5a, 0xd7, 0xda, 0x8d, 0xea, 0x02, 0x13,
+	0x41, 0x6c, 0x71, 0x03, 0x87, 0x88, 0x42, 0xfa, 0x63, 0x5d, 0x91, 0x75, 0x92, 0x66, 0x14, 0x7c,
+	0x30, 0xe4, 0xd7, 0xb2, 0x8a, 0x6d, 0x4a, 0x72, 0xcb, 0x1c, 0xbe, 0xf8, 0xa8, 0x6f, 0x82, 0xf8,
+	0x1f, 0xf8, 0x0f, 0xf8, 0x47, 0x08, 0x7b, 0xf4, 0x2f, 0x10, 0xd1, 0x7f, 0xc4, 0x9b, 0xe4, 0xa6,
+	0x49, 0x6e, 0x5a, 0x91, 0xf5, 0x21, 0x25, 0x39, 0xe7, 0x7c, 0x3f, 0xf7, 0x9b, 0x93, 0x7b, 0x2e,
+	0x85, 0x96, 0xd9, 0xc7, 0xa7, 0x63, 0xb5, 0xa2, 0x59, 0x83, 0xea, 0x60, 0x57, 0x57, 0xc9, 0x4f,
+	0xd5, 0xb1, 0xb5, 0xea, 0xc0, 0xc0, 0x76, 0x5f, 0x73, 0xaa, 0xa6, 0x31, 0x34, 0x6c, 0x05, 0x1b,
+	0x7a, 0x75, 0x64, 0x5b, 0xd8, 0xa2, 0xf1, 0x91, 0x5a, 0x25, 0x82, 0x91, 0xe5, 0xf4, 0xb1, 0x51,
+	0xf1, 0x12, 0x68, 0x39, 0xc8, 0x94, 0x1e, 0x44, 0x90, 0xa6, 0x65, 0x5a, 0xbe, 0x52, 0x1d, 0x9f,
+	0x78, 0x4f, 0x3e, 0xc6, 0xbd, 0xf3, 0x85, 0xa5, 0xc6, 0x65, 0x1d, 0xf8, 0x37, 0x94, 0xb2, 0x3f,
+	0x07, 0x45, 0xd1, 0x15, 0xac, 0x5c, 0xd2, 0xcd, 0xc8, 0x7a, 0xd3, 0xd7, 0xce, 0x09, 0xc7, 0xbf,
+	0xf1, 0x29, 0xfc, 0x07, 0x0e, 0xf2, 0x75, 0x6b, 0x3c, 0xc4, 0x86, 0xdd, 0x23, 0xbc, 0x43, 0xba,
+	0x86, 0x83, 0x1e, 0xc2, 0x92, 0xe6, 0xc7, 0x8b, 0xdc, 0x1d, 0xee, 0x5e, 0x76, 0xe7, 0x7a, 0x25,
+	0x70, 0x52, 0xa1, 0x82, 0x5a, 0xfa, 0xe2, 0xe7, 0xed, 0x05, 0x31, 0xa8, 0x43, 0x4f, 0xe1, 0x6a,
+	0xe0, 0xd1, 0x29, 0x5e, 0xf1, 0x44, 0xb7, 0x42, 0x51, 0x17, 0x2b, 0xa6, 0xa1, 0x4f, 0x16, 0xa0,
+	0xe2, 0x50, 0xc1, 0x7f, 0xe1, 0xa0, 0x50, 0x53, 0xb0, 0x76, 0x2a, 0xf5, 0x07, 0xac, 0x9b, 0x27,
+	0x90, 0x55, 0xdd, 0x94, 0x8c, 0xdd, 0x1c, 0x75, 0x94, 0x0f, 0xe1, 0xa1, 0x8e, 0x72, 0x41, 0x9d,
+	0x44, 0xe6, 0xf5, 0xf5, 0x9e, 0x03, 0xd4, 0x52, 0xc6, 0xa6, 0x11, 0xb7, 0x74, 0x1f, 0x16, 0x4d,
+	0x37, 0x4a, 0xcd, 0x5c, 0x0b, 0x89, 0x5e, 0x31, 0xe5, 0xf8, 0x35, 0xf3, 0x5a, 0xf8, 0xcc, 0xc1,
+	0xc6, 0xbe, 0x65, 0x9f, 0x29, 0xb6, 0xee, 0xd5, 0x11, 0x59, 0xd4, 0x0c, 0xda, 0x83, 0x8c, 0x0f,
+	0xa3, 0x66, 0x22, 0x6c, 0x46, 0x46, 0xd9, 0xb4, 0x9c, 0xf4, 0x75, 0x39, 0x58, 0x25, 0x69, 0x8b,
+	0x4a, 0x83, 0x55, 0xa8, 0x74, 0x22, 0xe0, 0x3f, 0x92, 0x0f, 0xe6, 0x76, 0x78, 0x9a, 0xa3, 0x5d,
+	0xc6, 0xd1, 0xcd, 0x10, 0x1b, 0x91, 0x30, 0x6e, 0x1e, 0x27, 0xdc, 0x14, 0x92, 0xb2, 0xe9, 0x5e,
+	0xbe, 0x72, 0xb0, 0xc9, 0x78, 0xe9, 0x62, 0xcb, 0x26, 0x7d, 0x7d, 0xe1, 0x6d, 0x77, 0xf4, 0x0c,
+	0x56, 0xdc, 0xbd, 0xa3, 0xcb, 0xff, 0x6f, 0x2b, 0x8b, 0xc3, 0x10, 0x6a, 0xc0, 0x9a, 0xe3, 0x03,
+	0x65, 0x7f, 0x80, 0x26, 0x0e, 0x83, 0xc1, 0xaa, 0xc4, 0x16, 0xa4, 0x8c, 0x55, 0x27, 0x1a, 0xe4,
+	0xdf, 0x41, 0x4e, 0x30, 0x4d, 0xdb, 0x30, 0xdd, 0xc1, 0x9c, 0x90, 0xe3, 0xad, 0xba, 0x3b, 0xd5,
+	0x53, 0xe2, 0x8d, 0x98, 0xde, 0x6d, 0xc1, 0x8a, 0x31, 0xd4, 0x2c, 0xdd, 0x90, 0x87, 0xca, 0xd0,
+	0xf2, 0x37, 0x59, 0x4a, 0xcc, 0xfa, 0xb1, 0x8e, 0x1b, 0xe2, 0xbf, 0x65, 0xe0, 0x46, 0xf2, 0x53,
+	0x39, 0xe8, 0x11, 0xa4, 0xf1, 0xf9, 0xc8, 0xdf, 0xc8, 0x6b, 0x3b, 0x7c, 0xb8, 0xfc, 0x94, 0xe2,
+	0x8a, 0x44, 0x2a, 0x45, 0xaf, 0x1e, 0x49, 0xb0, 0x4e, 0x47, 0x5f, 0x3e, 0x23, 0x35, 0x32, 0xbb,
+	0xc3, 0xcb, 0x89, 0x13, 0x23, 0x86, 0x12, 0xf3, 0xda, 0xb4, 0x83, 0xe7, 0x15, 0x94, 0x22, 0xa3,
+	0xce, 0x92, 0x53, 0x1e, 0x79, 0x6b, 0xda, 0xe4, 0xc7, 0xe1, 0x05, 0x75, 0xc6, 0x51, 0xd2, 0x81,
+	0xbc, 0x37, 0x93, 0x2c, 0x39, 0xed, 0x91, 0x37, 0x99, 0x31, 0x8e, 0x43, 0x91, 0x99, 0x3c, 0x07,
+	0x5e, 0x43, 0xf9, 0x24, 0x98, 0x31, 0xba, 0xb9, 0xe2, 0xe8, 0xe2, 0xa2, 0x47, 0xde, 0x9e, 0x39,
+	0x93, 0x51, 0x9e, 0xb8, 0x71, 0xf2, 0x8f, 0x39, 0x27, 0xbd, 0x89, 0x6e, 0x62, 0x66, 0x9d, 0x0c,
+	0xdb, 0x9b, 0x19, 0xc3, 0x29, 0x16, 0xf0, 0x8c, 0xa9, 0x3d, 0x00, 0xe4, 0x18, 0x98, 0xed, 0xcc,
+	0x92, 0xc7, 0x2d, 0x45, 0xce, 0x2b, 0x03, 0xc7, 0xfb, 0x92, 0x73, 0x98, 0x08, 0xff, 0x9d, 0x83,
+	0xb4, 0xbb, 0x55, 0x50, 0x16, 0x96, 0x8e, 0x3b, 0xcf, 0x3b, 0x47, 0xbd, 0x4e, 0x6e, 0x01, 0x95,
+	0x60, 0xbd, 0x7e, 0x74, 0xdc, 0x91, 0x9a, 0xa2, 0xdc, 0x6b, 0x4b, 0x07, 0xf2, 0x61, 0x53, 0x12,
+	0x1a, 0x82, 0x24, 0x74, 0x73, 0x1c, 0x2a, 0x43, 0xa9, 0x26, 0x48, 0xf5, 0x03, 0x59, 0x6a, 0x1f,
+	0x26, 0xf3, 0x57, 0x50, 0x11, 0xf2, 0x2d, 0xe1, 0xb8, 0xd5, 0x64, 0x33, 0x29, 0xc4, 0x43, 0x79,
+	0xff, 0x48, 0xec, 0x09, 0x62, 0xa3, 0xd9, 0x70, 0x13, 0x62, 0xbb, 0x1e, 0x2f, 0xca, 0xa5, 0x5d,
+	0xba, 0xcb, 0x9d, 0x91, 0x5f, 0x44, 0xeb, 0x80, 0xba, 0x4d, 0x89, 0x65, 0x67, 0xf8, 0xb7, 0x90,
+	0x63, 0xdf, 0x16, 0x6d, 0x43, 0x8a, 0xbc, 0x2f, 0x9d, 0xd6, 0xd5, 0x58, 0x5b, 0xe8, 0x50, 0xba,
+	0xf9, 0x39, 0xcf, 0xfc, 0x5a, 0xfb, 0xe2, 0x77, 0x99, 0xfb, 0x41, 0xae, 0x5f, 0xe4, 0xfa, 0xf4,
+	0xa7, 0xbc, 0xf0, 0x72, 0xef, 0x92, 0xff, 0x1c, 0xd4, 0x8c, 0xf7, 0xbc, 0xfb, 0x17, 0xfe, 0xa8,
+	0x87, 0x17, 0x43, 0x09, 0x00, 0x00,
 }
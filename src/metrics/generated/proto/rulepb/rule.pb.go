@@ -316,6 +316,7 @@ type RuleSet struct {
 	MappingRules       []*MappingRule `protobuf:"bytes,7,rep,name=mapping_rules,json=mappingRules" json:"mapping_rules,omitempty"`
 	RollupRules        []*RollupRule  `protobuf:"bytes,8,rep,name=rollup_rules,json=rollupRules" json:"rollup_rules,omitempty"`
 	LastUpdatedBy      string         `protobuf:"bytes,9,opt,name=last_updated_by,json=lastUpdatedBy,proto3" json:"last_updated_by,omitempty"`
+	IngestRules        []*IngestRule  `protobuf:"bytes,10,rep,name=ingest_rules,json=ingestRules" json:"ingest_rules,omitempty"`
 }
 
 func (m *RuleSet) Reset()                    { *m = RuleSet{} }
@@ -386,6 +387,173 @@ func (m *RuleSet) GetLastUpdatedBy() string {
 	return ""
 }
 
+func (m *RuleSet) GetIngestRules() []*IngestRule {
+	if m != nil {
+		return m.IngestRules
+	}
+	return nil
+}
+
+type IngestTag struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *IngestTag) Reset()                    { *m = IngestTag{} }
+func (m *IngestTag) String() string            { return proto.CompactTextString(m) }
+func (*IngestTag) ProtoMessage()               {}
+func (*IngestTag) Descriptor() ([]byte, []int) { return fileDescriptorRule, []int{7} }
+
+func (m *IngestTag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IngestTag) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type IngestTagRename struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (m *IngestTagRename) Reset()                    { *m = IngestTagRename{} }
+func (m *IngestTagRename) String() string            { return proto.CompactTextString(m) }
+func (*IngestTagRename) ProtoMessage()               {}
+func (*IngestTagRename) Descriptor() ([]byte, []int) { return fileDescriptorRule, []int{8} }
+
+func (m *IngestTagRename) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *IngestTagRename) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type IngestRuleSnapshot struct {
+	Name               string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tombstoned         bool               `protobuf:"varint,2,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	CutoverNanos       int64              `protobuf:"varint,3,opt,name=cutover_nanos,json=cutoverNanos,proto3" json:"cutover_nanos,omitempty"`
+	Filter             string             `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	LastUpdatedAtNanos int64              `protobuf:"varint,5,opt,name=last_updated_at_nanos,json=lastUpdatedAtNanos,proto3" json:"last_updated_at_nanos,omitempty"`
+	LastUpdatedBy      string             `protobuf:"bytes,6,opt,name=last_updated_by,json=lastUpdatedBy,proto3" json:"last_updated_by,omitempty"`
+	Drop               bool               `protobuf:"varint,7,opt,name=drop,proto3" json:"drop,omitempty"`
+	DropTags           []string           `protobuf:"bytes,8,rep,name=drop_tags,json=dropTags" json:"drop_tags,omitempty"`
+	RenameTags         []*IngestTagRename `protobuf:"bytes,9,rep,name=rename_tags,json=renameTags" json:"rename_tags,omitempty"`
+	AddTags            []*IngestTag       `protobuf:"bytes,10,rep,name=add_tags,json=addTags" json:"add_tags,omitempty"`
+}
+
+func (m *IngestRuleSnapshot) Reset()                    { *m = IngestRuleSnapshot{} }
+func (m *IngestRuleSnapshot) String() string            { return proto.CompactTextString(m) }
+func (*IngestRuleSnapshot) ProtoMessage()               {}
+func (*IngestRuleSnapshot) Descriptor() ([]byte, []int) { return fileDescriptorRule, []int{9} }
+
+func (m *IngestRuleSnapshot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IngestRuleSnapshot) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *IngestRuleSnapshot) GetCutoverNanos() int64 {
+	if m != nil {
+		return m.CutoverNanos
+	}
+	return 0
+}
+
+func (m *IngestRuleSnapshot) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *IngestRuleSnapshot) GetLastUpdatedAtNanos() int64 {
+	if m != nil {
+		return m.LastUpdatedAtNanos
+	}
+	return 0
+}
+
+func (m *IngestRuleSnapshot) GetLastUpdatedBy() string {
+	if m != nil {
+		return m.LastUpdatedBy
+	}
+	return ""
+}
+
+func (m *IngestRuleSnapshot) GetDrop() bool {
+	if m != nil {
+		return m.Drop
+	}
+	return false
+}
+
+func (m *IngestRuleSnapshot) GetDropTags() []string {
+	if m != nil {
+		return m.DropTags
+	}
+	return nil
+}
+
+func (m *IngestRuleSnapshot) GetRenameTags() []*IngestTagRename {
+	if m != nil {
+		return m.RenameTags
+	}
+	return nil
+}
+
+func (m *IngestRuleSnapshot) GetAddTags() []*IngestTag {
+	if m != nil {
+		return m.AddTags
+	}
+	return nil
+}
+
+type IngestRule struct {
+	Uuid      string                `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Snapshots []*IngestRuleSnapshot `protobuf:"bytes,2,rep,name=snapshots" json:"snapshots,omitempty"`
+}
+
+func (m *IngestRule) Reset()                    { *m = IngestRule{} }
+func (m *IngestRule) String() string            { return proto.CompactTextString(m) }
+func (*IngestRule) ProtoMessage()               {}
+func (*IngestRule) Descriptor() ([]byte, []int) { return fileDescriptorRule, []int{10} }
+
+func (m *IngestRule) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *IngestRule) GetSnapshots() []*IngestRuleSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MappingRuleSnapshot)(nil), "rulepb.MappingRuleSnapshot")
 	proto.RegisterType((*MappingRule)(nil), "rulepb.MappingRule")
@@ -394,6 +562,10 @@ func init() {
 	proto.RegisterType((*RollupRuleSnapshot)(nil), "rulepb.RollupRuleSnapshot")
 	proto.RegisterType((*RollupRule)(nil), "rulepb.RollupRule")
 	proto.RegisterType((*RuleSet)(nil), "rulepb.RuleSet")
+	proto.RegisterType((*IngestTag)(nil), "rulepb.IngestTag")
+	proto.RegisterType((*IngestTagRename)(nil), "rulepb.IngestTagRename")
+	proto.RegisterType((*IngestRuleSnapshot)(nil), "rulepb.IngestRuleSnapshot")
+	proto.RegisterType((*IngestRule)(nil), "rulepb.IngestRule")
 }
 func (m *MappingRuleSnapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -822,130 +994,343 @@ func (m *RuleSet) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRule(dAtA, i, uint64(len(m.LastUpdatedBy)))
 		i += copy(dAtA[i:], m.LastUpdatedBy)
 	}
+	if len(m.IngestRules) > 0 {
+		for _, msg := range m.IngestRules {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintRule(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func encodeVarintRule(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *IngestTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *MappingRuleSnapshot) Size() (n int) {
+
+func (m *IngestTag) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRule(uint64(l))
-	}
-	if m.Tombstoned {
-		n += 2
-	}
-	if m.CutoverNanos != 0 {
-		n += 1 + sovRule(uint64(m.CutoverNanos))
-	}
-	l = len(m.Filter)
-	if l > 0 {
-		n += 1 + l + sovRule(uint64(l))
-	}
-	if len(m.Policies) > 0 {
-		for _, e := range m.Policies {
-			l = e.Size()
-			n += 1 + l + sovRule(uint64(l))
-		}
-	}
-	if m.LastUpdatedAtNanos != 0 {
-		n += 1 + sovRule(uint64(m.LastUpdatedAtNanos))
-	}
-	l = len(m.LastUpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovRule(uint64(l))
-	}
-	if len(m.AggregationTypes) > 0 {
-		l = 0
-		for _, e := range m.AggregationTypes {
-			l += sovRule(uint64(e))
-		}
-		n += 1 + sovRule(uint64(l)) + l
-	}
-	if len(m.StoragePolicies) > 0 {
-		for _, e := range m.StoragePolicies {
-			l = e.Size()
-			n += 1 + l + sovRule(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRule(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.DropPolicy != 0 {
-		n += 1 + sovRule(uint64(m.DropPolicy))
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRule(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
-	return n
+	return i, nil
 }
 
-func (m *MappingRule) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovRule(uint64(l))
-	}
-	if len(m.Snapshots) > 0 {
-		for _, e := range m.Snapshots {
-			l = e.Size()
-			n += 1 + l + sovRule(uint64(l))
-		}
+func (m *IngestTagRename) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RollupTarget) Size() (n int) {
+func (m *IngestTagRename) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRule(uint64(l))
-	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
-			n += 1 + l + sovRule(uint64(l))
-		}
+	if len(m.From) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRule(dAtA, i, uint64(len(m.From)))
+		i += copy(dAtA[i:], m.From)
 	}
-	if len(m.Policies) > 0 {
-		for _, e := range m.Policies {
-			l = e.Size()
-			n += 1 + l + sovRule(uint64(l))
-		}
+	if len(m.To) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRule(dAtA, i, uint64(len(m.To)))
+		i += copy(dAtA[i:], m.To)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RollupTargetV2) Size() (n int) {
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovRule(uint64(l))
-	}
-	if len(m.StoragePolicies) > 0 {
-		for _, e := range m.StoragePolicies {
-			l = e.Size()
-			n += 1 + l + sovRule(uint64(l))
-		}
+func (m *IngestRuleSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RollupRuleSnapshot) Size() (n int) {
+func (m *IngestRuleSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRule(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRule(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	if m.Tombstoned {
-		n += 2
+		dAtA[i] = 0x10
+		i++
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CutoverNanos != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRule(dAtA, i, uint64(m.CutoverNanos))
+	}
+	if len(m.Filter) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRule(dAtA, i, uint64(len(m.Filter)))
+		i += copy(dAtA[i:], m.Filter)
+	}
+	if m.LastUpdatedAtNanos != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRule(dAtA, i, uint64(m.LastUpdatedAtNanos))
+	}
+	if len(m.LastUpdatedBy) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRule(dAtA, i, uint64(len(m.LastUpdatedBy)))
+		i += copy(dAtA[i:], m.LastUpdatedBy)
+	}
+	if m.Drop {
+		dAtA[i] = 0x38
+		i++
+		if m.Drop {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.DropTags) > 0 {
+		for _, s := range m.DropTags {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.RenameTags) > 0 {
+		for _, msg := range m.RenameTags {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintRule(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.AddTags) > 0 {
+		for _, msg := range m.AddTags {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintRule(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *IngestRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngestRule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uuid) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRule(dAtA, i, uint64(len(m.Uuid)))
+		i += copy(dAtA[i:], m.Uuid)
+	}
+	if len(m.Snapshots) > 0 {
+		for _, msg := range m.Snapshots {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRule(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintRule(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *MappingRuleSnapshot) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.CutoverNanos != 0 {
+		n += 1 + sovRule(uint64(m.CutoverNanos))
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
+	if m.LastUpdatedAtNanos != 0 {
+		n += 1 + sovRule(uint64(m.LastUpdatedAtNanos))
+	}
+	l = len(m.LastUpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if len(m.AggregationTypes) > 0 {
+		l = 0
+		for _, e := range m.AggregationTypes {
+			l += sovRule(uint64(e))
+		}
+		n += 1 + sovRule(uint64(l)) + l
+	}
+	if len(m.StoragePolicies) > 0 {
+		for _, e := range m.StoragePolicies {
+			l = e.Size()
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
+	if m.DropPolicy != 0 {
+		n += 1 + sovRule(uint64(m.DropPolicy))
+	}
+	return n
+}
+
+func (m *MappingRule) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RollupTarget) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RollupTargetV2) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if len(m.StoragePolicies) > 0 {
+		for _, e := range m.StoragePolicies {
+			l = e.Size()
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RollupRuleSnapshot) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if m.Tombstoned {
+		n += 2
 	}
 	if m.CutoverNanos != 0 {
 		n += 1 + sovRule(uint64(m.CutoverNanos))
@@ -1031,6 +1416,104 @@ func (m *RuleSet) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRule(uint64(l))
 	}
+	if len(m.IngestRules) > 0 {
+		for _, e := range m.IngestRules {
+			l = e.Size()
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IngestTag) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	return n
+}
+
+func (m *IngestTagRename) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	return n
+}
+
+func (m *IngestRuleSnapshot) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.CutoverNanos != 0 {
+		n += 1 + sovRule(uint64(m.CutoverNanos))
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if m.LastUpdatedAtNanos != 0 {
+		n += 1 + sovRule(uint64(m.LastUpdatedAtNanos))
+	}
+	l = len(m.LastUpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if m.Drop {
+		n += 2
+	}
+	if len(m.DropTags) > 0 {
+		for _, s := range m.DropTags {
+			l = len(s)
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
+	if len(m.RenameTags) > 0 {
+		for _, e := range m.RenameTags {
+			l = e.Size()
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
+	if len(m.AddTags) > 0 {
+		for _, e := range m.AddTags {
+			l = e.Size()
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IngestRule) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovRule(uint64(l))
+	}
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovRule(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1057,28 +1540,175 @@ func (m *MappingRuleSnapshot) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowRule
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MappingRuleSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MappingRuleSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CutoverNanos", wireType)
+			}
+			m.CutoverNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CutoverNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Policies = append(m.Policies, &policypb.Policy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MappingRuleSnapshot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MappingRuleSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAtNanos", wireType)
+			}
+			m.LastUpdatedAtNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedAtNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1103,13 +1733,75 @@ func (m *MappingRuleSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.LastUpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+		case 8:
+			if wireType == 0 {
+				var v aggregationpb.AggregationType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRule
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (aggregationpb.AggregationType(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AggregationTypes = append(m.AggregationTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRule
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRule
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v aggregationpb.AggregationType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRule
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (aggregationpb.AggregationType(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AggregationTypes = append(m.AggregationTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationTypes", wireType)
 			}
-			var v int
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoragePolicies", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -1119,17 +1811,28 @@ func (m *MappingRuleSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoragePolicies = append(m.StoragePolicies, &policypb.StoragePolicy{})
+			if err := m.StoragePolicies[len(m.StoragePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CutoverNanos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropPolicy", wireType)
 			}
-			m.CutoverNanos = 0
+			m.DropPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -1139,14 +1842,64 @@ func (m *MappingRuleSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CutoverNanos |= (int64(b) & 0x7F) << shift
+				m.DropPolicy |= (policypb.DropPolicy(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MappingRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MappingRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MappingRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1171,11 +1924,11 @@ func (m *MappingRuleSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filter = string(dAtA[iNdEx:postIndex])
+			m.Uuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1199,16 +1952,66 @@ func (m *MappingRuleSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policies = append(m.Policies, &policypb.Policy{})
-			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Snapshots = append(m.Snapshots, &MappingRuleSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAtNanos", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollupTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.LastUpdatedAtNanos = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollupTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollupTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -1218,14 +2021,24 @@ func (m *MappingRuleSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdatedAtNanos |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1250,73 +2063,11 @@ func (m *MappingRuleSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastUpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
-			if wireType == 0 {
-				var v aggregationpb.AggregationType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRule
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (aggregationpb.AggregationType(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.AggregationTypes = append(m.AggregationTypes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRule
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRule
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v aggregationpb.AggregationType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRule
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (aggregationpb.AggregationType(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AggregationTypes = append(m.AggregationTypes, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregationTypes", wireType)
-			}
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoragePolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1340,30 +2091,11 @@ func (m *MappingRuleSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoragePolicies = append(m.StoragePolicies, &policypb.StoragePolicy{})
-			if err := m.StoragePolicies[len(m.StoragePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Policies = append(m.Policies, &policypb.Policy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropPolicy", wireType)
-			}
-			m.DropPolicy = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRule
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DropPolicy |= (policypb.DropPolicy(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRule(dAtA[iNdEx:])
@@ -1385,7 +2117,7 @@ func (m *MappingRuleSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MappingRule) Unmarshal(dAtA []byte) error {
+func (m *RollupTargetV2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1408,17 +2140,17 @@ func (m *MappingRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MappingRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollupTargetV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MappingRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollupTargetV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -1428,24 +2160,28 @@ func (m *MappingRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRule
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &pipelinepb.Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoragePolicies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1469,8 +2205,8 @@ func (m *MappingRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Snapshots = append(m.Snapshots, &MappingRuleSnapshot{})
-			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StoragePolicies = append(m.StoragePolicies, &policypb.StoragePolicy{})
+			if err := m.StoragePolicies[len(m.StoragePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1495,7 +2231,7 @@ func (m *MappingRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RollupTarget) Unmarshal(dAtA []byte) error {
+func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1518,17 +2254,114 @@ func (m *RollupTarget) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RollupTarget: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollupRuleSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RollupTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollupRuleSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CutoverNanos", wireType)
+			}
+			m.CutoverNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CutoverNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -1538,24 +2371,45 @@ func (m *RollupTarget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRule
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Targets = append(m.Targets, &RollupTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAtNanos", wireType)
+			}
+			m.LastUpdatedAtNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedAtNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1580,11 +2434,11 @@ func (m *RollupTarget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			m.LastUpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetsV2", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1608,8 +2462,8 @@ func (m *RollupTarget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policies = append(m.Policies, &policypb.Policy{})
-			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TargetsV2 = append(m.TargetsV2, &RollupTargetV2{})
+			if err := m.TargetsV2[len(m.TargetsV2)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1634,7 +2488,7 @@ func (m *RollupTarget) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RollupTargetV2) Unmarshal(dAtA []byte) error {
+func (m *RollupRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1657,17 +2511,17 @@ func (m *RollupTargetV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RollupTargetV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollupRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RollupTargetV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollupRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -1677,28 +2531,24 @@ func (m *RollupTargetV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRule
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &pipelinepb.Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Uuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoragePolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1722,8 +2572,8 @@ func (m *RollupTargetV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoragePolicies = append(m.StoragePolicies, &policypb.StoragePolicy{})
-			if err := m.StoragePolicies[len(m.StoragePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Snapshots = append(m.Snapshots, &RollupRuleSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1748,7 +2598,7 @@ func (m *RollupTargetV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
+func (m *RuleSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1771,15 +2621,15 @@ func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RollupRuleSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuleSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RollupRuleSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuleSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1804,9 +2654,76 @@ func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Uuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtNanos", wireType)
+			}
+			m.CreatedAtNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAtNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAtNanos", wireType)
+			}
+			m.LastUpdatedAtNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedAtNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
@@ -1826,7 +2743,7 @@ func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Tombstoned = bool(v != 0)
-		case 3:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CutoverNanos", wireType)
 			}
@@ -1845,11 +2762,11 @@ func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MappingRules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -1859,24 +2776,26 @@ func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRule
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filter = string(dAtA[iNdEx:postIndex])
+			m.MappingRules = append(m.MappingRules, &MappingRule{})
+			if err := m.MappingRules[len(m.MappingRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollupRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1900,16 +2819,16 @@ func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Targets = append(m.Targets, &RollupTarget{})
-			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RollupRules = append(m.RollupRules, &RollupRule{})
+			if err := m.RollupRules[len(m.RollupRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAtNanos", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBy", wireType)
 			}
-			m.LastUpdatedAtNanos = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -1919,14 +2838,105 @@ func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdatedAtNanos |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastUpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngestRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IngestRules = append(m.IngestRules, &IngestRule{})
+			if err := m.IngestRules[len(m.IngestRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IngestTag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IngestTag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IngestTag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1951,13 +2961,13 @@ func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastUpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetsV2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -1967,22 +2977,20 @@ func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRule
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetsV2 = append(m.TargetsV2, &RollupTargetV2{})
-			if err := m.TargetsV2[len(m.TargetsV2)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2005,7 +3013,7 @@ func (m *RollupRuleSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RollupRule) Unmarshal(dAtA []byte) error {
+func (m *IngestTagRename) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2028,15 +3036,15 @@ func (m *RollupRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RollupRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: IngestTagRename: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RollupRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IngestTagRename: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2061,13 +3069,13 @@ func (m *RollupRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -2077,22 +3085,20 @@ func (m *RollupRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRule
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Snapshots = append(m.Snapshots, &RollupRuleSnapshot{})
-			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2115,7 +3121,7 @@ func (m *RollupRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RuleSet) Unmarshal(dAtA []byte) error {
+func (m *IngestRuleSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2138,15 +3144,15 @@ func (m *RuleSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RuleSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: IngestRuleSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuleSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IngestRuleSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2171,11 +3177,50 @@ func (m *RuleSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CutoverNanos", wireType)
+			}
+			m.CutoverNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CutoverNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2200,13 +3245,13 @@ func (m *RuleSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Filter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtNanos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAtNanos", wireType)
 			}
-			m.CreatedAtNanos = 0
+			m.LastUpdatedAtNanos = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -2216,16 +3261,16 @@ func (m *RuleSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAtNanos |= (int64(b) & 0x7F) << shift
+				m.LastUpdatedAtNanos |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAtNanos", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBy", wireType)
 			}
-			m.LastUpdatedAtNanos = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -2235,14 +3280,24 @@ func (m *RuleSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdatedAtNanos |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastUpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Drop", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2259,12 +3314,12 @@ func (m *RuleSet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CutoverNanos", wireType)
+			m.Drop = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTags", wireType)
 			}
-			m.CutoverNanos = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRule
@@ -2274,14 +3329,24 @@ func (m *RuleSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CutoverNanos |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DropTags = append(m.DropTags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MappingRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameTags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2305,14 +3370,14 @@ func (m *RuleSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MappingRules = append(m.MappingRules, &MappingRule{})
-			if err := m.MappingRules[len(m.MappingRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RenameTags = append(m.RenameTags, &IngestTagRename{})
+			if err := m.RenameTags[len(m.RenameTags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollupRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddTags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2336,14 +3401,64 @@ func (m *RuleSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollupRules = append(m.RollupRules, &RollupRule{})
-			if err := m.RollupRules[len(m.RollupRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AddTags = append(m.AddTags, &IngestTag{})
+			if err := m.AddTags[len(m.AddTags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IngestRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IngestRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IngestRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2368,7 +3483,38 @@ func (m *RuleSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastUpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Uuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, &IngestRuleSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2501,49 +3647,58 @@ func init() {
 }
 
 var fileDescriptorRule = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6b, 0xdb, 0x4a,
-	0x10, 0x7e, 0xb2, 0x1d, 0xdb, 0x1a, 0x3b, 0x8e, 0xdf, 0x26, 0x2f, 0x4f, 0xe4, 0x3d, 0x8c, 0x71,
-	0xa1, 0xf8, 0x50, 0xe4, 0x56, 0x21, 0x90, 0xde, 0x9a, 0x10, 0x68, 0xa1, 0x34, 0x84, 0x4d, 0x9a,
-	0x43, 0x28, 0x18, 0xd9, 0xda, 0x2a, 0x02, 0x49, 0xbb, 0xec, 0xae, 0x02, 0xfe, 0x03, 0x3d, 0xf7,
-	0x5f, 0xb5, 0xc7, 0xde, 0x7a, 0x2d, 0xe9, 0x7f, 0xe8, 0xb9, 0x68, 0x57, 0xb2, 0x25, 0xa2, 0x10,
-	0x5c, 0x28, 0x3d, 0x65, 0x76, 0x34, 0xfb, 0xed, 0xcc, 0xf7, 0x7d, 0x13, 0xc3, 0x0b, 0x3f, 0x90,
-	0xd7, 0xc9, 0xcc, 0x9e, 0xd3, 0x68, 0x12, 0xed, 0x7b, 0xb3, 0x49, 0xb4, 0x3f, 0x11, 0x7c, 0x3e,
-	0x89, 0x88, 0xe4, 0xc1, 0x5c, 0x4c, 0x7c, 0x12, 0x13, 0xee, 0x4a, 0xe2, 0x4d, 0x18, 0xa7, 0x92,
-	0x4e, 0x78, 0x12, 0x12, 0x36, 0x53, 0x7f, 0x6c, 0x95, 0x41, 0x4d, 0x9d, 0xda, 0x3b, 0x5d, 0x13,
-	0xc9, 0xf5, 0x7d, 0x4e, 0x7c, 0x57, 0x06, 0x34, 0x66, 0xb3, 0xe2, 0x49, 0xe3, 0xee, 0xbd, 0x5a,
-	0x13, 0x8f, 0x05, 0x8c, 0x84, 0x41, 0x9c, 0x76, 0x97, 0x87, 0x19, 0xd2, 0xc9, 0xba, 0x48, 0x34,
-	0x0c, 0xe6, 0x8b, 0x14, 0x47, 0x05, 0x1a, 0x65, 0xf4, 0xb5, 0x0e, 0xdb, 0x6f, 0x5c, 0xc6, 0x82,
-	0xd8, 0xc7, 0x49, 0x48, 0xce, 0x63, 0x97, 0x89, 0x6b, 0x2a, 0x11, 0x82, 0x46, 0xec, 0x46, 0xc4,
-	0x32, 0x86, 0xc6, 0xd8, 0xc4, 0x2a, 0x46, 0x03, 0x00, 0x49, 0xa3, 0x99, 0x90, 0x34, 0x26, 0x9e,
-	0x55, 0x1b, 0x1a, 0xe3, 0x36, 0x2e, 0x64, 0xd0, 0x23, 0xd8, 0x9c, 0x27, 0x92, 0xde, 0x10, 0x3e,
-	0x8d, 0xdd, 0x98, 0x0a, 0xab, 0x3e, 0x34, 0xc6, 0x75, 0xdc, 0xcd, 0x92, 0xa7, 0x69, 0x0e, 0xed,
-	0x42, 0xf3, 0x7d, 0x10, 0x4a, 0xc2, 0xad, 0x86, 0x82, 0xce, 0x4e, 0xe8, 0x09, 0xb4, 0x55, 0x63,
-	0x01, 0x11, 0xd6, 0xc6, 0xb0, 0x3e, 0xee, 0x38, 0x7d, 0x3b, 0x6f, 0xd9, 0x3e, 0x53, 0x01, 0x5e,
-	0x56, 0xa0, 0x67, 0xf0, 0x4f, 0xe8, 0x0a, 0x39, 0x4d, 0x98, 0x97, 0x8e, 0x38, 0x75, 0x65, 0xf6,
-	0x64, 0x53, 0x3d, 0x89, 0xd2, 0x8f, 0x6f, 0xf5, 0xb7, 0x23, 0xa9, 0x1f, 0x7e, 0x0c, 0x5b, 0xa5,
-	0x2b, 0xb3, 0x85, 0xd5, 0x52, 0x1d, 0x6c, 0x16, 0x8a, 0x8f, 0x17, 0xe8, 0x35, 0xfc, 0x5d, 0x90,
-	0x6d, 0x2a, 0x17, 0x8c, 0x08, 0xab, 0x3d, 0xac, 0x8f, 0x7b, 0xce, 0xc0, 0x2e, 0xc9, 0x6b, 0x1f,
-	0xad, 0x4e, 0x17, 0x0b, 0x46, 0x70, 0xdf, 0x2d, 0x27, 0x04, 0x3a, 0x86, 0xbe, 0x90, 0x94, 0xbb,
-	0x3e, 0x99, 0x2e, 0xa7, 0x33, 0xd5, 0x74, 0xff, 0xae, 0xa6, 0x3b, 0xd7, 0x15, 0xd9, 0x90, 0x5b,
-	0xa2, 0x70, 0x4c, 0x67, 0x3d, 0x80, 0x8e, 0xc7, 0x29, 0xd3, 0x00, 0x0b, 0x0b, 0x86, 0xc6, 0xb8,
-	0xe7, 0xec, 0xac, 0xae, 0x9f, 0x70, 0xca, 0xb2, 0xbb, 0xe0, 0x2d, 0xe3, 0xd1, 0x3b, 0xe8, 0x14,
-	0x84, 0x4d, 0x05, 0x4d, 0x92, 0xc0, 0xcb, 0x05, 0x4d, 0x63, 0xf4, 0x1c, 0x4c, 0x91, 0x09, 0x2e,
-	0xac, 0x9a, 0x6a, 0xeb, 0x3f, 0x5b, 0x1b, 0xdf, 0xae, 0x30, 0x05, 0x5e, 0x55, 0x8f, 0x3c, 0xe8,
-	0x62, 0x1a, 0x86, 0x09, 0xbb, 0x70, 0xb9, 0x4f, 0xaa, 0xfd, 0x82, 0xa0, 0x21, 0x5d, 0x5f, 0x23,
-	0x9b, 0x58, 0xc5, 0x25, 0x99, 0xeb, 0x0f, 0xc9, 0x3c, 0xfa, 0x60, 0x40, 0xaf, 0xf8, 0xcc, 0xa5,
-	0x83, 0x9e, 0x42, 0x3b, 0x5f, 0x04, 0xf5, 0x58, 0x27, 0xa5, 0x62, 0xb9, 0x24, 0xf6, 0x59, 0x16,
-	0xe2, 0x65, 0x55, 0xa5, 0x06, 0xb5, 0xf5, 0x34, 0x18, 0x7d, 0xaa, 0x01, 0xd2, 0x8d, 0xfc, 0xd9,
-	0x2d, 0xb1, 0xa1, 0x25, 0x15, 0x13, 0xf9, 0x92, 0xec, 0xe4, 0x7a, 0x15, 0x69, 0xc2, 0x79, 0xd1,
-	0xef, 0xdc, 0x93, 0x03, 0x80, 0xec, 0x95, 0xe9, 0x8d, 0xa3, 0x16, 0xa4, 0xe3, 0xec, 0x56, 0x75,
-	0x73, 0xe9, 0x60, 0x33, 0xab, 0xbc, 0x74, 0x46, 0x57, 0x00, 0x2b, 0x22, 0x2b, 0x5d, 0x79, 0x78,
-	0xd7, 0x95, 0x7b, 0x65, 0xdc, 0xfb, 0x4c, 0xf9, 0xa3, 0x06, 0x2d, 0xf5, 0x4d, 0x1b, 0xf2, 0x0e,
-	0xf2, 0xff, 0x60, 0xa6, 0x12, 0x09, 0xe6, 0xce, 0x89, 0x52, 0xc6, 0xc4, 0xab, 0x04, 0x1a, 0x43,
-	0x7f, 0xce, 0x49, 0x99, 0x26, 0xad, 0x4d, 0x2f, 0xcb, 0xe7, 0x14, 0xdd, 0xcb, 0x6a, 0xe3, 0x5e,
-	0x56, 0xcb, 0xae, 0xd8, 0x78, 0xd8, 0x15, 0xcd, 0x0a, 0x57, 0x1c, 0xc2, 0x66, 0xa4, 0xd7, 0x72,
-	0x9a, 0xf2, 0x21, 0xac, 0x96, 0x62, 0x67, 0xbb, 0x62, 0x67, 0x71, 0x37, 0x5a, 0x1d, 0xd2, 0xff,
-	0x21, 0x5d, 0xae, 0xa8, 0xcb, 0x2e, 0x6a, 0xb9, 0xd0, 0x5d, 0x5a, 0x71, 0x87, 0x2f, 0xe3, 0x4a,
-	0x2f, 0x98, 0x15, 0x5e, 0x38, 0x7e, 0xf9, 0xf9, 0x76, 0x60, 0x7c, 0xb9, 0x1d, 0x18, 0xdf, 0x6e,
-	0x07, 0xc6, 0xc7, 0xef, 0x83, 0xbf, 0xae, 0x0e, 0x7e, 0xe9, 0x17, 0x78, 0xd6, 0x54, 0xa7, 0xfd,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x69, 0x70, 0x7f, 0x58, 0xc1, 0x07, 0x00, 0x00,
+	// 841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc5, 0x56, 0x4b, 0x6b, 0xdb, 0x40,
+	0x10, 0xae, 0xed, 0xf8, 0xa1, 0xb1, 0xe3, 0x38, 0x9b, 0x34, 0x35, 0x49, 0x31, 0xc1, 0x85, 0x92,
+	0x43, 0x91, 0x5b, 0x07, 0x43, 0x7a, 0x6b, 0x42, 0xa0, 0x2d, 0xa5, 0x21, 0x6c, 0xdc, 0x1c, 0x42,
+	0xc1, 0xac, 0xad, 0x8d, 0x22, 0xb0, 0x1e, 0x48, 0xeb, 0x80, 0xff, 0x40, 0xcf, 0xfd, 0x23, 0xfd,
+	0x1d, 0xed, 0xb1, 0xb7, 0x5e, 0x4b, 0xfb, 0x2b, 0x7a, 0xeb, 0x3e, 0xf4, 0xc4, 0x32, 0xc1, 0x85,
+	0x92, 0x83, 0xec, 0xd9, 0xd9, 0x99, 0x6f, 0x67, 0xe6, 0x9b, 0x59, 0x09, 0x5e, 0x99, 0x16, 0xbb,
+	0x99, 0x8d, 0xf5, 0x89, 0x6b, 0xf7, 0xec, 0x43, 0x63, 0xcc, 0x7f, 0x7a, 0x81, 0x3f, 0xe9, 0xd9,
+	0x94, 0xf9, 0xd6, 0x24, 0xe8, 0x99, 0xd4, 0xa1, 0x3e, 0x61, 0xd4, 0xe8, 0x79, 0xbe, 0xcb, 0xdc,
+	0x9e, 0x3f, 0x9b, 0x52, 0x6f, 0x2c, 0xff, 0x74, 0xa9, 0x41, 0x15, 0xa5, 0xda, 0x3d, 0x5b, 0x11,
+	0x89, 0x98, 0xa6, 0x4f, 0x4d, 0xc2, 0x2c, 0xd7, 0xe1, 0x80, 0xa9, 0x95, 0xc2, 0xdd, 0x7d, 0xb3,
+	0x22, 0x9e, 0x67, 0x79, 0x74, 0x6a, 0x39, 0x22, 0xba, 0x48, 0x0c, 0x91, 0x4e, 0x57, 0x45, 0x72,
+	0xa7, 0xd6, 0x64, 0x2e, 0x70, 0xa4, 0xa0, 0x50, 0xba, 0x3f, 0x4a, 0xb0, 0xf5, 0x9e, 0x78, 0x9e,
+	0xe5, 0x98, 0x98, 0x67, 0x7c, 0xe1, 0x10, 0x2f, 0xb8, 0x71, 0x19, 0x42, 0xb0, 0xe6, 0x10, 0x9b,
+	0xb6, 0x0b, 0xfb, 0x85, 0x03, 0x0d, 0x4b, 0x19, 0x75, 0x00, 0x98, 0x6b, 0x8f, 0x03, 0xe6, 0x3a,
+	0xd4, 0x68, 0x17, 0xf9, 0x4e, 0x0d, 0xa7, 0x34, 0xe8, 0x09, 0xac, 0x4f, 0x66, 0xcc, 0xbd, 0xa5,
+	0xfe, 0xc8, 0x21, 0x8e, 0x1b, 0xb4, 0x4b, 0xdc, 0xa4, 0x84, 0x1b, 0xa1, 0xf2, 0x4c, 0xe8, 0xd0,
+	0x0e, 0x54, 0xae, 0xad, 0x29, 0xa3, 0x7e, 0x7b, 0x4d, 0x42, 0x87, 0x2b, 0xf4, 0x0c, 0x6a, 0x32,
+	0x30, 0x8b, 0x06, 0xed, 0xf2, 0x7e, 0xe9, 0xa0, 0xde, 0x6f, 0xe9, 0x51, 0xc8, 0xfa, 0xb9, 0x14,
+	0x70, 0x6c, 0x81, 0x5e, 0xc0, 0xc3, 0x29, 0x09, 0xd8, 0x68, 0xe6, 0x19, 0x22, 0xc5, 0x11, 0x61,
+	0xe1, 0x91, 0x15, 0x79, 0x24, 0x12, 0x9b, 0x1f, 0xd4, 0xde, 0x31, 0x53, 0x07, 0x3f, 0x85, 0x8d,
+	0x8c, 0xcb, 0x78, 0xde, 0xae, 0xca, 0x08, 0xd6, 0x53, 0xc6, 0x27, 0x73, 0xf4, 0x0e, 0x36, 0x53,
+	0xb4, 0x8d, 0xd8, 0xdc, 0xe3, 0x11, 0xd5, 0x78, 0x44, 0xcd, 0x7e, 0x47, 0xcf, 0xd0, 0xab, 0x1f,
+	0x27, 0xab, 0x21, 0x37, 0xc3, 0x2d, 0x92, 0x55, 0x04, 0xe8, 0x04, 0x5a, 0xbc, 0x38, 0x3e, 0x31,
+	0xe9, 0x28, 0xce, 0x4e, 0x93, 0xd9, 0x3d, 0x4a, 0xb2, 0xbb, 0x50, 0x16, 0x61, 0x92, 0x1b, 0x41,
+	0x6a, 0x29, 0x72, 0x1d, 0x40, 0xdd, 0xf0, 0x5d, 0x4f, 0x01, 0xcc, 0xdb, 0xc0, 0x83, 0x6e, 0xf6,
+	0xb7, 0x13, 0xf7, 0x53, 0xbe, 0x19, 0xfa, 0x82, 0x11, 0xcb, 0xdd, 0x8f, 0x50, 0x4f, 0x11, 0x2b,
+	0x08, 0x9d, 0xcd, 0x2c, 0x23, 0x22, 0x54, 0xc8, 0xe8, 0x25, 0x68, 0x41, 0x48, 0x78, 0xc0, 0xf9,
+	0x14, 0x61, 0xed, 0xe9, 0xaa, 0xf1, 0xf5, 0x9c, 0xa6, 0xc0, 0x89, 0x75, 0xd7, 0x80, 0x06, 0x76,
+	0xa7, 0xd3, 0x99, 0x37, 0x24, 0xbe, 0x49, 0xf3, 0xfb, 0x85, 0xeb, 0x18, 0x31, 0x15, 0x32, 0xd7,
+	0x09, 0x39, 0x43, 0x73, 0xe9, 0x2e, 0x9a, 0xbb, 0x9f, 0x0a, 0xd0, 0x4c, 0x1f, 0x73, 0xd9, 0x47,
+	0xcf, 0x39, 0x40, 0x38, 0x08, 0xf2, 0xb0, 0xba, 0x28, 0x45, 0x3c, 0x24, 0xfa, 0x79, 0x28, 0xe2,
+	0xd8, 0x2a, 0x97, 0x83, 0xe2, 0x6a, 0x1c, 0x74, 0xbf, 0x16, 0x01, 0xa9, 0x40, 0xee, 0x77, 0x4a,
+	0x74, 0xa8, 0x32, 0x59, 0x89, 0x68, 0x48, 0xb6, 0x23, 0xbe, 0xd2, 0x65, 0xc2, 0x91, 0xd1, 0xff,
+	0x9c, 0x93, 0x01, 0xcf, 0x53, 0x9d, 0x32, 0xba, 0xed, 0xcb, 0x01, 0xa9, 0xf7, 0x77, 0xf2, 0xa2,
+	0xb9, 0xec, 0x63, 0x2d, 0xb4, 0xbc, 0xec, 0x77, 0xaf, 0x00, 0x92, 0x42, 0xe6, 0x76, 0xe5, 0xd1,
+	0x62, 0x57, 0xee, 0x66, 0x71, 0x97, 0x35, 0xe5, 0x97, 0x12, 0x54, 0xe5, 0x9e, 0x6a, 0xc8, 0x05,
+	0xe4, 0xc7, 0xa0, 0x09, 0x8a, 0x02, 0x8f, 0x4c, 0xa8, 0x64, 0x46, 0xc3, 0x89, 0x02, 0x1d, 0x40,
+	0x6b, 0xe2, 0xd3, 0x6c, 0x99, 0x14, 0x37, 0xcd, 0x50, 0x1f, 0x95, 0x68, 0x69, 0x55, 0xd7, 0x96,
+	0x56, 0x35, 0xdb, 0x15, 0xe5, 0xbb, 0xbb, 0xa2, 0x92, 0xd3, 0x15, 0x47, 0xb0, 0x6e, 0xab, 0xb1,
+	0x1c, 0x89, 0x7a, 0x04, 0x9c, 0x18, 0x51, 0x9d, 0xad, 0x9c, 0x99, 0xc5, 0x0d, 0x3b, 0x59, 0x88,
+	0x3b, 0xa4, 0xe1, 0xcb, 0xd2, 0x85, 0x8e, 0x8a, 0x2e, 0xb4, 0x58, 0x56, 0x5c, 0xf7, 0x63, 0x39,
+	0xb7, 0x17, 0xb4, 0xfc, 0x5e, 0x68, 0xf0, 0xa3, 0x28, 0xb7, 0x54, 0xf0, 0x90, 0x85, 0x7f, 0x2b,
+	0xf7, 0x14, 0xbc, 0x15, 0xcb, 0x41, 0x77, 0x00, 0x9a, 0xda, 0x1a, 0x12, 0x33, 0x77, 0x96, 0xb6,
+	0xa1, 0x7c, 0x4b, 0xa6, 0xb3, 0x88, 0x2c, 0xb5, 0xe0, 0x6e, 0x1b, 0xb1, 0x1b, 0xa6, 0xd1, 0x55,
+	0x73, 0xed, 0xbb, 0x76, 0xe4, 0x2c, 0x64, 0xd4, 0x84, 0x22, 0x73, 0x43, 0x4f, 0x2e, 0x75, 0xff,
+	0xf0, 0x19, 0x4e, 0x22, 0xb9, 0xbf, 0x19, 0x5e, 0xda, 0x3d, 0xe5, 0x55, 0x66, 0xb2, 0x92, 0xc7,
+	0x03, 0xcf, 0x45, 0xbc, 0x01, 0xe4, 0xc0, 0xd6, 0xb0, 0x94, 0xd1, 0x1e, 0x68, 0xf2, 0xf5, 0x21,
+	0xaf, 0xe2, 0x9a, 0xbc, 0x8a, 0x6b, 0x42, 0x31, 0x14, 0xd7, 0xf1, 0x11, 0xd4, 0x7d, 0x59, 0x41,
+	0xb5, 0x1d, 0xbd, 0x9a, 0x32, 0xbc, 0xc5, 0x55, 0xc6, 0xa0, 0x6c, 0x87, 0xe1, 0x45, 0x4e, 0x0c,
+	0x43, 0xb9, 0x29, 0xba, 0x37, 0x17, 0xdd, 0xaa, 0xdc, 0x44, 0x58, 0x8b, 0xa9, 0x4f, 0x4a, 0xbf,
+	0xf2, 0xd4, 0x2f, 0xb2, 0x96, 0x9a, 0xfa, 0x93, 0xd7, 0xdf, 0x7e, 0x75, 0x0a, 0xdf, 0xf9, 0xf3,
+	0x93, 0x3f, 0x9f, 0x7f, 0x77, 0x1e, 0x5c, 0x0d, 0xfe, 0xe9, 0xf3, 0x6f, 0x5c, 0x91, 0xab, 0xc3,
+	0xbf, 0xbc, 0xcf, 0x38, 0xba, 0x3e, 0x0a, 0x00, 0x00,
 }
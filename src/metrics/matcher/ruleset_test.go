@@ -278,6 +278,7 @@ func (r *mockRuleSet) ActiveSet(timeNanos int64) rules.Matcher  { return r.match
 func (r *mockRuleSet) ToMutableRuleSet() rules.MutableRuleSet   { return nil }
 func (r *mockRuleSet) MappingRules() (view.MappingRules, error) { return nil, nil }
 func (r *mockRuleSet) RollupRules() (view.RollupRules, error)   { return nil, nil }
+func (r *mockRuleSet) IngestRules() (view.IngestRules, error)   { return nil, nil }
 func (r *mockRuleSet) Latest() (view.RuleSet, error)            { return view.RuleSet{}, nil }
 
 func testRuleSet() (kv.Store, cache.Cache, *ruleSet) {
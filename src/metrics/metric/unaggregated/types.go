@@ -36,6 +36,7 @@ var (
 	errNilCounterWithMetadatasProto    = errors.New("nil counter with metadatas proto message")
 	errNilBatchTimerWithMetadatasProto = errors.New("nil batch timer with metadatas proto message")
 	errNilGaugeWithMetadatasProto      = errors.New("nil gauge with metadatas proto message")
+	errNilSetWithMetadatasProto        = errors.New("nil set with metadatas proto message")
 )
 
 // Counter is a counter containing the counter ID and the counter value.
@@ -119,6 +120,34 @@ func (g *Gauge) FromProto(pb metricpb.Gauge) {
 	g.Value = pb.Value
 }
 
+// Set is a set containing the set ID and a list of set values whose distinct
+// count is aggregated.
+type Set struct {
+	ID     id.RawID
+	Values [][]byte
+}
+
+// ToUnion converts the set to a metric union.
+func (s Set) ToUnion() MetricUnion {
+	return MetricUnion{
+		Type:   metric.SetType,
+		ID:     s.ID,
+		SetVal: s.Values,
+	}
+}
+
+// ToProto converts the set to a protobuf message in place.
+func (s Set) ToProto(pb *metricpb.Set) {
+	pb.Id = s.ID
+	pb.Values = s.Values
+}
+
+// FromProto converts the protobuf message to a set in place.
+func (s *Set) FromProto(pb metricpb.Set) {
+	s.ID = pb.Id
+	s.Values = pb.Values
+}
+
 // CounterWithPoliciesList is a counter with applicable policies list.
 type CounterWithPoliciesList struct {
 	Counter
@@ -137,6 +166,12 @@ type GaugeWithPoliciesList struct {
 	policy.PoliciesList
 }
 
+// SetWithPoliciesList is a set with applicable policies list.
+type SetWithPoliciesList struct {
+	Set
+	policy.PoliciesList
+}
+
 // CounterWithMetadatas is a counter with applicable metadatas.
 type CounterWithMetadatas struct {
 	Counter
@@ -218,6 +253,33 @@ func (gm *GaugeWithMetadatas) FromProto(pb *metricpb.GaugeWithMetadatas) error {
 	return nil
 }
 
+// SetWithMetadatas is a set with applicable metadatas.
+type SetWithMetadatas struct {
+	Set
+	metadata.StagedMetadatas
+}
+
+// ToProto converts the set with metadatas to a protobuf message in place.
+func (sm SetWithMetadatas) ToProto(pb *metricpb.SetWithMetadatas) error {
+	if err := sm.StagedMetadatas.ToProto(&pb.Metadatas); err != nil {
+		return err
+	}
+	sm.Set.ToProto(&pb.Set)
+	return nil
+}
+
+// FromProto converts the protobuf message to a set with metadatas in place.
+func (sm *SetWithMetadatas) FromProto(pb *metricpb.SetWithMetadatas) error {
+	if pb == nil {
+		return errNilSetWithMetadatasProto
+	}
+	if err := sm.StagedMetadatas.FromProto(pb.Metadatas); err != nil {
+		return err
+	}
+	sm.Set.FromProto(pb.Set)
+	return nil
+}
+
 // MetricUnion is a union of different types of metrics, only one of which is valid
 // at any given time. The actual type of the metric depends on the type field,
 // which determines which value field is valid. Note that if the timer values are
@@ -229,6 +291,7 @@ type MetricUnion struct {
 	CounterVal    int64
 	BatchTimerVal []float64
 	GaugeVal      float64
+	SetVal        [][]byte
 	TimerValPool  pool.FloatsPool
 }
 
@@ -243,6 +306,8 @@ func (m *MetricUnion) String() string {
 		return fmt.Sprintf("{type:%s,id:%s,value:%v}", m.Type, m.ID.String(), m.BatchTimerVal)
 	case metric.GaugeType:
 		return fmt.Sprintf("{type:%s,id:%s,value:%f}", m.Type, m.ID.String(), m.GaugeVal)
+	case metric.SetType:
+		return fmt.Sprintf("{type:%s,id:%s,value:%q}", m.Type, m.ID.String(), m.SetVal)
 	default:
 		return fmt.Sprintf(
 			"{type:%d,id:%s,counterVal:%d,batchTimerVal:%v,gaugeVal:%f}",
@@ -262,3 +327,6 @@ func (m *MetricUnion) BatchTimer() BatchTimer { return BatchTimer{ID: m.ID, Valu
 
 // Gauge returns the gauge metric.
 func (m *MetricUnion) Gauge() Gauge { return Gauge{ID: m.ID, Value: m.GaugeVal} }
+
+// Set returns the set metric.
+func (m *MetricUnion) Set() Set { return Set{ID: m.ID, Values: m.SetVal} }
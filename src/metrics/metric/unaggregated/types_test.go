@@ -69,6 +69,15 @@ var (
 		ID:       []byte("testGauge"),
 		GaugeVal: 45.28,
 	}
+	testSet = Set{
+		ID:     []byte("testSet"),
+		Values: [][]byte{[]byte("foo"), []byte("bar"), []byte("foo")},
+	}
+	testSetUnion = MetricUnion{
+		Type:   metric.SetType,
+		ID:     []byte("testSet"),
+		SetVal: [][]byte{[]byte("foo"), []byte("bar"), []byte("foo")},
+	}
 	testMetadatas = metadata.StagedMetadatas{
 		{
 			CutoverNanos: 1234,
@@ -163,6 +172,10 @@ var (
 		Gauge:           testGauge,
 		StagedMetadatas: testMetadatas,
 	}
+	testSetWithMetadatas = SetWithMetadatas{
+		Set:             testSet,
+		StagedMetadatas: testMetadatas,
+	}
 	testCounterProto = metricpb.Counter{
 		Id:    []byte("testCounter"),
 		Value: 1234,
@@ -175,6 +188,10 @@ var (
 		Id:    []byte("testGauge"),
 		Value: 45.28,
 	}
+	testSetProto = metricpb.Set{
+		Id:     []byte("testSet"),
+		Values: [][]byte{[]byte("foo"), []byte("bar"), []byte("foo")},
+	}
 	testMetadatasProto = metricpb.StagedMetadatas{
 		Metadatas: []metricpb.StagedMetadata{
 			{
@@ -300,6 +317,10 @@ var (
 		Gauge:     testGaugeProto,
 		Metadatas: testMetadatasProto,
 	}
+	testSetWithMetadatasProto = metricpb.SetWithMetadatas{
+		Set:       testSetProto,
+		Metadatas: testMetadatasProto,
+	}
 )
 
 func TestCounterToUnion(t *testing.T) {
@@ -380,6 +401,21 @@ func TestGaugeRoundTrip(t *testing.T) {
 	require.Equal(t, testGauge, c)
 }
 
+func TestSetToUnion(t *testing.T) {
+	require.Equal(t, testSetUnion, testSet.ToUnion())
+}
+
+func TestSetRoundTrip(t *testing.T) {
+	var (
+		pb metricpb.Set
+		s  Set
+	)
+	testSet.ToProto(&pb)
+	require.Equal(t, testSetProto, pb)
+	s.FromProto(pb)
+	require.Equal(t, testSet, s)
+}
+
 func TestCounterWithMetadatasToProto(t *testing.T) {
 	var pb metricpb.CounterWithMetadatas
 	require.NoError(t, testCounterWithMetadatas.ToProto(&pb))
@@ -514,3 +550,19 @@ func TestGaugeWithMetadatasRoundTrip(t *testing.T) {
 	require.NoError(t, g.FromProto(&pb))
 	require.Equal(t, testGaugeWithMetadatas, g)
 }
+
+func TestSetWithMetadatasFromProtoNilProto(t *testing.T) {
+	var s SetWithMetadatas
+	require.Equal(t, errNilSetWithMetadatasProto, s.FromProto(nil))
+}
+
+func TestSetWithMetadatasRoundTrip(t *testing.T) {
+	var (
+		pb metricpb.SetWithMetadatas
+		s  SetWithMetadatas
+	)
+	require.NoError(t, testSetWithMetadatas.ToProto(&pb))
+	require.Equal(t, testSetWithMetadatasProto, pb)
+	require.NoError(t, s.FromProto(&pb))
+	require.Equal(t, testSetWithMetadatas, s)
+}
@@ -56,6 +56,7 @@ type activeRuleSet struct {
 	version         int
 	mappingRules    []*mappingRule
 	rollupRules     []*rollupRule
+	ingestRules     []*ingestRule
 	cutoverTimesAsc []int64
 	tagsFilterOpts  filters.TagsFilterOptions
 	newRollupIDFn   metricID.NewIDFn
@@ -66,6 +67,7 @@ func newActiveRuleSet(
 	version int,
 	mappingRules []*mappingRule,
 	rollupRules []*rollupRule,
+	ingestRules []*ingestRule,
 	tagsFilterOpts filters.TagsFilterOptions,
 	newRollupIDFn metricID.NewIDFn,
 	isRollupIDFn metricID.MatchIDFn,
@@ -81,6 +83,11 @@ func newActiveRuleSet(
 			uniqueCutoverTimes[snapshot.cutoverNanos] = struct{}{}
 		}
 	}
+	for _, ingestRule := range ingestRules {
+		for _, snapshot := range ingestRule.snapshots {
+			uniqueCutoverTimes[snapshot.cutoverNanos] = struct{}{}
+		}
+	}
 
 	cutoverTimesAsc := make([]int64, 0, len(uniqueCutoverTimes))
 	for t := range uniqueCutoverTimes {
@@ -92,6 +99,7 @@ func newActiveRuleSet(
 		version:         version,
 		mappingRules:    mappingRules,
 		rollupRules:     rollupRules,
+		ingestRules:     ingestRules,
 		cutoverTimesAsc: cutoverTimesAsc,
 		tagsFilterOpts:  tagsFilterOpts,
 		newRollupIDFn:   newRollupIDFn,
@@ -124,13 +132,22 @@ func (as *activeRuleSet) ForwardMatch(
 		currMatchRes     = as.forwardMatchAt(id, fromNanos)
 		forExistingID    = metadata.StagedMetadatas{currMatchRes.forExistingID}
 		forNewRollupIDs  = currMatchRes.forNewRollupIDs
+		forIngest        []IngestResult
 		nextIdx          = as.nextCutoverIdx(fromNanos)
 		nextCutoverNanos = as.cutoverNanosAt(nextIdx)
 	)
+	if len(as.ingestRules) > 0 {
+		forIngest = append(forIngest, as.ingestResultFor(id, fromNanos))
+	}
 	for nextIdx < len(as.cutoverTimesAsc) && nextCutoverNanos < toNanos {
 		nextMatchRes := as.forwardMatchAt(id, nextCutoverNanos)
 		forExistingID = mergeResultsForExistingID(forExistingID, nextMatchRes.forExistingID, nextCutoverNanos)
 		forNewRollupIDs = mergeResultsForNewRollupIDs(forNewRollupIDs, nextMatchRes.forNewRollupIDs, nextCutoverNanos)
+		if len(as.ingestRules) > 0 {
+			nextIngestRes := as.ingestResultFor(id, nextCutoverNanos)
+			nextIngestRes.CutoverNanos = nextCutoverNanos
+			forIngest = append(forIngest, nextIngestRes)
+		}
 		nextIdx++
 		nextCutoverNanos = as.cutoverNanosAt(nextIdx)
 	}
@@ -139,7 +156,9 @@ func (as *activeRuleSet) ForwardMatch(
 	// after `fromNanos`, or the end of the match time range reaches the first cutover time after
 	// `toNanos` among all active rules because the metric may then be matched against a different
 	// set of rules.
-	return NewMatchResult(as.version, nextCutoverNanos, forExistingID, forNewRollupIDs)
+	res := NewMatchResult(as.version, nextCutoverNanos, forExistingID, forNewRollupIDs)
+	res.forIngest = forIngest
+	return res
 }
 
 func (as *activeRuleSet) ReverseMatch(
@@ -284,6 +303,33 @@ func (as *activeRuleSet) rollupResultsFor(id []byte, timeNanos int64) rollupResu
 	return res
 }
 
+// ingestResultFor returns the combined actions of the ingest rules matching the
+// metric ID at a given time, in the order the rules were added to the ruleset.
+// Every rule is matched against the original ID rather than the ID rewritten by
+// the rules before it.
+func (as *activeRuleSet) ingestResultFor(id []byte, timeNanos int64) IngestResult {
+	var res IngestResult
+	for _, ingestRule := range as.ingestRules {
+		snapshot := ingestRule.activeSnapshot(timeNanos)
+		if snapshot == nil {
+			continue
+		}
+		if !snapshot.filter.Matches(id) {
+			continue
+		}
+		// Make sure the cutover time tracks the latest cutover time among all matching
+		// ingest rules to represent the correct time of rule change.
+		if res.CutoverNanos < snapshot.cutoverNanos {
+			res.CutoverNanos = snapshot.cutoverNanos
+		}
+		if snapshot.tombstoned {
+			continue
+		}
+		res = mergeIngestResults(res, snapshot)
+	}
+	return res
+}
+
 // toRollupMatchResult applies the rollup operation in each rollup pipelines contained
 // in the rollup targets against the matching ID to determine the resulting new rollup
 // ID. It additionally distinguishes rollup pipelines whose first operation is a rollup
@@ -30,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/metrics/filters"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric"
+	metricID "github.com/m3db/m3/src/metrics/metric/id"
 	"github.com/m3db/m3/src/metrics/pipeline"
 	"github.com/m3db/m3/src/metrics/pipeline/applied"
 	"github.com/m3db/m3/src/metrics/policy"
@@ -55,6 +56,7 @@ func TestActiveRuleSetCutoverTimesWithMappingRules(t *testing.T) {
 		0,
 		testMappingRules(t),
 		nil,
+		nil,
 		testTagsFilterOptions(),
 		mockNewID,
 		nil,
@@ -68,6 +70,7 @@ func TestActiveRuleSetCutoverTimesWithRollupRules(t *testing.T) {
 		0,
 		nil,
 		testRollupRules(t),
+		nil,
 		testTagsFilterOptions(),
 		mockNewID,
 		nil,
@@ -81,6 +84,7 @@ func TestActiveRuleSetCutoverTimesWithMappingRulesAndRollupRules(t *testing.T) {
 		0,
 		testMappingRules(t),
 		testRollupRules(t),
+		nil,
 		testTagsFilterOptions(),
 		mockNewID,
 		nil,
@@ -543,6 +547,7 @@ func TestActiveRuleSetForwardMatchWithMappingRules(t *testing.T) {
 		0,
 		testMappingRules(t),
 		nil,
+		nil,
 		testTagsFilterOptions(),
 		mockNewID,
 		nil,
@@ -1313,6 +1318,7 @@ func TestActiveRuleSetForwardMatchWithRollupRules(t *testing.T) {
 		0,
 		nil,
 		testRollupRules(t),
+		nil,
 		testTagsFilterOptions(),
 		mockNewID,
 		nil,
@@ -2437,6 +2443,7 @@ func TestActiveRuleSetForwardMatchWithMappingRulesAndRollupRules(t *testing.T) {
 		0,
 		testMappingRules(t),
 		testRollupRules(t),
+		nil,
 		testTagsFilterOptions(),
 		mockNewID,
 		nil,
@@ -2919,6 +2926,7 @@ func TestActiveRuleSetReverseMatchWithMappingRulesForNonRollupID(t *testing.T) {
 		0,
 		testMappingRules(t),
 		nil,
+		nil,
 		testTagsFilterOptions(),
 		mockNewID,
 		func([]byte, []byte) bool { return false },
@@ -3123,6 +3131,7 @@ func TestActiveRuleSetReverseMatchWithRollupRulesForRollupID(t *testing.T) {
 		0,
 		nil,
 		testRollupRules(t),
+		nil,
 		testTagsFilterOptions(),
 		mockNewID,
 		func([]byte, []byte) bool { return true },
@@ -3135,6 +3144,58 @@ func TestActiveRuleSetReverseMatchWithRollupRulesForRollupID(t *testing.T) {
 	}
 }
 
+func TestActiveRuleSetForwardMatchWithIngestRules(t *testing.T) {
+	as := newActiveRuleSet(
+		0,
+		nil,
+		nil,
+		testIngestRules(t),
+		testTagsFilterOptions(),
+		mockNewID,
+		nil,
+	)
+	require.Equal(t, []int64{5000, 10000, 15000, 20000, 30000}, as.cutoverTimesAsc)
+
+	res := as.ForwardMatch(b("itagName1=itagValue1"), 12000, 35000)
+	require.Equal(t, timeNanosMax, res.ExpireAtNanos())
+
+	expected := []IngestResult{
+		{
+			CutoverNanos: 10000,
+			DropTags:     [][]byte{b("itagName2")},
+		},
+		{
+			CutoverNanos: 15000,
+			DropTags:     [][]byte{b("itagName2")},
+			AddTags:      []metricID.TagPair{{Name: b("env"), Value: b("prod")}},
+		},
+		{
+			CutoverNanos: 20000,
+			RenameTags:   []IngestTagRename{{From: b("itagName3"), To: b("itagName4")}},
+			AddTags:      []metricID.TagPair{{Name: b("env"), Value: b("prod")}},
+		},
+		{
+			CutoverNanos: 30000,
+			RenameTags:   []IngestTagRename{{From: b("itagName3"), To: b("itagName4")}},
+		},
+	}
+	require.Equal(t, expected[0], res.IngestAt(12000))
+	require.Equal(t, expected[0], res.IngestAt(14999))
+	require.Equal(t, expected[1], res.IngestAt(15000))
+	require.Equal(t, expected[2], res.IngestAt(25000))
+	require.Equal(t, expected[3], res.IngestAt(40000))
+	require.True(t, res.IngestAt(0).IsEmpty())
+
+	res = as.ForwardMatch(b("itagName1=itagValue2"), 12000, 35000)
+	ingestRes := res.IngestAt(12000)
+	require.True(t, ingestRes.Drop)
+	require.Equal(t, int64(5000), ingestRes.CutoverNanos)
+
+	res = as.ForwardMatch(b("otherTagName=otherTagValue"), 12000, 35000)
+	require.True(t, res.IngestAt(12000).IsEmpty())
+	require.True(t, res.IngestAt(35000).IsEmpty())
+}
+
 func testMappingRules(t *testing.T) []*mappingRule {
 	filter1, err := filters.NewTagsFilter(
 		filters.TagFilterValueMap{"mtagName1": filters.FilterValue{Pattern: "mtagValue1"}},
@@ -3901,3 +3962,71 @@ func testRollupRules(t *testing.T) []*rollupRule {
 
 	return []*rollupRule{rollupRule1, rollupRule2, rollupRule3, rollupRule4, rollupRule5, rollupRule6, rollupRule7, rollupRule8}
 }
+
+func testIngestRules(t *testing.T) []*ingestRule {
+	filter1, err := filters.NewTagsFilter(
+		filters.TagFilterValueMap{"itagName1": filters.FilterValue{Pattern: "itagValue1"}},
+		filters.Conjunction,
+		testTagsFilterOptions(),
+	)
+	require.NoError(t, err)
+	filter2, err := filters.NewTagsFilter(
+		filters.TagFilterValueMap{"itagName1": filters.FilterValue{Pattern: "itagValue*"}},
+		filters.Conjunction,
+		testTagsFilterOptions(),
+	)
+	require.NoError(t, err)
+	filter3, err := filters.NewTagsFilter(
+		filters.TagFilterValueMap{"itagName1": filters.FilterValue{Pattern: "itagValue2"}},
+		filters.Conjunction,
+		testTagsFilterOptions(),
+	)
+	require.NoError(t, err)
+
+	ingestRule1 := &ingestRule{
+		uuid: "ingestRule1",
+		snapshots: []*ingestRuleSnapshot{
+			&ingestRuleSnapshot{
+				name:         "ingestRule1.snapshot1",
+				cutoverNanos: 10000,
+				filter:       filter1,
+				dropTags:     [][]byte{b("itagName2")},
+			},
+			&ingestRuleSnapshot{
+				name:         "ingestRule1.snapshot2",
+				cutoverNanos: 20000,
+				filter:       filter1,
+				renameTags:   []IngestTagRename{{From: b("itagName3"), To: b("itagName4")}},
+			},
+		},
+	}
+	ingestRule2 := &ingestRule{
+		uuid: "ingestRule2",
+		snapshots: []*ingestRuleSnapshot{
+			&ingestRuleSnapshot{
+				name:         "ingestRule2.snapshot1",
+				cutoverNanos: 15000,
+				filter:       filter2,
+				addTags:      []metricID.TagPair{{Name: b("env"), Value: b("prod")}},
+			},
+			&ingestRuleSnapshot{
+				name:         "ingestRule2.snapshot2",
+				tombstoned:   true,
+				cutoverNanos: 30000,
+				filter:       filter2,
+			},
+		},
+	}
+	ingestRule3 := &ingestRule{
+		uuid: "ingestRule3",
+		snapshots: []*ingestRuleSnapshot{
+			&ingestRuleSnapshot{
+				name:         "ingestRule3.snapshot1",
+				cutoverNanos: 5000,
+				filter:       filter3,
+				drop:         true,
+			},
+		},
+	}
+	return []*ingestRule{ingestRule1, ingestRule2, ingestRule3}
+}
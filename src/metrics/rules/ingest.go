@@ -0,0 +1,540 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+
+	merrors "github.com/m3db/m3/src/metrics/errors"
+	"github.com/m3db/m3/src/metrics/filters"
+	"github.com/m3db/m3/src/metrics/generated/proto/rulepb"
+	metricID "github.com/m3db/m3/src/metrics/metric/id"
+	"github.com/m3db/m3/src/metrics/rules/view"
+
+	"github.com/pborman/uuid"
+)
+
+var (
+	errNoActionsInIngestRuleSnapshot     = errors.New("no actions in ingest rule snapshot")
+	errEmptyTagNameInIngestRule          = errors.New("empty tag name in ingest rule snapshot")
+	errIngestRuleSnapshotIndexOutOfRange = errors.New("ingest rule snapshot index out of range")
+	errNilIngestRuleSnapshotProto        = errors.New("nil ingest rule snapshot proto")
+	errNilIngestRuleProto                = errors.New("nil ingest rule proto")
+)
+
+// IngestTagRename renames a tag from one name to another.
+type IngestTagRename struct {
+	From []byte
+	To   []byte
+}
+
+// IngestResult is the result of matching a metric against the ingest rules in
+// effect at a given time. Writers apply it to a metric before the metric is
+// stored unaggregated or aggregated: a dropped metric is discarded, otherwise
+// tags are dropped, then renamed and finally the static tags are added,
+// replacing any existing tags with the same names.
+type IngestResult struct {
+	CutoverNanos int64
+	Drop         bool
+	DropTags     [][]byte
+	RenameTags   []IngestTagRename
+	AddTags      []metricID.TagPair
+}
+
+// IsEmpty returns whether the ingest result leaves a metric unchanged.
+func (r IngestResult) IsEmpty() bool {
+	return !r.Drop && len(r.DropTags) == 0 && len(r.RenameTags) == 0 && len(r.AddTags) == 0
+}
+
+// ingestRuleSnapshot defines a rule snapshot such that if a metric matches the
+// provided filters, it is either dropped or its tags are rewritten as it is ingested.
+type ingestRuleSnapshot struct {
+	name               string
+	tombstoned         bool
+	cutoverNanos       int64
+	filter             filters.Filter
+	rawFilter          string
+	drop               bool
+	dropTags           [][]byte
+	renameTags         []IngestTagRename
+	addTags            []metricID.TagPair
+	lastUpdatedAtNanos int64
+	lastUpdatedBy      string
+}
+
+func newIngestRuleSnapshotFromProto(
+	r *rulepb.IngestRuleSnapshot,
+	opts filters.TagsFilterOptions,
+) (*ingestRuleSnapshot, error) {
+	if r == nil {
+		return nil, errNilIngestRuleSnapshotProto
+	}
+	var (
+		dropTags   [][]byte
+		renameTags []IngestTagRename
+		addTags    []metricID.TagPair
+	)
+	for _, t := range r.DropTags {
+		dropTags = append(dropTags, []byte(t))
+	}
+	for _, t := range r.RenameTags {
+		renameTags = append(renameTags, IngestTagRename{From: []byte(t.From), To: []byte(t.To)})
+	}
+	for _, t := range r.AddTags {
+		addTags = append(addTags, metricID.TagPair{Name: []byte(t.Name), Value: []byte(t.Value)})
+	}
+	if !r.Tombstoned {
+		if err := validateIngestActions(r.Drop, dropTags, renameTags, addTags); err != nil {
+			return nil, err
+		}
+	}
+
+	filterValues, err := filters.ParseTagFilterValueMap(r.Filter)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := filters.NewTagsFilter(filterValues, filters.Conjunction, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIngestRuleSnapshotFromFieldsInternal(
+		r.Name,
+		r.Tombstoned,
+		r.CutoverNanos,
+		filter,
+		r.Filter,
+		r.Drop,
+		dropTags,
+		renameTags,
+		addTags,
+		r.LastUpdatedAtNanos,
+		r.LastUpdatedBy,
+	), nil
+}
+
+func newIngestRuleSnapshotFromFields(
+	name string,
+	cutoverNanos int64,
+	filter filters.Filter,
+	rawFilter string,
+	drop bool,
+	dropTags [][]byte,
+	renameTags []IngestTagRename,
+	addTags []metricID.TagPair,
+	lastUpdatedAtNanos int64,
+	lastUpdatedBy string,
+) (*ingestRuleSnapshot, error) {
+	if _, err := filters.ValidateTagsFilter(rawFilter); err != nil {
+		return nil, err
+	}
+	if err := validateIngestActions(drop, dropTags, renameTags, addTags); err != nil {
+		return nil, merrors.NewInvalidInputError(err.Error())
+	}
+	return newIngestRuleSnapshotFromFieldsInternal(
+		name,
+		false,
+		cutoverNanos,
+		filter,
+		rawFilter,
+		drop,
+		dropTags,
+		renameTags,
+		addTags,
+		lastUpdatedAtNanos,
+		lastUpdatedBy,
+	), nil
+}
+
+// newIngestRuleSnapshotFromFieldsInternal creates a new ingest rule snapshot
+// from various given fields assuming the filter has already been validated.
+func newIngestRuleSnapshotFromFieldsInternal(
+	name string,
+	tombstoned bool,
+	cutoverNanos int64,
+	filter filters.Filter,
+	rawFilter string,
+	drop bool,
+	dropTags [][]byte,
+	renameTags []IngestTagRename,
+	addTags []metricID.TagPair,
+	lastUpdatedAtNanos int64,
+	lastUpdatedBy string,
+) *ingestRuleSnapshot {
+	return &ingestRuleSnapshot{
+		name:               name,
+		tombstoned:         tombstoned,
+		cutoverNanos:       cutoverNanos,
+		filter:             filter,
+		rawFilter:          rawFilter,
+		drop:               drop,
+		dropTags:           dropTags,
+		renameTags:         renameTags,
+		addTags:            addTags,
+		lastUpdatedAtNanos: lastUpdatedAtNanos,
+		lastUpdatedBy:      lastUpdatedBy,
+	}
+}
+
+func (irs *ingestRuleSnapshot) clone() ingestRuleSnapshot {
+	var filter filters.Filter
+	if irs.filter != nil {
+		filter = irs.filter.Clone()
+	}
+	var dropTags [][]byte
+	if irs.dropTags != nil {
+		dropTags = make([][]byte, len(irs.dropTags))
+		copy(dropTags, irs.dropTags)
+	}
+	var renameTags []IngestTagRename
+	if irs.renameTags != nil {
+		renameTags = make([]IngestTagRename, len(irs.renameTags))
+		copy(renameTags, irs.renameTags)
+	}
+	var addTags []metricID.TagPair
+	if irs.addTags != nil {
+		addTags = make([]metricID.TagPair, len(irs.addTags))
+		copy(addTags, irs.addTags)
+	}
+	return ingestRuleSnapshot{
+		name:               irs.name,
+		tombstoned:         irs.tombstoned,
+		cutoverNanos:       irs.cutoverNanos,
+		filter:             filter,
+		rawFilter:          irs.rawFilter,
+		drop:               irs.drop,
+		dropTags:           dropTags,
+		renameTags:         renameTags,
+		addTags:            addTags,
+		lastUpdatedAtNanos: irs.lastUpdatedAtNanos,
+		lastUpdatedBy:      irs.lastUpdatedBy,
+	}
+}
+
+// proto returns the given IngestRuleSnapshot in protobuf form.
+func (irs *ingestRuleSnapshot) proto() *rulepb.IngestRuleSnapshot {
+	var (
+		dropTags   []string
+		renameTags []*rulepb.IngestTagRename
+		addTags    []*rulepb.IngestTag
+	)
+	for _, t := range irs.dropTags {
+		dropTags = append(dropTags, string(t))
+	}
+	for _, t := range irs.renameTags {
+		renameTags = append(renameTags, &rulepb.IngestTagRename{
+			From: string(t.From),
+			To:   string(t.To),
+		})
+	}
+	for _, t := range irs.addTags {
+		addTags = append(addTags, &rulepb.IngestTag{
+			Name:  string(t.Name),
+			Value: string(t.Value),
+		})
+	}
+	return &rulepb.IngestRuleSnapshot{
+		Name:               irs.name,
+		Tombstoned:         irs.tombstoned,
+		CutoverNanos:       irs.cutoverNanos,
+		Filter:             irs.rawFilter,
+		LastUpdatedAtNanos: irs.lastUpdatedAtNanos,
+		LastUpdatedBy:      irs.lastUpdatedBy,
+		Drop:               irs.drop,
+		DropTags:           dropTags,
+		RenameTags:         renameTags,
+		AddTags:            addTags,
+	}
+}
+
+// ingestRule stores ingest rule snapshots.
+type ingestRule struct {
+	uuid      string
+	snapshots []*ingestRuleSnapshot
+}
+
+func newEmptyIngestRule() *ingestRule {
+	return &ingestRule{uuid: uuid.New()}
+}
+
+func newIngestRuleFromProto(
+	ic *rulepb.IngestRule,
+	opts filters.TagsFilterOptions,
+) (*ingestRule, error) {
+	if ic == nil {
+		return nil, errNilIngestRuleProto
+	}
+	snapshots := make([]*ingestRuleSnapshot, 0, len(ic.Snapshots))
+	for i := 0; i < len(ic.Snapshots); i++ {
+		ir, err := newIngestRuleSnapshotFromProto(ic.Snapshots[i], opts)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, ir)
+	}
+	return &ingestRule{
+		uuid:      ic.Uuid,
+		snapshots: snapshots,
+	}, nil
+}
+
+func (ic *ingestRule) clone() ingestRule {
+	snapshots := make([]*ingestRuleSnapshot, len(ic.snapshots))
+	for i, s := range ic.snapshots {
+		c := s.clone()
+		snapshots[i] = &c
+	}
+	return ingestRule{
+		uuid:      ic.uuid,
+		snapshots: snapshots,
+	}
+}
+
+// proto returns the given IngestRule in protobuf form.
+func (ic *ingestRule) proto() *rulepb.IngestRule {
+	snapshots := make([]*rulepb.IngestRuleSnapshot, len(ic.snapshots))
+	for i, s := range ic.snapshots {
+		snapshots[i] = s.proto()
+	}
+	return &rulepb.IngestRule{
+		Uuid:      ic.uuid,
+		Snapshots: snapshots,
+	}
+}
+
+// activeSnapshot returns the active rule snapshot whose cutover time is no later than
+// the time passed in, or nil if no such rule snapshot exists.
+func (ic *ingestRule) activeSnapshot(timeNanos int64) *ingestRuleSnapshot {
+	idx := ic.activeIndex(timeNanos)
+	if idx < 0 {
+		return nil
+	}
+	return ic.snapshots[idx]
+}
+
+// activeRule returns the rule containing snapshots that's in effect at time timeNanos
+// and all future snapshots after time timeNanos.
+func (ic *ingestRule) activeRule(timeNanos int64) *ingestRule {
+	idx := ic.activeIndex(timeNanos)
+	// If there are no snapshots that are currently in effect, it means either all
+	// snapshots are in the future, or there are no snapshots.
+	if idx < 0 {
+		return ic
+	}
+	return &ingestRule{
+		uuid:      ic.uuid,
+		snapshots: ic.snapshots[idx:],
+	}
+}
+
+func (ic *ingestRule) name() (string, error) {
+	if len(ic.snapshots) == 0 {
+		return "", errNoRuleSnapshots
+	}
+	latest := ic.snapshots[len(ic.snapshots)-1]
+	return latest.name, nil
+}
+
+func (ic *ingestRule) tombstoned() bool {
+	if len(ic.snapshots) == 0 {
+		return true
+	}
+	latest := ic.snapshots[len(ic.snapshots)-1]
+	return latest.tombstoned
+}
+
+func (ic *ingestRule) addSnapshot(
+	irv view.IngestRule,
+	meta UpdateMetadata,
+) error {
+	dropTags, renameTags, addTags := ingestActionsFromView(irv)
+	snapshot, err := newIngestRuleSnapshotFromFields(
+		irv.Name,
+		meta.cutoverNanos,
+		nil,
+		irv.Filter,
+		irv.Drop,
+		dropTags,
+		renameTags,
+		addTags,
+		meta.updatedAtNanos,
+		meta.updatedBy,
+	)
+	if err != nil {
+		return err
+	}
+	ic.snapshots = append(ic.snapshots, snapshot)
+	return nil
+}
+
+func (ic *ingestRule) markTombstoned(meta UpdateMetadata) error {
+	n, err := ic.name()
+	if err != nil {
+		return err
+	}
+
+	if ic.tombstoned() {
+		return merrors.NewInvalidInputError(fmt.Sprintf("%s is already tombstoned", n))
+	}
+	if len(ic.snapshots) == 0 {
+		return errNoRuleSnapshots
+	}
+	snapshot := ic.snapshots[len(ic.snapshots)-1].clone()
+	snapshot.tombstoned = true
+	snapshot.cutoverNanos = meta.cutoverNanos
+	snapshot.lastUpdatedAtNanos = meta.updatedAtNanos
+	snapshot.lastUpdatedBy = meta.updatedBy
+	snapshot.drop = false
+	snapshot.dropTags = nil
+	snapshot.renameTags = nil
+	snapshot.addTags = nil
+	ic.snapshots = append(ic.snapshots, &snapshot)
+	return nil
+}
+
+func (ic *ingestRule) revive(
+	irv view.IngestRule,
+	meta UpdateMetadata,
+) error {
+	n, err := ic.name()
+	if err != nil {
+		return err
+	}
+	if !ic.tombstoned() {
+		return merrors.NewInvalidInputError(fmt.Sprintf("%s is not tombstoned", n))
+	}
+	return ic.addSnapshot(irv, meta)
+}
+
+func (ic *ingestRule) activeIndex(timeNanos int64) int {
+	idx := len(ic.snapshots) - 1
+	for idx >= 0 && ic.snapshots[idx].cutoverNanos > timeNanos {
+		idx--
+	}
+	return idx
+}
+
+func (ic *ingestRule) history() ([]view.IngestRule, error) {
+	lastIdx := len(ic.snapshots) - 1
+	views := make([]view.IngestRule, len(ic.snapshots))
+	// Snapshots are stored oldest -> newest. History should start with newest.
+	for i := 0; i < len(ic.snapshots); i++ {
+		irs, err := ic.ingestRuleView(lastIdx - i)
+		if err != nil {
+			return nil, err
+		}
+		views[i] = irs
+	}
+	return views, nil
+}
+
+func (ic *ingestRule) ingestRuleView(snapshotIdx int) (view.IngestRule, error) {
+	if snapshotIdx < 0 || snapshotIdx >= len(ic.snapshots) {
+		return view.IngestRule{}, errIngestRuleSnapshotIndexOutOfRange
+	}
+
+	irs := ic.snapshots[snapshotIdx]
+	var (
+		dropTags   []string
+		renameTags []view.IngestTagRename
+		addTags    []view.IngestTag
+	)
+	for _, t := range irs.dropTags {
+		dropTags = append(dropTags, string(t))
+	}
+	for _, t := range irs.renameTags {
+		renameTags = append(renameTags, view.IngestTagRename{From: string(t.From), To: string(t.To)})
+	}
+	for _, t := range irs.addTags {
+		addTags = append(addTags, view.IngestTag{Name: string(t.Name), Value: string(t.Value)})
+	}
+	return view.IngestRule{
+		ID:                  ic.uuid,
+		Name:                irs.name,
+		Tombstoned:          irs.tombstoned,
+		CutoverMillis:       irs.cutoverNanos / nanosPerMilli,
+		Filter:              irs.rawFilter,
+		Drop:                irs.drop,
+		DropTags:            dropTags,
+		RenameTags:          renameTags,
+		AddTags:             addTags,
+		LastUpdatedBy:       irs.lastUpdatedBy,
+		LastUpdatedAtMillis: irs.lastUpdatedAtNanos / nanosPerMilli,
+	}, nil
+}
+
+func ingestActionsFromView(
+	irv view.IngestRule,
+) ([][]byte, []IngestTagRename, []metricID.TagPair) {
+	var (
+		dropTags   [][]byte
+		renameTags []IngestTagRename
+		addTags    []metricID.TagPair
+	)
+	for _, t := range irv.DropTags {
+		dropTags = append(dropTags, []byte(t))
+	}
+	for _, t := range irv.RenameTags {
+		renameTags = append(renameTags, IngestTagRename{From: []byte(t.From), To: []byte(t.To)})
+	}
+	for _, t := range irv.AddTags {
+		addTags = append(addTags, metricID.TagPair{Name: []byte(t.Name), Value: []byte(t.Value)})
+	}
+	return dropTags, renameTags, addTags
+}
+
+func validateIngestActions(
+	drop bool,
+	dropTags [][]byte,
+	renameTags []IngestTagRename,
+	addTags []metricID.TagPair,
+) error {
+	if !drop && len(dropTags) == 0 && len(renameTags) == 0 && len(addTags) == 0 {
+		return errNoActionsInIngestRuleSnapshot
+	}
+	for _, t := range dropTags {
+		if len(t) == 0 {
+			return errEmptyTagNameInIngestRule
+		}
+	}
+	for _, t := range renameTags {
+		if len(t.From) == 0 || len(t.To) == 0 {
+			return errEmptyTagNameInIngestRule
+		}
+	}
+	for _, t := range addTags {
+		if len(t.Name) == 0 {
+			return errEmptyTagNameInIngestRule
+		}
+	}
+	return nil
+}
+
+// mergeIngestResults appends the actions of the next ingest result to the
+// current ingest result.
+func mergeIngestResults(curr IngestResult, next *ingestRuleSnapshot) IngestResult {
+	curr.Drop = curr.Drop || next.drop
+	curr.DropTags = append(curr.DropTags, next.dropTags...)
+	curr.RenameTags = append(curr.RenameTags, next.renameTags...)
+	curr.AddTags = append(curr.AddTags, next.addTags...)
+	return curr
+}
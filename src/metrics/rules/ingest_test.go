@@ -0,0 +1,372 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3/src/metrics/errors"
+	"github.com/m3db/m3/src/metrics/filters"
+	"github.com/m3db/m3/src/metrics/generated/proto/rulepb"
+	metricID "github.com/m3db/m3/src/metrics/metric/id"
+	"github.com/m3db/m3/src/metrics/rules/view"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testIngestRuleSnapshot1Proto = &rulepb.IngestRuleSnapshot{
+		Name:               "foo",
+		Tombstoned:         false,
+		CutoverNanos:       12345000000,
+		Filter:             "tag1:value1 tag2:value2",
+		LastUpdatedAtNanos: 12345000000,
+		LastUpdatedBy:      "someone",
+		DropTags:           []string{"tag3"},
+		RenameTags: []*rulepb.IngestTagRename{
+			&rulepb.IngestTagRename{From: "tag4", To: "tag5"},
+		},
+		AddTags: []*rulepb.IngestTag{
+			&rulepb.IngestTag{Name: "env", Value: "prod"},
+		},
+	}
+	testIngestRuleSnapshot2Proto = &rulepb.IngestRuleSnapshot{
+		Name:               "bar",
+		Tombstoned:         true,
+		CutoverNanos:       67890000000,
+		Filter:             "tag3:value3 tag4:value4",
+		LastUpdatedAtNanos: 67890000000,
+		LastUpdatedBy:      "someone-else",
+	}
+	testIngestRuleSnapshot3Proto = &rulepb.IngestRuleSnapshot{
+		Name:               "baz",
+		Tombstoned:         false,
+		CutoverNanos:       67890000000,
+		Filter:             "tag3:value3",
+		LastUpdatedAtNanos: 67890000000,
+		LastUpdatedBy:      "someone-else",
+		Drop:               true,
+	}
+	testIngestRule1Proto = &rulepb.IngestRule{
+		Uuid: "12669817-13ae-40e6-ba2f-33087b262c68",
+		Snapshots: []*rulepb.IngestRuleSnapshot{
+			testIngestRuleSnapshot1Proto,
+			testIngestRuleSnapshot2Proto,
+		},
+	}
+	testIngestRuleSnapshot1 = &ingestRuleSnapshot{
+		name:               "foo",
+		tombstoned:         false,
+		cutoverNanos:       12345000000,
+		rawFilter:          "tag1:value1 tag2:value2",
+		dropTags:           [][]byte{[]byte("tag3")},
+		renameTags:         []IngestTagRename{{From: []byte("tag4"), To: []byte("tag5")}},
+		addTags:            []metricID.TagPair{{Name: []byte("env"), Value: []byte("prod")}},
+		lastUpdatedAtNanos: 12345000000,
+		lastUpdatedBy:      "someone",
+	}
+	testIngestRuleSnapshot2 = &ingestRuleSnapshot{
+		name:               "bar",
+		tombstoned:         true,
+		cutoverNanos:       67890000000,
+		rawFilter:          "tag3:value3 tag4:value4",
+		lastUpdatedAtNanos: 67890000000,
+		lastUpdatedBy:      "someone-else",
+	}
+	testIngestRuleSnapshot3 = &ingestRuleSnapshot{
+		name:               "baz",
+		tombstoned:         false,
+		cutoverNanos:       67890000000,
+		rawFilter:          "tag3:value3",
+		drop:               true,
+		lastUpdatedAtNanos: 67890000000,
+		lastUpdatedBy:      "someone-else",
+	}
+	testIngestRule1 = &ingestRule{
+		uuid: "12669817-13ae-40e6-ba2f-33087b262c68",
+		snapshots: []*ingestRuleSnapshot{
+			testIngestRuleSnapshot1,
+			testIngestRuleSnapshot2,
+		},
+	}
+	testIngestRuleSnapshotCmpOpts = []cmp.Option{
+		cmp.AllowUnexported(ingestRuleSnapshot{}),
+		cmpopts.IgnoreInterfaces(struct{ filters.Filter }{}),
+	}
+	testIngestRuleCmpOpts = []cmp.Option{
+		cmp.AllowUnexported(ingestRule{}),
+		cmp.AllowUnexported(ingestRuleSnapshot{}),
+		cmpopts.IgnoreInterfaces(struct{ filters.Filter }{}),
+	}
+)
+
+func TestIngestResultIsEmpty(t *testing.T) {
+	require.True(t, IngestResult{CutoverNanos: 1000}.IsEmpty())
+	require.False(t, IngestResult{Drop: true}.IsEmpty())
+	require.False(t, IngestResult{DropTags: [][]byte{[]byte("foo")}}.IsEmpty())
+}
+
+func TestNewIngestRuleSnapshotFromProtoNilProto(t *testing.T) {
+	_, err := newIngestRuleSnapshotFromProto(nil, testTagsFilterOptions())
+	require.Equal(t, errNilIngestRuleSnapshotProto, err)
+}
+
+func TestNewIngestRuleSnapshotFromProtoNoActions(t *testing.T) {
+	proto := &rulepb.IngestRuleSnapshot{Filter: "tag1:value1"}
+	_, err := newIngestRuleSnapshotFromProto(proto, testTagsFilterOptions())
+	require.Equal(t, errNoActionsInIngestRuleSnapshot, err)
+}
+
+func TestNewIngestRuleSnapshotFromProtoEmptyTagName(t *testing.T) {
+	protos := []*rulepb.IngestRuleSnapshot{
+		{DropTags: []string{""}},
+		{RenameTags: []*rulepb.IngestTagRename{{From: "foo"}}},
+		{AddTags: []*rulepb.IngestTag{{Value: "bar"}}},
+	}
+	for _, proto := range protos {
+		_, err := newIngestRuleSnapshotFromProto(proto, testTagsFilterOptions())
+		require.Equal(t, errEmptyTagNameInIngestRule, err)
+	}
+}
+
+func TestNewIngestRuleSnapshotFromProto(t *testing.T) {
+	inputs := []*rulepb.IngestRuleSnapshot{
+		testIngestRuleSnapshot1Proto,
+		testIngestRuleSnapshot2Proto,
+		testIngestRuleSnapshot3Proto,
+	}
+	expected := []*ingestRuleSnapshot{
+		testIngestRuleSnapshot1,
+		testIngestRuleSnapshot2,
+		testIngestRuleSnapshot3,
+	}
+	for i, input := range inputs {
+		res, err := newIngestRuleSnapshotFromProto(input, testTagsFilterOptions())
+		require.NoError(t, err)
+		require.True(t, cmp.Equal(expected[i], res, testIngestRuleSnapshotCmpOpts...))
+		require.NotNil(t, res.filter)
+	}
+}
+
+func TestNewIngestRuleSnapshotFromFieldsValidationError(t *testing.T) {
+	badFilters := []string{
+		"tag3:",
+		"tag3:*a*b*c*d",
+		"ab[cd",
+	}
+	for _, f := range badFilters {
+		_, err := newIngestRuleSnapshotFromFields(
+			"bar",
+			12345000000,
+			nil,
+			f,
+			true,
+			nil,
+			nil,
+			nil,
+			1234,
+			"test_user",
+		)
+		require.Error(t, err)
+		_, ok := err.(errors.ValidationError)
+		require.True(t, ok)
+	}
+
+	_, err := newIngestRuleSnapshotFromFields(
+		"bar",
+		12345000000,
+		nil,
+		"tag3:value3",
+		false,
+		nil,
+		nil,
+		nil,
+		1234,
+		"test_user",
+	)
+	require.Error(t, err)
+	_, ok := err.(errors.InvalidInputError)
+	require.True(t, ok)
+}
+
+func TestIngestRuleSnapshotProto(t *testing.T) {
+	snapshots := []*ingestRuleSnapshot{
+		testIngestRuleSnapshot1,
+		testIngestRuleSnapshot2,
+		testIngestRuleSnapshot3,
+	}
+	expected := []*rulepb.IngestRuleSnapshot{
+		testIngestRuleSnapshot1Proto,
+		testIngestRuleSnapshot2Proto,
+		testIngestRuleSnapshot3Proto,
+	}
+	for i, snapshot := range snapshots {
+		require.Equal(t, expected[i], snapshot.proto())
+	}
+}
+
+func TestNewIngestRuleFromProtoNilProto(t *testing.T) {
+	_, err := newIngestRuleFromProto(nil, testTagsFilterOptions())
+	require.Equal(t, errNilIngestRuleProto, err)
+}
+
+func TestNewIngestRuleFromProtoValidProto(t *testing.T) {
+	res, err := newIngestRuleFromProto(testIngestRule1Proto, testTagsFilterOptions())
+	require.NoError(t, err)
+	require.True(t, cmp.Equal(testIngestRule1, res, testIngestRuleCmpOpts...))
+	require.Equal(t, testIngestRule1Proto, res.proto())
+}
+
+func TestIngestRuleClone(t *testing.T) {
+	cloned := testIngestRule1.clone()
+	require.True(t, cmp.Equal(&cloned, testIngestRule1, testIngestRuleCmpOpts...))
+
+	// Asserting that modifying the clone doesn't modify the original ingest rule.
+	cloned.snapshots[0].tombstoned = true
+	cloned.snapshots[0].dropTags[0] = []byte("other")
+	require.False(t, cmp.Equal(&cloned, testIngestRule1, testIngestRuleCmpOpts...))
+	require.False(t, testIngestRule1.snapshots[0].tombstoned)
+	require.Equal(t, []byte("tag3"), testIngestRule1.snapshots[0].dropTags[0])
+}
+
+func TestIngestRuleActiveSnapshot(t *testing.T) {
+	require.Nil(t, testIngestRule1.activeSnapshot(0))
+	require.Equal(t, testIngestRule1.snapshots[1], testIngestRule1.activeSnapshot(100000000000))
+}
+
+func TestIngestRuleActiveRule(t *testing.T) {
+	require.Equal(t, testIngestRule1, testIngestRule1.activeRule(0))
+	expected := &ingestRule{
+		uuid:      testIngestRule1.uuid,
+		snapshots: testIngestRule1.snapshots[1:],
+	}
+	require.Equal(t, expected, testIngestRule1.activeRule(100000000000))
+}
+
+func TestIngestRuleNameNoSnapshot(t *testing.T) {
+	ir := ingestRule{uuid: "blah"}
+	_, err := ir.name()
+	require.Equal(t, errNoRuleSnapshots, err)
+	require.True(t, ir.tombstoned())
+}
+
+func TestIngestRuleMarkTombstoned(t *testing.T) {
+	proto := &rulepb.IngestRule{
+		Uuid: "12669817-13ae-40e6-ba2f-33087b262c68",
+		Snapshots: []*rulepb.IngestRuleSnapshot{
+			testIngestRuleSnapshot1Proto,
+		},
+	}
+	ir, err := newIngestRuleFromProto(proto, testTagsFilterOptions())
+	require.NoError(t, err)
+
+	meta := UpdateMetadata{
+		cutoverNanos:   67890000000,
+		updatedAtNanos: 10000,
+		updatedBy:      "john",
+	}
+	require.NoError(t, ir.markTombstoned(meta))
+	require.Equal(t, 2, len(ir.snapshots))
+	require.True(t, cmp.Equal(testIngestRuleSnapshot1, ir.snapshots[0], testIngestRuleSnapshotCmpOpts...))
+
+	expected := &ingestRuleSnapshot{
+		name:               "foo",
+		tombstoned:         true,
+		cutoverNanos:       67890000000,
+		rawFilter:          "tag1:value1 tag2:value2",
+		lastUpdatedAtNanos: 10000,
+		lastUpdatedBy:      "john",
+	}
+	require.True(t, cmp.Equal(expected, ir.snapshots[1], testIngestRuleSnapshotCmpOpts...))
+
+	err = ir.markTombstoned(meta)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "foo is already tombstoned"))
+}
+
+func TestIngestRuleRevive(t *testing.T) {
+	ir, err := newIngestRuleFromProto(testIngestRule1Proto, testTagsFilterOptions())
+	require.NoError(t, err)
+
+	irv := view.IngestRule{
+		Name:   "bar",
+		Filter: "tag3:value3",
+		Drop:   true,
+	}
+	meta := UpdateMetadata{
+		cutoverNanos:   70000000000,
+		updatedAtNanos: 70000000000,
+		updatedBy:      "john",
+	}
+	require.NoError(t, ir.revive(irv, meta))
+	require.False(t, ir.tombstoned())
+	require.Equal(t, 3, len(ir.snapshots))
+	require.True(t, ir.snapshots[2].drop)
+
+	err = ir.revive(irv, meta)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "bar is not tombstoned"))
+}
+
+func TestIngestRuleIngestRuleViewError(t *testing.T) {
+	badIndices := []int{-2, 2, 30}
+	for _, i := range badIndices {
+		_, err := testIngestRule1.ingestRuleView(i)
+		require.Equal(t, errIngestRuleSnapshotIndexOutOfRange, err)
+	}
+}
+
+func TestIngestRuleHistory(t *testing.T) {
+	history, err := testIngestRule1.history()
+	require.NoError(t, err)
+
+	expected := []view.IngestRule{
+		{
+			ID:                  "12669817-13ae-40e6-ba2f-33087b262c68",
+			Name:                "bar",
+			Tombstoned:          true,
+			CutoverMillis:       67890,
+			Filter:              "tag3:value3 tag4:value4",
+			LastUpdatedAtMillis: 67890,
+			LastUpdatedBy:       "someone-else",
+		},
+		{
+			ID:            "12669817-13ae-40e6-ba2f-33087b262c68",
+			Name:          "foo",
+			Tombstoned:    false,
+			CutoverMillis: 12345,
+			Filter:        "tag1:value1 tag2:value2",
+			DropTags:      []string{"tag3"},
+			RenameTags: []view.IngestTagRename{
+				{From: "tag4", To: "tag5"},
+			},
+			AddTags: []view.IngestTag{
+				{Name: "env", Value: "prod"},
+			},
+			LastUpdatedAtMillis: 12345,
+			LastUpdatedBy:       "someone",
+		},
+	}
+	require.Equal(t, expected, history)
+}
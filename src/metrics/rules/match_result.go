@@ -59,6 +59,10 @@ type MatchResult struct {
 	// produced by a rollup rule whose rollup pipeline contains a rollup operation
 	// as its first step.
 	forNewRollupIDs []IDWithMetadatas
+	// This contains the ingest rule matches sorted by cutover time in ascending
+	// order, which determine how the metric is rewritten before it is stored
+	// or aggregated.
+	forIngest []IngestResult
 }
 
 // NewMatchResult creates a new match result.
@@ -102,6 +106,16 @@ func (r *MatchResult) ForNewRollupIDsAt(idx int, timeNanos int64) IDWithMetadata
 	return IDWithMetadatas{ID: forNewRollupID.ID, Metadatas: metadatas}
 }
 
+// IngestAt returns the ingest result in effect at a given time.
+func (r *MatchResult) IngestAt(timeNanos int64) IngestResult {
+	for idx := len(r.forIngest) - 1; idx >= 0; idx-- {
+		if r.forIngest[idx].CutoverNanos <= timeNanos {
+			return r.forIngest[idx]
+		}
+	}
+	return IngestResult{}
+}
+
 // activeStagedMetadatasAt returns the active staged metadatas at a given time, assuming
 // the input list of staged metadatas are sorted by cutover time in ascending order.
 func activeStagedMetadatasAt(
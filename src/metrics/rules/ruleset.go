@@ -83,6 +83,9 @@ type RuleSet interface {
 	// RollupRuleHistory returns a map of rollup rule id to states that rule has been in.
 	RollupRules() (view.RollupRules, error)
 
+	// IngestRules returns a map of ingest rule id to states that rule has been in.
+	IngestRules() (view.IngestRules, error)
+
 	// Latest returns the latest snapshot of a ruleset containing the latest snapshots
 	// of each rule in the ruleset.
 	Latest() (view.RuleSet, error)
@@ -121,6 +124,16 @@ type MutableRuleSet interface {
 	// DeleteRollupRule deletes a rollup rule
 	DeleteRollupRule(string, UpdateMetadata) error
 
+	// AddIngestRule creates a new ingest rule and adds it to this ruleset.
+	// Should return the id of the newly created rule.
+	AddIngestRule(view.IngestRule, UpdateMetadata) (string, error)
+
+	// UpdateIngestRule creates a new ingest rule snapshot and adds it to this ruleset.
+	UpdateIngestRule(view.IngestRule, UpdateMetadata) error
+
+	// DeleteIngestRule deletes an ingest rule
+	DeleteIngestRule(string, UpdateMetadata) error
+
 	// Tombstone tombstones this ruleset and all of its rules.
 	Delete(UpdateMetadata) error
 
@@ -142,6 +155,7 @@ type ruleSet struct {
 	cutoverNanos       int64
 	mappingRules       []*mappingRule
 	rollupRules        []*rollupRule
+	ingestRules        []*ingestRule
 	tagsFilterOpts     filters.TagsFilterOptions
 	newRollupIDFn      metricID.NewIDFn
 	isRollupIDFn       metricID.MatchIDFn
@@ -169,6 +183,14 @@ func NewRuleSetFromProto(version int, rs *rulepb.RuleSet, opts Options) (RuleSet
 		}
 		rollupRules = append(rollupRules, rc)
 	}
+	ingestRules := make([]*ingestRule, 0, len(rs.IngestRules))
+	for _, ingestRule := range rs.IngestRules {
+		ic, err := newIngestRuleFromProto(ingestRule, tagsFilterOpts)
+		if err != nil {
+			return nil, err
+		}
+		ingestRules = append(ingestRules, ic)
+	}
 	return &ruleSet{
 		uuid:               rs.Uuid,
 		version:            version,
@@ -180,6 +202,7 @@ func NewRuleSetFromProto(version int, rs *rulepb.RuleSet, opts Options) (RuleSet
 		cutoverNanos:       rs.CutoverNanos,
 		mappingRules:       mappingRules,
 		rollupRules:        rollupRules,
+		ingestRules:        ingestRules,
 		tagsFilterOpts:     tagsFilterOpts,
 		newRollupIDFn:      opts.NewRollupIDFn(),
 		isRollupIDFn:       opts.IsRollupIDFn(),
@@ -195,6 +218,7 @@ func NewEmptyRuleSet(namespaceName string, meta UpdateMetadata) MutableRuleSet {
 		tombstoned:   false,
 		mappingRules: make([]*mappingRule, 0),
 		rollupRules:  make([]*rollupRule, 0),
+		ingestRules:  make([]*ingestRule, 0),
 	}
 	rs.updateMetadata(meta)
 	return rs
@@ -219,10 +243,16 @@ func (rs *ruleSet) ActiveSet(timeNanos int64) Matcher {
 		activeRule := rollupRule.activeRule(timeNanos)
 		rollupRules = append(rollupRules, activeRule)
 	}
+	ingestRules := make([]*ingestRule, 0, len(rs.ingestRules))
+	for _, ingestRule := range rs.ingestRules {
+		activeRule := ingestRule.activeRule(timeNanos)
+		ingestRules = append(ingestRules, activeRule)
+	}
 	return newActiveRuleSet(
 		rs.version,
 		mappingRules,
 		rollupRules,
+		ingestRules,
 		rs.tagsFilterOpts,
 		rs.newRollupIDFn,
 		rs.isRollupIDFn,
@@ -261,6 +291,14 @@ func (rs *ruleSet) Proto() (*rulepb.RuleSet, error) {
 	}
 	res.RollupRules = rollupRules
 
+	if len(rs.ingestRules) > 0 {
+		ingestRules := make([]*rulepb.IngestRule, len(rs.ingestRules))
+		for i, r := range rs.ingestRules {
+			ingestRules[i] = r.proto()
+		}
+		res.IngestRules = ingestRules
+	}
+
 	return res, nil
 }
 
@@ -288,6 +326,18 @@ func (rs *ruleSet) RollupRules() (view.RollupRules, error) {
 	return rollupRules, nil
 }
 
+func (rs *ruleSet) IngestRules() (view.IngestRules, error) {
+	ingestRules := make(view.IngestRules, len(rs.ingestRules))
+	for _, r := range rs.ingestRules {
+		hist, err := r.history()
+		if err != nil {
+			return nil, err
+		}
+		ingestRules[r.uuid] = hist
+	}
+	return ingestRules, nil
+}
+
 func (rs *ruleSet) Latest() (view.RuleSet, error) {
 	mrs, err := rs.latestMappingRules()
 	if err != nil {
@@ -297,12 +347,17 @@ func (rs *ruleSet) Latest() (view.RuleSet, error) {
 	if err != nil {
 		return view.RuleSet{}, err
 	}
+	irs, err := rs.latestIngestRules()
+	if err != nil {
+		return view.RuleSet{}, err
+	}
 	return view.RuleSet{
 		Namespace:     string(rs.Namespace()),
 		Version:       rs.Version(),
 		CutoverMillis: rs.CutoverNanos() / nanosPerMilli,
 		MappingRules:  mrs,
 		RollupRules:   rrs,
+		IngestRules:   irs,
 	}, nil
 }
 
@@ -322,6 +377,12 @@ func (rs *ruleSet) Clone() MutableRuleSet {
 		rollupRules[i] = &c
 	}
 
+	ingestRules := make([]*ingestRule, len(rs.ingestRules))
+	for i, r := range rs.ingestRules {
+		c := r.clone()
+		ingestRules[i] = &c
+	}
+
 	// This clone deliberately ignores tagFliterOpts and rollupIDFn
 	// as they are not useful for the MutableRuleSet.
 	return &ruleSet{
@@ -335,6 +396,7 @@ func (rs *ruleSet) Clone() MutableRuleSet {
 		namespace:          namespace,
 		mappingRules:       mappingRules,
 		rollupRules:        rollupRules,
+		ingestRules:        ingestRules,
 		tagsFilterOpts:     rs.tagsFilterOpts,
 		newRollupIDFn:      rs.newRollupIDFn,
 		isRollupIDFn:       rs.isRollupIDFn,
@@ -469,6 +531,51 @@ func (rs *ruleSet) DeleteRollupRule(id string, meta UpdateMetadata) error {
 	return nil
 }
 
+func (rs *ruleSet) AddIngestRule(irv view.IngestRule, meta UpdateMetadata) (string, error) {
+	r, err := rs.getIngestRuleByName(irv.Name)
+	if err != nil && err != errRuleNotFound {
+		return "", xerrors.Wrap(err, fmt.Sprintf(ruleActionErrorFmt, "add", irv.Name))
+	}
+	if err == errRuleNotFound {
+		r = newEmptyIngestRule()
+		if err = r.addSnapshot(irv, meta); err != nil {
+			return "", xerrors.Wrap(err, fmt.Sprintf(ruleActionErrorFmt, "add", irv.Name))
+		}
+		rs.ingestRules = append(rs.ingestRules, r)
+	} else {
+		if err := r.revive(irv, meta); err != nil {
+			return "", xerrors.Wrap(err, fmt.Sprintf(ruleActionErrorFmt, "revive", irv.Name))
+		}
+	}
+	rs.updateMetadata(meta)
+	return r.uuid, nil
+}
+
+func (rs *ruleSet) UpdateIngestRule(irv view.IngestRule, meta UpdateMetadata) error {
+	r, err := rs.getIngestRuleByID(irv.ID)
+	if err != nil {
+		return merrors.NewInvalidInputError(fmt.Sprintf(ruleIDNotFoundErrorFmt, irv.ID))
+	}
+	if err := r.addSnapshot(irv, meta); err != nil {
+		return xerrors.Wrap(err, fmt.Sprintf(ruleActionErrorFmt, "update", irv.Name))
+	}
+	rs.updateMetadata(meta)
+	return nil
+}
+
+func (rs *ruleSet) DeleteIngestRule(id string, meta UpdateMetadata) error {
+	r, err := rs.getIngestRuleByID(id)
+	if err != nil {
+		return merrors.NewInvalidInputError(fmt.Sprintf(ruleIDNotFoundErrorFmt, id))
+	}
+
+	if err := r.markTombstoned(meta); err != nil {
+		return xerrors.Wrap(err, fmt.Sprintf(ruleActionErrorFmt, "delete", id))
+	}
+	rs.updateMetadata(meta)
+	return nil
+}
+
 func (rs *ruleSet) Delete(meta UpdateMetadata) error {
 	if rs.tombstoned {
 		return fmt.Errorf("%s is already tombstoned", string(rs.namespace))
@@ -490,6 +597,12 @@ func (rs *ruleSet) Delete(meta UpdateMetadata) error {
 		}
 	}
 
+	for _, r := range rs.ingestRules {
+		if t := r.tombstoned(); !t {
+			_ = r.markTombstoned(meta)
+		}
+	}
+
 	return nil
 }
 
@@ -497,7 +610,10 @@ func (rs *ruleSet) ApplyRuleSetChanges(rsc changes.RuleSetChanges, meta UpdateMe
 	if err := rs.applyMappingRuleChanges(rsc.MappingRuleChanges, meta); err != nil {
 		return err
 	}
-	return rs.applyRollupRuleChanges(rsc.RollupRuleChanges, meta)
+	if err := rs.applyRollupRuleChanges(rsc.RollupRuleChanges, meta); err != nil {
+		return err
+	}
+	return rs.applyIngestRuleChanges(rsc.IngestRuleChanges, meta)
 }
 
 func (rs *ruleSet) Revive(meta UpdateMetadata) error {
@@ -562,6 +678,29 @@ func (rs *ruleSet) getRollupRuleByID(id string) (*rollupRule, error) {
 	return nil, errRuleNotFound
 }
 
+func (rs *ruleSet) getIngestRuleByName(name string) (*ingestRule, error) {
+	for _, r := range rs.ingestRules {
+		n, err := r.name()
+		if err != nil {
+			return nil, err
+		}
+
+		if n == name {
+			return r, nil
+		}
+	}
+	return nil, errRuleNotFound
+}
+
+func (rs *ruleSet) getIngestRuleByID(id string) (*ingestRule, error) {
+	for _, r := range rs.ingestRules {
+		if r.uuid == id {
+			return r, nil
+		}
+	}
+	return nil, errRuleNotFound
+}
+
 func (rs *ruleSet) latestMappingRules() ([]view.MappingRule, error) {
 	mrs, err := rs.MappingRules()
 	if err != nil {
@@ -594,6 +733,22 @@ func (rs *ruleSet) latestRollupRules() ([]view.RollupRule, error) {
 	return filtered, nil
 }
 
+func (rs *ruleSet) latestIngestRules() ([]view.IngestRule, error) {
+	irs, err := rs.IngestRules()
+	if err != nil {
+		return nil, err
+	}
+	var filtered []view.IngestRule
+	for _, r := range irs {
+		if len(r) > 0 && !r[0].Tombstoned {
+			// Rule snapshots are sorted by cutover time in descending order.
+			filtered = append(filtered, r[0])
+		}
+	}
+	sort.Sort(view.IngestRulesByNameAsc(filtered))
+	return filtered, nil
+}
+
 func (rs *ruleSet) applyMappingRuleChanges(mrChanges []changes.MappingRuleChange, meta UpdateMetadata) error {
 	for _, mrChange := range mrChanges {
 		switch mrChange.Op {
@@ -640,6 +795,29 @@ func (rs *ruleSet) applyRollupRuleChanges(rrChanges []changes.RollupRuleChange,
 	return nil
 }
 
+func (rs *ruleSet) applyIngestRuleChanges(irChanges []changes.IngestRuleChange, meta UpdateMetadata) error {
+	for _, irChange := range irChanges {
+		switch irChange.Op {
+		case changes.AddOp:
+			if _, err := rs.AddIngestRule(*irChange.RuleData, meta); err != nil {
+				return err
+			}
+		case changes.ChangeOp:
+			if err := rs.UpdateIngestRule(*irChange.RuleData, meta); err != nil {
+				return err
+			}
+		case changes.DeleteOp:
+			if err := rs.DeleteIngestRule(*irChange.RuleID, meta); err != nil {
+				return err
+			}
+		default:
+			return merrors.NewInvalidInputError(fmt.Sprintf(unknownOpTypeFmt, irChange.Op))
+		}
+	}
+
+	return nil
+}
+
 // RuleSetUpdateHelper stores the necessary details to create an UpdateMetadata.
 type RuleSetUpdateHelper struct {
 	propagationDelay time.Duration
@@ -57,6 +57,8 @@ var (
 		cmp.AllowUnexported(mappingRuleSnapshot{}),
 		cmp.AllowUnexported(rollupRule{}),
 		cmp.AllowUnexported(rollupRuleSnapshot{}),
+		cmp.AllowUnexported(ingestRule{}),
+		cmp.AllowUnexported(ingestRuleSnapshot{}),
 		cmpopts.IgnoreTypes(
 			activeRuleSet{}.tagsFilterOpts,
 			activeRuleSet{}.newRollupIDFn,
@@ -71,6 +73,8 @@ var (
 		cmp.AllowUnexported(mappingRuleSnapshot{}),
 		cmp.AllowUnexported(rollupRule{}),
 		cmp.AllowUnexported(rollupRuleSnapshot{}),
+		cmp.AllowUnexported(ingestRule{}),
+		cmp.AllowUnexported(ingestRuleSnapshot{}),
 		cmpopts.IgnoreTypes(
 			ruleSet{}.tagsFilterOpts,
 			ruleSet{}.newRollupIDFn,
@@ -197,6 +201,7 @@ func TestRuleSetActiveSet(t *testing.T) {
 			version,
 			input.expectedMappingRules,
 			input.expectedRollupRules,
+			[]*ingestRule{},
 			rs.tagsFilterOpts,
 			rs.newRollupIDFn,
 			rs.isRollupIDFn,
@@ -1033,6 +1038,67 @@ func TestRuleSetDeleteRollupRule(t *testing.T) {
 	require.Contains(t, rrs, "rollupRule5")
 }
 
+func TestRuleSetIngestRules(t *testing.T) {
+	var (
+		version = 1
+		proto   = testRuleSetProto()
+		opts    = testRuleSetOptions()
+	)
+	res, err := NewRuleSetFromProto(version, proto, opts)
+	require.NoError(t, err)
+	rs := res.(*ruleSet)
+
+	helper := NewRuleSetUpdateHelper(10)
+	irv := view.IngestRule{
+		Name:     "foo",
+		Filter:   "tag1:value1",
+		DropTags: []string{"tag2"},
+	}
+	newID, err := rs.AddIngestRule(irv, helper.NewUpdateMetadata(1000, testUser))
+	require.NoError(t, err)
+
+	_, err = rs.AddIngestRule(irv, helper.NewUpdateMetadata(2000, testUser))
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "foo is not tombstoned"))
+
+	irv.ID = newID
+	irv.DropTags = nil
+	err = rs.UpdateIngestRule(irv, helper.NewUpdateMetadata(2000, testUser))
+	require.Error(t, err)
+	_, ok := xerrors.InnerError(err).(merrors.InvalidInputError)
+	require.True(t, ok)
+
+	irv.Drop = true
+	require.NoError(t, rs.UpdateIngestRule(irv, helper.NewUpdateMetadata(2000, testUser)))
+
+	irs, err := rs.IngestRules()
+	require.NoError(t, err)
+	require.Len(t, irs[newID], 2)
+	require.True(t, irs[newID][0].Drop)
+
+	latest, err := rs.Latest()
+	require.NoError(t, err)
+	require.Len(t, latest.IngestRules, 1)
+	require.Equal(t, newID, latest.IngestRules[0].ID)
+
+	// Ingest rules survive a roundtrip through the ruleset proto.
+	rsProto, err := rs.Proto()
+	require.NoError(t, err)
+	require.Len(t, rsProto.IngestRules, 1)
+	res, err = NewRuleSetFromProto(version, rsProto, opts)
+	require.NoError(t, err)
+	require.True(t, cmp.Equal(rs.ingestRules, res.(*ruleSet).ingestRules, testIngestRuleCmpOpts...))
+
+	require.NoError(t, rs.DeleteIngestRule(newID, helper.NewUpdateMetadata(3000, testUser)))
+	latest, err = rs.Latest()
+	require.NoError(t, err)
+	require.Nil(t, latest.IngestRules)
+
+	err = rs.DeleteIngestRule("nonexistent", helper.NewUpdateMetadata(4000, testUser))
+	require.Error(t, err)
+	require.IsType(t, merrors.NewInvalidInputError(""), err)
+}
+
 func TestRuleSetDelete(t *testing.T) {
 	var (
 		version = 1
@@ -1398,6 +1464,42 @@ func TestApplyRollupRuleWithInvalidOp(t *testing.T) {
 	require.IsType(t, merrors.NewInvalidInputError(""), err)
 }
 
+func TestApplyIngestRuleChanges(t *testing.T) {
+	var (
+		version = 1
+		proto   = testRuleSetProto()
+		opts    = testRuleSetOptions()
+	)
+	res, err := NewRuleSetFromProto(version, proto, opts)
+	require.NoError(t, err)
+	rs := res.(*ruleSet)
+
+	rsChanges := changes.RuleSetChanges{
+		IngestRuleChanges: []changes.IngestRuleChange{
+			{
+				Op: changes.AddOp,
+				RuleData: &view.IngestRule{
+					Name:   "dropRule",
+					Filter: "tag1:value1",
+					Drop:   true,
+				},
+			},
+		},
+	}
+
+	nowNanos := time.Now().UnixNano()
+	helper := NewRuleSetUpdateHelper(10)
+	require.NoError(t, rs.ApplyRuleSetChanges(rsChanges, helper.NewUpdateMetadata(nowNanos, testUser)))
+	r, err := rs.getIngestRuleByName("dropRule")
+	require.NoError(t, err)
+	require.Equal(t, nowNanos+10, r.snapshots[0].cutoverNanos)
+
+	rsChanges.IngestRuleChanges = []changes.IngestRuleChange{{}}
+	err = rs.ApplyRuleSetChanges(rsChanges, helper.NewUpdateMetadata(nowNanos, testUser))
+	require.Error(t, err)
+	require.IsType(t, merrors.NewInvalidInputError(""), err)
+}
+
 func testRuleSetProto() *rulepb.RuleSet {
 	return &rulepb.RuleSet{
 		Uuid:               "ruleset",
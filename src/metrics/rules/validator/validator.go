@@ -42,6 +42,7 @@ var (
 	errMoreThanOneAggregationOpInPipeline = errors.New("more than one aggregation operation in pipeline")
 	errAggregationOpNotFirstInPipeline    = errors.New("aggregation operation is not the first operation in pipeline")
 	errNoRollupOpInPipeline               = errors.New("no rollup operation in pipeline")
+	errSetRollupNotSupported              = errors.New("set metrics cannot be rolled up")
 )
 
 type validator struct {
@@ -178,6 +179,13 @@ func (v *validator) validateRollupRules(rrv []view.RollupRule) error {
 		if len(types) == 0 {
 			return fmt.Errorf("rollup rule '%s' does not match any allowed metric types, filter=%s", rule.Name, rule.Filter)
 		}
+		// Rolled up aggregations are forwarded as values, which for sets are
+		// distinct count estimates that cannot be merged across series.
+		for _, t := range types {
+			if t == metric.SetType {
+				return fmt.Errorf("rollup rule '%s' may match metric type %v: %v", rule.Name, t, errSetRollupNotSupported)
+			}
+		}
 
 		for _, target := range rule.Targets {
 			// Validate the pipeline is valid.
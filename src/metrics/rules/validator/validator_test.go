@@ -48,6 +48,7 @@ const (
 	testCounterType   = "counter"
 	testTimerType     = "timer"
 	testGaugeType     = "gauge"
+	testSetType       = "set"
 	testNamespacesKey = "testNamespaces"
 )
 
@@ -492,6 +493,36 @@ func TestValidatorValidateRollupRuleInvalidMetricType(t *testing.T) {
 	require.Error(t, validator.ValidateSnapshot(view))
 }
 
+func TestValidatorValidateRollupRuleSetMetricType(t *testing.T) {
+	view := view.RuleSet{
+		RollupRules: []view.RollupRule{
+			{
+				Name:   "snapshot1",
+				Filter: testTypeTag + ":" + testSetType,
+				Targets: []view.RollupTarget{
+					{
+						Pipeline: pipeline.NewPipeline([]pipeline.OpUnion{
+							{
+								Type: pipeline.RollupOpType,
+								Rollup: pipeline.RollupOp{
+									NewName:       []byte("rName1"),
+									Tags:          [][]byte{[]byte("rtagName1"), []byte("rtagName2")},
+									AggregationID: aggregation.DefaultID,
+								},
+							},
+						}),
+						StoragePolicies: testStoragePolicies(),
+					},
+				},
+			},
+		},
+	}
+	validator := NewValidator(testValidatorOptions())
+	err := validator.ValidateSnapshot(view)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), errSetRollupNotSupported.Error()))
+}
+
 func TestValidatorValidateRollupRulePipelineEmptyPipeline(t *testing.T) {
 	view := view.RuleSet{
 		RollupRules: []view.RollupRule{
@@ -1651,6 +1682,8 @@ func testMetricTypesFn() MetricTypesFn {
 			return []metric.Type{metric.TimerType}, nil
 		case testGaugeType:
 			return []metric.Type{metric.GaugeType}, nil
+		case testSetType:
+			return []metric.Type{metric.SetType}, nil
 		default:
 			return nil, fmt.Errorf("unknown metric type %v", fv.Pattern)
 		}
@@ -29,10 +29,12 @@ type RuleSetChanges struct {
 	Namespace          string              `json:"namespace"`
 	MappingRuleChanges []MappingRuleChange `json:"mappingRuleChanges"`
 	RollupRuleChanges  []RollupRuleChange  `json:"rollupRuleChanges"`
+	IngestRuleChanges  []IngestRuleChange  `json:"ingestRuleChanges,omitempty"`
 }
 
 // Sort sorts the ruleset diff by op and rule names.
 func (d *RuleSetChanges) Sort() {
 	sort.Sort(mappingRuleChangesByOpAscNameAscIDAsc(d.MappingRuleChanges))
 	sort.Sort(rollupRuleChangesByOpAscNameAscIDAsc(d.RollupRuleChanges))
+	sort.Sort(ingestRuleChangesByOpAscNameAscIDAsc(d.IngestRuleChanges))
 }
@@ -0,0 +1,101 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package view
+
+// IngestTag is a tag added to series matching an ingest rule.
+type IngestTag struct {
+	Name  string `json:"name" validate:"required"`
+	Value string `json:"value" validate:"required"`
+}
+
+// IngestTagRename renames a tag of series matching an ingest rule.
+type IngestTagRename struct {
+	From string `json:"from" validate:"required"`
+	To   string `json:"to" validate:"required"`
+}
+
+// IngestRule is an ingest rule model at a given point in time. Ingest rules
+// are applied to series as they are written, before they are stored
+// unaggregated or downsampled.
+type IngestRule struct {
+	ID                  string            `json:"id,omitempty"`
+	Name                string            `json:"name" validate:"required"`
+	Tombstoned          bool              `json:"tombstoned"`
+	CutoverMillis       int64             `json:"cutoverMillis,omitempty"`
+	Filter              string            `json:"filter" validate:"required"`
+	Drop                bool              `json:"drop"`
+	DropTags            []string          `json:"dropTags,omitempty"`
+	RenameTags          []IngestTagRename `json:"renameTags,omitempty" validate:"dive"`
+	AddTags             []IngestTag       `json:"addTags,omitempty" validate:"dive"`
+	LastUpdatedBy       string            `json:"lastUpdatedBy"`
+	LastUpdatedAtMillis int64             `json:"lastUpdatedAtMillis"`
+}
+
+// Equal determines whether two ingest rules are equal.
+func (r *IngestRule) Equal(other *IngestRule) bool {
+	if r == nil && other == nil {
+		return true
+	}
+	if r == nil || other == nil {
+		return false
+	}
+	if r.ID != other.ID ||
+		r.Name != other.Name ||
+		r.Filter != other.Filter ||
+		r.Drop != other.Drop ||
+		len(r.DropTags) != len(other.DropTags) ||
+		len(r.RenameTags) != len(other.RenameTags) ||
+		len(r.AddTags) != len(other.AddTags) {
+		return false
+	}
+	for i := range r.DropTags {
+		if r.DropTags[i] != other.DropTags[i] {
+			return false
+		}
+	}
+	for i := range r.RenameTags {
+		if r.RenameTags[i] != other.RenameTags[i] {
+			return false
+		}
+	}
+	for i := range r.AddTags {
+		if r.AddTags[i] != other.AddTags[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// IngestRules belonging to a ruleset indexed by uuid.
+// Each value contains the entire snapshot history of the rule.
+type IngestRules map[string][]IngestRule
+
+// IngestRulesByNameAsc sorts ingest rules by name in ascending order.
+type IngestRulesByNameAsc []IngestRule
+
+func (a IngestRulesByNameAsc) Len() int           { return len(a) }
+func (a IngestRulesByNameAsc) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a IngestRulesByNameAsc) Less(i, j int) bool { return a[i].Name < a[j].Name }
+
+// IngestRuleSnapshots contains a list of ingest rule snapshots.
+type IngestRuleSnapshots struct {
+	IngestRules []IngestRule `json:"ingestRules"`
+}
@@ -31,12 +31,14 @@ type RuleSet struct {
 	CutoverMillis int64         `json:"cutoverMillis"`
 	MappingRules  []MappingRule `json:"mappingRules"`
 	RollupRules   []RollupRule  `json:"rollupRules"`
+	IngestRules   []IngestRule  `json:"ingestRules,omitempty"`
 }
 
 // Sort sorts the rules in the ruleset.
 func (r *RuleSet) Sort() {
 	sort.Sort(MappingRulesByNameAsc(r.MappingRules))
 	sort.Sort(RollupRulesByNameAsc(r.RollupRules))
+	sort.Sort(IngestRulesByNameAsc(r.IngestRules))
 }
 
 // RuleSets is a collection of rulesets.
@@ -83,6 +83,7 @@ type buffer struct {
 	maxSpilloverSize uint64
 	maxMessageSize   int
 	onFinalizeFn     producer.OnFinalizeFn
+	overflow         producer.OverflowBuffer
 	retrier          retry.Retrier
 	m                bufferMetrics
 
@@ -110,6 +111,7 @@ func NewBuffer(opts Options) (producer.Buffer, error) {
 		maxSpilloverSize: uint64(allowedSpillover) + maxBufferSize,
 		maxMessageSize:   opts.MaxMessageSize(),
 		opts:             opts,
+		overflow:         opts.OverflowBuffer(),
 		retrier:          retry.NewRetrier(opts.CleanupRetryOptions()),
 		m: newBufferMetrics(
 			opts.InstrumentOptions().MetricsScope(),
@@ -285,7 +287,7 @@ func (b *buffer) cleanupBatchWithListLock(
 		}
 		// There is a chance that the message is consumed right before
 		// the drop call which will lead drop to return false.
-		if rm.Drop() {
+		if b.drop(rm) {
 			b.bufferList.Remove(e)
 			removed++
 			b.m.messageDropped.Inc(1)
@@ -295,6 +297,15 @@ func (b *buffer) cleanupBatchWithListLock(
 	return next, removed
 }
 
+// drop drops the message, spilling it to the overflow buffer first if one
+// is configured so that it can be replayed later.
+func (b *buffer) drop(rm *producer.RefCountedMessage) bool {
+	if b.overflow == nil {
+		return rm.Drop()
+	}
+	return rm.Spill(b.overflow.Spill)
+}
+
 func (b *buffer) dropOldestUntilClose() {
 	ticker := time.NewTicker(b.opts.DropOldestInterval())
 	defer ticker.Stop()
@@ -345,7 +356,7 @@ func (b *buffer) dropOldestBatchUntilTargetWithListLock(
 		}
 		// There is a chance that the message is consumed right before
 		// the drop call which will lead drop to return false.
-		if rm.Drop() {
+		if b.drop(rm) {
 			b.m.messageDropped.Inc(1)
 			b.m.byteDropped.Inc(int64(rm.Size()))
 		}
@@ -21,6 +21,7 @@
 package buffer
 
 import (
+	"os"
 	"sync"
 	"testing"
 	"time"
@@ -339,6 +340,68 @@ func TestBufferCloseDropEverything(t *testing.T) {
 	}
 }
 
+func TestBufferCloseDropEverythingSpillsToOverflow(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	ob := mustNewOverflowBuffer(t, testOverflowOptions(dir))
+	defer ob.Close()
+
+	mm := producer.NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(3).AnyTimes()
+	mm.EXPECT().Shard().Return(uint32(1))
+	mm.EXPECT().Bytes().Return([]byte("foo"))
+
+	b := mustNewBuffer(t, testOptions().SetOverflowBuffer(ob))
+	_, err := b.Add(mm)
+	require.NoError(t, err)
+
+	b.Init()
+	mm.EXPECT().Finalize(producer.Spilled)
+	b.Close(producer.DropEverything)
+	require.Equal(t, 0, int(b.size.Load()))
+	require.Equal(t, testRecordLen("foo"), ob.size)
+}
+
+func TestBufferDropOldestSpillsToOverflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	ob := mustNewOverflowBuffer(t, testOverflowOptions(dir))
+	defer ob.Close()
+
+	mm1 := producer.NewMockMessage(ctrl)
+	mm1.EXPECT().Size().Return(3).AnyTimes()
+	mm1.EXPECT().Shard().Return(uint32(1))
+	mm1.EXPECT().Bytes().Return([]byte("foo"))
+	mm2 := producer.NewMockMessage(ctrl)
+	mm2.EXPECT().Size().Return(3).AnyTimes()
+
+	b := mustNewBuffer(t, testOptions().
+		SetMaxBufferSize(3).
+		SetMaxMessageSize(3).
+		SetOverflowBuffer(ob),
+	)
+	rd1, err := b.Add(mm1)
+	require.NoError(t, err)
+
+	mm1.EXPECT().Finalize(producer.Spilled)
+	rd2, err := b.Add(mm2)
+	require.NoError(t, err)
+	require.True(t, rd1.IsDroppedOrConsumed())
+	require.False(t, rd2.IsDroppedOrConsumed())
+	require.Equal(t, 3, int(b.size.Load()))
+	require.Equal(t, testRecordLen("foo"), ob.size)
+}
+
 func TestBufferDropOldestAsyncOnFull(t *testing.T) {
 	defer leaktest.Check(t)()
 
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/m3db/m3/src/msg/producer"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/retry"
 )
@@ -54,6 +55,7 @@ type bufferOptions struct {
 	dropOldestInterval    time.Duration
 	scanBatchSize         int
 	allowedSpilloverRatio float64
+	overflow              producer.OverflowBuffer
 	rOpts                 retry.Options
 	iOpts                 instrument.Options
 }
@@ -146,6 +148,16 @@ func (opts *bufferOptions) SetAllowedSpilloverRatio(value float64) Options {
 	return &o
 }
 
+func (opts *bufferOptions) OverflowBuffer() producer.OverflowBuffer {
+	return opts.overflow
+}
+
+func (opts *bufferOptions) SetOverflowBuffer(value producer.OverflowBuffer) Options {
+	o := *opts
+	o.overflow = value
+	return &o
+}
+
 func (opts *bufferOptions) CleanupRetryOptions() retry.Options {
 	return opts.rOpts
 }
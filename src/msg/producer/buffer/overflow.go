@@ -0,0 +1,517 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/msg/producer"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/atomic"
+)
+
+const (
+	overflowSegmentPrefix = "overflow-"
+	overflowSegmentSuffix = ".log"
+
+	// Each record holds the shard and the length of the message, followed by
+	// the message bytes and the checksum of the message bytes.
+	overflowRecordHeaderLen   = 8
+	overflowRecordChecksumLen = 4
+
+	overflowDirectoryMode = 0755
+	overflowSegmentMode   = 0644
+)
+
+var (
+	errOverflowBufferFull    = errors.New("overflow buffer full")
+	errOverflowBufferClosed  = errors.New("overflow buffer closed")
+	errCorruptOverflowRecord = errors.New("corrupt overflow record")
+)
+
+type overflowMetrics struct {
+	messageSpilled  tally.Counter
+	byteSpilled     tally.Counter
+	messageReplayed tally.Counter
+	byteReplayed    tally.Counter
+	overflowFull    tally.Counter
+	spillError      tally.Counter
+	replayError     tally.Counter
+	corruptSegment  tally.Counter
+	bytePending     tally.Gauge
+	segmentPending  tally.Gauge
+}
+
+func newOverflowMetrics(scope tally.Scope) overflowMetrics {
+	return overflowMetrics{
+		messageSpilled:  scope.Counter("overflow-message-spilled"),
+		byteSpilled:     scope.Counter("overflow-byte-spilled"),
+		messageReplayed: scope.Counter("overflow-message-replayed"),
+		byteReplayed:    scope.Counter("overflow-byte-replayed"),
+		overflowFull:    scope.Counter("overflow-full"),
+		spillError:      scope.Counter("overflow-spill-error"),
+		replayError:     scope.Counter("overflow-replay-error"),
+		corruptSegment:  scope.Counter("overflow-corrupt-segment"),
+		bytePending:     scope.Gauge("overflow-byte-pending"),
+		segmentPending:  scope.Gauge("overflow-segment-pending"),
+	}
+}
+
+// overflowSegment is an append only segment file of spilled messages.
+type overflowSegment struct {
+	path string
+	// size is the number of bytes written to the segment.
+	size int
+	// offset is the number of bytes replayed from the segment.
+	offset int
+}
+
+// nolint: maligned
+type overflowBuffer struct {
+	sync.Mutex
+
+	opts            OverflowOptions
+	maxSegmentSize  int
+	maxOverflowSize int
+	m               overflowMetrics
+
+	// sealed segments are no longer written to and are replayed oldest first.
+	sealed        []*overflowSegment
+	active        *os.File
+	activeSegment *overflowSegment
+	nextIndex     int
+	size          int
+	scratch       []byte
+	isClosed      bool
+
+	spills   *atomic.Uint64
+	replayFn producer.ReplayFn
+	doneCh   chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewOverflowBuffer returns a new overflow buffer which persists messages to
+// append only segment files in the configured directory. Segments left over
+// by a previous overflow buffer in the same directory are replayed after Init.
+// Messages are replayed at least once, a segment which was partially replayed
+// before a restart is replayed again from its start.
+func NewOverflowBuffer(opts OverflowOptions) (producer.OverflowBuffer, error) {
+	if opts == nil {
+		opts = NewOverflowOptions()
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(opts.Directory(), overflowDirectoryMode); err != nil {
+		return nil, err
+	}
+	segments, nextIndex, err := readOverflowSegments(opts.Directory())
+	if err != nil {
+		return nil, err
+	}
+	var size int
+	for _, s := range segments {
+		size += s.size
+	}
+	return &overflowBuffer{
+		opts:            opts,
+		maxSegmentSize:  opts.MaxSegmentSize(),
+		maxOverflowSize: opts.MaxOverflowSize(),
+		m:               newOverflowMetrics(opts.InstrumentOptions().MetricsScope()),
+		sealed:          segments,
+		nextIndex:       nextIndex,
+		size:            size,
+		spills:          atomic.NewUint64(0),
+		doneCh:          make(chan struct{}),
+	}, nil
+}
+
+func (b *overflowBuffer) Spill(m producer.Message) error {
+	bytes := m.Bytes()
+	recordLen := overflowRecordHeaderLen + len(bytes) + overflowRecordChecksumLen
+
+	b.Lock()
+	defer b.Unlock()
+
+	if b.isClosed {
+		return errOverflowBufferClosed
+	}
+	if b.size+recordLen > b.maxOverflowSize {
+		b.m.overflowFull.Inc(1)
+		return errOverflowBufferFull
+	}
+	if b.active != nil && b.activeSegment.size+recordLen > b.maxSegmentSize {
+		if err := b.sealActiveWithLock(); err != nil {
+			b.m.spillError.Inc(1)
+			return err
+		}
+	}
+	if b.active == nil {
+		if err := b.openActiveWithLock(); err != nil {
+			b.m.spillError.Inc(1)
+			return err
+		}
+	}
+
+	b.scratch = appendOverflowRecord(b.scratch[:0], m.Shard(), bytes)
+	n, err := b.active.Write(b.scratch)
+	b.activeSegment.size += n
+	b.size += n
+	if err != nil {
+		b.m.spillError.Inc(1)
+		// NB: A partially written record will be detected as corrupt when
+		// replayed, seal the segment so that no records are written after it.
+		b.sealActiveWithLock()
+		return err
+	}
+	b.spills.Inc()
+	b.m.messageSpilled.Inc(1)
+	b.m.byteSpilled.Inc(int64(len(bytes)))
+	return nil
+}
+
+func (b *overflowBuffer) openActiveWithLock() error {
+	path := overflowSegmentPath(b.opts.Directory(), b.nextIndex)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, overflowSegmentMode)
+	if err != nil {
+		return err
+	}
+	b.nextIndex++
+	b.active = f
+	b.activeSegment = &overflowSegment{path: path}
+	return nil
+}
+
+func (b *overflowBuffer) sealActiveWithLock() error {
+	if b.active == nil {
+		return nil
+	}
+	err := b.active.Close()
+	b.sealed = append(b.sealed, b.activeSegment)
+	b.active = nil
+	b.activeSegment = nil
+	return err
+}
+
+func (b *overflowBuffer) Init(fn producer.ReplayFn) error {
+	b.Lock()
+	if b.isClosed {
+		b.Unlock()
+		return errOverflowBufferClosed
+	}
+	b.replayFn = fn
+	b.Unlock()
+
+	b.wg.Add(1)
+	go func() {
+		b.replayUntilClose()
+		b.wg.Done()
+	}()
+	return nil
+}
+
+func (b *overflowBuffer) replayUntilClose() {
+	ticker := time.NewTicker(b.opts.ReplayInterval())
+	defer ticker.Stop()
+
+	// Replay whatever was spilled before the last shutdown right away.
+	lastSpills := b.spills.Load()
+	b.replay(lastSpills)
+	b.updatePendingMetrics()
+	for {
+		select {
+		case <-ticker.C:
+			b.updatePendingMetrics()
+			// NB: Only replay when nothing was spilled during the last interval,
+			// otherwise the buffer is still dropping messages and the replayed
+			// messages would most likely be spilled again.
+			spills := b.spills.Load()
+			if spills != lastSpills {
+				lastSpills = spills
+				continue
+			}
+			b.replay(spills)
+		case <-b.doneCh:
+			return
+		}
+	}
+}
+
+// replay replays the segments oldest first until there is nothing left to
+// replay, a message fails to be replayed or a message gets spilled.
+func (b *overflowBuffer) replay(spills uint64) {
+	for {
+		segment, ok := b.nextReplaySegment()
+		if !ok {
+			return
+		}
+		if !b.replaySegment(segment, spills) {
+			return
+		}
+	}
+}
+
+func (b *overflowBuffer) nextReplaySegment() (*overflowSegment, bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	if len(b.sealed) == 0 && b.active != nil && b.activeSegment.size > 0 {
+		// Seal the active segment so it can be replayed, new messages will be
+		// spilled into a new segment.
+		if err := b.sealActiveWithLock(); err != nil {
+			b.m.spillError.Inc(1)
+		}
+	}
+	if len(b.sealed) == 0 {
+		return nil, false
+	}
+	return b.sealed[0], true
+}
+
+// replaySegment returns true if the segment was fully replayed.
+func (b *overflowBuffer) replaySegment(s *overflowSegment, spills uint64) bool {
+	f, err := os.Open(s.path)
+	if err != nil {
+		b.m.replayError.Inc(1)
+		if os.IsNotExist(err) {
+			b.removeSegment(s)
+			return true
+		}
+		return false
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(int64(s.offset), io.SeekStart); err != nil {
+		b.m.replayError.Inc(1)
+		return false
+	}
+
+	r := bufio.NewReader(f)
+	for {
+		select {
+		case <-b.doneCh:
+			return false
+		default:
+		}
+		if b.spills.Load() != spills {
+			return false
+		}
+
+		m, n, err := readOverflowRecord(r, b.maxOverflowSize)
+		if err == io.EOF {
+			b.removeSegment(s)
+			return true
+		}
+		if err != nil {
+			// NB: The rest of a segment can not be read past a corrupt record,
+			// which is most likely a partial write before a crash.
+			b.m.corruptSegment.Inc(1)
+			b.removeSegment(s)
+			return true
+		}
+		if err := b.replayFn(m); err != nil {
+			b.m.replayError.Inc(1)
+			return false
+		}
+
+		b.Lock()
+		s.offset += n
+		b.size -= n
+		b.Unlock()
+		b.m.messageReplayed.Inc(1)
+		b.m.byteReplayed.Inc(int64(m.Size()))
+	}
+}
+
+func (b *overflowBuffer) removeSegment(s *overflowSegment) {
+	if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		b.m.replayError.Inc(1)
+	}
+
+	b.Lock()
+	for i, sealed := range b.sealed {
+		if sealed == s {
+			b.sealed = append(b.sealed[:i], b.sealed[i+1:]...)
+			break
+		}
+	}
+	b.size -= s.size - s.offset
+	s.offset = s.size
+	b.Unlock()
+}
+
+func (b *overflowBuffer) updatePendingMetrics() {
+	b.Lock()
+	size := b.size
+	segments := len(b.sealed)
+	if b.active != nil {
+		segments++
+	}
+	b.Unlock()
+	b.m.bytePending.Update(float64(size))
+	b.m.segmentPending.Update(float64(segments))
+}
+
+func (b *overflowBuffer) Close() error {
+	b.Lock()
+	if b.isClosed {
+		b.Unlock()
+		return nil
+	}
+	b.isClosed = true
+	b.Unlock()
+
+	close(b.doneCh)
+	b.wg.Wait()
+
+	b.Lock()
+	defer b.Unlock()
+	if b.active == nil {
+		return nil
+	}
+	syncErr := b.active.Sync()
+	if err := b.sealActiveWithLock(); err != nil {
+		return err
+	}
+	return syncErr
+}
+
+func overflowSegmentPath(dir string, index int) string {
+	return filepath.Join(dir, fmt.Sprintf("%s%020d%s", overflowSegmentPrefix, index, overflowSegmentSuffix))
+}
+
+// readOverflowSegments returns the existing segments in the directory oldest
+// first and the index for the next segment.
+func readOverflowSegments(dir string) ([]*overflowSegment, int, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		segments  []*overflowSegment
+		nextIndex int
+	)
+	// NB: ReadDir returns the files sorted by name, which orders the segments
+	// by index since the indexes are zero padded.
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() ||
+			!strings.HasPrefix(name, overflowSegmentPrefix) ||
+			!strings.HasSuffix(name, overflowSegmentSuffix) {
+			continue
+		}
+		index, err := strconv.Atoi(strings.TrimSuffix(
+			strings.TrimPrefix(name, overflowSegmentPrefix), overflowSegmentSuffix))
+		if err != nil || index < 0 {
+			continue
+		}
+		segments = append(segments, &overflowSegment{
+			path: filepath.Join(dir, name),
+			size: int(f.Size()),
+		})
+		if index >= nextIndex {
+			nextIndex = index + 1
+		}
+	}
+	return segments, nextIndex, nil
+}
+
+func appendOverflowRecord(buf []byte, shard uint32, bytes []byte) []byte {
+	var header [overflowRecordHeaderLen]byte
+	binary.BigEndian.PutUint32(header[:4], shard)
+	binary.BigEndian.PutUint32(header[4:], uint32(len(bytes)))
+	buf = append(buf, header[:]...)
+	buf = append(buf, bytes...)
+
+	var checksum [overflowRecordChecksumLen]byte
+	binary.BigEndian.PutUint32(checksum[:], crc32.ChecksumIEEE(bytes))
+	return append(buf, checksum[:]...)
+}
+
+// readOverflowRecord returns io.EOF only if there are no more records, any
+// partially written record is returned as corrupt.
+func readOverflowRecord(
+	r io.Reader,
+	maxSize int,
+) (replayedMessage, int, error) {
+	var header [overflowRecordHeaderLen]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.EOF {
+			return replayedMessage{}, 0, io.EOF
+		}
+		return replayedMessage{}, 0, errCorruptOverflowRecord
+	}
+
+	var (
+		shard  = binary.BigEndian.Uint32(header[:4])
+		length = int(binary.BigEndian.Uint32(header[4:]))
+	)
+	if length > maxSize {
+		return replayedMessage{}, 0, errCorruptOverflowRecord
+	}
+
+	bytes := make([]byte, length+overflowRecordChecksumLen)
+	if _, err := io.ReadFull(r, bytes); err != nil {
+		return replayedMessage{}, 0, errCorruptOverflowRecord
+	}
+	checksum := binary.BigEndian.Uint32(bytes[length:])
+	bytes = bytes[:length]
+	if crc32.ChecksumIEEE(bytes) != checksum {
+		return replayedMessage{}, 0, errCorruptOverflowRecord
+	}
+
+	n := overflowRecordHeaderLen + length + overflowRecordChecksumLen
+	return replayedMessage{shard: shard, bytes: bytes}, n, nil
+}
+
+// replayedMessage is a message replayed from the overflow buffer.
+type replayedMessage struct {
+	shard uint32
+	bytes []byte
+}
+
+func (m replayedMessage) Shard() uint32 {
+	return m.shard
+}
+
+func (m replayedMessage) Bytes() []byte {
+	return m.bytes
+}
+
+func (m replayedMessage) Size() int {
+	return len(m.bytes)
+}
+
+func (m replayedMessage) Finalize(producer.FinalizeReason) {}
@@ -0,0 +1,126 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"errors"
+	"time"
+
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	defaultMaxSegmentSize  = 64 * 1024 * 1024   // 64MB.
+	defaultMaxOverflowSize = 1024 * 1024 * 1024 // 1GB.
+	defaultReplayInterval  = time.Second
+)
+
+var (
+	errEmptyOverflowDirectory    = errors.New("empty overflow directory")
+	errInvalidMaxSegmentSize     = errors.New("invalid max segment size")
+	errInvalidMaxOverflowSize    = errors.New("invalid max overflow size")
+	errNonPositiveReplayInterval = errors.New("non-positive replay interval")
+)
+
+type overflowOptions struct {
+	directory       string
+	maxSegmentSize  int
+	maxOverflowSize int
+	replayInterval  time.Duration
+	iOpts           instrument.Options
+}
+
+// NewOverflowOptions creates OverflowOptions.
+func NewOverflowOptions() OverflowOptions {
+	return &overflowOptions{
+		maxSegmentSize:  defaultMaxSegmentSize,
+		maxOverflowSize: defaultMaxOverflowSize,
+		replayInterval:  defaultReplayInterval,
+		iOpts:           instrument.NewOptions(),
+	}
+}
+
+func (opts *overflowOptions) Directory() string {
+	return opts.directory
+}
+
+func (opts *overflowOptions) SetDirectory(value string) OverflowOptions {
+	o := *opts
+	o.directory = value
+	return &o
+}
+
+func (opts *overflowOptions) MaxSegmentSize() int {
+	return opts.maxSegmentSize
+}
+
+func (opts *overflowOptions) SetMaxSegmentSize(value int) OverflowOptions {
+	o := *opts
+	o.maxSegmentSize = value
+	return &o
+}
+
+func (opts *overflowOptions) MaxOverflowSize() int {
+	return opts.maxOverflowSize
+}
+
+func (opts *overflowOptions) SetMaxOverflowSize(value int) OverflowOptions {
+	o := *opts
+	o.maxOverflowSize = value
+	return &o
+}
+
+func (opts *overflowOptions) ReplayInterval() time.Duration {
+	return opts.replayInterval
+}
+
+func (opts *overflowOptions) SetReplayInterval(value time.Duration) OverflowOptions {
+	o := *opts
+	o.replayInterval = value
+	return &o
+}
+
+func (opts *overflowOptions) InstrumentOptions() instrument.Options {
+	return opts.iOpts
+}
+
+func (opts *overflowOptions) SetInstrumentOptions(value instrument.Options) OverflowOptions {
+	o := *opts
+	o.iOpts = value
+	return &o
+}
+
+func (opts *overflowOptions) Validate() error {
+	if opts.Directory() == "" {
+		return errEmptyOverflowDirectory
+	}
+	if opts.MaxSegmentSize() <= 0 {
+		return errInvalidMaxSegmentSize
+	}
+	if opts.MaxOverflowSize() < opts.MaxSegmentSize() {
+		// Max overflow size must fit at least one full segment.
+		return errInvalidMaxOverflowSize
+	}
+	if opts.ReplayInterval() <= 0 {
+		return errNonPositiveReplayInterval
+	}
+	return nil
+}
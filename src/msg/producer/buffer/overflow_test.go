@@ -0,0 +1,297 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/msg/producer"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/fortytw2/leaktest"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestOverflowOptionsValidation(t *testing.T) {
+	opts := NewOverflowOptions()
+	require.Equal(t, errEmptyOverflowDirectory, opts.Validate())
+
+	opts = opts.SetDirectory("/tmp")
+	require.NoError(t, opts.Validate())
+
+	opts = opts.SetReplayInterval(0)
+	require.Equal(t, errNonPositiveReplayInterval, opts.Validate())
+
+	opts = opts.SetMaxOverflowSize(1).SetMaxSegmentSize(2)
+	require.Equal(t, errInvalidMaxOverflowSize, opts.Validate())
+
+	opts = opts.SetMaxSegmentSize(0)
+	require.Equal(t, errInvalidMaxSegmentSize, opts.Validate())
+}
+
+func TestOverflowBufferSpillAndReplay(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	scope := tally.NewTestScope("", nil)
+	opts := testOverflowOptions(dir).
+		SetMaxSegmentSize(2 * testRecordLen("foo")).
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope))
+	b := mustNewOverflowBuffer(t, opts)
+
+	msgs := []replayedMessage{
+		{shard: 1, bytes: []byte("foo")},
+		{shard: 2, bytes: []byte("bar")},
+		{shard: 3, bytes: []byte("baz")},
+	}
+	for _, m := range msgs {
+		require.NoError(t, b.Spill(m))
+	}
+	require.Equal(t, 3*testRecordLen("foo"), b.size)
+	require.Equal(t, 1, len(b.sealed))
+
+	r := newTestReplayer()
+	require.NoError(t, b.Init(r.replay))
+	r.waitForReplayed(t, len(msgs))
+	require.Equal(t, msgs, r.replayedMessages())
+	require.NoError(t, b.Close())
+
+	require.Equal(t, 0, b.size)
+	require.Equal(t, 0, len(b.sealed))
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(files))
+
+	counters := scope.Snapshot().Counters()
+	require.Equal(t, int64(3), counters["overflow-message-spilled+"].Value())
+	require.Equal(t, int64(9), counters["overflow-byte-spilled+"].Value())
+	require.Equal(t, int64(3), counters["overflow-message-replayed+"].Value())
+	require.Equal(t, int64(9), counters["overflow-byte-replayed+"].Value())
+}
+
+func TestOverflowBufferReplayAfterRestart(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	b := mustNewOverflowBuffer(t, testOverflowOptions(dir))
+	require.NoError(t, b.Spill(replayedMessage{shard: 1, bytes: []byte("foo")}))
+	require.NoError(t, b.Spill(replayedMessage{shard: 2, bytes: []byte("bar")}))
+	require.NoError(t, b.Close())
+	require.Equal(t, errOverflowBufferClosed, b.Spill(replayedMessage{bytes: []byte("baz")}))
+
+	b = mustNewOverflowBuffer(t, testOverflowOptions(dir))
+	require.Equal(t, 2*testRecordLen("foo"), b.size)
+	require.NoError(t, b.Spill(replayedMessage{shard: 3, bytes: []byte("baz")}))
+
+	r := newTestReplayer()
+	require.NoError(t, b.Init(r.replay))
+	r.waitForReplayed(t, 3)
+	require.NoError(t, b.Close())
+	require.Equal(t, []replayedMessage{
+		{shard: 1, bytes: []byte("foo")},
+		{shard: 2, bytes: []byte("bar")},
+		{shard: 3, bytes: []byte("baz")},
+	}, r.replayedMessages())
+}
+
+func TestOverflowBufferReplayError(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	b := mustNewOverflowBuffer(t, testOverflowOptions(dir))
+	require.NoError(t, b.Spill(replayedMessage{shard: 1, bytes: []byte("foo")}))
+	require.NoError(t, b.Spill(replayedMessage{shard: 2, bytes: []byte("bar")}))
+
+	r := newTestReplayer()
+	r.failures = 2
+	require.NoError(t, b.Init(r.replay))
+	r.waitForReplayed(t, 2)
+	require.NoError(t, b.Close())
+	require.Equal(t, []replayedMessage{
+		{shard: 1, bytes: []byte("foo")},
+		{shard: 2, bytes: []byte("bar")},
+	}, r.replayedMessages())
+}
+
+func TestOverflowBufferNoReplayWhileSpilling(t *testing.T) {
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	b := mustNewOverflowBuffer(t, testOverflowOptions(dir))
+	require.NoError(t, b.Spill(replayedMessage{shard: 1, bytes: []byte("foo")}))
+	require.NoError(t, b.Spill(replayedMessage{shard: 2, bytes: []byte("bar")}))
+
+	var replayed int
+	b.replayFn = func(m producer.Message) error {
+		replayed++
+		// Replaying the message caused another message to be spilled.
+		return b.Spill(replayedMessage{shard: 3, bytes: []byte("baz")})
+	}
+	b.replay(b.spills.Load())
+	require.Equal(t, 1, replayed)
+	require.Equal(t, 2*testRecordLen("foo"), b.size)
+	require.NoError(t, b.Close())
+}
+
+func TestOverflowBufferFull(t *testing.T) {
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	opts := testOverflowOptions(dir).
+		SetMaxSegmentSize(testRecordLen("foo")).
+		SetMaxOverflowSize(2 * testRecordLen("foo"))
+	b := mustNewOverflowBuffer(t, opts)
+	require.NoError(t, b.Spill(replayedMessage{shard: 1, bytes: []byte("foo")}))
+	require.NoError(t, b.Spill(replayedMessage{shard: 2, bytes: []byte("bar")}))
+	require.Equal(t, errOverflowBufferFull, b.Spill(replayedMessage{shard: 3, bytes: []byte("baz")}))
+	require.Equal(t, 1, len(b.sealed))
+	require.NoError(t, b.Close())
+}
+
+func TestOverflowBufferCorruptSegment(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	b := mustNewOverflowBuffer(t, testOverflowOptions(dir).SetMaxSegmentSize(testRecordLen("foo")))
+	require.NoError(t, b.Spill(replayedMessage{shard: 1, bytes: []byte("foo")}))
+	require.NoError(t, b.Spill(replayedMessage{shard: 2, bytes: []byte("bar")}))
+	require.NoError(t, b.Close())
+
+	// Truncate the first segment as if the process crashed mid write.
+	first := overflowSegmentPath(dir, 0)
+	require.NoError(t, os.Truncate(first, int64(testRecordLen("foo")-1)))
+
+	b = mustNewOverflowBuffer(t, testOverflowOptions(dir))
+	r := newTestReplayer()
+	require.NoError(t, b.Init(r.replay))
+	r.waitForReplayed(t, 1)
+	require.NoError(t, b.Close())
+	require.Equal(t, []replayedMessage{
+		{shard: 2, bytes: []byte("bar")},
+	}, r.replayedMessages())
+	_, err := os.Stat(first)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestOverflowRecordRoundTrip(t *testing.T) {
+	buf := appendOverflowRecord(nil, 7, []byte("foobar"))
+	require.Equal(t, testRecordLen("foobar"), len(buf))
+
+	f, err := ioutil.TempFile("", "overflow-record")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	_, err = f.Write(buf)
+	require.NoError(t, err)
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	m, n, err := readOverflowRecord(f, len(buf))
+	require.NoError(t, err)
+	require.Equal(t, len(buf), n)
+	require.Equal(t, replayedMessage{shard: 7, bytes: []byte("foobar")}, m)
+
+	// Flip a bit in the message bytes.
+	buf[overflowRecordHeaderLen] ^= 1
+	_, err = f.WriteAt(buf, 0)
+	require.NoError(t, err)
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	_, _, err = readOverflowRecord(f, len(buf))
+	require.Equal(t, errCorruptOverflowRecord, err)
+}
+
+type testReplayer struct {
+	sync.Mutex
+
+	failures int
+	replayed []replayedMessage
+}
+
+func newTestReplayer() *testReplayer {
+	return &testReplayer{}
+}
+
+func (r *testReplayer) replay(m producer.Message) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.failures > 0 {
+		r.failures--
+		return errors.New("replay failed")
+	}
+	r.replayed = append(r.replayed, m.(replayedMessage))
+	return nil
+}
+
+func (r *testReplayer) replayedMessages() []replayedMessage {
+	r.Lock()
+	defer r.Unlock()
+	return r.replayed
+}
+
+func (r *testReplayer) waitForReplayed(t *testing.T, n int) {
+	for i := 0; i < 100; i++ {
+		if len(r.replayedMessages()) >= n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.FailNow(t, "timed out waiting for replayed messages")
+}
+
+func testRecordLen(bytes string) int {
+	return overflowRecordHeaderLen + len(bytes) + overflowRecordChecksumLen
+}
+
+func mustTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "overflow")
+	require.NoError(t, err)
+	return dir
+}
+
+func mustNewOverflowBuffer(t *testing.T, opts OverflowOptions) *overflowBuffer {
+	b, err := NewOverflowBuffer(opts)
+	require.NoError(t, err)
+	return b.(*overflowBuffer)
+}
+
+func testOverflowOptions(dir string) OverflowOptions {
+	return NewOverflowOptions().
+		SetDirectory(dir).
+		SetReplayInterval(10 * time.Millisecond)
+}
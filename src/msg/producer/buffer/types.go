@@ -23,6 +23,7 @@ package buffer
 import (
 	"time"
 
+	"github.com/m3db/m3/src/msg/producer"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/retry"
 )
@@ -90,6 +91,14 @@ type Options interface {
 	// SetAllowedSpilloverRatio sets the ratio for allowed buffer spill over.
 	SetAllowedSpilloverRatio(value float64) Options
 
+	// OverflowBuffer returns the overflow buffer that unacked messages are
+	// spilled to when they are dropped, nil if they are discarded.
+	OverflowBuffer() producer.OverflowBuffer
+
+	// SetOverflowBuffer sets the overflow buffer that unacked messages are
+	// spilled to when they are dropped, nil if they are discarded.
+	SetOverflowBuffer(value producer.OverflowBuffer) Options
+
 	// CleanupRetryOptions returns the cleanup retry options.
 	CleanupRetryOptions() retry.Options
 
@@ -105,3 +114,40 @@ type Options interface {
 	// Validate validates the options.
 	Validate() error
 }
+
+// OverflowOptions configs the overflow buffer.
+type OverflowOptions interface {
+	// Directory returns the directory to persist overflowed messages in.
+	Directory() string
+
+	// SetDirectory sets the directory to persist overflowed messages in.
+	SetDirectory(value string) OverflowOptions
+
+	// MaxSegmentSize returns the max size of each segment file.
+	MaxSegmentSize() int
+
+	// SetMaxSegmentSize sets the max size of each segment file.
+	SetMaxSegmentSize(value int) OverflowOptions
+
+	// MaxOverflowSize returns the max size of all the segment files, messages
+	// spilled beyond the max size will be dropped.
+	MaxOverflowSize() int
+
+	// SetMaxOverflowSize sets the max size of all the segment files.
+	SetMaxOverflowSize(value int) OverflowOptions
+
+	// ReplayInterval returns the interval to replay overflowed messages.
+	ReplayInterval() time.Duration
+
+	// SetReplayInterval sets the interval to replay overflowed messages.
+	SetReplayInterval(value time.Duration) OverflowOptions
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) OverflowOptions
+
+	// Validate validates the options.
+	Validate() error
+}
@@ -69,3 +69,26 @@ func (c *BufferConfiguration) NewOptions(iOpts instrument.Options) buffer.Option
 	}
 	return opts.SetInstrumentOptions(iOpts)
 }
+
+// OverflowConfiguration configs the overflow buffer.
+type OverflowConfiguration struct {
+	Directory       string         `yaml:"directory" validate:"nonzero"`
+	MaxSegmentSize  *int           `yaml:"maxSegmentSize"`
+	MaxOverflowSize *int           `yaml:"maxOverflowSize"`
+	ReplayInterval  *time.Duration `yaml:"replayInterval"`
+}
+
+// NewOptions creates new overflow buffer options.
+func (c *OverflowConfiguration) NewOptions(iOpts instrument.Options) buffer.OverflowOptions {
+	opts := buffer.NewOverflowOptions().SetDirectory(c.Directory)
+	if c.MaxSegmentSize != nil {
+		opts = opts.SetMaxSegmentSize(*c.MaxSegmentSize)
+	}
+	if c.MaxOverflowSize != nil {
+		opts = opts.SetMaxOverflowSize(*c.MaxOverflowSize)
+	}
+	if c.ReplayInterval != nil {
+		opts = opts.SetReplayInterval(*c.ReplayInterval)
+	}
+	return opts.SetInstrumentOptions(iOpts)
+}
@@ -70,3 +70,22 @@ func TestEmptyBufferConfiguration(t *testing.T) {
 		cfg.NewOptions(iopts).SetCleanupRetryOptions(rOpts),
 	)
 }
+
+func TestOverflowConfiguration(t *testing.T) {
+	str := `
+directory: /var/lib/m3/overflow
+maxSegmentSize: 1024
+maxOverflowSize: 4096
+replayInterval: 5s
+`
+
+	var cfg OverflowConfiguration
+	require.NoError(t, yaml.Unmarshal([]byte(str), &cfg))
+
+	oOpts := cfg.NewOptions(instrument.NewOptions())
+	require.Equal(t, "/var/lib/m3/overflow", oOpts.Directory())
+	require.Equal(t, 1024, oOpts.MaxSegmentSize())
+	require.Equal(t, 4096, oOpts.MaxOverflowSize())
+	require.Equal(t, 5*time.Second, oOpts.ReplayInterval())
+	require.NoError(t, oOpts.Validate())
+}
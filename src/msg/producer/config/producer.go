@@ -30,8 +30,9 @@ import (
 
 // ProducerConfiguration configs the producer.
 type ProducerConfiguration struct {
-	Buffer BufferConfiguration `yaml:"buffer"`
-	Writer WriterConfiguration `yaml:"writer"`
+	Buffer   BufferConfiguration    `yaml:"buffer"`
+	Overflow *OverflowConfiguration `yaml:"overflow"`
+	Writer   WriterConfiguration    `yaml:"writer"`
 }
 
 func (c *ProducerConfiguration) newOptions(
@@ -42,13 +43,22 @@ func (c *ProducerConfiguration) newOptions(
 	if err != nil {
 		return nil, err
 	}
-	b, err := buffer.NewBuffer(c.Buffer.NewOptions(iOpts))
+	bOpts := c.Buffer.NewOptions(iOpts)
+	opts := producer.NewOptions().
+		SetWriter(writer.NewWriter(wOpts))
+	if c.Overflow != nil {
+		ob, err := buffer.NewOverflowBuffer(c.Overflow.NewOptions(iOpts))
+		if err != nil {
+			return nil, err
+		}
+		bOpts = bOpts.SetOverflowBuffer(ob)
+		opts = opts.SetOverflowBuffer(ob)
+	}
+	b, err := buffer.NewBuffer(bOpts)
 	if err != nil {
 		return nil, err
 	}
-	return producer.NewOptions().
-		SetBuffer(b).
-		SetWriter(writer.NewWriter(wOpts)), nil
+	return opts.SetBuffer(b), nil
 }
 
 // NewProducer creates new producer.
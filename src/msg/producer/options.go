@@ -21,8 +21,9 @@
 package producer
 
 type producerOptions struct {
-	buffer Buffer
-	writer Writer
+	buffer   Buffer
+	writer   Writer
+	overflow OverflowBuffer
 }
 
 // NewOptions creates a Options.
@@ -49,3 +50,13 @@ func (opts *producerOptions) SetWriter(value Writer) Options {
 	o.writer = value
 	return &o
 }
+
+func (opts *producerOptions) OverflowBuffer() OverflowBuffer {
+	return opts.overflow
+}
+
+func (opts *producerOptions) SetOverflowBuffer(value OverflowBuffer) Options {
+	o := *opts
+	o.overflow = value
+	return &o
+}
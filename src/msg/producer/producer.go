@@ -23,19 +23,30 @@ package producer
 type producer struct {
 	Buffer
 	Writer
+
+	overflow OverflowBuffer
 }
 
 // NewProducer returns a new producer.
 func NewProducer(opts Options) Producer {
 	return &producer{
-		Buffer: opts.Buffer(),
-		Writer: opts.Writer(),
+		Buffer:   opts.Buffer(),
+		Writer:   opts.Writer(),
+		overflow: opts.OverflowBuffer(),
 	}
 }
 
 func (p *producer) Init() error {
 	p.Buffer.Init()
-	return p.Writer.Init()
+	if err := p.Writer.Init(); err != nil {
+		return err
+	}
+	if p.overflow == nil {
+		return nil
+	}
+	// NB: Messages replayed from the overflow buffer are produced just like
+	// new messages, so they will be spilled again if they are dropped again.
+	return p.overflow.Init(p.Produce)
 }
 
 func (p *producer) Produce(m Message) error {
@@ -54,4 +65,9 @@ func (p *producer) Close(ct CloseType) {
 	p.Buffer.Close(ct)
 	// Then we can close writer to clean up outstanding go routines.
 	p.Writer.Close()
+	// The overflow buffer is closed last since messages still unacked when
+	// the buffer is closed are spilled to it.
+	if p.overflow != nil {
+		p.overflow.Close()
+	}
 }
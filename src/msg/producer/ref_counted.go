@@ -99,19 +99,44 @@ func (rm *RefCountedMessage) IsDroppedOrConsumed() bool {
 	return rm.isDroppedOrConsumed.Load()
 }
 
+// Spill drops the message without waiting for it to be consumed, the message
+// is first persisted with the given function so that it can be produced again
+// later and is finalized as spilled if that succeeds.
+func (rm *RefCountedMessage) Spill(fn func(m Message) error) bool {
+	if !rm.markDroppedOrConsumed() {
+		return false
+	}
+	r := Dropped
+	if fn(rm.Message) == nil {
+		r = Spilled
+	}
+	rm.finalizeWithReason(r)
+	return true
+}
+
 func (rm *RefCountedMessage) finalize(r FinalizeReason) bool {
+	if !rm.markDroppedOrConsumed() {
+		return false
+	}
+	rm.finalizeWithReason(r)
+	return true
+}
+
+func (rm *RefCountedMessage) markDroppedOrConsumed() bool {
 	// NB: This lock prevents the message from being finalized when its still
 	// being read.
 	rm.Lock()
+	defer rm.Unlock()
 	if rm.isDroppedOrConsumed.Load() {
-		rm.Unlock()
 		return false
 	}
 	rm.isDroppedOrConsumed.Store(true)
-	rm.Unlock()
+	return true
+}
+
+func (rm *RefCountedMessage) finalizeWithReason(r FinalizeReason) {
 	if rm.onFinalizeFn != nil {
 		rm.onFinalizeFn(rm)
 	}
 	rm.Message.Finalize(r)
-	return true
 }
@@ -21,6 +21,7 @@
 package producer
 
 import (
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -76,6 +77,52 @@ func TestRefCountedMessageDrop(t *testing.T) {
 	require.True(t, rm.IsDroppedOrConsumed())
 }
 
+func TestRefCountedMessageSpill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mm := NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(100).AnyTimes()
+
+	var spilled []Message
+	spillFn := func(m Message) error {
+		spilled = append(spilled, m)
+		return nil
+	}
+
+	rm := NewRefCountedMessage(mm, nil)
+	mm.EXPECT().Finalize(Spilled)
+	require.True(t, rm.Spill(spillFn))
+	require.True(t, rm.IsDroppedOrConsumed())
+	require.Equal(t, []Message{mm}, spilled)
+
+	// Messages already dropped or consumed are not spilled.
+	require.False(t, rm.Spill(spillFn))
+	require.Equal(t, 1, len(spilled))
+
+	rm = NewRefCountedMessage(mm, nil)
+	mm.EXPECT().Finalize(Consumed)
+	rm.IncRef()
+	rm.DecRef()
+	require.False(t, rm.Spill(spillFn))
+	require.Equal(t, 1, len(spilled))
+}
+
+func TestRefCountedMessageSpillError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mm := NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(100).AnyTimes()
+
+	rm := NewRefCountedMessage(mm, nil)
+	mm.EXPECT().Finalize(Dropped)
+	require.True(t, rm.Spill(func(Message) error {
+		return errors.New("spill failed")
+	}))
+	require.True(t, rm.IsDroppedOrConsumed())
+}
+
 func TestRefCountedMessageBytesReadBlocking(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -33,6 +33,10 @@ const (
 
 	// Dropped means the message has been dropped.
 	Dropped
+
+	// Spilled means the message has been persisted to the overflow buffer
+	// and will be produced again later.
+	Spilled
 )
 
 // Message contains the data that will be produced by the producer.
@@ -101,6 +105,13 @@ type Options interface {
 
 	// SetWriter sets the writer.
 	SetWriter(value Writer) Options
+
+	// OverflowBuffer returns the overflow buffer, messages spilled to it
+	// by the buffer are replayed when the producer is initialized.
+	OverflowBuffer() OverflowBuffer
+
+	// SetOverflowBuffer sets the overflow buffer.
+	SetOverflowBuffer(value OverflowBuffer) Options
 }
 
 // Buffer buffers all the messages in the producer.
@@ -117,6 +128,25 @@ type Buffer interface {
 	Close(ct CloseType)
 }
 
+// ReplayFn produces a message replayed from the overflow buffer.
+type ReplayFn func(m Message) error
+
+// OverflowBuffer persists the messages dropped from the buffer so that they
+// can be replayed once the buffer has room for them again.
+type OverflowBuffer interface {
+	// Spill persists the message, the message is still owned by the caller
+	// once the call returns.
+	Spill(m Message) error
+
+	// Init initializes the overflow buffer, persisted messages will be
+	// replayed with the given function in the background until closed.
+	Init(fn ReplayFn) error
+
+	// Close stops replaying messages and closes the overflow buffer, the
+	// messages not yet replayed will be replayed after the next Init.
+	Close() error
+}
+
 // Writer writes all the messages out to the consumer services.
 type Writer interface {
 	// Write writes a reference counted message out.
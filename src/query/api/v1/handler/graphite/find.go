@@ -96,7 +96,8 @@ func (h *grahiteFindHandler) ServeHTTP(
 	w http.ResponseWriter,
 	r *http.Request,
 ) {
-	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	ctx, warnings := storage.NewContextWithWarnings(r.Context())
+	ctx = context.WithValue(ctx, handler.HeaderKey, r.Header)
 	logger := logging.WithContext(ctx)
 	w.Header().Set("Content-Type", "application/json")
 
@@ -152,6 +153,7 @@ func (h *grahiteFindHandler) ServeHTTP(
 	}
 
 	// TODO: Support multiple result types
+	handler.AddWarningsHeader(w, warnings.All())
 	if err = findResultsJSON(w, prefix, seenMap); err != nil {
 		logger.Error("unable to print find results", zap.Error(err))
 		xhttp.Error(w, err, http.StatusBadRequest)
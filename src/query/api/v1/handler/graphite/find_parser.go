@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/m3db/m3/src/query/errors"
@@ -51,6 +52,11 @@ import (
 // As an example, given the query `a.b*`, and metrics `a.bar.c` and `a.biz`,
 // terminatedQuery will return only [biz], and childQuery will return only
 // [bar].
+//
+// The query may also filter on the tags of graphite tagged series in the
+// graphite tagged format, e.g. `a.b*;dc=dc1;server=~web.*`, in which case only
+// the nodes of series matching the tag expressions are returned and the raw
+// query string is the path of the query.
 func parseFindParamsToQueries(r *http.Request) (
 	_terminatedQuery *storage.CompleteTagsQuery,
 	_childQuery *storage.CompleteTagsQuery,
@@ -98,6 +104,19 @@ func parseFindParamsToQueries(r *http.Request) (
 				http.StatusBadRequest)
 	}
 
+	var tagMatchers models.Matchers
+	if idx := strings.Index(query, graphite.TaggedSeparator); idx >= 0 {
+		exprs := strings.Split(query[idx+1:], graphite.TaggedSeparator)
+		tagMatchers, err = graphiteStorage.TranslateTagExpressionsToMatchers(exprs)
+		if err != nil {
+			return nil, nil, "",
+				xhttp.NewParseError(fmt.Errorf("invalid 'query': %s: %v", query, err),
+					http.StatusBadRequest)
+		}
+
+		query = query[:idx]
+	}
+
 	matchers, err := graphiteStorage.TranslateQueryToMatchersWithTerminator(query)
 	if err != nil {
 		return nil, nil, "",
@@ -118,16 +137,17 @@ func parseFindParamsToQueries(r *http.Request) (
 	terminatedQuery := &storage.CompleteTagsQuery{
 		CompleteNameOnly: false,
 		FilterNameTags:   filter,
-		TagMatchers:      matchers,
+		TagMatchers:      append(matchers, tagMatchers...),
 		Start:            from,
 		End:              until,
 	}
 
-	clonedMatchers := make([]models.Matcher, len(matchers))
+	clonedMatchers := make([]models.Matcher, len(matchers), len(matchers)+len(tagMatchers))
 	copy(clonedMatchers, matchers)
 	// NB: change terminator from `MatchNotRegexp` to `MatchRegexp` to ensure
 	// segments with children are matched.
 	clonedMatchers[len(clonedMatchers)-1].Type = models.MatchRegexp
+	clonedMatchers = append(clonedMatchers, tagMatchers...)
 	childQuery := &storage.CompleteTagsQuery{
 		CompleteNameOnly: false,
 		FilterNameTags:   filter,
@@ -119,15 +119,18 @@ func bs(ss ...string) [][]byte {
 	return bb
 }
 
-func setupStorage(ctrl *gomock.Controller) storage.Storage {
+func setupStorage(
+	ctrl *gomock.Controller,
+	tagMatchers ...models.Matcher,
+) storage.Storage {
 	store := storage.NewMockStorage(ctrl)
 	// set up no children case
 	noChildrenMatcher := &completeTagQueryMatcher{
-		matchers: []models.Matcher{
+		matchers: append([]models.Matcher{
 			{Type: models.MatchEqual, Name: b("__g0__"), Value: b("foo")},
 			{Type: models.MatchRegexp, Name: b("__g1__"), Value: b(`b[^\.]*`)},
 			{Type: models.MatchNotRegexp, Name: b("__g2__"), Value: b(".*")},
-		},
+		}, tagMatchers...),
 	}
 
 	noChildrenResult := &storage.CompleteTagsResult{
@@ -142,11 +145,11 @@ func setupStorage(ctrl *gomock.Controller) storage.Storage {
 
 	// set up children case
 	childrenMatcher := &completeTagQueryMatcher{
-		matchers: []models.Matcher{
+		matchers: append([]models.Matcher{
 			{Type: models.MatchEqual, Name: b("__g0__"), Value: b("foo")},
 			{Type: models.MatchRegexp, Name: b("__g1__"), Value: b(`b[^\.]*`)},
 			{Type: models.MatchRegexp, Name: b("__g2__"), Value: b(".*")},
-		},
+		}, tagMatchers...),
 	}
 
 	childrenResult := &storage.CompleteTagsResult{
@@ -196,20 +199,43 @@ func (r results) Less(i, j int) bool {
 }
 
 func TestFind(t *testing.T) {
+	testFind(t, "foo.b*")
+}
+
+func TestFindTagged(t *testing.T) {
+	testFind(t, "foo.b*;dc=dc1;server=~web.*",
+		models.Matcher{Type: models.MatchEqual, Name: b("dc"), Value: b("dc1")},
+		models.Matcher{Type: models.MatchRegexp, Name: b("server"), Value: b("web.*")})
+}
+
+func TestFindTaggedInvalid(t *testing.T) {
+	for _, query := range []string{"foo.b*;dc", "foo.b*;name=foo.bar"} {
+		req := &http.Request{
+			URL: &url.URL{RawQuery: "query=" + url.QueryEscape(query)},
+		}
+
+		_, _, _, err := parseFindParamsToQueries(req)
+		require.NotNil(t, err, query)
+		require.Equal(t, http.StatusBadRequest, err.Code())
+	}
+}
+
+func testFind(t *testing.T, query string, tagMatchers ...models.Matcher) {
 	logging.InitWithCores(nil)
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	// setup storage and handler
-	store := setupStorage(ctrl)
+	store := setupStorage(ctrl, tagMatchers...)
 	handler := NewFindHandler(store)
 
 	// execute the query
 	w := &writer{}
 	req := &http.Request{
 		URL: &url.URL{
-			RawQuery: fmt.Sprintf("query=foo.b*&from=%s&until=%s", from.s, until.s),
+			RawQuery: fmt.Sprintf("query=%s&from=%s&until=%s",
+				url.QueryEscape(query), from.s, until.s),
 		},
 	}
 
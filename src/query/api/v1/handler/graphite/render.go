@@ -90,7 +90,8 @@ func (h *renderHandler) serveHTTP(
 	w http.ResponseWriter,
 	r *http.Request,
 ) respError {
-	reqCtx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	reqCtx, warnings := storage.NewContextWithWarnings(r.Context())
+	reqCtx = context.WithValue(reqCtx, handler.HeaderKey, r.Header)
 	p, err := ParseRenderRequest(r)
 	if err != nil {
 		return respError{err: err, code: http.StatusBadRequest}
@@ -194,6 +195,7 @@ func (h *renderHandler) serveHTTP(
 		SortApplied: true,
 	}
 
+	handler.AddWarningsHeader(w, warnings.All())
 	err = WriteRenderResponse(w, response, p.Format)
 	return respError{err: err, code: http.StatusOK}
 }
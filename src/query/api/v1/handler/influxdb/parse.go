@@ -0,0 +1,270 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	errMissingFields   = errors.New("missing fields")
+	errMissingTagValue = errors.New("missing tag value")
+	errMissingMeasure  = errors.New("missing measurement")
+	errTimestampRange  = errors.New("timestamp out of range")
+)
+
+type tag struct {
+	key   []byte
+	value []byte
+}
+
+type field struct {
+	key   []byte
+	value float64
+}
+
+// point is a single line of line protocol, only fields with numeric or
+// boolean values are kept since string fields cannot be stored as datapoints.
+type point struct {
+	measurement []byte
+	tags        []tag
+	fields      []field
+	timestamp   time.Time
+}
+
+// parsePrecision parses the precision query parameter of an InfluxDB write,
+// defaulting to nanoseconds.
+func parsePrecision(precision string) (xtime.Unit, error) {
+	switch precision {
+	case "", "n", "ns":
+		return xtime.Nanosecond, nil
+	case "u", "us", "µ":
+		return xtime.Microsecond, nil
+	case "ms":
+		return xtime.Millisecond, nil
+	case "s":
+		return xtime.Second, nil
+	case "m":
+		return xtime.Minute, nil
+	case "h":
+		return xtime.Hour, nil
+	default:
+		return xtime.None, fmt.Errorf("invalid precision: %s", precision)
+	}
+}
+
+// parsePoints parses a body of line protocol, lines without a timestamp are
+// assigned the default timestamp.
+func parsePoints(
+	body []byte,
+	precision xtime.Unit,
+	defaultTimestamp time.Time,
+) ([]point, error) {
+	unitDuration, err := precision.Value()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		points  []point
+		lineNum int
+	)
+	for len(body) > 0 {
+		var line []byte
+		if idx := bytes.IndexByte(body, '\n'); idx >= 0 {
+			line, body = body[:idx], body[idx+1:]
+		} else {
+			line, body = body, nil
+		}
+		lineNum++
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		p, err := parseLine(line, unitDuration, defaultTimestamp)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse line %d: %v", lineNum, err)
+		}
+		points = append(points, p)
+	}
+
+	return points, nil
+}
+
+func parseLine(
+	line []byte,
+	unitDuration time.Duration,
+	defaultTimestamp time.Time,
+) (point, error) {
+	var p point
+
+	keyEnd := indexUnescaped(line, ' ', false)
+	if keyEnd < 0 {
+		return p, errMissingFields
+	}
+	key, rest := line[:keyEnd], bytes.TrimLeft(line[keyEnd:], " ")
+
+	keyParts := splitUnescaped(key, ',', false)
+	if len(keyParts[0]) == 0 {
+		return p, errMissingMeasure
+	}
+	p.measurement = unescape(keyParts[0])
+	for _, part := range keyParts[1:] {
+		eq := indexUnescaped(part, '=', false)
+		if eq <= 0 || eq == len(part)-1 {
+			return p, errMissingTagValue
+		}
+		p.tags = append(p.tags, tag{
+			key:   unescape(part[:eq]),
+			value: unescape(part[eq+1:]),
+		})
+	}
+
+	fieldsEnd := indexUnescaped(rest, ' ', true)
+	if fieldsEnd < 0 {
+		fieldsEnd = len(rest)
+	}
+	fields, rest := rest[:fieldsEnd], bytes.TrimSpace(rest[fieldsEnd:])
+	if len(fields) == 0 {
+		return p, errMissingFields
+	}
+
+	for _, part := range splitUnescaped(fields, ',', true) {
+		eq := indexUnescaped(part, '=', false)
+		if eq <= 0 || eq == len(part)-1 {
+			return p, fmt.Errorf("invalid field: %s", part)
+		}
+		value, ok, err := parseFieldValue(part[eq+1:])
+		if err != nil {
+			return p, fmt.Errorf("invalid field %s: %v", part[:eq], err)
+		}
+		if !ok {
+			continue
+		}
+		p.fields = append(p.fields, field{
+			key:   unescape(part[:eq]),
+			value: value,
+		})
+	}
+
+	p.timestamp = defaultTimestamp
+	if len(rest) > 0 {
+		ts, err := strconv.ParseInt(string(rest), 10, 64)
+		if err != nil {
+			return p, fmt.Errorf("invalid timestamp: %v", err)
+		}
+		unitNanos := int64(unitDuration)
+		if ts > math.MaxInt64/unitNanos || ts < math.MinInt64/unitNanos {
+			return p, errTimestampRange
+		}
+		p.timestamp = time.Unix(0, ts*unitNanos)
+	}
+
+	return p, nil
+}
+
+// parseFieldValue parses a field value, returning false for string values
+// which have no numeric representation.
+func parseFieldValue(value []byte) (float64, bool, error) {
+	if value[0] == '"' {
+		if len(value) < 2 || value[len(value)-1] != '"' {
+			return 0, false, errors.New("unterminated string")
+		}
+		return 0, false, nil
+	}
+
+	switch string(value) {
+	case "t", "T", "true", "True", "TRUE":
+		return 1, true, nil
+	case "f", "F", "false", "False", "FALSE":
+		return 0, true, nil
+	}
+
+	switch value[len(value)-1] {
+	case 'i':
+		v, err := strconv.ParseInt(string(value[:len(value)-1]), 10, 64)
+		return float64(v), err == nil, err
+	case 'u':
+		v, err := strconv.ParseUint(string(value[:len(value)-1]), 10, 64)
+		return float64(v), err == nil, err
+	}
+
+	v, err := strconv.ParseFloat(string(value), 64)
+	return v, err == nil, err
+}
+
+// indexUnescaped returns the index of the first occurrence of sep that is not
+// escaped with a backslash and, if quoteAware, not within a quoted string.
+func indexUnescaped(b []byte, sep byte, quoteAware bool) int {
+	inQuotes := false
+	for i := 0; i < len(b); i++ {
+		switch {
+		case b[i] == '\\':
+			i++
+		case quoteAware && b[i] == '"':
+			inQuotes = !inQuotes
+		case b[i] == sep && !inQuotes:
+			return i
+		}
+	}
+	return -1
+}
+
+func splitUnescaped(b []byte, sep byte, quoteAware bool) [][]byte {
+	var parts [][]byte
+	for {
+		idx := indexUnescaped(b, sep, quoteAware)
+		if idx < 0 {
+			return append(parts, b)
+		}
+		parts = append(parts, b[:idx])
+		b = b[idx+1:]
+	}
+}
+
+// unescape removes the escaping of commas, spaces and equals signs that is
+// valid in measurements, tag keys, tag values and field keys.
+func unescape(b []byte) []byte {
+	if bytes.IndexByte(b, '\\') < 0 {
+		return b
+	}
+
+	result := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		if b[i] == '\\' && i+1 < len(b) {
+			switch b[i+1] {
+			case ',', ' ', '=':
+				i++
+			}
+		}
+		result = append(result, b[i])
+	}
+	return result
+}
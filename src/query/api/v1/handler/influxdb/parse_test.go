@@ -0,0 +1,137 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"testing"
+	"time"
+
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePoints(t *testing.T) {
+	body := `
+# comment
+cpu,host=serverA,region=us\ west usage_idle=92.5,usage_user=3i,online=t 1556813561098000000
+weather\,daily,city=a\=b temperature=82u,summary="hot, sunny" 1556813561098000000
+mem free=1024
+`
+	now := time.Unix(1556813600, 0)
+	points, err := parsePoints([]byte(body), xtime.Nanosecond, now)
+	require.NoError(t, err)
+	require.Len(t, points, 3)
+
+	p := points[0]
+	assert.Equal(t, "cpu", string(p.measurement))
+	require.Len(t, p.tags, 2)
+	assert.Equal(t, "host", string(p.tags[0].key))
+	assert.Equal(t, "serverA", string(p.tags[0].value))
+	assert.Equal(t, "region", string(p.tags[1].key))
+	assert.Equal(t, "us west", string(p.tags[1].value))
+	require.Len(t, p.fields, 3)
+	assert.Equal(t, "usage_idle", string(p.fields[0].key))
+	assert.Equal(t, 92.5, p.fields[0].value)
+	assert.Equal(t, "usage_user", string(p.fields[1].key))
+	assert.Equal(t, 3.0, p.fields[1].value)
+	assert.Equal(t, "online", string(p.fields[2].key))
+	assert.Equal(t, 1.0, p.fields[2].value)
+	assert.True(t, time.Unix(0, 1556813561098000000).Equal(p.timestamp))
+
+	p = points[1]
+	assert.Equal(t, "weather,daily", string(p.measurement))
+	require.Len(t, p.tags, 1)
+	assert.Equal(t, "a=b", string(p.tags[0].value))
+	// String fields are dropped.
+	require.Len(t, p.fields, 1)
+	assert.Equal(t, "temperature", string(p.fields[0].key))
+	assert.Equal(t, 82.0, p.fields[0].value)
+
+	p = points[2]
+	assert.Equal(t, "mem", string(p.measurement))
+	assert.Len(t, p.tags, 0)
+	require.Len(t, p.fields, 1)
+	assert.Equal(t, 1024.0, p.fields[0].value)
+	assert.True(t, now.Equal(p.timestamp))
+}
+
+func TestParsePointsPrecision(t *testing.T) {
+	for _, test := range []struct {
+		precision string
+		timestamp string
+		expected  time.Time
+	}{
+		{precision: "", timestamp: "1556813561", expected: time.Unix(0, 1556813561)},
+		{precision: "u", timestamp: "1556813561", expected: time.Unix(0, 1556813561*int64(time.Microsecond))},
+		{precision: "ms", timestamp: "1556813561", expected: time.Unix(0, 1556813561*int64(time.Millisecond))},
+		{precision: "s", timestamp: "1556813561", expected: time.Unix(1556813561, 0)},
+		{precision: "h", timestamp: "432448", expected: time.Unix(432448*3600, 0)},
+	} {
+		unit, err := parsePrecision(test.precision)
+		require.NoError(t, err)
+
+		points, err := parsePoints([]byte("cpu value=1 "+test.timestamp), unit, time.Now())
+		require.NoError(t, err)
+		require.Len(t, points, 1)
+		assert.True(t, test.expected.Equal(points[0].timestamp), test.precision)
+	}
+
+	_, err := parsePrecision("d")
+	require.Error(t, err)
+}
+
+func TestParsePointsTimestampOutOfRange(t *testing.T) {
+	for _, line := range []string{
+		"cpu value=1 9223372036854775807",
+		"cpu value=1 -9223372036854775808",
+		"cpu value=1 9223372037",
+	} {
+		_, err := parsePoints([]byte(line), xtime.Second, time.Now())
+		assert.Error(t, err, line)
+	}
+
+	// The largest timestamps which fit are still accepted.
+	_, err := parsePoints([]byte("cpu value=1 9223372036"), xtime.Second, time.Now())
+	require.NoError(t, err)
+	_, err = parsePoints([]byte("cpu value=1 9223372036854775807"), xtime.Nanosecond, time.Now())
+	require.NoError(t, err)
+}
+
+func TestParsePointsInvalid(t *testing.T) {
+	for _, line := range []string{
+		"cpu",
+		"cpu ",
+		",host=a value=1",
+		"cpu,host value=1",
+		"cpu,host= value=1",
+		"cpu value",
+		"cpu value=",
+		"cpu value=abc",
+		"cpu value=1x",
+		`cpu value="abc`,
+		"cpu value=1 abc",
+	} {
+		_, err := parsePoints([]byte(line), xtime.Nanosecond, time.Now())
+		assert.Error(t, err, line)
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	// InfluxWriteURL is the url for the InfluxDB line protocol write handler.
+	InfluxWriteURL = handler.RoutePrefixV1 + "/influxdb/write"
+
+	// InfluxWriteHTTPMethod is the HTTP method used with this resource.
+	InfluxWriteHTTPMethod = http.MethodPost
+
+	precisionParam = "precision"
+
+	defaultMaxBodySize = 64 * 1024 * 1024 // 64MB.
+)
+
+var (
+	errNoDownsamplerAndWriter = errors.New("no ingest.DownsamplerAndWriter was set")
+	errBodyTooLarge           = errors.New("request body too large")
+)
+
+// WriteHandler represents a handler for the InfluxDB line protocol write
+// endpoint, each numeric field of a point is written as a separate series
+// named after the measurement and field.
+type WriteHandler struct {
+	downsamplerAndWriter ingest.DownsamplerAndWriter
+	tagOptions           models.TagOptions
+	nowFn                clock.NowFn
+	maxBodySize          int64
+	metrics              writeMetrics
+}
+
+// NewWriteHandler returns a new instance of handler.
+func NewWriteHandler(
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+	tagOptions models.TagOptions,
+	nowFn clock.NowFn,
+	scope tally.Scope,
+) (http.Handler, error) {
+	if downsamplerAndWriter == nil {
+		return nil, errNoDownsamplerAndWriter
+	}
+
+	return &WriteHandler{
+		downsamplerAndWriter: downsamplerAndWriter,
+		tagOptions:           tagOptions,
+		nowFn:                nowFn,
+		maxBodySize:          defaultMaxBodySize,
+		metrics:              newWriteMetrics(scope),
+	}, nil
+}
+
+type writeMetrics struct {
+	writeSuccess      tally.Counter
+	writeErrorsServer tally.Counter
+	writeErrorsClient tally.Counter
+}
+
+func newWriteMetrics(scope tally.Scope) writeMetrics {
+	return writeMetrics{
+		writeSuccess:      scope.Counter("write.success"),
+		writeErrorsServer: scope.Tagged(map[string]string{"code": "5XX"}).Counter("write.errors"),
+		writeErrorsClient: scope.Tagged(map[string]string{"code": "4XX"}).Counter("write.errors"),
+	}
+}
+
+func (h *WriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	points, unit, rErr := h.parseRequest(r)
+	if rErr != nil {
+		h.metrics.writeErrorsClient.Inc(1)
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	err := h.write(r.Context(), points, unit)
+	if err != nil {
+		if xerrors.IsResourceExhausted(err) {
+			h.metrics.writeErrorsClient.Inc(1)
+			xhttp.Error(w, err, http.StatusTooManyRequests)
+			return
+		}
+
+		h.metrics.writeErrorsServer.Inc(1)
+		logger := logging.WithContext(r.Context())
+		logger.Error("write error",
+			zap.String("remoteAddr", r.RemoteAddr),
+			zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	h.metrics.writeSuccess.Inc(1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WriteHandler) parseRequest(r *http.Request) ([]point, xtime.Unit, *xhttp.ParseError) {
+	if r.Body == nil {
+		err := fmt.Errorf("empty request body")
+		return nil, xtime.None, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+	defer r.Body.Close()
+
+	unit, err := parsePrecision(r.URL.Query().Get(precisionParam))
+	if err != nil {
+		return nil, xtime.None, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, xtime.None, xhttp.NewParseError(err, http.StatusBadRequest)
+		}
+		defer gzipReader.Close()
+		body = gzipReader
+	}
+
+	// NB: The limit applies after decompression so that a small gzipped body
+	// can not expand into an unbounded amount of memory, one byte past the
+	// limit is read to tell a body of exactly the limit apart from a larger one.
+	buf, err := ioutil.ReadAll(io.LimitReader(body, h.maxBodySize+1))
+	if err != nil {
+		return nil, xtime.None, xhttp.NewParseError(err, http.StatusInternalServerError)
+	}
+	if int64(len(buf)) > h.maxBodySize {
+		return nil, xtime.None, xhttp.NewParseError(errBodyTooLarge,
+			http.StatusRequestEntityTooLarge)
+	}
+
+	// Points without a timestamp use the server time, truncated to the
+	// precision of the request.
+	unitDuration, _ := unit.Value()
+	now := h.nowFn().Truncate(unitDuration)
+	points, err := parsePoints(buf, unit, now)
+	if err != nil {
+		return nil, xtime.None, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	return points, unit, nil
+}
+
+func (h *WriteHandler) write(ctx context.Context, points []point, unit xtime.Unit) error {
+	iter := newInfluxTSIter(points, unit, h.tagOptions)
+	return h.downsamplerAndWriter.WriteBatch(ctx, iter)
+}
+
+// newInfluxTSIter maps each numeric field of each point to a series with the
+// name <measurement>_<field> and the point's tags.
+func newInfluxTSIter(
+	points []point,
+	unit xtime.Unit,
+	tagOpts models.TagOptions,
+) *influxTSIter {
+	var (
+		tags       = make([]models.Tags, 0, len(points))
+		datapoints = make([]ts.Datapoints, 0, len(points))
+	)
+	for _, p := range points {
+		for _, f := range p.fields {
+			seriesTags := models.NewTags(len(p.tags)+1, tagOpts)
+			for _, t := range p.tags {
+				seriesTags = seriesTags.AddTagWithoutNormalizing(models.Tag{
+					Name:  sanitizeName(t.key),
+					Value: t.value,
+				})
+			}
+			seriesTags = seriesTags.AddTag(models.Tag{
+				Name:  tagOpts.MetricName(),
+				Value: metricName(p.measurement, f.key),
+			})
+
+			tags = append(tags, seriesTags)
+			datapoints = append(datapoints, ts.Datapoints{
+				{Timestamp: p.timestamp, Value: f.value},
+			})
+		}
+	}
+
+	return &influxTSIter{
+		idx:        -1,
+		tags:       tags,
+		datapoints: datapoints,
+		unit:       unit,
+	}
+}
+
+// metricName returns <measurement>_<field>, sanitized so the name can be
+// queried with PromQL.
+func metricName(measurement, field []byte) []byte {
+	name := make([]byte, 0, len(measurement)+len(field)+1)
+	name = append(name, measurement...)
+	name = append(name, '_')
+	name = append(name, field...)
+	return sanitizeName(name)
+}
+
+// sanitizeName replaces characters that are not valid in a Prometheus metric
+// or label name with underscores.
+func sanitizeName(name []byte) []byte {
+	var sanitized []byte
+	for i, c := range name {
+		valid := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_' ||
+			c == ':' || (i > 0 && c >= '0' && c <= '9')
+		if valid {
+			continue
+		}
+		if sanitized == nil {
+			sanitized = append([]byte(nil), name...)
+		}
+		sanitized[i] = '_'
+	}
+	if sanitized == nil {
+		return name
+	}
+	return sanitized
+}
+
+type influxTSIter struct {
+	idx        int
+	tags       []models.Tags
+	datapoints []ts.Datapoints
+	unit       xtime.Unit
+}
+
+func (i *influxTSIter) Next() bool {
+	i.idx++
+	return i.idx < len(i.tags)
+}
+
+func (i *influxTSIter) Current() (models.Tags, ts.Datapoints, xtime.Unit) {
+	if len(i.tags) == 0 || i.idx < 0 || i.idx >= len(i.tags) {
+		return models.EmptyTags(), nil, 0
+	}
+
+	return i.tags[i.idx], i.datapoints[i.idx], i.unit
+}
+
+func (i *influxTSIter) Reset() error {
+	i.idx = -1
+	return nil
+}
+
+func (i *influxTSIter) Error() error {
+	return nil
+}
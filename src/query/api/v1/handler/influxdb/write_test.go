@@ -0,0 +1,197 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/util/logging"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type writtenSeries struct {
+	id        string
+	value     float64
+	timestamp time.Time
+	unit      xtime.Unit
+}
+
+func newTestWriteHandler(
+	t *testing.T,
+	ctrl *gomock.Controller,
+	written *[]writtenSeries,
+) http.Handler {
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, iter ingest.DownsampleAndWriteIter) error {
+			for iter.Next() {
+				tags, dps, unit := iter.Current()
+				*written = append(*written, writtenSeries{
+					id:        string(tags.ID()),
+					value:     dps[0].Value,
+					timestamp: dps[0].Timestamp,
+					unit:      unit,
+				})
+			}
+			return iter.Error()
+		}).AnyTimes()
+
+	h, err := NewWriteHandler(mockDownsamplerAndWriter, models.NewTagOptions(),
+		func() time.Time { return time.Unix(1556813600, 0) }, tally.NoopScope)
+	require.NoError(t, err)
+	return h
+}
+
+func expectedID(name string, tags ...string) string {
+	t := models.NewTags(0, models.NewTagOptions()).SetName([]byte(name))
+	for i := 0; i < len(tags); i += 2 {
+		t = t.AddTag(models.Tag{Name: []byte(tags[i]), Value: []byte(tags[i+1])})
+	}
+	return string(t.ID())
+}
+
+func TestInfluxWrite(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var written []writtenSeries
+	h := newTestWriteHandler(t, ctrl, &written)
+
+	body := "cpu,host=a,cpu-id=1 usage_idle=92.5,usage_user=3i 1556813561\n" +
+		"disk.io,host=a reads=10\n"
+	req := httptest.NewRequest(InfluxWriteHTTPMethod, InfluxWriteURL+"?precision=s",
+		strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	require.Len(t, written, 3)
+	assert.Equal(t, expectedID("cpu_usage_idle", "cpu_id", "1", "host", "a"), written[0].id)
+	assert.Equal(t, 92.5, written[0].value)
+	assert.True(t, time.Unix(1556813561, 0).Equal(written[0].timestamp))
+	assert.Equal(t, xtime.Second, written[0].unit)
+
+	assert.Equal(t, expectedID("cpu_usage_user", "cpu_id", "1", "host", "a"), written[1].id)
+	assert.Equal(t, 3.0, written[1].value)
+
+	assert.Equal(t, expectedID("disk_io_reads", "host", "a"), written[2].id)
+	assert.True(t, time.Unix(1556813600, 0).Equal(written[2].timestamp))
+}
+
+func TestInfluxWriteGzip(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var written []writtenSeries
+	h := newTestWriteHandler(t, ctrl, &written)
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err := gw.Write([]byte("mem free=1024 1556813561000000000"))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+
+	req := httptest.NewRequest(InfluxWriteHTTPMethod, InfluxWriteURL, &buf)
+	req.Header.Set("Content-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+
+	require.Len(t, written, 1)
+	assert.Equal(t, expectedID("mem_free"), written[0].id)
+	assert.Equal(t, xtime.Nanosecond, written[0].unit)
+}
+
+func TestInfluxWriteBadRequest(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var written []writtenSeries
+	h := newTestWriteHandler(t, ctrl, &written)
+
+	for _, url := range []string{
+		InfluxWriteURL + "?precision=d",
+		InfluxWriteURL,
+	} {
+		req := httptest.NewRequest(InfluxWriteHTTPMethod, url,
+			strings.NewReader("cpu value=abc"))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	}
+	assert.Len(t, written, 0)
+}
+
+func TestInfluxWriteBodyTooLarge(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var written []writtenSeries
+	h := newTestWriteHandler(t, ctrl, &written)
+	h.(*WriteHandler).maxBodySize = 8
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err := gw.Write([]byte("mem free=1024"))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+
+	// The limit applies to the decompressed body.
+	req := httptest.NewRequest(InfluxWriteHTTPMethod, InfluxWriteURL, &buf)
+	req.Header.Set("Content-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+	req = httptest.NewRequest(InfluxWriteHTTPMethod, InfluxWriteURL,
+		strings.NewReader("mem a=1"))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	assert.Len(t, written, 1)
+}
+
+func TestNewWriteHandlerRequiresWriter(t *testing.T) {
+	_, err := NewWriteHandler(nil, models.NewTagOptions(), time.Now, tally.NoopScope)
+	require.Error(t, err)
+}
@@ -87,6 +87,11 @@ func (h *AddHandler) ServeHTTP(serviceName string, w http.ResponseWriter, r *htt
 		return
 	}
 
+	if isDryRun(r, req.DryRun) {
+		writeDryRunResponse(w, r, h.HandlerOptions, serviceName, h.nowFn(), placement, req.EstimatedShardSizeBytes, logger)
+		return
+	}
+
 	placementProto, err := placement.Proto()
 	if err != nil {
 		logger.Error("unable to get placement protobuf", zap.Error(err))
@@ -109,6 +114,10 @@ func (h *AddHandler) parseRequest(r *http.Request) (*admin.PlacementAddRequest,
 		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
 	}
 
+	if pErr := validateEstimatedShardSize(addReq.EstimatedShardSizeBytes); pErr != nil {
+		return nil, pErr
+	}
+
 	return addReq, nil
 }
 
@@ -125,6 +134,7 @@ func (h *AddHandler) Add(
 
 	serviceOpts := handler.NewServiceOptions(
 		serviceName, httpReq.Header, h.M3AggServiceOptions)
+	serviceOpts.DryRun = isDryRun(httpReq, req.DryRun)
 	var validateFn placement.ValidateFn
 	if !req.Force {
 		validateFn = validateAllAvailable
@@ -282,6 +282,24 @@ func RegisterRoutes(r *mux.Router, opts HandlerOptions) {
 	r.HandleFunc(M3DBReplaceURL, replaceFn).Methods(ReplaceHTTPMethod)
 	r.HandleFunc(M3AggReplaceURL, replaceFn).Methods(ReplaceHTTPMethod)
 	r.HandleFunc(M3CoordinatorReplaceURL, replaceFn).Methods(ReplaceHTTPMethod)
+
+	// Rebalance
+	var (
+		rebalanceHandler = NewRebalanceHandler(opts)
+		rebalanceFn      = applyMiddleware(rebalanceHandler.ServeHTTP)
+	)
+	r.HandleFunc(M3DBRebalanceURL, rebalanceFn).Methods(RebalanceHTTPMethod)
+	r.HandleFunc(M3AggRebalanceURL, rebalanceFn).Methods(RebalanceHTTPMethod)
+	r.HandleFunc(M3CoordinatorRebalanceURL, rebalanceFn).Methods(RebalanceHTTPMethod)
+
+	// Set weights
+	var (
+		setWeightsHandler = NewSetWeightsHandler(opts)
+		setWeightsFn      = applyMiddleware(setWeightsHandler.ServeHTTP)
+	)
+	r.HandleFunc(M3DBSetWeightsURL, setWeightsFn).Methods(SetWeightsHTTPMethod)
+	r.HandleFunc(M3AggSetWeightsURL, setWeightsFn).Methods(SetWeightsHTTPMethod)
+	r.HandleFunc(M3CoordinatorSetWeightsURL, setWeightsFn).Methods(SetWeightsHTTPMethod)
 }
 
 func newPlacementCutoverNanosFn(
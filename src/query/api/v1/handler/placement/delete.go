@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"strconv"
 	"time"
 
 	"github.com/m3db/m3/src/cluster/placement"
@@ -38,8 +39,10 @@ import (
 )
 
 const (
-	placementIDVar    = "id"
-	placementForceVar = "force"
+	placementIDVar                      = "id"
+	placementForceVar                   = "force"
+	placementDryRunVar                  = "dryRun"
+	placementEstimatedShardSizeBytesVar = "estimatedShardSizeBytes"
 
 	// DeleteHTTPMethod is the HTTP method used with this resource.
 	DeleteHTTPMethod = http.MethodDelete
@@ -86,10 +89,27 @@ func (h *DeleteHandler) ServeHTTP(serviceName string, w http.ResponseWriter, r *
 	}
 
 	var (
-		force = r.FormValue(placementForceVar) == "true"
-		opts  = handler.NewServiceOptions(
+		force  = r.FormValue(placementForceVar) == "true"
+		dryRun = isDryRun(r, r.FormValue(placementDryRunVar) == "true")
+		opts   = handler.NewServiceOptions(
 			serviceName, r.Header, h.M3AggServiceOptions)
+		shardSizeBytes int64
 	)
+	opts.DryRun = dryRun
+
+	if v := r.FormValue(placementEstimatedShardSizeBytesVar); v != "" {
+		var err error
+		shardSizeBytes, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			logger.Info("unable to parse estimated shard size", zap.String("value", v), zap.Error(err))
+			xhttp.Error(w, err, http.StatusBadRequest)
+			return
+		}
+		if pErr := validateEstimatedShardSize(shardSizeBytes); pErr != nil {
+			xhttp.Error(w, pErr.Inner(), pErr.Code())
+			return
+		}
+	}
 
 	service, algo, err := ServiceWithAlgo(h.ClusterClient, opts, h.nowFn(), nil)
 	if err != nil {
@@ -149,6 +169,11 @@ func (h *DeleteHandler) ServeHTTP(serviceName string, w http.ResponseWriter, r *
 		}
 	}
 
+	if dryRun {
+		writeDryRunResponse(w, r, h.HandlerOptions, serviceName, h.nowFn(), newPlacement, shardSizeBytes, logger)
+		return
+	}
+
 	placementProto, err := newPlacement.Proto()
 	if err != nil {
 		logger.Error("unable to get placement protobuf", zap.Error(err))
@@ -72,6 +72,10 @@ func (h *DeleteAllHandler) ServeHTTP(serviceName string, w http.ResponseWriter,
 			serviceName, r.Header, h.M3AggServiceOptions)
 	)
 
+	if rejectDryRun(w, r) {
+		return
+	}
+
 	service, err := Service(h.ClusterClient, opts, h.nowFn(), nil)
 	if err != nil {
 		xhttp.Error(w, err, http.StatusInternalServerError)
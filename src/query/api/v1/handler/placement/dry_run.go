@@ -0,0 +1,212 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+var (
+	errNegativeShardSize = errors.New("estimated shard size bytes must not be negative")
+	errDryRunUnsupported = errors.New("dry run is not supported for this placement request")
+)
+
+// isDryRun returns true if either the request body or the Dry-Run header
+// asked for the placement change to be computed without being persisted.
+func isDryRun(r *http.Request, requested bool) bool {
+	return requested || strings.TrimSpace(r.Header.Get(handler.HeaderDryRun)) == "true"
+}
+
+// rejectDryRun writes a bad request error and returns true if a dry run was
+// requested of a handler that cannot compute one, so that a dry run is never
+// mistaken for a request that changes the placement.
+func rejectDryRun(w http.ResponseWriter, r *http.Request) bool {
+	if !isDryRun(r, r.URL.Query().Get(placementDryRunVar) == "true") {
+		return false
+	}
+
+	xhttp.Error(w, errDryRunUnsupported, http.StatusBadRequest)
+	return true
+}
+
+func validateEstimatedShardSize(shardSizeBytes int64) *xhttp.ParseError {
+	if shardSizeBytes < 0 {
+		return xhttp.NewParseError(errNegativeShardSize, http.StatusBadRequest)
+	}
+	return nil
+}
+
+// writeDryRunResponse compares the proposed placement against the placement
+// currently stored for the service and writes the proposed placement along
+// with the shard movements it implies.
+func writeDryRunResponse(
+	w http.ResponseWriter,
+	r *http.Request,
+	opts HandlerOptions,
+	serviceName string,
+	now time.Time,
+	proposed placement.Placement,
+	shardSizeBytes int64,
+	logger *zap.Logger,
+) {
+	serviceOpts := handler.NewServiceOptions(serviceName, r.Header, opts.M3AggServiceOptions)
+	service, err := Service(opts.ClusterClient, serviceOpts, now, nil)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	current, err := service.Placement()
+	if err != nil {
+		logger.Error("unable to fetch current placement", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := newDryRunResponse(current, proposed, shardSizeBytes)
+	if err != nil {
+		logger.Error("unable to get placement protobuf", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
+
+func newDryRunResponse(
+	current placement.Placement,
+	proposed placement.Placement,
+	shardSizeBytes int64,
+) (*admin.PlacementDryRunResponse, error) {
+	proposedProto, err := proposed.Proto()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admin.PlacementDryRunResponse{
+		Placement: proposedProto,
+		Version:   int32(proposed.Version()),
+	}
+
+	ids := make(map[string]struct{}, proposed.NumInstances())
+	for _, instance := range current.Instances() {
+		ids[instance.ID()] = struct{}{}
+	}
+	for _, instance := range proposed.Instances() {
+		ids[instance.ID()] = struct{}{}
+	}
+	sortedIDs := make([]string, 0, len(ids))
+	for id := range ids {
+		sortedIDs = append(sortedIDs, id)
+	}
+	sort.Strings(sortedIDs)
+
+	for _, id := range sortedIDs {
+		curInstance, curOK := current.Instance(id)
+		newInstance, newOK := proposed.Instance(id)
+
+		var curShards, newShards shard.Shards
+		if curOK {
+			curShards = curInstance.Shards()
+		}
+		if newOK {
+			newShards = newInstance.Shards()
+		}
+
+		diff := newInstanceDiff(id, curShards, newShards, shardSizeBytes)
+		if curOK == newOK && len(diff.ShardsGained) == 0 && len(diff.ShardsLost) == 0 {
+			continue
+		}
+		resp.Instances = append(resp.Instances, diff)
+		resp.EstimatedBytesToStream += diff.EstimatedBytesToStream
+	}
+
+	return resp, nil
+}
+
+// newInstanceDiff describes the shards an instance gains and loses when it
+// moves from the current to the new set of shards. Leaving shards are not
+// considered owned since they are about to be handed off to another instance.
+func newInstanceDiff(
+	id string,
+	cur shard.Shards,
+	next shard.Shards,
+	shardSizeBytes int64,
+) *admin.PlacementInstanceDiff {
+	var (
+		curOwned = ownedShards(cur)
+		newOwned = ownedShards(next)
+		diff     = &admin.PlacementInstanceDiff{Id: id}
+	)
+	for shardID := range newOwned {
+		if _, ok := curOwned[shardID]; ok {
+			continue
+		}
+		diff.ShardsGained = append(diff.ShardsGained, shardID)
+		if s, _ := next.Shard(shardID); s.State() == shard.Initializing {
+			diff.EstimatedBytesToStream += shardSizeBytes
+		}
+	}
+	for shardID := range curOwned {
+		if _, ok := newOwned[shardID]; !ok {
+			diff.ShardsLost = append(diff.ShardsLost, shardID)
+		}
+	}
+	if next != nil {
+		for _, s := range next.ShardsForState(shard.Initializing) {
+			diff.ShardsInitializing = append(diff.ShardsInitializing, s.ID())
+		}
+	}
+
+	sortShardIDs(diff.ShardsGained)
+	sortShardIDs(diff.ShardsLost)
+	sortShardIDs(diff.ShardsInitializing)
+	return diff
+}
+
+func ownedShards(shards shard.Shards) map[uint32]struct{} {
+	owned := make(map[uint32]struct{})
+	if shards == nil {
+		return owned
+	}
+	for _, s := range shards.All() {
+		if s.State() != shard.Leaving {
+			owned[s.ID()] = struct{}{}
+		}
+	}
+	return owned
+}
+
+func sortShardIDs(ids []uint32) {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	apihandler "github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDryRunTestInstance(id string, shards ...shard.Shard) placement.Instance {
+	return placement.NewInstance().
+		SetID(id).
+		SetIsolationGroup(id).
+		SetWeight(1).
+		SetShards(shard.NewShards(shards))
+}
+
+func newDryRunTestPlacements() (placement.Placement, placement.Placement) {
+	current := placement.NewPlacement().
+		SetIsSharded(true).
+		SetReplicaFactor(1).
+		SetShards([]uint32{0, 1, 2, 3, 4}).
+		SetInstances([]placement.Instance{
+			newDryRunTestInstance("A",
+				shard.NewShard(0).SetState(shard.Available),
+				shard.NewShard(1).SetState(shard.Available)),
+			newDryRunTestInstance("B",
+				shard.NewShard(2).SetState(shard.Available),
+				shard.NewShard(3).SetState(shard.Available)),
+			newDryRunTestInstance("D",
+				shard.NewShard(4).SetState(shard.Available)),
+		}).
+		SetVersion(4)
+
+	proposed := placement.NewPlacement().
+		SetIsSharded(true).
+		SetReplicaFactor(1).
+		SetShards([]uint32{0, 1, 2, 3, 4}).
+		SetInstances([]placement.Instance{
+			newDryRunTestInstance("A",
+				shard.NewShard(0).SetState(shard.Available),
+				shard.NewShard(1).SetState(shard.Leaving),
+				shard.NewShard(4).SetState(shard.Initializing).SetSourceID("D")),
+			newDryRunTestInstance("B",
+				shard.NewShard(2).SetState(shard.Available),
+				shard.NewShard(3).SetState(shard.Leaving)),
+			newDryRunTestInstance("C",
+				shard.NewShard(1).SetState(shard.Initializing).SetSourceID("A"),
+				shard.NewShard(3).SetState(shard.Initializing).SetSourceID("B")),
+		}).
+		SetVersion(5)
+
+	return current, proposed
+}
+
+func TestNewDryRunResponse(t *testing.T) {
+	current, proposed := newDryRunTestPlacements()
+
+	resp, err := newDryRunResponse(current, proposed, 100)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(5), resp.Version)
+	assert.Equal(t, 3, len(resp.Placement.Instances))
+	assert.Equal(t, int64(300), resp.EstimatedBytesToStream)
+	assert.Equal(t, []*admin.PlacementInstanceDiff{
+		{
+			Id:                     "A",
+			ShardsGained:           []uint32{4},
+			ShardsLost:             []uint32{1},
+			ShardsInitializing:     []uint32{4},
+			EstimatedBytesToStream: 100,
+		},
+		{
+			Id:         "B",
+			ShardsLost: []uint32{3},
+		},
+		{
+			Id:                     "C",
+			ShardsGained:           []uint32{1, 3},
+			ShardsInitializing:     []uint32{1, 3},
+			EstimatedBytesToStream: 200,
+		},
+		{
+			Id:         "D",
+			ShardsLost: []uint32{4},
+		},
+	}, resp.Instances)
+}
+
+func TestNewDryRunResponseNoChanges(t *testing.T) {
+	current, _ := newDryRunTestPlacements()
+
+	resp, err := newDryRunResponse(current, current, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(resp.Instances))
+	assert.Equal(t, int64(0), resp.EstimatedBytesToStream)
+}
+
+func TestIsDryRun(t *testing.T) {
+	req := httptest.NewRequest(AddHTTPMethod, M3DBAddURL, nil)
+	assert.False(t, isDryRun(req, false))
+	assert.True(t, isDryRun(req, true))
+
+	req.Header.Set(apihandler.HeaderDryRun, "true")
+	assert.True(t, isDryRun(req, false))
+}
+
+func readDryRunResponse(t *testing.T, w *httptest.ResponseRecorder) *admin.PlacementDryRunResponse {
+	resp := w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var dryRunResp admin.PlacementDryRunResponse
+	require.NoError(t, jsonpb.Unmarshal(resp.Body, &dryRunResp))
+	return &dryRunResp
+}
+
+func TestPlacementAddHandler_DryRun(t *testing.T) {
+	runForAllAllowedServices(func(serviceName string) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			mockClient, mockPlacementService = SetupPlacementTest(t, ctrl)
+			handlerOpts                      = NewHandlerOptions(
+				mockClient, config.Configuration{}, nil)
+			handler           = NewAddHandler(handlerOpts)
+			current, proposed = newDryRunTestPlacements()
+		)
+		handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(AddHTTPMethod, M3DBAddURL, strings.NewReader(
+			`{"force": true, "dryRun": true, "estimatedShardSizeBytes": 100, "instances":[{"id": "C","isolation_group": "C","zone": "test","weight": 1,"endpoint": "http://C:1234","hostname": "C","port": 1234}]}`))
+		mockPlacementService.EXPECT().AddInstances(gomock.Any()).Return(proposed, nil, nil)
+		mockPlacementService.EXPECT().Placement().Return(current, nil)
+		handler.ServeHTTP(serviceName, w, req)
+
+		resp := readDryRunResponse(t, w)
+		assert.Equal(t, int32(5), resp.Version)
+		assert.Equal(t, int64(300), resp.EstimatedBytesToStream)
+		assert.Equal(t, 4, len(resp.Instances))
+	})
+}
+
+func TestPlacementAddHandler_NegativeShardSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, _ := SetupPlacementTest(t, ctrl)
+	handler := NewAddHandler(NewHandlerOptions(mockClient, config.Configuration{}, nil))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(AddHTTPMethod, M3DBAddURL, strings.NewReader(
+		`{"dryRun": true, "estimatedShardSizeBytes": -1, "instances":[]}`))
+	handler.ServeHTTP(apihandler.M3DBServiceName, w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestPlacementDeleteHandler_DryRun(t *testing.T) {
+	runForAllAllowedServices(func(serviceName string) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			mockClient, mockPlacementService = SetupPlacementTest(t, ctrl)
+			handler                          = NewDeleteHandler(NewHandlerOptions(mockClient, config.Configuration{}, nil))
+			current, proposed                = newDryRunTestPlacements()
+		)
+		handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(DeleteHTTPMethod, "/placement/D?force=true&dryRun=true&estimatedShardSizeBytes=10", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "D"})
+		mockPlacementService.EXPECT().RemoveInstances([]string{"D"}).Return(proposed, nil)
+		mockPlacementService.EXPECT().Placement().Return(current, nil)
+		handler.ServeHTTP(serviceName, w, req)
+
+		resp := readDryRunResponse(t, w)
+		assert.Equal(t, int64(30), resp.EstimatedBytesToStream)
+		assert.Equal(t, 4, len(resp.Instances))
+	})
+}
+
+func TestPlacementRebalanceHandler_DryRunHeader(t *testing.T) {
+	runForAllAllowedServices(func(serviceName string) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			mockClient, mockPlacementService = SetupPlacementTest(t, ctrl)
+			handler                          = NewRebalanceHandler(NewHandlerOptions(mockClient, config.Configuration{}, nil))
+			current, proposed                = newDryRunTestPlacements()
+		)
+		handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+
+		w := httptest.NewRecorder()
+		req := newRebalanceRequest(`{"force": true}`)
+		req.Header.Set(apihandler.HeaderDryRun, "true")
+		mockPlacementService.EXPECT().Rebalance().Return(proposed, nil)
+		mockPlacementService.EXPECT().Placement().Return(current, nil)
+		handler.ServeHTTP(serviceName, w, req)
+
+		resp := readDryRunResponse(t, w)
+		assert.Equal(t, int64(0), resp.EstimatedBytesToStream)
+		assert.Equal(t, 4, len(resp.Instances))
+	})
+}
+
+func TestPlacementInitAndDeleteAllHandler_DryRunUnsupported(t *testing.T) {
+	runForAllAllowedServices(func(serviceName string) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			mockClient, _ = SetupPlacementTest(t, ctrl)
+			handlerOpts   = NewHandlerOptions(mockClient, config.Configuration{}, nil)
+		)
+
+		// The placement service is never called so nothing is persisted.
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(InitHTTPMethod, M3DBInitURL, strings.NewReader(`{}`))
+		req.Header.Set(apihandler.HeaderDryRun, "true")
+		NewInitHandler(handlerOpts).ServeHTTP(serviceName, w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		req = httptest.NewRequest(DeleteAllHTTPMethod, M3DBDeleteAllURL+"?dryRun=true", nil)
+		NewDeleteAllHandler(handlerOpts).ServeHTTP(serviceName, w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -70,6 +70,10 @@ func (h *InitHandler) ServeHTTP(serviceName string, w http.ResponseWriter, r *ht
 	ctx := r.Context()
 	logger := logging.WithContext(ctx)
 
+	if rejectDryRun(w, r) {
+		return
+	}
+
 	req, rErr := h.parseRequest(r)
 	if rErr != nil {
 		xhttp.Error(w, rErr.Inner(), rErr.Code())
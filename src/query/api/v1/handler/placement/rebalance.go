@@ -0,0 +1,141 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"go.uber.org/zap"
+)
+
+const (
+	// RebalanceHTTPMethod is the HTTP method for the rebalance endpoint.
+	RebalanceHTTPMethod = http.MethodPost
+
+	rebalancePathName = "rebalance"
+)
+
+var (
+	// M3DBRebalanceURL is the url for the m3db rebalance handler (method POST).
+	M3DBRebalanceURL = path.Join(handler.RoutePrefixV1, M3DBServicePlacementPathName, rebalancePathName)
+
+	// M3AggRebalanceURL is the url for the m3aggregator rebalance handler
+	// (method POST).
+	M3AggRebalanceURL = path.Join(handler.RoutePrefixV1, M3AggServicePlacementPathName, rebalancePathName)
+
+	// M3CoordinatorRebalanceURL is the url for the m3coordinator rebalance
+	// handler (method POST).
+	M3CoordinatorRebalanceURL = path.Join(handler.RoutePrefixV1, M3CoordinatorServicePlacementPathName, rebalancePathName)
+)
+
+// RebalanceHandler is the type for placement rebalances.
+type RebalanceHandler Handler
+
+// NewRebalanceHandler returns a new RebalanceHandler.
+func NewRebalanceHandler(opts HandlerOptions) *RebalanceHandler {
+	return &RebalanceHandler{HandlerOptions: opts, nowFn: time.Now}
+}
+
+func (h *RebalanceHandler) ServeHTTP(serviceName string, w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx)
+
+	req, pErr := h.parseRequest(r)
+	if pErr != nil {
+		xhttp.Error(w, pErr.Inner(), pErr.Code())
+		return
+	}
+
+	placement, err := h.Rebalance(serviceName, r, req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(unsafeAddError); ok {
+			status = http.StatusBadRequest
+		}
+		logger.Error("unable to rebalance placement", zap.Error(err))
+		xhttp.Error(w, err, status)
+		return
+	}
+
+	if isDryRun(r, req.DryRun) {
+		writeDryRunResponse(w, r, h.HandlerOptions, serviceName, h.nowFn(), placement, req.EstimatedShardSizeBytes, logger)
+		return
+	}
+
+	placementProto, err := placement.Proto()
+	if err != nil {
+		logger.Error("unable to get placement protobuf", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp := &admin.PlacementGetResponse{
+		Placement: placementProto,
+		Version:   int32(placement.Version()),
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
+
+func (h *RebalanceHandler) parseRequest(r *http.Request) (*admin.PlacementRebalanceRequest, *xhttp.ParseError) {
+	defer r.Body.Close()
+
+	req := &admin.PlacementRebalanceRequest{}
+	if err := jsonpb.Unmarshal(r.Body, req); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	if pErr := validateEstimatedShardSize(req.EstimatedShardSizeBytes); pErr != nil {
+		return nil, pErr
+	}
+
+	return req, nil
+}
+
+// Rebalance moves shards between the instances in the placement so that every
+// instance ends up with a load proportional to its weight.
+func (h *RebalanceHandler) Rebalance(
+	serviceName string,
+	httpReq *http.Request,
+	req *admin.PlacementRebalanceRequest,
+) (placement.Placement, error) {
+	serviceOpts := handler.NewServiceOptions(serviceName, httpReq.Header, h.M3AggServiceOptions)
+	serviceOpts.DryRun = isDryRun(httpReq, req.DryRun)
+	var validateFn placement.ValidateFn
+	if !req.Force {
+		validateFn = validateAllAvailable
+	}
+	service, _, err := ServiceWithAlgo(h.ClusterClient, serviceOpts, h.nowFn(), validateFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.Rebalance()
+}
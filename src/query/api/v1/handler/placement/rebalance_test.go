@@ -0,0 +1,94 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRebalanceRequest(body string) *http.Request {
+	return httptest.NewRequest(RebalanceHTTPMethod, M3DBRebalanceURL, strings.NewReader(body))
+}
+
+func TestPlacementRebalanceHandler_Force(t *testing.T) {
+	runForAllAllowedServices(func(serviceName string) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			mockClient, mockPlacementService = SetupPlacementTest(t, ctrl)
+			handlerOpts                      = NewHandlerOptions(mockClient, config.Configuration{}, nil)
+			handler                          = NewRebalanceHandler(handlerOpts)
+		)
+		handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+
+		w := httptest.NewRecorder()
+		mockPlacementService.EXPECT().Rebalance().Return(nil, errors.New("test"))
+		handler.ServeHTTP(serviceName, w, newRebalanceRequest(`{"force": true}`))
+
+		resp := w.Result()
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, `{"error":"test"}`+"\n", string(body))
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		w = httptest.NewRecorder()
+		mockPlacementService.EXPECT().Rebalance().Return(placement.NewPlacement(), nil)
+		handler.ServeHTTP(serviceName, w, newRebalanceRequest(`{"force": true}`))
+
+		resp = w.Result()
+		body, _ = ioutil.ReadAll(resp.Body)
+		assert.Equal(t, `{"placement":{"instances":{},"replicaFactor":0,"numShards":0,"isSharded":false,"cutoverTime":"0","isMirrored":false,"maxShardSetId":0},"version":0}`, string(body))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestPlacementRebalanceHandler_SafeErr_NotAllAvailable(t *testing.T) {
+	runForAllAllowedServices(func(serviceName string) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			mockClient  = setupPlacementTest(t, ctrl, newValidInitPlacement())
+			handlerOpts = NewHandlerOptions(mockClient, config.Configuration{}, nil)
+			handler     = NewRebalanceHandler(handlerOpts)
+		)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(serviceName, w, newRebalanceRequest(`{}`))
+
+		resp := w.Result()
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, `{"error":"instances [A,B] do not have all shards available"}`+"\n", string(body))
+	})
+}
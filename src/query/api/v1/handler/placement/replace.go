@@ -84,6 +84,11 @@ func (h *ReplaceHandler) ServeHTTP(serviceName string, w http.ResponseWriter, r
 		return
 	}
 
+	if isDryRun(r, req.DryRun) {
+		writeDryRunResponse(w, r, h.HandlerOptions, serviceName, h.nowFn(), placement, req.EstimatedShardSizeBytes, logger)
+		return
+	}
+
 	placementProto, err := placement.Proto()
 	if err != nil {
 		logger.Error("unable to get placement protobuf", zap.Error(err))
@@ -107,6 +112,10 @@ func (h *ReplaceHandler) parseRequest(r *http.Request) (*admin.PlacementReplaceR
 		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
 	}
 
+	if pErr := validateEstimatedShardSize(req.EstimatedShardSizeBytes); pErr != nil {
+		return nil, pErr
+	}
+
 	return req, nil
 }
 
@@ -122,6 +131,7 @@ func (h *ReplaceHandler) Replace(
 	}
 
 	serviceOpts := handler.NewServiceOptions(serviceName, httpReq.Header, h.M3AggServiceOptions)
+	serviceOpts.DryRun = isDryRun(httpReq, req.DryRun)
 	service, algo, err := ServiceWithAlgo(h.ClusterClient, serviceOpts, h.nowFn(), nil)
 	if err != nil {
 		return nil, err
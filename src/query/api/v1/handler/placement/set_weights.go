@@ -0,0 +1,164 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"go.uber.org/zap"
+)
+
+const (
+	// SetWeightsHTTPMethod is the HTTP method for the set weights endpoint.
+	SetWeightsHTTPMethod = http.MethodPost
+
+	setWeightsPathName = "weights"
+)
+
+var (
+	// M3DBSetWeightsURL is the url for the m3db set weights handler (method POST).
+	M3DBSetWeightsURL = path.Join(handler.RoutePrefixV1, M3DBServicePlacementPathName, setWeightsPathName)
+
+	// M3AggSetWeightsURL is the url for the m3aggregator set weights handler
+	// (method POST).
+	M3AggSetWeightsURL = path.Join(handler.RoutePrefixV1, M3AggServicePlacementPathName, setWeightsPathName)
+
+	// M3CoordinatorSetWeightsURL is the url for the m3coordinator set weights
+	// handler (method POST).
+	M3CoordinatorSetWeightsURL = path.Join(handler.RoutePrefixV1, M3CoordinatorServicePlacementPathName, setWeightsPathName)
+
+	errNoInstanceWeights = errors.New("no instance weights specified")
+)
+
+// SetWeightsHandler is the type for placement instance weight changes.
+type SetWeightsHandler Handler
+
+// NewSetWeightsHandler returns a new SetWeightsHandler.
+func NewSetWeightsHandler(opts HandlerOptions) *SetWeightsHandler {
+	return &SetWeightsHandler{HandlerOptions: opts, nowFn: time.Now}
+}
+
+func (h *SetWeightsHandler) ServeHTTP(serviceName string, w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx)
+
+	req, pErr := h.parseRequest(r)
+	if pErr != nil {
+		xhttp.Error(w, pErr.Inner(), pErr.Code())
+		return
+	}
+
+	placement, err := h.SetWeights(serviceName, r, req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(unsafeAddError); ok {
+			status = http.StatusBadRequest
+		}
+		logger.Error("unable to set instance weights", zap.Error(err))
+		xhttp.Error(w, err, status)
+		return
+	}
+
+	if isDryRun(r, req.DryRun) {
+		writeDryRunResponse(w, r, h.HandlerOptions, serviceName, h.nowFn(), placement, req.EstimatedShardSizeBytes, logger)
+		return
+	}
+
+	placementProto, err := placement.Proto()
+	if err != nil {
+		logger.Error("unable to get placement protobuf", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp := &admin.PlacementGetResponse{
+		Placement: placementProto,
+		Version:   int32(placement.Version()),
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
+
+func (h *SetWeightsHandler) parseRequest(r *http.Request) (*admin.PlacementSetWeightsRequest, *xhttp.ParseError) {
+	defer r.Body.Close()
+
+	req := &admin.PlacementSetWeightsRequest{}
+	if err := jsonpb.Unmarshal(r.Body, req); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	if len(req.Instances) == 0 {
+		return nil, xhttp.NewParseError(errNoInstanceWeights, http.StatusBadRequest)
+	}
+
+	for _, instance := range req.Instances {
+		if instance.Id == "" {
+			return nil, xhttp.NewParseError(errors.New("instance id must be specified"), http.StatusBadRequest)
+		}
+		if instance.Weight == 0 {
+			return nil, xhttp.NewParseError(
+				fmt.Errorf("weight for instance %s must be positive", instance.Id), http.StatusBadRequest)
+		}
+	}
+
+	if pErr := validateEstimatedShardSize(req.EstimatedShardSizeBytes); pErr != nil {
+		return nil, pErr
+	}
+
+	return req, nil
+}
+
+// SetWeights updates the weights of the given instances and rebalances the
+// placement accordingly.
+func (h *SetWeightsHandler) SetWeights(
+	serviceName string,
+	httpReq *http.Request,
+	req *admin.PlacementSetWeightsRequest,
+) (placement.Placement, error) {
+	weights := make(map[string]uint32, len(req.Instances))
+	for _, instance := range req.Instances {
+		weights[instance.Id] = instance.Weight
+	}
+
+	serviceOpts := handler.NewServiceOptions(serviceName, httpReq.Header, h.M3AggServiceOptions)
+	serviceOpts.DryRun = isDryRun(httpReq, req.DryRun)
+	var validateFn placement.ValidateFn
+	if !req.Force {
+		validateFn = validateAllAvailable
+	}
+	service, _, err := ServiceWithAlgo(h.ClusterClient, serviceOpts, h.nowFn(), validateFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.SetInstanceWeights(weights)
+}
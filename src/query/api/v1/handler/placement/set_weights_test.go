@@ -0,0 +1,127 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	apihandler "github.com/m3db/m3/src/query/api/v1/handler"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSetWeightsRequest(body string) *http.Request {
+	return httptest.NewRequest(SetWeightsHTTPMethod, M3DBSetWeightsURL, strings.NewReader(body))
+}
+
+func TestPlacementSetWeightsHandler_InvalidRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		mockClient, _ = SetupPlacementTest(t, ctrl)
+		handlerOpts   = NewHandlerOptions(mockClient, config.Configuration{}, nil)
+		handler       = NewSetWeightsHandler(handlerOpts)
+	)
+
+	for _, input := range []struct {
+		body     string
+		expected string
+	}{
+		{body: `{"instances":[]}`, expected: `{"error":"no instance weights specified"}`},
+		{body: `{"instances":[{"weight": 2}]}`, expected: `{"error":"instance id must be specified"}`},
+		{body: `{"instances":[{"id": "A"}]}`, expected: `{"error":"weight for instance A must be positive"}`},
+	} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(apihandler.M3DBServiceName, w, newSetWeightsRequest(input.body))
+
+		resp := w.Result()
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, input.expected+"\n", string(body))
+	}
+}
+
+func TestPlacementSetWeightsHandler_Force(t *testing.T) {
+	runForAllAllowedServices(func(serviceName string) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			mockClient, mockPlacementService = SetupPlacementTest(t, ctrl)
+			handlerOpts                      = NewHandlerOptions(mockClient, config.Configuration{}, nil)
+			handler                          = NewSetWeightsHandler(handlerOpts)
+		)
+		handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+
+		w := httptest.NewRecorder()
+		mockPlacementService.EXPECT().
+			SetInstanceWeights(map[string]uint32{"A": 2}).
+			Return(nil, errors.New("test"))
+		handler.ServeHTTP(serviceName, w, newSetWeightsRequest(`{"force": true, "instances":[{"id": "A", "weight": 2}]}`))
+
+		resp := w.Result()
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, `{"error":"test"}`+"\n", string(body))
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		w = httptest.NewRecorder()
+		mockPlacementService.EXPECT().
+			SetInstanceWeights(map[string]uint32{"A": 2, "B": 3}).
+			Return(placement.NewPlacement(), nil)
+		handler.ServeHTTP(serviceName, w, newSetWeightsRequest(`{"force": true, "instances":[{"id": "A", "weight": 2}, {"id": "B", "weight": 3}]}`))
+
+		resp = w.Result()
+		body, _ = ioutil.ReadAll(resp.Body)
+		assert.Equal(t, `{"placement":{"instances":{},"replicaFactor":0,"numShards":0,"isSharded":false,"cutoverTime":"0","isMirrored":false,"maxShardSetId":0},"version":0}`, string(body))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestPlacementSetWeightsHandler_SafeErr_NotAllAvailable(t *testing.T) {
+	runForAllAllowedServices(func(serviceName string) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			mockClient  = setupPlacementTest(t, ctrl, newValidInitPlacement())
+			handlerOpts = NewHandlerOptions(mockClient, config.Configuration{}, nil)
+			handler     = NewSetWeightsHandler(handlerOpts)
+		)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(serviceName, w, newSetWeightsRequest(`{"instances":[{"id": "A", "weight": 2}]}`))
+
+		resp := w.Result()
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, `{"error":"instances [A,B] do not have all shards available"}`+"\n", string(body))
+	})
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/util"
+	"github.com/m3db/m3/src/query/util/json"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// CardinalityURL is the url for analysing series cardinality.
+	CardinalityURL = handler.RoutePrefixV1 + "/cardinality"
+
+	// CardinalityHTTPMethod is the HTTP method used with this resource.
+	CardinalityHTTPMethod = http.MethodGet
+
+	defaultCardinalityLimit = 10
+	defaultCardinalityRange = time.Hour
+
+	cardinalityNamespaceParam = "namespace"
+	cardinalityLimitParam     = "limit"
+)
+
+// CardinalityHandler represents a handler for the cardinality endpoint,
+// which returns the metric names, label names and label value pairs with the
+// most series in a namespace.
+type CardinalityHandler struct {
+	clusters   m3.Clusters
+	tagOptions models.TagOptions
+	nowFn      clock.NowFn
+}
+
+// NewCardinalityHandler returns a new instance of handler.
+func NewCardinalityHandler(
+	clusters m3.Clusters,
+	tagOptions models.TagOptions,
+	nowFn clock.NowFn,
+) http.Handler {
+	return &CardinalityHandler{
+		clusters:   clusters,
+		tagOptions: tagOptions,
+		nowFn:      nowFn,
+	}
+}
+
+func (h *CardinalityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx)
+	w.Header().Set("Content-Type", "application/json")
+
+	namespace, opts, rErr := h.parseRequest(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	result, err := namespace.Session().Cardinality(namespace.NamespaceID(), opts)
+	if err != nil {
+		logger.Error("unable to analyse cardinality", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	renderCardinalityResultJSON(w, result)
+}
+
+func (h *CardinalityHandler) parseRequest(
+	r *http.Request,
+) (m3.ClusterNamespace, index.CardinalityOptions, *xhttp.ParseError) {
+	end := h.nowFn()
+	if str := r.FormValue(endParam); str != "" {
+		t, err := util.ParseTimeString(str)
+		if err != nil {
+			return nil, index.CardinalityOptions{}, xhttp.NewParseError(
+				fmt.Errorf(formatErrStr, endParam, err), http.StatusBadRequest)
+		}
+		end = t
+	}
+
+	start := end.Add(-defaultCardinalityRange)
+	if str := r.FormValue(startParam); str != "" {
+		t, err := util.ParseTimeString(str)
+		if err != nil {
+			return nil, index.CardinalityOptions{}, xhttp.NewParseError(
+				fmt.Errorf(formatErrStr, startParam, err), http.StatusBadRequest)
+		}
+		start = t
+	}
+
+	if !start.Before(end) {
+		return nil, index.CardinalityOptions{}, xhttp.NewParseError(
+			fmt.Errorf("start %v must be before end %v", start, end),
+			http.StatusBadRequest)
+	}
+
+	limit := defaultCardinalityLimit
+	if str := r.FormValue(cardinalityLimitParam); str != "" {
+		n, err := strconv.Atoi(str)
+		if err != nil || n <= 0 {
+			return nil, index.CardinalityOptions{}, xhttp.NewParseError(
+				fmt.Errorf(formatErrStr, cardinalityLimitParam,
+					"must be a positive integer"), http.StatusBadRequest)
+		}
+		limit = n
+	}
+
+	namespace, err := h.namespace(r.FormValue(cardinalityNamespaceParam))
+	if err != nil {
+		return nil, index.CardinalityOptions{}, xhttp.NewParseError(
+			err, http.StatusBadRequest)
+	}
+
+	return namespace, index.CardinalityOptions{
+		StartInclusive:  start,
+		EndExclusive:    end,
+		Limit:           limit,
+		MetricNameField: h.tagOptions.MetricName(),
+	}, nil
+}
+
+func (h *CardinalityHandler) namespace(name string) (m3.ClusterNamespace, error) {
+	if name == "" {
+		return h.clusters.UnaggregatedClusterNamespace(), nil
+	}
+
+	id := ident.StringID(name)
+	for _, ns := range h.clusters.ClusterNamespaces() {
+		if ns.NamespaceID().Equal(id) {
+			return ns, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown namespace: %s", name)
+}
+
+func renderCardinalityResultJSON(w io.Writer, result index.CardinalityResult) {
+	jw := json.NewWriter(w)
+	jw.BeginObject()
+
+	jw.BeginObjectField("status")
+	jw.WriteString("success")
+
+	jw.BeginObjectField("data")
+	jw.BeginObject()
+
+	jw.BeginObjectField("numSeries")
+	jw.WriteInt(int(result.NumSeries))
+
+	renderCardinalityEntriesJSON(jw, "metricNames", result.MetricNames, false)
+	renderCardinalityEntriesJSON(jw, "labelNames", result.LabelNames, false)
+	renderCardinalityEntriesJSON(jw, "labelValuePairs", result.LabelValuePairs, true)
+
+	jw.EndObject()
+
+	jw.EndObject()
+	jw.Close()
+}
+
+func renderCardinalityEntriesJSON(
+	jw *json.Writer,
+	field string,
+	entries []index.CardinalityEntry,
+	withValue bool,
+) {
+	jw.BeginObjectField(field)
+	jw.BeginArray()
+	for _, entry := range entries {
+		jw.BeginObject()
+		jw.BeginObjectField("name")
+		jw.WriteString(string(entry.Name))
+		if withValue {
+			jw.BeginObjectField("value")
+			jw.WriteString(string(entry.Value))
+		}
+		jw.BeginObjectField("numSeries")
+		jw.WriteInt(int(entry.NumSeries))
+		jw.EndObject()
+	}
+	jw.EndArray()
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCardinalityHandler(
+	t *testing.T,
+	ctrl *gomock.Controller,
+	now time.Time,
+) (http.Handler, *client.MockSession) {
+	session := client.NewMockSession(ctrl)
+	clusters, err := m3.NewClusters(m3.UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("metrics"),
+		Session:     session,
+		Retention:   24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	nowFn := func() time.Time {
+		return now
+	}
+	return NewCardinalityHandler(clusters, models.NewTagOptions(), nowFn), session
+}
+
+func TestCardinality(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().Truncate(time.Second)
+	handler, session := newTestCardinalityHandler(t, ctrl, now)
+
+	result := index.CardinalityResult{
+		NumSeries: 30,
+		MetricNames: []index.CardinalityEntry{
+			{Name: b("http_requests"), NumSeries: 20},
+			{Name: b("up"), NumSeries: 10},
+		},
+		LabelNames: []index.CardinalityEntry{
+			{Name: b("__name__"), NumSeries: 30},
+		},
+		LabelValuePairs: []index.CardinalityEntry{
+			{Name: b("__name__"), Value: b("http_requests"), NumSeries: 20},
+		},
+	}
+	session.EXPECT().
+		Cardinality(ident.NewIDMatcher("metrics"), gomock.Any()).
+		DoAndReturn(func(_ ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+			require.True(t, now.Add(-time.Hour).Equal(opts.StartInclusive))
+			require.True(t, now.Equal(opts.EndExclusive))
+			require.Equal(t, 2, opts.Limit)
+			require.Equal(t, "__name__", string(opts.MetricNameField))
+			return result, nil
+		})
+
+	req := httptest.NewRequest(CardinalityHTTPMethod, CardinalityURL+"?limit=2", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+	body := w.Result().Body
+	defer body.Close()
+
+	r, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+
+	ex := `{"status":"success","data":{"numSeries":30,` +
+		`"metricNames":[{"name":"http_requests","numSeries":20},{"name":"up","numSeries":10}],` +
+		`"labelNames":[{"name":"__name__","numSeries":30}],` +
+		`"labelValuePairs":[{"name":"__name__","value":"http_requests","numSeries":20}]}}`
+	require.Equal(t, ex, string(r))
+}
+
+func TestCardinalityBadRequest(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, _ := newTestCardinalityHandler(t, ctrl, time.Now())
+	for _, query := range []string{
+		"?limit=-1",
+		"?limit=foo",
+		"?namespace=unknown",
+		"?start=2000&end=1000",
+	} {
+		req := httptest.NewRequest(CardinalityHTTPMethod, CardinalityURL+query, nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode, query)
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/query/errors"
 	"github.com/m3db/m3/src/query/functions/utils"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util"
 	"github.com/m3db/m3/src/query/util/json"
@@ -245,6 +246,7 @@ func renderResultsJSON(
 	series []*ts.Series,
 	params models.RequestParams,
 	keepNans bool,
+	warnings []storage.Warning,
 ) {
 	jw := json.NewWriter(w)
 	jw.BeginObject()
@@ -309,6 +311,8 @@ func renderResultsJSON(
 
 	jw.EndObject()
 
+	renderWarnings(jw, warnings)
+
 	jw.EndObject()
 	jw.Close()
 }
@@ -316,6 +320,7 @@ func renderResultsJSON(
 func renderResultsInstantaneousJSON(
 	w io.Writer,
 	series []*ts.Series,
+	warnings []storage.Warning,
 ) {
 	jw := json.NewWriter(w)
 	jw.BeginObject()
@@ -355,10 +360,28 @@ func renderResultsInstantaneousJSON(
 
 	jw.EndObject()
 
+	renderWarnings(jw, warnings)
+
 	jw.EndObject()
 	jw.Close()
 }
 
+// renderWarnings writes the warnings raised while serving the read, e.g. for
+// storages skipped when returning partial results, the same way Prometheus
+// does. The field is omitted when there are no warnings.
+func renderWarnings(jw *json.Writer, warnings []storage.Warning) {
+	if len(warnings) == 0 {
+		return
+	}
+
+	jw.BeginObjectField("warnings")
+	jw.BeginArray()
+	for _, warning := range warnings {
+		jw.WriteString(warning.String())
+	}
+	jw.EndArray()
+}
+
 func renderM3QLResultsJSON(
 	w io.Writer,
 	series []*ts.Series,
@@ -32,6 +32,7 @@ import (
 
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/test"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util/logging"
@@ -221,7 +222,7 @@ func TestRenderResultsJSON(t *testing.T) {
 			})),
 	}
 
-	renderResultsJSON(buffer, series, params, true)
+	renderResultsJSON(buffer, series, params, true, nil)
 
 	expected := mustPrettyJSON(t, `
 	{
@@ -314,7 +315,7 @@ func TestRenderResultsJSONWithDroppedNaNs(t *testing.T) {
 			})),
 	}
 
-	renderResultsJSON(buffer, series, params, false)
+	renderResultsJSON(buffer, series, params, false, nil)
 
 	expected := mustPrettyJSON(t, `
 	{
@@ -385,7 +386,7 @@ func TestRenderInstantaneousResultsJSON(t *testing.T) {
 			})),
 	}
 
-	renderResultsInstantaneousJSON(buffer, series)
+	renderResultsInstantaneousJSON(buffer, series, nil)
 
 	expected := mustPrettyJSON(t, `
 	{
@@ -421,6 +422,46 @@ func TestRenderInstantaneousResultsJSON(t *testing.T) {
 	assert.Equal(t, expected, actual, xtest.Diff(expected, actual))
 }
 
+func TestRenderInstantaneousResultsJSONWithWarnings(t *testing.T) {
+	start := time.Unix(1535948880, 0)
+	buffer := bytes.NewBuffer(nil)
+	series := []*ts.Series{
+		ts.NewSeries([]byte("foo"),
+			ts.NewFixedStepValues(10*time.Second, 1, 1, start), test.TagSliceToTags([]models.Tag{
+				models.Tag{Name: []byte("bar"), Value: []byte("baz")},
+			})),
+	}
+
+	renderResultsInstantaneousJSON(buffer, series, []storage.Warning{
+		{Name: "remote", Message: "context deadline exceeded"},
+	})
+
+	expected := mustPrettyJSON(t, `
+	{
+		"status": "success",
+		"data": {
+			"resultType": "vector",
+			"result": [
+				{
+					"metric": {
+						"bar": "baz"
+					},
+					"value": [
+						1535948880,
+						"1"
+					]
+				}
+			]
+		},
+		"warnings": [
+			"remote: context deadline exceeded"
+		]
+	}
+	`)
+	actual := mustPrettyJSON(t, buffer.String())
+	assert.Equal(t, expected, actual, xtest.Diff(expected, actual))
+}
+
 func mustPrettyJSON(t *testing.T, str string) string {
 	var unmarshalled map[string]interface{}
 	err := json.Unmarshal([]byte(str), &unmarshalled)
@@ -29,12 +29,15 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/cache"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util/httperrors"
 	"github.com/m3db/m3/src/query/util/logging"
 	opentracingutil "github.com/m3db/m3/src/query/util/opentracing"
+	xerrors "github.com/m3db/m3/src/x/errors"
 
 	opentracingext "github.com/opentracing/opentracing-go/ext"
 	opentracinglog "github.com/opentracing/opentracing-go/log"
@@ -67,6 +70,7 @@ type PromReadHandler struct {
 	promReadMetrics promReadMetrics
 	timeoutOps      *prometheus.TimeoutOpts
 	keepNans        bool
+	resultsCache    *cache.ResultsCache
 }
 
 type promReadMetrics struct {
@@ -111,6 +115,7 @@ func NewPromReadHandler(
 	scope tally.Scope,
 	timeoutOpts *prometheus.TimeoutOpts,
 	keepNans bool,
+	resultsCache *cache.ResultsCache,
 ) *PromReadHandler {
 	h := &PromReadHandler{
 		engine:          engine,
@@ -119,6 +124,7 @@ func NewPromReadHandler(
 		promReadMetrics: newPromReadMetrics(scope),
 		timeoutOps:      timeoutOpts,
 		keepNans:        keepNans,
+		resultsCache:    resultsCache,
 	}
 
 	h.promReadMetrics.maxDatapoints.Update(float64(limitsCfg.MaxComputedDatapoints()))
@@ -128,12 +134,16 @@ func NewPromReadHandler(
 func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	timer := h.promReadMetrics.fetchTimerSuccess.Start()
 
+	ctx, warnings := storage.NewContextWithWarnings(r.Context())
+	r = r.WithContext(ctx)
+
 	result, params, respErr := h.ServeHTTPWithEngine(w, r, h.engine)
 	if respErr != nil {
 		httperrors.ErrorWithReqInfo(w, r, respErr.Code, respErr.Err)
 		return
 	}
 
+	handler.AddWarningsHeader(w, warnings.All())
 	w.Header().Set("Content-Type", "application/json")
 	if params.FormatType == models.FormatM3QL {
 		renderM3QLResultsJSON(w, result, params)
@@ -145,7 +155,7 @@ func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.promReadMetrics.fetchSuccess.Inc(1)
 	timer.Stop()
 	// TODO: Support multiple result types
-	renderResultsJSON(w, result, params, h.keepNans)
+	renderResultsJSON(w, result, params, h.keepNans, warnings.All())
 }
 
 // ServeHTTPWithEngine returns query results from the storage
@@ -171,12 +181,32 @@ func (h *PromReadHandler) ServeHTTPWithEngine(
 		return nil, emptyReqParams, &RespError{Err: err, Code: http.StatusBadRequest}
 	}
 
-	result, err := read(ctx, engine, h.tagOpts, w, params)
+	fetch := func(
+		ctx context.Context,
+		params models.RequestParams,
+	) ([]*ts.Series, error) {
+		return read(ctx, engine, h.tagOpts, w, params)
+	}
+
+	var (
+		result []*ts.Series
+		err    error
+	)
+	if h.resultsCache != nil {
+		result, err = h.resultsCache.FetchRange(ctx, params, fetch)
+	} else {
+		result, err = fetch(ctx, params)
+	}
 	if err != nil {
 		sp := opentracingutil.SpanFromContextOrNoop(ctx)
 		sp.LogFields(opentracinglog.Error(err))
 		opentracingext.Error.Set(sp, true)
 		logger.Error("unable to fetch data", zap.Error(err))
+		if xerrors.IsResourceExhausted(err) {
+			h.promReadMetrics.fetchErrorsClient.Inc(1)
+			return nil, emptyReqParams, &RespError{Err: err, Code: http.StatusTooManyRequests}
+		}
+
 		h.promReadMetrics.fetchErrorsServer.Inc(1)
 		return nil, emptyReqParams, &RespError{Err: err, Code: http.StatusInternalServerError}
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/util/httperrors"
 	"github.com/m3db/m3/src/query/util/logging"
 
@@ -65,7 +66,8 @@ func NewPromReadInstantHandler(
 }
 
 func (h *PromReadInstantHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	ctx, warnings := storage.NewContextWithWarnings(r.Context())
+	ctx = context.WithValue(ctx, handler.HeaderKey, r.Header)
 	logger := logging.WithContext(ctx)
 	params, rErr := parseInstantaneousParams(r, h.timeoutOpts)
 	if rErr != nil {
@@ -85,6 +87,7 @@ func (h *PromReadInstantHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 	}
 
 	// TODO: Support multiple result types
+	handler.AddWarningsHeader(w, warnings.All())
 	w.Header().Set("Content-Type", "application/json")
-	renderResultsInstantaneousJSON(w, result)
+	renderResultsInstantaneousJSON(w, result, warnings.All())
 }
@@ -132,6 +132,7 @@ func newTestSetup() *testSetup {
 			tally.NewTestScope("", nil),
 			timeoutOpts,
 			false,
+			nil,
 		),
 	}
 }
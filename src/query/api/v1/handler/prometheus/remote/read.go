@@ -30,8 +30,11 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	xhttp "github.com/m3db/m3/src/x/net/http"
 
 	"github.com/golang/protobuf/proto"
@@ -51,14 +54,31 @@ const (
 // PromReadHandler represents a handler for prometheus read endpoint.
 type PromReadHandler struct {
 	engine          *executor.Engine
+	querier         m3.Querier
+	clusters        m3.Clusters
+	tagOptions      models.TagOptions
 	promReadMetrics promReadMetrics
 	timeoutOpts     *prometheus.TimeoutOpts
 }
 
-// NewPromReadHandler returns a new instance of handler.
-func NewPromReadHandler(engine *executor.Engine, scope tally.Scope, timeoutOpts *prometheus.TimeoutOpts) http.Handler {
+// NewPromReadHandler returns a new instance of handler. If querier is not nil
+// streamed responses are re-encoded directly from the compressed series it
+// fetches, otherwise they are encoded from the series fetched by the engine.
+// The clusters determine which namespaces store series of histograms, which
+// are expanded to one series per bucket, and may be nil.
+func NewPromReadHandler(
+	engine *executor.Engine,
+	querier m3.Querier,
+	clusters m3.Clusters,
+	tagOptions models.TagOptions,
+	scope tally.Scope,
+	timeoutOpts *prometheus.TimeoutOpts,
+) http.Handler {
 	return &PromReadHandler{
 		engine:          engine,
+		querier:         querier,
+		clusters:        clusters,
+		tagOptions:      tagOptions,
 		promReadMetrics: newPromReadMetrics(scope),
 		timeoutOpts:     timeoutOpts,
 	}
@@ -79,7 +99,8 @@ func newPromReadMetrics(scope tally.Scope) promReadMetrics {
 }
 
 func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	ctx, warnings := storage.NewContextWithWarnings(r.Context())
+	ctx = context.WithValue(ctx, handler.HeaderKey, r.Header)
 
 	logger := logging.WithContext(ctx)
 
@@ -97,8 +118,26 @@ func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	responseType, err := negotiateResponseType(req.AcceptedResponseTypes)
+	if err != nil {
+		h.promReadMetrics.fetchErrorsClient.Inc(1)
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if responseType == prompb.ReadRequest_STREAMED_XOR_CHUNKS {
+		h.serveStreamed(ctx, w, req, timeout)
+		return
+	}
+
 	result, err := h.read(ctx, w, req, timeout)
 	if err != nil {
+		if xerrors.IsResourceExhausted(err) {
+			h.promReadMetrics.fetchErrorsClient.Inc(1)
+			xhttp.Error(w, err, http.StatusTooManyRequests)
+			return
+		}
+
 		h.promReadMetrics.fetchErrorsServer.Inc(1)
 		logger.Error("unable to fetch data", zap.Error(err))
 		xhttp.Error(w, err, http.StatusInternalServerError)
@@ -117,6 +156,7 @@ func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	handler.AddWarningsHeader(w, warnings.All())
 	w.Header().Set("Content-Type", "application/x-protobuf")
 	w.Header().Set("Content-Encoding", "snappy")
 
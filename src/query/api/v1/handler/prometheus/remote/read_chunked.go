@@ -0,0 +1,457 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/prometheus/tsdb/chunkenc"
+	"go.uber.org/zap"
+)
+
+const (
+	// streamedContentType is the content type of STREAMED_XOR_CHUNKS responses.
+	streamedContentType = "application/x-streamed-protobuf; proto=prometheus.ChunkedReadResponse"
+
+	// maxSamplesPerChunk is the number of samples after which a chunk is cut,
+	// this matches the number of samples per chunk in the Prometheus TSDB.
+	maxSamplesPerChunk = 120
+
+	// maxBytesInFrame is the soft limit on the size of the chunk data in a
+	// single frame, series larger than this are split across several frames.
+	maxBytesInFrame = 1024 * 1024
+)
+
+var (
+	castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+	errStreamingUnsupported = errors.New("response writer does not support streaming")
+)
+
+// negotiateResponseType returns the first response type accepted by the
+// client that is supported, defaulting to SAMPLES if the client accepts none.
+func negotiateResponseType(
+	accepted []prompb.ReadRequest_ResponseType,
+) (prompb.ReadRequest_ResponseType, error) {
+	if len(accepted) == 0 {
+		return prompb.ReadRequest_SAMPLES, nil
+	}
+
+	for _, responseType := range accepted {
+		switch responseType {
+		case prompb.ReadRequest_SAMPLES, prompb.ReadRequest_STREAMED_XOR_CHUNKS:
+			return responseType, nil
+		}
+	}
+
+	return 0, fmt.Errorf("none of the accepted response types are supported: %v",
+		accepted)
+}
+
+// sampleIterator iterates over the samples of a single series.
+type sampleIterator interface {
+	Next() bool
+	Current() (int64, float64)
+	Err() error
+}
+
+type seriesIteratorSamples struct {
+	iter encoding.SeriesIterator
+}
+
+func (s seriesIteratorSamples) Next() bool {
+	return s.iter.Next()
+}
+
+func (s seriesIteratorSamples) Current() (int64, float64) {
+	dp, _, _ := s.iter.Current()
+	return storage.TimeToTimestamp(dp.Timestamp), dp.Value
+}
+
+func (s seriesIteratorSamples) Err() error {
+	return s.iter.Err()
+}
+
+type datapointSamples struct {
+	datapoints ts.Datapoints
+	idx        int
+}
+
+func (s *datapointSamples) Next() bool {
+	s.idx++
+	return s.idx < len(s.datapoints)
+}
+
+func (s *datapointSamples) Current() (int64, float64) {
+	dp := s.datapoints[s.idx]
+	return storage.TimeToTimestamp(dp.Timestamp), dp.Value
+}
+
+func (s *datapointSamples) Err() error {
+	return nil
+}
+
+type chunkedSeries struct {
+	labels  []*prompb.Label
+	samples sampleIterator
+}
+
+func fetchResultToChunkedSeries(result *storage.FetchResult) []chunkedSeries {
+	series := make([]chunkedSeries, 0, len(result.SeriesList))
+	for _, s := range result.SeriesList {
+		series = append(series, chunkedSeries{
+			labels: storage.TagsToPromLabels(s.Tags),
+			samples: &datapointSamples{
+				datapoints: s.Values().Datapoints(),
+				idx:        -1,
+			},
+		})
+	}
+
+	return series
+}
+
+// sortChunkedSeries sorts series by their labels, since clients merge
+// streamed series sets on the assumption that they are sorted.
+func sortChunkedSeries(series []chunkedSeries) {
+	sort.Slice(series, func(i, j int) bool {
+		return compareLabels(series[i].labels, series[j].labels) < 0
+	})
+}
+
+func compareLabels(a, b []*prompb.Label) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := bytes.Compare(a[i].Name, b[i].Name); c != 0 {
+			return c
+		}
+		if c := bytes.Compare(a[i].Value, b[i].Value); c != 0 {
+			return c
+		}
+	}
+
+	return len(a) - len(b)
+}
+
+// chunkedWriter writes a STREAMED_XOR_CHUNKS response, each frame is a
+// varint length prefixed ChunkedReadResponse followed by the big endian
+// CRC32 Castagnoli checksum of the message and is flushed once written.
+type chunkedWriter struct {
+	w        http.ResponseWriter
+	flusher  http.Flusher
+	warnings *storage.Warnings
+	buf      []byte
+	written  bool
+}
+
+func newChunkedWriter(
+	w http.ResponseWriter,
+	flusher http.Flusher,
+	warnings *storage.Warnings,
+) *chunkedWriter {
+	return &chunkedWriter{w: w, flusher: flusher, warnings: warnings}
+}
+
+// writeHeaders sets the headers of the response, the warnings header only
+// includes the warnings raised before the first frame is written since the
+// headers are sent along with it.
+func (w *chunkedWriter) writeHeaders() {
+	handler.AddWarningsHeader(w.w, w.warnings.All())
+	w.w.Header().Set("Content-Type", streamedContentType)
+}
+
+func (w *chunkedWriter) writeFrame(resp *prompb.ChunkedReadResponse) error {
+	size := resp.Size()
+	frameSize := binary.MaxVarintLen64 + size + crc32.Size
+	if cap(w.buf) < frameSize {
+		w.buf = make([]byte, frameSize)
+	}
+
+	buf := w.buf[:frameSize]
+	n := binary.PutUvarint(buf, uint64(size))
+	m, err := resp.MarshalTo(buf[n:])
+	if err != nil {
+		return err
+	}
+
+	checksum := crc32.Checksum(buf[n:n+m], castagnoliTable)
+	binary.BigEndian.PutUint32(buf[n+m:], checksum)
+
+	if !w.written {
+		w.writeHeaders()
+		w.written = true
+	}
+
+	if _, err := w.w.Write(buf[:n+m+crc32.Size]); err != nil {
+		return err
+	}
+
+	w.flusher.Flush()
+	return nil
+}
+
+// writeSeries streams each series as XOR encoded chunks, re-encoding the
+// samples as they are read so that at most one frame is held in memory.
+func (w *chunkedWriter) writeSeries(queryIndex int64, series []chunkedSeries) error {
+	sortChunkedSeries(series)
+	for _, s := range series {
+		if err := w.writeSingleSeries(queryIndex, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *chunkedWriter) writeSingleSeries(queryIndex int64, series chunkedSeries) error {
+	var (
+		chunks     []*prompb.Chunk
+		frameBytes int
+		chunk      *chunkenc.XORChunk
+		appender   chunkenc.Appender
+		minTime    int64
+		maxTime    int64
+		err        error
+	)
+
+	writeChunks := func() error {
+		return w.writeFrame(&prompb.ChunkedReadResponse{
+			ChunkedSeries: []*prompb.ChunkedSeries{
+				&prompb.ChunkedSeries{
+					Labels: series.labels,
+					Chunks: chunks,
+				},
+			},
+			QueryIndex: queryIndex,
+		})
+	}
+
+	cutChunk := func() {
+		chunks = append(chunks, &prompb.Chunk{
+			MinTimeMs: minTime,
+			MaxTimeMs: maxTime,
+			Type:      prompb.Chunk_XOR,
+			Data:      chunk.Bytes(),
+		})
+		frameBytes += len(chunk.Bytes())
+		chunk = nil
+	}
+
+	for series.samples.Next() {
+		t, v := series.samples.Current()
+		if chunk == nil {
+			chunk = chunkenc.NewXORChunk()
+			if appender, err = chunk.Appender(); err != nil {
+				return err
+			}
+			minTime = t
+		}
+
+		appender.Append(t, v)
+		maxTime = t
+		if chunk.NumSamples() < maxSamplesPerChunk {
+			continue
+		}
+
+		cutChunk()
+		if frameBytes < maxBytesInFrame {
+			continue
+		}
+
+		if err := writeChunks(); err != nil {
+			return err
+		}
+
+		chunks, frameBytes = nil, 0
+	}
+
+	if err := series.samples.Err(); err != nil {
+		return err
+	}
+
+	if chunk != nil {
+		cutChunk()
+	}
+
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	return writeChunks()
+}
+
+func (h *PromReadHandler) serveStreamed(
+	ctx context.Context,
+	w http.ResponseWriter,
+	r *prompb.ReadRequest,
+	timeout time.Duration,
+) {
+	logger := logging.WithContext(ctx)
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.promReadMetrics.fetchErrorsServer.Inc(1)
+		xhttp.Error(w, errStreamingUnsupported, http.StatusInternalServerError)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	// Detect clients closing connections
+	handler.CloseWatcher(ctx, cancel, w)
+
+	writer := newChunkedWriter(w, flusher, storage.WarningsFromContext(ctx))
+	for i, query := range r.Queries {
+		err := h.streamQuery(ctx, writer, int64(i), query)
+		if err == nil {
+			continue
+		}
+
+		if writer.written {
+			// NB: the status has already been sent, so the client can only
+			// detect the failure by the stream ending with a partial frame.
+			h.promReadMetrics.fetchErrorsServer.Inc(1)
+			logger.Error("unable to stream read results", zap.Error(err))
+			return
+		}
+
+		if xerrors.IsResourceExhausted(err) {
+			h.promReadMetrics.fetchErrorsClient.Inc(1)
+			xhttp.Error(w, err, http.StatusTooManyRequests)
+			return
+		}
+
+		h.promReadMetrics.fetchErrorsServer.Inc(1)
+		logger.Error("unable to fetch data", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	if !writer.written {
+		writer.writeHeaders()
+	}
+
+	h.promReadMetrics.fetchSuccess.Inc(1)
+}
+
+// streamQuery streams the results of a single query, re-encoding compressed
+// series straight from their iterators when a compressed querier is set.
+func (h *PromReadHandler) streamQuery(
+	ctx context.Context,
+	writer *chunkedWriter,
+	queryIndex int64,
+	promQuery *prompb.Query,
+) error {
+	query, err := storage.PromReadQueryToM3(promQuery)
+	if err != nil {
+		return err
+	}
+
+	if h.querier == nil {
+		return h.streamDecodedQuery(ctx, writer, queryIndex, query)
+	}
+
+	iters, cleanup, err := h.querier.FetchCompressed(ctx, query,
+		storage.NewFetchOptions())
+	if err != nil {
+		return err
+	}
+
+	defer cleanup()
+
+	// Series of histograms expand to one series per bucket which is only
+	// supported by the decoded path.
+	if m3.PeekHistogramSeriesIters(iters, h.clusters) {
+		result, err := storage.SeriesIteratorsToFetchResult(iters, nil, false,
+			cost.NoopChainedEnforcer(), h.tagOptions)
+		if err != nil {
+			return err
+		}
+
+		return writer.writeSeries(queryIndex, fetchResultToChunkedSeries(result))
+	}
+
+	series := make([]chunkedSeries, 0, iters.Len())
+	for _, iter := range iters.Iters() {
+		tags, err := storage.FromIdentTagIteratorToTags(iter.Tags(), h.tagOptions)
+		if err != nil {
+			return err
+		}
+
+		series = append(series, chunkedSeries{
+			labels:  storage.TagsToPromLabels(tags),
+			samples: seriesIteratorSamples{iter: iter},
+		})
+	}
+
+	return writer.writeSeries(queryIndex, series)
+}
+
+func (h *PromReadHandler) streamDecodedQuery(
+	ctx context.Context,
+	writer *chunkedWriter,
+	queryIndex int64,
+	query *storage.FetchQuery,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	// Results is closed by execute
+	results := make(chan *storage.QueryResult)
+	go h.engine.Execute(ctx, query, &executor.EngineOptions{}, results)
+
+	// NB: on error the remaining results are drained so that execute does
+	// not block sending to the channel once the query is cancelled.
+	defer func() {
+		cancel()
+		for range results {
+		}
+	}()
+
+	for result := range results {
+		if result.Err != nil {
+			return result.Err
+		}
+
+		series := fetchResultToChunkedSeries(result.FetchResult)
+		if err := writer.writeSeries(queryIndex, series); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
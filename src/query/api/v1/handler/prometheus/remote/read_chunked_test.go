@@ -0,0 +1,303 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	dbts "github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/remote/test"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/prometheus/tsdb/chunkenc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type testSample struct {
+	t int64
+	v float64
+}
+
+// testSeriesIter is a series iterator over a fixed set of samples, any
+// methods not used by the streamed read path panic.
+type testSeriesIter struct {
+	encoding.SeriesIterator
+
+	tags    ident.Tags
+	samples []testSample
+	idx     int
+}
+
+func newTestSeriesIter(tags ident.Tags, samples []testSample) *testSeriesIter {
+	return &testSeriesIter{tags: tags, samples: samples, idx: -1}
+}
+
+func (it *testSeriesIter) Tags() ident.TagIterator {
+	return ident.NewTagsIterator(it.tags)
+}
+
+func (it *testSeriesIter) Next() bool {
+	it.idx++
+	return it.idx < len(it.samples)
+}
+
+func (it *testSeriesIter) Current() (dbts.Datapoint, xtime.Unit, dbts.Annotation) {
+	s := it.samples[it.idx]
+	return dbts.Datapoint{
+		Timestamp: storage.TimestampToTime(s.t),
+		Value:     s.v,
+	}, xtime.Millisecond, nil
+}
+
+func (it *testSeriesIter) Err() error {
+	return nil
+}
+
+func (it *testSeriesIter) Close() {}
+
+type testQuerier struct {
+	iters encoding.SeriesIterators
+}
+
+func (q *testQuerier) FetchCompressed(
+	_ context.Context,
+	_ *storage.FetchQuery,
+	_ *storage.FetchOptions,
+) (encoding.SeriesIterators, m3.Cleanup, error) {
+	return q.iters, func() error { return nil }, nil
+}
+
+func (q *testQuerier) SearchCompressed(
+	_ context.Context,
+	_ *storage.FetchQuery,
+	_ *storage.FetchOptions,
+) ([]m3.MultiTagResult, m3.Cleanup, error) {
+	panic("not implemented")
+}
+
+func testSamples(start, n int) []testSample {
+	samples := make([]testSample, 0, n)
+	for i := start; i < start+n; i++ {
+		samples = append(samples, testSample{t: int64(i) * 1000, v: float64(i)})
+	}
+
+	return samples
+}
+
+func xorChunkData(t *testing.T, samples []testSample) []byte {
+	chunk := chunkenc.NewXORChunk()
+	app, err := chunk.Appender()
+	require.NoError(t, err)
+	for _, s := range samples {
+		app.Append(s.t, s.v)
+	}
+
+	return chunk.Bytes()
+}
+
+func readFrames(t *testing.T, body []byte) []*prompb.ChunkedReadResponse {
+	var frames []*prompb.ChunkedReadResponse
+	for len(body) > 0 {
+		size, n := binary.Uvarint(body)
+		require.True(t, n > 0)
+		body = body[n:]
+		require.True(t, len(body) >= int(size)+crc32.Size)
+
+		msg := body[:size]
+		checksum := binary.BigEndian.Uint32(body[size:])
+		require.Equal(t, crc32.Checksum(msg, castagnoliTable), checksum)
+
+		var frame prompb.ChunkedReadResponse
+		require.NoError(t, frame.Unmarshal(msg))
+		frames = append(frames, &frame)
+		body = body[int(size)+crc32.Size:]
+	}
+
+	return frames
+}
+
+func TestNegotiateResponseType(t *testing.T) {
+	responseType, err := negotiateResponseType(nil)
+	require.NoError(t, err)
+	assert.Equal(t, prompb.ReadRequest_SAMPLES, responseType)
+
+	responseType, err = negotiateResponseType([]prompb.ReadRequest_ResponseType{
+		prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+		prompb.ReadRequest_SAMPLES,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, prompb.ReadRequest_STREAMED_XOR_CHUNKS, responseType)
+
+	_, err = negotiateResponseType([]prompb.ReadRequest_ResponseType{5})
+	assert.Error(t, err)
+}
+
+func TestPromReadStreamedFromCompressedSeries(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	var (
+		fooSamples = testSamples(0, 250)
+		barSamples = testSamples(10, 3)
+	)
+	iters := encoding.NewSeriesIterators([]encoding.SeriesIterator{
+		newTestSeriesIter(ident.NewTags(
+			ident.StringTag("__name__", "foo"),
+			ident.StringTag("host", "a"),
+		), fooSamples),
+		newTestSeriesIter(ident.NewTags(
+			ident.StringTag("__name__", "bar"),
+		), barSamples),
+		newTestSeriesIter(ident.NewTags(
+			ident.StringTag("__name__", "baz"),
+		), nil),
+	}, nil)
+
+	promRead := &PromReadHandler{
+		querier:         &testQuerier{iters: iters},
+		tagOptions:      models.NewTagOptions(),
+		promReadMetrics: newPromReadMetrics(tally.NewTestScope("", nil)),
+		timeoutOpts:     timeoutOpts,
+	}
+
+	req := test.GeneratePromReadRequest()
+	req.AcceptedResponseTypes = []prompb.ReadRequest_ResponseType{
+		prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+	}
+	httpReq, err := http.NewRequest(PromReadHTTPMethod, PromReadURL,
+		test.GeneratePromReadRequestBody(t, req))
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	promRead.ServeHTTP(recorder, httpReq)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, streamedContentType, recorder.Header().Get("Content-Type"))
+
+	// Series are sorted by labels and series without samples are omitted.
+	frames := readFrames(t, recorder.Body.Bytes())
+	require.Equal(t, 2, len(frames))
+	for _, frame := range frames {
+		assert.Equal(t, int64(0), frame.QueryIndex)
+		require.Equal(t, 1, len(frame.ChunkedSeries))
+	}
+
+	bar := frames[0].ChunkedSeries[0]
+	assert.Equal(t, []*prompb.Label{
+		{Name: []byte("__name__"), Value: []byte("bar")},
+	}, bar.Labels)
+	assert.Equal(t, []*prompb.Chunk{
+		{
+			MinTimeMs: 10000,
+			MaxTimeMs: 12000,
+			Type:      prompb.Chunk_XOR,
+			Data:      xorChunkData(t, barSamples),
+		},
+	}, bar.Chunks)
+
+	foo := frames[1].ChunkedSeries[0]
+	assert.Equal(t, []*prompb.Label{
+		{Name: []byte("__name__"), Value: []byte("foo")},
+		{Name: []byte("host"), Value: []byte("a")},
+	}, foo.Labels)
+	assert.Equal(t, []*prompb.Chunk{
+		{
+			MinTimeMs: 0,
+			MaxTimeMs: 119000,
+			Type:      prompb.Chunk_XOR,
+			Data:      xorChunkData(t, fooSamples[:120]),
+		},
+		{
+			MinTimeMs: 120000,
+			MaxTimeMs: 239000,
+			Type:      prompb.Chunk_XOR,
+			Data:      xorChunkData(t, fooSamples[120:240]),
+		},
+		{
+			MinTimeMs: 240000,
+			MaxTimeMs: 249000,
+			Type:      prompb.Chunk_XOR,
+			Data:      xorChunkData(t, fooSamples[240:]),
+		},
+	}, foo.Chunks)
+}
+
+func TestChunkedWriterSplitsLargeSeriesAcrossFrames(t *testing.T) {
+	var (
+		recorder = httptest.NewRecorder()
+		writer   = newChunkedWriter(recorder, recorder, nil)
+		// Random values compress poorly enough to fill frames quickly.
+		numSamples = 200000
+		datapoints = make(ts.Datapoints, 0, numSamples)
+	)
+	for i := 0; i < numSamples; i++ {
+		datapoints = append(datapoints, ts.Datapoint{
+			Timestamp: time.Unix(int64(i), 0),
+			Value:     float64(i*7919%104729) / 7.0,
+		})
+	}
+
+	labels := []*prompb.Label{{Name: []byte("__name__"), Value: []byte("foo")}}
+	require.NoError(t, writer.writeSeries(3, []chunkedSeries{
+		{
+			labels:  labels,
+			samples: &datapointSamples{datapoints: datapoints, idx: -1},
+		},
+	}))
+
+	frames := readFrames(t, recorder.Body.Bytes())
+	require.True(t, len(frames) > 1)
+
+	var (
+		numChunks  int
+		lastMaxT   = int64(-1)
+		totalBytes int
+	)
+	for _, frame := range frames {
+		assert.Equal(t, int64(3), frame.QueryIndex)
+		require.Equal(t, 1, len(frame.ChunkedSeries))
+		assert.Equal(t, labels, frame.ChunkedSeries[0].Labels)
+		for _, chunk := range frame.ChunkedSeries[0].Chunks {
+			assert.True(t, chunk.MinTimeMs > lastMaxT)
+			lastMaxT = chunk.MaxTimeMs
+			totalBytes += len(chunk.Data)
+			numChunks++
+		}
+	}
+
+	assert.Equal(t, (numSamples+maxSamplesPerChunk-1)/maxSamplesPerChunk, numChunks)
+	assert.Equal(t, int64(numSamples-1)*1000, lastMaxT)
+	assert.True(t, totalBytes > maxBytesInFrame)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	xhttp "github.com/m3db/m3/src/x/net/http"
 	xtime "github.com/m3db/m3/src/x/time"
 
@@ -101,6 +102,12 @@ func (h *PromWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	err := h.write(r.Context(), req)
 	if err != nil {
+		if xerrors.IsResourceExhausted(err) {
+			h.promWriteMetrics.writeErrorsClient.Inc(1)
+			xhttp.Error(w, err, http.StatusTooManyRequests)
+			return
+		}
+
 		h.promWriteMetrics.writeErrorsServer.Inc(1)
 		logger := logging.WithContext(r.Context())
 		logger.Error("write error",
@@ -308,6 +308,7 @@ func newServer() (*httptest.Server, *PromDebugHandler) {
 			tally.NewTestScope("test", nil),
 			timeoutOpts,
 			true,
+			nil,
 		), tally.NewTestScope("test", nil),
 		defaultLookbackDuration,
 	)
@@ -35,6 +35,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/database"
 	"github.com/m3db/m3/src/query/api/v1/handler/graphite"
+	"github.com/m3db/m3/src/query/api/v1/handler/influxdb"
 	m3json "github.com/m3db/m3/src/query/api/v1/handler/json"
 	"github.com/m3db/m3/src/query/api/v1/handler/namespace"
 	"github.com/m3db/m3/src/query/api/v1/handler/openapi"
@@ -47,9 +48,11 @@ import (
 	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/quota"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
 	xhttp "github.com/m3db/m3/src/x/net/http"
 	"github.com/m3db/m3/src/x/net/http/cors"
 
@@ -67,6 +70,7 @@ const (
 var (
 	remoteSource = map[string]string{"source": "remote"}
 	nativeSource = map[string]string{"source": "native"}
+	influxSource = map[string]string{"source": "influxdb"}
 
 	defaultTimeout = 30 * time.Second
 )
@@ -78,6 +82,7 @@ type Handler struct {
 	storage              storage.Storage
 	downsamplerAndWriter ingest.DownsamplerAndWriter
 	engine               *executor.Engine
+	querier              m3.Querier
 	clusters             m3.Clusters
 	clusterClient        clusterclient.Client
 	config               config.Configuration
@@ -94,11 +99,13 @@ func (h *Handler) Router() http.Handler {
 	return h.handler
 }
 
-// NewHandler returns a new instance of handler with routes.
+// NewHandler returns a new instance of handler with routes. The querier is
+// optional and used to serve compressed series where supported.
 func NewHandler(
 	downsamplerAndWriter ingest.DownsamplerAndWriter,
 	tagOptions models.TagOptions,
 	engine *executor.Engine,
+	querier m3.Querier,
 	m3dbClusters m3.Clusters,
 	clusterClient clusterclient.Client,
 	cfg config.Configuration,
@@ -109,6 +116,10 @@ func NewHandler(
 	r := mux.NewRouter()
 
 	handlerWithMiddleware := applyMiddleware(r, opentracing.GlobalTracer())
+	if cfg.Quotas.Enabled {
+		handlerWithMiddleware = quota.NewTenantHandler(
+			cfg.Quotas.TenantHeaderOrDefault(), handlerWithMiddleware)
+	}
 
 	var timeoutOpts = &prometheus.TimeoutOpts{}
 	if embeddedDbCfg == nil || embeddedDbCfg.Client.FetchTimeout == nil {
@@ -127,6 +138,7 @@ func NewHandler(
 		storage:              downsamplerAndWriter.Storage(),
 		downsamplerAndWriter: downsamplerAndWriter,
 		engine:               engine,
+		querier:              querier,
 		clusters:             m3dbClusters,
 		clusterClient:        clusterClient,
 		config:               cfg,
@@ -170,7 +182,8 @@ func (h *Handler) RegisterRoutes() error {
 	h.router.PathPrefix(openapi.StaticURLPrefix).Handler(wrapped(openapi.StaticHandler()))
 
 	// Prometheus remote read/write endpoints
-	promRemoteReadHandler := remote.NewPromReadHandler(h.engine, h.scope.Tagged(remoteSource), h.timeoutOpts)
+	promRemoteReadHandler := remote.NewPromReadHandler(h.engine, h.querier,
+		h.clusters, h.tagOptions, h.scope.Tagged(remoteSource), h.timeoutOpts)
 	promRemoteWriteHandler, err := remote.NewPromWriteHandler(
 		h.downsamplerAndWriter,
 		h.tagOptions,
@@ -180,6 +193,24 @@ func (h *Handler) RegisterRoutes() error {
 		return err
 	}
 
+	influxWriteHandler, err := influxdb.NewWriteHandler(
+		h.downsamplerAndWriter,
+		h.tagOptions,
+		nowFn,
+		h.scope.Tagged(influxSource),
+	)
+	if err != nil {
+		return err
+	}
+
+	resultsCache, err := h.config.ResultsCache.NewResultsCache(
+		h.tagOptions,
+		instrument.NewOptions().SetMetricsScope(h.scope.Tagged(nativeSource)),
+	)
+	if err != nil {
+		return err
+	}
+
 	nativePromReadHandler := native.NewPromReadHandler(
 		h.engine,
 		h.tagOptions,
@@ -187,6 +218,7 @@ func (h *Handler) RegisterRoutes() error {
 		h.scope.Tagged(nativeSource),
 		h.timeoutOpts,
 		h.config.ResultOptions.KeepNans,
+		resultsCache,
 	)
 
 	h.router.HandleFunc(remote.PromReadURL,
@@ -210,6 +242,11 @@ func (h *Handler) RegisterRoutes() error {
 		wrapped(m3json.NewWriteJSONHandler(h.storage)).ServeHTTP,
 	).Methods(m3json.JSONWriteHTTPMethod)
 
+	// InfluxDB line protocol write endpoint
+	h.router.HandleFunc(influxdb.InfluxWriteURL,
+		panicOnly(influxWriteHandler).ServeHTTP,
+	).Methods(influxdb.InfluxWriteHTTPMethod)
+
 	// Tag completion endpoints
 	h.router.HandleFunc(native.CompleteTagsURL,
 		wrapped(native.NewCompleteTagsHandler(h.storage)).ServeHTTP,
@@ -246,6 +283,13 @@ func (h *Handler) RegisterRoutes() error {
 		wrapped(graphite.NewFindHandler(h.storage)).ServeHTTP,
 	).Methods(graphite.FindHTTPMethods...)
 
+	// Cardinality analysis endpoint
+	if h.clusters != nil {
+		h.router.HandleFunc(native.CardinalityURL,
+			wrapped(native.NewCardinalityHandler(h.clusters, h.tagOptions, nowFn)).ServeHTTP,
+		).Methods(native.CardinalityHTTPMethod)
+	}
+
 	if h.clusterClient != nil {
 		placementOpts := placement.HandlerOptions{
 			ClusterClient:       h.clusterClient,
@@ -69,6 +69,7 @@ func setupHandler(store storage.Storage) (*Handler, error) {
 			time.Minute, nil),
 		nil,
 		nil,
+		nil,
 		config.Configuration{LookbackDuration: &defaultLookbackDuration},
 		nil,
 		nil,
@@ -87,7 +88,7 @@ func TestHandlerFetchTimeoutError(t *testing.T) {
 	engine := executor.NewEngine(storage, tally.NewTestScope("test", nil), time.Minute, nil)
 	cfg := config.Configuration{LookbackDuration: &defaultLookbackDuration}
 	_, err := NewHandler(downsamplerAndWriter, makeTagOptions(), engine, nil, nil,
-		cfg, dbconfig, nil, tally.NewTestScope("", nil))
+		nil, cfg, dbconfig, nil, tally.NewTestScope("", nil))
 
 	require.Error(t, err)
 }
@@ -104,7 +105,7 @@ func TestHandlerFetchTimeout(t *testing.T) {
 	engine := executor.NewEngine(storage, tally.NewTestScope("test", nil), time.Minute, nil)
 	cfg := config.Configuration{LookbackDuration: &defaultLookbackDuration}
 	h, err := NewHandler(downsamplerAndWriter, makeTagOptions(), engine,
-		nil, nil, cfg, dbconfig, nil, tally.NewTestScope("", nil))
+		nil, nil, nil, cfg, dbconfig, nil, tally.NewTestScope("", nil))
 	require.NoError(t, err)
 	assert.Equal(t, 4*time.Minute, h.timeoutOpts.FetchTimeout)
 }
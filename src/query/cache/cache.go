@@ -0,0 +1,351 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+// ResultsCache caches the results of range queries as time aligned extents,
+// a range query is split into extents of the configured size and only the
+// extents missing from the cache plus any unaligned head and tail are fetched
+// from storage. Extents ending within the max freshness of the request time
+// are never cached so that late arriving datapoints are not masked.
+type ResultsCache struct {
+	backend      Backend
+	extentSize   time.Duration
+	maxFreshness time.Duration
+	tagOpts      models.TagOptions
+	logger       *zap.Logger
+	metrics      resultsCacheMetrics
+}
+
+type resultsCacheMetrics struct {
+	bypass       tally.Counter
+	hits         tally.Counter
+	misses       tally.Counter
+	fetches      tally.Counter
+	encodeErrors tally.Counter
+	decodeErrors tally.Counter
+}
+
+func newResultsCacheMetrics(scope tally.Scope) resultsCacheMetrics {
+	return resultsCacheMetrics{
+		bypass:       scope.Counter("bypass"),
+		hits:         scope.Counter("extent-hits"),
+		misses:       scope.Counter("extent-misses"),
+		fetches:      scope.Counter("fetches"),
+		encodeErrors: scope.Counter("encode-errors"),
+		decodeErrors: scope.Counter("decode-errors"),
+	}
+}
+
+// NewResultsCache returns a new results cache.
+func NewResultsCache(opts Options) (*ResultsCache, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	iOpts := opts.InstrumentOptions()
+	return &ResultsCache{
+		backend:      opts.Backend(),
+		extentSize:   opts.ExtentSize(),
+		maxFreshness: opts.MaxFreshness(),
+		tagOpts:      opts.TagOptions(),
+		logger:       iOpts.Logger(),
+		metrics: newResultsCacheMetrics(
+			iOpts.MetricsScope().SubScope("results-cache")),
+	}, nil
+}
+
+// segment is a contiguous part of the requested range, either served from a
+// cached extent or fetched from storage.
+type segment struct {
+	start  time.Time
+	end    time.Time
+	extent bool
+	cached bool
+	series []*ts.Series
+}
+
+// FetchRange returns the results of the range query, serving completed
+// extents from the cache and fetching the remainder with the fetch function.
+func (c *ResultsCache) FetchRange(
+	ctx context.Context,
+	params models.RequestParams,
+	fetch FetchFn,
+) ([]*ts.Series, error) {
+	if !c.cacheable(params) {
+		c.metrics.bypass.Inc(1)
+		return fetch(ctx, params)
+	}
+
+	var (
+		start        = params.Start
+		end          = params.ExclusiveEnd()
+		firstExtent  = ceil(start, c.extentSize)
+		cacheableEnd = truncate(params.Now.Add(-c.maxFreshness), c.extentSize)
+	)
+	if end.Before(cacheableEnd) {
+		cacheableEnd = truncate(end, c.extentSize)
+	}
+	if firstExtent.Add(c.extentSize).After(cacheableEnd) {
+		// No complete extent can be cached for this range.
+		c.metrics.bypass.Inc(1)
+		return fetch(ctx, params)
+	}
+
+	var (
+		queryHash  = hashQuery(params.Query)
+		numExtents = int(cacheableEnd.Sub(firstExtent) / c.extentSize)
+		segments   = make([]segment, 0, numExtents+2)
+	)
+	if start.Before(firstExtent) {
+		segments = append(segments, segment{start: start, end: firstExtent})
+	}
+	for i := 0; i < numExtents; i++ {
+		extentStart := firstExtent.Add(time.Duration(i) * c.extentSize)
+		seg := segment{
+			start:  extentStart,
+			end:    extentStart.Add(c.extentSize),
+			extent: true,
+		}
+		if value, ok := c.backend.Get(c.key(queryHash, params.Step, extentStart)); ok {
+			series, err := c.decode(value, extentStart, params.Step)
+			if err == nil {
+				c.metrics.hits.Inc(1)
+				seg.cached = true
+				seg.series = series
+				segments = append(segments, seg)
+				continue
+			}
+			c.metrics.decodeErrors.Inc(1)
+			c.logger.Warn("unable to decode cached extent", zap.Error(err))
+		}
+		c.metrics.misses.Inc(1)
+		segments = append(segments, seg)
+	}
+	if last := segments[len(segments)-1].end; last.Before(end) {
+		segments = append(segments, segment{start: last, end: end})
+	}
+
+	// Coalesce adjacent uncached segments into a single fetch.
+	pieces := make([]segment, 0, len(segments))
+	for i := 0; i < len(segments); {
+		if segments[i].cached {
+			pieces = append(pieces, segments[i])
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(segments) && !segments[j].cached {
+			j++
+		}
+
+		fetchParams := params
+		fetchParams.Start = segments[i].start
+		fetchParams.End = segments[j-1].end
+		fetchParams.IncludeEnd = false
+
+		c.metrics.fetches.Inc(1)
+		series, err := fetch(ctx, fetchParams)
+		if err != nil {
+			return nil, err
+		}
+
+		// Partial results are never cached, otherwise the series of any
+		// skipped storages would stay missing until the extents are evicted.
+		partial := storage.WarningsFromContext(ctx).Partial()
+		for _, seg := range segments[i:j] {
+			if seg.extent && !partial {
+				c.store(queryHash, params.Step, seg, fetchParams.Start, series)
+			}
+		}
+
+		pieces = append(pieces, segment{
+			start:  fetchParams.Start,
+			end:    fetchParams.End,
+			series: series,
+		})
+		i = j
+	}
+
+	return merge(pieces, start, end, params.Step), nil
+}
+
+func (c *ResultsCache) cacheable(params models.RequestParams) bool {
+	step := params.Step
+	return step > 0 &&
+		c.extentSize%step == 0 &&
+		params.Start.UnixNano()%int64(step) == 0
+}
+
+func (c *ResultsCache) key(queryHash string, step time.Duration, extentStart time.Time) string {
+	return fmt.Sprintf("%s:%d:%d:%d",
+		queryHash, step, c.extentSize, extentStart.UnixNano())
+}
+
+// store slices the extent out of the fetched series and caches it.
+func (c *ResultsCache) store(
+	queryHash string,
+	step time.Duration,
+	extent segment,
+	fetchStart time.Time,
+	series []*ts.Series,
+) {
+	var (
+		offset   = int(extent.start.Sub(fetchStart) / step)
+		numSteps = int(extent.end.Sub(extent.start) / step)
+		encoded  = make([]cachedSeries, 0, len(series))
+	)
+	for _, s := range series {
+		values := make([]float64, numSteps)
+		for i := range values {
+			values[i] = math.NaN()
+			if idx := offset + i; idx < s.Len() {
+				values[i] = s.Values().ValueAt(idx)
+			}
+		}
+		encoded = append(encoded, cachedSeries{
+			Name:   s.Name(),
+			Tags:   s.Tags.Tags,
+			Values: values,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(encoded); err != nil {
+		c.metrics.encodeErrors.Inc(1)
+		c.logger.Warn("unable to encode extent", zap.Error(err))
+		return
+	}
+	c.backend.Set(c.key(queryHash, step, extent.start), buf.Bytes())
+}
+
+func (c *ResultsCache) decode(
+	value []byte,
+	extentStart time.Time,
+	step time.Duration,
+) ([]*ts.Series, error) {
+	var decoded []cachedSeries
+	if err := gob.NewDecoder(bytes.NewReader(value)).Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	numSteps := int(c.extentSize / step)
+	series := make([]*ts.Series, 0, len(decoded))
+	for _, d := range decoded {
+		if len(d.Values) != numSteps {
+			return nil, fmt.Errorf("cached extent has %d steps, expected %d",
+				len(d.Values), numSteps)
+		}
+		values := ts.NewFixedStepValues(step, numSteps, math.NaN(), extentStart)
+		for i, v := range d.Values {
+			values.SetValueAt(i, v)
+		}
+		tags := models.NewTags(len(d.Tags), c.tagOpts)
+		tags.Tags = append(tags.Tags, d.Tags...)
+		series = append(series, ts.NewSeries(d.Name, values, tags))
+	}
+	return series, nil
+}
+
+// cachedSeries is the encoded form of a series for a single extent.
+type cachedSeries struct {
+	Name   []byte
+	Tags   []models.Tag
+	Values []float64
+}
+
+// merge stitches the series of each piece into series covering the whole
+// range, series missing from a piece have NaN values for that piece.
+func merge(pieces []segment, start, end time.Time, step time.Duration) []*ts.Series {
+	var (
+		numSteps = int(end.Sub(start) / step)
+		byKey    = make(map[string]ts.FixedResolutionMutableValues)
+		result   []*ts.Series
+	)
+	for _, p := range pieces {
+		offset := int(p.start.Sub(start) / step)
+		for _, s := range p.series {
+			key := seriesKey(s)
+			values, ok := byKey[key]
+			if !ok {
+				values = ts.NewFixedStepValues(step, numSteps, math.NaN(), start)
+				byKey[key] = values
+				result = append(result, ts.NewSeries(s.Name(), values, s.Tags))
+			}
+			for i := 0; i < s.Len() && offset+i < numSteps; i++ {
+				values.SetValueAt(offset+i, s.Values().ValueAt(i))
+			}
+		}
+	}
+
+	if result == nil {
+		return []*ts.Series{}
+	}
+	return result
+}
+
+func seriesKey(s *ts.Series) string {
+	var buf bytes.Buffer
+	buf.Write(s.Name())
+	for _, t := range s.Tags.Tags {
+		buf.WriteByte(0)
+		buf.Write(t.Name)
+		buf.WriteByte(0)
+		buf.Write(t.Value)
+	}
+	return buf.String()
+}
+
+func hashQuery(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+func truncate(t time.Time, d time.Duration) time.Time {
+	nanos := t.UnixNano()
+	return time.Unix(0, nanos-nanos%int64(d))
+}
+
+func ceil(t time.Time, d time.Duration) time.Time {
+	truncated := truncate(t, d)
+	if truncated.Before(t) {
+		return truncated.Add(d)
+	}
+	return truncated
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fetchRange struct {
+	start time.Time
+	end   time.Time
+}
+
+// testFetcher returns two series whose values are derived from the step
+// timestamps, the second series only has values before the cutoff.
+type testFetcher struct {
+	fetches []fetchRange
+	cutoff  time.Time
+}
+
+func (f *testFetcher) fetch(
+	_ context.Context,
+	params models.RequestParams,
+) ([]*ts.Series, error) {
+	end := params.ExclusiveEnd()
+	f.fetches = append(f.fetches, fetchRange{start: params.Start, end: end})
+
+	numSteps := int(end.Sub(params.Start) / params.Step)
+	tagOpts := models.NewTagOptions()
+	a := ts.NewFixedStepValues(params.Step, numSteps, math.NaN(), params.Start)
+	b := ts.NewFixedStepValues(params.Step, numSteps, math.NaN(), params.Start)
+	for i := 0; i < numSteps; i++ {
+		t := params.Start.Add(time.Duration(i) * params.Step)
+		a.SetValueAt(i, float64(t.Unix()))
+		if t.Before(f.cutoff) {
+			b.SetValueAt(i, -float64(t.Unix()))
+		}
+	}
+
+	series := []*ts.Series{
+		ts.NewSeries([]byte("a"), a, models.NewTags(1, tagOpts).
+			AddTag(models.Tag{Name: []byte("id"), Value: []byte("a")})),
+	}
+	if params.Start.Before(f.cutoff) {
+		series = append(series, ts.NewSeries([]byte("b"), b, models.NewTags(1, tagOpts).
+			AddTag(models.Tag{Name: []byte("id"), Value: []byte("b")})))
+	}
+	return series, nil
+}
+
+func newTestResultsCache(t *testing.T) *ResultsCache {
+	backend, err := NewLRUBackend(1 << 20)
+	require.NoError(t, err)
+
+	cache, err := NewResultsCache(NewOptions().
+		SetBackend(backend).
+		SetExtentSize(time.Hour).
+		SetMaxFreshness(10 * time.Minute))
+	require.NoError(t, err)
+	return cache
+}
+
+func requireSeriesEqual(t *testing.T, expected, actual []*ts.Series) {
+	require.Equal(t, len(expected), len(actual))
+	for i := range expected {
+		require.Equal(t, string(expected[i].Name()), string(actual[i].Name()))
+		require.Equal(t, expected[i].Tags.Tags, actual[i].Tags.Tags)
+		require.Equal(t, expected[i].Len(), actual[i].Len())
+		for j := 0; j < expected[i].Len(); j++ {
+			e, a := expected[i].Values().ValueAt(j), actual[i].Values().ValueAt(j)
+			if math.IsNaN(e) {
+				require.True(t, math.IsNaN(a), "series %d step %d", i, j)
+				continue
+			}
+			require.Equal(t, e, a, "series %d step %d", i, j)
+		}
+	}
+}
+
+func TestResultsCacheFetchRange(t *testing.T) {
+	var (
+		cache  = newTestResultsCache(t)
+		base   = time.Unix(1556812800, 0)
+		params = models.RequestParams{
+			Query:      "rate(foo[1m])",
+			Start:      base.Add(30 * time.Minute),
+			End:        base.Add(5 * time.Hour),
+			Now:        base.Add(5 * time.Hour),
+			Step:       time.Minute,
+			IncludeEnd: true,
+		}
+		fetcher = &testFetcher{cutoff: base.Add(150 * time.Minute)}
+	)
+
+	expected, err := (&testFetcher{cutoff: fetcher.cutoff}).fetch(context.Background(), params)
+	require.NoError(t, err)
+
+	result, err := cache.FetchRange(context.Background(), params, fetcher.fetch)
+	require.NoError(t, err)
+	requireSeriesEqual(t, expected, result)
+	require.Equal(t, []fetchRange{
+		{start: params.Start, end: params.ExclusiveEnd()},
+	}, fetcher.fetches)
+
+	// Extents ending up to 10 minutes before now are cached, so only the
+	// head and the tail are fetched.
+	fetcher.fetches = nil
+	result, err = cache.FetchRange(context.Background(), params, fetcher.fetch)
+	require.NoError(t, err)
+	requireSeriesEqual(t, expected, result)
+	require.Equal(t, []fetchRange{
+		{start: params.Start, end: base.Add(time.Hour)},
+		{start: base.Add(4 * time.Hour), end: params.ExclusiveEnd()},
+	}, fetcher.fetches)
+
+	// A later refresh only fetches the new tail.
+	params.End = params.End.Add(time.Hour)
+	params.Now = params.Now.Add(time.Hour)
+	expected, err = (&testFetcher{cutoff: fetcher.cutoff}).fetch(context.Background(), params)
+	require.NoError(t, err)
+
+	fetcher.fetches = nil
+	result, err = cache.FetchRange(context.Background(), params, fetcher.fetch)
+	require.NoError(t, err)
+	requireSeriesEqual(t, expected, result)
+	require.Equal(t, []fetchRange{
+		{start: params.Start, end: base.Add(time.Hour)},
+		{start: base.Add(4 * time.Hour), end: params.ExclusiveEnd()},
+	}, fetcher.fetches)
+}
+
+func TestResultsCachePartialResultsNotCached(t *testing.T) {
+	var (
+		cache  = newTestResultsCache(t)
+		base   = time.Unix(1556812800, 0)
+		params = models.RequestParams{
+			Query: "foo",
+			Start: base,
+			End:   base.Add(3 * time.Hour),
+			Now:   base.Add(4 * time.Hour),
+			Step:  time.Minute,
+		}
+		fetcher = &testFetcher{}
+	)
+
+	ctx, warnings := storage.NewContextWithWarnings(context.Background())
+	warnings.Add(storage.Warning{Name: "remote", Message: "unavailable"})
+	_, err := cache.FetchRange(ctx, params, fetcher.fetch)
+	require.NoError(t, err)
+
+	// Nothing was cached by the partial read, so the whole range is fetched.
+	_, err = cache.FetchRange(context.Background(), params, fetcher.fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []fetchRange{
+		{start: params.Start, end: params.ExclusiveEnd()},
+		{start: params.Start, end: params.ExclusiveEnd()},
+	}, fetcher.fetches)
+}
+
+func TestResultsCacheBypass(t *testing.T) {
+	var (
+		cache = newTestResultsCache(t)
+		base  = time.Unix(1556812800, 0)
+	)
+
+	for _, params := range []models.RequestParams{
+		// Start not aligned to step.
+		{Start: base.Add(time.Second), End: base.Add(3 * time.Hour), Now: base.Add(4 * time.Hour), Step: time.Minute},
+		// Extent size not a multiple of step.
+		{Start: base, End: base.Add(3 * time.Hour), Now: base.Add(4 * time.Hour), Step: 7 * time.Minute},
+		// Range too recent to contain a complete extent.
+		{Start: base, End: base.Add(time.Hour), Now: base.Add(time.Hour), Step: time.Minute},
+	} {
+		fetcher := &testFetcher{}
+		for i := 0; i < 2; i++ {
+			_, err := cache.FetchRange(context.Background(), params, fetcher.fetch)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []fetchRange{
+			{start: params.Start, end: params.ExclusiveEnd()},
+			{start: params.Start, end: params.ExclusiveEnd()},
+		}, fetcher.fetches)
+	}
+}
+
+func TestResultsCacheDistinctQueries(t *testing.T) {
+	var (
+		cache  = newTestResultsCache(t)
+		base   = time.Unix(1556812800, 0)
+		params = models.RequestParams{
+			Query: "foo",
+			Start: base,
+			End:   base.Add(2 * time.Hour),
+			Now:   base.Add(3 * time.Hour),
+			Step:  time.Minute,
+		}
+		fetcher = &testFetcher{}
+	)
+
+	_, err := cache.FetchRange(context.Background(), params, fetcher.fetch)
+	require.NoError(t, err)
+
+	params.Query = "bar"
+	_, err = cache.FetchRange(context.Background(), params, fetcher.fetch)
+	require.NoError(t, err)
+
+	params.Step = 2 * time.Minute
+	_, err = cache.FetchRange(context.Background(), params, fetcher.fetch)
+	require.NoError(t, err)
+
+	assert.Len(t, fetcher.fetches, 3)
+}
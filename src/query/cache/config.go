@@ -0,0 +1,85 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	defaultExtentSize   = time.Hour
+	defaultMaxFreshness = 10 * time.Minute
+	defaultLRUMaxBytes  = 256 << 20
+)
+
+// ResultsCacheConfiguration is the configuration for the range query
+// results cache.
+type ResultsCacheConfiguration struct {
+	// Enabled enables the results cache.
+	Enabled bool `yaml:"enabled"`
+
+	// ExtentSize is the size of the time aligned extents that range queries
+	// are split into and cached as.
+	ExtentSize *time.Duration `yaml:"extentSize"`
+
+	// MaxFreshness is how far behind the current time an extent must end
+	// before it is cached, to allow for late arriving datapoints.
+	MaxFreshness *time.Duration `yaml:"maxFreshness"`
+
+	// LRUMaxBytes is the maximum size of the in-memory LRU backend.
+	LRUMaxBytes *int `yaml:"lruMaxBytes"`
+}
+
+// NewResultsCache returns a new results cache backed by an in-memory LRU,
+// or nil if the results cache is not enabled.
+func (c ResultsCacheConfiguration) NewResultsCache(
+	tagOpts models.TagOptions,
+	instrumentOpts instrument.Options,
+) (*ResultsCache, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+
+	maxBytes := defaultLRUMaxBytes
+	if c.LRUMaxBytes != nil {
+		maxBytes = *c.LRUMaxBytes
+	}
+	backend, err := NewLRUBackend(maxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := NewOptions().
+		SetBackend(backend).
+		SetTagOptions(tagOpts).
+		SetInstrumentOptions(instrumentOpts)
+	if c.ExtentSize != nil {
+		opts = opts.SetExtentSize(*c.ExtentSize)
+	}
+	if c.MaxFreshness != nil {
+		opts = opts.SetMaxFreshness(*c.MaxFreshness)
+	}
+
+	return NewResultsCache(opts)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+)
+
+var errInvalidLRUMaxBytes = errors.New("lru backend max bytes must be positive")
+
+// lruBackend is an in-memory Backend that evicts the least recently used
+// values once the total size of keys and values exceeds the max bytes.
+type lruBackend struct {
+	sync.Mutex
+
+	maxBytes  int
+	bytes     int
+	evictList *list.List
+	items     map[string]*list.Element
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+// NewLRUBackend returns a new in-memory LRU backend.
+func NewLRUBackend(maxBytes int) (Backend, error) {
+	if maxBytes <= 0 {
+		return nil, errInvalidLRUMaxBytes
+	}
+
+	return &lruBackend{
+		maxBytes:  maxBytes,
+		evictList: list.New(),
+		items:     make(map[string]*list.Element),
+	}, nil
+}
+
+func (c *lruBackend) Get(key string) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.evictList.MoveToFront(elem)
+	return elem.Value.(*lruEntry).value, true
+}
+
+func (c *lruBackend) Set(key string, value []byte) {
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+
+	size := len(key) + len(value)
+	if size > c.maxBytes {
+		// Never cache a value that would evict the whole cache.
+		return
+	}
+
+	c.items[key] = c.evictList.PushFront(&lruEntry{key: key, value: value})
+	c.bytes += size
+	for c.bytes > c.maxBytes {
+		c.removeElement(c.evictList.Back())
+	}
+}
+
+func (c *lruBackend) removeElement(elem *list.Element) {
+	entry := c.evictList.Remove(elem).(*lruEntry)
+	delete(c.items, entry.key)
+	c.bytes -= len(entry.key) + len(entry.value)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUBackendEvictsLeastRecentlyUsed(t *testing.T) {
+	backend, err := NewLRUBackend(12)
+	require.NoError(t, err)
+
+	backend.Set("a", []byte("aaa"))
+	backend.Set("b", []byte("bbb"))
+	backend.Set("c", []byte("ccc"))
+
+	// Touch a so that b is the least recently used.
+	_, ok := backend.Get("a")
+	require.True(t, ok)
+
+	backend.Set("d", []byte("ddd"))
+	_, ok = backend.Get("b")
+	assert.False(t, ok)
+
+	for _, key := range []string{"a", "c", "d"} {
+		_, ok = backend.Get(key)
+		assert.True(t, ok, key)
+	}
+
+	// Replacing a value does not double count its size.
+	backend.Set("a", []byte("a"))
+	value, ok := backend.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, []byte("a"), value)
+
+	// Values larger than the cache are not stored.
+	backend.Set("e", make([]byte, 12))
+	_, ok = backend.Get("e")
+	assert.False(t, ok)
+
+	_, err = NewLRUBackend(0)
+	require.Error(t, err)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"errors"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+var (
+	errNoBackend           = errors.New("results cache backend is not set")
+	errNoTagOptions        = errors.New("results cache tag options are not set")
+	errInvalidExtentSize   = errors.New("results cache extent size must be positive")
+	errInvalidMaxFreshness = errors.New("results cache max freshness must not be negative")
+)
+
+type options struct {
+	backend        Backend
+	extentSize     time.Duration
+	maxFreshness   time.Duration
+	tagOpts        models.TagOptions
+	instrumentOpts instrument.Options
+}
+
+// NewOptions returns new results cache options.
+func NewOptions() Options {
+	return &options{
+		extentSize:     defaultExtentSize,
+		maxFreshness:   defaultMaxFreshness,
+		tagOpts:        models.NewTagOptions(),
+		instrumentOpts: instrument.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.backend == nil {
+		return errNoBackend
+	}
+	if o.tagOpts == nil {
+		return errNoTagOptions
+	}
+	if o.extentSize <= 0 {
+		return errInvalidExtentSize
+	}
+	if o.maxFreshness < 0 {
+		return errInvalidMaxFreshness
+	}
+	return nil
+}
+
+func (o *options) SetBackend(value Backend) Options {
+	opts := *o
+	opts.backend = value
+	return &opts
+}
+
+func (o *options) Backend() Backend {
+	return o.backend
+}
+
+func (o *options) SetExtentSize(value time.Duration) Options {
+	opts := *o
+	opts.extentSize = value
+	return &opts
+}
+
+func (o *options) ExtentSize() time.Duration {
+	return o.extentSize
+}
+
+func (o *options) SetMaxFreshness(value time.Duration) Options {
+	opts := *o
+	opts.maxFreshness = value
+	return &opts
+}
+
+func (o *options) MaxFreshness() time.Duration {
+	return o.maxFreshness
+}
+
+func (o *options) SetTagOptions(value models.TagOptions) Options {
+	opts := *o
+	opts.tagOpts = value
+	return &opts
+}
+
+func (o *options) TagOptions() models.TagOptions {
+	return o.tagOpts
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
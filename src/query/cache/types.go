@@ -0,0 +1,84 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+// Backend is a store for encoded result extents, implementations must be
+// safe for concurrent use.
+type Backend interface {
+	// Get returns the value stored for the key.
+	Get(key string) ([]byte, bool)
+
+	// Set stores the value for the key.
+	Set(key string, value []byte)
+}
+
+// FetchFn executes a range query for the given request params.
+type FetchFn func(
+	ctx context.Context,
+	params models.RequestParams,
+) ([]*ts.Series, error)
+
+// Options are the options for the results cache.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetBackend sets the backend extents are stored in.
+	SetBackend(value Backend) Options
+
+	// Backend returns the backend extents are stored in.
+	Backend() Backend
+
+	// SetExtentSize sets the size of the time aligned extents.
+	SetExtentSize(value time.Duration) Options
+
+	// ExtentSize returns the size of the time aligned extents.
+	ExtentSize() time.Duration
+
+	// SetMaxFreshness sets how far behind the request time an extent must
+	// end before it is cached.
+	SetMaxFreshness(value time.Duration) Options
+
+	// MaxFreshness returns how far behind the request time an extent must
+	// end before it is cached.
+	MaxFreshness() time.Duration
+
+	// SetTagOptions sets the tag options used for decoded series.
+	SetTagOptions(value models.TagOptions) Options
+
+	// TagOptions returns the tag options used for decoded series.
+	TagOptions() models.TagOptions
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+}
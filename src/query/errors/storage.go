@@ -69,4 +69,8 @@ var (
 
 	// ErrUnexpectedGRPCResponseType is an error returned when rpc response type is unhandled
 	ErrUnexpectedGRPCResponseType = errors.New("unexpected grpc response type")
+
+	// ErrQueryLimitExceeded is an error returned when a fetch requires exhaustive
+	// results but matched more series than its limit
+	ErrQueryLimitExceeded = errors.New("query exceeded series limit")
 )
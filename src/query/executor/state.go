@@ -167,13 +167,18 @@ func (s *ExecutionState) createNode(
 	}
 
 	transformNode, controller := CreateTransform(step.ID(), transformParams, options)
+	parentOptions := options
+	if timeSpecOp, ok := transformParams.(transform.ParentTimeSpecOp); ok {
+		parentOptions.TimeSpec = timeSpecOp.ParentTimeSpec(options.TimeSpec)
+	}
+
 	for _, parentID := range step.Parents {
 		parentStep, ok := s.plan.Step(parentID)
 		if !ok {
 			return nil, fmt.Errorf("incorrect parent reference, parentId: %s, node: %s", parentID, step.ID())
 		}
 
-		parentController, err := s.createNode(parentStep, options)
+		parentController, err := s.createNode(parentStep, parentOptions)
 		if err != nil {
 			return nil, err
 		}
@@ -94,3 +94,11 @@ type BoundSpec struct {
 	Range  time.Duration
 	Offset time.Duration
 }
+
+// ParentTimeSpecOp is implemented by operations which require their parents to
+// be evaluated with a different time spec, such as subqueries.
+type ParentTimeSpecOp interface {
+	// ParentTimeSpec returns the time spec to evaluate the parents with given
+	// the time spec of the operation itself.
+	ParentTimeSpec(spec TimeSpec) TimeSpec
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package subquery
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+)
+
+// SubqueryType evaluates an inner expression at a sub-step and exposes the
+// results as a range vector to the enclosing temporal function.
+const SubqueryType = "subquery"
+
+// NewSubqueryOp creates a new subquery operation. A step of zero evaluates
+// the inner expression at the step of the enclosing query.
+func NewSubqueryOp(
+	rng time.Duration,
+	step time.Duration,
+) (parser.Params, error) {
+	if rng <= 0 {
+		return baseOp{}, fmt.Errorf("subquery range must be positive, received: %v", rng)
+	}
+
+	if step < 0 {
+		return baseOp{}, fmt.Errorf("subquery step cannot be negative, received: %v", step)
+	}
+
+	return baseOp{
+		rng:  rng,
+		step: step,
+	}, nil
+}
+
+// baseOp stores required properties for the subquery
+type baseOp struct {
+	rng  time.Duration
+	step time.Duration
+}
+
+func (o baseOp) OpType() string {
+	return SubqueryType
+}
+
+func (o baseOp) String() string {
+	return fmt.Sprintf("type: %s, range: %v, step: %v", o.OpType(), o.rng, o.step)
+}
+
+// Bounds returns the bounds for the subquery, the range is required on top
+// of any range required by the inner expression.
+func (o baseOp) Bounds() transform.BoundSpec {
+	return transform.BoundSpec{
+		Range: o.rng,
+	}
+}
+
+// ParentTimeSpec returns the time spec used to evaluate the inner expression.
+func (o baseOp) ParentTimeSpec(spec transform.TimeSpec) transform.TimeSpec {
+	if o.step > 0 {
+		spec.Step = o.step
+	}
+
+	return spec
+}
+
+func (o baseOp) Node(
+	controller *transform.Controller,
+	opts transform.Options,
+) transform.OpNode {
+	return &baseNode{
+		op:         o,
+		controller: controller,
+		step:       opts.TimeSpec.Step,
+	}
+}
+
+type baseNode struct {
+	op         baseOp
+	controller *transform.Controller
+	step       time.Duration
+}
+
+func (n *baseNode) Params() parser.Params {
+	return n.op
+}
+
+// processBlock converts the consolidated inner results into an unconsolidated
+// block at the outer step, where each outer step holds every inner result
+// which was evaluated since the previous outer step.
+func (n *baseNode) processBlock(b block.Block) (block.Block, error) {
+	iter, err := b.SeriesIter()
+	if err != nil {
+		return nil, err
+	}
+
+	defer iter.Close()
+	meta := iter.Meta()
+	bounds := meta.Bounds
+	if n.step <= 0 {
+		return nil, fmt.Errorf("invalid step for subquery: %v", n.step)
+	}
+
+	seriesList := make(ts.SeriesList, 0, iter.SeriesCount())
+	for iter.Next() {
+		series := iter.Current()
+		dps := make(ts.Datapoints, 0, series.Len())
+		for i := 0; i < series.Len(); i++ {
+			v := series.ValueAtStep(i)
+			// NB: steps where the inner expression had no value are skipped,
+			// matching how range selectors only expose existing samples.
+			if math.IsNaN(v) {
+				continue
+			}
+
+			t, err := bounds.TimeForIndex(i)
+			if err != nil {
+				return nil, err
+			}
+
+			dps = append(dps, ts.Datapoint{Timestamp: t, Value: v})
+		}
+
+		// NB: common tags are pushed down onto each series since the resulting
+		// block does not carry block level tags.
+		tags := series.Meta.Tags
+		if meta.Tags.Len() > 0 {
+			tags = tags.Clone().Add(meta.Tags)
+		}
+
+		seriesList = append(seriesList, ts.NewSeries(series.Meta.Name, dps, tags))
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	unconsolidated, err := storage.NewMultiSeriesBlock(seriesList, &storage.FetchQuery{
+		Start:    bounds.Start,
+		End:      bounds.End(),
+		Interval: n.step,
+	}, n.step)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.NewMultiBlockWrapper(unconsolidated), nil
+}
+
+func (n *baseNode) Process(
+	queryCtx *models.QueryContext,
+	_ parser.NodeID,
+	b block.Block,
+) error {
+	nextBlock, err := n.processBlock(b)
+	if err != nil {
+		return err
+	}
+
+	return n.controller.Process(queryCtx, nextBlock)
+}
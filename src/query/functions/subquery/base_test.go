@@ -0,0 +1,98 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package subquery
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/test"
+	"github.com/m3db/m3/src/query/test/executor"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvalidSubqueryOp(t *testing.T) {
+	_, err := NewSubqueryOp(0, time.Minute)
+	assert.Error(t, err)
+	_, err = NewSubqueryOp(time.Hour, -1)
+	assert.Error(t, err)
+}
+
+func TestSubqueryOp(t *testing.T) {
+	op, err := NewSubqueryOp(time.Hour, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, SubqueryType, op.OpType())
+	assert.Equal(t, "type: subquery, range: 1h0m0s, step: 1m0s", op.String())
+
+	base, ok := op.(baseOp)
+	require.True(t, ok)
+	assert.Equal(t, transform.BoundSpec{Range: time.Hour}, base.Bounds())
+
+	spec := transform.TimeSpec{Step: time.Hour}
+	assert.Equal(t, time.Minute, base.ParentTimeSpec(spec).Step)
+
+	base.step = 0
+	assert.Equal(t, time.Hour, base.ParentTimeSpec(spec).Step, "defaults to query step")
+}
+
+func TestSubqueryNode(t *testing.T) {
+	now := time.Now().Truncate(time.Hour)
+	innerBounds := models.Bounds{
+		Start:    now,
+		Duration: 4 * time.Minute,
+		StepSize: time.Minute,
+	}
+
+	values := [][]float64{{1, math.NaN(), 3, 4}}
+	b := test.NewBlockFromValues(innerBounds, values)
+
+	op, err := NewSubqueryOp(time.Hour, time.Minute)
+	require.NoError(t, err)
+	c, sink := executor.NewControllerWithSink(parser.NodeID(1))
+	node := op.(baseOp).Node(c, transform.Options{
+		TimeSpec: transform.TimeSpec{Step: 2 * time.Minute},
+	})
+
+	err = node.Process(models.NoopQueryContext(), parser.NodeID(0), b)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, sink.Meta.Bounds.StepSize)
+	assert.Equal(t, innerBounds.Start, sink.Meta.Bounds.Start)
+
+	bl := node.(*baseNode)
+	outer, err := bl.processBlock(b)
+	require.NoError(t, err)
+	unconsolidated, err := outer.Unconsolidated()
+	require.NoError(t, err)
+	iter, err := unconsolidated.SeriesIter()
+	require.NoError(t, err)
+	require.True(t, iter.Next())
+	series := iter.Current()
+	require.Equal(t, 2, series.Len())
+	assert.Equal(t, []float64{1}, series.DatapointsAtStep(0).Values())
+	assert.Equal(t, []float64{3}, series.DatapointsAtStep(1).Values())
+	assert.False(t, iter.Next())
+}
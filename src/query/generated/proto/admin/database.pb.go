@@ -41,6 +41,10 @@
 		PlacementGetResponse
 		PlacementAddRequest
 		PlacementReplaceRequest
+		PlacementRebalanceRequest
+		PlacementSetWeightsRequest
+		PlacementInstanceDiff
+		PlacementDryRunResponse
 		TopicGetResponse
 		TopicInitRequest
 		TopicAddRequest
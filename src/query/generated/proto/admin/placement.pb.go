@@ -96,6 +96,12 @@ type PlacementAddRequest struct {
 	// By default add requests will only succeed if all instances in the placement
 	// are AVAILABLE for all their shards. force overrides that.
 	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// dry_run computes the resulting placement and the shard movements it implies
+	// without persisting anything.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// estimated_shard_size_bytes, if set, is used to estimate the number of bytes
+	// each instance will need to stream in a dry run.
+	EstimatedShardSizeBytes int64 `protobuf:"varint,4,opt,name=estimated_shard_size_bytes,json=estimatedShardSizeBytes,proto3" json:"estimated_shard_size_bytes,omitempty"`
 }
 
 func (m *PlacementAddRequest) Reset()                    { *m = PlacementAddRequest{} }
@@ -117,10 +123,30 @@ func (m *PlacementAddRequest) GetForce() bool {
 	return false
 }
 
+func (m *PlacementAddRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PlacementAddRequest) GetEstimatedShardSizeBytes() int64 {
+	if m != nil {
+		return m.EstimatedShardSizeBytes
+	}
+	return 0
+}
+
 type PlacementReplaceRequest struct {
 	LeavingInstanceIDs []string                `protobuf:"bytes,1,rep,name=leavingInstanceIDs" json:"leavingInstanceIDs,omitempty"`
 	Candidates         []*placementpb.Instance `protobuf:"bytes,2,rep,name=candidates" json:"candidates,omitempty"`
 	Force              bool                    `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// dry_run computes the resulting placement and the shard movements it implies
+	// without persisting anything.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// estimated_shard_size_bytes, if set, is used to estimate the number of bytes
+	// each instance will need to stream in a dry run.
+	EstimatedShardSizeBytes int64 `protobuf:"varint,5,opt,name=estimated_shard_size_bytes,json=estimatedShardSizeBytes,proto3" json:"estimated_shard_size_bytes,omitempty"`
 }
 
 func (m *PlacementReplaceRequest) Reset()                    { *m = PlacementReplaceRequest{} }
@@ -149,11 +175,200 @@ func (m *PlacementReplaceRequest) GetForce() bool {
 	return false
 }
 
+func (m *PlacementReplaceRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PlacementReplaceRequest) GetEstimatedShardSizeBytes() int64 {
+	if m != nil {
+		return m.EstimatedShardSizeBytes
+	}
+	return 0
+}
+
+type PlacementRebalanceRequest struct {
+	// By default rebalance requests will only succeed if all instances in the
+	// placement are AVAILABLE for all their shards. force overrides that.
+	Force bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"`
+	// dry_run computes the resulting placement and the shard movements it implies
+	// without persisting anything.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// estimated_shard_size_bytes, if set, is used to estimate the number of bytes
+	// each instance will need to stream in a dry run.
+	EstimatedShardSizeBytes int64 `protobuf:"varint,3,opt,name=estimated_shard_size_bytes,json=estimatedShardSizeBytes,proto3" json:"estimated_shard_size_bytes,omitempty"`
+}
+
+func (m *PlacementRebalanceRequest) Reset()                    { *m = PlacementRebalanceRequest{} }
+func (m *PlacementRebalanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*PlacementRebalanceRequest) ProtoMessage()               {}
+func (*PlacementRebalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorPlacement, []int{4} }
+
+func (m *PlacementRebalanceRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *PlacementRebalanceRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PlacementRebalanceRequest) GetEstimatedShardSizeBytes() int64 {
+	if m != nil {
+		return m.EstimatedShardSizeBytes
+	}
+	return 0
+}
+
+type PlacementSetWeightsRequest struct {
+	// Only the id and weight of the instances are used.
+	Instances []*placementpb.Instance `protobuf:"bytes,1,rep,name=instances" json:"instances,omitempty"`
+	Force     bool                    `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// dry_run computes the resulting placement and the shard movements it implies
+	// without persisting anything.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// estimated_shard_size_bytes, if set, is used to estimate the number of bytes
+	// each instance will need to stream in a dry run.
+	EstimatedShardSizeBytes int64 `protobuf:"varint,4,opt,name=estimated_shard_size_bytes,json=estimatedShardSizeBytes,proto3" json:"estimated_shard_size_bytes,omitempty"`
+}
+
+func (m *PlacementSetWeightsRequest) Reset()                    { *m = PlacementSetWeightsRequest{} }
+func (m *PlacementSetWeightsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PlacementSetWeightsRequest) ProtoMessage()               {}
+func (*PlacementSetWeightsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPlacement, []int{5} }
+
+func (m *PlacementSetWeightsRequest) GetInstances() []*placementpb.Instance {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+func (m *PlacementSetWeightsRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *PlacementSetWeightsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PlacementSetWeightsRequest) GetEstimatedShardSizeBytes() int64 {
+	if m != nil {
+		return m.EstimatedShardSizeBytes
+	}
+	return 0
+}
+
+type PlacementInstanceDiff struct {
+	Id                     string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShardsGained           []uint32 `protobuf:"varint,2,rep,packed,name=shards_gained,json=shardsGained" json:"shards_gained,omitempty"`
+	ShardsLost             []uint32 `protobuf:"varint,3,rep,packed,name=shards_lost,json=shardsLost" json:"shards_lost,omitempty"`
+	ShardsInitializing     []uint32 `protobuf:"varint,4,rep,packed,name=shards_initializing,json=shardsInitializing" json:"shards_initializing,omitempty"`
+	EstimatedBytesToStream int64    `protobuf:"varint,5,opt,name=estimated_bytes_to_stream,json=estimatedBytesToStream,proto3" json:"estimated_bytes_to_stream,omitempty"`
+}
+
+func (m *PlacementInstanceDiff) Reset()                    { *m = PlacementInstanceDiff{} }
+func (m *PlacementInstanceDiff) String() string            { return proto.CompactTextString(m) }
+func (*PlacementInstanceDiff) ProtoMessage()               {}
+func (*PlacementInstanceDiff) Descriptor() ([]byte, []int) { return fileDescriptorPlacement, []int{6} }
+
+func (m *PlacementInstanceDiff) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PlacementInstanceDiff) GetShardsGained() []uint32 {
+	if m != nil {
+		return m.ShardsGained
+	}
+	return nil
+}
+
+func (m *PlacementInstanceDiff) GetShardsLost() []uint32 {
+	if m != nil {
+		return m.ShardsLost
+	}
+	return nil
+}
+
+func (m *PlacementInstanceDiff) GetShardsInitializing() []uint32 {
+	if m != nil {
+		return m.ShardsInitializing
+	}
+	return nil
+}
+
+func (m *PlacementInstanceDiff) GetEstimatedBytesToStream() int64 {
+	if m != nil {
+		return m.EstimatedBytesToStream
+	}
+	return 0
+}
+
+type PlacementDryRunResponse struct {
+	Placement              *placementpb.Placement   `protobuf:"bytes,1,opt,name=placement" json:"placement,omitempty"`
+	Version                int32                    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Instances              []*PlacementInstanceDiff `protobuf:"bytes,3,rep,name=instances" json:"instances,omitempty"`
+	EstimatedBytesToStream int64                    `protobuf:"varint,4,opt,name=estimated_bytes_to_stream,json=estimatedBytesToStream,proto3" json:"estimated_bytes_to_stream,omitempty"`
+}
+
+func (m *PlacementDryRunResponse) Reset()                    { *m = PlacementDryRunResponse{} }
+func (m *PlacementDryRunResponse) String() string            { return proto.CompactTextString(m) }
+func (*PlacementDryRunResponse) ProtoMessage()               {}
+func (*PlacementDryRunResponse) Descriptor() ([]byte, []int) { return fileDescriptorPlacement, []int{7} }
+
+func (m *PlacementDryRunResponse) GetPlacement() *placementpb.Placement {
+	if m != nil {
+		return m.Placement
+	}
+	return nil
+}
+
+func (m *PlacementDryRunResponse) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PlacementDryRunResponse) GetInstances() []*PlacementInstanceDiff {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+func (m *PlacementDryRunResponse) GetEstimatedBytesToStream() int64 {
+	if m != nil {
+		return m.EstimatedBytesToStream
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PlacementInitRequest)(nil), "admin.PlacementInitRequest")
 	proto.RegisterType((*PlacementGetResponse)(nil), "admin.PlacementGetResponse")
 	proto.RegisterType((*PlacementAddRequest)(nil), "admin.PlacementAddRequest")
 	proto.RegisterType((*PlacementReplaceRequest)(nil), "admin.PlacementReplaceRequest")
+	proto.RegisterType((*PlacementRebalanceRequest)(nil), "admin.PlacementRebalanceRequest")
+	proto.RegisterType((*PlacementSetWeightsRequest)(nil), "admin.PlacementSetWeightsRequest")
+	proto.RegisterType((*PlacementInstanceDiff)(nil), "admin.PlacementInstanceDiff")
+	proto.RegisterType((*PlacementDryRunResponse)(nil), "admin.PlacementDryRunResponse")
 }
 func (m *PlacementInitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -265,6 +480,21 @@ func (m *PlacementAddRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.DryRun {
+		dAtA[i] = 0x18
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.EstimatedShardSizeBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(m.EstimatedShardSizeBytes))
+	}
 	return i, nil
 }
 
@@ -320,82 +550,429 @@ func (m *PlacementReplaceRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.DryRun {
+		dAtA[i] = 0x20
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.EstimatedShardSizeBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(m.EstimatedShardSizeBytes))
+	}
 	return i, nil
 }
 
-func encodeVarintPlacement(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PlacementRebalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *PlacementInitRequest) Size() (n int) {
+
+func (m *PlacementRebalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Instances) > 0 {
-		for _, e := range m.Instances {
-			l = e.Size()
-			n += 1 + l + sovPlacement(uint64(l))
+	if m.Force {
+		dAtA[i] = 0x8
+		i++
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.NumShards != 0 {
-		n += 1 + sovPlacement(uint64(m.NumShards))
+	if m.DryRun {
+		dAtA[i] = 0x10
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.ReplicationFactor != 0 {
-		n += 1 + sovPlacement(uint64(m.ReplicationFactor))
+	if m.EstimatedShardSizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(m.EstimatedShardSizeBytes))
 	}
-	return n
+	return i, nil
 }
 
-func (m *PlacementGetResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Placement != nil {
-		l = m.Placement.Size()
-		n += 1 + l + sovPlacement(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovPlacement(uint64(m.Version))
+func (m *PlacementSetWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PlacementAddRequest) Size() (n int) {
+func (m *PlacementSetWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if len(m.Instances) > 0 {
-		for _, e := range m.Instances {
-			l = e.Size()
-			n += 1 + l + sovPlacement(uint64(l))
+		for _, msg := range m.Instances {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPlacement(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.Force {
-		n += 2
+		dAtA[i] = 0x10
+		i++
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	if m.DryRun {
+		dAtA[i] = 0x18
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.EstimatedShardSizeBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(m.EstimatedShardSizeBytes))
+	}
+	return i, nil
 }
 
-func (m *PlacementReplaceRequest) Size() (n int) {
+func (m *PlacementInstanceDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementInstanceDiff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.LeavingInstanceIDs) > 0 {
-		for _, s := range m.LeavingInstanceIDs {
-			l = len(s)
-			n += 1 + l + sovPlacement(uint64(l))
-		}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
-	if len(m.Candidates) > 0 {
-		for _, e := range m.Candidates {
-			l = e.Size()
-			n += 1 + l + sovPlacement(uint64(l))
+	if len(m.ShardsGained) > 0 {
+		dAtA2 := make([]byte, len(m.ShardsGained)*10)
+		var j1 int
+		for _, num := range m.ShardsGained {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA2[:j1])
+	}
+	if len(m.ShardsLost) > 0 {
+		dAtA4 := make([]byte, len(m.ShardsLost)*10)
+		var j3 int
+		for _, num := range m.ShardsLost {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
+	}
+	if len(m.ShardsInitializing) > 0 {
+		dAtA6 := make([]byte, len(m.ShardsInitializing)*10)
+		var j5 int
+		for _, num := range m.ShardsInitializing {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
+	}
+	if m.EstimatedBytesToStream != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(m.EstimatedBytesToStream))
+	}
+	return i, nil
+}
+
+func (m *PlacementDryRunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementDryRunResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Placement != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(m.Placement.Size()))
+		n1, err := m.Placement.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(m.Version))
+	}
+	if len(m.Instances) > 0 {
+		for _, msg := range m.Instances {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPlacement(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.EstimatedBytesToStream != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(m.EstimatedBytesToStream))
+	}
+	return i, nil
+}
+
+func encodeVarintPlacement(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *PlacementInitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Instances) > 0 {
+		for _, e := range m.Instances {
+			l = e.Size()
+			n += 1 + l + sovPlacement(uint64(l))
+		}
+	}
+	if m.NumShards != 0 {
+		n += 1 + sovPlacement(uint64(m.NumShards))
+	}
+	if m.ReplicationFactor != 0 {
+		n += 1 + sovPlacement(uint64(m.ReplicationFactor))
+	}
+	return n
+}
+
+func (m *PlacementGetResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Placement != nil {
+		l = m.Placement.Size()
+		n += 1 + l + sovPlacement(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPlacement(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *PlacementAddRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Instances) > 0 {
+		for _, e := range m.Instances {
+			l = e.Size()
+			n += 1 + l + sovPlacement(uint64(l))
+		}
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.EstimatedShardSizeBytes != 0 {
+		n += 1 + sovPlacement(uint64(m.EstimatedShardSizeBytes))
+	}
+	return n
+}
+
+func (m *PlacementReplaceRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.LeavingInstanceIDs) > 0 {
+		for _, s := range m.LeavingInstanceIDs {
+			l = len(s)
+			n += 1 + l + sovPlacement(uint64(l))
+		}
+	}
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovPlacement(uint64(l))
 		}
 	}
 	if m.Force {
 		n += 2
 	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.EstimatedShardSizeBytes != 0 {
+		n += 1 + sovPlacement(uint64(m.EstimatedShardSizeBytes))
+	}
+	return n
+}
+
+func (m *PlacementRebalanceRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Force {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.EstimatedShardSizeBytes != 0 {
+		n += 1 + sovPlacement(uint64(m.EstimatedShardSizeBytes))
+	}
+	return n
+}
+
+func (m *PlacementSetWeightsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Instances) > 0 {
+		for _, e := range m.Instances {
+			l = e.Size()
+			n += 1 + l + sovPlacement(uint64(l))
+		}
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.EstimatedShardSizeBytes != 0 {
+		n += 1 + sovPlacement(uint64(m.EstimatedShardSizeBytes))
+	}
+	return n
+}
+
+func (m *PlacementInstanceDiff) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPlacement(uint64(l))
+	}
+	if len(m.ShardsGained) > 0 {
+		l = 0
+		for _, e := range m.ShardsGained {
+			l += sovPlacement(uint64(e))
+		}
+		n += 1 + sovPlacement(uint64(l)) + l
+	}
+	if len(m.ShardsLost) > 0 {
+		l = 0
+		for _, e := range m.ShardsLost {
+			l += sovPlacement(uint64(e))
+		}
+		n += 1 + sovPlacement(uint64(l)) + l
+	}
+	if len(m.ShardsInitializing) > 0 {
+		l = 0
+		for _, e := range m.ShardsInitializing {
+			l += sovPlacement(uint64(e))
+		}
+		n += 1 + sovPlacement(uint64(l)) + l
+	}
+	if m.EstimatedBytesToStream != 0 {
+		n += 1 + sovPlacement(uint64(m.EstimatedBytesToStream))
+	}
+	return n
+}
+
+func (m *PlacementDryRunResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Placement != nil {
+		l = m.Placement.Size()
+		n += 1 + l + sovPlacement(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPlacement(uint64(m.Version))
+	}
+	if len(m.Instances) > 0 {
+		for _, e := range m.Instances {
+			l = e.Size()
+			n += 1 + l + sovPlacement(uint64(l))
+		}
+	}
+	if m.EstimatedBytesToStream != 0 {
+		n += 1 + sovPlacement(uint64(m.EstimatedBytesToStream))
+	}
 	return n
 }
 
@@ -407,12 +984,542 @@ func sovPlacement(x uint64) (n int) {
 			break
 		}
 	}
-	return n
-}
-func sozPlacement(x uint64) (n int) {
-	return sovPlacement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return n
+}
+func sozPlacement(x uint64) (n int) {
+	return sovPlacement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PlacementInitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlacement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlacementInitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlacementInitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instances = append(m.Instances, &placementpb.Instance{})
+			if err := m.Instances[len(m.Instances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumShards", wireType)
+			}
+			m.NumShards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumShards |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+			}
+			m.ReplicationFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationFactor |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlacement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlacementGetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlacement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlacementGetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlacementGetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Placement == nil {
+				m.Placement = &placementpb.Placement{}
+			}
+			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlacement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlacementAddRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlacement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlacementAddRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlacementAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instances = append(m.Instances, &placementpb.Instance{})
+			if err := m.Instances[len(m.Instances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedShardSizeBytes", wireType)
+			}
+			m.EstimatedShardSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedShardSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlacement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlacementReplaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlacement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlacementReplaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlacementReplaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeavingInstanceIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeavingInstanceIDs = append(m.LeavingInstanceIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, &placementpb.Instance{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedShardSizeBytes", wireType)
+			}
+			m.EstimatedShardSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedShardSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlacement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPlacement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *PlacementInitRequest) Unmarshal(dAtA []byte) error {
+func (m *PlacementRebalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -435,17 +1542,17 @@ func (m *PlacementInitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PlacementInitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlacementRebalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlacementInitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlacementRebalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlacement
@@ -455,28 +1562,17 @@ func (m *PlacementInitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlacement
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Instances = append(m.Instances, &placementpb.Instance{})
-			if err := m.Instances[len(m.Instances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumShards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			m.NumShards = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlacement
@@ -486,16 +1582,17 @@ func (m *PlacementInitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumShards |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DryRun = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedShardSizeBytes", wireType)
 			}
-			m.ReplicationFactor = 0
+			m.EstimatedShardSizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlacement
@@ -505,7 +1602,7 @@ func (m *PlacementInitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicationFactor |= (int32(b) & 0x7F) << shift
+				m.EstimatedShardSizeBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -531,7 +1628,7 @@ func (m *PlacementInitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PlacementGetResponse) Unmarshal(dAtA []byte) error {
+func (m *PlacementSetWeightsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -554,15 +1651,15 @@ func (m *PlacementGetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PlacementGetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlacementSetWeightsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlacementGetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlacementSetWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -586,18 +1683,16 @@ func (m *PlacementGetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Placement == nil {
-				m.Placement = &placementpb.Placement{}
-			}
-			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Instances = append(m.Instances, &placementpb.Instance{})
+			if err := m.Instances[len(m.Instances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			m.Version = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlacement
@@ -607,7 +1702,47 @@ func (m *PlacementGetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedShardSizeBytes", wireType)
+			}
+			m.EstimatedShardSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedShardSizeBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -633,7 +1768,7 @@ func (m *PlacementGetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PlacementAddRequest) Unmarshal(dAtA []byte) error {
+func (m *PlacementInstanceDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -656,17 +1791,17 @@ func (m *PlacementAddRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PlacementAddRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlacementInstanceDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlacementAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlacementInstanceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlacement
@@ -676,28 +1811,212 @@ func (m *PlacementAddRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlacement
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Instances = append(m.Instances, &placementpb.Instance{})
-			if err := m.Instances[len(m.Instances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlacement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardsGained = append(m.ShardsGained, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlacement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlacement
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlacement
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardsGained = append(m.ShardsGained, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsGained", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlacement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardsLost = append(m.ShardsLost, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlacement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlacement
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlacement
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardsLost = append(m.ShardsLost, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsLost", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlacement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardsInitializing = append(m.ShardsInitializing, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlacement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlacement
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlacement
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardsInitializing = append(m.ShardsInitializing, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsInitializing", wireType)
+			}
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedBytesToStream", wireType)
 			}
-			var v int
+			m.EstimatedBytesToStream = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlacement
@@ -707,12 +2026,11 @@ func (m *PlacementAddRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.EstimatedBytesToStream |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlacement(dAtA[iNdEx:])
@@ -734,7 +2052,7 @@ func (m *PlacementAddRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PlacementReplaceRequest) Unmarshal(dAtA []byte) error {
+func (m *PlacementDryRunResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -757,17 +2075,17 @@ func (m *PlacementReplaceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PlacementReplaceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlacementDryRunResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlacementReplaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlacementDryRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeavingInstanceIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlacement
@@ -777,24 +2095,47 @@ func (m *PlacementReplaceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlacement
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeavingInstanceIDs = append(m.LeavingInstanceIDs, string(dAtA[iNdEx:postIndex]))
+			if m.Placement == nil {
+				m.Placement = &placementpb.Placement{}
+			}
+			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -818,16 +2159,16 @@ func (m *PlacementReplaceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Candidates = append(m.Candidates, &placementpb.Instance{})
-			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Instances = append(m.Instances, &PlacementInstanceDiff{})
+			if err := m.Instances[len(m.Instances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedBytesToStream", wireType)
 			}
-			var v int
+			m.EstimatedBytesToStream = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlacement
@@ -837,12 +2178,11 @@ func (m *PlacementReplaceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.EstimatedBytesToStream |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlacement(dAtA[iNdEx:])
@@ -974,28 +2314,43 @@ func init() {
 }
 
 var fileDescriptorPlacement = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcd, 0x8e, 0xd3, 0x30,
-	0x14, 0x85, 0x31, 0x55, 0x81, 0xb8, 0x1b, 0x30, 0x05, 0x22, 0x24, 0xa2, 0x2a, 0xab, 0x6e, 0x88,
-	0xa5, 0x06, 0x1e, 0x80, 0x0a, 0x81, 0xca, 0x0a, 0x85, 0x07, 0x28, 0x8e, 0x7d, 0xdb, 0x5a, 0x4a,
-	0xec, 0xd4, 0x76, 0x2a, 0xf1, 0x16, 0xac, 0x46, 0x9a, 0x37, 0x9a, 0xe5, 0x3c, 0xc2, 0xa8, 0xf3,
-	0x22, 0xa3, 0x71, 0x9b, 0x9f, 0xf9, 0xdd, 0xcc, 0xd2, 0xe7, 0x9c, 0x7b, 0xee, 0xa7, 0x2b, 0xe3,
-	0xf9, 0x5a, 0xba, 0x4d, 0x9d, 0x27, 0x5c, 0x97, 0xb4, 0x4c, 0x45, 0x4e, 0xcb, 0x94, 0x5a, 0xc3,
-	0xe9, 0xb6, 0x06, 0xf3, 0x8f, 0xae, 0x41, 0x81, 0x61, 0x0e, 0x04, 0xad, 0x8c, 0x76, 0x9a, 0x32,
-	0x51, 0x4a, 0x45, 0xab, 0x82, 0x71, 0x28, 0x41, 0xb9, 0xc4, 0xab, 0x64, 0xe8, 0xe5, 0x8f, 0xbf,
-	0x1e, 0xa8, 0xe2, 0x45, 0x6d, 0x1d, 0x98, 0x3b, 0x65, 0x6d, 0x4d, 0x95, 0xdf, 0xae, 0x8c, 0x4f,
-	0x11, 0x1e, 0xff, 0x6e, 0xb4, 0x85, 0x92, 0x2e, 0x83, 0x6d, 0x0d, 0xd6, 0x91, 0x14, 0x07, 0x52,
-	0x59, 0xc7, 0x14, 0x07, 0x1b, 0xa2, 0xc9, 0x60, 0x3a, 0x9a, 0xbd, 0x4b, 0x7a, 0x4d, 0xc9, 0xe2,
-	0xe8, 0x66, 0x5d, 0x8e, 0x7c, 0xc2, 0x58, 0xd5, 0xe5, 0xd2, 0x6e, 0x98, 0x11, 0x36, 0x7c, 0x3e,
-	0x41, 0xd3, 0x61, 0x16, 0xa8, 0xba, 0xfc, 0xe3, 0x05, 0xf2, 0x19, 0x13, 0x03, 0x55, 0x21, 0x39,
-	0x73, 0x52, 0xab, 0xe5, 0x8a, 0x71, 0xa7, 0x4d, 0x38, 0xf0, 0xb1, 0x37, 0x3d, 0xe7, 0x87, 0x37,
-	0xe2, 0x55, 0x0f, 0xed, 0x27, 0xb8, 0x0c, 0x6c, 0xa5, 0x95, 0x05, 0xf2, 0x05, 0x07, 0x2d, 0x48,
-	0x88, 0x26, 0x68, 0x3a, 0x9a, 0xbd, 0xbf, 0x81, 0xd6, 0x4e, 0x65, 0x5d, 0x90, 0x84, 0xf8, 0xe5,
-	0x0e, 0x8c, 0x95, 0x5a, 0x1d, 0xc1, 0x9a, 0x67, 0xfc, 0x17, 0xbf, 0x6d, 0x27, 0xbe, 0x09, 0xf1,
-	0xa4, 0x0b, 0x8c, 0xf1, 0x70, 0xa5, 0x0d, 0x07, 0xbf, 0xe3, 0x55, 0x76, 0x78, 0xc4, 0x27, 0x08,
-	0x7f, 0xe8, 0xa0, 0xc0, 0x97, 0x34, 0x6b, 0x12, 0x4c, 0x0a, 0x60, 0x3b, 0xa9, 0xd6, 0x4d, 0xdf,
-	0xe2, 0xfb, 0x61, 0x5f, 0x90, 0xdd, 0xe3, 0x90, 0xaf, 0x18, 0x73, 0xa6, 0x84, 0x14, 0xcc, 0xc1,
-	0xf5, 0x8d, 0x1f, 0xe1, 0xea, 0x05, 0x3b, 0xb0, 0x41, 0x0f, 0x6c, 0xfe, 0xfa, 0x6c, 0x1f, 0xa1,
-	0xf3, 0x7d, 0x84, 0x2e, 0xf6, 0x11, 0xfa, 0x7f, 0x19, 0x3d, 0xcb, 0x5f, 0xf8, 0x7f, 0x91, 0x5e,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xae, 0x31, 0x55, 0xba, 0xb0, 0x02, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x71, 0x9c, 0xb4, 0xcd, 0x53, 0x8a, 0xe0, 0xfa, 0x92, 0x34, 0x82, 0x52, 0x99, 0xa5,
+	0x0b, 0xb6, 0x44, 0x60, 0x00, 0x26, 0xa2, 0x88, 0x2a, 0x88, 0x01, 0x39, 0x48, 0x8c, 0xd6, 0xd9,
+	0x77, 0x49, 0x4e, 0xb2, 0xcf, 0xe1, 0xee, 0x5c, 0x29, 0xfd, 0x02, 0xac, 0xac, 0x7c, 0x11, 0x36,
+	0x76, 0x46, 0x56, 0xb6, 0x0a, 0x56, 0x3e, 0x04, 0x97, 0xb3, 0xe3, 0x18, 0x68, 0x22, 0x55, 0x88,
+	0x81, 0xc1, 0x96, 0xef, 0x79, 0xfe, 0x7e, 0xee, 0xf7, 0x7f, 0xee, 0x05, 0x7a, 0x63, 0xa6, 0x26,
+	0x59, 0xe8, 0x46, 0x69, 0xe2, 0x25, 0x5d, 0x12, 0xea, 0x97, 0x27, 0x45, 0xe4, 0xbd, 0xcd, 0xa8,
+	0x98, 0x79, 0x63, 0xca, 0xa9, 0xc0, 0x8a, 0x12, 0x6f, 0x2a, 0x52, 0x95, 0x7a, 0x98, 0x24, 0x8c,
+	0x7b, 0xd3, 0x18, 0x47, 0x34, 0xa1, 0x5c, 0xb9, 0x26, 0x8a, 0x1a, 0x26, 0xdc, 0x79, 0xb1, 0xa2,
+	0x54, 0x14, 0x67, 0x52, 0x51, 0xf1, 0x47, 0xb1, 0xb2, 0xcc, 0x34, 0xfc, 0xbd, 0xa4, 0xf3, 0xc1,
+	0x82, 0xbd, 0x57, 0x8b, 0xd8, 0x80, 0x33, 0xe5, 0x53, 0x4d, 0x24, 0x15, 0xea, 0x42, 0x93, 0x71,
+	0xa9, 0x30, 0x8f, 0xa8, 0x6c, 0x5b, 0xc7, 0xf6, 0xc9, 0xf6, 0x83, 0x7d, 0xb7, 0x52, 0xc9, 0x1d,
+	0x14, 0x59, 0x7f, 0xa9, 0x43, 0x77, 0x00, 0x78, 0x96, 0x04, 0x72, 0x82, 0x05, 0x91, 0xed, 0xda,
+	0xb1, 0x75, 0xd2, 0xf0, 0x9b, 0x3a, 0x32, 0x34, 0x01, 0x74, 0x1f, 0x90, 0xa0, 0xd3, 0x98, 0x45,
+	0x58, 0xb1, 0x94, 0x07, 0x23, 0x1c, 0xa9, 0x54, 0xb4, 0x6d, 0x23, 0xbb, 0x55, 0xc9, 0x3c, 0x37,
+	0x09, 0x67, 0x54, 0x41, 0x3b, 0xa5, 0x9a, 0x4c, 0x4e, 0x53, 0x2e, 0x29, 0x7a, 0x08, 0xcd, 0x12,
+	0x44, 0xa3, 0x59, 0x1a, 0xed, 0xe0, 0x17, 0xb4, 0xf2, 0x2f, 0x7f, 0x29, 0x44, 0x6d, 0xd8, 0x3c,
+	0xa3, 0x42, 0xea, 0xf2, 0x05, 0xd8, 0x62, 0xe8, 0x7c, 0xb4, 0x60, 0xb7, 0xfc, 0xe5, 0x19, 0x21,
+	0x7f, 0xd5, 0x82, 0x3d, 0x68, 0x8c, 0x52, 0x11, 0x51, 0x33, 0xc9, 0x96, 0x9f, 0x0f, 0x50, 0x0b,
+	0x36, 0x89, 0x98, 0x05, 0x22, 0xe3, 0xc6, 0xee, 0x96, 0xbf, 0xa1, 0x87, 0x7e, 0xc6, 0xd1, 0x53,
+	0xe8, 0xe8, 0xa9, 0x58, 0x32, 0x5f, 0xae, 0xbc, 0x6f, 0x81, 0x64, 0xe7, 0x34, 0x08, 0x67, 0x4a,
+	0x4f, 0x5a, 0xd7, 0x5a, 0xdb, 0x6f, 0x95, 0x0a, 0xd3, 0xc7, 0xa1, 0xce, 0xf7, 0xe6, 0x69, 0xe7,
+	0x87, 0x05, 0xad, 0xa5, 0x57, 0x6a, 0xd0, 0x16, 0xf0, 0x2e, 0xa0, 0x98, 0xe2, 0x33, 0xc6, 0xc7,
+	0x0b, 0xca, 0x41, 0x3f, 0x77, 0xd1, 0xf4, 0x2f, 0xc9, 0xa0, 0x47, 0x00, 0x11, 0xe6, 0x84, 0x11,
+	0x3c, 0x9f, 0xb8, 0xb6, 0xce, 0x6d, 0x45, 0xb8, 0xb4, 0x6b, 0xaf, 0xb0, 0x5b, 0xbf, 0x82, 0xdd,
+	0xc6, 0x7a, 0xbb, 0xef, 0x2c, 0x38, 0xac, 0xd8, 0x0d, 0x71, 0x6c, 0x70, 0x0a, 0xc3, 0x25, 0x89,
+	0xb5, 0x82, 0xa4, 0x76, 0x05, 0x12, 0x7b, 0x3d, 0xc9, 0x27, 0x0b, 0x3a, 0x25, 0xc9, 0x90, 0xaa,
+	0x37, 0x94, 0x8d, 0x27, 0x4a, 0xfe, 0x37, 0x1b, 0xe7, 0xab, 0x05, 0xfb, 0x95, 0x53, 0x9f, 0x23,
+	0xf4, 0xd9, 0x68, 0x84, 0x6e, 0x40, 0x8d, 0x11, 0xd3, 0xc2, 0xa6, 0xaf, 0xbf, 0xd0, 0x3d, 0xd8,
+	0xc9, 0x4f, 0x73, 0x30, 0xc6, 0x8c, 0x53, 0x62, 0x76, 0xc6, 0x8e, 0x7f, 0x3d, 0x0f, 0x9e, 0x9a,
+	0x18, 0xba, 0x0b, 0xdb, 0x85, 0x28, 0x4e, 0xa5, 0xd2, 0xa0, 0x73, 0x09, 0xe4, 0xa1, 0x97, 0x3a,
+	0x82, 0x3c, 0xd8, 0x2d, 0x04, 0x4c, 0x5f, 0x31, 0x0c, 0xc7, 0xec, 0x5c, 0x6f, 0x3f, 0x4d, 0x39,
+	0x17, 0xa2, 0x3c, 0x35, 0xa8, 0x64, 0xd0, 0x63, 0x38, 0x5c, 0xba, 0x33, 0x96, 0x02, 0x95, 0x06,
+	0x52, 0x09, 0x8a, 0x93, 0x62, 0x9b, 0x1c, 0x94, 0x02, 0xe3, 0xe9, 0x75, 0x3a, 0x34, 0x59, 0xe7,
+	0xa2, 0x7a, 0x28, 0xfa, 0xa6, 0x59, 0xff, 0xea, 0xe6, 0x40, 0x4f, 0xaa, 0x0b, 0x6d, 0x9b, 0x85,
+	0xbe, 0xed, 0x9a, 0x4b, 0xda, 0xbd, 0xb4, 0xbd, 0xd5, 0xf5, 0x5e, 0x6b, 0xb1, 0xbe, 0xce, 0x62,
+	0xef, 0xe6, 0xe7, 0x6f, 0x47, 0xd6, 0x17, 0xfd, 0x5c, 0xe8, 0xe7, 0xfd, 0xf7, 0xa3, 0x6b, 0xe1,
+	0x86, 0xb9, 0xcd, 0xbb, 0x3f, 0x01, 0xc9, 0xff, 0xec, 0xf4, 0x66, 0x06, 0x00, 0x00,
 }
@@ -0,0 +1,280 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// SeriesByTagFunction is the name of the graphite function that selects
+	// series by tag expressions rather than by path.
+	SeriesByTagFunction = "seriesByTag"
+
+	// NameTag is the graphite tag which refers to the dotted path of a series.
+	NameTag = "name"
+
+	// TaggedSeparator separates the path and each of the tags of a tagged
+	// graphite series name, e.g. disk.used;datacenter=dc1;server=web01.
+	TaggedSeparator = ";"
+)
+
+var (
+	graphiteTagPrefix = []byte("__g")
+	graphiteTagSuffix = []byte("__")
+
+	errEmptyTagExpression = errors.New("empty tag expression")
+)
+
+// TagOperator is the operator of a seriesByTag tag expression.
+type TagOperator string
+
+const (
+	// TagOperatorEqual matches tags with an exact value.
+	TagOperatorEqual TagOperator = "="
+	// TagOperatorNotEqual matches tags without an exact value.
+	TagOperatorNotEqual TagOperator = "!="
+	// TagOperatorRegexp matches tags with a value matching a regular expression.
+	TagOperatorRegexp TagOperator = "=~"
+	// TagOperatorNotRegexp matches tags with a value not matching a regular
+	// expression.
+	TagOperatorNotRegexp TagOperator = "!=~"
+)
+
+// TagExpression is a single tag expression of a seriesByTag call.
+type TagExpression struct {
+	Tag      string
+	Operator TagOperator
+	Value    string
+}
+
+// String returns the string representation of the tag expression.
+func (e TagExpression) String() string {
+	return e.Tag + string(e.Operator) + e.Value
+}
+
+// Positive returns true if the expression can only match series which have
+// the tag set, graphite requires at least one of these per seriesByTag call.
+func (e TagExpression) Positive() bool {
+	switch e.Operator {
+	case TagOperatorEqual, TagOperatorRegexp:
+		return e.Value != ""
+	default:
+		return false
+	}
+}
+
+// ParseTagExpression parses a tag expression such as `dc=us-east`,
+// `dc!=us-east`, `dc=~us-.*` or `dc!=~us-.*`.
+func ParseTagExpression(expr string) (TagExpression, error) {
+	idx := strings.IndexByte(expr, '=')
+	if idx < 0 {
+		return TagExpression{}, fmt.Errorf("invalid tag expression, missing operator: %s", expr)
+	}
+
+	var (
+		tag   = expr[:idx]
+		value = expr[idx+1:]
+		op    = TagOperatorEqual
+	)
+
+	if strings.HasSuffix(tag, "!") {
+		tag = tag[:len(tag)-1]
+		op = TagOperatorNotEqual
+	}
+
+	if strings.HasPrefix(value, "~") {
+		value = value[1:]
+		if op == TagOperatorEqual {
+			op = TagOperatorRegexp
+		} else {
+			op = TagOperatorNotRegexp
+		}
+	}
+
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return TagExpression{}, fmt.Errorf("invalid tag expression, missing tag: %s", expr)
+	}
+
+	return TagExpression{Tag: tag, Operator: op, Value: value}, nil
+}
+
+// SeriesByTagQuery builds the storage query for a seriesByTag call with the
+// given tag expressions.
+func SeriesByTagQuery(exprs []string) string {
+	var buf bytes.Buffer
+	buf.WriteString(SeriesByTagFunction)
+	buf.WriteByte('(')
+	for i, expr := range exprs {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		// NB: expressions are not escaped, so use whichever quote character
+		// does not appear in the expression.
+		quote := byte('\'')
+		if strings.IndexByte(expr, quote) >= 0 {
+			quote = '"'
+		}
+
+		buf.WriteByte(quote)
+		buf.WriteString(expr)
+		buf.WriteByte(quote)
+	}
+
+	buf.WriteByte(')')
+	return buf.String()
+}
+
+// IsSeriesByTagQuery returns true if the query is a seriesByTag query.
+func IsSeriesByTagQuery(query string) bool {
+	return strings.HasPrefix(query, SeriesByTagFunction+"(")
+}
+
+// ParseSeriesByTagQuery parses the tag expressions of a seriesByTag query
+// such as seriesByTag('name=disk.used','server=~web.*').
+func ParseSeriesByTagQuery(query string) ([]TagExpression, error) {
+	if !IsSeriesByTagQuery(query) || !strings.HasSuffix(query, ")") {
+		return nil, fmt.Errorf("invalid %s query: %s", SeriesByTagFunction, query)
+	}
+
+	args, err := splitQuotedArgs(query[len(SeriesByTagFunction)+1 : len(query)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args) == 0 {
+		return nil, errEmptyTagExpression
+	}
+
+	exprs := make([]TagExpression, 0, len(args))
+	for _, arg := range args {
+		expr, err := ParseTagExpression(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		exprs = append(exprs, expr)
+	}
+
+	return exprs, nil
+}
+
+// splitQuotedArgs splits a comma separated list of single or double quoted
+// strings, commas inside of quotes are part of the argument.
+func splitQuotedArgs(s string) ([]string, error) {
+	var (
+		args  []string
+		quote byte
+		start int
+	)
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				args = append(args, s[start:i])
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			start = i + 1
+		case c == ',' || c == ' ':
+			continue
+		default:
+			return nil, fmt.Errorf("unexpected character '%c' in tag expressions: %s", c, s)
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in tag expressions: %s", s)
+	}
+
+	return args, nil
+}
+
+// TagIndex returns the path index for a graphite path tag name, e.g. 2 for
+// __g2__, and false if the tag name is not a graphite path tag.
+func TagIndex(name []byte) (int, bool) {
+	if !bytes.HasPrefix(name, graphiteTagPrefix) ||
+		!bytes.HasSuffix(name, graphiteTagSuffix) ||
+		len(name) <= len(graphiteTagPrefix)+len(graphiteTagSuffix) {
+		return 0, false
+	}
+
+	digits := name[len(graphiteTagPrefix) : len(name)-len(graphiteTagSuffix)]
+	idx, err := strconv.Atoi(string(digits))
+	if err != nil || idx < 0 {
+		return 0, false
+	}
+
+	return idx, true
+}
+
+// TaggedName returns the graphite name for a path with additional tags, which
+// are appended to the path in sorted order: path;tag1=value1;tag2=value2.
+func TaggedName(path string, tags map[string]string) string {
+	if len(tags) == 0 {
+		return path
+	}
+
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	var buf bytes.Buffer
+	buf.WriteString(path)
+	for _, name := range names {
+		buf.WriteString(TaggedSeparator)
+		buf.WriteString(name)
+		buf.WriteByte('=')
+		buf.WriteString(tags[name])
+	}
+
+	return buf.String()
+}
+
+// ParseTaggedName parses the tags of a graphite series name, the path of the
+// series is returned as the name tag. Names without tags only have a name tag.
+func ParseTaggedName(name string) map[string]string {
+	parts := strings.Split(name, TaggedSeparator)
+	tags := make(map[string]string, len(parts))
+	tags[NameTag] = parts[0]
+	for _, part := range parts[1:] {
+		idx := strings.IndexByte(part, '=')
+		if idx <= 0 {
+			continue
+		}
+
+		tags[part[:idx]] = part[idx+1:]
+	}
+
+	return tags
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTagExpression(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected TagExpression
+	}{
+		{"dc=us-east", TagExpression{"dc", TagOperatorEqual, "us-east"}},
+		{"dc!=us-east", TagExpression{"dc", TagOperatorNotEqual, "us-east"}},
+		{"dc=~us-.*", TagExpression{"dc", TagOperatorRegexp, "us-.*"}},
+		{"dc!=~us-.*", TagExpression{"dc", TagOperatorNotRegexp, "us-.*"}},
+		{"dc=", TagExpression{"dc", TagOperatorEqual, ""}},
+		{"name=a=b", TagExpression{"name", TagOperatorEqual, "a=b"}},
+	}
+
+	for _, test := range tests {
+		actual, err := ParseTagExpression(test.expr)
+		require.NoError(t, err, test.expr)
+		assert.Equal(t, test.expected, actual, test.expr)
+		assert.Equal(t, test.expr, actual.String())
+	}
+
+	for _, expr := range []string{"dc", "=foo", "!=foo"} {
+		_, err := ParseTagExpression(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestTagExpressionPositive(t *testing.T) {
+	assert.True(t, TagExpression{"dc", TagOperatorEqual, "a"}.Positive())
+	assert.True(t, TagExpression{"dc", TagOperatorRegexp, "a.*"}.Positive())
+	assert.False(t, TagExpression{"dc", TagOperatorEqual, ""}.Positive())
+	assert.False(t, TagExpression{"dc", TagOperatorNotEqual, "a"}.Positive())
+	assert.False(t, TagExpression{"dc", TagOperatorNotRegexp, "a.*"}.Positive())
+}
+
+func TestSeriesByTagQuery(t *testing.T) {
+	exprs := []string{"name=disk.used", "server=~web[0-9]+", `dc!='quoted'`}
+	query := SeriesByTagQuery(exprs)
+	assert.Equal(t,
+		`seriesByTag('name=disk.used','server=~web[0-9]+',"dc!='quoted'")`, query)
+	assert.True(t, IsSeriesByTagQuery(query))
+	assert.False(t, IsSeriesByTagQuery("foo.bar.*"))
+
+	parsed, err := ParseSeriesByTagQuery(query)
+	require.NoError(t, err)
+	assert.Equal(t, []TagExpression{
+		{"name", TagOperatorEqual, "disk.used"},
+		{"server", TagOperatorRegexp, "web[0-9]+"},
+		{"dc", TagOperatorNotEqual, "'quoted'"},
+	}, parsed)
+
+	parsed, err = ParseSeriesByTagQuery(`seriesByTag('a=b,c', "d=e")`)
+	require.NoError(t, err)
+	assert.Equal(t, []TagExpression{
+		{"a", TagOperatorEqual, "b,c"},
+		{"d", TagOperatorEqual, "e"},
+	}, parsed)
+}
+
+func TestParseInvalidSeriesByTagQuery(t *testing.T) {
+	for _, query := range []string{
+		"foo.bar",
+		"seriesByTag()",
+		"seriesByTag('a=b'",
+		"seriesByTag('a=b)",
+		"seriesByTag(a=b)",
+		"seriesByTag('a')",
+	} {
+		_, err := ParseSeriesByTagQuery(query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestTagIndex(t *testing.T) {
+	for i := 0; i < 2*numPreFormattedTagNames; i++ {
+		idx, ok := TagIndex(TagName(i))
+		require.True(t, ok)
+		require.Equal(t, i, idx)
+	}
+
+	for _, name := range []string{"__g__", "__gx__", "__g-1__", "g1", "dc"} {
+		_, ok := TagIndex([]byte(name))
+		assert.False(t, ok, name)
+	}
+}
+
+func TestTaggedName(t *testing.T) {
+	tags := map[string]string{"server": "web01", "dc": "dc1"}
+	name := TaggedName("disk.used", tags)
+	assert.Equal(t, "disk.used;dc=dc1;server=web01", name)
+	assert.Equal(t, "disk.used", TaggedName("disk.used", nil))
+
+	parsed := ParseTaggedName(name)
+	assert.Equal(t, map[string]string{
+		NameTag:  "disk.used",
+		"server": "web01",
+		"dc":     "dc1",
+	}, parsed)
+
+	assert.Equal(t, map[string]string{NameTag: "disk.used"},
+		ParseTaggedName("disk.used"))
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/m3db/m3/src/query/graphite/common"
 	"github.com/m3db/m3/src/query/graphite/errors"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 )
 
@@ -156,6 +157,23 @@ func sumSeriesWithWildcards(
 	return combineSeriesWithWildcards(ctx, series, positions, sumSpecificationFunc, ts.Sum)
 }
 
+// aggregateWithWildcards splits the given set of series into sub-groupings
+// based on wildcard matches in the hierarchy, then aggregates the values in
+// each grouping with the given function
+func aggregateWithWildcards(
+	ctx *common.Context,
+	series singlePathSpec,
+	fname string,
+	positions ...int,
+) (ts.SeriesList, error) {
+	f, fexists := summarizeFuncs[fname]
+	if !fexists {
+		return ts.SeriesList{}, errors.NewInvalidParamsError(fmt.Errorf("invalid func %s", fname))
+	}
+
+	return combineSeriesWithWildcards(ctx, series, positions, f.specificationFunc, f.consolidationFunc)
+}
+
 // combineSeriesWithWildcards splits the given set of series into sub-groupings
 // based on wildcard matches in the hierarchy, then combines the values in each
 // sub-grouping according to the provided consolidation function
@@ -274,6 +292,70 @@ func groupByNode(ctx *common.Context, series singlePathSpec, node int, fname str
 	return r, nil
 }
 
+// groupByTags takes a serieslist and maps a callback to subgroups within as
+// defined by a common set of tag values
+//
+//    &target=groupByTags(seriesByTag("name=cpu","dc=dc1"),"sum","datacenter")
+//
+//  Would return a series for each datacenter which is the result of applying
+//  the "sum" function to every series with that datacenter, named
+//  sum;datacenter=dc1. Grouping by the name tag uses the series path in place
+//  of the function name.
+func groupByTags(
+	ctx *common.Context,
+	series singlePathSpec,
+	fname string,
+	tags ...string,
+) (ts.SeriesList, error) {
+	if len(tags) == 0 {
+		return ts.SeriesList{}, errors.NewInvalidParamsError(errors.New("groupByTags requires at least one tag"))
+	}
+
+	f, fexists := summarizeFuncs[fname]
+	if !fexists {
+		return ts.SeriesList{}, errors.NewInvalidParamsError(fmt.Errorf("invalid func %s", fname))
+	}
+
+	metaSeries := make(map[string][]*ts.Series)
+	for _, s := range series.Values {
+		seriesTags := graphite.ParseTaggedName(s.Name())
+		base := fname
+		groupTags := make(map[string]string, len(tags))
+		for _, tag := range tags {
+			if tag == graphite.NameTag {
+				base = seriesTags[graphite.NameTag]
+				continue
+			}
+
+			groupTags[tag] = seriesTags[tag]
+		}
+
+		key := graphite.TaggedName(base, groupTags)
+		metaSeries[key] = append(metaSeries[key], s)
+	}
+
+	newSeries := make([]*ts.Series, 0, len(metaSeries))
+	for key, series := range metaSeries {
+		seriesList := ts.SeriesList{Values: series}
+		output, err := combineSeries(ctx, multiplePathSpecs(seriesList), key, f.consolidationFunc)
+		if err != nil {
+			return ts.SeriesList{}, err
+		}
+		output.Values[0].Specification = f.specificationFunc(seriesList)
+		newSeries = append(newSeries, output.Values...)
+	}
+
+	r := ts.SeriesList(series)
+
+	r.Values = newSeries
+
+	// Ranging over hash map to create results destroys
+	// any sort order on the incoming series list
+	r.SortApplied = false
+
+	return r, nil
+}
+
 // combineSeries combines multiple series into a single series using a
 // consolidation func.  If the series use different time intervals, the
 // coarsest time will apply.
@@ -184,6 +184,40 @@ func TestVariadicSumSeries(t *testing.T) {
 	assert.Equal(t, []float64{10, 10, 10}, r.Values[0].SafeValues())
 }
 
+func TestSeriesByTagAggregation(t *testing.T) {
+	expr, err := compile(`groupByTags(seriesByTag('name=disk.used', 'dc=~dc[12]'), 'sum', 'dc')`)
+	require.NoError(t, err)
+
+	ctx := common.NewTestContext()
+	ctx.Engine = mockEngine{fn: func(
+		ctx context.Context,
+		query string,
+		start, end time.Time,
+		timeout time.Duration,
+	) (*storage.FetchResult, error) {
+		switch query {
+		case "seriesByTag('name=disk.used','dc=~dc[12]')":
+			return storage.NewFetchResult(ctx, []*ts.Series{
+				ts.NewSeries(ctx, "disk.used;dc=dc1;server=a", start, ts.NewConstantValues(ctx, 1, 3, 1000)),
+				ts.NewSeries(ctx, "disk.used;dc=dc1;server=b", start, ts.NewConstantValues(ctx, 2, 3, 1000)),
+				ts.NewSeries(ctx, "disk.used;dc=dc2;server=c", start, ts.NewConstantValues(ctx, 4, 3, 1000)),
+			}), nil
+		}
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}}
+
+	r, err := expr.Execute(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, 2, r.Len())
+	r, err = sortByName(ctx, singlePathSpec(r))
+	require.NoError(t, err)
+	assert.Equal(t, "sum;dc=dc1", r.Values[0].Name())
+	assert.Equal(t, []float64{3, 3, 3}, r.Values[0].SafeValues())
+	assert.Equal(t, "sum;dc=dc2", r.Values[1].Name())
+	assert.Equal(t, []float64{4, 4, 4}, r.Values[1].SafeValues())
+}
+
 func TestDiffSeries(t *testing.T) {
 	testAggregatedSeries(t, diffSeries, -15.0, -8.0, -10.0, -17.0, "invalid diff value for step %d")
 }
@@ -325,6 +359,91 @@ func TestSumSeriesWithWildcards(t *testing.T) {
 	}
 }
 
+func TestAggregateWithWildcards(t *testing.T) {
+	var (
+		start, _ = time.Parse(time.RFC1123, "Mon, 27 Jul 2015 19:41:19 GMT")
+		end, _   = time.Parse(time.RFC1123, "Mon, 27 Jul 2015 19:43:19 GMT")
+		ctx      = common.NewContext(common.ContextOptions{Start: start, End: end})
+		inputs   = []*ts.Series{
+			ts.NewSeries(ctx, "servers.foo-1.pod1.status.500", start,
+				ts.NewConstantValues(ctx, 2, 12, 10000)),
+			ts.NewSeries(ctx, "servers.foo-2.pod1.status.500", start,
+				ts.NewConstantValues(ctx, 4, 12, 10000)),
+			ts.NewSeries(ctx, "servers.foo-1.pod1.status.400", start,
+				ts.NewConstantValues(ctx, 20, 12, 10000)),
+		}
+	)
+	defer ctx.Close()
+
+	outSeries, err := aggregateWithWildcards(ctx, singlePathSpec{
+		Values: inputs,
+	}, "max", 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(outSeries.Values))
+
+	outSeries, _ = sortByName(ctx, singlePathSpec(outSeries))
+	assert.Equal(t, "servers.pod1.status.400", outSeries.Values[0].Name())
+	assert.Equal(t, 20.0, outSeries.Values[0].ValueAt(0))
+	assert.Equal(t, "servers.pod1.status.500", outSeries.Values[1].Name())
+	assert.Equal(t, 4.0, outSeries.Values[1].ValueAt(0))
+
+	_, err = aggregateWithWildcards(ctx, singlePathSpec{
+		Values: inputs,
+	}, "unknown", 1)
+	require.Error(t, err)
+}
+
+func TestGroupByTags(t *testing.T) {
+	var (
+		start, _ = time.Parse(time.RFC1123, "Mon, 27 Jul 2015 19:41:19 GMT")
+		end, _   = time.Parse(time.RFC1123, "Mon, 27 Jul 2015 19:43:19 GMT")
+		ctx      = common.NewContext(common.ContextOptions{Start: start, End: end})
+		inputs   = []*ts.Series{
+			ts.NewSeries(ctx, "disk.used;dc=dc1;server=web01", start,
+				ts.NewConstantValues(ctx, 2, 12, 10000)),
+			ts.NewSeries(ctx, "disk.used;dc=dc1;server=web02", start,
+				ts.NewConstantValues(ctx, 4, 12, 10000)),
+			ts.NewSeries(ctx, "disk.used;dc=dc2;server=web03", start,
+				ts.NewConstantValues(ctx, 6, 12, 10000)),
+		}
+	)
+	defer ctx.Close()
+
+	tests := []struct {
+		fname    string
+		tags     []string
+		expected map[string]float64
+	}{
+		{"sum", []string{"dc"}, map[string]float64{
+			"sum;dc=dc1": 6,
+			"sum;dc=dc2": 6,
+		}},
+		{"max", []string{"name", "dc"}, map[string]float64{
+			"disk.used;dc=dc1": 4,
+			"disk.used;dc=dc2": 6,
+		}},
+		{"avg", []string{"missing"}, map[string]float64{
+			"avg;missing=": 4,
+		}},
+	}
+
+	for _, test := range tests {
+		outSeries, err := groupByTags(ctx, singlePathSpec{
+			Values: inputs,
+		}, test.fname, test.tags...)
+		require.NoError(t, err)
+		require.Equal(t, len(test.expected), len(outSeries.Values))
+		for _, series := range outSeries.Values {
+			expected, ok := test.expected[series.Name()]
+			require.True(t, ok, "unexpected series %s", series.Name())
+			assert.Equal(t, expected, series.ValueAt(0))
+		}
+	}
+
+	_, err := groupByTags(ctx, singlePathSpec{Values: inputs}, "sum")
+	require.Error(t, err)
+}
+
 func TestGroupByNode(t *testing.T) {
 	var (
 		start, _ = time.Parse(time.RFC1123, "Mon, 27 Jul 2015 19:41:19 GMT")
@@ -21,7 +21,12 @@
 package native
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/m3db/m3/src/query/graphite/common"
+	"github.com/m3db/m3/src/query/graphite/errors"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 )
 
@@ -43,6 +48,63 @@ func aliasByNode(ctx *common.Context, seriesList singlePathSpec, nodes ...int) (
 	return common.AliasByNode(ctx, ts.SeriesList(seriesList), nodes...)
 }
 
+// aliasByTags renames a time series result according to a list of tags, or
+// nodes of its path when given numbers, joined by dots.
+func aliasByTags(_ *common.Context, seriesList singlePathSpec, tags ...genericInterface) (ts.SeriesList, error) {
+	renamed := make([]*ts.Series, 0, len(seriesList.Values))
+	for _, series := range seriesList.Values {
+		name := series.Name()
+		// NB: use the innermost series name for series which have already been
+		// wrapped by other functions.
+		left := strings.LastIndex(name, "(") + 1
+		name = name[left:]
+		if right := strings.IndexAny(name, ",)"); right != -1 {
+			name = name[:right]
+		}
+
+		seriesTags := graphite.ParseTaggedName(name)
+		nameParts := strings.Split(seriesTags[graphite.NameTag], ".")
+		newNameParts := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			switch t := tag.(type) {
+			case string:
+				newNameParts = append(newNameParts, seriesTags[t])
+			case float64:
+				if part, ok := pathNode(nameParts, int(t)); ok {
+					newNameParts = append(newNameParts, part)
+				}
+			case int:
+				if part, ok := pathNode(nameParts, t); ok {
+					newNameParts = append(newNameParts, part)
+				}
+			default:
+				err := errors.NewInvalidParamsError(fmt.Errorf("invalid tag or node %v", tag))
+				return ts.SeriesList{}, err
+			}
+		}
+
+		renamed = append(renamed, series.RenamedTo(strings.Join(newNameParts, ".")))
+	}
+
+	r := ts.SeriesList(seriesList)
+	r.Values = renamed
+	return r, nil
+}
+
+// pathNode returns the path part at the given node, supporting negative
+// indexing from the end of the path like graphite does.
+func pathNode(parts []string, node int) (string, bool) {
+	if node < 0 {
+		node += len(parts)
+	}
+
+	if node < 0 || node >= len(parts) {
+		return "", false
+	}
+
+	return parts[node], true
+}
+
 // aliasSub runs series names through a regex search/replace.
 func aliasSub(ctx *common.Context, input singlePathSpec, search, replace string) (ts.SeriesList, error) {
 	return common.AliasSub(ctx, ts.SeriesList(input), search, replace)
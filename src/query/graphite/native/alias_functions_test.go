@@ -194,6 +194,42 @@ func TestAliasByNode(t *testing.T) {
 	assert.Equal(t, "P75", results.Values[2].Name())
 }
 
+func TestAliasByTags(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	values := ts.NewConstantValues(ctx, 10.0, 1000, 10)
+
+	series := []*ts.Series{
+		ts.NewSeries(ctx, "disk.used;datacenter=dc1;server=web01", now, values),
+		ts.NewSeries(ctx, "sumSeries(disk.free;datacenter=dc2;server=web02)", now, values),
+		ts.NewSeries(ctx, "disk.total", now, values),
+	}
+
+	results, err := aliasByTags(ctx, singlePathSpec{
+		Values: series,
+	}, "server", 1.0, "name")
+	require.Nil(t, err)
+	require.Equal(t, len(series), results.Len())
+	assert.Equal(t, "web01.used.disk.used", results.Values[0].Name())
+	assert.Equal(t, "web02.free.disk.free", results.Values[1].Name())
+	assert.Equal(t, ".total.disk.total", results.Values[2].Name())
+
+	results, err = aliasByTags(ctx, singlePathSpec{
+		Values: series,
+	}, "datacenter", -1)
+	require.Nil(t, err)
+	assert.Equal(t, "dc1.used", results.Values[0].Name())
+	assert.Equal(t, "dc2.free", results.Values[1].Name())
+	assert.Equal(t, ".total", results.Values[2].Name())
+
+	_, err = aliasByTags(ctx, singlePathSpec{
+		Values: series,
+	}, true)
+	require.Error(t, err)
+}
+
 func TestAliasByNodeWithComposition(t *testing.T) {
 	ctx := common.NewTestContext()
 	defer ctx.Close()
@@ -32,6 +32,7 @@ import (
 
 	"github.com/m3db/m3/src/query/graphite/common"
 	"github.com/m3db/m3/src/query/graphite/errors"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 )
 
@@ -180,6 +181,23 @@ func constantLine(ctx *common.Context, value float64) (ts.SeriesList, error) {
 	return ts.SeriesList{Values: []*ts.Series{newSeries}}, nil
 }
 
+// seriesByTag returns the series matching all of the given tag expressions,
+// e.g. seriesByTag('name=disk.used', 'datacenter=~dc[12]').
+func seriesByTag(ctx *common.Context, tagExpressions ...string) (ts.SeriesList, error) {
+	query := graphite.SeriesByTagQuery(tagExpressions)
+	result, err := ctx.Engine.FetchByQuery(ctx, query, ctx.StartTime,
+		ctx.EndTime, ctx.Timeout)
+	if err != nil {
+		return ts.SeriesList{}, err
+	}
+
+	for _, r := range result.SeriesList {
+		r.Specification = query
+	}
+
+	return ts.SeriesList{Values: result.SeriesList}, nil
+}
+
 // identity returns datapoints where the value equals the timestamp of the datapoint.
 func identity(ctx *common.Context, name string) (ts.SeriesList, error) {
 	return common.Identity(ctx, name)
@@ -1823,9 +1841,11 @@ func init() {
 	MustRegisterFunction(aggregateLine).WithDefaultParams(map[uint8]interface{}{
 		2: "avg", // f
 	})
+	MustRegisterFunction(aggregateWithWildcards)
 	MustRegisterFunction(alias)
 	MustRegisterFunction(aliasByMetric)
 	MustRegisterFunction(aliasByNode)
+	MustRegisterFunction(aliasByTags)
 	MustRegisterFunction(aliasSub)
 	MustRegisterFunction(asPercent).WithDefaultParams(map[uint8]interface{}{
 		2: []*ts.Series(nil), // total
@@ -1850,6 +1870,7 @@ func init() {
 	MustRegisterFunction(fallbackSeries)
 	MustRegisterFunction(group)
 	MustRegisterFunction(groupByNode)
+	MustRegisterFunction(groupByTags)
 	MustRegisterFunction(highestAverage)
 	MustRegisterFunction(highestCurrent)
 	MustRegisterFunction(highestMax)
@@ -1901,6 +1922,7 @@ func init() {
 	MustRegisterFunction(removeEmptySeries)
 	MustRegisterFunction(scale)
 	MustRegisterFunction(scaleToSeconds)
+	MustRegisterFunction(seriesByTag)
 	MustRegisterFunction(sortByMaxima)
 	MustRegisterFunction(sortByName)
 	MustRegisterFunction(sortByTotal)
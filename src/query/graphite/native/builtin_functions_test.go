@@ -2779,9 +2779,11 @@ func TestFunctionsRegistered(t *testing.T) {
 		"abs",
 		"absolute",
 		"aggregateLine",
+		"aggregateWithWildcards",
 		"alias",
 		"aliasByMetric",
 		"aliasByNode",
+		"aliasByTags",
 		"aliasSub",
 		"asPercent",
 		"averageAbove",
@@ -2803,6 +2805,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"fallbackSeries",
 		"group",
 		"groupByNode",
+		"groupByTags",
 		"highestAverage",
 		"highestCurrent",
 		"highestMax",
@@ -2845,6 +2848,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"removeEmptySeries",
 		"scale",
 		"scaleToSeconds",
+		"seriesByTag",
 		"sortByMaxima",
 		"sortByName",
 		"sortByTotal",
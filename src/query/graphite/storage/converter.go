@@ -21,14 +21,27 @@
 package storage
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/models"
 )
 
 var (
 	wildcard = []byte(".*")
+	nonEmpty = []byte(".+")
+
+	errNoPositiveTagExpression = fmt.Errorf(
+		"%s requires at least one tag expression with = or =~ and a non-empty value",
+		graphite.SeriesByTagFunction)
 )
 
+// pathFilter is applied to the path of fetched series for tag expressions on
+// the name tag which cannot be expressed as tag matchers.
+type pathFilter func(path string) bool
+
 func convertMetricPartToMatcher(
 	count int,
 	metric string,
@@ -59,3 +72,160 @@ func matcherTerminator(count int) models.Matcher {
 		Value: wildcard,
 	}
 }
+
+// convertTagExpressionsToMatchers converts seriesByTag tag expressions into
+// tag matchers. An exact match on the name tag is converted into matchers on
+// each of the path tags, any other expression on the name tag is returned as
+// a filter to be applied to the path of fetched series.
+func convertTagExpressionsToMatchers(
+	exprs []graphite.TagExpression,
+) (models.Matchers, []pathFilter, error) {
+	var (
+		matchers models.Matchers
+		filters  []pathFilter
+		positive bool
+		indexed  bool
+	)
+
+	for _, expr := range exprs {
+		if expr.Positive() {
+			positive = true
+		}
+
+		if expr.Tag != graphite.NameTag {
+			m, err := convertTagExpressionToMatcher(expr)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			indexed = indexed || expr.Positive()
+			matchers = append(matchers, m)
+			continue
+		}
+
+		if expr.Operator == graphite.TagOperatorEqual && expr.Value != "" {
+			matchers = append(matchers, pathToMatchers(expr.Value)...)
+			indexed = true
+			continue
+		}
+
+		filter, err := newPathFilter(expr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		filters = append(filters, filter)
+	}
+
+	if !positive {
+		return nil, nil, errNoPositiveTagExpression
+	}
+
+	if !indexed {
+		// NB: only name filters select series, so fetch every graphite series
+		// and rely on the filters to narrow them down.
+		matchers = append(matchers, models.Matcher{
+			Type:  models.MatchRegexp,
+			Name:  graphite.TagName(0),
+			Value: nonEmpty,
+		})
+	}
+
+	return matchers, filters, nil
+}
+
+// TranslateTagExpressionsToMatchers converts the tag expressions of a tagged
+// path query, e.g. dc=dc1 and server=~web.* for disk.*;dc=dc1;server=~web.*,
+// into tag matchers. Expressions on the name tag are not supported as the
+// path of such queries is matched by the path itself.
+func TranslateTagExpressionsToMatchers(exprs []string) (models.Matchers, error) {
+	matchers := make(models.Matchers, 0, len(exprs))
+	for _, e := range exprs {
+		expr, err := graphite.ParseTagExpression(e)
+		if err != nil {
+			return nil, err
+		}
+
+		if expr.Tag == graphite.NameTag {
+			return nil, fmt.Errorf("cannot match the %s tag of a path query: %s",
+				graphite.NameTag, e)
+		}
+
+		m, err := convertTagExpressionToMatcher(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		matchers = append(matchers, m)
+	}
+
+	return matchers, nil
+}
+
+func convertTagExpressionToMatcher(
+	expr graphite.TagExpression,
+) (models.Matcher, error) {
+	var (
+		name  = []byte(expr.Tag)
+		value = []byte(expr.Value)
+	)
+
+	switch expr.Operator {
+	case graphite.TagOperatorEqual:
+		if len(value) == 0 {
+			// NB: an empty value matches series without the tag.
+			return models.Matcher{Type: models.MatchNotRegexp, Name: name, Value: nonEmpty}, nil
+		}
+
+		return models.Matcher{Type: models.MatchEqual, Name: name, Value: value}, nil
+	case graphite.TagOperatorNotEqual:
+		if len(value) == 0 {
+			// NB: a non-empty value matches series with the tag.
+			return models.Matcher{Type: models.MatchRegexp, Name: name, Value: nonEmpty}, nil
+		}
+
+		return models.Matcher{Type: models.MatchNotEqual, Name: name, Value: value}, nil
+	case graphite.TagOperatorRegexp:
+		return models.Matcher{Type: models.MatchRegexp, Name: name, Value: value}, nil
+	case graphite.TagOperatorNotRegexp:
+		return models.Matcher{Type: models.MatchNotRegexp, Name: name, Value: value}, nil
+	default:
+		return models.Matcher{}, fmt.Errorf("unknown tag operator: %s", expr.Operator)
+	}
+}
+
+// pathToMatchers converts an exact graphite path into equality matchers on
+// each of its path tags, terminated so that longer paths do not match.
+func pathToMatchers(path string) models.Matchers {
+	parts := strings.Split(path, ".")
+	matchers := make(models.Matchers, 0, len(parts)+1)
+	for i, part := range parts {
+		matchers = append(matchers, models.Matcher{
+			Type:  models.MatchEqual,
+			Name:  graphite.TagName(i),
+			Value: []byte(part),
+		})
+	}
+
+	return append(matchers, matcherTerminator(len(parts)))
+}
+
+func newPathFilter(expr graphite.TagExpression) (pathFilter, error) {
+	switch expr.Operator {
+	case graphite.TagOperatorEqual:
+		return func(path string) bool { return path == expr.Value }, nil
+	case graphite.TagOperatorNotEqual:
+		return func(path string) bool { return path != expr.Value }, nil
+	}
+
+	re, err := regexp.Compile("^(?:" + expr.Value + ")$")
+	if err != nil {
+		return nil, err
+	}
+
+	if expr.Operator == graphite.TagOperatorNotRegexp {
+		return func(path string) bool { return !re.MatchString(path) }, nil
+	}
+
+	return re.MatchString, nil
+}
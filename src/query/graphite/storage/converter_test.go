@@ -70,3 +70,70 @@ func TestMatcherTerminator(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	}
 }
+
+func TestConvertTagExpressionsToMatchers(t *testing.T) {
+	exprs := []graphite.TagExpression{
+		{Tag: "name", Operator: graphite.TagOperatorEqual, Value: "disk.used"},
+		{Tag: "dc", Operator: graphite.TagOperatorEqual, Value: "dc1"},
+		{Tag: "server", Operator: graphite.TagOperatorRegexp, Value: "web.*"},
+		{Tag: "env", Operator: graphite.TagOperatorNotEqual, Value: "test"},
+		{Tag: "rack", Operator: graphite.TagOperatorNotRegexp, Value: "r1.*"},
+		{Tag: "shard", Operator: graphite.TagOperatorEqual, Value: ""},
+		{Tag: "zone", Operator: graphite.TagOperatorNotEqual, Value: ""},
+	}
+
+	matchers, filters, err := convertTagExpressionsToMatchers(exprs)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(filters))
+	expected := models.Matchers{
+		{Type: models.MatchEqual, Name: graphite.TagName(0), Value: []byte("disk")},
+		{Type: models.MatchEqual, Name: graphite.TagName(1), Value: []byte("used")},
+		{Type: models.MatchNotRegexp, Name: graphite.TagName(2), Value: []byte(".*")},
+		{Type: models.MatchEqual, Name: []byte("dc"), Value: []byte("dc1")},
+		{Type: models.MatchRegexp, Name: []byte("server"), Value: []byte("web.*")},
+		{Type: models.MatchNotEqual, Name: []byte("env"), Value: []byte("test")},
+		{Type: models.MatchNotRegexp, Name: []byte("rack"), Value: []byte("r1.*")},
+		{Type: models.MatchNotRegexp, Name: []byte("shard"), Value: []byte(".+")},
+		{Type: models.MatchRegexp, Name: []byte("zone"), Value: []byte(".+")},
+	}
+
+	assert.Equal(t, expected, matchers)
+}
+
+func TestConvertNameTagExpressionsToFilters(t *testing.T) {
+	exprs := []graphite.TagExpression{
+		{Tag: "name", Operator: graphite.TagOperatorRegexp, Value: "disk\\..*"},
+		{Tag: "name", Operator: graphite.TagOperatorNotEqual, Value: "disk.free"},
+	}
+
+	matchers, filters, err := convertTagExpressionsToMatchers(exprs)
+	require.NoError(t, err)
+	assert.Equal(t, models.Matchers{
+		{Type: models.MatchRegexp, Name: graphite.TagName(0), Value: []byte(".+")},
+	}, matchers)
+
+	require.Equal(t, 2, len(filters))
+	matches := func(path string) bool {
+		for _, filter := range filters {
+			if !filter(path) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	assert.True(t, matches("disk.used"))
+	assert.False(t, matches("disk.free"))
+	assert.False(t, matches("cpu.disk.used"))
+}
+
+func TestConvertTagExpressionsRequiresPositive(t *testing.T) {
+	exprs := []graphite.TagExpression{
+		{Tag: "dc", Operator: graphite.TagOperatorNotEqual, Value: "dc1"},
+		{Tag: "server", Operator: graphite.TagOperatorEqual, Value: ""},
+	}
+
+	_, _, err := convertTagExpressionsToMatchers(exprs)
+	require.Error(t, err)
+}
@@ -22,12 +22,13 @@ package storage
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/m3db/m3/src/query/cost"
 	xctx "github.com/m3db/m3/src/query/graphite/context"
+	"github.com/m3db/m3/src/query/graphite/errors"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 	"github.com/m3db/m3/src/query/models"
@@ -113,6 +114,83 @@ func translateQuery(query string, opts FetchOptions) (*storage.FetchQuery, error
 	}, nil
 }
 
+func translateSeriesByTagQuery(
+	query string,
+	opts FetchOptions,
+) (*storage.FetchQuery, []pathFilter, error) {
+	exprs, err := graphite.ParseSeriesByTagQuery(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matchers, filters, err := convertTagExpressionsToMatchers(exprs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &storage.FetchQuery{
+		Raw:         query,
+		TagMatchers: matchers,
+		Start:       opts.StartTime,
+		End:         opts.EndTime,
+		Interval:    time.Duration(0),
+	}, filters, nil
+}
+
+// translateTaggedSeries names series fetched by a seriesByTag query using
+// their graphite path and any additional tags, dropping series which do not
+// match the path filters.
+func translateTaggedSeries(
+	m3list m3ts.SeriesList,
+	filters []pathFilter,
+) m3ts.SeriesList {
+	result := m3list[:0]
+	for _, m3series := range m3list {
+		var (
+			parts []string
+			tags  = make(map[string]string, m3series.Tags.Len())
+		)
+
+		for _, tag := range m3series.Tags.Tags {
+			idx, ok := graphite.TagIndex(tag.Name)
+			if !ok {
+				tags[string(tag.Name)] = string(tag.Value)
+				continue
+			}
+
+			for len(parts) <= idx {
+				parts = append(parts, "")
+			}
+
+			parts[idx] = string(tag.Value)
+		}
+
+		path := strings.Join(parts, ".")
+		if len(parts) == 0 {
+			path = string(m3series.Name())
+		}
+
+		matches := true
+		for _, filter := range filters {
+			if !filter(path) {
+				matches = false
+				break
+			}
+		}
+
+		if !matches {
+			continue
+		}
+
+		name := []byte(graphite.TaggedName(path, tags))
+		series := m3ts.NewSeries(name, m3series.Values(), m3series.Tags)
+		series.SetResolution(m3series.Resolution())
+		result = append(result, series)
+	}
+
+	return result
+}
+
 func translateTimeseries(
 	ctx xctx.Context,
 	m3list m3ts.SeriesList,
@@ -147,7 +225,24 @@ func translateTimeseries(
 func (s *m3WrappedStore) FetchByQuery(
 	ctx xctx.Context, query string, opts FetchOptions,
 ) (*FetchResult, error) {
-	m3query, err := translateQuery(query, opts)
+	var (
+		m3query *storage.FetchQuery
+		filters []pathFilter
+		tagged  = graphite.IsSeriesByTagQuery(query)
+		err     error
+	)
+
+	if tagged {
+		m3query, filters, err = translateSeriesByTagQuery(query, opts)
+		if err != nil {
+			// NB: invalid tag expressions are a user error rather than a
+			// query which does not translate to any series.
+			return nil, errors.NewInvalidParamsError(err)
+		}
+	} else {
+		m3query, err = translateQuery(query, opts)
+	}
+
 	if err != nil {
 		// NB: error here implies the query cannot be translated; empty set expected
 		// rather than propagating an error.
@@ -177,7 +272,12 @@ func (s *m3WrappedStore) FetchByQuery(
 		return nil, err
 	}
 
-	series, err := translateTimeseries(ctx, m3result.SeriesList,
+	m3list := m3result.SeriesList
+	if tagged {
+		m3list = translateTaggedSeries(m3list, filters)
+	}
+
+	series, err := translateTimeseries(ctx, m3list,
 		opts.StartTime, opts.EndTime)
 	if err != nil {
 		return nil, err
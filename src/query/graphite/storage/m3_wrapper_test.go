@@ -196,6 +196,57 @@ func TestFetchByQuery(t *testing.T) {
 	assert.Equal(t, childEnforcer, store.LastFetchOptions().Enforcer)
 }
 
+func TestFetchBySeriesByTagQuery(t *testing.T) {
+	store := mock.NewMockStorage()
+	start := time.Now().Add(time.Hour * -1)
+	resolution := 10 * time.Second
+	steps := 3
+	newSeries := func(value float64, tags ...string) *m3ts.Series {
+		seriesTags := models.NewTags(len(tags)/2, models.NewTagOptions())
+		for i := 0; i < len(tags); i += 2 {
+			seriesTags = seriesTags.AddTag(models.Tag{
+				Name:  []byte(tags[i]),
+				Value: []byte(tags[i+1]),
+			})
+		}
+
+		vals := m3ts.NewFixedStepValues(resolution, steps, value, start)
+		series := m3ts.NewSeries([]byte("id"), vals, seriesTags)
+		series.SetResolution(resolution)
+		return series
+	}
+
+	seriesList := m3ts.SeriesList{
+		newSeries(1, "__g0__", "disk", "__g1__", "used", "dc", "dc1"),
+		newSeries(2, "__g0__", "disk", "__g1__", "free", "dc", "dc1"),
+	}
+
+	store.SetFetchResult(&storage.FetchResult{SeriesList: seriesList}, nil)
+	wrapper := NewM3WrappedStorage(store, nil)
+	ctx := xctx.New()
+	ctx.SetRequestContext(context.TODO())
+	end := time.Now()
+	opts := FetchOptions{
+		StartTime: start,
+		EndTime:   end,
+		DataOptions: DataOptions{
+			Timeout: time.Minute,
+		},
+	}
+
+	query := graphite.SeriesByTagQuery([]string{"dc=dc1", "name!=disk.free"})
+	result, err := wrapper.FetchByQuery(ctx, query, opts)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.SeriesList))
+	series := result.SeriesList[0]
+	assert.Equal(t, "disk.used;dc=dc1", series.Name())
+	assert.Equal(t, []float64{1, 1, 1}, series.SafeValues())
+
+	query = graphite.SeriesByTagQuery([]string{"dc!=dc1"})
+	_, err = wrapper.FetchByQuery(ctx, query, opts)
+	require.Error(t, err)
+}
+
 func TestFetchByInvalidQuery(t *testing.T) {
 	logging.InitWithCores(nil)
 	store := mock.NewMockStorage()
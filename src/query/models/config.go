@@ -30,6 +30,7 @@ var validIDSchemes = []IDSchemeType{
 	TypeQuoted,
 	TypePrependMeta,
 	TypeGraphite,
+	TypeGraphiteTagged,
 }
 
 // Validate validates that the scheme type is valid.
@@ -38,7 +39,7 @@ func (t IDSchemeType) Validate() error {
 		return errors.New("id scheme type not set")
 	}
 
-	if t >= TypeLegacy && t <= TypeGraphite {
+	if t >= TypeLegacy && t <= TypeGraphiteTagged {
 		return nil
 	}
 
@@ -58,6 +59,8 @@ func (t IDSchemeType) String() string {
 		return "prepend_meta"
 	case TypeGraphite:
 		return "graphite"
+	case TypeGraphiteTagged:
+		return "graphite_tagged"
 	default:
 		// Should never get here.
 		return "unknown"
@@ -72,10 +75,10 @@ func (t *IDSchemeType) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 
 	for _, valid := range validIDSchemes {
-		if valid == TypeGraphite {
-			// NB: while the graphite scheme is valid, it is not available to choose
-			// as a general ID scheme; instead, it is set on any metric coming through
-			// the graphite ingestion path.
+		if valid == TypeGraphite || valid == TypeGraphiteTagged {
+			// NB: while the graphite schemes are valid, they are not available to
+			// choose as a general ID scheme; instead, they are set on any metric
+			// coming through the graphite ingestion path.
 			continue
 		}
 
@@ -69,8 +69,8 @@ func TestBadBucketTagOptions(t *testing.T) {
 
 func TestBadSchemeTagOptions(t *testing.T) {
 	msg := "invalid config id schema type 'unknown': should be one of" +
-		" [legacy quoted prepend_meta graphite]"
+		" [legacy quoted prepend_meta graphite graphite_tagged]"
 	opts := NewTagOptions().
-		SetIDSchemeType(IDSchemeType(6))
+		SetIDSchemeType(IDSchemeType(7))
 	assert.EqualError(t, opts.Validate(), msg)
 }
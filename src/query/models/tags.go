@@ -69,6 +69,8 @@ func (t Tags) ID() []byte {
 		return t.prependMetaID()
 	case TypeGraphite:
 		return t.graphiteID()
+	case TypeGraphiteTagged:
+		return t.taggedGraphiteID()
 	default:
 		// Default to prepending meta
 		return t.prependMetaID()
@@ -273,6 +275,78 @@ func (t Tags) idLenGraphite() int {
 	return idLen
 }
 
+func (t Tags) taggedGraphiteID() []byte {
+	// TODO: pool these bytes.
+	id := make([]byte, 0, t.idLenTaggedGraphite())
+	numPathTags := 0
+	for _, tag := range t.Tags {
+		if !isGraphitePathTag(tag.Name) {
+			continue
+		}
+
+		if numPathTags > 0 {
+			id = append(id, graphiteSep)
+		}
+
+		id = append(id, tag.Value...)
+		numPathTags++
+	}
+
+	// Tags other than path tags are appended in the graphite tagged format,
+	// e.g. disk.used;dc=dc1;server=web01.
+	for _, tag := range t.Tags {
+		if isGraphitePathTag(tag.Name) {
+			continue
+		}
+
+		id = append(id, graphiteTagSep)
+		id = append(id, tag.Name...)
+		id = append(id, eq)
+		id = append(id, tag.Value...)
+	}
+
+	return id
+}
+
+func (t Tags) idLenTaggedGraphite() int {
+	idLen, numPathTags := 0, 0
+	for _, tag := range t.Tags {
+		idLen += len(tag.Value)
+		if isGraphitePathTag(tag.Name) {
+			numPathTags++
+			continue
+		}
+
+		// Account for the tag and value separators.
+		idLen += len(tag.Name) + 2
+	}
+
+	if numPathTags > 0 {
+		// Account for separators between path tags.
+		idLen += numPathTags - 1
+	}
+
+	return idLen
+}
+
+// isGraphitePathTag returns true if the tag name is the name of a graphite
+// path tag, e.g. __g0__.
+func isGraphitePathTag(name []byte) bool {
+	if len(name) <= len(graphitePathTagPrefix)+len(graphitePathTagSuffix) ||
+		!bytes.HasPrefix(name, graphitePathTagPrefix) ||
+		!bytes.HasSuffix(name, graphitePathTagSuffix) {
+		return false
+	}
+
+	for _, c := range name[len(graphitePathTagPrefix) : len(name)-len(graphitePathTagSuffix)] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (t Tags) tagSubset(keys [][]byte, include bool) Tags {
 	tags := NewTags(t.Len(), t.Opts)
 	for _, tag := range t.Tags {
@@ -403,7 +477,33 @@ func (t sortableTagsNumericallyAsc) Swap(i, j int) {
 	t.Tags[i], t.Tags[j] = t.Tags[j], t.Tags[i]
 }
 func (t sortableTagsNumericallyAsc) Less(i, j int) bool {
+	return lessNumerically(t.Tags[i].Name, t.Tags[j].Name)
+}
+
+type sortableGraphiteTaggedTagsAsc Tags
+
+func (t sortableGraphiteTaggedTagsAsc) Len() int { return len(t.Tags) }
+func (t sortableGraphiteTaggedTagsAsc) Swap(i, j int) {
+	t.Tags[i], t.Tags[j] = t.Tags[j], t.Tags[i]
+}
+func (t sortableGraphiteTaggedTagsAsc) Less(i, j int) bool {
 	iName, jName := t.Tags[i].Name, t.Tags[j].Name
+
+	// Path tags are ordered before any tags of graphite tagged series, which
+	// are ordered lexically.
+	iPath, jPath := isGraphitePathTag(iName), isGraphitePathTag(jName)
+	if iPath != jPath {
+		return iPath
+	}
+
+	if !iPath {
+		return bytes.Compare(iName, jName) == -1
+	}
+
+	return lessNumerically(iName, jName)
+}
+
+func lessNumerically(iName, jName []byte) bool {
 	lenDiff := len(iName) - len(jName)
 	if lenDiff < 0 {
 		return true
@@ -420,9 +520,12 @@ func (t sortableTagsNumericallyAsc) Less(i, j int) bool {
 // In the future, it might also ensure other things like uniqueness.
 func (t Tags) Normalize() Tags {
 	// Graphite tags are sorted numerically rather than lexically.
-	if t.Opts.IDSchemeType() == TypeGraphite {
+	switch t.Opts.IDSchemeType() {
+	case TypeGraphite:
 		sort.Sort(sortableTagsNumericallyAsc(t))
-	} else {
+	case TypeGraphiteTagged:
+		sort.Sort(sortableGraphiteTaggedTagsAsc(t))
+	default:
 		sort.Sort(t)
 	}
 
@@ -82,6 +82,35 @@ func TestLongTagNewIDOutOfOrderGraphite(t *testing.T) {
 	assert.Equal(t, []byte("v0.v1.v2.v3.v4.v5.v6.v7.v8.v9.v10.v11.v12"), actual)
 }
 
+func TestTaggedGraphiteID(t *testing.T) {
+	opts := NewTagOptions().SetIDSchemeType(TypeGraphiteTagged)
+	tags := NewTags(5, opts).AddTags([]Tag{
+		{Name: []byte("server"), Value: []byte("web01")},
+		{Name: []byte("__g1__"), Value: []byte("used")},
+		{Name: []byte("dc"), Value: []byte("dc1")},
+		{Name: []byte("__g0__"), Value: []byte("disk")},
+		{Name: []byte("__gx__"), Value: []byte("x")},
+	})
+
+	// Path tags are ordered first, followed by the other tags lexically.
+	expected := []Tag{
+		{Name: []byte("__g0__"), Value: []byte("disk")},
+		{Name: []byte("__g1__"), Value: []byte("used")},
+		{Name: []byte("__gx__"), Value: []byte("x")},
+		{Name: []byte("dc"), Value: []byte("dc1")},
+		{Name: []byte("server"), Value: []byte("web01")},
+	}
+	assert.Equal(t, expected, tags.Tags)
+
+	actual := tags.ID()
+	assert.Equal(t, []byte("disk.used;__gx__=x;dc=dc1;server=web01"), actual)
+	assert.Equal(t, len(actual), cap(actual))
+
+	// The graphite scheme is unaffected by tags other than path tags.
+	tags.Opts = NewTagOptions().SetIDSchemeType(TypeGraphite)
+	assert.Equal(t, []byte("disk.used.x.dc1.web01"), tags.ID())
+}
+
 func TestHashedID(t *testing.T) {
 	tags := testLongTagIDOutOfOrder(t, TypeLegacy)
 	actual := tags.HashedID()
@@ -394,6 +423,7 @@ func TestEmptyTags(t *testing.T) {
 		{TypeLegacy, ""},
 		{TypePrependMeta, ""},
 		{TypeGraphite, ""},
+		{TypeGraphiteTagged, ""},
 		{TypeQuoted, "{}"},
 	}
 
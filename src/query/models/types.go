@@ -26,12 +26,18 @@ import (
 
 // Separators for tags.
 const (
-	graphiteSep  = byte('.')
-	sep          = byte(',')
-	finish       = byte('!')
-	eq           = byte('=')
-	leftBracket  = byte('{')
-	rightBracket = byte('}')
+	graphiteSep    = byte('.')
+	graphiteTagSep = byte(';')
+	sep            = byte(',')
+	finish         = byte('!')
+	eq             = byte('=')
+	leftBracket    = byte('{')
+	rightBracket   = byte('}')
+)
+
+var (
+	graphitePathTagPrefix = []byte("__g")
+	graphitePathTagSuffix = []byte("__")
 )
 
 // IDSchemeType determines the scheme for generating
@@ -79,6 +85,19 @@ const (
 	// a general ID scheme; instead, it is set on any metric coming through the
 	// graphite ingestion path.
 	TypeGraphite
+	// TypeGraphiteTagged describes a scheme where IDs are generated to match
+	// the graphite tagged series representation of the tags, the path tags
+	// are joined as with TypeGraphite and any other tags are appended in the
+	// graphite tagged format.
+	// {__g0__:v1},{__g1__:v2},{dc:dc1} -> v1.v2;dc=dc1
+	//
+	// NB: when TypeGraphiteTagged is specified, path tags are ordered
+	// numerically, followed by any other tags ordered lexically.
+	//
+	// NB 2: as with TypeGraphite, this scheme is not available to choose as a
+	// general ID scheme; instead, it is set on graphite tagged series coming
+	// through the graphite ingestion path.
+	TypeGraphiteTagged
 )
 
 // TagOptions describes additional options for tags.
@@ -68,6 +68,9 @@ type parseState struct {
 	edges      parser.Edges
 	transforms parser.Nodes
 	tagOpts    models.TagOptions
+	// subqueryOffset is the offset of any enclosing subqueries, which is
+	// applied on top of the offset of each selector inside of them.
+	subqueryOffset time.Duration
 }
 
 func (p *parseState) lastTransformID() parser.NodeID {
@@ -129,22 +132,49 @@ func (p *parseState) walk(node pql.Node) error {
 		return nil
 
 	case *pql.MatrixSelector:
-		operation, err := NewSelectorFromMatrix(n, p.tagOpts)
+		selector := *n
+		selector.Offset += p.subqueryOffset
+		operation, err := NewSelectorFromMatrix(&selector, p.tagOpts)
 		if err != nil {
 			return err
 		}
 
 		p.transforms = append(p.transforms, parser.NewTransformFromOperation(operation, p.transformLen()))
-		return p.addOffsetTransform(n.Offset)
+		return p.addOffsetTransform(selector.Offset)
 
 	case *pql.VectorSelector:
-		operation, err := NewSelectorFromVector(n, p.tagOpts)
+		selector := *n
+		selector.Offset += p.subqueryOffset
+		operation, err := NewSelectorFromVector(&selector, p.tagOpts)
 		if err != nil {
 			return err
 		}
 
 		p.transforms = append(p.transforms, parser.NewTransformFromOperation(operation, p.transformLen()))
-		return p.addOffsetTransform(n.Offset)
+		return p.addOffsetTransform(selector.Offset)
+
+	case *pql.SubqueryExpr:
+		// NB: the subquery offset shifts every selector of the inner expression.
+		prevOffset := p.subqueryOffset
+		p.subqueryOffset += n.Offset
+		err := p.walk(n.Expr)
+		p.subqueryOffset = prevOffset
+		if err != nil {
+			return err
+		}
+
+		op, err := NewSubqueryOperator(n)
+		if err != nil {
+			return err
+		}
+
+		opTransform := parser.NewTransformFromOperation(op, p.transformLen())
+		p.edges = append(p.edges, parser.Edge{
+			ParentID: p.lastTransformID(),
+			ChildID:  opTransform.ID,
+		})
+		p.transforms = append(p.transforms, opTransform)
+		return nil
 
 	case *pql.Call:
 		expressions := n.Args
@@ -167,6 +197,10 @@ func (p *parseState) walk(node pql.Node) error {
 					argValues = append(argValues, e.Range)
 				}
 
+				if e, ok := expr.(*pql.SubqueryExpr); ok {
+					argValues = append(argValues, e.Range)
+				}
+
 				if err := p.walk(expr); err != nil {
 					return err
 				}
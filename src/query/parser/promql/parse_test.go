@@ -22,6 +22,7 @@ package promql
 
 import (
 	"testing"
+	"time"
 
 	"github.com/m3db/m3/src/query/functions"
 	"github.com/m3db/m3/src/query/functions/aggregation"
@@ -29,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/query/functions/linear"
 	"github.com/m3db/m3/src/query/functions/offset"
 	"github.com/m3db/m3/src/query/functions/scalar"
+	"github.com/m3db/m3/src/query/functions/subquery"
 	"github.com/m3db/m3/src/query/functions/tag"
 	"github.com/m3db/m3/src/query/functions/temporal"
 	"github.com/m3db/m3/src/query/models"
@@ -349,6 +351,28 @@ func TestTemporalParses(t *testing.T) {
 	}
 }
 
+func TestSubqueryParses(t *testing.T) {
+	q := "max_over_time(rate(http_requests[5m])[1h:1m] offset 2m)"
+	p, err := Parse(q, models.NewTagOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 5)
+	fetch, ok := transforms[0].Op.(functions.FetchOp)
+	require.True(t, ok)
+	assert.Equal(t, 5*time.Minute, fetch.Range)
+	assert.Equal(t, 2*time.Minute, fetch.Offset, "subquery offset pushed down")
+	assert.Equal(t, transforms[1].Op.OpType(), offset.OffsetType)
+	assert.Equal(t, transforms[2].Op.OpType(), temporal.RateType)
+	assert.Equal(t, transforms[3].Op.OpType(), subquery.SubqueryType)
+	assert.Equal(t, transforms[4].Op.OpType(), temporal.MaxType)
+	require.Len(t, edges, 4)
+	for i, edge := range edges {
+		assert.Equal(t, transforms[i].ID, edge.ParentID)
+		assert.Equal(t, transforms[i+1].ID, edge.ChildID)
+	}
+}
+
 var tagParseTests = []struct {
 	q            string
 	expectedType string
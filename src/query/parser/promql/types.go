@@ -29,6 +29,7 @@ import (
 	"github.com/m3db/m3/src/query/functions/binary"
 	"github.com/m3db/m3/src/query/functions/linear"
 	"github.com/m3db/m3/src/query/functions/scalar"
+	"github.com/m3db/m3/src/query/functions/subquery"
 	"github.com/m3db/m3/src/query/functions/tag"
 	"github.com/m3db/m3/src/query/functions/temporal"
 	"github.com/m3db/m3/src/query/functions/unconsolidated"
@@ -75,6 +76,11 @@ func NewSelectorFromMatrix(
 	}, nil
 }
 
+// NewSubqueryOperator creates a new subquery operator
+func NewSubqueryOperator(expr *promql.SubqueryExpr) (parser.Params, error) {
+	return subquery.NewSubqueryOp(expr.Range, expr.Step)
+}
+
 // NewAggregationOperator creates a new aggregation operator based on the type
 func NewAggregationOperator(expr *promql.AggregateExpr) (parser.Params, error) {
 	opType := expr.Op
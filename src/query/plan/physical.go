@@ -80,7 +80,12 @@ func (p PhysicalPlan) shiftTime() PhysicalPlan {
 	var maxRange time.Duration
 	// Start offset with lookback
 	maxOffset := p.LookbackDuration
+	ranges := make(map[parser.NodeID]time.Duration, len(p.pipeline))
 	for _, transformID := range p.pipeline {
+		if r := p.cumulativeRange(transformID, ranges); r > maxRange {
+			maxRange = r
+		}
+
 		node := p.steps[transformID]
 		boundOp, ok := node.Transform.Op.(transform.BoundOp)
 		if !ok {
@@ -91,10 +96,6 @@ func (p PhysicalPlan) shiftTime() PhysicalPlan {
 		if spec.Offset+p.LookbackDuration > maxOffset {
 			maxOffset = spec.Offset + p.LookbackDuration
 		}
-
-		if spec.Range > maxRange {
-			maxRange = spec.Range
-		}
 	}
 
 	startShift := maxOffset + maxRange
@@ -103,6 +104,38 @@ func (p PhysicalPlan) shiftTime() PhysicalPlan {
 	return p
 }
 
+// cumulativeRange returns the total range required to evaluate a step. Ranges
+// add up along a path since nested ranges (e.g. a range selector inside of a
+// subquery) each need their own window of data before the query start.
+func (p PhysicalPlan) cumulativeRange(
+	ID parser.NodeID,
+	ranges map[parser.NodeID]time.Duration,
+) time.Duration {
+	if r, ok := ranges[ID]; ok {
+		return r
+	}
+
+	node, ok := p.steps[ID]
+	if !ok {
+		return 0
+	}
+
+	var parentRange time.Duration
+	for _, parentID := range node.Parents {
+		if r := p.cumulativeRange(parentID, ranges); r > parentRange {
+			parentRange = r
+		}
+	}
+
+	r := parentRange
+	if boundOp, ok := node.Transform.Op.(transform.BoundOp); ok {
+		r += boundOp.Bounds().Range
+	}
+
+	ranges[ID] = r
+	return r
+}
+
 func (p PhysicalPlan) createResultNode() (PhysicalPlan, error) {
 	leaf, err := p.leafNode()
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 
 	"github.com/m3db/m3/src/query/functions"
 	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/functions/subquery"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
 
@@ -86,3 +87,26 @@ func TestShiftTime(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, p.TimeSpec.Start, start.Add(-1*(time.Minute+time.Hour+defaultLookbackDuration)), "start time offset by fetch")
 }
+
+func TestShiftTimeWithNestedRanges(t *testing.T) {
+	fetchTransform := parser.NewTransformFromOperation(functions.FetchOp{Range: 5 * time.Minute}, 1)
+	sq, err := subquery.NewSubqueryOp(time.Hour, time.Minute)
+	require.NoError(t, err)
+	subqueryTransform := parser.NewTransformFromOperation(sq, 2)
+	transforms := parser.Nodes{fetchTransform, subqueryTransform}
+	edges := parser.Edges{
+		parser.Edge{
+			ParentID: fetchTransform.ID,
+			ChildID:  subqueryTransform.ID,
+		},
+	}
+
+	lp, err := NewLogicalPlan(transforms, edges)
+	require.NoError(t, err)
+	now := time.Now()
+	start := now.Add(-1 * time.Hour)
+	p, err := NewPhysicalPlan(lp, nil, models.RequestParams{Now: now, Start: start}, defaultLookbackDuration)
+	require.NoError(t, err)
+	expected := start.Add(-1 * (time.Hour + 5*time.Minute + defaultLookbackDuration))
+	assert.Equal(t, expected, p.TimeSpec.Start, "start time offset by subquery and fetch ranges")
+}
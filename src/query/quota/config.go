@@ -0,0 +1,106 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+// Configuration is the configuration for per-tenant quotas.
+type Configuration struct {
+	// Enabled enables per-tenant quotas.
+	Enabled bool `yaml:"enabled"`
+
+	// TenantHeader is the header which identifies the tenant of a request.
+	TenantHeader string `yaml:"tenantHeader"`
+
+	// TenantTag is the tag which identifies the tenant of a series, if set
+	// and present on a written series it takes precedence over the tenant
+	// of the request.
+	TenantTag string `yaml:"tenantTag"`
+
+	// DefaultTenant is the tenant for requests and series without a tenant
+	// or with a tenant that has no limits of its own.
+	DefaultTenant string `yaml:"defaultTenant"`
+
+	// Defaults are the limits for tenants without limits of their own.
+	Defaults Limits `yaml:"defaults"`
+
+	// Tenants are the limits of individual tenants.
+	Tenants map[string]Limits `yaml:"tenants"`
+
+	// NewSeriesWindow is how long a series is remembered for when counting
+	// new series.
+	NewSeriesWindow *time.Duration `yaml:"newSeriesWindow"`
+
+	// NewSeriesCapacity is the expected number of distinct series a tenant
+	// writes within the new series window.
+	NewSeriesCapacity *int `yaml:"newSeriesCapacity"`
+
+	// KVKey is the KV key watched for dynamic limits which take precedence
+	// over the configured limits, only used if cluster management is
+	// configured.
+	KVKey string `yaml:"kvKey"`
+}
+
+// TenantHeaderOrDefault returns the configured tenant header or the default.
+func (c Configuration) TenantHeaderOrDefault() string {
+	if c.TenantHeader != "" {
+		return c.TenantHeader
+	}
+	return DefaultTenantHeader
+}
+
+// NewEnforcer returns a new enforcer, or nil if quotas are not enabled.
+func (c Configuration) NewEnforcer(
+	store kv.Store,
+	instrumentOpts instrument.Options,
+) (Enforcer, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+
+	opts := NewOptions().
+		SetDefaultLimits(c.Defaults).
+		SetTenantLimits(c.Tenants).
+		SetKVStore(store).
+		SetInstrumentOptions(instrumentOpts)
+	if c.TenantTag != "" {
+		opts = opts.SetTenantTag([]byte(c.TenantTag))
+	}
+	if c.DefaultTenant != "" {
+		opts = opts.SetDefaultTenant(c.DefaultTenant)
+	}
+	if c.NewSeriesWindow != nil {
+		opts = opts.SetNewSeriesWindow(*c.NewSeriesWindow)
+	}
+	if c.NewSeriesCapacity != nil {
+		opts = opts.SetNewSeriesCapacity(*c.NewSeriesCapacity)
+	}
+	if c.KVKey != "" {
+		opts = opts.SetKVKey(c.KVKey)
+	}
+
+	return NewEnforcer(opts)
+}
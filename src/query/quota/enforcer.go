@@ -0,0 +1,432 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/kv/util"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
+
+	"github.com/uber-go/tally"
+)
+
+// dynamicLimits are the limits stored in KV as JSON in a string proto,
+// they take precedence over the configured limits.
+type dynamicLimits struct {
+	Defaults *Limits           `json:"defaults"`
+	Tenants  map[string]Limits `json:"tenants"`
+}
+
+type enforcer struct {
+	sync.RWMutex
+
+	opts    Options
+	nowFn   clock.NowFn
+	scope   tally.Scope
+	dynamic *dynamicLimits
+	tenants map[string]*tenantState
+	watch   kv.ValueWatch
+}
+
+type tenantState struct {
+	sync.Mutex
+
+	// tenant is the key of the state, unknown tenants share the state of
+	// the default tenant.
+	tenant     string
+	limits     Limits
+	datapoints rateBudget
+	newSeries  rateBudget
+	series     *seriesTracker
+	metrics    tenantMetrics
+}
+
+// rateBudget is a per second budget, it is guarded by the lock of the
+// tenant state it belongs to.
+type rateBudget struct {
+	limit       int64
+	windowStart time.Time
+	used        int64
+}
+
+func (b *rateBudget) reset(limit int64) {
+	b.limit = limit
+	b.windowStart = time.Time{}
+	b.used = 0
+}
+
+// allows returns whether n more events fit within the budget of the
+// current second without using them up.
+func (b *rateBudget) allows(n int64, now time.Time) bool {
+	if b.limit <= 0 {
+		return true
+	}
+	used := b.used
+	if now.Truncate(time.Second).After(b.windowStart) {
+		used = 0
+	}
+	return used+n <= b.limit
+}
+
+func (b *rateBudget) use(n int64, now time.Time) {
+	if aligned := now.Truncate(time.Second); aligned.After(b.windowStart) {
+		b.windowStart = aligned
+		b.used = 0
+	}
+	b.used += n
+}
+
+type tenantMetrics struct {
+	datapoints            tally.Counter
+	newSeries             tally.Counter
+	datapointsExceeded    tally.Counter
+	newSeriesExceeded     tally.Counter
+	fetchedSeriesExceeded tally.Counter
+}
+
+func newTenantMetrics(scope tally.Scope) tenantMetrics {
+	return tenantMetrics{
+		datapoints:            scope.Counter("datapoints"),
+		newSeries:             scope.Counter("new-series"),
+		datapointsExceeded:    scope.Counter("datapoints-exceeded"),
+		newSeriesExceeded:     scope.Counter("new-series-exceeded"),
+		fetchedSeriesExceeded: scope.Counter("fetched-series-exceeded"),
+	}
+}
+
+// NewEnforcer returns a new enforcer, if a KV store is set the enforcer
+// watches it for limits which take precedence over the configured limits.
+func NewEnforcer(opts Options) (Enforcer, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	iOpts := opts.InstrumentOptions()
+	e := &enforcer{
+		opts:    opts,
+		nowFn:   opts.ClockOptions().NowFn(),
+		scope:   iOpts.MetricsScope().SubScope("quota"),
+		tenants: make(map[string]*tenantState),
+	}
+
+	store := opts.KVStore()
+	if store == nil {
+		return e, nil
+	}
+
+	watchOpts := util.NewOptions().
+		SetLogger(iOpts.Logger()).
+		SetValidateFn(func(v interface{}) error {
+			if _, ok := v.(*dynamicLimits); !ok {
+				return fmt.Errorf("unexpected dynamic limits type: %T", v)
+			}
+			return nil
+		})
+	watch, err := util.WatchAndUpdateGeneric(store, opts.KVKey(),
+		getDynamicLimits, e.updateDynamicLimits, nil, (*dynamicLimits)(nil),
+		watchOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to watch key '%s': %v", opts.KVKey(), err)
+	}
+	e.watch = watch
+
+	return e, nil
+}
+
+func getDynamicLimits(v kv.Value) (interface{}, error) {
+	var stringProto commonpb.StringProto
+	if err := v.Unmarshal(&stringProto); err != nil {
+		return nil, err
+	}
+
+	var limits dynamicLimits
+	if err := json.Unmarshal([]byte(stringProto.Value), &limits); err != nil {
+		return nil, err
+	}
+	return &limits, nil
+}
+
+func (e *enforcer) updateDynamicLimits(v interface{}) {
+	dynamic, _ := v.(*dynamicLimits)
+
+	e.Lock()
+	defer e.Unlock()
+
+	e.dynamic = dynamic
+	for tenant, state := range e.tenants {
+		limits, ok := e.limitsWithLock(tenant)
+		if !ok {
+			// Tenant no longer has limits of its own.
+			delete(e.tenants, tenant)
+			continue
+		}
+		e.applyLimitsWithLock(state, limits)
+	}
+}
+
+// limitsWithLock returns the limits of the tenant and whether the tenant is
+// known, unknown tenants are attributed to the default tenant.
+func (e *enforcer) limitsWithLock(tenant string) (Limits, bool) {
+	if e.dynamic != nil {
+		if limits, ok := e.dynamic.Tenants[tenant]; ok {
+			return limits, true
+		}
+	}
+	if limits, ok := e.opts.TenantLimits()[tenant]; ok {
+		return limits, true
+	}
+
+	if e.dynamic != nil && e.dynamic.Defaults != nil {
+		return *e.dynamic.Defaults, tenant == e.opts.DefaultTenant()
+	}
+	return e.opts.DefaultLimits(), tenant == e.opts.DefaultTenant()
+}
+
+func (e *enforcer) applyLimitsWithLock(state *tenantState, limits Limits) {
+	if state.limits == limits {
+		return
+	}
+
+	state.datapoints.reset(limits.DatapointsPerSecond)
+	state.newSeries.reset(limits.NewSeriesPerSecond)
+
+	switch {
+	case limits.NewSeriesPerSecond <= 0:
+		state.series = nil
+	case state.series == nil:
+		state.series = newSeriesTracker(e.opts.NewSeriesWindow(),
+			e.opts.NewSeriesCapacity(), e.nowFn())
+	}
+	state.limits = limits
+}
+
+func (e *enforcer) Tenant(ctx context.Context, tags models.Tags) string {
+	if tag := e.opts.TenantTag(); len(tag) > 0 {
+		if value, ok := tags.Get(tag); ok {
+			return e.knownTenant(string(value))
+		}
+	}
+	if tenant, ok := TenantFromContext(ctx); ok {
+		return e.knownTenant(tenant)
+	}
+	return e.opts.DefaultTenant()
+}
+
+func (e *enforcer) knownTenant(tenant string) string {
+	e.RLock()
+	_, ok := e.limitsWithLock(tenant)
+	e.RUnlock()
+	if !ok {
+		return e.opts.DefaultTenant()
+	}
+	return tenant
+}
+
+// stateWithRLock returns the state of the tenant with the read lock held,
+// the caller must release the read lock.
+func (e *enforcer) stateWithRLock(tenant string) *tenantState {
+	return e.statesWithRLock([]string{tenant})[0]
+}
+
+// statesWithRLock returns the states of the tenants with the read lock held,
+// the caller must release the read lock.
+func (e *enforcer) statesWithRLock(tenants []string) []*tenantState {
+	states := make([]*tenantState, len(tenants))
+	e.RLock()
+	if e.lookupStatesWithLock(tenants, states) {
+		return states
+	}
+	e.RUnlock()
+
+	e.Lock()
+	for i, tenant := range tenants {
+		if states[i] != nil {
+			continue
+		}
+		states[i] = e.newStateWithLock(tenant)
+	}
+	e.Unlock()
+
+	e.RLock()
+	return states
+}
+
+// lookupStatesWithLock fills in the states of the tenants that exist and
+// returns whether all of them do.
+func (e *enforcer) lookupStatesWithLock(tenants []string, states []*tenantState) bool {
+	found := true
+	for i, tenant := range tenants {
+		state, ok := e.tenants[tenant]
+		if !ok {
+			found = false
+			continue
+		}
+		states[i] = state
+	}
+	return found
+}
+
+func (e *enforcer) newStateWithLock(tenant string) *tenantState {
+	if state, ok := e.tenants[tenant]; ok {
+		return state
+	}
+	limits, known := e.limitsWithLock(tenant)
+	if !known {
+		tenant = e.opts.DefaultTenant()
+		if state, ok := e.tenants[tenant]; ok {
+			return state
+		}
+		limits, _ = e.limitsWithLock(tenant)
+	}
+	state := &tenantState{
+		tenant: tenant,
+		metrics: newTenantMetrics(e.scope.Tagged(map[string]string{
+			"tenant": tenant,
+		})),
+	}
+	e.applyLimitsWithLock(state, limits)
+	e.tenants[tenant] = state
+	return state
+}
+
+func (e *enforcer) AddWrites(tenant string, ids [][]byte, datapoints int) error {
+	return e.AddBatchWrites(map[string]TenantWrites{
+		tenant: {IDs: ids, Datapoints: datapoints},
+	})
+}
+
+// pendingWrites are the writes of a single tenant state being accounted for.
+type pendingWrites struct {
+	tenant     string
+	state      *tenantState
+	ids        [][]byte
+	datapoints int
+	newIDs     [][]byte
+}
+
+func (e *enforcer) AddBatchWrites(writes map[string]TenantWrites) error {
+	tenants := make([]string, 0, len(writes))
+	for tenant := range writes {
+		tenants = append(tenants, tenant)
+	}
+	sort.Strings(tenants)
+
+	states := e.statesWithRLock(tenants)
+	defer e.RUnlock()
+
+	// Unknown tenants share the state of the default tenant.
+	var (
+		pending = make([]*pendingWrites, 0, len(tenants))
+		byState = make(map[*tenantState]*pendingWrites, len(tenants))
+	)
+	for i, tenant := range tenants {
+		w := writes[tenant]
+		p, ok := byState[states[i]]
+		if !ok {
+			p = &pendingWrites{tenant: tenant, state: states[i]}
+			byState[states[i]] = p
+			pending = append(pending, p)
+		}
+		p.ids = append(p.ids, w.IDs...)
+		p.datapoints += w.Datapoints
+	}
+
+	// NB: States are locked in the order of their keys rather than of the
+	// tenants written to, since unknown tenants share the state of the default
+	// tenant, so that concurrent batches of overlapping tenants can not
+	// deadlock.
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].state.tenant < pending[j].state.tenant
+	})
+	for _, p := range pending {
+		p.state.Lock()
+		defer p.state.Unlock()
+	}
+
+	// Check the quotas of every tenant before using up any of them so that a
+	// rejected batch does not count towards the quotas of any tenant.
+	now := e.nowFn()
+	for _, p := range pending {
+		state := p.state
+		if state.series != nil {
+			p.newIDs = state.series.newSeries(p.ids, now)
+		}
+		if !state.newSeries.allows(int64(len(p.newIDs)), now) {
+			state.metrics.newSeriesExceeded.Inc(1)
+			return newQuotaExceededError(p.tenant, "new series per second",
+				state.limits.NewSeriesPerSecond)
+		}
+		if !state.datapoints.allows(int64(p.datapoints), now) {
+			state.metrics.datapointsExceeded.Inc(1)
+			return newQuotaExceededError(p.tenant, "datapoints per second",
+				state.limits.DatapointsPerSecond)
+		}
+	}
+
+	for _, p := range pending {
+		state := p.state
+		state.newSeries.use(int64(len(p.newIDs)), now)
+		state.datapoints.use(int64(p.datapoints), now)
+		if state.series != nil {
+			state.series.add(p.newIDs)
+		}
+		state.metrics.datapoints.Inc(int64(p.datapoints))
+		state.metrics.newSeries.Inc(int64(len(p.newIDs)))
+	}
+	return nil
+}
+
+func (e *enforcer) FetchLimit(tenant string) int {
+	e.RLock()
+	limits, _ := e.limitsWithLock(tenant)
+	e.RUnlock()
+	return limits.MaxFetchedSeries
+}
+
+func (e *enforcer) FetchLimitExceeded(tenant string) error {
+	state := e.stateWithRLock(tenant)
+	defer e.RUnlock()
+
+	state.metrics.fetchedSeriesExceeded.Inc(1)
+	return newQuotaExceededError(tenant, "fetched series per query",
+		int64(state.limits.MaxFetchedSeries))
+}
+
+func (e *enforcer) Close() {
+	if e.watch != nil {
+		e.watch.Close()
+	}
+}
+
+func newQuotaExceededError(tenant, quota string, limit int64) error {
+	return xerrors.NewResourceExhaustedError(fmt.Errorf(
+		"tenant %s exceeded quota of %d %s", tenant, limit, quota))
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) nowFn() time.Time {
+	return c.now
+}
+
+func newTestEnforcer(t *testing.T, opts Options) (Enforcer, *testClock) {
+	clk := &testClock{now: time.Now().Truncate(time.Second)}
+	e, err := NewEnforcer(opts.
+		SetClockOptions(clock.NewOptions().SetNowFn(clk.nowFn)))
+	require.NoError(t, err)
+	return e, clk
+}
+
+func testIDs(ids ...string) [][]byte {
+	result := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, []byte(id))
+	}
+	return result
+}
+
+func TestEnforcerTenant(t *testing.T) {
+	e, _ := newTestEnforcer(t, NewOptions().
+		SetTenantTag([]byte("team")).
+		SetTenantLimits(map[string]Limits{"a": {}, "b": {}}))
+
+	tags := models.NewTags(1, models.NewTagOptions()).
+		AddTag(models.Tag{Name: []byte("team"), Value: []byte("b")})
+	ctx := NewContextWithTenant(context.Background(), "a")
+
+	assert.Equal(t, "default", e.Tenant(context.Background(), models.Tags{}))
+	assert.Equal(t, "a", e.Tenant(ctx, models.Tags{}))
+	assert.Equal(t, "b", e.Tenant(ctx, tags))
+
+	// Unknown tenants are attributed to the default tenant.
+	unknown := NewContextWithTenant(context.Background(), "c")
+	assert.Equal(t, "default", e.Tenant(unknown, models.Tags{}))
+}
+
+func TestEnforcerDatapointsPerSecond(t *testing.T) {
+	e, clk := newTestEnforcer(t, NewOptions().
+		SetTenantLimits(map[string]Limits{"a": {DatapointsPerSecond: 10}}))
+
+	require.NoError(t, e.AddWrites("a", testIDs("foo"), 6))
+	err := e.AddWrites("a", testIDs("foo"), 6)
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+
+	// Rejected writes do not count towards the quota.
+	require.NoError(t, e.AddWrites("a", testIDs("foo"), 4))
+	require.Error(t, e.AddWrites("a", testIDs("foo"), 1))
+
+	// Other tenants are not affected.
+	require.NoError(t, e.AddWrites("default", testIDs("foo"), 100))
+
+	clk.now = clk.now.Add(time.Second)
+	require.NoError(t, e.AddWrites("a", testIDs("foo"), 6))
+}
+
+func TestEnforcerNewSeriesPerSecond(t *testing.T) {
+	e, clk := newTestEnforcer(t, NewOptions().
+		SetNewSeriesCapacity(1000).
+		SetNewSeriesWindow(time.Minute).
+		SetDefaultLimits(Limits{NewSeriesPerSecond: 2}))
+
+	require.NoError(t, e.AddWrites("default", testIDs("foo", "bar", "foo"), 3))
+
+	// Existing series do not count towards the quota.
+	require.NoError(t, e.AddWrites("default", testIDs("foo", "bar"), 2))
+
+	err := e.AddWrites("default", testIDs("baz"), 1)
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+
+	// Rejected series are not remembered.
+	clk.now = clk.now.Add(time.Second)
+	require.NoError(t, e.AddWrites("default", testIDs("baz", "qux"), 2))
+	err = e.AddWrites("default", testIDs("quux"), 1)
+	require.Error(t, err)
+
+	// Series are forgotten after two windows without writes.
+	clk.now = clk.now.Add(3 * time.Minute)
+	require.NoError(t, e.AddWrites("default", testIDs("foo", "bar"), 2))
+	require.Error(t, e.AddWrites("default", testIDs("baz"), 1))
+}
+
+func TestEnforcerRejectedWritesUseNoQuota(t *testing.T) {
+	e, _ := newTestEnforcer(t, NewOptions().
+		SetNewSeriesCapacity(1000).
+		SetNewSeriesWindow(time.Minute).
+		SetDefaultLimits(Limits{NewSeriesPerSecond: 1, DatapointsPerSecond: 1}))
+
+	// The new series fits its quota but the datapoints do not, so neither
+	// quota is used up and the series is not remembered.
+	err := e.AddWrites("default", testIDs("foo"), 2)
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+	require.NoError(t, e.AddWrites("default", testIDs("bar"), 1))
+}
+
+func TestEnforcerAddBatchWrites(t *testing.T) {
+	e, _ := newTestEnforcer(t, NewOptions().
+		SetTenantLimits(map[string]Limits{
+			"a": {DatapointsPerSecond: 5},
+			"b": {DatapointsPerSecond: 5},
+		}))
+
+	require.NoError(t, e.AddBatchWrites(map[string]TenantWrites{
+		"a": {IDs: testIDs("foo"), Datapoints: 4},
+		"b": {IDs: testIDs("foo"), Datapoints: 4},
+	}))
+
+	// The batch exceeds the quota of tenant a, so tenant b is not charged.
+	err := e.AddBatchWrites(map[string]TenantWrites{
+		"a": {IDs: testIDs("foo"), Datapoints: 2},
+		"b": {IDs: testIDs("foo"), Datapoints: 1},
+	})
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+	require.NoError(t, e.AddWrites("b", testIDs("foo"), 1))
+
+	// Unknown tenants share the quota of the default tenant.
+	e, _ = newTestEnforcer(t, NewOptions().
+		SetDefaultLimits(Limits{DatapointsPerSecond: 5}))
+	require.Error(t, e.AddBatchWrites(map[string]TenantWrites{
+		"default": {IDs: testIDs("foo"), Datapoints: 3},
+		"unknown": {IDs: testIDs("foo"), Datapoints: 3},
+	}))
+	require.NoError(t, e.AddWrites("unknown", testIDs("foo"), 5))
+}
+
+func TestEnforcerAddBatchWritesLockOrder(t *testing.T) {
+	e, _ := newTestEnforcer(t, NewOptions().
+		SetTenantLimits(map[string]Limits{"m": {}}))
+	require.NoError(t, e.AddWrites("m", testIDs("foo"), 1))
+	require.NoError(t, e.AddWrites("zz", testIDs("foo"), 1))
+
+	// The unknown tenant zz sorts after tenant m but shares the state of the
+	// default tenant, which is locked before the state of tenant m so the
+	// blocked batch must not hold the state of tenant m.
+	enforcer := e.(*enforcer)
+	enforcer.RLock()
+	defaultState := enforcer.tenants["default"]
+	enforcer.RUnlock()
+
+	defaultState.Lock()
+	blocked := make(chan error, 1)
+	go func() {
+		blocked <- e.AddBatchWrites(map[string]TenantWrites{
+			"m":  {IDs: testIDs("foo"), Datapoints: 1},
+			"zz": {IDs: testIDs("foo"), Datapoints: 1},
+		})
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- e.AddWrites("m", testIDs("foo"), 1)
+	}()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "state of tenant m locked out of order")
+	}
+
+	defaultState.Unlock()
+	require.NoError(t, <-blocked)
+}
+
+func TestEnforcerDynamicLimits(t *testing.T) {
+	store := mem.NewStore()
+	e, _ := newTestEnforcer(t, NewOptions().
+		SetKVStore(store).
+		SetTenantLimits(map[string]Limits{"a": {MaxFetchedSeries: 10}}))
+	defer e.Close()
+
+	assert.Equal(t, 10, e.FetchLimit("a"))
+	assert.Equal(t, "default", e.Tenant(
+		NewContextWithTenant(context.Background(), "b"), models.Tags{}))
+
+	_, err := store.Set(DefaultKVKey, &commonpb.StringProto{Value: `{
+		"defaults": {"maxFetchedSeries": 5},
+		"tenants": {"a": {"maxFetchedSeries": 20}, "b": {"datapointsPerSecond": 1}}
+	}`})
+	require.NoError(t, err)
+
+	require.True(t, clock.WaitUntil(func() bool {
+		return e.FetchLimit("a") == 20
+	}, 5*time.Second))
+	assert.Equal(t, 5, e.FetchLimit("default"))
+	assert.Equal(t, "b", e.Tenant(
+		NewContextWithTenant(context.Background(), "b"), models.Tags{}))
+	require.NoError(t, e.AddWrites("b", testIDs("foo"), 1))
+	require.Error(t, e.AddWrites("b", testIDs("foo"), 1))
+
+	err = e.FetchLimitExceeded("a")
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+}
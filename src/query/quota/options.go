@@ -0,0 +1,191 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"errors"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	// DefaultTenantHeader is the default header which identifies the tenant
+	// of a request.
+	DefaultTenantHeader = "M3-Tenant"
+
+	// DefaultKVKey is the default KV key watched for dynamic limits.
+	DefaultKVKey = "m3coordinator.quotas"
+
+	defaultDefaultTenant     = "default"
+	defaultNewSeriesWindow   = time.Hour
+	defaultNewSeriesCapacity = 1 << 20
+)
+
+var (
+	errNoDefaultTenant          = errors.New("no default tenant set")
+	errInvalidNewSeriesWindow   = errors.New("new series window must be positive")
+	errInvalidNewSeriesCapacity = errors.New("new series capacity must be positive")
+	errNoKVKey                  = errors.New("no KV key set for dynamic limits")
+)
+
+type options struct {
+	defaultLimits     Limits
+	tenantLimits      map[string]Limits
+	tenantTag         []byte
+	defaultTenant     string
+	newSeriesWindow   time.Duration
+	newSeriesCapacity int
+	kvStore           kv.Store
+	kvKey             string
+	clockOpts         clock.Options
+	instrumentOpts    instrument.Options
+}
+
+// NewOptions returns new enforcer options.
+func NewOptions() Options {
+	return &options{
+		defaultTenant:     defaultDefaultTenant,
+		newSeriesWindow:   defaultNewSeriesWindow,
+		newSeriesCapacity: defaultNewSeriesCapacity,
+		kvKey:             DefaultKVKey,
+		clockOpts:         clock.NewOptions(),
+		instrumentOpts:    instrument.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.defaultTenant == "" {
+		return errNoDefaultTenant
+	}
+	if o.newSeriesWindow <= 0 {
+		return errInvalidNewSeriesWindow
+	}
+	if o.newSeriesCapacity <= 0 {
+		return errInvalidNewSeriesCapacity
+	}
+	if o.kvStore != nil && o.kvKey == "" {
+		return errNoKVKey
+	}
+	return nil
+}
+
+func (o *options) SetDefaultLimits(value Limits) Options {
+	opts := *o
+	opts.defaultLimits = value
+	return &opts
+}
+
+func (o *options) DefaultLimits() Limits {
+	return o.defaultLimits
+}
+
+func (o *options) SetTenantLimits(value map[string]Limits) Options {
+	opts := *o
+	opts.tenantLimits = value
+	return &opts
+}
+
+func (o *options) TenantLimits() map[string]Limits {
+	return o.tenantLimits
+}
+
+func (o *options) SetTenantTag(value []byte) Options {
+	opts := *o
+	opts.tenantTag = value
+	return &opts
+}
+
+func (o *options) TenantTag() []byte {
+	return o.tenantTag
+}
+
+func (o *options) SetDefaultTenant(value string) Options {
+	opts := *o
+	opts.defaultTenant = value
+	return &opts
+}
+
+func (o *options) DefaultTenant() string {
+	return o.defaultTenant
+}
+
+func (o *options) SetNewSeriesWindow(value time.Duration) Options {
+	opts := *o
+	opts.newSeriesWindow = value
+	return &opts
+}
+
+func (o *options) NewSeriesWindow() time.Duration {
+	return o.newSeriesWindow
+}
+
+func (o *options) SetNewSeriesCapacity(value int) Options {
+	opts := *o
+	opts.newSeriesCapacity = value
+	return &opts
+}
+
+func (o *options) NewSeriesCapacity() int {
+	return o.newSeriesCapacity
+}
+
+func (o *options) SetKVStore(value kv.Store) Options {
+	opts := *o
+	opts.kvStore = value
+	return &opts
+}
+
+func (o *options) KVStore() kv.Store {
+	return o.kvStore
+}
+
+func (o *options) SetKVKey(value string) Options {
+	opts := *o
+	opts.kvKey = value
+	return &opts
+}
+
+func (o *options) KVKey() string {
+	return o.kvKey
+}
+
+func (o *options) SetClockOptions(value clock.Options) Options {
+	opts := *o
+	opts.clockOpts = value
+	return &opts
+}
+
+func (o *options) ClockOptions() clock.Options {
+	return o.clockOpts
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
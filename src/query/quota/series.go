@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"sync"
+	"time"
+
+	"github.com/m3db/bloom"
+)
+
+const newSeriesFalsePositiveRate = 0.01
+
+// seriesTracker tracks the series written within a window using a pair of
+// bloom filters that are rotated every window, so a series is remembered
+// for between one and two windows after it was last written. False
+// positives only ever cause new series to be undercounted.
+type seriesTracker struct {
+	sync.Mutex
+
+	window   time.Duration
+	m, k     uint
+	rotateAt time.Time
+	curr     *bloom.BloomFilter
+	prev     *bloom.BloomFilter
+}
+
+func newSeriesTracker(window time.Duration, capacity int, now time.Time) *seriesTracker {
+	m, k := bloom.EstimateFalsePositiveRate(uint(capacity), newSeriesFalsePositiveRate)
+	return &seriesTracker{
+		window:   window,
+		m:        m,
+		k:        k,
+		rotateAt: now.Add(window),
+		curr:     bloom.NewBloomFilter(m, k),
+		prev:     bloom.NewBloomFilter(m, k),
+	}
+}
+
+// newSeries returns the IDs not seen within the window, each distinct ID
+// is returned at most once.
+func (t *seriesTracker) newSeries(ids [][]byte, now time.Time) [][]byte {
+	t.Lock()
+	defer t.Unlock()
+
+	t.maybeRotateWithLock(now)
+
+	var (
+		result [][]byte
+		seen   map[string]struct{}
+	)
+	for _, id := range ids {
+		if t.curr.Test(id) {
+			continue
+		}
+		if t.prev.Test(id) {
+			// Keep series that are still being written to in the current
+			// filter so they are not forgotten on the next rotation.
+			t.curr.Add(id)
+			continue
+		}
+		if seen == nil {
+			seen = make(map[string]struct{})
+		}
+		if _, ok := seen[string(id)]; ok {
+			continue
+		}
+		seen[string(id)] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
+
+// add marks the IDs as seen.
+func (t *seriesTracker) add(ids [][]byte) {
+	t.Lock()
+	for _, id := range ids {
+		t.curr.Add(id)
+	}
+	t.Unlock()
+}
+
+func (t *seriesTracker) maybeRotateWithLock(now time.Time) {
+	if now.Before(t.rotateAt) {
+		return
+	}
+	if now.Sub(t.rotateAt) >= t.window {
+		// Nothing was written within the last window either.
+		t.prev = bloom.NewBloomFilter(t.m, t.k)
+	} else {
+		t.prev = t.curr
+	}
+	t.curr = bloom.NewBloomFilter(t.m, t.k)
+	t.rotateAt = now.Add(t.window)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"context"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	xerrors "github.com/m3db/m3/src/x/errors"
+)
+
+type quotaStorage struct {
+	storage.Storage
+
+	enforcer Enforcer
+}
+
+// NewStorage returns a storage which enforces the fetched series quotas of
+// the tenant of each query, and the write quotas of each written series.
+func NewStorage(store storage.Storage, enforcer Enforcer) storage.Storage {
+	return &quotaStorage{
+		Storage:  store,
+		enforcer: enforcer,
+	}
+}
+
+func (s *quotaStorage) Fetch(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (*storage.FetchResult, error) {
+	tenant, options, limited := s.fetchOptions(ctx, options)
+	result, err := s.Storage.Fetch(ctx, query, options)
+	return result, s.fetchError(tenant, limited, err)
+}
+
+func (s *quotaStorage) FetchBlocks(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (block.Result, error) {
+	tenant, options, limited := s.fetchOptions(ctx, options)
+	result, err := s.Storage.FetchBlocks(ctx, query, options)
+	return result, s.fetchError(tenant, limited, err)
+}
+
+func (s *quotaStorage) SearchSeries(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (*storage.SearchResults, error) {
+	tenant, options, limited := s.fetchOptions(ctx, options)
+	result, err := s.Storage.SearchSeries(ctx, query, options)
+	return result, s.fetchError(tenant, limited, err)
+}
+
+func (s *quotaStorage) Write(
+	ctx context.Context,
+	query *storage.WriteQuery,
+) error {
+	if query != nil {
+		tenant := s.enforcer.Tenant(ctx, query.Tags)
+		err := s.enforcer.AddWrites(tenant, [][]byte{query.Tags.ID()},
+			len(query.Datapoints))
+		if err != nil {
+			return err
+		}
+	}
+	return s.Storage.Write(ctx, query)
+}
+
+// fetchOptions returns the tenant of the query and the options limited to
+// the tenant's quota, and whether the quota is what limits the query.
+func (s *quotaStorage) fetchOptions(
+	ctx context.Context,
+	options *storage.FetchOptions,
+) (string, *storage.FetchOptions, bool) {
+	tenant := s.enforcer.Tenant(ctx, models.Tags{})
+	limit := s.enforcer.FetchLimit(tenant)
+	if limit <= 0 || options == nil {
+		return tenant, options, false
+	}
+	if options.Limit > 0 && options.Limit <= limit {
+		return tenant, options, false
+	}
+
+	limited := *options
+	limited.Limit = limit
+	limited.RequireExhaustive = true
+	return tenant, &limited, true
+}
+
+func (s *quotaStorage) fetchError(tenant string, limited bool, err error) error {
+	if err != nil && limited && xerrors.IsResourceExhausted(err) {
+		return s.enforcer.FetchLimitExceeded(tenant)
+	}
+	return err
+}
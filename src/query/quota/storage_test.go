@@ -0,0 +1,78 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/m3db/m3/src/query/storage"
+	xerrors "github.com/m3db/m3/src/x/errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageFetchLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, _ := newTestEnforcer(t, NewOptions().
+		SetTenantLimits(map[string]Limits{"a": {MaxFetchedSeries: 10}}))
+
+	inner := storage.NewMockStorage(ctrl)
+	s := NewStorage(inner, e)
+	ctx := NewContextWithTenant(context.Background(), "a")
+
+	// The tenant's limit is applied and must not be exceeded.
+	opts := storage.NewFetchOptions()
+	inner.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ *storage.FetchQuery,
+			options *storage.FetchOptions,
+		) (*storage.FetchResult, error) {
+			assert.Equal(t, 10, options.Limit)
+			assert.True(t, options.RequireExhaustive)
+			return nil, xerrors.NewResourceExhaustedError(errors.New("limit"))
+		})
+	_, err := s.Fetch(ctx, &storage.FetchQuery{}, opts)
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+	assert.Contains(t, err.Error(), "tenant a exceeded quota")
+	assert.Equal(t, 0, opts.Limit)
+
+	// A lower request limit is left as is.
+	opts.Limit = 5
+	inner.EXPECT().Fetch(gomock.Any(), gomock.Any(), opts).
+		Return(&storage.FetchResult{}, nil)
+	_, err = s.Fetch(ctx, &storage.FetchQuery{}, opts)
+	require.NoError(t, err)
+
+	// Tenants without a limit are not limited.
+	opts = storage.NewFetchOptions()
+	inner.EXPECT().SearchSeries(gomock.Any(), gomock.Any(), opts).
+		Return(&storage.SearchResults{}, nil)
+	_, err = s.SearchSeries(context.Background(), &storage.FetchQuery{}, opts)
+	require.NoError(t, err)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package quota provides per-tenant write and query quotas for the
+// coordinator, so that a single misbehaving tenant of a shared cluster is
+// rejected before it can degrade the cluster for every other tenant.
+package quota
+
+import (
+	"context"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+// Limits are the quotas applied to a single tenant, zero values are unlimited.
+type Limits struct {
+	// DatapointsPerSecond is the maximum number of datapoints the tenant may
+	// write per second.
+	DatapointsPerSecond int64 `yaml:"datapointsPerSecond" json:"datapointsPerSecond"`
+
+	// NewSeriesPerSecond is the maximum number of series not seen within the
+	// new series window the tenant may write per second.
+	NewSeriesPerSecond int64 `yaml:"newSeriesPerSecond" json:"newSeriesPerSecond"`
+
+	// MaxFetchedSeries is the maximum number of series a single query of the
+	// tenant may fetch.
+	MaxFetchedSeries int `yaml:"maxFetchedSeries" json:"maxFetchedSeries"`
+}
+
+// TenantWrites are the writes of a single tenant.
+type TenantWrites struct {
+	// IDs are the IDs of the series written to.
+	IDs [][]byte
+
+	// Datapoints is the number of datapoints written.
+	Datapoints int
+}
+
+// Enforcer enforces per-tenant quotas.
+type Enforcer interface {
+	// Tenant returns the tenant a series with the given tags belongs to,
+	// the tags may be empty for requests that are not for a single series.
+	Tenant(ctx context.Context, tags models.Tags) string
+
+	// AddWrites accounts for a write of datapoints to the series with the
+	// given IDs, returning a resource exhausted error if the write would
+	// exceed the tenant's quotas in which case it should be rejected.
+	AddWrites(tenant string, ids [][]byte, datapoints int) error
+
+	// AddBatchWrites accounts for the writes of a batch to the series of
+	// several tenants, the batch is either accounted for as a whole or
+	// rejected with a resource exhausted error without counting towards the
+	// quotas of any tenant.
+	AddBatchWrites(writes map[string]TenantWrites) error
+
+	// FetchLimit returns the maximum number of series a single query of the
+	// tenant may fetch, zero if unlimited.
+	FetchLimit(tenant string) int
+
+	// FetchLimitExceeded returns the resource exhausted error for a query of
+	// the tenant that matched more series than its fetch limit.
+	FetchLimitExceeded(tenant string) error
+
+	// Close closes the enforcer.
+	Close()
+}
+
+// Options are the options for an enforcer.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetDefaultLimits sets the limits for tenants without their own limits.
+	SetDefaultLimits(value Limits) Options
+
+	// DefaultLimits returns the limits for tenants without their own limits.
+	DefaultLimits() Limits
+
+	// SetTenantLimits sets the limits of individual tenants.
+	SetTenantLimits(value map[string]Limits) Options
+
+	// TenantLimits returns the limits of individual tenants.
+	TenantLimits() map[string]Limits
+
+	// SetTenantTag sets the tag which identifies the tenant of a series, if
+	// set it takes precedence over the tenant of the request.
+	SetTenantTag(value []byte) Options
+
+	// TenantTag returns the tag which identifies the tenant of a series.
+	TenantTag() []byte
+
+	// SetDefaultTenant sets the tenant used for series and requests
+	// without a known tenant.
+	SetDefaultTenant(value string) Options
+
+	// DefaultTenant returns the tenant used for series and requests
+	// without a known tenant.
+	DefaultTenant() string
+
+	// SetNewSeriesWindow sets how long a series is remembered for, a series
+	// not written to within the window is counted as new again.
+	SetNewSeriesWindow(value time.Duration) Options
+
+	// NewSeriesWindow returns how long a series is remembered for.
+	NewSeriesWindow() time.Duration
+
+	// SetNewSeriesCapacity sets the expected number of distinct series a
+	// tenant writes within the new series window, used to size the filters
+	// which track the series seen.
+	SetNewSeriesCapacity(value int) Options
+
+	// NewSeriesCapacity returns the expected number of distinct series a
+	// tenant writes within the new series window.
+	NewSeriesCapacity() int
+
+	// SetKVStore sets the KV store to watch for dynamic limits, if nil only
+	// the configured limits are used.
+	SetKVStore(value kv.Store) Options
+
+	// KVStore returns the KV store to watch for dynamic limits.
+	KVStore() kv.Store
+
+	// SetKVKey sets the KV key to watch for dynamic limits.
+	SetKVKey(value string) Options
+
+	// KVKey returns the KV key to watch for dynamic limits.
+	KVKey() string
+
+	// SetClockOptions sets the clock options.
+	SetClockOptions(value clock.Options) Options
+
+	// ClockOptions returns the clock options.
+	ClockOptions() clock.Options
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"context"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type quotaDownsamplerAndWriter struct {
+	ingest.DownsamplerAndWriter
+
+	enforcer Enforcer
+	storage  storage.Storage
+}
+
+// NewDownsamplerAndWriter returns a downsampler and writer which rejects
+// writes that exceed the quotas of their tenant. Batches are checked in
+// full before any series is written so that a batch is either written or
+// rejected as a whole, the storage it returns enforces quotas too.
+func NewDownsamplerAndWriter(
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+	enforcer Enforcer,
+) ingest.DownsamplerAndWriter {
+	return &quotaDownsamplerAndWriter{
+		DownsamplerAndWriter: downsamplerAndWriter,
+		enforcer:             enforcer,
+		storage:              NewStorage(downsamplerAndWriter.Storage(), enforcer),
+	}
+}
+
+func (d *quotaDownsamplerAndWriter) Write(
+	ctx context.Context,
+	tags models.Tags,
+	datapoints ts.Datapoints,
+	unit xtime.Unit,
+	overrides ingest.WriteOptions,
+) error {
+	tenant := d.enforcer.Tenant(ctx, tags)
+	if err := d.enforcer.AddWrites(tenant, [][]byte{tags.ID()}, len(datapoints)); err != nil {
+		return err
+	}
+	return d.DownsamplerAndWriter.Write(ctx, tags, datapoints, unit, overrides)
+}
+
+func (d *quotaDownsamplerAndWriter) WriteBatch(
+	ctx context.Context,
+	iter ingest.DownsampleAndWriteIter,
+) error {
+	writes := make(map[string]TenantWrites)
+	for iter.Next() {
+		tags, datapoints, _ := iter.Current()
+		tenant := d.enforcer.Tenant(ctx, tags)
+		w := writes[tenant]
+		w.IDs = append(w.IDs, tags.ID())
+		w.Datapoints += len(datapoints)
+		writes[tenant] = w
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	if err := d.enforcer.AddBatchWrites(writes); err != nil {
+		return err
+	}
+
+	if err := iter.Reset(); err != nil {
+		return err
+	}
+	return d.DownsamplerAndWriter.WriteBatch(ctx, iter)
+}
+
+func (d *quotaDownsamplerAndWriter) Storage() storage.Storage {
+	return d.storage
+}
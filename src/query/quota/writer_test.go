@@ -0,0 +1,143 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quota
+
+import (
+	"context"
+	"testing"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testIter struct {
+	idx        int
+	tags       []models.Tags
+	datapoints []ts.Datapoints
+}
+
+func newTestIter(tags []models.Tags, datapoints []ts.Datapoints) *testIter {
+	return &testIter{idx: -1, tags: tags, datapoints: datapoints}
+}
+
+func (i *testIter) Next() bool {
+	i.idx++
+	return i.idx < len(i.tags)
+}
+
+func (i *testIter) Current() (models.Tags, ts.Datapoints, xtime.Unit) {
+	return i.tags[i.idx], i.datapoints[i.idx], xtime.Second
+}
+
+func (i *testIter) Reset() error {
+	i.idx = -1
+	return nil
+}
+
+func (i *testIter) Error() error {
+	return nil
+}
+
+func testTags(team, name string) models.Tags {
+	return models.NewTags(2, models.NewTagOptions()).
+		AddTag(models.Tag{Name: []byte("team"), Value: []byte(team)}).
+		AddTag(models.Tag{Name: []byte("__name__"), Value: []byte(name)})
+}
+
+func testDatapoints(n int) ts.Datapoints {
+	return make(ts.Datapoints, n)
+}
+
+func TestDownsamplerAndWriterWriteBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, _ := newTestEnforcer(t, NewOptions().
+		SetTenantTag([]byte("team")).
+		SetTenantLimits(map[string]Limits{
+			"a": {DatapointsPerSecond: 5},
+			"b": {DatapointsPerSecond: 11},
+		}))
+
+	inner := ingest.NewMockDownsamplerAndWriter(ctrl)
+	inner.EXPECT().Storage().Return(storage.NewMockStorage(ctrl))
+	w := NewDownsamplerAndWriter(inner, e)
+
+	iter := newTestIter(
+		[]models.Tags{testTags("a", "foo"), testTags("b", "bar"), testTags("a", "baz")},
+		[]ts.Datapoints{testDatapoints(2), testDatapoints(10), testDatapoints(2)},
+	)
+	inner.EXPECT().WriteBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, iter ingest.DownsampleAndWriteIter) error {
+			n := 0
+			for iter.Next() {
+				n++
+			}
+			assert.Equal(t, 3, n)
+			return nil
+		})
+	require.NoError(t, w.WriteBatch(context.Background(), iter))
+
+	// The batch exceeds the quota of tenant a so is rejected as a whole.
+	iter = newTestIter(
+		[]models.Tags{testTags("a", "foo"), testTags("b", "bar")},
+		[]ts.Datapoints{testDatapoints(2), testDatapoints(1)},
+	)
+	err := w.WriteBatch(context.Background(), iter)
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+
+	// Tenant b was not charged for the rejected batch.
+	inner.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any()).Return(nil)
+	require.NoError(t, w.Write(context.Background(), testTags("b", "bar"),
+		testDatapoints(1), xtime.Second, ingest.WriteOptions{}))
+}
+
+func TestDownsamplerAndWriterWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, _ := newTestEnforcer(t, NewOptions().
+		SetDefaultLimits(Limits{DatapointsPerSecond: 1}))
+
+	inner := ingest.NewMockDownsamplerAndWriter(ctrl)
+	inner.EXPECT().Storage().Return(storage.NewMockStorage(ctrl))
+	inner.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any()).Return(nil)
+	w := NewDownsamplerAndWriter(inner, e)
+
+	tags := testTags("a", "foo")
+	require.NoError(t, w.Write(context.Background(), tags, testDatapoints(1),
+		xtime.Second, ingest.WriteOptions{}))
+	err := w.Write(context.Background(), tags, testDatapoints(1),
+		xtime.Second, ingest.WriteOptions{})
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+}
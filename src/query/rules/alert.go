@@ -0,0 +1,438 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"text/template"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	alertMetricName     = "ALERTS"
+	alertNameLabel      = "alertname"
+	alertStateLabel     = "alertstate"
+	templateDefs        = "{{$labels := .Labels}}{{$value := .Value}}"
+	resolvedRetention   = 15 * time.Minute
+	endsAtResendPeriods = 4
+)
+
+type alertStatus int
+
+const (
+	alertInactive alertStatus = iota
+	alertPending
+	alertFiring
+)
+
+func (s alertStatus) String() string {
+	switch s {
+	case alertPending:
+		return "pending"
+	case alertFiring:
+		return "firing"
+	default:
+		return "inactive"
+	}
+}
+
+func (s alertStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *alertStatus) UnmarshalText(data []byte) error {
+	switch string(data) {
+	case "inactive":
+		*s = alertInactive
+	case "pending":
+		*s = alertPending
+	case "firing":
+		*s = alertFiring
+	default:
+		return fmt.Errorf("invalid alert status: %s", data)
+	}
+	return nil
+}
+
+// activeAlert is the state of the alert of a single series of the results
+// of an alerting rule.
+type activeAlert struct {
+	Rule        int               `json:"rule"`
+	Alert       string            `json:"alert"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Status      alertStatus       `json:"status"`
+	ActiveAt    time.Time         `json:"activeAt"`
+	FiredAt     time.Time         `json:"firedAt"`
+	ResolvedAt  time.Time         `json:"resolvedAt"`
+	LastSentAt  time.Time         `json:"lastSentAt"`
+
+	// Value is not persisted so that the state only changes, and is written
+	// to KV, when the status of an alert changes.
+	Value float64 `json:"-"`
+}
+
+// needsSending returns whether the alert should be sent at the given time,
+// firing alerts are resent every resend delay and resolved alerts are sent
+// once they resolve and then resent until they are forgotten.
+func (a *activeAlert) needsSending(t time.Time, resendDelay time.Duration) bool {
+	if a.Status == alertPending {
+		return false
+	}
+	if a.ResolvedAt.After(a.LastSentAt) {
+		return true
+	}
+	return !t.Before(a.LastSentAt.Add(resendDelay))
+}
+
+// alertState is the state of the alerts of a rule group persisted to KV.
+type alertState struct {
+	Alerts []*activeAlert `json:"alerts"`
+}
+
+type templateData struct {
+	Labels map[string]string
+	Value  float64
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).
+		Option("missingkey=zero").
+		Parse(templateDefs + text)
+}
+
+// expandTemplate expands a label or annotation template, returning the
+// error as the value if the template can not be expanded so that the alert
+// is still sent.
+func expandTemplate(name, text string, data templateData) string {
+	tmpl, err := parseTemplate(name, text)
+	if err != nil {
+		return fmt.Sprintf("<error expanding template: %v>", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Sprintf("<error expanding template: %v>", err)
+	}
+	return buf.String()
+}
+
+// alertKey returns the key of the alert with the given labels.
+func (g *group) alertKey(labels map[string]string) string {
+	return string(g.alertTags(labels).ID())
+}
+
+func (g *group) alertTags(labels map[string]string) models.Tags {
+	tags := models.NewTags(len(labels), g.opts.TagOptions())
+	for name, value := range labels {
+		tags = tags.AddTag(models.Tag{Name: []byte(name), Value: []byte(value)})
+	}
+	return tags
+}
+
+// evaluateAlertRule updates the state of the alerts of an alerting rule with
+// the results of its expression and returns the writes of the ALERTS series
+// of its pending and firing alerts.
+func (g *group) evaluateAlertRule(
+	idx int,
+	rule Rule,
+	samples []Sample,
+	t time.Time,
+) ([]*storage.WriteQuery, error) {
+	var (
+		metricName = string(g.opts.TagOptions().MetricName())
+		results    = make(map[string]*activeAlert, len(samples))
+	)
+	for _, sample := range samples {
+		labels := make(map[string]string, sample.Tags.Len()+len(rule.Labels)+1)
+		for _, tag := range sample.Tags.Tags {
+			if name := string(tag.Name); name != metricName {
+				labels[name] = string(tag.Value)
+			}
+		}
+
+		data := templateData{Labels: labels, Value: sample.Value}
+		alertLabels := make(map[string]string, len(labels)+len(rule.Labels)+1)
+		for name, value := range labels {
+			alertLabels[name] = value
+		}
+		for name, value := range rule.Labels {
+			alertLabels[name] = expandTemplate(name, value, data)
+		}
+		alertLabels[alertNameLabel] = rule.Alert
+
+		var annotations map[string]string
+		if len(rule.Annotations) > 0 {
+			annotations = make(map[string]string, len(rule.Annotations))
+			for name, value := range rule.Annotations {
+				annotations[name] = expandTemplate(name, value, data)
+			}
+		}
+
+		key := g.alertKey(alertLabels)
+		if _, ok := results[key]; ok {
+			return nil, fmt.Errorf("results contain series with the same "+
+				"labels after applying rule labels: %s", key)
+		}
+		results[key] = &activeAlert{
+			Rule:        idx,
+			Alert:       rule.Alert,
+			Labels:      alertLabels,
+			Annotations: annotations,
+			Status:      alertPending,
+			ActiveAt:    t,
+			Value:       sample.Value,
+		}
+	}
+
+	active := g.alerts[idx]
+	if active == nil {
+		active = make(map[string]*activeAlert, len(results))
+		g.alerts[idx] = active
+	}
+	for key, result := range results {
+		alert, ok := active[key]
+		if !ok || alert.Status == alertInactive {
+			active[key] = result
+			continue
+		}
+		alert.Annotations = result.Annotations
+		alert.Value = result.Value
+	}
+
+	forDuration := time.Duration(rule.For)
+	queries := make([]*storage.WriteQuery, 0, len(active))
+	for key, alert := range active {
+		if _, ok := results[key]; !ok {
+			switch {
+			case alert.Status == alertPending,
+				alert.Status == alertInactive && t.Sub(alert.ResolvedAt) > resolvedRetention:
+				delete(active, key)
+			case alert.Status == alertFiring:
+				alert.Status = alertInactive
+				alert.ResolvedAt = t
+			}
+			continue
+		}
+
+		if alert.Status == alertPending && t.Sub(alert.ActiveAt) >= forDuration {
+			alert.Status = alertFiring
+			alert.FiredAt = t
+		}
+		queries = append(queries, g.alertWriteQuery(alert, metricName, t))
+	}
+	return queries, nil
+}
+
+// alertWriteQuery returns the write of the ALERTS series of an alert.
+func (g *group) alertWriteQuery(
+	alert *activeAlert,
+	metricName string,
+	t time.Time,
+) *storage.WriteQuery {
+	labels := make(map[string]string, len(alert.Labels)+2)
+	for name, value := range alert.Labels {
+		labels[name] = value
+	}
+	labels[metricName] = alertMetricName
+	labels[alertStateLabel] = alert.Status.String()
+
+	return &storage.WriteQuery{
+		Tags: g.alertTags(labels),
+		Datapoints: ts.Datapoints{
+			{
+				Timestamp: t,
+				Value:     1,
+			},
+		},
+		Unit: xtime.Millisecond,
+	}
+}
+
+// sendAlerts sends the alerts of the group which are due to be sent.
+func (g *group) sendAlerts(ctx context.Context, t time.Time) {
+	notifier := g.opts.Notifier()
+	if notifier == nil {
+		return
+	}
+
+	var (
+		resendDelay = g.opts.ResendDelay()
+		sent        []*activeAlert
+		alerts      []Alert
+	)
+	for _, active := range g.alerts {
+		for _, key := range sortedAlertKeys(active) {
+			alert := active[key]
+			if !alert.needsSending(t, resendDelay) {
+				continue
+			}
+			sent = append(sent, alert)
+			alerts = append(alerts, g.newAlert(alert, t))
+		}
+	}
+	if len(alerts) == 0 {
+		return
+	}
+
+	// NB: Alerts are only marked as sent once the send succeeds so that
+	// alerts which failed to send are retried on the next evaluation.
+	if err := notifier.Send(ctx, alerts); err != nil {
+		g.metrics.notificationErrors.Inc(1)
+		g.logger.Error("could not send alerts", zap.Error(err))
+		return
+	}
+	for _, alert := range sent {
+		alert.LastSentAt = t
+	}
+	g.metrics.alertsSent.Inc(int64(len(alerts)))
+}
+
+func (g *group) newAlert(alert *activeAlert, t time.Time) Alert {
+	result := Alert{
+		Labels:      alert.Labels,
+		Annotations: alert.Annotations,
+		StartsAt:    alert.ActiveAt,
+		EndsAt:      alert.ResolvedAt,
+	}
+	if alert.Status != alertInactive {
+		// Alertmanager resolves the alert itself if it is not resent before
+		// it ends, so that alerts resolve if the coordinators stop sending.
+		validFor := g.opts.ResendDelay()
+		if g.interval > validFor {
+			validFor = g.interval
+		}
+		result.EndsAt = t.Add(endsAtResendPeriods * validFor)
+	}
+	if externalURL := g.opts.ExternalURL(); externalURL != "" {
+		result.GeneratorURL = externalURL + "/api/v1/query?query=" +
+			url.QueryEscape(g.def.Rules[alert.Rule].Expr)
+	}
+	return result
+}
+
+func sortedAlertKeys(alerts map[string]*activeAlert) []string {
+	keys := make([]string, 0, len(alerts))
+	for key := range alerts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (g *group) hasAlertRules() bool {
+	for _, rule := range g.def.Rules {
+		if rule.Alert != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *group) alertStateKey() string {
+	return fmt.Sprintf(g.opts.AlertStateKeyFmt(), g.def.Name)
+}
+
+// restoreAlerts restores the state of the alerts of the group from KV, so
+// that a coordinator elected leader of the group continues where the
+// previous leader stopped rather than starting all alerts as pending again.
+func (g *group) restoreAlerts() error {
+	store := g.opts.KVStore()
+	if store == nil || !g.hasAlertRules() {
+		return nil
+	}
+
+	value, err := store.Get(g.alertStateKey())
+	if err == kv.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var proto commonpb.StringProto
+	if err := value.Unmarshal(&proto); err != nil {
+		return err
+	}
+	var state alertState
+	if err := json.Unmarshal([]byte(proto.Value), &state); err != nil {
+		return err
+	}
+
+	alerts := make([]map[string]*activeAlert, len(g.def.Rules))
+	for _, alert := range state.Alerts {
+		// Ignore the state of rules which changed since it was persisted.
+		if alert.Rule < 0 || alert.Rule >= len(g.def.Rules) ||
+			g.def.Rules[alert.Rule].Alert != alert.Alert {
+			continue
+		}
+		if alerts[alert.Rule] == nil {
+			alerts[alert.Rule] = make(map[string]*activeAlert)
+		}
+		alerts[alert.Rule][g.alertKey(alert.Labels)] = alert
+	}
+	g.alerts = alerts
+	g.persistedAlerts = []byte(proto.Value)
+	return nil
+}
+
+// persistAlerts persists the state of the alerts of the group to KV if it
+// changed since it was last persisted.
+func (g *group) persistAlerts() error {
+	store := g.opts.KVStore()
+	if store == nil || !g.hasAlertRules() {
+		return nil
+	}
+
+	var state alertState
+	for _, active := range g.alerts {
+		for _, key := range sortedAlertKeys(active) {
+			state.Alerts = append(state.Alerts, active[key])
+		}
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(data, g.persistedAlerts) {
+		return nil
+	}
+
+	if _, err := store.Set(g.alertStateKey(),
+		&commonpb.StringProto{Value: string(data)}); err != nil {
+		return err
+	}
+	g.persistedAlerts = data
+	return nil
+}
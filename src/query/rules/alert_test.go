@@ -0,0 +1,271 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/query/storage/mock"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testNotifier struct {
+	sync.Mutex
+	alerts [][]Alert
+	err    error
+}
+
+func (n *testNotifier) Send(_ context.Context, alerts []Alert) error {
+	n.Lock()
+	defer n.Unlock()
+	if n.err != nil {
+		return n.err
+	}
+	n.alerts = append(n.alerts, alerts)
+	return nil
+}
+
+func (n *testNotifier) setError(err error) {
+	n.Lock()
+	n.err = err
+	n.Unlock()
+}
+
+func (n *testNotifier) sent() [][]Alert {
+	n.Lock()
+	defer n.Unlock()
+	result := n.alerts
+	n.alerts = nil
+	return result
+}
+
+var testAlertGroup = RuleGroup{
+	Name: "test",
+	Rules: []Rule{
+		{
+			Alert:       "InstanceDown",
+			Expr:        "up == 0",
+			For:         model.Duration(2 * time.Minute),
+			Labels:      map[string]string{"severity": "page"},
+			Annotations: map[string]string{"summary": "{{ $labels.instance }} is down: {{ $value }}"},
+		},
+	},
+}
+
+// testAlertQueryFunc returns a single down instance while down is true.
+func testAlertQueryFunc(down *bool) QueryFunc {
+	return func(_ context.Context, _ string, _ time.Time) ([]Sample, error) {
+		if !*down {
+			return nil, nil
+		}
+		return []Sample{
+			{
+				Tags: newTestTags(map[string]string{
+					"__name__": "up",
+					"instance": "a",
+				}),
+				Value: 0,
+			},
+		}, nil
+	}
+}
+
+func alertStates(t *testing.T, store mock.Storage, from int) []string {
+	var states []string
+	for _, write := range store.Writes()[from:] {
+		name, ok := write.Tags.Name()
+		require.True(t, ok)
+		require.Equal(t, alertMetricName, string(name))
+		state, ok := write.Tags.Get([]byte(alertStateLabel))
+		require.True(t, ok)
+		states = append(states, string(state))
+	}
+	return states
+}
+
+func TestGroupAlertStates(t *testing.T) {
+	var (
+		down     = true
+		store    = mock.NewMockStorage()
+		notifier = &testNotifier{}
+		opts     = newTestGroupOptions(store, testAlertQueryFunc(&down)).
+				SetEvaluationInterval(time.Minute).
+				SetResendDelay(5 * time.Minute).
+				SetNotifier(notifier).
+				SetExternalURL("http://m3query")
+		g     = newGroup(testAlertGroup, opts)
+		start = time.Unix(1565000000, 0)
+		ctx   = context.Background()
+	)
+
+	// The alert is pending until the for duration has elapsed.
+	g.evaluate(ctx, start)
+	g.evaluate(ctx, start.Add(time.Minute))
+	assert.Equal(t, []string{"pending", "pending"}, alertStates(t, store, 0))
+	assert.Equal(t, 0, len(notifier.sent()))
+
+	// The alert fires and is sent once the for duration has elapsed.
+	g.evaluate(ctx, start.Add(2*time.Minute))
+	assert.Equal(t, []string{"firing"}, alertStates(t, store, 2))
+	write := store.Writes()[2]
+	assert.Equal(t, newTestTags(map[string]string{
+		"__name__":   "ALERTS",
+		"alertname":  "InstanceDown",
+		"alertstate": "firing",
+		"instance":   "a",
+		"severity":   "page",
+	}).Tags, write.Tags.Tags)
+
+	expected := Alert{
+		Labels: map[string]string{
+			"alertname": "InstanceDown",
+			"instance":  "a",
+			"severity":  "page",
+		},
+		Annotations:  map[string]string{"summary": "a is down: 0"},
+		StartsAt:     start,
+		EndsAt:       start.Add(2*time.Minute + 4*5*time.Minute),
+		GeneratorURL: "http://m3query/api/v1/query?query=up+%3D%3D+0",
+	}
+	assert.Equal(t, [][]Alert{{expected}}, notifier.sent())
+
+	// The firing alert is not resent until the resend delay has elapsed.
+	g.evaluate(ctx, start.Add(3*time.Minute))
+	assert.Equal(t, 0, len(notifier.sent()))
+	g.evaluate(ctx, start.Add(7*time.Minute))
+	assert.Equal(t, 1, len(notifier.sent()))
+
+	// The alert resolves and is sent once the series is no longer returned.
+	down = false
+	numWrites := len(store.Writes())
+	g.evaluate(ctx, start.Add(8*time.Minute))
+	assert.Equal(t, numWrites, len(store.Writes()))
+
+	expected.EndsAt = start.Add(8 * time.Minute)
+	assert.Equal(t, [][]Alert{{expected}}, notifier.sent())
+
+	// Resolved alerts are forgotten after the retention.
+	require.Equal(t, 1, len(g.alerts[0]))
+	g.evaluate(ctx, start.Add(8*time.Minute+resolvedRetention+time.Minute))
+	assert.Equal(t, 0, len(g.alerts[0]))
+}
+
+func TestGroupAlertResentAfterSendError(t *testing.T) {
+	var (
+		down     = true
+		store    = mock.NewMockStorage()
+		notifier = &testNotifier{}
+		opts     = newTestGroupOptions(store, testAlertQueryFunc(&down)).
+				SetEvaluationInterval(time.Minute).
+				SetResendDelay(5 * time.Minute).
+				SetNotifier(notifier)
+		g     = newGroup(testAlertGroup, opts)
+		start = time.Unix(1565000000, 0)
+		ctx   = context.Background()
+	)
+
+	g.evaluate(ctx, start)
+	notifier.setError(errors.New("unavailable"))
+	g.evaluate(ctx, start.Add(2*time.Minute))
+	assert.Equal(t, 0, len(notifier.sent()))
+
+	// The alert is sent on the next evaluation rather than after the resend
+	// delay since it was never sent.
+	notifier.setError(nil)
+	g.evaluate(ctx, start.Add(3*time.Minute))
+	assert.Equal(t, 1, len(notifier.sent()))
+	g.evaluate(ctx, start.Add(4*time.Minute))
+	assert.Equal(t, 0, len(notifier.sent()))
+}
+
+func TestGroupAlertPendingResets(t *testing.T) {
+	var (
+		down  = true
+		store = mock.NewMockStorage()
+		g     = newGroup(testAlertGroup,
+			newTestGroupOptions(store, testAlertQueryFunc(&down)))
+		start = time.Unix(1565000000, 0)
+		ctx   = context.Background()
+	)
+
+	g.evaluate(ctx, start)
+	down = false
+	g.evaluate(ctx, start.Add(time.Minute))
+	assert.Equal(t, 0, len(g.alerts[0]))
+
+	// The for duration starts again when the series returns.
+	down = true
+	g.evaluate(ctx, start.Add(2*time.Minute))
+	g.evaluate(ctx, start.Add(3*time.Minute))
+	assert.Equal(t, []string{"pending", "pending", "pending"},
+		alertStates(t, store, 0))
+}
+
+func TestGroupAlertStateRestore(t *testing.T) {
+	var (
+		down    = true
+		kvStore = mem.NewStore()
+		store   = mock.NewMockStorage()
+		opts    = newTestGroupOptions(store, testAlertQueryFunc(&down)).
+			SetKVStore(kvStore)
+		start = time.Unix(1565000000, 0)
+		ctx   = context.Background()
+	)
+
+	g := newGroup(testAlertGroup, opts)
+	require.NoError(t, g.restoreAlerts())
+	g.evaluate(ctx, start)
+	g.evaluate(ctx, start.Add(2*time.Minute))
+	assert.Equal(t, []string{"pending", "firing"}, alertStates(t, store, 0))
+
+	// A new leader of the group continues from the persisted state rather
+	// than starting the alert as pending again.
+	restored := newGroup(testAlertGroup, opts)
+	require.NoError(t, restored.restoreAlerts())
+	require.Equal(t, 1, len(restored.alerts[0]))
+	for key, alert := range restored.alerts[0] {
+		expected := g.alerts[0][key]
+		require.NotNil(t, expected)
+		assert.Equal(t, alertFiring, alert.Status)
+		assert.True(t, expected.ActiveAt.Equal(alert.ActiveAt))
+		assert.True(t, expected.FiredAt.Equal(alert.FiredAt))
+		assert.Equal(t, expected.Labels, alert.Labels)
+		assert.Equal(t, expected.Annotations, alert.Annotations)
+	}
+
+	restored.evaluate(ctx, start.Add(3*time.Minute))
+	assert.Equal(t, []string{"firing"}, alertStates(t, store, 2))
+
+	// State of rules which changed is not restored.
+	changed := testAlertGroup
+	changed.Rules = []Rule{{Alert: "Other", Expr: "up == 0"}}
+	g = newGroup(changed, opts)
+	require.NoError(t, g.restoreAlerts())
+	assert.Equal(t, 0, len(g.alerts[0]))
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"time"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	defaultQueryTimeout        = time.Minute
+	defaultAlertmanagerTimeout = 10 * time.Second
+)
+
+// Configuration is the configuration for rules evaluation.
+type Configuration struct {
+	// Enabled enables rules evaluation.
+	Enabled bool `yaml:"enabled"`
+
+	// Groups are the rule groups evaluated in addition to any set in KV,
+	// in the Prometheus rule file format.
+	Groups []RuleGroup `yaml:"groups"`
+
+	// EvaluationInterval is the evaluation interval of rule groups without
+	// an interval of their own.
+	EvaluationInterval *time.Duration `yaml:"evaluationInterval"`
+
+	// QueryTimeout is the timeout for evaluating a single rule.
+	QueryTimeout *time.Duration `yaml:"queryTimeout"`
+
+	// KVKey is the KV key watched for rule groups, only used if cluster
+	// management is configured.
+	KVKey string `yaml:"kvKey"`
+
+	// AlertStateKeyFmt is the format of the KV key the state of the alerts
+	// of a rule group is persisted to, formatted with the name of the group,
+	// only used if cluster management is configured.
+	AlertStateKeyFmt string `yaml:"alertStateKeyFmt"`
+
+	// Alertmanager configures the Alertmanagers alerts are sent to, if not
+	// set alerting rules are evaluated but alerts are not sent.
+	Alertmanager *AlertmanagerConfiguration `yaml:"alertmanager"`
+
+	// ResendDelay is how long to wait before resending a firing alert.
+	ResendDelay *time.Duration `yaml:"resendDelay"`
+
+	// ExternalURL is the URL the generator URLs of alerts link to.
+	ExternalURL string `yaml:"externalURL"`
+
+	// Election configures the leader election which ensures each rule group
+	// is evaluated by a single coordinator, if not set every coordinator
+	// evaluates every rule group.
+	Election *ElectionConfiguration `yaml:"election"`
+}
+
+// AlertmanagerConfiguration is the configuration for sending alerts to
+// Alertmanager.
+type AlertmanagerConfiguration struct {
+	// URLs are the URLs of the Alertmanagers, alerts are sent to each of
+	// them using the Alertmanager v2 API.
+	URLs []string `yaml:"urls" validate:"nonzero"`
+
+	// Timeout is the timeout for sending alerts to a single Alertmanager.
+	Timeout *time.Duration `yaml:"timeout"`
+}
+
+// NewNotifier returns a new notifier which sends alerts to the configured
+// Alertmanagers.
+func (c AlertmanagerConfiguration) NewNotifier() Notifier {
+	timeout := defaultAlertmanagerTimeout
+	if c.Timeout != nil {
+		timeout = *c.Timeout
+	}
+	return NewAlertmanagerNotifier(c.URLs, timeout)
+}
+
+// ElectionConfiguration is the configuration for rule group leader election.
+type ElectionConfiguration struct {
+	// ServiceID is the service the elections are held for, its zone must be
+	// the zone of the cluster management etcd cluster.
+	ServiceID services.ServiceIDConfiguration `yaml:"serviceID"`
+
+	// Election configures election timeouts and TTLs.
+	Election services.ElectionConfiguration `yaml:"election"`
+
+	// LeaderValue is the value announced by the coordinator when it is the
+	// leader, defaults to the hostname.
+	LeaderValue string `yaml:"leaderValue"`
+
+	// ElectionKeyFmt is the format of the election ID of a rule group,
+	// formatted with the name of the group.
+	ElectionKeyFmt string `yaml:"electionKeyFmt"`
+}
+
+// NewManager returns a new rules manager, or nil if rules evaluation is not
+// enabled. The cluster management client is optional, rule groups are only
+// watched in KV and elections held if it is set.
+func (c Configuration) NewManager(
+	engine *executor.Engine,
+	store storage.Storage,
+	clusterManagementClient clusterclient.Client,
+	tagOptions models.TagOptions,
+	instrumentOpts instrument.Options,
+) (Manager, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+
+	queryTimeout := defaultQueryTimeout
+	if c.QueryTimeout != nil {
+		queryTimeout = *c.QueryTimeout
+	}
+
+	opts := NewOptions().
+		SetGroups(c.Groups).
+		SetQueryFunc(NewEngineQueryFunc(engine, tagOptions, queryTimeout)).
+		SetStorage(store).
+		SetTagOptions(tagOptions).
+		SetInstrumentOptions(instrumentOpts.
+			SetMetricsScope(instrumentOpts.MetricsScope().SubScope("rules")))
+	if c.EvaluationInterval != nil {
+		opts = opts.SetEvaluationInterval(*c.EvaluationInterval)
+	}
+	if c.KVKey != "" {
+		opts = opts.SetKVKey(c.KVKey)
+	}
+	if c.AlertStateKeyFmt != "" {
+		opts = opts.SetAlertStateKeyFmt(c.AlertStateKeyFmt)
+	}
+	if c.Alertmanager != nil {
+		opts = opts.SetNotifier(c.Alertmanager.NewNotifier())
+	}
+	if c.ResendDelay != nil {
+		opts = opts.SetResendDelay(*c.ResendDelay)
+	}
+	if c.ExternalURL != "" {
+		opts = opts.SetExternalURL(c.ExternalURL)
+	}
+
+	if clusterManagementClient != nil {
+		kvStore, err := clusterManagementClient.KV()
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetKVStore(kvStore)
+
+		if c.Election != nil {
+			opts, err = c.Election.apply(opts, clusterManagementClient)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return NewManager(opts)
+}
+
+func (c ElectionConfiguration) apply(
+	opts Options,
+	clusterManagementClient clusterclient.Client,
+) (Options, error) {
+	svcs, err := clusterManagementClient.Services(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderService, err := svcs.LeaderService(c.ServiceID.NewServiceID(),
+		c.Election.NewOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	campaignOpts, err := services.NewCampaignOptions()
+	if err != nil {
+		return nil, err
+	}
+	if c.LeaderValue != "" {
+		campaignOpts = campaignOpts.SetLeaderValue(c.LeaderValue)
+	}
+
+	opts = opts.
+		SetLeaderService(leaderService).
+		SetCampaignOptions(campaignOpts)
+	if c.ElectionKeyFmt != "" {
+		opts = opts.SetElectionKeyFmt(c.ElectionKeyFmt)
+	}
+	return opts, nil
+}
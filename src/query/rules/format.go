@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var (
+	metricNameRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNameRegexp  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	errNoGroupName = errors.New("rule group has no name")
+	errNoRules     = errors.New("rule group has no rules")
+	errNoRecord    = errors.New("rule has neither a record nor an alert name")
+	errRecordAlert = errors.New("rule has both a record and an alert name")
+	errNoExpr      = errors.New("rule has no expression")
+	errRecordFor   = errors.New("recording rule has a for duration")
+	errRecordAnnot = errors.New("recording rule has annotations")
+)
+
+// ParseRuleGroups parses and validates rule groups in the Prometheus rule
+// file format, as either YAML or JSON.
+func ParseRuleGroups(data []byte) ([]RuleGroup, error) {
+	var groups RuleGroups
+	if err := yaml.UnmarshalStrict(data, &groups); err != nil {
+		return nil, err
+	}
+	if err := validateRuleGroups(groups.Groups); err != nil {
+		return nil, err
+	}
+	return groups.Groups, nil
+}
+
+func validateRuleGroups(groups []RuleGroup) error {
+	names := make(map[string]struct{}, len(groups))
+	for _, group := range groups {
+		if group.Name == "" {
+			return errNoGroupName
+		}
+		if _, ok := names[group.Name]; ok {
+			return fmt.Errorf("duplicate rule group name: %s", group.Name)
+		}
+		names[group.Name] = struct{}{}
+
+		if err := group.validate(); err != nil {
+			return fmt.Errorf("invalid rule group %s: %v", group.Name, err)
+		}
+	}
+	return nil
+}
+
+func (g RuleGroup) validate() error {
+	if g.Interval < 0 {
+		return fmt.Errorf("negative interval: %v", g.Interval)
+	}
+	if len(g.Rules) == 0 {
+		return errNoRules
+	}
+	for i, rule := range g.Rules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("invalid rule %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (r Rule) validate() error {
+	switch {
+	case r.Record == "" && r.Alert == "":
+		return errNoRecord
+	case r.Record != "" && r.Alert != "":
+		return errRecordAlert
+	}
+	if r.Expr == "" {
+		return errNoExpr
+	}
+	for name := range r.Labels {
+		if !labelNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid label name: %s", name)
+		}
+	}
+
+	if r.Record != "" {
+		if !metricNameRegexp.MatchString(r.Record) {
+			return fmt.Errorf("invalid record name: %s", r.Record)
+		}
+		if r.For != 0 {
+			return errRecordFor
+		}
+		if len(r.Annotations) > 0 {
+			return errRecordAnnot
+		}
+		return nil
+	}
+
+	if !labelNameRegexp.MatchString(r.Alert) {
+		return fmt.Errorf("invalid alert name: %s", r.Alert)
+	}
+	if r.For < 0 {
+		return fmt.Errorf("negative for duration: %v", r.For)
+	}
+	for name, value := range r.Labels {
+		if _, err := parseTemplate(name, value); err != nil {
+			return fmt.Errorf("invalid template for label %s: %v", name, err)
+		}
+	}
+	for name, value := range r.Annotations {
+		if !labelNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid annotation name: %s", name)
+		}
+		if _, err := parseTemplate(name, value); err != nil {
+			return fmt.Errorf("invalid template for annotation %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// name returns the record or alert name of the rule.
+func (r Rule) name() string {
+	if r.Alert != "" {
+		return r.Alert
+	}
+	return r.Record
+}
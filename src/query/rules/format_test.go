@@ -0,0 +1,203 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRuleGroupsYAML(t *testing.T) {
+	groups, err := ParseRuleGroups([]byte(`
+groups:
+  - name: http
+    interval: 30s
+    rules:
+      - record: job:http_requests:rate5m
+        expr: sum by (job) (rate(http_requests_total[5m]))
+        labels:
+          team: storage
+      - record: job:http_errors:ratio5m
+        expr: job:http_errors:rate5m / job:http_requests:rate5m
+  - name: cpu
+    rules:
+      - record: instance:cpu:avg
+        expr: avg by (instance) (cpu)
+`))
+	require.NoError(t, err)
+
+	expected := []RuleGroup{
+		{
+			Name:     "http",
+			Interval: model.Duration(30 * time.Second),
+			Rules: []Rule{
+				{
+					Record: "job:http_requests:rate5m",
+					Expr:   "sum by (job) (rate(http_requests_total[5m]))",
+					Labels: map[string]string{"team": "storage"},
+				},
+				{
+					Record: "job:http_errors:ratio5m",
+					Expr:   "job:http_errors:rate5m / job:http_requests:rate5m",
+				},
+			},
+		},
+		{
+			Name: "cpu",
+			Rules: []Rule{
+				{
+					Record: "instance:cpu:avg",
+					Expr:   "avg by (instance) (cpu)",
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, groups)
+}
+
+func TestParseRuleGroupsAlerts(t *testing.T) {
+	groups, err := ParseRuleGroups([]byte(`
+groups:
+  - name: alerts
+    rules:
+      - alert: InstanceDown
+        expr: up == 0
+        for: 5m
+        labels:
+          severity: page
+        annotations:
+          summary: "{{ $labels.instance }} is down"
+`))
+	require.NoError(t, err)
+
+	expected := []RuleGroup{
+		{
+			Name: "alerts",
+			Rules: []Rule{
+				{
+					Alert:       "InstanceDown",
+					Expr:        "up == 0",
+					For:         model.Duration(5 * time.Minute),
+					Labels:      map[string]string{"severity": "page"},
+					Annotations: map[string]string{"summary": "{{ $labels.instance }} is down"},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, groups)
+}
+
+func TestParseRuleGroupsJSON(t *testing.T) {
+	groups, err := ParseRuleGroups([]byte(`{"groups": [{"name": "cpu", ` +
+		`"interval": "1m", "rules": [{"record": "instance:cpu:avg", ` +
+		`"expr": "avg by (instance) (cpu)"}]}]}`))
+	require.NoError(t, err)
+
+	expected := []RuleGroup{
+		{
+			Name:     "cpu",
+			Interval: model.Duration(time.Minute),
+			Rules: []Rule{
+				{
+					Record: "instance:cpu:avg",
+					Expr:   "avg by (instance) (cpu)",
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, groups)
+}
+
+func TestParseRuleGroupsInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "unknown field",
+			data: `groups: [{name: a, rules: [{record: a, expr: b, unknown: c}]}]`,
+		},
+		{
+			name: "no group name",
+			data: `groups: [{rules: [{record: a, expr: b}]}]`,
+		},
+		{
+			name: "duplicate group name",
+			data: `groups: [{name: a, rules: [{record: a, expr: b}]}, ` +
+				`{name: a, rules: [{record: a, expr: b}]}]`,
+		},
+		{
+			name: "no rules",
+			data: `groups: [{name: a}]`,
+		},
+		{
+			name: "no record or alert",
+			data: `groups: [{name: a, rules: [{expr: b}]}]`,
+		},
+		{
+			name: "record and alert",
+			data: `groups: [{name: a, rules: [{record: a, alert: a, expr: b}]}]`,
+		},
+		{
+			name: "invalid record",
+			data: `groups: [{name: a, rules: [{record: "a-b", expr: b}]}]`,
+		},
+		{
+			name: "no expr",
+			data: `groups: [{name: a, rules: [{record: a}]}]`,
+		},
+		{
+			name: "invalid alert",
+			data: `groups: [{name: a, rules: [{alert: "a:b", expr: b}]}]`,
+		},
+		{
+			name: "record with for",
+			data: `groups: [{name: a, rules: [{record: a, expr: b, for: 1m}]}]`,
+		},
+		{
+			name: "record with annotations",
+			data: `groups: [{name: a, rules: [{record: a, expr: b, annotations: {c: d}}]}]`,
+		},
+		{
+			name: "invalid label template",
+			data: `groups: [{name: a, rules: [{alert: a, expr: b, labels: {c: "{{ $labels"}}]}]`,
+		},
+		{
+			name: "invalid annotation template",
+			data: `groups: [{name: a, rules: [{alert: a, expr: b, annotations: {c: "{{ $foo }}"}}]}]`,
+		},
+		{
+			name: "invalid label name",
+			data: `groups: [{name: a, rules: [{record: a, expr: b, labels: {"a-b": c}}]}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseRuleGroups([]byte(test.data))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/services/leader/campaign"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+type groupMetrics struct {
+	evaluations        tally.Counter
+	evaluationFailures tally.Counter
+	evaluationMisses   tally.Counter
+	samplesWritten     tally.Counter
+	writeErrors        tally.Counter
+	alertsSent         tally.Counter
+	notificationErrors tally.Counter
+	alertStateErrors   tally.Counter
+	evaluationLatency  tally.Timer
+	leader             tally.Gauge
+}
+
+func newGroupMetrics(scope tally.Scope) groupMetrics {
+	return groupMetrics{
+		evaluations:        scope.Counter("evaluations"),
+		evaluationFailures: scope.Counter("evaluation-failures"),
+		evaluationMisses:   scope.Counter("evaluation-misses"),
+		samplesWritten:     scope.Counter("samples-written"),
+		writeErrors:        scope.Counter("write-errors"),
+		alertsSent:         scope.Counter("alerts-sent"),
+		notificationErrors: scope.Counter("notification-errors"),
+		alertStateErrors:   scope.Counter("alert-state-errors"),
+		evaluationLatency:  scope.Timer("evaluation-latency"),
+		leader:             scope.Gauge("leader"),
+	}
+}
+
+// group evaluates the rules of a rule group on its interval, while it is
+// the leader of the group's election if leader election is enabled.
+type group struct {
+	def      RuleGroup
+	interval time.Duration
+	opts     Options
+	logger   *zap.Logger
+	metrics  groupMetrics
+	nowFn    func() time.Time
+
+	// alerts is the state of the alerts of each alerting rule of the group,
+	// keyed by the labels of the alerts, it is only accessed by evaluations.
+	alerts          []map[string]*activeAlert
+	persistedAlerts []byte
+
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+func newGroup(def RuleGroup, opts Options) *group {
+	interval := time.Duration(def.Interval)
+	if interval == 0 {
+		interval = opts.EvaluationInterval()
+	}
+
+	iOpts := opts.InstrumentOptions()
+	scope := iOpts.MetricsScope().Tagged(map[string]string{"group": def.Name})
+	return &group{
+		def:      def,
+		interval: interval,
+		opts:     opts,
+		logger:   iOpts.Logger().With(zap.String("group", def.Name)),
+		metrics:  newGroupMetrics(scope),
+		nowFn:    opts.ClockOptions().NowFn(),
+		alerts:   make([]map[string]*activeAlert, len(def.Rules)),
+		closeCh:  make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+func (g *group) start() {
+	go func() {
+		defer close(g.doneCh)
+		if g.opts.LeaderService() == nil {
+			g.evaluateLoop(g.closeCh)
+			return
+		}
+		g.campaignLoop()
+	}()
+}
+
+func (g *group) close() {
+	close(g.closeCh)
+	<-g.doneCh
+}
+
+// campaignLoop campaigns for leadership of the group and evaluates the group
+// while it is the leader, campaigning again whenever the campaign ends.
+func (g *group) campaignLoop() {
+	var (
+		leaderService = g.opts.LeaderService()
+		electionID    = fmt.Sprintf(g.opts.ElectionKeyFmt(), g.def.Name)
+	)
+	for {
+		statusCh, err := leaderService.Campaign(electionID, g.opts.CampaignOptions())
+		if err != nil {
+			g.logger.Error("could not campaign for rule group", zap.Error(err))
+			select {
+			case <-g.closeCh:
+				return
+			case <-time.After(g.interval):
+				continue
+			}
+		}
+
+		if closed := g.watchCampaign(statusCh); closed {
+			if err := leaderService.Resign(electionID); err != nil {
+				g.logger.Debug("could not resign rule group leadership", zap.Error(err))
+			}
+			// The status channel must be consumed until it is closed.
+			go func() {
+				for range statusCh {
+				}
+			}()
+			return
+		}
+	}
+}
+
+// watchCampaign evaluates the group while the campaign is in the leader
+// state, returning true if the group was closed or false if the campaign
+// ended.
+func (g *group) watchCampaign(statusCh <-chan campaign.Status) bool {
+	var (
+		evaluateCloseCh chan struct{}
+		evaluateDoneCh  chan struct{}
+	)
+	stopEvaluating := func() {
+		if evaluateCloseCh == nil {
+			return
+		}
+		close(evaluateCloseCh)
+		<-evaluateDoneCh
+		evaluateCloseCh, evaluateDoneCh = nil, nil
+		g.metrics.leader.Update(0)
+	}
+	defer stopEvaluating()
+
+	for {
+		select {
+		case <-g.closeCh:
+			return true
+		case status, ok := <-statusCh:
+			if !ok {
+				return false
+			}
+
+			switch status.State {
+			case campaign.Leader:
+				if evaluateCloseCh != nil {
+					continue
+				}
+				g.logger.Info("elected leader of rule group")
+				g.metrics.leader.Update(1)
+				evaluateCloseCh = make(chan struct{})
+				evaluateDoneCh = make(chan struct{})
+				go func(closeCh, doneCh chan struct{}) {
+					defer close(doneCh)
+					g.evaluateLoop(closeCh)
+				}(evaluateCloseCh, evaluateDoneCh)
+			case campaign.Error:
+				g.logger.Error("rule group campaign error", zap.Error(status.Err))
+				stopEvaluating()
+			default:
+				stopEvaluating()
+			}
+		}
+	}
+}
+
+// evaluateLoop evaluates the group at the start of every interval until
+// closed.
+func (g *group) evaluateLoop(closeCh <-chan struct{}) {
+	if err := g.restoreAlerts(); err != nil {
+		g.metrics.alertStateErrors.Inc(1)
+		g.logger.Error("could not restore alert state", zap.Error(err))
+	}
+
+	var last time.Time
+	for {
+		// Align evaluations to the interval so that the timestamps of the
+		// results do not depend on which coordinator evaluates the group.
+		now := g.nowFn()
+		next := now.Truncate(g.interval).Add(g.interval)
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-closeCh:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if !last.IsZero() {
+			if missed := int64(next.Sub(last)/g.interval) - 1; missed > 0 {
+				g.metrics.evaluationMisses.Inc(missed)
+			}
+		}
+		last = next
+
+		g.evaluate(context.Background(), next)
+	}
+}
+
+// evaluate evaluates the rules of the group in order at the given time,
+// writes their results and sends and persists the resulting alerts.
+func (g *group) evaluate(ctx context.Context, t time.Time) {
+	start := g.nowFn()
+	defer func() {
+		g.metrics.evaluationLatency.Record(g.nowFn().Sub(start))
+	}()
+
+	for i, rule := range g.def.Rules {
+		g.metrics.evaluations.Inc(1)
+		samples, err := g.opts.QueryFunc()(ctx, rule.Expr, t)
+		if err != nil {
+			g.metrics.evaluationFailures.Inc(1)
+			g.logger.Error("could not evaluate rule",
+				zap.String("rule", rule.name()), zap.Error(err))
+			continue
+		}
+
+		var queries []*storage.WriteQuery
+		if rule.Alert != "" {
+			queries, err = g.evaluateAlertRule(i, rule, samples, t)
+		} else {
+			queries, err = g.writeQueries(rule, samples, t)
+		}
+		if err != nil {
+			g.metrics.evaluationFailures.Inc(1)
+			g.logger.Error("could not evaluate rule",
+				zap.String("rule", rule.name()), zap.Error(err))
+			continue
+		}
+
+		for _, query := range queries {
+			if err := g.opts.Storage().Write(ctx, query); err != nil {
+				g.metrics.writeErrors.Inc(1)
+				g.logger.Error("could not write rule result",
+					zap.String("rule", rule.name()), zap.Error(err))
+				continue
+			}
+			g.metrics.samplesWritten.Inc(1)
+		}
+	}
+
+	g.sendAlerts(ctx, t)
+	if err := g.persistAlerts(); err != nil {
+		g.metrics.alertStateErrors.Inc(1)
+		g.logger.Error("could not persist alert state", zap.Error(err))
+	}
+}
+
+// writeQueries returns the writes of the results of a rule, renamed to the
+// record name of the rule and with the labels of the rule applied.
+func (g *group) writeQueries(
+	rule Rule,
+	samples []Sample,
+	t time.Time,
+) ([]*storage.WriteQuery, error) {
+	var (
+		tagOpts = g.opts.TagOptions()
+		queries = make([]*storage.WriteQuery, 0, len(samples))
+		seen    = make(map[string]struct{}, len(samples))
+	)
+	for _, sample := range samples {
+		tags := models.NewTags(sample.Tags.Len()+len(rule.Labels)+1, tagOpts).
+			AddTags(sample.Tags.Tags).
+			SetName([]byte(rule.Record))
+		for name, value := range rule.Labels {
+			tags = tags.AddOrUpdateTag(models.Tag{
+				Name:  []byte(name),
+				Value: []byte(value),
+			})
+		}
+
+		id := string(tags.ID())
+		if _, ok := seen[id]; ok {
+			return nil, fmt.Errorf("results contain series with the same "+
+				"tags after applying rule labels: %s", id)
+		}
+		seen[id] = struct{}{}
+
+		queries = append(queries, &storage.WriteQuery{
+			Tags: tags,
+			Datapoints: ts.Datapoints{
+				{
+					Timestamp: t,
+					Value:     sample.Value,
+				},
+			},
+			Unit: xtime.Millisecond,
+		})
+	}
+	return queries, nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/mock"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTags(tags map[string]string) models.Tags {
+	result := models.NewTags(len(tags), models.NewTagOptions())
+	for name, value := range tags {
+		result = result.AddTag(models.Tag{Name: []byte(name), Value: []byte(value)})
+	}
+	return result
+}
+
+func newTestGroupOptions(
+	store mock.Storage,
+	queryFn QueryFunc,
+) Options {
+	return NewOptions().
+		SetQueryFunc(queryFn).
+		SetStorage(store)
+}
+
+func TestGroupEvaluate(t *testing.T) {
+	var queries []string
+	queryFn := func(_ context.Context, query string, _ time.Time) ([]Sample, error) {
+		queries = append(queries, query)
+		switch query {
+		case "sum by (job) (rate(http_requests_total[5m]))":
+			return []Sample{
+				{Tags: newTestTags(map[string]string{"job": "a"}), Value: 1},
+				{Tags: newTestTags(map[string]string{"job": "b"}), Value: 2},
+			}, nil
+		case "up":
+			return []Sample{
+				{
+					Tags: newTestTags(map[string]string{
+						"__name__": "up",
+						"instance": "c",
+						"team":     "other",
+					}),
+					Value: 3,
+				},
+			}, nil
+		}
+		return nil, errors.New("unexpected query")
+	}
+
+	store := mock.NewMockStorage()
+	g := newGroup(RuleGroup{
+		Name: "test",
+		Rules: []Rule{
+			{
+				Record: "job:http_requests:rate5m",
+				Expr:   "sum by (job) (rate(http_requests_total[5m]))",
+			},
+			{
+				Record: "bad",
+				Expr:   "bad",
+			},
+			{
+				Record: "instance:up",
+				Expr:   "up",
+				Labels: map[string]string{"team": "storage"},
+			},
+		},
+	}, newTestGroupOptions(store, queryFn))
+
+	now := time.Unix(1565000000, 0)
+	g.evaluate(context.Background(), now)
+
+	// Rules are evaluated in order and failed rules do not prevent later
+	// rules from being evaluated.
+	assert.Equal(t, []string{
+		"sum by (job) (rate(http_requests_total[5m]))",
+		"bad",
+		"up",
+	}, queries)
+
+	writes := store.Writes()
+	require.Equal(t, 3, len(writes))
+
+	expected := []struct {
+		tags  map[string]string
+		value float64
+	}{
+		{
+			tags:  map[string]string{"__name__": "job:http_requests:rate5m", "job": "a"},
+			value: 1,
+		},
+		{
+			tags:  map[string]string{"__name__": "job:http_requests:rate5m", "job": "b"},
+			value: 2,
+		},
+		{
+			tags: map[string]string{
+				"__name__": "instance:up",
+				"instance": "c",
+				"team":     "storage",
+			},
+			value: 3,
+		},
+	}
+	for i, write := range writes {
+		assert.Equal(t, newTestTags(expected[i].tags).Tags, write.Tags.Tags)
+		require.Equal(t, 1, len(write.Datapoints))
+		assert.Equal(t, now, write.Datapoints[0].Timestamp)
+		assert.Equal(t, expected[i].value, write.Datapoints[0].Value)
+	}
+}
+
+func TestGroupEvaluateDuplicateSeries(t *testing.T) {
+	queryFn := func(_ context.Context, _ string, _ time.Time) ([]Sample, error) {
+		return []Sample{
+			{Tags: newTestTags(map[string]string{"team": "a"}), Value: 1},
+			{Tags: newTestTags(map[string]string{"team": "b"}), Value: 2},
+		}, nil
+	}
+
+	store := mock.NewMockStorage()
+	g := newGroup(RuleGroup{
+		Name: "test",
+		Rules: []Rule{
+			{
+				Record: "team:count",
+				Expr:   "count by (team) (up)",
+				Labels: map[string]string{"team": "storage"},
+			},
+		},
+	}, newTestGroupOptions(store, queryFn))
+
+	g.evaluate(context.Background(), time.Now())
+	assert.Equal(t, 0, len(store.Writes()))
+}
+
+func TestGroupInterval(t *testing.T) {
+	opts := newTestGroupOptions(mock.NewMockStorage(), nil).
+		SetEvaluationInterval(time.Minute)
+
+	g := newGroup(RuleGroup{Name: "test"}, opts)
+	assert.Equal(t, time.Minute, g.interval)
+
+	g = newGroup(RuleGroup{
+		Name:     "test",
+		Interval: model.Duration(10 * time.Second),
+	}, opts)
+	assert.Equal(t, 10*time.Second, g.interval)
+}
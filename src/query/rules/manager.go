@@ -0,0 +1,179 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/kv/util"
+
+	"go.uber.org/zap"
+)
+
+var (
+	errManagerAlreadyStarted = errors.New("rules manager already started")
+	errManagerClosed         = errors.New("rules manager is closed")
+)
+
+type manager struct {
+	sync.Mutex
+
+	opts    Options
+	logger  *zap.Logger
+	dynamic []RuleGroup
+	groups  map[string]*group
+	watch   kv.ValueWatch
+	started bool
+	closed  bool
+}
+
+// NewManager returns a new rules manager, if a KV store is set the manager
+// watches it for rule groups which are evaluated along with the configured
+// rule groups and replace any configured rule group of the same name.
+func NewManager(opts Options) (Manager, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &manager{
+		opts:   opts,
+		logger: opts.InstrumentOptions().Logger(),
+		groups: make(map[string]*group),
+	}, nil
+}
+
+func (m *manager) Start() error {
+	m.Lock()
+	if m.closed {
+		m.Unlock()
+		return errManagerClosed
+	}
+	if m.started {
+		m.Unlock()
+		return errManagerAlreadyStarted
+	}
+	m.started = true
+	m.updateGroupsWithLock()
+	m.Unlock()
+
+	store := m.opts.KVStore()
+	if store == nil {
+		return nil
+	}
+
+	watchOpts := util.NewOptions().
+		SetLogger(m.logger).
+		SetValidateFn(func(v interface{}) error {
+			if _, ok := v.([]RuleGroup); !ok {
+				return fmt.Errorf("unexpected rule groups type: %T", v)
+			}
+			return nil
+		})
+	watch, err := util.WatchAndUpdateGeneric(store, m.opts.KVKey(),
+		getRuleGroups, m.updateDynamicGroups, nil, []RuleGroup(nil), watchOpts)
+	if err != nil {
+		return fmt.Errorf("unable to watch key '%s': %v", m.opts.KVKey(), err)
+	}
+
+	m.Lock()
+	m.watch = watch
+	m.Unlock()
+	return nil
+}
+
+func getRuleGroups(v kv.Value) (interface{}, error) {
+	var stringProto commonpb.StringProto
+	if err := v.Unmarshal(&stringProto); err != nil {
+		return nil, err
+	}
+	return ParseRuleGroups([]byte(stringProto.Value))
+}
+
+func (m *manager) updateDynamicGroups(v interface{}) {
+	groups, _ := v.([]RuleGroup)
+
+	m.Lock()
+	defer m.Unlock()
+	if m.closed {
+		return
+	}
+
+	m.dynamic = groups
+	m.updateGroupsWithLock()
+}
+
+// updateGroupsWithLock starts evaluating new and changed rule groups and
+// stops evaluating removed and changed rule groups.
+func (m *manager) updateGroupsWithLock() {
+	desired := make(map[string]RuleGroup, len(m.opts.Groups())+len(m.dynamic))
+	for _, def := range m.opts.Groups() {
+		desired[def.Name] = def
+	}
+	for _, def := range m.dynamic {
+		desired[def.Name] = def
+	}
+
+	for name, g := range m.groups {
+		if def, ok := desired[name]; ok && reflect.DeepEqual(def, g.def) {
+			continue
+		}
+		g.close()
+		delete(m.groups, name)
+		m.logger.Info("stopped evaluating rule group", zap.String("group", name))
+	}
+
+	for name, def := range desired {
+		if _, ok := m.groups[name]; ok {
+			continue
+		}
+		g := newGroup(def, m.opts)
+		g.start()
+		m.groups[name] = g
+		m.logger.Info("started evaluating rule group",
+			zap.String("group", name), zap.Duration("interval", g.interval))
+	}
+}
+
+func (m *manager) Close() error {
+	m.Lock()
+	if m.closed {
+		m.Unlock()
+		return errManagerClosed
+	}
+	m.closed = true
+	watch := m.watch
+	groups := m.groups
+	m.groups = nil
+	m.Unlock()
+
+	if watch != nil {
+		watch.Close()
+	}
+	for _, g := range groups {
+		g.close()
+	}
+	return nil
+}
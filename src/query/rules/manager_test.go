@@ -0,0 +1,184 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/cluster/services/leader/campaign"
+	"github.com/m3db/m3/src/query/storage/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRuleGroups = []RuleGroup{
+	{
+		Name:  "a",
+		Rules: []Rule{{Record: "a", Expr: "a"}},
+	},
+	{
+		Name:  "b",
+		Rules: []Rule{{Record: "b", Expr: "b"}},
+	},
+}
+
+func noopQueryFunc(_ context.Context, _ string, _ time.Time) ([]Sample, error) {
+	return nil, nil
+}
+
+func groupNames(m *manager) []string {
+	m.Lock()
+	defer m.Unlock()
+	var names []string
+	for name := range m.groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func groupDef(m *manager, name string) RuleGroup {
+	m.Lock()
+	defer m.Unlock()
+	return m.groups[name].def
+}
+
+func TestManagerStaticAndKVGroups(t *testing.T) {
+	store := mem.NewStore()
+	opts := NewOptions().
+		SetGroups(testRuleGroups).
+		SetQueryFunc(noopQueryFunc).
+		SetStorage(mock.NewMockStorage()).
+		SetEvaluationInterval(time.Hour).
+		SetKVStore(store)
+
+	mgr, err := NewManager(opts)
+	require.NoError(t, err)
+	require.NoError(t, mgr.Start())
+	m := mgr.(*manager)
+
+	assert.Equal(t, []string{"a", "b"}, groupNames(m))
+
+	// Rule groups in KV replace configured rule groups with the same name.
+	_, err = store.Set(DefaultKVKey, &commonpb.StringProto{Value: `
+groups:
+  - name: b
+    rules:
+      - record: b
+        expr: sum(b)
+  - name: c
+    rules:
+      - record: c
+        expr: c
+`})
+	require.NoError(t, err)
+
+	for {
+		if names := groupNames(m); len(names) == 3 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, groupNames(m))
+	assert.Equal(t, "sum(b)", groupDef(m, "b").Rules[0].Expr)
+
+	// Deleting the key reverts to the configured rule groups.
+	_, err = store.Delete(DefaultKVKey)
+	require.NoError(t, err)
+
+	for {
+		if names := groupNames(m); len(names) == 2 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, []string{"a", "b"}, groupNames(m))
+	assert.Equal(t, "b", groupDef(m, "b").Rules[0].Expr)
+
+	require.NoError(t, mgr.Close())
+	assert.Equal(t, errManagerClosed, mgr.Close())
+}
+
+func TestManagerLeaderElection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	campaignOpts, err := services.NewCampaignOptions()
+	require.NoError(t, err)
+
+	statusCh := make(chan campaign.Status, 2)
+	leaderService := services.NewMockLeaderService(ctrl)
+	leaderService.EXPECT().
+		Campaign("rules/a", campaignOpts).
+		Return((<-chan campaign.Status)(statusCh), nil)
+	leaderService.EXPECT().
+		Resign("rules/a").
+		DoAndReturn(func(_ string) error {
+			close(statusCh)
+			return nil
+		})
+
+	evaluated := make(chan struct{}, 1)
+	queryFn := func(_ context.Context, _ string, _ time.Time) ([]Sample, error) {
+		select {
+		case evaluated <- struct{}{}:
+		default:
+		}
+		return nil, nil
+	}
+
+	opts := NewOptions().
+		SetGroups(testRuleGroups[:1]).
+		SetQueryFunc(queryFn).
+		SetStorage(mock.NewMockStorage()).
+		SetEvaluationInterval(10 * time.Millisecond).
+		SetLeaderService(leaderService).
+		SetCampaignOptions(campaignOpts)
+
+	mgr, err := NewManager(opts)
+	require.NoError(t, err)
+	require.NoError(t, mgr.Start())
+
+	// Followers do not evaluate the group.
+	statusCh <- campaign.NewStatus(campaign.Follower)
+	select {
+	case <-evaluated:
+		require.FailNow(t, "group evaluated by follower")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	statusCh <- campaign.NewStatus(campaign.Leader)
+	select {
+	case <-evaluated:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "group not evaluated by leader")
+	}
+
+	require.NoError(t, mgr.Close())
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	xerrors "github.com/m3db/m3/src/x/errors"
+)
+
+const (
+	alertmanagerAlertsPath = "/api/v2/alerts"
+)
+
+type alertmanagerNotifier struct {
+	urls   []string
+	client *http.Client
+}
+
+// NewAlertmanagerNotifier returns a notifier which sends alerts to each of
+// the given Alertmanager URLs using the Alertmanager v2 API.
+func NewAlertmanagerNotifier(urls []string, timeout time.Duration) Notifier {
+	endpoints := make([]string, 0, len(urls))
+	for _, u := range urls {
+		endpoints = append(endpoints, strings.TrimSuffix(u, "/")+alertmanagerAlertsPath)
+	}
+	return &alertmanagerNotifier{
+		urls:   endpoints,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (n *alertmanagerNotifier) Send(ctx context.Context, alerts []Alert) error {
+	body, err := json.Marshal(alerts)
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, u := range n.urls {
+		if err := n.send(ctx, u, body); err != nil {
+			multiErr = multiErr.Add(fmt.Errorf("could not send alerts to %s: %v", u, err))
+		}
+	}
+	return multiErr.FinalError()
+}
+
+func (n *alertmanagerNotifier) send(ctx context.Context, endpoint string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so that the connection can be reused.
+	_, drainErr := io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	if drainErr != nil {
+		return fmt.Errorf("could not read response body: %v", drainErr)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertmanagerNotifier(t *testing.T) {
+	var (
+		lock     sync.Mutex
+		received []Alert
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v2/alerts", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var alerts []Alert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alerts))
+		lock.Lock()
+		received = append(received, alerts...)
+		lock.Unlock()
+	}))
+	defer server.Close()
+
+	now := time.Unix(1565000000, 0).UTC()
+	alerts := []Alert{
+		{
+			Labels:      map[string]string{"alertname": "InstanceDown", "instance": "a"},
+			Annotations: map[string]string{"summary": "a is down"},
+			StartsAt:    now,
+			EndsAt:      now.Add(time.Minute),
+		},
+	}
+
+	notifier := NewAlertmanagerNotifier([]string{server.URL + "/"}, time.Second)
+	require.NoError(t, notifier.Send(context.Background(), alerts))
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, alerts, received)
+}
+
+func TestAlertmanagerNotifierError(t *testing.T) {
+	var (
+		lock  sync.Mutex
+		calls int
+	)
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		calls++
+		lock.Unlock()
+	}))
+	defer ok.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	// Alerts are still sent to the other Alertmanagers if one fails.
+	notifier := NewAlertmanagerNotifier([]string{failing.URL, ok.URL}, time.Second)
+	err := notifier.Send(context.Background(), []Alert{{
+		Labels: map[string]string{"alertname": "InstanceDown"},
+	}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), failing.URL)
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, 1, calls)
+}
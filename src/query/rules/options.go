@@ -0,0 +1,285 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"errors"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	// DefaultKVKey is the default KV key watched for rule groups.
+	DefaultKVKey = "m3coordinator.rules"
+
+	// DefaultElectionKeyFmt is the default format of the election ID of a
+	// rule group.
+	DefaultElectionKeyFmt = "rules/%s"
+
+	// DefaultAlertStateKeyFmt is the default format of the KV key the state
+	// of the alerts of a rule group is persisted to.
+	DefaultAlertStateKeyFmt = "m3coordinator.rules.alerts.%s"
+
+	defaultEvaluationInterval = time.Minute
+	defaultResendDelay        = time.Minute
+)
+
+var (
+	errNoQueryFunc               = errors.New("no query func set")
+	errNoStorage                 = errors.New("no storage set")
+	errNoTagOptions              = errors.New("no tag options set")
+	errInvalidEvaluationInterval = errors.New("evaluation interval must be positive")
+	errInvalidResendDelay        = errors.New("resend delay must be positive")
+	errNoKVKey                   = errors.New("no KV key set for rule groups")
+	errNoAlertStateKeyFmt        = errors.New("no KV key format set for alert state")
+	errNoCampaignOptions         = errors.New("no campaign options set for leader election")
+	errNoElectionKeyFmt          = errors.New("no election key format set for leader election")
+)
+
+type options struct {
+	groups             []RuleGroup
+	queryFn            QueryFunc
+	storage            storage.Storage
+	tagOptions         models.TagOptions
+	evaluationInterval time.Duration
+	notifier           Notifier
+	resendDelay        time.Duration
+	externalURL        string
+	kvStore            kv.Store
+	kvKey              string
+	alertStateKeyFmt   string
+	leaderService      services.LeaderService
+	campaignOpts       services.CampaignOptions
+	electionKeyFmt     string
+	clockOpts          clock.Options
+	instrumentOpts     instrument.Options
+}
+
+// NewOptions returns new rules manager options.
+func NewOptions() Options {
+	return &options{
+		tagOptions:         models.NewTagOptions(),
+		evaluationInterval: defaultEvaluationInterval,
+		resendDelay:        defaultResendDelay,
+		kvKey:              DefaultKVKey,
+		alertStateKeyFmt:   DefaultAlertStateKeyFmt,
+		electionKeyFmt:     DefaultElectionKeyFmt,
+		clockOpts:          clock.NewOptions(),
+		instrumentOpts:     instrument.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.queryFn == nil {
+		return errNoQueryFunc
+	}
+	if o.storage == nil {
+		return errNoStorage
+	}
+	if o.tagOptions == nil {
+		return errNoTagOptions
+	}
+	if o.evaluationInterval <= 0 {
+		return errInvalidEvaluationInterval
+	}
+	if o.resendDelay <= 0 {
+		return errInvalidResendDelay
+	}
+	if o.kvStore != nil && o.kvKey == "" {
+		return errNoKVKey
+	}
+	if o.kvStore != nil && o.alertStateKeyFmt == "" {
+		return errNoAlertStateKeyFmt
+	}
+	if o.leaderService != nil && o.campaignOpts == nil {
+		return errNoCampaignOptions
+	}
+	if o.leaderService != nil && o.electionKeyFmt == "" {
+		return errNoElectionKeyFmt
+	}
+	return validateRuleGroups(o.groups)
+}
+
+func (o *options) SetGroups(value []RuleGroup) Options {
+	opts := *o
+	opts.groups = value
+	return &opts
+}
+
+func (o *options) Groups() []RuleGroup {
+	return o.groups
+}
+
+func (o *options) SetQueryFunc(value QueryFunc) Options {
+	opts := *o
+	opts.queryFn = value
+	return &opts
+}
+
+func (o *options) QueryFunc() QueryFunc {
+	return o.queryFn
+}
+
+func (o *options) SetStorage(value storage.Storage) Options {
+	opts := *o
+	opts.storage = value
+	return &opts
+}
+
+func (o *options) Storage() storage.Storage {
+	return o.storage
+}
+
+func (o *options) SetTagOptions(value models.TagOptions) Options {
+	opts := *o
+	opts.tagOptions = value
+	return &opts
+}
+
+func (o *options) TagOptions() models.TagOptions {
+	return o.tagOptions
+}
+
+func (o *options) SetEvaluationInterval(value time.Duration) Options {
+	opts := *o
+	opts.evaluationInterval = value
+	return &opts
+}
+
+func (o *options) EvaluationInterval() time.Duration {
+	return o.evaluationInterval
+}
+
+func (o *options) SetNotifier(value Notifier) Options {
+	opts := *o
+	opts.notifier = value
+	return &opts
+}
+
+func (o *options) Notifier() Notifier {
+	return o.notifier
+}
+
+func (o *options) SetResendDelay(value time.Duration) Options {
+	opts := *o
+	opts.resendDelay = value
+	return &opts
+}
+
+func (o *options) ResendDelay() time.Duration {
+	return o.resendDelay
+}
+
+func (o *options) SetExternalURL(value string) Options {
+	opts := *o
+	opts.externalURL = value
+	return &opts
+}
+
+func (o *options) ExternalURL() string {
+	return o.externalURL
+}
+
+func (o *options) SetKVStore(value kv.Store) Options {
+	opts := *o
+	opts.kvStore = value
+	return &opts
+}
+
+func (o *options) KVStore() kv.Store {
+	return o.kvStore
+}
+
+func (o *options) SetKVKey(value string) Options {
+	opts := *o
+	opts.kvKey = value
+	return &opts
+}
+
+func (o *options) KVKey() string {
+	return o.kvKey
+}
+
+func (o *options) SetAlertStateKeyFmt(value string) Options {
+	opts := *o
+	opts.alertStateKeyFmt = value
+	return &opts
+}
+
+func (o *options) AlertStateKeyFmt() string {
+	return o.alertStateKeyFmt
+}
+
+func (o *options) SetLeaderService(value services.LeaderService) Options {
+	opts := *o
+	opts.leaderService = value
+	return &opts
+}
+
+func (o *options) LeaderService() services.LeaderService {
+	return o.leaderService
+}
+
+func (o *options) SetCampaignOptions(value services.CampaignOptions) Options {
+	opts := *o
+	opts.campaignOpts = value
+	return &opts
+}
+
+func (o *options) CampaignOptions() services.CampaignOptions {
+	return o.campaignOpts
+}
+
+func (o *options) SetElectionKeyFmt(value string) Options {
+	opts := *o
+	opts.electionKeyFmt = value
+	return &opts
+}
+
+func (o *options) ElectionKeyFmt() string {
+	return o.electionKeyFmt
+}
+
+func (o *options) SetClockOptions(value clock.Options) Options {
+	opts := *o
+	opts.clockOpts = value
+	return &opts
+}
+
+func (o *options) ClockOptions() clock.Options {
+	return o.clockOpts
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
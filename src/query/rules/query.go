@@ -0,0 +1,129 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+)
+
+// NewEngineQueryFunc returns a query func which evaluates queries with the
+// query engine, queries are cancelled if not evaluated within the timeout.
+func NewEngineQueryFunc(
+	engine *executor.Engine,
+	tagOpts models.TagOptions,
+	timeout time.Duration,
+) QueryFunc {
+	return func(ctx context.Context, query string, t time.Time) ([]Sample, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		parser, err := promql.Parse(query, tagOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		params := models.RequestParams{
+			Start:      t,
+			End:        t,
+			Now:        t,
+			Timeout:    timeout,
+			Step:       time.Second,
+			Query:      query,
+			IncludeEnd: true,
+		}
+
+		// Results is closed by execute
+		results := make(chan executor.Query)
+		go engine.ExecuteExpr(ctx, parser, &executor.EngineOptions{}, params, results)
+
+		var (
+			samples    []Sample
+			processErr error
+		)
+		for result := range results {
+			if result.Err != nil {
+				processErr = result.Err
+				continue
+			}
+
+			for blkResult := range result.Result.ResultChan() {
+				if blkResult.Err != nil {
+					processErr = blkResult.Err
+				}
+				if blkResult.Block == nil {
+					continue
+				}
+				if processErr == nil {
+					samples, processErr = appendBlockSamples(samples, blkResult.Block)
+				}
+				blkResult.Block.Close()
+			}
+		}
+
+		if processErr != nil {
+			return nil, processErr
+		}
+		return samples, nil
+	}
+}
+
+// appendBlockSamples appends the last value of each series of the block
+// to the samples, series without a value at the evaluation time are skipped.
+func appendBlockSamples(samples []Sample, b block.Block) ([]Sample, error) {
+	iter, err := b.SeriesIter()
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	commonTags := iter.Meta().Tags
+	for iter.Next() {
+		series := iter.Current()
+		if series.Len() == 0 {
+			continue
+		}
+
+		value := series.ValueAtStep(series.Len() - 1)
+		if math.IsNaN(value) {
+			continue
+		}
+
+		tags := models.NewTags(commonTags.Len()+series.Meta.Tags.Len(), commonTags.Opts).
+			AddTags(commonTags.Tags).
+			AddTags(series.Meta.Tags.Tags)
+		samples = append(samples, Sample{
+			Tags:  tags,
+			Value: value,
+		})
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
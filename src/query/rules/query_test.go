@@ -0,0 +1,70 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendBlockSamples(t *testing.T) {
+	bounds := models.Bounds{
+		Start:    time.Unix(1565000000, 0),
+		Duration: time.Second,
+		StepSize: time.Second,
+	}
+	meta := block.Metadata{
+		Bounds: bounds,
+		Tags:   newTestTags(map[string]string{"job": "a"}),
+	}
+	seriesMeta := []block.SeriesMeta{
+		{Tags: newTestTags(map[string]string{"instance": "b"})},
+		{Tags: newTestTags(map[string]string{"instance": "c"})},
+		{Tags: newTestTags(map[string]string{"instance": "d"})},
+	}
+	b := test.NewBlockFromValuesWithMetaAndSeriesMeta(meta, seriesMeta,
+		[][]float64{{1}, {math.NaN()}, {3}})
+
+	existing := []Sample{{Tags: newTestTags(map[string]string{"job": "e"}), Value: 0}}
+	samples, err := appendBlockSamples(existing, b)
+	require.NoError(t, err)
+
+	// Series without a value are skipped and the common tags of the block
+	// are added to each series.
+	expected := []Sample{
+		existing[0],
+		{Tags: newTestTags(map[string]string{"job": "a", "instance": "b"}), Value: 1},
+		{Tags: newTestTags(map[string]string{"job": "a", "instance": "d"}), Value: 3},
+	}
+	require.Equal(t, len(expected), len(samples))
+	for i, sample := range samples {
+		assert.Equal(t, expected[i].Tags.Tags, sample.Tags.Tags)
+		assert.Equal(t, expected[i].Value, sample.Value)
+	}
+}
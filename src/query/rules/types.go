@@ -0,0 +1,234 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package rules evaluates Prometheus recording and alerting rules against
+// the query engine on their interval, writing the results of recording rules
+// back to storage and sending alerts to Alertmanager, so that expensive
+// queries can be precomputed and alerts evaluated without an external
+// Prometheus.
+package rules
+
+import (
+	"context"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/prometheus/common/model"
+)
+
+// RuleGroups is a set of rule groups in the Prometheus rule file format.
+type RuleGroups struct {
+	Groups []RuleGroup `yaml:"groups"`
+}
+
+// RuleGroup is a group of rules evaluated sequentially on the same interval,
+// so that a rule can use the results of the rules before it.
+type RuleGroup struct {
+	// Name is the name of the group, it must be unique.
+	Name string `yaml:"name"`
+
+	// Interval is the evaluation interval of the group, if not set the
+	// default evaluation interval is used.
+	Interval model.Duration `yaml:"interval,omitempty"`
+
+	// Rules are the rules of the group.
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule is a recording rule if Record is set or an alerting rule if Alert
+// is set.
+type Rule struct {
+	// Record is the name of the series the results of the rule are
+	// written as.
+	Record string `yaml:"record,omitempty"`
+
+	// Alert is the name of the alert raised for each series of the results
+	// of the rule.
+	Alert string `yaml:"alert,omitempty"`
+
+	// Expr is the PromQL expression of the rule.
+	Expr string `yaml:"expr"`
+
+	// For is how long a series must be returned by the expression of an
+	// alerting rule before its alert fires, the alert is pending until then.
+	For model.Duration `yaml:"for,omitempty"`
+
+	// Labels are added to or replace the labels of the results, the labels
+	// of alerting rules are templates expanded with $labels and $value.
+	Labels map[string]string `yaml:"labels,omitempty"`
+
+	// Annotations are the annotations of the alerts of an alerting rule,
+	// templates expanded with $labels and $value.
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// Alert is an alert sent to Alertmanager.
+type Alert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+// Notifier sends alerts to Alertmanager.
+type Notifier interface {
+	// Send sends the alerts.
+	Send(ctx context.Context, alerts []Alert) error
+}
+
+// Sample is the value of a single series of a query result.
+type Sample struct {
+	Tags  models.Tags
+	Value float64
+}
+
+// QueryFunc evaluates a PromQL query at a single instant.
+type QueryFunc func(ctx context.Context, query string, t time.Time) ([]Sample, error)
+
+// Manager evaluates rule groups on their interval.
+type Manager interface {
+	// Start starts evaluating the configured rule groups and any rule groups
+	// set in KV.
+	Start() error
+
+	// Close stops evaluating all rule groups.
+	Close() error
+}
+
+// Options are the options for the rules manager.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetGroups sets the statically configured rule groups.
+	SetGroups(value []RuleGroup) Options
+
+	// Groups returns the statically configured rule groups.
+	Groups() []RuleGroup
+
+	// SetQueryFunc sets the function used to evaluate rule expressions.
+	SetQueryFunc(value QueryFunc) Options
+
+	// QueryFunc returns the function used to evaluate rule expressions.
+	QueryFunc() QueryFunc
+
+	// SetStorage sets the storage the results of rules are written to.
+	SetStorage(value storage.Storage) Options
+
+	// Storage returns the storage the results of rules are written to.
+	Storage() storage.Storage
+
+	// SetTagOptions sets the tag options of written series.
+	SetTagOptions(value models.TagOptions) Options
+
+	// TagOptions returns the tag options of written series.
+	TagOptions() models.TagOptions
+
+	// SetEvaluationInterval sets the evaluation interval of groups without
+	// an interval of their own.
+	SetEvaluationInterval(value time.Duration) Options
+
+	// EvaluationInterval returns the evaluation interval of groups without
+	// an interval of their own.
+	EvaluationInterval() time.Duration
+
+	// SetNotifier sets the notifier alerts are sent with, alerts are not
+	// sent if not set.
+	SetNotifier(value Notifier) Options
+
+	// Notifier returns the notifier alerts are sent with.
+	Notifier() Notifier
+
+	// SetResendDelay sets how long to wait before resending a firing alert.
+	SetResendDelay(value time.Duration) Options
+
+	// ResendDelay returns how long to wait before resending a firing alert.
+	ResendDelay() time.Duration
+
+	// SetExternalURL sets the URL the generator URLs of alerts link to.
+	SetExternalURL(value string) Options
+
+	// ExternalURL returns the URL the generator URLs of alerts link to.
+	ExternalURL() string
+
+	// SetKVStore sets the KV store watched for rule groups and which the
+	// state of alerts is persisted to, rule groups are only statically
+	// configured and the state of alerts is lost on failover if not set.
+	SetKVStore(value kv.Store) Options
+
+	// KVStore returns the KV store watched for rule groups.
+	KVStore() kv.Store
+
+	// SetKVKey sets the KV key watched for rule groups.
+	SetKVKey(value string) Options
+
+	// KVKey returns the KV key watched for rule groups.
+	KVKey() string
+
+	// SetAlertStateKeyFmt sets the format of the KV key the state of the
+	// alerts of a rule group is persisted to, formatted with the name of
+	// the group.
+	SetAlertStateKeyFmt(value string) Options
+
+	// AlertStateKeyFmt returns the format of the KV key the state of the
+	// alerts of a rule group is persisted to.
+	AlertStateKeyFmt() string
+
+	// SetLeaderService sets the leader service used to elect the coordinator
+	// which evaluates each rule group, every group is evaluated locally if
+	// not set.
+	SetLeaderService(value services.LeaderService) Options
+
+	// LeaderService returns the leader service used to elect the coordinator
+	// which evaluates each rule group.
+	LeaderService() services.LeaderService
+
+	// SetCampaignOptions sets the options used to campaign for leadership.
+	SetCampaignOptions(value services.CampaignOptions) Options
+
+	// CampaignOptions returns the options used to campaign for leadership.
+	CampaignOptions() services.CampaignOptions
+
+	// SetElectionKeyFmt sets the format of the election ID of a rule group,
+	// formatted with the name of the group.
+	SetElectionKeyFmt(value string) Options
+
+	// ElectionKeyFmt returns the format of the election ID of a rule group.
+	ElectionKeyFmt() string
+
+	// SetClockOptions sets the clock options.
+	SetClockOptions(value clock.Options) Options
+
+	// ClockOptions returns the clock options.
+	ClockOptions() clock.Options
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+}
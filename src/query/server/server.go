@@ -34,9 +34,11 @@ import (
 
 	clusterclient "github.com/m3db/m3/src/cluster/client"
 	etcdclient "github.com/m3db/m3/src/cluster/client/etcd"
+	"github.com/m3db/m3/src/cluster/kv"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/downsample"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
 	ingestcarbon "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/carbon"
+	ingestscrape "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/scrape"
 	dbconfig "github.com/m3db/m3/src/cmd/services/m3dbnode/config"
 	"github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/dbnode/client"
@@ -48,6 +50,8 @@ import (
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/policy/filter"
 	"github.com/m3db/m3/src/query/pools"
+	"github.com/m3db/m3/src/query/quota"
+	"github.com/m3db/m3/src/query/rules"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/fanout"
 	"github.com/m3db/m3/src/query/storage/m3"
@@ -73,6 +77,10 @@ import (
 
 const (
 	serviceName = "m3query"
+
+	// defaultRemoteName is the name of the storage of the remote listen
+	// addresses that are not part of a named remote zone.
+	defaultRemoteName = "remote"
 )
 
 var (
@@ -88,6 +96,8 @@ var (
 
 	defaultDownsamplerAndWriterWorkerPoolSize = 1024
 	defaultCarbonIngesterWorkerPoolSize       = 1024
+
+	errInvalidRemoteZone = errors.New("remote zones require a name and remote listen addresses")
 )
 
 type cleanupFn func() error
@@ -175,10 +185,12 @@ func Run(runOpts RunOptions) {
 
 	defer buildReporter.Stop()
 	var (
-		backendStorage storage.Storage
-		clusterClient  clusterclient.Client
-		downsampler    downsample.Downsampler
-		enabled        bool
+		backendStorage     storage.Storage
+		querier            m3.Querier
+		clusterClient      clusterclient.Client
+		downsampler        downsample.Downsampler
+		ingestRulesApplier downsample.IngestRulesApplier
+		enabled            bool
 	)
 
 	readWorkerPool, writeWorkerPool, err := pools.BuildWorkerPools(
@@ -232,7 +244,7 @@ func Run(runOpts RunOptions) {
 		}
 
 		var cleanup cleanupFn
-		backendStorage, clusterClient, downsampler, cleanup, err = newM3DBStorage(
+		backendStorage, querier, clusterClient, downsampler, ingestRulesApplier, cleanup, err = newM3DBStorage(
 			runOpts, cfg, tagOptions, m3dbClusters, m3dbPoolWrapper,
 			readWorkerPool, writeWorkerPool, instrumentOptions)
 		if err != nil {
@@ -246,15 +258,55 @@ func Run(runOpts RunOptions) {
 		logger.Fatal("unable to setup perQueryEnforcer", zap.Error(err))
 	}
 
-	engine := executor.NewEngine(backendStorage, scope.SubScope("engine"), *cfg.LookbackDuration, perQueryEnforcer)
+	quotaEnforcer, err := newQuotaEnforcer(cfg, clusterClient, instrumentOptions)
+	if err != nil {
+		logger.Fatal("unable to setup quota enforcer", zap.Error(err))
+	}
+
+	// NB: queries through the engine and writes through the downsampler and
+	// writer, which include carbon ingestion and scraping, count towards
+	// tenant quotas. Aggregated writes from the downsampler, writes from the
+	// m3msg ingester and rule writes go to the backend storage directly and
+	// do not.
+	queryStorage := backendStorage
+	if quotaEnforcer != nil {
+		defer quotaEnforcer.Close()
+		queryStorage = quota.NewStorage(backendStorage, quotaEnforcer)
+		// Compressed fetches bypass the quota storage, so fall back to the
+		// decoded fetch path to keep enforcing the fetched series limits.
+		querier = nil
+	}
+
+	engine := executor.NewEngine(queryStorage, scope.SubScope("engine"), *cfg.LookbackDuration, perQueryEnforcer)
+
+	rulesManager, err := cfg.Rules.NewManager(engine, backendStorage,
+		clusterClient, tagOptions, instrumentOptions)
+	if err != nil {
+		logger.Fatal("unable to setup rules manager", zap.Error(err))
+	}
+	if rulesManager != nil {
+		if err := rulesManager.Start(); err != nil {
+			logger.Fatal("unable to start rules manager", zap.Error(err))
+		}
+		defer rulesManager.Close()
+	}
 
 	downsamplerAndWriter, err := newDownsamplerAndWriter(backendStorage, downsampler)
 	if err != nil {
 		logger.Fatal("unable to create new downsampler and writer", zap.Error(err))
 	}
+	if quotaEnforcer != nil {
+		downsamplerAndWriter = quota.NewDownsamplerAndWriter(downsamplerAndWriter, quotaEnforcer)
+	}
+	if ingestRulesApplier != nil {
+		// NB: ingest rules are applied before quotas are enforced so that
+		// quotas count the series that are actually written.
+		downsamplerAndWriter = ingest.NewIngestRulesDownsamplerAndWriter(
+			downsamplerAndWriter, ingestRulesApplier)
+	}
 
 	handler, err := httpd.NewHandler(downsamplerAndWriter, tagOptions, engine,
-		m3dbClusters, clusterClient, cfg, runOpts.DBConfig, perQueryEnforcer, scope)
+		querier, m3dbClusters, clusterClient, cfg, runOpts.DBConfig, perQueryEnforcer, scope)
 	if err != nil {
 		logger.Fatal("unable to set up handlers", zap.Error(err))
 	}
@@ -316,6 +368,12 @@ func Run(runOpts RunOptions) {
 			cfg.Carbon, instrumentOptions, logger, m3dbClusters, downsamplerAndWriter)
 	}
 
+	if cfg.Scrape != nil {
+		scraper := startScrapeIngestion(
+			cfg.Scrape, tagOptions, instrumentOptions, logger, downsamplerAndWriter)
+		defer scraper.Close()
+	}
+
 	var interruptCh <-chan error = make(chan error)
 	if runOpts.InterruptCh != nil {
 		interruptCh = runOpts.InterruptCh
@@ -349,7 +407,7 @@ func newM3DBStorage(
 	readWorkerPool xsync.PooledWorkerPool,
 	writeWorkerPool xsync.PooledWorkerPool,
 	instrumentOptions instrument.Options,
-) (storage.Storage, clusterclient.Client, downsample.Downsampler, cleanupFn, error) {
+) (storage.Storage, m3.Querier, clusterclient.Client, downsample.Downsampler, downsample.IngestRulesApplier, cleanupFn, error) {
 	var (
 		logger              = instrumentOptions.Logger()
 		clusterClient       clusterclient.Client
@@ -359,10 +417,19 @@ func newM3DBStorage(
 		// Only use a cluster client if we are going to receive one, that
 		// way passing nil to httpd NewHandler disables the endpoints entirely
 		clusterClientDoneCh := make(chan struct{}, 1)
-		clusterClientWaitCh = clusterClientDoneCh
 		clusterClient = m3dbcluster.NewAsyncClient(func() (clusterclient.Client, error) {
 			return <-clusterClientCh, nil
 		}, clusterClientDoneCh)
+
+		// Both the downsampler and the ingest rules applier may need to wait
+		// for the cluster client, so close the wait channel once it's done
+		// rather than waiting on the done channel directly.
+		clusterClientReadyCh := make(chan struct{})
+		clusterClientWaitCh = clusterClientReadyCh
+		go func() {
+			<-clusterClientDoneCh
+			close(clusterClientReadyCh)
+		}()
 	} else {
 		var etcdCfg *etcdclient.Configuration
 		switch {
@@ -383,15 +450,15 @@ func newM3DBStorage(
 			)
 			clusterClient, err = etcdclient.NewConfigServiceClient(clusterSvcClientOpts)
 			if err != nil {
-				return nil, nil, nil, nil, errors.Wrap(err, "unable to create cluster management etcd client")
+				return nil, nil, nil, nil, nil, nil, errors.Wrap(err, "unable to create cluster management etcd client")
 			}
 		}
 	}
 
-	fanoutStorage, storageCleanup, err := newStorages(clusters, cfg, tagOptions,
+	fanoutStorage, querier, storageCleanup, err := newStorages(clusters, cfg, tagOptions,
 		poolWrapper, readWorkerPool, writeWorkerPool, instrumentOptions)
 	if err != nil {
-		return nil, nil, nil, nil, errors.Wrap(err, "unable to set up storages")
+		return nil, nil, nil, nil, nil, nil, errors.Wrap(err, "unable to set up storages")
 	}
 
 	var (
@@ -403,7 +470,7 @@ func newM3DBStorage(
 			zap.Int("numAggregatedClusterNamespaces", n))
 		autoMappingRules, err := newDownsamplerAutoMappingRules(namespaces)
 		if err != nil {
-			return nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, nil, err
 		}
 
 		newDownsamplerFn := func() (downsample.Downsampler, error) {
@@ -423,7 +490,30 @@ func newM3DBStorage(
 			// Otherwise we already have a client and can immediately construct the downsampler
 			downsampler, err = newDownsamplerFn()
 			if err != nil {
-				return nil, nil, nil, nil, err
+				return nil, nil, nil, nil, nil, nil, err
+			}
+		}
+	}
+
+	var ingestRulesApplier downsample.IngestRulesApplier
+	if cfg.IngestRules.Enabled {
+		logger.Info("configuring ingest rules applier")
+		newIngestRulesApplierFn := func() (downsample.IngestRulesApplier, error) {
+			return newIngestRulesApplier(cfg.IngestRules, clusterClient,
+				tagOptions, instrumentOptions)
+		}
+
+		if clusterClientWaitCh != nil {
+			// Same as the downsampler, wait for the cluster client before
+			// constructing the ingest rules applier.
+			ingestRulesApplier = downsample.NewAsyncIngestRulesApplier(func() (downsample.IngestRulesApplier, error) {
+				<-clusterClientWaitCh
+				return newIngestRulesApplierFn()
+			})
+		} else {
+			ingestRulesApplier, err = newIngestRulesApplierFn()
+			if err != nil {
+				return nil, nil, nil, nil, nil, nil, err
 			}
 		}
 	}
@@ -444,7 +534,7 @@ func newM3DBStorage(
 		return lastErr
 	}
 
-	return fanoutStorage, clusterClient, downsampler, cleanup, nil
+	return fanoutStorage, querier, clusterClient, downsampler, ingestRulesApplier, cleanup, nil
 }
 
 func newDownsampler(
@@ -498,6 +588,40 @@ func newDownsampler(
 	return downsampler, nil
 }
 
+func newIngestRulesApplier(
+	cfg downsample.IngestRulesConfiguration,
+	clusterManagementClient clusterclient.Client,
+	tagOptions models.TagOptions,
+	instrumentOpts instrument.Options,
+) (downsample.IngestRulesApplier, error) {
+	if clusterManagementClient == nil {
+		return nil, fmt.Errorf("no configured cluster management config, " +
+			"must set this config for ingest rules")
+	}
+
+	kvStore, err := clusterManagementClient.KV()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create KV store from the "+
+			"cluster management config client")
+	}
+
+	applier, err := cfg.NewIngestRulesApplier(downsample.IngestRulesApplierOptions{
+		RulesKVStore:          kvStore,
+		NameTag:               string(tagOptions.MetricName()),
+		ClockOptions:          clock.NewOptions(),
+		InstrumentOptions:     instrumentOpts,
+		TagEncoderOptions:     serialize.NewTagEncoderOptions(),
+		TagDecoderOptions:     serialize.NewTagDecoderOptions(),
+		TagEncoderPoolOptions: pool.NewObjectPoolOptions(),
+		TagDecoderPoolOptions: pool.NewObjectPoolOptions(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create ingest rules applier")
+	}
+
+	return applier, nil
+}
+
 func newDownsamplerAutoMappingRules(
 	namespaces []m3.ClusterNamespace,
 ) ([]downsample.MappingRule, error) {
@@ -603,7 +727,7 @@ func newStorages(
 	readWorkerPool xsync.PooledWorkerPool,
 	writeWorkerPool xsync.PooledWorkerPool,
 	instrumentOpts instrument.Options,
-) (storage.Storage, cleanupFn, error) {
+) (storage.Storage, m3.Querier, cleanupFn, error) {
 	var (
 		logger  = instrumentOpts.Logger()
 		cleanup = func() error { return nil }
@@ -617,7 +741,7 @@ func newStorages(
 		*cfg.LookbackDuration,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	stores := []storage.Storage{localStorage}
@@ -626,7 +750,7 @@ func newStorages(
 		logger.Info("rpc enabled")
 		server, err := startGrpcServer(logger, localStorage, poolWrapper, cfg.RPC)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		cleanup = func() error {
@@ -634,19 +758,19 @@ func newStorages(
 			return nil
 		}
 
-		remoteStorage, enabled, err := remoteClient(
+		remoteStorages, err := remoteClients(
 			cfg,
 			tagOptions,
 			poolWrapper,
 			readWorkerPool,
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
-		if enabled {
-			stores = append(stores, remoteStorage)
-			remoteEnabled = enabled
+		if len(remoteStorages) > 0 {
+			stores = append(stores, remoteStorages...)
+			remoteEnabled = true
 		}
 	}
 
@@ -693,8 +817,21 @@ func newStorages(
 		completeTagsFilter = filter.CompleteTagsAllowNone
 	}
 
-	fanoutStorage := fanout.NewStorage(stores, readFilter, writeFilter, completeTagsFilter)
-	return fanoutStorage, cleanup, nil
+	// Compressed series can only be fetched directly from the local storage
+	// when it is the only storage reads fan out to.
+	var querier m3.Querier
+	if len(stores) == 1 &&
+		cfg.Filter.Read != config.FilterRemoteOnly &&
+		cfg.Filter.Read != config.FilterAllowNone {
+		querier = localStorage
+	}
+
+	fanoutStorage := fanout.NewStorage(stores, readFilter, writeFilter, completeTagsFilter, fanout.Options{
+		PartialResults: cfg.PartialResults.Enabled,
+		StoreTimeout:   cfg.PartialResults.StoreTimeout,
+		Scope:          instrumentOpts.MetricsScope().SubScope("fanout"),
+	})
+	return fanoutStorage, querier, cleanup, nil
 }
 
 func remoteClient(
@@ -708,24 +845,74 @@ func remoteClient(
 	}
 
 	if remotes := cfg.RPC.RemoteListenAddresses; len(remotes) > 0 {
-		client, err := tsdbRemote.NewGRPCClient(
-			remotes,
-			poolWrapper,
-			readWorkerPool,
-			tagOptions,
-			*cfg.LookbackDuration,
-		)
+		remoteStorage, err := newRemoteStorage(defaultRemoteName, remotes,
+			cfg, tagOptions, poolWrapper, readWorkerPool)
 		if err != nil {
 			return nil, false, err
 		}
 
-		remoteStorage := remote.NewStorage(client)
 		return remoteStorage, true, nil
 	}
 
 	return nil, false, nil
 }
 
+// remoteClients returns a storage for the remote listen addresses and one
+// for each remote zone, each named so that it can be told apart in the
+// warnings and metrics of reads that skip it.
+func remoteClients(
+	cfg config.Configuration,
+	tagOptions models.TagOptions,
+	poolWrapper *pools.PoolWrapper,
+	readWorkerPool xsync.PooledWorkerPool,
+) ([]storage.Storage, error) {
+	var remoteStorages []storage.Storage
+	remoteStorage, enabled, err := remoteClient(cfg, tagOptions, poolWrapper,
+		readWorkerPool)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		remoteStorages = append(remoteStorages, remoteStorage)
+	}
+
+	for _, zone := range cfg.RPC.Remotes {
+		if zone.Name == "" || len(zone.RemoteListenAddresses) == 0 {
+			return nil, errInvalidRemoteZone
+		}
+		remoteStorage, err := newRemoteStorage(zone.Name, zone.RemoteListenAddresses,
+			cfg, tagOptions, poolWrapper, readWorkerPool)
+		if err != nil {
+			return nil, err
+		}
+		remoteStorages = append(remoteStorages, remoteStorage)
+	}
+
+	return remoteStorages, nil
+}
+
+func newRemoteStorage(
+	name string,
+	addresses []string,
+	cfg config.Configuration,
+	tagOptions models.TagOptions,
+	poolWrapper *pools.PoolWrapper,
+	readWorkerPool xsync.PooledWorkerPool,
+) (storage.Storage, error) {
+	client, err := tsdbRemote.NewGRPCClient(
+		addresses,
+		poolWrapper,
+		readWorkerPool,
+		tagOptions,
+		*cfg.LookbackDuration,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return remote.NewStorage(client, name), nil
+}
+
 func startGrpcServer(
 	logger *zap.Logger,
 	storage m3.Storage,
@@ -748,6 +935,27 @@ func startGrpcServer(
 	return server, startErr
 }
 
+func startScrapeIngestion(
+	cfg *ingestscrape.Configuration,
+	tagOptions models.TagOptions,
+	iOpts instrument.Options,
+	logger *zap.Logger,
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+) *ingestscrape.Scraper {
+	logger.Info("scrape ingestion enabled, configuring scraper")
+
+	scrapeIOpts := iOpts.SetMetricsScope(
+		iOpts.MetricsScope().SubScope("ingest-scrape"))
+	scraper, err := cfg.NewScraper(downsamplerAndWriter, tagOptions, scrapeIOpts)
+	if err != nil {
+		logger.Fatal("unable to create scraper", zap.Error(err))
+	}
+
+	scraper.Start()
+	logger.Info("started scrape ingestion", zap.Int("jobs", len(cfg.Jobs)))
+	return scraper
+}
+
 func startCarbonIngestion(
 	cfg *config.CarbonConfiguration,
 	iOpts instrument.Options,
@@ -871,6 +1079,27 @@ func startCarbonIngestion(
 	logger.Info("started carbon ingestion server", zap.String("listenAddress", carbonListenAddress))
 }
 
+func newQuotaEnforcer(
+	cfg config.Configuration,
+	clusterManagementClient clusterclient.Client,
+	instrumentOpts instrument.Options,
+) (quota.Enforcer, error) {
+	if !cfg.Quotas.Enabled {
+		return nil, nil
+	}
+
+	var kvStore kv.Store
+	if clusterManagementClient != nil {
+		store, err := clusterManagementClient.KV()
+		if err != nil {
+			return nil, err
+		}
+		kvStore = store
+	}
+
+	return cfg.Quotas.NewEnforcer(kvStore, instrumentOpts)
+}
+
 func newDownsamplerAndWriter(storage storage.Storage, downsampler downsample.Downsampler) (ingest.DownsamplerAndWriter, error) {
 	// Make sure the downsampler and writer gets its own PooledWorkerPool and that its not shared with any other
 	// codepaths because PooledWorkerPools can deadlock if used recursively.
@@ -22,12 +22,14 @@ package storage
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"sort"
 	"sync"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
 	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
 	"github.com/m3db/m3/src/query/models"
@@ -37,6 +39,9 @@ import (
 	xtime "github.com/m3db/m3/src/x/time"
 )
 
+var errMismatchedResolutionsLength = errors.New("length of resolutions and" +
+	" series iterators does not match")
+
 const (
 	xTimeUnit             = xtime.Millisecond
 	initRawFetchAllocSize = 32
@@ -266,19 +271,35 @@ func SeriesToPromSamples(series *ts.Series) []*prompb.Sample {
 	return samplesPointers
 }
 
+// iteratorToTsSeries converts a series iterator into a series, or if the
+// series is a stream of sparse histograms into one series per bucket.
 func iteratorToTsSeries(
 	iter encoding.SeriesIterator,
 	enforcer cost.ChainedEnforcer,
 	tagOptions models.TagOptions,
-) (*ts.Series, error) {
+) ([]*ts.Series, error) {
 	metric, err := FromM3IdentToMetric(iter.ID(), iter.Tags(), tagOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	datapoints := make(ts.Datapoints, 0, initRawFetchAllocSize)
+	var (
+		datapoints = make(ts.Datapoints, 0, initRawFetchAllocSize)
+		histograms []histogram.Histogram
+	)
 	for iter.Next() {
-		dp, _, _ := iter.Current()
+		dp, _, annotation := iter.Current()
+		if len(datapoints) == 0 && histogram.IsAnnotation(annotation) {
+			histograms = make([]histogram.Histogram, 0, initRawFetchAllocSize)
+		}
+		if histograms != nil {
+			h, err := histogram.UnmarshalAnnotation(annotation)
+			if err != nil {
+				return nil, fmt.Errorf("invalid histogram for series %s: %v",
+					metric.ID, err)
+			}
+			histograms = append(histograms, h)
+		}
 		datapoints = append(datapoints, ts.Datapoint{Timestamp: dp.Timestamp, Value: dp.Value})
 	}
 
@@ -286,12 +307,16 @@ func iteratorToTsSeries(
 		return nil, err
 	}
 
+	if histograms != nil {
+		return histogramsToBucketSeries(metric, datapoints, histograms, enforcer)
+	}
+
 	r := enforcer.Add(xcost.Cost(len(datapoints)))
 	if r.Error != nil {
 		return nil, r.Error
 	}
 
-	return ts.NewSeries(metric.ID, datapoints, metric.Tags), nil
+	return []*ts.Series{ts.NewSeries(metric.ID, datapoints, metric.Tags)}, nil
 }
 
 // Fall back to sequential decompression if unable to decompress concurrently
@@ -300,19 +325,17 @@ func decompressSequentially(
 	iters []encoding.SeriesIterator,
 	enforcer cost.ChainedEnforcer,
 	tagOptions models.TagOptions,
-) (*FetchResult, error) {
-	seriesList := make([]*ts.Series, 0, len(iters))
+) ([][]*ts.Series, error) {
+	seriesLists := make([][]*ts.Series, 0, len(iters))
 	for _, iter := range iters {
 		series, err := iteratorToTsSeries(iter, enforcer, tagOptions)
 		if err != nil {
 			return nil, err
 		}
-		seriesList = append(seriesList, series)
+		seriesLists = append(seriesLists, series)
 	}
 
-	return &FetchResult{
-		SeriesList: seriesList,
-	}, nil
+	return seriesLists, nil
 }
 
 func decompressConcurrently(
@@ -321,8 +344,8 @@ func decompressConcurrently(
 	readWorkerPool xsync.PooledWorkerPool,
 	enforcer cost.ChainedEnforcer,
 	tagOptions models.TagOptions,
-) (*FetchResult, error) {
-	seriesList := make([]*ts.Series, iterLength)
+) ([][]*ts.Series, error) {
+	seriesLists := make([][]*ts.Series, iterLength)
 	var wg sync.WaitGroup
 	errorCh := make(chan error, 1)
 	done := make(chan struct{})
@@ -356,7 +379,7 @@ func decompressConcurrently(
 				}
 				return
 			}
-			seriesList[i] = series
+			seriesLists[i] = series
 		})
 	}
 
@@ -366,9 +389,7 @@ func decompressConcurrently(
 		return nil, err
 	}
 
-	return &FetchResult{
-		SeriesList: seriesList,
-	}, nil
+	return seriesLists, nil
 }
 
 // SeriesIteratorsToFetchResult converts SeriesIterators into a fetch result
@@ -379,6 +400,59 @@ func SeriesIteratorsToFetchResult(
 	enforcer cost.ChainedEnforcer,
 	tagOptions models.TagOptions,
 ) (*FetchResult, error) {
+	seriesLists, err := seriesIteratorsToSeriesLists(seriesIterators,
+		readWorkerPool, cleanupSeriesIters, enforcer, tagOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FetchResult{
+		SeriesList: flattenSeriesLists(seriesLists),
+	}, nil
+}
+
+// SeriesIteratorsToFetchResultWithResolutions converts SeriesIterators into
+// a fetch result, setting the resolution of the series converted from each
+// iterator to the resolution at the same index.
+func SeriesIteratorsToFetchResultWithResolutions(
+	seriesIterators encoding.SeriesIterators,
+	resolutions []time.Duration,
+	readWorkerPool xsync.PooledWorkerPool,
+	cleanupSeriesIters bool,
+	enforcer cost.ChainedEnforcer,
+	tagOptions models.TagOptions,
+) (*FetchResult, error) {
+	if seriesIterators.Len() != len(resolutions) {
+		if cleanupSeriesIters {
+			seriesIterators.Close()
+		}
+		return nil, errMismatchedResolutionsLength
+	}
+
+	seriesLists, err := seriesIteratorsToSeriesLists(seriesIterators,
+		readWorkerPool, cleanupSeriesIters, enforcer, tagOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, seriesList := range seriesLists {
+		for _, series := range seriesList {
+			series.SetResolution(resolutions[i])
+		}
+	}
+
+	return &FetchResult{
+		SeriesList: flattenSeriesLists(seriesLists),
+	}, nil
+}
+
+func seriesIteratorsToSeriesLists(
+	seriesIterators encoding.SeriesIterators,
+	readWorkerPool xsync.PooledWorkerPool,
+	cleanupSeriesIters bool,
+	enforcer cost.ChainedEnforcer,
+	tagOptions models.TagOptions,
+) ([][]*ts.Series, error) {
 	if cleanupSeriesIters {
 		defer seriesIterators.Close()
 	}
@@ -392,3 +466,16 @@ func SeriesIteratorsToFetchResult(
 	return decompressConcurrently(iterLength, iters, readWorkerPool,
 		enforcer, tagOptions)
 }
+
+func flattenSeriesLists(seriesLists [][]*ts.Series) []*ts.Series {
+	length := 0
+	for _, seriesList := range seriesLists {
+		length += len(seriesList)
+	}
+
+	flattened := make([]*ts.Series, 0, length)
+	for _, seriesList := range seriesLists {
+		flattened = append(flattened, seriesList...)
+	}
+	return flattened
+}
@@ -22,6 +22,7 @@ package fanout
 
 import (
 	"context"
+	"time"
 
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/errors"
@@ -31,15 +32,64 @@ import (
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util/execution"
 	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
 
+	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 )
 
+const (
+	fetchOp        = "fetch"
+	fetchBlocksOp  = "fetch-blocks"
+	searchSeriesOp = "search-series"
+	completeTagsOp = "complete-tags"
+)
+
+// Options are the options for the fanout storage.
+type Options struct {
+	// PartialResults makes reads skip the storages that fail or time out
+	// instead of failing, as long as at least one storage succeeds. Each
+	// skipped storage adds a warning to the context of the read.
+	PartialResults bool
+	// StoreTimeout is the timeout applied to the context of each storage read
+	// when partial results are enabled, so that a slow storage is skipped
+	// before it uses up the whole query timeout. Zero means no timeout.
+	StoreTimeout time.Duration
+	// Scope is used to report metrics about degraded reads.
+	Scope tally.Scope
+}
+
 type fanoutStorage struct {
 	stores             []storage.Storage
 	fetchFilter        filter.Storage
 	writeFilter        filter.Storage
 	completeTagsFilter filter.StorageCompleteTags
+	opts               Options
+	metrics            fanoutMetrics
+}
+
+type fanoutMetrics struct {
+	scope tally.Scope
+}
+
+func newFanoutMetrics(scope tally.Scope) fanoutMetrics {
+	if scope == nil {
+		scope = tally.NoopScope
+	}
+	return fanoutMetrics{scope: scope}
+}
+
+// degraded counts the reads that returned partial results.
+func (m fanoutMetrics) degraded(op string) tally.Counter {
+	return m.scope.Tagged(map[string]string{"op": op}).Counter("degraded-reads")
+}
+
+// skipped counts the storages skipped by reads returning partial results.
+func (m fanoutMetrics) skipped(op string, store storage.Storage) tally.Counter {
+	return m.scope.Tagged(map[string]string{
+		"op":    op,
+		"store": store.Name(),
+	}).Counter("skipped-stores")
 }
 
 // NewStorage creates a new fanout Storage instance.
@@ -48,12 +98,15 @@ func NewStorage(
 	fetchFilter filter.Storage,
 	writeFilter filter.Storage,
 	completeTagsFilter filter.StorageCompleteTags,
+	opts Options,
 ) storage.Storage {
 	return &fanoutStorage{
 		stores:             stores,
 		fetchFilter:        fetchFilter,
 		writeFilter:        writeFilter,
 		completeTagsFilter: completeTagsFilter,
+		opts:               opts,
+		metrics:            newFanoutMetrics(opts.Scope),
 	}
 }
 
@@ -65,7 +118,7 @@ func (s *fanoutStorage) Fetch(
 	stores := filterStores(s.stores, s.fetchFilter, query)
 	requests := make([]execution.Request, len(stores))
 	for idx, store := range stores {
-		requests[idx] = newFetchRequest(store, query, options)
+		requests[idx] = newFetchRequest(store, query, options, s.opts)
 	}
 
 	err := execution.ExecuteParallel(ctx, requests)
@@ -73,7 +126,7 @@ func (s *fanoutStorage) Fetch(
 		return nil, err
 	}
 
-	return handleFetchResponses(requests)
+	return s.handleFetchResponses(ctx, requests)
 }
 
 func (s *fanoutStorage) FetchBlocks(
@@ -83,27 +136,65 @@ func (s *fanoutStorage) FetchBlocks(
 ) (block.Result, error) {
 	stores := filterStores(s.stores, s.writeFilter, query)
 	blockResult := block.Result{}
+	degraded := newDegradedRead(s, fetchBlocksOp, len(stores))
 	for _, store := range stores {
-		result, err := store.FetchBlocks(ctx, query, options)
+		result, err := s.fetchBlocks(ctx, store, query, options)
 		if err != nil {
-			return block.Result{}, err
+			if err := degraded.skip(store, err); err != nil {
+				return block.Result{}, err
+			}
+			continue
 		}
 
 		blockResult.Blocks = append(blockResult.Blocks, result.Blocks...)
 	}
 
+	if err := degraded.done(ctx); err != nil {
+		return block.Result{}, err
+	}
+
 	return blockResult, nil
 }
 
-func handleFetchResponses(requests []execution.Request) (*storage.FetchResult, error) {
+func (s *fanoutStorage) fetchBlocks(
+	ctx context.Context,
+	store storage.Storage,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (block.Result, error) {
+	ctx, cancel := storeContext(ctx, s.opts)
+	defer cancel()
+	return store.FetchBlocks(ctx, query, options)
+}
+
+// storeContext returns the context to read from a single storage with.
+func storeContext(ctx context.Context, opts Options) (context.Context, context.CancelFunc) {
+	if !opts.PartialResults || opts.StoreTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, opts.StoreTimeout)
+}
+
+func (s *fanoutStorage) handleFetchResponses(
+	ctx context.Context,
+	requests []execution.Request,
+) (*storage.FetchResult, error) {
 	seriesList := make([]*ts.Series, 0, len(requests))
 	result := &storage.FetchResult{SeriesList: seriesList, LocalOnly: true}
+	degraded := newDegradedRead(s, fetchOp, len(requests))
 	for _, req := range requests {
 		fetchreq, ok := req.(*fetchRequest)
 		if !ok {
 			return nil, errors.ErrFetchRequestType
 		}
 
+		if fetchreq.err != nil {
+			if err := degraded.skip(fetchreq.store, fetchreq.err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		if fetchreq.result == nil {
 			return nil, errors.ErrInvalidFetchResult
 		}
@@ -115,6 +206,10 @@ func handleFetchResponses(requests []execution.Request) (*storage.FetchResult, e
 		result.SeriesList = append(result.SeriesList, fetchreq.result.SeriesList...)
 	}
 
+	if err := degraded.done(ctx); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
@@ -126,14 +221,22 @@ func (s *fanoutStorage) SearchSeries(
 	var metrics models.Metrics
 
 	stores := filterStores(s.stores, s.fetchFilter, query)
+	degraded := newDegradedRead(s, searchSeriesOp, len(stores))
 	for _, store := range stores {
-		results, err := store.SearchSeries(ctx, query, options)
+		results, err := s.searchSeries(ctx, store, query, options)
 		if err != nil {
-			return nil, err
+			if err := degraded.skip(store, err); err != nil {
+				return nil, err
+			}
+			continue
 		}
 		metrics = append(metrics, results.Metrics...)
 	}
 
+	if err := degraded.done(ctx); err != nil {
+		return nil, err
+	}
+
 	result := &storage.SearchResults{Metrics: metrics}
 
 	return result, nil
@@ -146,19 +249,49 @@ func (s *fanoutStorage) CompleteTags(
 ) (*storage.CompleteTagsResult, error) {
 	accumulatedTags := storage.NewCompleteTagsResultBuilder(query.CompleteNameOnly)
 	stores := filterCompleteTagsStores(s.stores, s.completeTagsFilter, *query)
+	degraded := newDegradedRead(s, completeTagsOp, len(stores))
 	for _, store := range stores {
-		result, err := store.CompleteTags(ctx, query, options)
+		result, err := s.completeTags(ctx, store, query, options)
 		if err != nil {
-			return nil, err
+			if err := degraded.skip(store, err); err != nil {
+				return nil, err
+			}
+			continue
 		}
 
 		accumulatedTags.Add(result)
 	}
 
+	if err := degraded.done(ctx); err != nil {
+		return nil, err
+	}
+
 	built := accumulatedTags.Build()
 	return &built, nil
 }
 
+func (s *fanoutStorage) searchSeries(
+	ctx context.Context,
+	store storage.Storage,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (*storage.SearchResults, error) {
+	ctx, cancel := storeContext(ctx, s.opts)
+	defer cancel()
+	return store.SearchSeries(ctx, query, options)
+}
+
+func (s *fanoutStorage) completeTags(
+	ctx context.Context,
+	store storage.Storage,
+	query *storage.CompleteTagsQuery,
+	options *storage.FetchOptions,
+) (*storage.CompleteTagsResult, error) {
+	ctx, cancel := storeContext(ctx, s.opts)
+	defer cancel()
+	return store.CompleteTags(ctx, query, options)
+}
+
 func (s *fanoutStorage) Write(ctx context.Context, query *storage.WriteQuery) error {
 	// TODO: Consider removing this lookup on every write by maintaining different read/write lists
 	stores := filterStores(s.stores, s.writeFilter, query)
@@ -179,6 +312,10 @@ func (s *fanoutStorage) Type() storage.Type {
 	return storage.TypeMultiDC
 }
 
+func (s *fanoutStorage) Name() string {
+	return "multi"
+}
+
 func (s *fanoutStorage) Close() error {
 	var lastErr error
 	for idx, store := range s.stores {
@@ -224,27 +361,41 @@ func filterCompleteTagsStores(
 }
 
 type fetchRequest struct {
-	store   storage.Storage
-	query   *storage.FetchQuery
-	options *storage.FetchOptions
-	result  *storage.FetchResult
+	store      storage.Storage
+	query      *storage.FetchQuery
+	options    *storage.FetchOptions
+	fanoutOpts Options
+	result     *storage.FetchResult
+	err        error
 }
 
 func newFetchRequest(
 	store storage.Storage,
 	query *storage.FetchQuery,
 	options *storage.FetchOptions,
+	fanoutOpts Options,
 ) execution.Request {
 	return &fetchRequest{
-		store:   store,
-		query:   query,
-		options: options,
+		store:      store,
+		query:      query,
+		options:    options,
+		fanoutOpts: fanoutOpts,
 	}
 }
 
 func (f *fetchRequest) Process(ctx context.Context) error {
+	ctx, cancel := storeContext(ctx, f.fanoutOpts)
+	defer cancel()
+
 	result, err := f.store.Fetch(ctx, f.query, f.options)
 	if err != nil {
+		if f.fanoutOpts.PartialResults {
+			// Keep the error to decide once every storage has responded whether
+			// the fetch can continue without this one, rather than cancelling
+			// the fetches still in flight.
+			f.err = err
+			return nil
+		}
 		return err
 	}
 
@@ -267,3 +418,71 @@ func newWriteRequest(store storage.Storage, query *storage.WriteQuery) execution
 func (f *writeRequest) Process(ctx context.Context) error {
 	return f.store.Write(ctx, f.query)
 }
+
+// degradedRead tracks the storages that failed during a single read.
+type degradedRead struct {
+	storage   *fanoutStorage
+	op        string
+	numStores int
+	failed    []failedStore
+}
+
+type failedStore struct {
+	store storage.Storage
+	err   error
+}
+
+func newDegradedRead(s *fanoutStorage, op string, numStores int) *degradedRead {
+	return &degradedRead{
+		storage:   s,
+		op:        op,
+		numStores: numStores,
+	}
+}
+
+// skip returns nil if the read may be able to continue without the store
+// that failed with err.
+func (d *degradedRead) skip(store storage.Storage, err error) error {
+	if !d.storage.opts.PartialResults || !isDegradable(err) {
+		return err
+	}
+
+	d.failed = append(d.failed, failedStore{store: store, err: err})
+	return nil
+}
+
+// done returns an error if every store failed, otherwise it adds a warning
+// to the context of the read for each store that was skipped.
+func (d *degradedRead) done(ctx context.Context) error {
+	if len(d.failed) == 0 {
+		return nil
+	}
+	if len(d.failed) == d.numStores {
+		// Nothing left to return partial results from.
+		return d.failed[len(d.failed)-1].err
+	}
+
+	var (
+		logger   = logging.WithContext(ctx)
+		warnings = storage.WarningsFromContext(ctx)
+	)
+	for _, f := range d.failed {
+		name := f.store.Name()
+		warnings.Add(storage.Warning{
+			Name:    name,
+			Message: f.err.Error(),
+		})
+		d.storage.metrics.skipped(d.op, f.store).Inc(1)
+		logger.Warn("skipping failed storage, returning partial results",
+			zap.String("op", d.op), zap.String("store", name), zap.Error(f.err))
+	}
+	d.storage.metrics.degraded(d.op).Inc(1)
+	return nil
+}
+
+// isDegradable returns true if a read can succeed without the storage that
+// returned err. Errors caused by the query itself, such as exceeding a limit
+// or invalid parameters, would occur on every storage and fail the read.
+func isDegradable(err error) bool {
+	return !xerrors.IsResourceExhausted(err) && !xerrors.IsInvalidParams(err)
+}
@@ -36,11 +36,13 @@ import (
 	"github.com/m3db/m3/src/query/test/seriesiter"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
 )
 
 func filterFunc(output bool) filter.Storage {
@@ -100,7 +102,7 @@ func setupFanoutRead(t *testing.T, output bool, response ...*fetchResponse) stor
 		store1, store2,
 	}
 
-	store := NewStorage(stores, filterFunc(output), filterFunc(output), filterCompleteTagsFunc(output))
+	store := NewStorage(stores, filterFunc(output), filterFunc(output), filterCompleteTagsFunc(output), Options{})
 	return store
 }
 
@@ -128,7 +130,7 @@ func setupFanoutWrite(t *testing.T, output bool, errs ...error) storage.Storage
 	stores := []storage.Storage{
 		store1, store2,
 	}
-	store := NewStorage(stores, filterFunc(output), filterFunc(output), filterCompleteTagsFunc(output))
+	store := NewStorage(stores, filterFunc(output), filterFunc(output), filterCompleteTagsFunc(output), Options{})
 	return store
 }
 
@@ -218,3 +220,149 @@ func TestCompleteTagsError(t *testing.T) {
 	)
 	assert.Error(t, err)
 }
+
+func newPartialResultsStores(
+	ctrl *gomock.Controller,
+) (*storage.MockStorage, *storage.MockStorage) {
+	local := storage.NewMockStorage(ctrl)
+	local.EXPECT().Type().Return(storage.TypeLocalDC).AnyTimes()
+	local.EXPECT().Name().Return("local").AnyTimes()
+	remote := storage.NewMockStorage(ctrl)
+	remote.EXPECT().Type().Return(storage.TypeRemoteDC).AnyTimes()
+	remote.EXPECT().Name().Return("zone-b").AnyTimes()
+	return local, remote
+}
+
+func setupFanoutPartialResults(
+	stores []storage.Storage,
+	opts Options,
+) storage.Storage {
+	setup()
+	return NewStorage(stores, filterFunc(true), filterFunc(true), filterCompleteTagsFunc(true), opts)
+}
+
+func TestFanoutFetchPartialResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	local, remote := newPartialResultsStores(ctrl)
+	series := ts.NewSeries([]byte("foo"), ts.NewFixedStepValues(time.Second, 1, 1, time.Now()), models.NewTags(0, nil))
+	local.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&storage.FetchResult{SeriesList: ts.SeriesList{series}}, nil).Times(2)
+	remote.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("remote unavailable")).Times(2)
+	stores := []storage.Storage{local, remote}
+
+	// Fails the whole fetch unless partial results are enabled.
+	store := setupFanoutPartialResults(stores, Options{})
+	_, err := store.Fetch(context.TODO(), &storage.FetchQuery{}, storage.NewFetchOptions())
+	require.Error(t, err)
+
+	scope := tally.NewTestScope("", nil)
+	store = setupFanoutPartialResults(stores, Options{PartialResults: true, Scope: scope})
+	ctx, warnings := storage.NewContextWithWarnings(context.TODO())
+	res, err := store.Fetch(ctx, &storage.FetchQuery{}, storage.NewFetchOptions())
+	require.NoError(t, err)
+	assert.Equal(t, ts.SeriesList{series}, res.SeriesList)
+	assert.Equal(t, []storage.Warning{{Name: "zone-b", Message: "remote unavailable"}}, warnings.All())
+
+	counters := scope.Snapshot().Counters()
+	require.NotNil(t, counters["degraded-reads+op=fetch"])
+	assert.Equal(t, int64(1), counters["degraded-reads+op=fetch"].Value())
+	require.NotNil(t, counters["skipped-stores+op=fetch,store=zone-b"])
+	assert.Equal(t, int64(1), counters["skipped-stores+op=fetch,store=zone-b"].Value())
+}
+
+func TestFanoutFetchPartialResultsAllFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	local, remote := newPartialResultsStores(ctrl)
+	local.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("local unavailable"))
+	remote.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("remote unavailable"))
+
+	store := setupFanoutPartialResults([]storage.Storage{local, remote}, Options{PartialResults: true})
+	ctx, warnings := storage.NewContextWithWarnings(context.TODO())
+	_, err := store.Fetch(ctx, &storage.FetchQuery{}, storage.NewFetchOptions())
+	assert.Error(t, err)
+	assert.False(t, warnings.Partial())
+}
+
+func TestFanoutFetchPartialResultsResourceExhausted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	local, remote := newPartialResultsStores(ctrl)
+	local.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&storage.FetchResult{}, nil)
+	remote.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, xerrors.NewResourceExhaustedError(fmt.Errorf("limit exceeded")))
+
+	store := setupFanoutPartialResults([]storage.Storage{local, remote}, Options{PartialResults: true})
+	_, err := store.Fetch(context.TODO(), &storage.FetchQuery{}, storage.NewFetchOptions())
+	require.Error(t, err)
+	assert.True(t, xerrors.IsResourceExhausted(err))
+}
+
+func TestFanoutFetchPartialResultsStoreTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	local, remote := newPartialResultsStores(ctrl)
+	local.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&storage.FetchResult{}, nil)
+	remote.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			_ *storage.FetchQuery,
+			_ *storage.FetchOptions,
+		) (*storage.FetchResult, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+	store := setupFanoutPartialResults([]storage.Storage{local, remote}, Options{
+		PartialResults: true,
+		StoreTimeout:   10 * time.Millisecond,
+	})
+	ctx, warnings := storage.NewContextWithWarnings(context.TODO())
+	_, err := store.Fetch(ctx, &storage.FetchQuery{}, storage.NewFetchOptions())
+	require.NoError(t, err)
+	assert.Equal(t, []storage.Warning{
+		{Name: "zone-b", Message: context.DeadlineExceeded.Error()},
+	}, warnings.All())
+}
+
+func TestFanoutSearchAndCompleteTagsPartialResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	local, remote := newPartialResultsStores(ctrl)
+	local.EXPECT().SearchSeries(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&storage.SearchResults{Metrics: models.Metrics{{ID: []byte("foo")}}}, nil)
+	remote.EXPECT().SearchSeries(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("remote unavailable"))
+	local.EXPECT().CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&storage.CompleteTagsResult{
+			CompleteNameOnly: true,
+			CompletedTags:    []storage.CompletedTag{{Name: []byte("foo")}},
+		}, nil)
+	remote.EXPECT().CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("remote unavailable"))
+
+	store := setupFanoutPartialResults([]storage.Storage{local, remote}, Options{PartialResults: true})
+	ctx, warnings := storage.NewContextWithWarnings(context.TODO())
+	searchRes, err := store.SearchSeries(ctx, &storage.FetchQuery{}, storage.NewFetchOptions())
+	require.NoError(t, err)
+	assert.Len(t, searchRes.Metrics, 1)
+
+	tagsRes, err := store.CompleteTags(ctx, &storage.CompleteTagsQuery{
+		CompleteNameOnly: true,
+		TagMatchers:      models.Matchers{},
+	}, storage.NewFetchOptions())
+	require.NoError(t, err)
+	assert.Len(t, tagsRes.CompletedTags, 1)
+	assert.Len(t, warnings.All(), 2)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
+	"github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xcost "github.com/m3db/m3/src/x/cost"
+)
+
+// histogramsToBucketSeries expands a series of sparse histograms into one
+// series of cumulative counts per bucket upper bound, tagged with the bucket
+// upper bound, which is the form expected by histogram_quantile. A +Inf
+// bucket with the histogram count is always included.
+func histogramsToBucketSeries(
+	metric models.Metric,
+	datapoints ts.Datapoints,
+	histograms []histogram.Histogram,
+	enforcer cost.ChainedEnforcer,
+) ([]*ts.Series, error) {
+	bounds := append(histogram.UpperBounds(histograms), math.Inf(1))
+	r := enforcer.Add(xcost.Cost(len(datapoints) * len(bounds)))
+	if r.Error != nil {
+		return nil, r.Error
+	}
+
+	values := make([]ts.Datapoints, len(bounds))
+	for i := range values {
+		values[i] = make(ts.Datapoints, 0, len(datapoints))
+	}
+
+	for i, h := range histograms {
+		for j, count := range h.Cumulative(bounds) {
+			values[j] = append(values[j], ts.Datapoint{
+				Timestamp: datapoints[i].Timestamp,
+				Value:     float64(count),
+			})
+		}
+	}
+
+	seriesList := make([]*ts.Series, 0, len(bounds))
+	for i, bound := range bounds {
+		tags := metric.Tags.Clone().SetBucket(formatUpperBound(bound))
+		seriesList = append(seriesList, ts.NewSeries(tags.ID(), values[i], tags))
+	}
+
+	return seriesList, nil
+}
+
+func formatUpperBound(bound float64) []byte {
+	if math.IsInf(bound, 1) {
+		return []byte("+Inf")
+	}
+	return strconv.AppendFloat(nil, bound, 'g', -1, 64)
+}
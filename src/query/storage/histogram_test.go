@@ -0,0 +1,103 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
+	dbts "github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/models"
+	xcost "github.com/m3db/m3/src/x/cost"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIteratorToTsSeriesExpandsHistograms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().Truncate(time.Minute)
+	histograms := []histogram.Histogram{
+		{
+			Sum:     1,
+			Count:   3,
+			Buckets: []histogram.Bucket{{UpperBound: 0.1, Count: 1}, {UpperBound: 1, Count: 1}},
+		},
+		{
+			Sum:     10,
+			Count:   6,
+			Buckets: []histogram.Bucket{{UpperBound: 0.1, Count: 2}, {UpperBound: 5, Count: 3}},
+		},
+	}
+
+	iter := encoding.NewMockSeriesIterator(ctrl)
+	iter.EXPECT().ID().Return(ident.StringID("foo"))
+	iter.EXPECT().Tags().Return(ident.NewTagsIterator(ident.NewTags(
+		ident.StringTag("__name__", "latency"))))
+	for i, h := range histograms {
+		iter.EXPECT().Next().Return(true)
+		iter.EXPECT().Current().Return(
+			dbts.Datapoint{
+				Timestamp: now.Add(time.Duration(i) * time.Minute),
+				Value:     float64(h.Count),
+			},
+			xtime.Second,
+			dbts.Annotation(h.MarshalAnnotation(nil)))
+	}
+	iter.EXPECT().Next().Return(false)
+	iter.EXPECT().Err().Return(nil)
+
+	enforcer := cost.NewMockChainedEnforcer(ctrl)
+	enforcer.EXPECT().Add(xcost.Cost(8)).Return(xcost.Report{})
+
+	seriesList, err := iteratorToTsSeries(iter, enforcer, models.NewTagOptions())
+	require.NoError(t, err)
+
+	expected := map[string][]float64{
+		"0.1":  {1, 2},
+		"1":    {2, 2},
+		"5":    {2, 5},
+		"+Inf": {3, 6},
+	}
+	require.Equal(t, len(expected), len(seriesList))
+	for _, series := range seriesList {
+		bucket, ok := series.Tags.Bucket()
+		require.True(t, ok)
+		name, ok := series.Tags.Name()
+		require.True(t, ok)
+		require.Equal(t, "latency", string(name))
+
+		values, ok := expected[string(bucket)]
+		require.True(t, ok, "unexpected bucket %s", bucket)
+		require.Equal(t, len(values), series.Values().Len())
+		for i, v := range values {
+			require.Equal(t, v, series.Values().ValueAt(i))
+		}
+		require.Equal(t, now, series.Values().DatapointAt(0).Timestamp)
+	}
+}
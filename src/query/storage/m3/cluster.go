@@ -80,6 +80,7 @@ type ClusterNamespaceOptions struct {
 	// and/or error if call to access a field is not relevant/correct.
 	attributes storage.Attributes
 	downsample *ClusterNamespaceDownsampleOptions
+	histograms bool
 }
 
 // Attributes returns the storage attributes of the cluster namespace.
@@ -102,6 +103,12 @@ func (o ClusterNamespaceOptions) DownsampleOptions() (
 	return *o.downsample, nil
 }
 
+// Histograms returns whether the cluster namespace stores series of sparse
+// histograms.
+func (o ClusterNamespaceOptions) Histograms() bool {
+	return o.histograms
+}
+
 // ClusterNamespaceDownsampleOptions is the downsample options for
 // a cluster namespace.
 type ClusterNamespaceDownsampleOptions struct {
@@ -149,6 +156,7 @@ type UnaggregatedClusterNamespaceDefinition struct {
 	NamespaceID ident.ID
 	Session     client.Session
 	Retention   time.Duration
+	Histograms  bool
 }
 
 // Validate will validate the cluster namespace definition.
@@ -174,6 +182,7 @@ type AggregatedClusterNamespaceDefinition struct {
 	Retention   time.Duration
 	Resolution  time.Duration
 	Downsample  *ClusterNamespaceDownsampleOptions
+	Histograms  bool
 }
 
 // Validate validates the cluster namespace definition.
@@ -321,6 +330,7 @@ func newUnaggregatedClusterNamespace(
 				MetricsType: storage.UnaggregatedMetricsType,
 				Retention:   def.Retention,
 			},
+			histograms: def.Histograms,
 		},
 		session: def.Session,
 	}, nil
@@ -345,6 +355,7 @@ func newAggregatedClusterNamespace(
 				Resolution:  def.Resolution,
 			},
 			downsample: def.Downsample,
+			histograms: def.Histograms,
 		},
 		session: def.Session,
 	}, nil
@@ -0,0 +1,122 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// PeekHistogramSeriesIters reads the first datapoint of each series iterator
+// fetched from a namespace configured to store histograms and returns whether
+// any of them is a series of histograms. The peeked iterators are replaced
+// with iterators that replay the peeked datapoint, series from all other
+// namespaces are left untouched.
+func PeekHistogramSeriesIters(
+	iters encoding.SeriesIterators,
+	clusters Clusters,
+) bool {
+	if clusters == nil {
+		return false
+	}
+
+	var histogramNamespaces []ident.ID
+	for _, namespace := range clusters.ClusterNamespaces() {
+		if namespace.Options().Histograms() {
+			histogramNamespaces = append(histogramNamespaces,
+				namespace.NamespaceID())
+		}
+	}
+	if len(histogramNamespaces) == 0 {
+		return false
+	}
+
+	var (
+		seriesIters  = iters.Iters()
+		anyHistogram bool
+	)
+	for i, iter := range seriesIters {
+		if !containsNamespace(histogramNamespaces, iter.Namespace()) {
+			continue
+		}
+
+		peeked := newPeekedSeriesIter(iter)
+		if peeked.isHistogram() {
+			anyHistogram = true
+		}
+		seriesIters[i] = peeked
+	}
+	return anyHistogram
+}
+
+func containsNamespace(namespaces []ident.ID, namespace ident.ID) bool {
+	for _, ns := range namespaces {
+		if ns.Equal(namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// peekedSeriesIter is a series iterator that has read ahead its first
+// datapoint and replays it on the first call to Next.
+type peekedSeriesIter struct {
+	encoding.SeriesIterator
+
+	hasPeeked  bool
+	replay     bool
+	dp         ts.Datapoint
+	unit       xtime.Unit
+	annotation ts.Annotation
+}
+
+func newPeekedSeriesIter(iter encoding.SeriesIterator) *peekedSeriesIter {
+	peeked := &peekedSeriesIter{SeriesIterator: iter}
+	if iter.Next() {
+		peeked.hasPeeked = true
+		peeked.replay = true
+		peeked.dp, peeked.unit, peeked.annotation = iter.Current()
+	}
+	return peeked
+}
+
+func (it *peekedSeriesIter) isHistogram() bool {
+	return it.hasPeeked && histogram.IsAnnotation(it.annotation)
+}
+
+func (it *peekedSeriesIter) Next() bool {
+	if it.replay {
+		it.replay = false
+		return true
+	}
+	it.hasPeeked = false
+	return it.SeriesIterator.Next()
+}
+
+func (it *peekedSeriesIter) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	if it.hasPeeked {
+		return it.dp, it.unit, it.annotation
+	}
+	return it.SeriesIterator.Current()
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHistogramClusters(t *testing.T, ctrl *gomock.Controller) Clusters {
+	session := client.NewMockSession(ctrl)
+	clusters, err := NewClusters(UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("UNAGG"),
+		Retention:   time.Hour,
+		Session:     session,
+	}, AggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("HISTOGRAMS"),
+		Retention:   time.Hour,
+		Resolution:  time.Minute,
+		Session:     session,
+		Histograms:  true,
+	})
+	require.NoError(t, err)
+	return clusters
+}
+
+func TestPeekHistogramSeriesIters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now        = time.Now()
+		annotation = histogram.Histogram{Count: 1}.MarshalAnnotation(nil)
+		first      = ts.Datapoint{Timestamp: now, Value: 1}
+		second     = ts.Datapoint{Timestamp: now.Add(time.Second), Value: 2}
+	)
+
+	histIter := encoding.NewMockSeriesIterator(ctrl)
+	histIter.EXPECT().Namespace().Return(ident.StringID("HISTOGRAMS"))
+	gomock.InOrder(
+		histIter.EXPECT().Next().Return(true),
+		histIter.EXPECT().Current().Return(first, xtime.Second, ts.Annotation(annotation)),
+		histIter.EXPECT().Next().Return(true),
+		histIter.EXPECT().Current().Return(second, xtime.Second, ts.Annotation(annotation)),
+		histIter.EXPECT().Next().Return(false),
+	)
+
+	emptyIter := encoding.NewMockSeriesIterator(ctrl)
+	emptyIter.EXPECT().Namespace().Return(ident.StringID("HISTOGRAMS"))
+	emptyIter.EXPECT().Next().Return(false).Times(2)
+
+	// Series from namespaces without histograms are never peeked.
+	floatIter := encoding.NewMockSeriesIterator(ctrl)
+	floatIter.EXPECT().Namespace().Return(ident.StringID("UNAGG"))
+
+	seriesIters := []encoding.SeriesIterator{histIter, emptyIter, floatIter}
+	iters := encoding.NewMockSeriesIterators(ctrl)
+	iters.EXPECT().Iters().Return(seriesIters)
+	require.True(t, PeekHistogramSeriesIters(iters,
+		newTestHistogramClusters(t, ctrl)))
+
+	peeked := seriesIters[0]
+	require.True(t, peeked.Next())
+	dp, _, ant := peeked.Current()
+	assert.Equal(t, first, dp)
+	assert.Equal(t, ts.Annotation(annotation), ant)
+	require.True(t, peeked.Next())
+	dp, _, _ = peeked.Current()
+	assert.Equal(t, second, dp)
+	require.False(t, peeked.Next())
+
+	require.False(t, seriesIters[1].Next())
+	require.Equal(t, floatIter, seriesIters[2])
+}
+
+func TestPeekHistogramSeriesItersNoHistogramNamespaces(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clusters, err := NewClusters(UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("UNAGG"),
+		Retention:   time.Hour,
+		Session:     client.NewMockSession(ctrl),
+	})
+	require.NoError(t, err)
+
+	// Neither the iterators nor their datapoints are touched.
+	iters := encoding.NewMockSeriesIterators(ctrl)
+	require.False(t, PeekHistogramSeriesIters(iters, clusters))
+	require.False(t, PeekHistogramSeriesIters(iters, nil))
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/ts/m3db"
 	"github.com/m3db/m3/src/query/ts/m3db/consolidators"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
 	xsync "github.com/m3db/m3/src/x/sync"
 )
@@ -44,9 +45,9 @@ import (
 var (
 	errUnaggregatedAndAggregatedDisabled = goerrors.New("fetch options has both " +
 		"aggregated and unaggregated namespace lookup disabled")
-	errNoNamespacesConfigured  = goerrors.New("no namespaces configured")
-	errMismatchedFetchedLength = goerrors.New("length of fetched attributes and" +
-		" series iterators does not match")
+	errNoNamespacesConfigured = goerrors.New("no namespaces configured")
+	errQueryLimitExceeded     = xerrors.NewResourceExhaustedError(
+		errors.ErrQueryLimitExceeded)
 )
 
 type queryFanoutType uint
@@ -109,27 +110,21 @@ func (s *m3storage) Fetch(
 		enforcer = cost.NoopChainedEnforcer()
 	}
 
-	fetchResult, err := storage.SeriesIteratorsToFetchResult(
+	resolutions := make([]time.Duration, 0, len(attrs))
+	for _, attr := range attrs {
+		resolutions = append(resolutions, attr.Resolution)
+	}
+
+	// NB: series of histograms expand to multiple series so resolutions are
+	// applied per series iterator rather than per resulting series.
+	return storage.SeriesIteratorsToFetchResultWithResolutions(
 		iters,
+		resolutions,
 		s.readWorkerPool,
 		false,
 		enforcer,
 		s.opts.TagOptions(),
 	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if len(fetchResult.SeriesList) != len(attrs) {
-		return nil, errMismatchedFetchedLength
-	}
-
-	for i := range fetchResult.SeriesList {
-		fetchResult.SeriesList[i].SetResolution(attrs[i].Resolution)
-	}
-
-	return fetchResult, nil
 }
 
 func (s *m3storage) FetchBlocks(
@@ -154,7 +149,7 @@ func (s *m3storage) FetchBlocks(
 			SetSplitSeriesByBlock(true)
 	}
 
-	raw, _, err := s.FetchCompressed(ctx, query, options)
+	raw, cleanup, err := s.FetchCompressed(ctx, query, options)
 	if err != nil {
 		return block.Result{}, err
 	}
@@ -170,6 +165,26 @@ func (s *m3storage) FetchBlocks(
 		enforcer = cost.NoopChainedEnforcer()
 	}
 
+	// Series of histograms expand to one series per bucket which is only
+	// supported by the decoded path, so if any series fetched from a namespace
+	// configured to store histograms is one the whole query is decoded.
+	if PeekHistogramSeriesIters(raw, s.clusters) {
+		defer cleanup()
+		fetchResult, err := storage.SeriesIteratorsToFetchResult(
+			raw,
+			s.readWorkerPool,
+			false,
+			enforcer,
+			opts.TagOptions(),
+		)
+		if err != nil {
+			return block.Result{}, err
+		}
+
+		return storage.FetchResultToBlockResult(fetchResult, query,
+			opts.LookbackDuration(), enforcer)
+	}
+
 	// TODO: mutating this array breaks the abstraction a bit, but it's the least fussy way I can think of to do this
 	// while maintaining the original pooling.
 	// Alternative would be to fetch a new MutableSeriesIterators() instance from the pool, populate it,
@@ -268,7 +283,11 @@ func (s *m3storage) fetchCompressed(
 		go func() {
 			session := namespace.Session()
 			ns := namespace.NamespaceID()
-			iters, _, err := session.FetchTagged(ns, m3query, opts)
+			iters, exhaustive, err := session.FetchTagged(ns, m3query, opts)
+			if err == nil && options.RequireExhaustive && !exhaustive {
+				iters.Close()
+				iters, err = nil, errQueryLimitExceeded
+			}
 			// Ignore error from getting iterator pools, since operation
 			// will not be dramatically impacted if pools is nil
 			result.Add(namespace.Options().Attributes(), iters, err)
@@ -454,7 +473,11 @@ func (s *m3storage) SearchCompressed(
 		go func() {
 			session := namespace.Session()
 			namespaceID := namespace.NamespaceID()
-			iter, _, err := session.FetchTaggedIDs(namespaceID, m3query, m3opts)
+			iter, exhaustive, err := session.FetchTaggedIDs(namespaceID, m3query, m3opts)
+			if err == nil && options.RequireExhaustive && !exhaustive {
+				iter.Finalize()
+				iter, err = nil, errQueryLimitExceeded
+			}
 			result.Add(iter, err)
 			wg.Done()
 		}()
@@ -527,6 +550,10 @@ func (s *m3storage) Type() storage.Type {
 	return storage.TypeLocalDC
 }
 
+func (s *m3storage) Name() string {
+	return "local"
+}
+
 func (s *m3storage) Close() error {
 	return nil
 }
@@ -34,6 +34,7 @@ type Storage interface {
 	storage.Storage
 
 	SetTypeResult(storage.Type)
+	SetNameResult(string)
 	LastFetchOptions() *storage.FetchOptions
 	SetFetchResult(*storage.FetchResult, error)
 	SetSearchSeriesResult(*storage.SearchResults, error)
@@ -49,6 +50,9 @@ type mockStorage struct {
 	typeResult struct {
 		result storage.Type
 	}
+	nameResult struct {
+		result string
+	}
 	lastFetchOptions *storage.FetchOptions
 	fetchResult      struct {
 		result *storage.FetchResult
@@ -86,6 +90,12 @@ func (s *mockStorage) SetTypeResult(result storage.Type) {
 	s.typeResult.result = result
 }
 
+func (s *mockStorage) SetNameResult(result string) {
+	s.Lock()
+	defer s.Unlock()
+	s.nameResult.result = result
+}
+
 func (s *mockStorage) SetFetchResult(result *storage.FetchResult, err error) {
 	s.Lock()
 	defer s.Unlock()
@@ -195,6 +205,12 @@ func (s *mockStorage) Type() storage.Type {
 	return s.typeResult.result
 }
 
+func (s *mockStorage) Name() string {
+	s.RLock()
+	defer s.RUnlock()
+	return s.nameResult.result
+}
+
 func (s *mockStorage) Close() error {
 	s.RLock()
 	defer s.RUnlock()
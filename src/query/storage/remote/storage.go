@@ -31,11 +31,13 @@ import (
 
 type remoteStorage struct {
 	client remote.Client
+	name   string
 }
 
-// NewStorage creates a new remote Storage instance.
-func NewStorage(c remote.Client) storage.Storage {
-	return &remoteStorage{client: c}
+// NewStorage creates a new remote Storage instance, the name identifies the
+// remote zone in warnings and metrics.
+func NewStorage(c remote.Client, name string) storage.Storage {
+	return &remoteStorage{client: c, name: name}
 }
 
 func (s *remoteStorage) Fetch(
@@ -78,6 +80,10 @@ func (s *remoteStorage) Type() storage.Type {
 	return storage.TypeRemoteDC
 }
 
+func (s *remoteStorage) Name() string {
+	return s.name
+}
+
 func (s *remoteStorage) Close() error {
 	return nil
 }
@@ -54,6 +54,8 @@ type Storage interface {
 	Appender
 	// Type identifies the type of the underlying storage
 	Type() Type
+	// Name identifies the underlying storage in warnings and metrics
+	Name() string
 	// Close is used to close the underlying storage and free up resources
 	Close() error
 }
@@ -85,6 +87,9 @@ func (q *FetchQuery) String() string {
 type FetchOptions struct {
 	// Limit is the maximum number of series to return.
 	Limit int
+	// RequireExhaustive requires that the fetch is not truncated by Limit,
+	// if more series match than the limit the fetch fails instead.
+	RequireExhaustive bool
 	// BlockType is the block type that the fetch function returns.
 	BlockType models.FetchedBlockType
 	// FanoutOptions are the options for the fetch namespace fanout.
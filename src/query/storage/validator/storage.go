@@ -158,6 +158,10 @@ func (s *debugStorage) Type() storage.Type {
 	return storage.TypeDebug
 }
 
+func (s *debugStorage) Name() string {
+	return "debug"
+}
+
 func (s *debugStorage) SearchSeries(
 	ctx context.Context,
 	query *storage.FetchQuery,
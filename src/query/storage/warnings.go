@@ -0,0 +1,95 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+type warningsContextKey struct{}
+
+// Warning is a non-fatal problem encountered while serving a read, such as
+// a storage that failed and was skipped, leaving the results partial.
+type Warning struct {
+	// Name identifies the source of the warning.
+	Name string
+	// Message describes the warning.
+	Message string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Name, w.Message)
+}
+
+// Warnings accumulates the warnings raised while serving a read. It is safe
+// for concurrent use, and a nil Warnings discards all warnings added to it.
+type Warnings struct {
+	sync.Mutex
+
+	warnings []Warning
+}
+
+// NewContextWithWarnings returns a context that collects the warnings raised
+// by reads made with it, along with the collector itself.
+func NewContextWithWarnings(ctx context.Context) (context.Context, *Warnings) {
+	warnings := &Warnings{}
+	return context.WithValue(ctx, warningsContextKey{}, warnings), warnings
+}
+
+// WarningsFromContext returns the warnings collector of the context, or nil
+// if the context does not collect warnings.
+func WarningsFromContext(ctx context.Context) *Warnings {
+	warnings, _ := ctx.Value(warningsContextKey{}).(*Warnings)
+	return warnings
+}
+
+// Add adds a warning.
+func (w *Warnings) Add(warning Warning) {
+	if w == nil {
+		return
+	}
+	w.Lock()
+	w.warnings = append(w.warnings, warning)
+	w.Unlock()
+}
+
+// All returns the warnings added so far.
+func (w *Warnings) All() []Warning {
+	if w == nil {
+		return nil
+	}
+	w.Lock()
+	defer w.Unlock()
+	return append([]Warning(nil), w.warnings...)
+}
+
+// Partial returns true if any warnings were added, meaning the results of
+// the read may be incomplete.
+func (w *Warnings) Partial() bool {
+	if w == nil {
+		return false
+	}
+	w.Lock()
+	defer w.Unlock()
+	return len(w.warnings) > 0
+}
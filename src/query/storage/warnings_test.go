@@ -0,0 +1,47 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarningsFromContext(t *testing.T) {
+	assert.Nil(t, WarningsFromContext(context.Background()))
+
+	ctx, warnings := NewContextWithWarnings(context.Background())
+	assert.False(t, warnings.Partial())
+
+	WarningsFromContext(ctx).Add(Warning{Name: "remote", Message: "unavailable"})
+	assert.True(t, warnings.Partial())
+	assert.Equal(t, []Warning{{Name: "remote", Message: "unavailable"}}, warnings.All())
+	assert.Equal(t, "remote: unavailable", warnings.All()[0].String())
+}
+
+func TestNilWarnings(t *testing.T) {
+	var warnings *Warnings
+	warnings.Add(Warning{Name: "remote", Message: "unavailable"})
+	assert.False(t, warnings.Partial())
+	assert.Nil(t, warnings.All())
+}
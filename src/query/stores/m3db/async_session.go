@@ -178,6 +178,18 @@ func (s *AsyncSession) Aggregate(namespace ident.ID, q index.Query, opts index.A
 	return s.session.Aggregate(namespace, q, opts)
 }
 
+// Cardinality returns the metric names, label names and label value pairs
+// with the most series in the namespace's reverse index across all hosts.
+func (s *AsyncSession) Cardinality(namespace ident.ID, opts index.CardinalityOptions) (index.CardinalityResult, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return index.CardinalityResult{}, s.err
+	}
+
+	return s.session.Cardinality(namespace, opts)
+}
+
 // ShardID returns the given shard for an ID for callers
 // to easily discern what shard is failing when operations
 // for given IDs begin failing.
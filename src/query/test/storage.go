@@ -90,6 +90,10 @@ func (s *slowStorage) Type() storage.Type {
 	return storage.TypeMultiDC
 }
 
+func (s *slowStorage) Name() string {
+	return s.storage.Name()
+}
+
 func (s *slowStorage) Close() error {
 	return nil
 }
@@ -48,6 +48,13 @@ func TestWrap(t *testing.T) {
 	assert.Error(t, wrappedErr)
 	assert.Equal(t, "context about nonretryable error: detailed error message", wrappedErr.Error())
 	assert.True(t, IsNonRetryableError(wrappedErr))
+
+	err = NewResourceExhaustedError(inner)
+	wrappedErr = Wrap(err, "context about resource exhausted error")
+	assert.Error(t, wrappedErr)
+	assert.Equal(t, "context about resource exhausted error: detailed error message", wrappedErr.Error())
+	assert.True(t, IsResourceExhausted(wrappedErr))
+	assert.False(t, IsInvalidParams(wrappedErr))
 }
 
 func TestWrapf(t *testing.T) {
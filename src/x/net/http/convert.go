@@ -101,6 +101,13 @@ func DurationToNanosMap(input map[string]interface{}) (map[string]interface{}, e
 				}
 
 				dictTranslated[k] = durMap
+			case []interface{}:
+				durSlice, err := durationToNanosSlice(vv)
+				if err != nil {
+					return nil, err
+				}
+
+				dictTranslated[k] = durSlice
 			default:
 				dictTranslated[k] = vv
 			}
@@ -109,3 +116,23 @@ func DurationToNanosMap(input map[string]interface{}) (map[string]interface{}, e
 
 	return dictTranslated, nil
 }
+
+func durationToNanosSlice(input []interface{}) ([]interface{}, error) {
+	sliceTranslated := make([]interface{}, 0, len(input))
+	for _, v := range input {
+		vv, ok := v.(map[string]interface{})
+		if !ok {
+			sliceTranslated = append(sliceTranslated, v)
+			continue
+		}
+
+		durMap, err := DurationToNanosMap(vv)
+		if err != nil {
+			return nil, err
+		}
+
+		sliceTranslated = append(sliceTranslated, durMap)
+	}
+
+	return sliceTranslated, nil
+}